@@ -0,0 +1,40 @@
+package db
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAuditLog(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Audit.CheckpointSigningKey = base64.StdEncoding.EncodeToString(make([]byte, ed25519.SeedSize))
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		require.NoError(t, s.Audit.CreateCheckpoints(ctx))
+		require.NoError(t, verifyAuditLog(ctx, s))
+
+		// Checkpoints whose signatures cannot be verified fail the verification.
+		signed := s.Audit
+		s.Audit, err = audit.NewService(config.DefaultServiceConfigFromEnv(), s.DB, s.Clock)
+		require.NoError(t, err)
+		require.EqualError(t, verifyAuditLog(ctx, s), "audit log checkpoint signatures not verified")
+
+		s.Audit = signed
+		_, err = s.DB.ExecContext(ctx, `UPDATE audit_logs SET action = 'forged' WHERE organization_id = $1`, org.ID)
+		require.NoError(t, err)
+		require.EqualError(t, verifyAuditLog(ctx, s), "audit log chain broken")
+	})
+}
@@ -0,0 +1,72 @@
+package keys
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func newCheck() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Compares the keys of the vaults against the root keys of the MPC server.",
+		Long: `Compares the keys of the vaults against the root keys of the MPC server.
+
+Reports keys referenced by vault_keys or wallets.key_id which are unknown to the MPC server,
+and root keys of the MPC server referenced by no vault. Retired keys are not expected on
+the MPC server. Exits with a non-zero code if any orphan is found.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			checkCmdFunc()
+		},
+	}
+}
+
+func checkCmdFunc() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+		log := util.LogFromContext(ctx)
+
+		report, err := s.Key.CheckConsistency(ctx)
+		if err != nil {
+			log.Err(err).Msg("Error while checking keys")
+			return err
+		}
+
+		for _, key := range report.Missing {
+			log.Error().
+				Str("keyId", key.KeyID).
+				Str("vaultId", key.VaultID).
+				Str("source", key.Source).
+				Msg("Key of vault is unknown to MPC server")
+		}
+		for _, key := range report.Orphaned {
+			log.Warn().
+				Str("keyId", key.KeyID).
+				Str("status", key.Status).
+				Str("protocol", key.Protocol).
+				Str("createdAt", key.CreatedAt).
+				Msg("Key of MPC server is referenced by no vault")
+		}
+
+		if len(report.Missing) > 0 || len(report.Orphaned) > 0 {
+			log.Error().
+				Int("checkedKeys", report.Checked).
+				Int("missingKeys", len(report.Missing)).
+				Int("orphanedKeys", len(report.Orphaned)).
+				Msg("Keys are inconsistent")
+			return errors.New("orphaned keys found")
+		}
+
+		log.Info().Int("checkedKeys", report.Checked).Msg("Successfully checked keys")
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to check keys")
+	}
+}
@@ -0,0 +1,12 @@
+package keys
+
+import (
+	"github.com/kashguard/go-mpc-vault/internal/util/command"
+	"github.com/spf13/cobra"
+)
+
+func New() *cobra.Command {
+	return command.NewSubcommandGroup("keys",
+		newCheck(),
+	)
+}
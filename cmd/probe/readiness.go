@@ -79,7 +79,8 @@ func RunReadiness(ctx context.Context, config config.Server, flags ReadinessFlag
 	readinessCtx, cancel := context.WithTimeout(context.Background(), config.Management.ReadinessTimeout)
 	defer cancel()
 
-	str, errs := common.ProbeReadiness(readinessCtx, db, config.Management.ProbeWriteablePathsAbs)
+	// The connection to the MPC server is probed by the running server only, see /-/ready.
+	str, errs := common.ProbeReadiness(readinessCtx, db, nil, config.Management.ProbeWriteablePathsAbs)
 
 	if flags.Verbose {
 		log.Info().Msg(str)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/kashguard/go-mpc-vault/cmd/db"
 	"github.com/kashguard/go-mpc-vault/cmd/env"
+	"github.com/kashguard/go-mpc-vault/cmd/keys"
 	"github.com/kashguard/go-mpc-vault/cmd/probe"
 	"github.com/kashguard/go-mpc-vault/cmd/server"
 	"github.com/kashguard/go-mpc-vault/internal/config"
@@ -33,6 +34,7 @@ func Execute() {
 	rootCmd.AddCommand(
 		db.New(),
 		env.New(),
+		keys.New(),
 		probe.New(),
 		server.New(),
 	)
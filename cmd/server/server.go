@@ -23,6 +23,7 @@ type Flags struct {
 	ProbeReadiness  bool
 	ApplyMigrations bool
 	SeedFixtures    bool
+	FakeMpc         bool
 }
 
 func New() *cobra.Command {
@@ -43,12 +44,16 @@ func New() *cobra.Command {
 	cmd.Flags().BoolVarP(&flags.ProbeReadiness, "probe", "p", false, "Probe readiness before startup.")
 	cmd.Flags().BoolVarP(&flags.ApplyMigrations, "migrate", "m", false, "Apply migrations before startup.")
 	cmd.Flags().BoolVarP(&flags.SeedFixtures, "seed", "s", false, "Seed fixtures into database before startup.")
+	cmd.Flags().BoolVar(&flags.FakeMpc, "fake-mpc", false, "Use an in-memory fake MPC server instead of connecting to one, for local development only.")
 
 	return cmd
 }
 
 func runServer(flags Flags) {
-	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Mpc.Fake = flags.FakeMpc
+
+	err := command.WithServer(context.Background(), cfg, func(ctx context.Context, s *api.Server) error {
 		log := util.LogFromContext(ctx)
 
 		if flags.ProbeReadiness {
@@ -83,6 +88,30 @@ func runServer(flags Flags) {
 			log.Fatal().Err(err).Msg("Failed to initialize router")
 		}
 
+		checkpointCtx, cancelCheckpoints := context.WithCancel(ctx)
+		defer cancelCheckpoints()
+		go s.Audit.RunCheckpoints(checkpointCtx)
+
+		depositCtx, cancelDeposits := context.WithCancel(ctx)
+		defer cancelDeposits()
+		go s.Deposit.Run(depositCtx)
+
+		outboxCtx, cancelOutbox := context.WithCancel(ctx)
+		defer cancelOutbox()
+		go s.Outbox.Run(outboxCtx)
+
+		backupHealthCtx, cancelBackupHealth := context.WithCancel(ctx)
+		defer cancelBackupHealth()
+		go s.Backup.Run(backupHealthCtx)
+
+		keyRefreshCtx, cancelKeyRefresh := context.WithCancel(ctx)
+		defer cancelKeyRefresh()
+		go s.Key.Run(keyRefreshCtx)
+
+		nodeRevocationCtx, cancelNodeRevocation := context.WithCancel(ctx)
+		defer cancelNodeRevocation()
+		go s.Device.Run(nodeRevocationCtx)
+
 		go func() {
 			if err := s.Start(); err != nil {
 				if errors.Is(err, http.ErrServerClosed) {
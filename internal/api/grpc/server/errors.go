@@ -0,0 +1,35 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusFromError maps the public HTTP errors returned by our services to gRPC status codes,
+// any other error is reported as codes.Internal.
+func statusFromError(msg string, err error) error {
+	var httpErr *httperrors.HTTPError
+	if !errors.As(err, &httpErr) {
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+
+	code := codes.Internal
+	switch int(*httpErr.Code) {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.FailedPrecondition
+	}
+
+	return status.Errorf(code, "%s: %s", msg, *httpErr.Title)
+}
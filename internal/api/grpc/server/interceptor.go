@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/go-openapi/strfmt"
+	apiv1 "github.com/kashguard/go-mpc-vault/internal/api/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/data/mapper"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	userAgentMetadataKey     = "user-agent"
+)
+
+// RequestOriginUnaryInterceptor stores the address of the gRPC peer and its user agent in the call
+// context, the gRPC counterpart of middleware.RequestOrigin.
+func RequestOriginUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var remoteAddr, userAgent string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			remoteAddr = p.Addr.String()
+		}
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(userAgentMetadataKey); len(values) > 0 {
+				userAgent = values[0]
+			}
+		}
+
+		return handler(util.WithRequestOrigin(ctx, util.NewRequestOrigin(remoteAddr, userAgent)), req)
+	}
+}
+
+// AuthUnaryInterceptor authenticates gRPC calls using the same bearer access tokens as the REST API
+// (see middleware.Auth). Calls to the AuthService are allowed without a token as they are used to obtain one.
+func AuthUnaryInterceptor(db *sql.DB, clock time2.Clock) grpc.UnaryServerInterceptor {
+	publicServicePrefix := "/" + apiv1.AuthService_ServiceDesc.ServiceName + "/"
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, publicServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		log := util.LogFromContext(ctx).With().Str("interceptor", "auth").Str("method", info.FullMethod).Logger()
+
+		token := bearerTokenFromMetadata(ctx)
+		if len(token) == 0 {
+			log.Trace().Msg("Call has missing or malformed token, rejecting")
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		if !strfmt.IsUUID4(token) {
+			log.Trace().Msg("Call has malformed token, rejecting")
+			return nil, status.Error(codes.InvalidArgument, "auth token is malformed")
+		}
+
+		accessToken, err := models.AccessTokens(
+			models.AccessTokenWhere.Token.EQ(token),
+			qm.Load(qm.Rels(models.AccessTokenRels.User, models.UserRels.AppUserProfile)),
+		).One(ctx, db)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Trace().Msg("Access token not found in database, rejecting")
+				return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+			}
+
+			log.Error().Err(err).Msg("Failed to query for access token in database, aborting call")
+			return nil, status.Error(codes.Internal, "failed to validate bearer token")
+		}
+
+		if clock.Now().After(accessToken.ValidUntil) {
+			log.Trace().Time("valid_until", accessToken.ValidUntil).Msg("Auth token is expired, rejecting")
+			return nil, status.Error(codes.Unauthenticated, "bearer token expired")
+		}
+
+		user := mapper.LocalUserToDTO(accessToken.R.User).Ptr()
+		if !user.IsActive {
+			log.Trace().Str("user_id", user.ID).Msg("User is deactivated, rejecting")
+			return nil, status.Error(codes.PermissionDenied, "user account is deactivated")
+		}
+
+		ctx = auth.EnrichContextWithCredentials(ctx, auth.Result{
+			Token:      accessToken.Token,
+			User:       user,
+			ValidUntil: accessToken.ValidUntil,
+		})
+
+		return handler(ctx, req)
+	}
+}
+
+func bearerTokenFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	token, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found {
+		return ""
+	}
+
+	return token
+}
+
+// userFromContext returns the user authenticated by AuthUnaryInterceptor.
+func userFromContext(ctx context.Context) (string, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return "", status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return user.ID, nil
+}
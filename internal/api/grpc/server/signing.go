@@ -2,9 +2,13 @@ package server
 
 import (
 	"context"
+	"time"
 
 	apiv1 "github.com/kashguard/go-mpc-vault/internal/api/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
 	"github.com/kashguard/go-mpc-vault/internal/service/signing"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,21 +17,32 @@ import (
 type SigningServer struct {
 	apiv1.UnimplementedSigningServiceServer
 	service signing.Service
+	vault   vault.Service
 }
 
-func NewSigningServer(s signing.Service) *SigningServer {
+func NewSigningServer(s signing.Service, v vault.Service) *SigningServer {
 	return &SigningServer{
 		service: s,
+		vault:   v,
 	}
 }
 
-func (s *SigningServer) CreateSigningRequest(ctx context.Context, req *apiv1.CreateSigningRequest) (*apiv1.CreateSigningResponse, error) {
-	// TODO: Get UserID from context
-	userID := "default-user"
+func (s *SigningServer) CreateRequest(ctx context.Context, req *apiv1.CreateSigningRequest) (*apiv1.CreateSigningResponse, error) {
+	userID, err := s.authorizeVault(ctx, req.GetVaultId())
+	if err != nil {
+		return nil, err
+	}
 
-	r, err := s.service.CreateRequest(ctx, req.GetWalletId(), req.GetTxData(), req.GetNote(), userID)
+	r, err := s.service.CreateRequest(ctx, signing.CreateRequestParams{
+		VaultID:   req.GetVaultId(),
+		WalletID:  req.GetWalletId(),
+		ToAddress: req.GetToAddress(),
+		TxData:    req.GetTxData(),
+		Note:      req.GetNote(),
+		UserID:    userID,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create request: %v", err)
+		return nil, statusFromError("failed to create request", err)
 	}
 
 	return &apiv1.CreateSigningResponse{
@@ -36,9 +51,25 @@ func (s *SigningServer) CreateSigningRequest(ctx context.Context, req *apiv1.Cre
 	}, nil
 }
 
-func (s *SigningServer) ApproveSigningRequest(ctx context.Context, req *apiv1.ApproveSigningRequest) (*apiv1.ApproveSigningResponse, error) {
-	// TODO: Get UserID from context
-	userID := "default-user"
+func (s *SigningServer) ApproveRequest(ctx context.Context, req *apiv1.ApproveSigningRequest) (*apiv1.ApproveSigningResponse, error) {
+	r, err := s.service.GetRequest(ctx, req.GetRequestId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "request not found")
+	}
+
+	userID, err := s.authorizeVault(ctx, r.VaultID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAction() == "reject" {
+		if err := s.service.RejectRequest(ctx, req.GetRequestId(), userID); err != nil {
+			return nil, statusFromError("failed to reject request", err)
+		}
+		return &apiv1.ApproveSigningResponse{
+			Status: "rejected",
+		}, nil
+	}
 
 	params := signing.ApprovalParams{
 		UserID:            userID,
@@ -48,17 +79,8 @@ func (s *SigningServer) ApproveSigningRequest(ctx context.Context, req *apiv1.Ap
 		ClientDataJSON:    req.GetClientDataJson(),
 	}
 
-	// Workaround: We'll assume the client sends a JSON string in `Comment` containing all auth data
-	// if we can't update proto easily.
-	// But let's check if we can update proto. We generated it earlier.
-	// Wait, the `ApproveSigningRequest` message in `api/v1/signing.proto` has:
-	// RequestId, Action, Comment.
-	// It DOES NOT have CredentialId, Signature, AuthenticatorData, ClientDataJson.
-
-	// I should update the proto file first.
-
 	if err := s.service.ApproveRequest(ctx, req.GetRequestId(), params); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to approve request: %v", err)
+		return nil, statusFromError("failed to approve request", err)
 	}
 
 	return &apiv1.ApproveSigningResponse{
@@ -66,6 +88,60 @@ func (s *SigningServer) ApproveSigningRequest(ctx context.Context, req *apiv1.Ap
 	}, nil
 }
 
+func (s *SigningServer) ListRequests(ctx context.Context, req *apiv1.ListSigningRequestsRequest) (*apiv1.ListSigningRequestsResponse, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, total, err := s.service.ListRequests(ctx, userID, req.GetOrganizationId(), req.GetVaultId(), req.GetStatus(), int(req.GetPage()), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list requests: %v", err)
+	}
+
+	res := &apiv1.ListSigningRequestsResponse{
+		Requests: make([]*apiv1.SigningRequest, 0, len(items)),
+		Total:    int32(total), //nolint:gosec
+	}
+	for _, r := range items {
+		item := &apiv1.SigningRequest{
+			Id:        r.ID,
+			VaultId:   r.VaultID.String,
+			WalletId:  r.WalletID.String,
+			ToAddress: r.ToAddress.String,
+			Status:    r.Status.String,
+		}
+		if r.Amount.Big != nil {
+			item.Amount = r.Amount.String()
+		}
+		if r.CreatedAt.Valid {
+			item.CreatedAt = r.CreatedAt.Time.Format(time.RFC3339)
+		}
+		res.Requests = append(res.Requests, item)
+	}
+
+	return res, nil
+}
+
+// authorizeVault ensures the authenticated user may act on signing requests of the vault,
+// auditors only have read access. Returns the ID of the user.
+func (s *SigningServer) authorizeVault(ctx context.Context, vaultID string) (string, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	_, role, err := s.vault.GetVaultMemberRole(ctx, vaultID, userID)
+	if err != nil {
+		return "", statusFromError("failed to authorize vault access", err)
+	}
+	if role == organization.RoleAuditor {
+		return "", statusFromError("failed to authorize vault access", httperrors.ErrForbiddenInsufficientRole)
+	}
+
+	return userID, nil
+}
+
 func RegisterSigningServer(s *grpc.Server, srv *SigningServer) {
 	apiv1.RegisterSigningServiceServer(s, srv)
 }
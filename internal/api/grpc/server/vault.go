@@ -2,8 +2,12 @@ package server
 
 import (
 	"context"
+	"time"
 
 	apiv1 "github.com/kashguard/go-mpc-vault/internal/api/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
 	"github.com/kashguard/go-mpc-vault/internal/service/vault"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,49 +16,67 @@ import (
 
 type VaultServer struct {
 	apiv1.UnimplementedVaultServiceServer
-	service vault.Service
+	service      vault.Service
+	organization organization.Service
 }
 
-func NewVaultServer(s vault.Service) *VaultServer {
+func NewVaultServer(s vault.Service, org organization.Service) *VaultServer {
 	return &VaultServer{
-		service: s,
+		service:      s,
+		organization: org,
 	}
 }
 
 func (s *VaultServer) CreateVault(ctx context.Context, req *apiv1.CreateVaultRequest) (*apiv1.CreateVaultResponse, error) {
-	// TODO: Get OrgID from context
-	orgID := "default-org"
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	v, err := s.service.CreateVault(ctx, req.GetName(), orgID)
+	orgID, err := s.organization.ResolveOrganization(ctx, userID, req.GetOrganizationId())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create vault: %v", err)
+		return nil, statusFromError("failed to resolve organization", err)
+	}
+	if err := s.requireWriteRole(ctx, orgID, userID); err != nil {
+		return nil, err
 	}
 
-	// TODO: Handle threshold and chains initialization if service supports it
-	// Current service implementation only inserts vault record.
-	// We might need to extend service to support initial wallets.
+	keys := make([]vault.KeyConfig, 0, len(req.GetKeys()))
+	for _, key := range req.GetKeys() {
+		keys = append(keys, vault.KeyConfig{
+			Curve:      key.GetCurve(),
+			Protocol:   key.GetProtocol(),
+			Threshold:  int(key.GetThreshold()),
+			TotalNodes: int(key.GetTotalNodes()),
+		})
+	}
 
-	if len(req.GetChains()) > 0 {
-		for _, chainID := range req.GetChains() {
-			_, err := s.service.CreateWallet(ctx, v.ID, chainID)
-			if err != nil {
-				// Log error but don't fail entire request? Or fail?
-				// For now, fail.
-				return nil, status.Errorf(codes.Internal, "failed to create wallet for chain %s: %v", chainID, err)
-			}
+	v, err := s.service.CreateVault(ctx, req.GetName(), orgID, int(req.GetThreshold()), userID, keys)
+	if err != nil {
+		return nil, statusFromError("failed to create vault", err)
+	}
+
+	for _, chainID := range req.GetChains() {
+		if _, err := s.service.CreateWallet(ctx, v.ID, chainID, userID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create wallet for chain %s: %v", chainID, err)
 		}
 	}
 
 	return &apiv1.CreateVaultResponse{
 		VaultId: v.ID,
-		Status:  "active",
+		Status:  v.Status,
 	}, nil
 }
 
 func (s *VaultServer) CreateWallet(ctx context.Context, req *apiv1.CreateWalletRequest) (*apiv1.CreateWalletResponse, error) {
-	w, err := s.service.CreateWallet(ctx, req.GetVaultId(), req.GetChainId())
+	userID, err := s.authorizeVault(ctx, req.GetVaultId(), true)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create wallet: %v", err)
+		return nil, err
+	}
+
+	w, err := s.service.CreateWallet(ctx, req.GetVaultId(), req.GetChainId(), userID)
+	if err != nil {
+		return nil, statusFromError("failed to create wallet", err)
 	}
 
 	return &apiv1.CreateWalletResponse{
@@ -64,6 +86,187 @@ func (s *VaultServer) CreateWallet(ctx context.Context, req *apiv1.CreateWalletR
 	}, nil
 }
 
+func (s *VaultServer) ListVaults(ctx context.Context, req *apiv1.ListVaultsRequest) (*apiv1.ListVaultsResponse, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := s.organization.ResolveOrganization(ctx, userID, req.GetOrganizationId())
+	if err != nil {
+		return nil, statusFromError("failed to resolve organization", err)
+	}
+
+	vaults, total, err := s.service.ListVaults(ctx, orgID, int(req.GetPage()), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list vaults: %v", err)
+	}
+
+	res := &apiv1.ListVaultsResponse{
+		Vaults: make([]*apiv1.Vault, 0, len(vaults)),
+		Total:  int32(total), //nolint:gosec
+	}
+	for _, v := range vaults {
+		res.Vaults = append(res.Vaults, mapVault(v))
+	}
+
+	return res, nil
+}
+
+func (s *VaultServer) GetVault(ctx context.Context, req *apiv1.GetVaultRequest) (*apiv1.GetVaultResponse, error) {
+	if _, err := s.authorizeVault(ctx, req.GetVaultId(), false); err != nil {
+		return nil, err
+	}
+
+	v, err := s.service.GetVault(ctx, req.GetVaultId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "vault not found: %v", err)
+	}
+
+	return &apiv1.GetVaultResponse{
+		Vault: mapVault(v),
+	}, nil
+}
+
+func (s *VaultServer) UpdateVault(ctx context.Context, req *apiv1.UpdateVaultRequest) (*apiv1.UpdateVaultResponse, error) {
+	userID, err := s.authorizeVault(ctx, req.GetVaultId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &apiv1.UpdateVaultResponse{}
+
+	if req.GetName() != "" {
+		if _, err := s.service.RenameVault(ctx, req.GetVaultId(), req.GetName(), userID); err != nil {
+			return nil, statusFromError("failed to rename vault", err)
+		}
+	}
+
+	if req.GetThreshold() != 0 {
+		p, err := s.service.ProposeThresholdChange(ctx, req.GetVaultId(), userID, int(req.GetThreshold()))
+		if err != nil {
+			return nil, statusFromError("failed to propose threshold change", err)
+		}
+		res.ThresholdChange = &apiv1.VaultProposal{
+			Id:                p.ID,
+			VaultId:           p.VaultID,
+			Kind:              p.Kind,
+			Status:            p.Status,
+			Threshold:         req.GetThreshold(),
+			RequiredApprovals: int32(p.RequiredApprovals), //nolint:gosec
+		}
+	}
+
+	v, err := s.service.GetVault(ctx, req.GetVaultId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "vault not found: %v", err)
+	}
+	res.Vault = mapVault(v)
+
+	return res, nil
+}
+
+func (s *VaultServer) ArchiveVault(ctx context.Context, req *apiv1.ArchiveVaultRequest) (*apiv1.ArchiveVaultResponse, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, role, err := s.service.GetVaultMemberRole(ctx, req.GetVaultId(), userID)
+	if err != nil {
+		return nil, statusFromError("failed to authorize vault access", err)
+	}
+	if role != organization.RoleOwner && role != organization.RoleAdmin {
+		return nil, statusFromError("failed to archive vault", httperrors.ErrForbiddenInsufficientRole)
+	}
+
+	if _, err := s.service.ArchiveVault(ctx, req.GetVaultId(), userID); err != nil {
+		return nil, statusFromError("failed to archive vault", err)
+	}
+
+	v, err := s.service.GetVault(ctx, req.GetVaultId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "vault not found: %v", err)
+	}
+
+	return &apiv1.ArchiveVaultResponse{
+		Vault: mapVault(v),
+	}, nil
+}
+
+// authorizeVault ensures the authenticated user belongs to the organization owning the vault and,
+// if write is set, is not restricted to read-only access. Returns the ID of the user.
+func (s *VaultServer) authorizeVault(ctx context.Context, vaultID string, write bool) (string, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	_, role, err := s.service.GetVaultMemberRole(ctx, vaultID, userID)
+	if err != nil {
+		return "", statusFromError("failed to authorize vault access", err)
+	}
+	if write && role == organization.RoleAuditor {
+		return "", statusFromError("failed to authorize vault access", httperrors.ErrForbiddenInsufficientRole)
+	}
+
+	return userID, nil
+}
+
+func (s *VaultServer) requireWriteRole(ctx context.Context, orgID string, userID string) error {
+	role, err := s.organization.MemberRole(ctx, orgID, userID)
+	if err != nil {
+		return statusFromError("failed to authorize organization access", err)
+	}
+	if role == organization.RoleAuditor {
+		return statusFromError("failed to authorize organization access", httperrors.ErrForbiddenInsufficientRole)
+	}
+	return nil
+}
+
+func mapVault(v *models.Vault) *apiv1.Vault {
+	res := &apiv1.Vault{
+		Id:             v.ID,
+		Name:           v.Name,
+		Threshold:      int32(v.Threshold), //nolint:gosec
+		OrganizationId: v.OrganizationID.String,
+		Status:         v.Status,
+		WhitelistOnly:  v.WhitelistOnly,
+	}
+	if v.CreatedAt.Valid {
+		res.CreatedAt = v.CreatedAt.Time.Format(time.RFC3339)
+	}
+	if v.ArchivedAt.Valid {
+		res.ArchivedAt = v.ArchivedAt.Time.Format(time.RFC3339)
+	}
+	if v.R == nil {
+		return res
+	}
+	for _, w := range v.R.Wallets {
+		res.Wallets = append(res.Wallets, &apiv1.Wallet{
+			Id:         w.ID,
+			ChainId:    w.ChainID.String,
+			Address:    w.Address,
+			KeyId:      w.KeyID,
+			DerivePath: w.DerivePath,
+		})
+	}
+	for _, k := range v.R.VaultKeys {
+		res.Keys = append(res.Keys, &apiv1.VaultKey{
+			Id:           k.ID,
+			KeyId:        k.KeyID,
+			Algorithm:    k.Algorithm,
+			Curve:        k.Curve,
+			PublicKeyHex: k.PublicKeyHex,
+			Protocol:     k.Protocol.String,
+			Threshold:    int32(k.Threshold.Int),  //nolint:gosec
+			TotalNodes:   int32(k.TotalNodes.Int), //nolint:gosec
+			RefreshEpoch: int32(k.RefreshEpoch),   //nolint:gosec
+		})
+	}
+	return res
+}
+
 func RegisterVaultServer(s *grpc.Server, srv *VaultServer) {
 	apiv1.RegisterVaultServiceServer(s, srv)
 }
@@ -26,6 +26,12 @@ type VaultServiceClient interface {
 	CreateVault(ctx context.Context, in *CreateVaultRequest, opts ...grpc.CallOption) (*CreateVaultResponse, error)
 	// List vaults
 	ListVaults(ctx context.Context, in *ListVaultsRequest, opts ...grpc.CallOption) (*ListVaultsResponse, error)
+	// Get a vault including its wallets and keys
+	GetVault(ctx context.Context, in *GetVaultRequest, opts ...grpc.CallOption) (*GetVaultResponse, error)
+	// Rename a vault and/or propose a threshold change (applied after quorum approval)
+	UpdateVault(ctx context.Context, in *UpdateVaultRequest, opts ...grpc.CallOption) (*UpdateVaultResponse, error)
+	// Archive a vault (freezes signing, keeps history)
+	ArchiveVault(ctx context.Context, in *ArchiveVaultRequest, opts ...grpc.CallOption) (*ArchiveVaultResponse, error)
 	// Create/Derive a wallet in a vault (requires Passkey signature)
 	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
 }
@@ -56,6 +62,33 @@ func (c *vaultServiceClient) ListVaults(ctx context.Context, in *ListVaultsReque
 	return out, nil
 }
 
+func (c *vaultServiceClient) GetVault(ctx context.Context, in *GetVaultRequest, opts ...grpc.CallOption) (*GetVaultResponse, error) {
+	out := new(GetVaultResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.VaultService/GetVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) UpdateVault(ctx context.Context, in *UpdateVaultRequest, opts ...grpc.CallOption) (*UpdateVaultResponse, error) {
+	out := new(UpdateVaultResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.VaultService/UpdateVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ArchiveVault(ctx context.Context, in *ArchiveVaultRequest, opts ...grpc.CallOption) (*ArchiveVaultResponse, error) {
+	out := new(ArchiveVaultResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.VaultService/ArchiveVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vaultServiceClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
 	out := new(CreateWalletResponse)
 	err := c.cc.Invoke(ctx, "/api.v1.VaultService/CreateWallet", in, out, opts...)
@@ -73,6 +106,12 @@ type VaultServiceServer interface {
 	CreateVault(context.Context, *CreateVaultRequest) (*CreateVaultResponse, error)
 	// List vaults
 	ListVaults(context.Context, *ListVaultsRequest) (*ListVaultsResponse, error)
+	// Get a vault including its wallets and keys
+	GetVault(context.Context, *GetVaultRequest) (*GetVaultResponse, error)
+	// Rename a vault and/or propose a threshold change (applied after quorum approval)
+	UpdateVault(context.Context, *UpdateVaultRequest) (*UpdateVaultResponse, error)
+	// Archive a vault (freezes signing, keeps history)
+	ArchiveVault(context.Context, *ArchiveVaultRequest) (*ArchiveVaultResponse, error)
 	// Create/Derive a wallet in a vault (requires Passkey signature)
 	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
 	mustEmbedUnimplementedVaultServiceServer()
@@ -88,6 +127,15 @@ func (UnimplementedVaultServiceServer) CreateVault(context.Context, *CreateVault
 func (UnimplementedVaultServiceServer) ListVaults(context.Context, *ListVaultsRequest) (*ListVaultsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVaults not implemented")
 }
+func (UnimplementedVaultServiceServer) GetVault(context.Context, *GetVaultRequest) (*GetVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVault not implemented")
+}
+func (UnimplementedVaultServiceServer) UpdateVault(context.Context, *UpdateVaultRequest) (*UpdateVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVault not implemented")
+}
+func (UnimplementedVaultServiceServer) ArchiveVault(context.Context, *ArchiveVaultRequest) (*ArchiveVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveVault not implemented")
+}
 func (UnimplementedVaultServiceServer) CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
 }
@@ -140,6 +188,60 @@ func _VaultService_ListVaults_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VaultService_GetVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.VaultService/GetVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetVault(ctx, req.(*GetVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_UpdateVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).UpdateVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.VaultService/UpdateVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).UpdateVault(ctx, req.(*UpdateVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ArchiveVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ArchiveVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.VaultService/ArchiveVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ArchiveVault(ctx, req.(*ArchiveVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VaultService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateWalletRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +275,18 @@ var VaultService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListVaults",
 			Handler:    _VaultService_ListVaults_Handler,
 		},
+		{
+			MethodName: "GetVault",
+			Handler:    _VaultService_GetVault_Handler,
+		},
+		{
+			MethodName: "UpdateVault",
+			Handler:    _VaultService_UpdateVault_Handler,
+		},
+		{
+			MethodName: "ArchiveVault",
+			Handler:    _VaultService_ArchiveVault_Handler,
+		},
 		{
 			MethodName: "CreateWallet",
 			Handler:    _VaultService_CreateWallet_Handler,
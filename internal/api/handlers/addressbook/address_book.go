@@ -0,0 +1,38 @@
+package addressbook
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+// memberRole returns the role of the authenticated user within the organization.
+func memberRole(ctx context.Context, s *api.Server, orgID string) (string, error) {
+	u := auth.UserFromContext(ctx)
+	return s.Organization.MemberRole(ctx, orgID, u.ID)
+}
+
+func mapEntry(e *models.AddressBook) *types.AddressBookEntry {
+	entry := &types.AddressBookEntry{
+		ID:            conv.UUID4(strfmt.UUID4(e.ID)),
+		ChainID:       swag.String(e.ChainID.String),
+		Address:       swag.String(e.Address),
+		Name:          swag.String(e.Name),
+		IsWhitelisted: swag.Bool(e.IsWhitelisted.Bool),
+		Status:        swag.String(e.Status),
+		CreatedBy:     strfmt.UUID4(e.CreatedBy.String),
+		ApprovedBy:    strfmt.UUID4(e.ApprovedBy.String),
+		CreatedAt:     strfmt.DateTime(e.CreatedAt.Time),
+	}
+	if e.UsableAfter.Valid {
+		entry.UsableAfter = strfmt.DateTime(e.UsableAfter.Time)
+	}
+	return entry
+}
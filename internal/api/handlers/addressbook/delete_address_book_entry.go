@@ -0,0 +1,36 @@
+package addressbook
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteAddressBookEntryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.DELETE("/:orgId/address-book/:entryId", deleteAddressBookEntryHandler(s))
+}
+
+func deleteAddressBookEntryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := addressbook.NewDeleteAddressBookEntryRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		if err := s.AddressBook.DeleteEntry(ctx, orgID, params.EntryID.String(), auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,44 @@
+package addressbook
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListAddressBookEntriesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/address-book", getListAddressBookEntriesHandler(s))
+}
+
+func getListAddressBookEntriesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := addressbook.NewGetListAddressBookEntriesRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if _, err := memberRole(ctx, s, orgID); err != nil {
+			return err
+		}
+
+		entries, err := s.AddressBook.ListEntries(ctx, orgID, swag.StringValue(params.ChainID))
+		if err != nil {
+			return err
+		}
+
+		items := make([]*types.AddressBookEntry, 0, len(entries))
+		for _, e := range entries {
+			items = append(items, mapEntry(e))
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListAddressBookEntriesResponse{
+			Entries: items,
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package addressbook
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PatchUpdateAddressBookEntryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.PATCH("/:orgId/address-book/:entryId", patchUpdateAddressBookEntryHandler(s))
+}
+
+func patchUpdateAddressBookEntryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := addressbook.NewPatchUpdateAddressBookEntryRouteParams()
+		var body types.UpdateAddressBookEntryPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		entry, err := s.AddressBook.RenameEntry(ctx, orgID, params.EntryID.String(), swag.StringValue(body.Name), auth.UserFromContext(ctx).ID)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapEntry(entry))
+	}
+}
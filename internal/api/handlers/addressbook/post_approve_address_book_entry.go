@@ -0,0 +1,38 @@
+package addressbook
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostApproveAddressBookEntryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/address-book/:entryId/approve", postApproveAddressBookEntryHandler(s))
+}
+
+func postApproveAddressBookEntryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := addressbook.NewPostApproveAddressBookEntryRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		u := auth.UserFromContext(ctx)
+		entry, err := s.AddressBook.ApproveEntry(ctx, orgID, params.EntryID.String(), u.ID)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapEntry(entry))
+	}
+}
@@ -0,0 +1,55 @@
+package addressbook
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	addressBookService "github.com/kashguard/go-mpc-vault/internal/service/addressbook"
+	organizationService "github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostCreateAddressBookEntryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/address-book", postCreateAddressBookEntryHandler(s))
+}
+
+func postCreateAddressBookEntryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := addressbook.NewPostCreateAddressBookEntryRouteParams()
+		var body types.CreateAddressBookEntryPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		role, err := memberRole(ctx, s, orgID)
+		if err != nil {
+			return err
+		}
+		if role == organizationService.RoleAuditor {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		u := auth.UserFromContext(ctx)
+		entry, err := s.AddressBook.CreateEntry(ctx, addressBookService.CreateEntryParams{
+			OrganizationID: orgID,
+			ChainID:        swag.StringValue(body.ChainID),
+			Address:        swag.StringValue(body.Address),
+			Name:           swag.StringValue(body.Name),
+			Whitelisted:    body.IsWhitelisted,
+			UserID:         u.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapEntry(entry))
+	}
+}
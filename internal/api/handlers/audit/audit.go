@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	organizationService "github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+// requireReader ensures the authenticated user may read the audit log of the organization,
+// which is limited to its owner, admins and auditors.
+func requireReader(ctx context.Context, s *api.Server, orgID string) error {
+	u := auth.UserFromContext(ctx)
+	role, err := s.Organization.MemberRole(ctx, orgID, u.ID)
+	if err != nil {
+		return err
+	}
+	if role != organizationService.RoleOwner && role != organizationService.RoleAdmin && role != organizationService.RoleAuditor {
+		return httperrors.ErrForbiddenInsufficientRole
+	}
+	return nil
+}
+
+func mapEntry(e *models.AuditLog) *types.AuditLogEntry {
+	entry := &types.AuditLogEntry{
+		ID:           conv.UUID4(strfmt.UUID4(e.ID)),
+		Sequence:     e.Sequence.Int64,
+		PrevHash:     e.PrevHash.String,
+		Hash:         e.Hash.String,
+		UserID:       strfmt.UUID4(e.UserID.String),
+		Action:       swag.String(e.Action),
+		ResourceType: e.ResourceType.String,
+		ResourceID:   e.ResourceID.String,
+		IPAddress:    e.IPAddress.String,
+		UserAgent:    e.UserAgent.String,
+		CredentialID: e.CredentialID.String,
+		CreatedAt:    conv.DateTime(strfmt.DateTime(e.CreatedAt)),
+	}
+	if e.Details.Valid {
+		entry.Details = json.RawMessage(e.Details.JSON)
+	}
+	return entry
+}
+
+func timeValue(t *strfmt.DateTime) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return time.Time(*t)
+}
+
+func uuidValue(id *strfmt.UUID4) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,152 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	auditService "github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/types/audit"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// csvHeader lists the columns of CSV downloads.
+var csvHeader = []string{
+	"id", "sequence", "created_at", "user_id", "action", "resource_type", "resource_id",
+	"ip_address", "user_agent", "credential_id", "details", "prev_hash", "hash",
+}
+
+func GetDownloadAuditLogsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/audit-logs/download", getDownloadAuditLogsHandler(s))
+}
+
+func getDownloadAuditLogsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := audit.NewGetDownloadAuditLogsRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := requireReader(ctx, s, orgID); err != nil {
+			return err
+		}
+
+		format := swag.StringValue(params.Format)
+		contentType := "text/csv"
+		if format == auditService.FormatNDJSON {
+			contentType = "application/x-ndjson"
+		}
+
+		res := c.Response()
+		csvWriter := csv.NewWriter(res)
+		encoder := json.NewEncoder(res)
+		written := 0
+
+		// The response is started with the first entry, so errors occurring beforehand are still returned as such.
+		start := func() error {
+			res.Header().Set(echo.HeaderContentType, contentType)
+			res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("audit-logs-%s.%s", orgID, format)))
+			res.WriteHeader(http.StatusOK)
+			if format == auditService.FormatCSV {
+				return csvWriter.Write(csvHeader)
+			}
+			return nil
+		}
+
+		err := s.Audit.StreamEntries(ctx, auditService.ListEntriesParams{
+			OrganizationID: orgID,
+			UserID:         uuidValue(params.UserID),
+			Action:         swag.StringValue(params.Action),
+			ResourceType:   swag.StringValue(params.ResourceType),
+			ResourceID:     swag.StringValue(params.ResourceID),
+			From:           timeValue(params.From),
+			To:             timeValue(params.To),
+			Query:          swag.StringValue(params.Q),
+			ReaderID:       auth.UserFromContext(ctx).ID,
+		}, format, func(entry *models.AuditLog) error {
+			if !res.Committed {
+				if err := start(); err != nil {
+					return err
+				}
+			}
+
+			if format == auditService.FormatNDJSON {
+				if err := encoder.Encode(mapEntry(entry)); err != nil {
+					return err
+				}
+			} else if err := csvWriter.Write(csvRecord(entry)); err != nil {
+				return err
+			}
+
+			written++
+			if written%100 == 0 {
+				csvWriter.Flush()
+				res.Flush()
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if !res.Committed {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return err
+		}
+		res.Flush()
+
+		return nil
+	}
+}
+
+func csvRecord(e *models.AuditLog) []string {
+	sequence := ""
+	if e.Sequence.Valid {
+		sequence = strconv.FormatInt(e.Sequence.Int64, 10)
+	}
+
+	record := []string{
+		e.ID,
+		sequence,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		e.UserID.String,
+		e.Action,
+		e.ResourceType.String,
+		e.ResourceID.String,
+		e.IPAddress.String,
+		e.UserAgent.String,
+		e.CredentialID.String,
+		string(e.Details.JSON),
+		e.PrevHash.String,
+		e.Hash.String,
+	}
+	for i, cell := range record {
+		record[i] = csvCell(cell)
+	}
+	return record
+}
+
+// csvCell prefixes cells spreadsheet applications would evaluate as formula with a single quote, so values like user
+// agents or resource IDs cannot inject formulas into opened downloads.
+func csvCell(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
@@ -0,0 +1,64 @@
+package audit_test
+
+import (
+	"encoding/csv"
+	"net/http"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDownloadAuditLogs(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+		require.NoError(t, err)
+		require.NoError(t, db.WithTransaction(ctx, s.DB, func(exec boil.ContextExecutor) error {
+			return audit.Record(ctx, exec, audit.Entry{
+				OrganizationID: org.ID,
+				UserID:         fix.User1.ID,
+				Action:         audit.ActionRenameVault,
+				ResourceType:   audit.ResourceTypeVault,
+				ResourceID:     `=HYPERLINK("https://example.com","click")`,
+			})
+		}))
+		path := "/api/v1/organizations/" + org.ID + "/audit-logs/download"
+
+		// Operators may not read the audit log.
+		res := test.PerformRequest(t, s, "GET", path, nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenInsufficientRole)
+
+		require.NoError(t, s.Organization.UpdateMemberRole(ctx, org.ID, fix.User2.ID, organization.RoleAuditor, fix.User1.ID))
+		res = test.PerformRequest(t, s, "GET", path, nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "text/csv", res.Result().Header.Get("Content-Type"))
+
+		records, err := csv.NewReader(res.Body).ReadAll()
+		require.NoError(t, err)
+		require.NotEmpty(t, records)
+		assert.Equal(t, "resource_id", records[0][6])
+
+		// Formulas are quoted, so spreadsheets show them as text.
+		var found bool
+		for _, record := range records[1:] {
+			if record[4] == audit.ActionRenameVault {
+				found = true
+				assert.Equal(t, `'=HYPERLINK("https://example.com","click")`, record[6])
+			}
+		}
+		assert.True(t, found)
+	})
+}
@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	auditService "github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/audit"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetExportAuditLogsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/audit-logs/export", getExportAuditLogsHandler(s))
+}
+
+func getExportAuditLogsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := audit.NewGetExportAuditLogsRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := requireReader(ctx, s, orgID); err != nil {
+			return err
+		}
+
+		bundle, err := s.Audit.Export(ctx, orgID, swag.Int64Value(params.FromSequence), swag.Int64Value(params.ToSequence), auth.UserFromContext(ctx).ID)
+		if err != nil {
+			return err
+		}
+
+		response := &types.AuditExportBundle{
+			OrganizationID:   conv.UUID4(strfmt.UUID4(bundle.OrganizationID)),
+			HashAlgorithm:    swag.String(auditService.HashAlgorithm),
+			Canonicalization: swag.String(auditService.Canonicalization),
+			KeyID:            bundle.KeyID,
+			NextSequence:     bundle.NextSequence,
+			Entries:          make([]*types.AuditLogEntry, 0, len(bundle.Entries)),
+			Checkpoints:      make([]*types.AuditCheckpoint, 0, len(bundle.Checkpoints)),
+		}
+		if bundle.PublicKey != nil {
+			response.PublicKey = base64.StdEncoding.EncodeToString(bundle.PublicKey)
+		}
+		for _, e := range bundle.Entries {
+			response.Entries = append(response.Entries, mapEntry(e))
+		}
+		for _, cp := range bundle.Checkpoints {
+			response.Checkpoints = append(response.Checkpoints, &types.AuditCheckpoint{
+				Sequence:  swag.Int64(cp.Sequence),
+				Hash:      swag.String(cp.Hash),
+				KeyID:     swag.String(cp.KeyID),
+				Signature: swag.String(cp.Signature),
+				CreatedAt: conv.DateTime(strfmt.DateTime(cp.CreatedAt)),
+			})
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	auditService "github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/audit"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListAuditLogsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/audit-logs", getListAuditLogsHandler(s))
+}
+
+func getListAuditLogsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := audit.NewGetListAuditLogsRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := requireReader(ctx, s, orgID); err != nil {
+			return err
+		}
+
+		entries, nextCursor, err := s.Audit.ListEntries(ctx, auditService.ListEntriesParams{
+			OrganizationID: orgID,
+			UserID:         uuidValue(params.UserID),
+			Action:         swag.StringValue(params.Action),
+			ResourceType:   swag.StringValue(params.ResourceType),
+			ResourceID:     swag.StringValue(params.ResourceID),
+			From:           timeValue(params.From),
+			To:             timeValue(params.To),
+			Query:          swag.StringValue(params.Q),
+			Cursor:         swag.StringValue(params.Cursor),
+			Limit:          int(swag.Int64Value(params.Limit)),
+			ReaderID:       auth.UserFromContext(ctx).ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		items := make([]*types.AuditLogEntry, 0, len(entries))
+		for _, e := range entries {
+			items = append(items, mapEntry(e))
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListAuditLogsResponse{
+			Entries:    items,
+			NextCursor: nextCursor,
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	backupService "github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+// mapBackup maps the backup of the share of the key, reporting the share as missing if never delivered.
+func mapBackup(keyID string, b *models.KeyShareBackup) *types.ShareBackup {
+	if b == nil {
+		return &types.ShareBackup{
+			KeyID:  swag.String(keyID),
+			Status: types.ShareBackupStatusMissing.Pointer(),
+		}
+	}
+
+	res := &types.ShareBackup{
+		KeyID:         swag.String(b.KeyID),
+		Status:        types.ShareBackupStatus(b.Status).Pointer(),
+		ShareIndex:    int64(b.ShareIndex),
+		DeliveredAt:   strfmt.DateTime(b.DeliveredAt),
+		FailureReason: b.FailureReason.String,
+	}
+	if b.ConfirmedAt.Valid {
+		res.ConfirmedAt = strfmt.DateTime(b.ConfirmedAt.Time)
+	}
+	return res
+}
+
+// requireMember ensures the authenticated user is a member of the organization.
+func requireMember(ctx context.Context, s *api.Server, orgID string) error {
+	_, err := s.Organization.MemberRole(ctx, orgID, auth.UserFromContext(ctx).ID)
+	return err
+}
+
+func mapRecovery(r *models.KeyShareRecovery) *types.KeyRecovery {
+	res := &types.KeyRecovery{
+		ID:                (*strfmt.UUID4)(swag.String(r.ID)),
+		VaultID:           (*strfmt.UUID4)(swag.String(r.VaultID)),
+		KeyID:             swag.String(r.KeyID),
+		NodeID:            swag.String(r.NodeID),
+		UserID:            (*strfmt.UUID4)(swag.String(r.SubjectUserID)),
+		InitiatorID:       strfmt.UUID4(r.InitiatorID.String),
+		Reason:            r.Reason.String,
+		Status:            swag.String(r.Status),
+		RequiredApprovals: swag.Int64(int64(r.RequiredApprovals)),
+		Votes:             make([]*types.KeyRecoveryVote, 0),
+		FailureReason:     r.FailureReason.String,
+		CreatedAt:         (*strfmt.DateTime)(&r.CreatedAt),
+	}
+	if r.CompletedAt.Valid {
+		res.CompletedAt = strfmt.DateTime(r.CompletedAt.Time)
+	}
+	if r.R != nil {
+		for _, v := range r.R.RecoveryKeyShareRecoveryApprovals {
+			res.Votes = append(res.Votes, &types.KeyRecoveryVote{
+				UserID:    (*strfmt.UUID4)(swag.String(v.UserID)),
+				Action:    swag.String(v.Action),
+				CreatedAt: (*strfmt.DateTime)(&v.CreatedAt),
+			})
+		}
+	}
+	return res
+}
+
+func mapEntry(e *backupService.BackupEntry) *types.OrganizationShareBackup {
+	res := &types.OrganizationShareBackup{
+		VaultID:       (*strfmt.UUID4)(swag.String(e.VaultID)),
+		KeyID:         swag.String(e.KeyID),
+		UserID:        (*strfmt.UUID4)(swag.String(e.UserID)),
+		Status:        types.ShareBackupStatus(e.Status).Pointer(),
+		ShareIndex:    int64(e.ShareIndex.Int),
+		FailureReason: e.FailureReason.String,
+	}
+	if e.DeliveredAt.Valid {
+		res.DeliveredAt = strfmt.DateTime(e.DeliveredAt.Time)
+	}
+	if e.ConfirmedAt.Valid {
+		res.ConfirmedAt = strfmt.DateTime(e.ConfirmedAt.Time)
+	}
+	return res
+}
+
+func mapHealth(h *models.KeyBackupHealth) (*types.KeyBackupHealth, error) {
+	var nodes []backupService.NodeHealth
+	if err := json.Unmarshal(h.Nodes, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal node backup health: %w", err)
+	}
+
+	res := &types.KeyBackupHealth{
+		KeyID:     swag.String(h.KeyID),
+		VaultID:   (*strfmt.UUID4)(swag.String(h.VaultID)),
+		AtRisk:    swag.Bool(h.AtRisk),
+		Nodes:     make([]*types.BackupNodeHealth, 0, len(nodes)),
+		Error:     h.Error.String,
+		CheckedAt: (*strfmt.DateTime)(&h.CheckedAt),
+	}
+	if h.DegradedAt.Valid {
+		res.DegradedAt = strfmt.DateTime(h.DegradedAt.Time)
+	}
+	for _, n := range nodes {
+		res.Nodes = append(res.Nodes, &types.BackupNodeHealth{
+			NodeID:         swag.String(n.NodeID),
+			TotalShares:    swag.Int64(int64(n.TotalShares)),
+			RequiredShares: swag.Int64(int64(n.RequiredShares)),
+			BackupShares:   swag.Int64(int64(n.BackupShares)),
+			Recoverable:    swag.Bool(n.Recoverable),
+			AtRisk:         swag.Bool(n.AtRisk),
+		})
+	}
+	return res, nil
+}
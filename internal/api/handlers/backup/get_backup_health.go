@@ -0,0 +1,59 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetBackupHealthRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/backup-health", getBackupHealthHandler(s))
+}
+
+func getBackupHealthHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewGetBackupHealthRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		health, err := s.Backup.GetHealth(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		atRiskOnly := swag.BoolValue(params.AtRisk)
+		resp := &types.BackupHealthResponse{
+			Keys:   make([]*types.KeyBackupHealth, 0, len(health)),
+			Total:  swag.Int64(int64(len(health))),
+			AtRisk: swag.Int64(0),
+		}
+		for _, h := range health {
+			if h.AtRisk {
+				*resp.AtRisk++
+			} else if atRiskOnly {
+				continue
+			}
+
+			key, err := mapHealth(h)
+			if err != nil {
+				return err
+			}
+			resp.Keys = append(resp.Keys, key)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,36 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetKeyRecoveryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/recoveries/:recoveryId", getKeyRecoveryHandler(s))
+}
+
+func getKeyRecoveryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewGetKeyRecoveryRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := requireMember(ctx, s, orgID); err != nil {
+			return err
+		}
+
+		recovery, err := s.Backup.GetRecovery(ctx, orgID, params.RecoveryID.String())
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapRecovery(recovery))
+	}
+}
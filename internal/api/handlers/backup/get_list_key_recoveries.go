@@ -0,0 +1,52 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	backupService "github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListKeyRecoveriesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/recoveries", getListKeyRecoveriesHandler(s))
+}
+
+func getListKeyRecoveriesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewGetListKeyRecoveriesRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := requireMember(ctx, s, orgID); err != nil {
+			return err
+		}
+
+		recoveries, total, err := s.Backup.ListRecoveries(ctx, backupService.ListRecoveriesParams{
+			OrganizationID: orgID,
+			Status:         swag.StringValue(params.Status),
+			Page:           int(swag.Int64Value(params.Page)),
+			Limit:          int(swag.Int64Value(params.Limit)),
+		})
+		if err != nil {
+			return err
+		}
+
+		resp := &types.ListKeyRecoveriesResponse{
+			Recoveries: make([]*types.KeyRecovery, 0, len(recoveries)),
+			Total:      swag.Int64(total),
+		}
+		for _, r := range recoveries {
+			resp.Recoveries = append(resp.Recoveries, mapRecovery(r))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
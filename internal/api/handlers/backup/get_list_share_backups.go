@@ -0,0 +1,59 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	backupService "github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListShareBackupsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/backups", getListShareBackupsHandler(s))
+}
+
+func getListShareBackupsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewGetListShareBackupsRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		var vaultID string
+		if params.VaultID != nil {
+			vaultID = params.VaultID.String()
+		}
+
+		entries, total, err := s.Backup.ListBackups(ctx, backupService.ListBackupsParams{
+			OrganizationID: orgID,
+			VaultID:        vaultID,
+			Status:         swag.StringValue(params.Status),
+			Page:           int(swag.Int64Value(params.Page)),
+			Limit:          int(swag.Int64Value(params.Limit)),
+		})
+		if err != nil {
+			return err
+		}
+
+		resp := &types.ListShareBackupsResponse{
+			Backups: make([]*types.OrganizationShareBackup, 0, len(entries)),
+			Total:   swag.Int64(total),
+		}
+		for _, e := range entries {
+			resp.Backups = append(resp.Backups, mapEntry(e))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
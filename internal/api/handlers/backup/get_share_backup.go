@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetShareBackupRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Backup.GET("/shares/:keyId", getShareBackupHandler(s))
+}
+
+func getShareBackupHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewGetShareBackupRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		status, err := s.Backup.GetShareStatus(ctx, auth.UserFromContext(ctx).ID, params.KeyID)
+		if err != nil {
+			return err
+		}
+
+		resp := mapBackup(params.KeyID, status.Backup)
+		if status.Infra != nil {
+			resp.InfraStatus = status.Infra.Status
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
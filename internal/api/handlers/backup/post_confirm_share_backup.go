@@ -0,0 +1,45 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	backupService "github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostConfirmShareBackupRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Backup.POST("/shares/:keyId/confirm", postConfirmShareBackupHandler(s))
+}
+
+func postConfirmShareBackupHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewPostConfirmShareBackupRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		var body types.ConfirmShareBackupPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		b, err := s.Backup.ConfirmShare(ctx, backupService.ConfirmShareParams{
+			UserID:               auth.UserFromContext(ctx).ID,
+			KeyID:                params.KeyID,
+			ReceivedSuccessfully: swag.BoolValue(body.ReceivedSuccessfully),
+			FailureReason:        body.FailureReason,
+		})
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapBackup(params.KeyID, b))
+	}
+}
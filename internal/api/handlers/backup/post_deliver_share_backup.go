@@ -0,0 +1,54 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	backupService "github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostDeliverShareBackupRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Backup.POST("/shares/:keyId/deliver", postDeliverShareBackupHandler(s))
+}
+
+func postDeliverShareBackupHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewPostDeliverShareBackupRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		var body types.DeliverShareBackupPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		delivery, err := s.Backup.DeliverShare(ctx, backupService.DeliverShareParams{
+			UserID:          auth.UserFromContext(ctx).ID,
+			KeyID:           params.KeyID,
+			ShareIndex:      int(swag.Int64Value(body.ShareIndex)),
+			DevicePublicKey: *body.DevicePublicKey,
+		})
+		if err != nil {
+			return err
+		}
+
+		encryptedShare := strfmt.Base64(delivery.EncryptedShare)
+		signature := strfmt.Base64(delivery.Signature)
+		timestamp := strfmt.DateTime(delivery.Timestamp)
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ShareBackupDelivery{
+			Backup:         mapBackup(params.KeyID, delivery.Backup),
+			EncryptedShare: &encryptedShare,
+			Signature:      &signature,
+			Timestamp:      &timestamp,
+		})
+	}
+}
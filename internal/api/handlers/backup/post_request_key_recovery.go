@@ -0,0 +1,46 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	backupService "github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostRequestKeyRecoveryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/recoveries", postRequestKeyRecoveryHandler(s))
+}
+
+func postRequestKeyRecoveryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewPostRequestKeyRecoveryRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		var body types.RequestKeyRecoveryPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		recovery, err := s.Backup.RequestRecovery(ctx, backupService.RequestRecoveryParams{
+			OrganizationID: params.OrgID.String(),
+			KeyID:          swag.StringValue(body.KeyID),
+			SubjectUserID:  body.UserID.String(),
+			InitiatorID:    auth.UserFromContext(ctx).ID,
+			Reason:         body.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapRecovery(recovery))
+	}
+}
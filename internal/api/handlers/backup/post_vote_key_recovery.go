@@ -0,0 +1,58 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	backupService "github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/backup"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostVoteKeyRecoveryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/recoveries/:recoveryId/vote", postVoteKeyRecoveryHandler(s))
+}
+
+func postVoteKeyRecoveryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := backup.NewPostVoteKeyRecoveryRouteParams()
+		var body types.VoteKeyRecoveryPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := requireMember(ctx, s, orgID); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		recoveryID := params.RecoveryID.String()
+
+		var recovery *models.KeyShareRecovery
+		var err error
+		if swag.StringValue(body.Action) == "reject" {
+			recovery, err = s.Backup.RejectRecovery(ctx, orgID, recoveryID, user.ID)
+		} else {
+			recovery, err = s.Backup.ApproveRecovery(ctx, orgID, recoveryID, backupService.RecoveryApprovalParams{
+				UserID:            user.ID,
+				CredentialID:      body.CredentialID,
+				Signature:         body.Signature,
+				AuthenticatorData: body.AuthenticatorData,
+				ClientDataJSON:    body.ClientDataJSON,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapRecovery(recovery))
+	}
+}
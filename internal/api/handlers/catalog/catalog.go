@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+func mapChain(c *models.Chain) *types.Chain {
+	return &types.Chain{
+		ID:             swag.String(c.ID),
+		Name:           swag.String(c.Name),
+		Type:           swag.String(c.Type),
+		ChainID:        c.ChainID.String,
+		Algorithm:      swag.String(c.Algorithm),
+		Curve:          swag.String(c.Curve),
+		CurrencySymbol: swag.String(c.CurrencySymbol),
+		ExplorerURL:    c.ExplorerURL.String,
+		IconURL:        c.IconURL.String,
+		IsTestnet:      swag.Bool(c.IsTestnet.Bool),
+		IsActive:       swag.Bool(c.IsActive),
+	}
+}
+
+func mapAsset(a *models.Asset) *types.Asset {
+	return &types.Asset{
+		ID:              conv.UUID4(strfmt.UUID4(a.ID)),
+		ChainID:         swag.String(a.ChainID.String),
+		Symbol:          swag.String(a.Symbol),
+		Name:            swag.String(a.Name),
+		Type:            swag.String(a.Type),
+		ContractAddress: a.ContractAddress.String,
+		Decimals:        swag.Int64(int64(a.Decimals)),
+		IconURL:         a.IconURL.String,
+		IsActive:        swag.Bool(a.IsActive.Bool),
+	}
+}
@@ -0,0 +1,48 @@
+package catalog
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAssetMetadataRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1AssetAdmin.GET("/metadata", getAssetMetadataHandler(s))
+}
+
+func getAssetMetadataHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := catalog.NewGetAssetMetadataRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		metadata, err := s.Catalog.ResolveAssetMetadata(ctx, params.ChainID, params.ContractAddress)
+		if err != nil {
+			log.Debug().Err(err).Str("chain_id", params.ChainID).Msg("Failed to resolve asset metadata")
+			return err
+		}
+
+		conflicts := make([]*types.Asset, 0, len(metadata.Conflicts))
+		for _, asset := range metadata.Conflicts {
+			conflicts = append(conflicts, mapAsset(asset))
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.AssetMetadata{
+			ChainID:         swag.String(metadata.ChainID),
+			Type:            swag.String(metadata.Type),
+			ContractAddress: swag.String(metadata.ContractAddress),
+			Symbol:          metadata.Symbol,
+			Name:            metadata.Name,
+			Decimals:        swag.Int64(int64(metadata.Decimals)),
+			Conflicts:       conflicts,
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package catalog
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	catalogService "github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListAssetsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Asset.GET("", getListAssetsHandler(s))
+}
+
+func getListAssetsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := catalog.NewGetListAssetsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		assets, err := s.Catalog.ListAssets(ctx, catalogService.ListAssetsParams{
+			ChainID:  swag.StringValue(params.ChainID),
+			Type:     swag.StringValue(params.Type),
+			IsActive: params.IsActive,
+		})
+		if err != nil {
+			return err
+		}
+
+		items := make([]*types.Asset, 0, len(assets))
+		for _, asset := range assets {
+			items = append(items, mapAsset(asset))
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListAssetsResponse{
+			Assets: items,
+		})
+	}
+}
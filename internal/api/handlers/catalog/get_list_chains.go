@@ -0,0 +1,43 @@
+package catalog
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	catalogService "github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListChainsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Chain.GET("", getListChainsHandler(s))
+}
+
+func getListChainsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := catalog.NewGetListChainsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		chains, err := s.Catalog.ListChains(ctx, catalogService.ListChainsParams{
+			IsTestnet: params.IsTestnet,
+			Type:      swag.StringValue(params.Type),
+		})
+		if err != nil {
+			return err
+		}
+
+		items := make([]*types.Chain, 0, len(chains))
+		for _, chain := range chains {
+			items = append(items, mapChain(chain))
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListChainsResponse{
+			Chains: items,
+		})
+	}
+}
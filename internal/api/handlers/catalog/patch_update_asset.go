@@ -0,0 +1,48 @@
+package catalog
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	catalogService "github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PatchUpdateAssetRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1AssetAdmin.PATCH("/:assetId", patchUpdateAssetHandler(s))
+}
+
+func patchUpdateAssetHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := catalog.NewPatchUpdateAssetRouteParams()
+		var body types.UpdateAssetPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		u := auth.UserFromContext(ctx)
+		asset, err := s.Catalog.UpdateAsset(ctx, catalogService.UpdateAssetParams{
+			AssetID:  params.AssetID.String(),
+			IsActive: swag.BoolValue(body.IsActive),
+			UserID:   u.ID,
+			Passkey: catalogService.PasskeyAssertion{
+				CredentialID:      *body.CredentialID,
+				Signature:         *body.Signature,
+				AuthenticatorData: *body.AuthenticatorData,
+				ClientDataJSON:    *body.ClientDataJSON,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapAsset(asset))
+	}
+}
@@ -0,0 +1,59 @@
+package catalog
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	catalogService "github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostCreateAssetRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1AssetAdmin.POST("", postCreateAssetHandler(s))
+}
+
+func postCreateAssetHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.CreateAssetPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		var decimals *int
+		if body.Decimals != nil {
+			decimals = swag.Int(int(*body.Decimals))
+		}
+
+		u := auth.UserFromContext(ctx)
+		asset, err := s.Catalog.CreateAsset(ctx, catalogService.CreateAssetParams{
+			ChainID:              swag.StringValue(body.ChainID),
+			Symbol:               body.Symbol,
+			Name:                 body.Name,
+			Type:                 swag.StringValue(body.Type),
+			ContractAddress:      swag.StringValue(body.ContractAddress),
+			Decimals:             decimals,
+			IconURL:              body.IconURL,
+			AcknowledgeConflicts: body.AcknowledgeConflicts,
+			UserID:               u.ID,
+			Passkey: catalogService.PasskeyAssertion{
+				CredentialID:      *body.CredentialID,
+				Signature:         *body.Signature,
+				AuthenticatorData: *body.AuthenticatorData,
+				ClientDataJSON:    *body.ClientDataJSON,
+			},
+		})
+		if err != nil {
+			log.Debug().Err(err).Str("chain_id", swag.StringValue(body.ChainID)).Msg("Failed to create asset")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapAsset(asset))
+	}
+}
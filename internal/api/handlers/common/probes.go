@@ -10,15 +10,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"golang.org/x/sys/unix"
 )
 
-func ProbeReadiness(ctx context.Context, database *sql.DB, writeablePaths []string) (string, []error) {
+// ProbeReadiness probes the database, the writeable paths and the connection to the MPC server, the latter is
+// skipped if mpcConn is nil.
+func ProbeReadiness(ctx context.Context, database *sql.DB, mpcConn *mpc.Connection, writeablePaths []string) (string, []error) {
 	var str strings.Builder
 
 	// slice collects all errors from probes
-	errs := make([]error, 0, 1+len(writeablePaths))
+	errs := make([]error, 0, 2+len(writeablePaths))
 
 	// DB readable?
 	dbPingStr, dbPingErr := probeDatabasePingable(ctx, database)
@@ -38,6 +41,16 @@ func ProbeReadiness(ctx context.Context, database *sql.DB, writeablePaths []stri
 		}
 	}
 
+	// MPC server reachable?
+	if mpcConn != nil {
+		mpcStr, mpcErr := probeMpcConnection(ctx, mpcConn)
+		str.WriteString(mpcStr)
+
+		if mpcErr != nil {
+			errs = append(errs, mpcErr)
+		}
+	}
+
 	// Feel free to add additional probes here...
 
 	return str.String(), errs
@@ -45,7 +58,8 @@ func ProbeReadiness(ctx context.Context, database *sql.DB, writeablePaths []stri
 
 func ProbeLiveness(ctx context.Context, database *sql.DB, writeablePaths []string, touch string) (string, []error) {
 	// fail immediately if any readiness probes above have already failed.
-	readinessProbeStr, readinessProbeErrs := ProbeReadiness(ctx, database, writeablePaths)
+	// The MPC server is not probed, as an unavailable MPC server is no reason to restart the service.
+	readinessProbeStr, readinessProbeErrs := ProbeReadiness(ctx, database, nil, writeablePaths)
 
 	if len(readinessProbeErrs) != 0 {
 		return readinessProbeStr, readinessProbeErrs
@@ -155,6 +169,29 @@ func probeDatabaseNextHealthSequence(ctx context.Context, database *sql.DB) (str
 	return str.String(), nil
 }
 
+func probeMpcConnection(ctx context.Context, mpcConn *mpc.Connection) (string, error) {
+	var str strings.Builder
+
+	if !mpcConn.Configured() {
+		str.WriteString("Probe mpc: Not configured, skipped.\n")
+		return str.String(), nil
+	}
+
+	ctx, cancel := context.WithDeadline(ctx, ensureProbeDeadlineFromContext(ctx))
+	defer cancel()
+
+	mpcProbeStart := time.Now()
+
+	if err := mpcConn.Probe(ctx); err != nil {
+		fmt.Fprintf(&str, "Probe mpc: Connection errored after %s, state=%s, error=%v.\n", time.Since(mpcProbeStart), mpcConn.State(), err.Error())
+		return str.String(), err
+	}
+
+	fmt.Fprintf(&str, "Probe mpc: Connection ready in %s, state=%s.\n", time.Since(mpcProbeStart), mpcConn.State())
+
+	return str.String(), nil
+}
+
 func probePathWriteablePermission(ctx context.Context, writeablePath string) (string, error) {
 	var str strings.Builder
 	ctxDeadline := ensureProbeDeadlineFromContext(ctx)
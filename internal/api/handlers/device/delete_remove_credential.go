@@ -0,0 +1,42 @@
+package device
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/device"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteRemoveCredentialRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Credential.DELETE("/:credentialId", deleteRemoveCredentialHandler(s))
+}
+
+func deleteRemoveCredentialHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+
+		params := device.NewDeleteRemoveCredentialRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		// Credential IDs are base64url encoded within paths, padding is optional.
+		credentialID, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(params.CredentialID, "="))
+		if err != nil {
+			return httperrors.ErrNotFoundCredential
+		}
+
+		if err := s.Device.RemoveCredential(ctx, user.ID, credentialID); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
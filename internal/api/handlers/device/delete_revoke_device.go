@@ -0,0 +1,34 @@
+package device
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/device"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteRevokeDeviceRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Device.DELETE("/:deviceId", deleteRevokeDeviceHandler(s))
+}
+
+func deleteRevokeDeviceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+
+		params := device.NewDeleteRevokeDeviceRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		d, err := s.Device.Revoke(ctx, user.ID, params.DeviceID.String())
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapDevice(d))
+	}
+}
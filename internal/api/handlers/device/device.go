@@ -0,0 +1,31 @@
+package device
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+func mapDevice(d *models.UserDevice) *types.Device {
+	res := &types.Device{
+		ID:         (*strfmt.UUID4)(swag.String(d.ID)),
+		NodeID:     swag.String(d.NodeID),
+		DeviceID:   swag.String(d.DeviceID),
+		Name:       d.Name.String,
+		Version:    d.Version.String,
+		Status:     types.DeviceStatus(d.Status).Pointer(),
+		NodeStatus: d.NodeStatus.String,
+		CreatedAt:  (*strfmt.DateTime)(&d.CreatedAt),
+	}
+	if credential := d.R.GetCredential(); credential != nil {
+		res.CredentialID = strfmt.Base64(credential.CredentialID)
+	}
+	if d.LastHeartbeatAt.Valid {
+		res.LastHeartbeatAt = strfmt.DateTime(d.LastHeartbeatAt.Time)
+	}
+	if d.RevokedAt.Valid {
+		res.RevokedAt = strfmt.DateTime(d.RevokedAt.Time)
+	}
+	return res
+}
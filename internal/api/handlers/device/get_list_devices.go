@@ -0,0 +1,36 @@
+package device
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListDevicesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Device.GET("", getListDevicesHandler(s))
+}
+
+func getListDevicesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+
+		devices, err := s.Device.ListDevices(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		resp := &types.ListDevicesResponse{
+			Devices: make([]*types.Device, 0, len(devices)),
+		}
+		for _, d := range devices {
+			resp.Devices = append(resp.Devices, mapDevice(d))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,43 @@
+package device
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/device"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostDeviceHeartbeatRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Device.POST("/:deviceId/heartbeat", postDeviceHeartbeatHandler(s))
+}
+
+func postDeviceHeartbeatHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+
+		params := device.NewPostDeviceHeartbeatRouteParams()
+		var body types.DeviceHeartbeatPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		d, commands, err := s.Device.Heartbeat(ctx, user.ID, params.DeviceID.String(), swag.StringValue(body.Status))
+		if err != nil {
+			return err
+		}
+		if commands == nil {
+			commands = []string{}
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.DeviceHeartbeatResponse{
+			Device:   mapDevice(d),
+			Commands: commands,
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package device
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	deviceService "github.com/kashguard/go-mpc-vault/internal/service/device"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostEnrollDeviceRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Device.POST("", postEnrollDeviceHandler(s))
+}
+
+func postEnrollDeviceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+
+		var body types.EnrollDevicePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		device, err := s.Device.Enroll(ctx, deviceService.EnrollParams{
+			UserID:            user.ID,
+			DeviceID:          swag.StringValue(body.DeviceID),
+			PublicKey:         swag.StringValue(body.PublicKey),
+			Name:              body.Name,
+			Version:           body.Version,
+			CredentialID:      *body.CredentialID,
+			Signature:         *body.Signature,
+			AuthenticatorData: *body.AuthenticatorData,
+			ClientDataJSON:    *body.ClientDataJSON,
+		})
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, mapDevice(device))
+	}
+}
@@ -0,0 +1,55 @@
+package device
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	mpcAuth "github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostPasskeyChallengeRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Credential.POST("/challenge", postPasskeyChallengeHandler(s))
+}
+
+func postPasskeyChallengeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		log := util.LogFromContext(ctx)
+
+		var body types.PasskeyChallengePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		assertion, err := s.WebAuthn.BeginAssertion(ctx, user.ID, mpcAuth.AssertionScope{
+			Action:     swag.StringValue(body.Action),
+			ResourceID: swag.StringValue(body.ResourceID),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to issue passkey challenge")
+			return err
+		}
+
+		options := assertion.Response
+		challenge := strfmt.Base64(options.Challenge)
+		resp := &types.PasskeyChallengeResponse{
+			Challenge:        &challenge,
+			RpID:             swag.String(options.RelyingPartyID),
+			Timeout:          swag.Int64(int64(options.Timeout)),
+			UserVerification: string(options.UserVerification),
+			AllowCredentials: make([]strfmt.Base64, 0, len(options.AllowedCredentials)),
+		}
+		for _, credential := range options.AllowedCredentials {
+			resp.AllowCredentials = append(resp.AllowCredentials, strfmt.Base64(credential.CredentialID))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
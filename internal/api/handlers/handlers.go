@@ -3,12 +3,20 @@ package handlers
 
 import (
 	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/audit"
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers/auth"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/backup"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/catalog"
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers/common"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/device"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/key"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/node"
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers/organization"
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers/push"
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers/signing"
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers/vault"
+	"github.com/kashguard/go-mpc-vault/internal/api/handlers/webhook"
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers/wellknown"
 	"github.com/labstack/echo/v4"
 )
@@ -16,6 +24,14 @@ import (
 func AttachAllRoutes(s *api.Server) {
 	// attach our routes
 	s.Router.Routes = []*echo.Route{
+		addressbook.DeleteAddressBookEntryRoute(s),
+		addressbook.GetListAddressBookEntriesRoute(s),
+		addressbook.PatchUpdateAddressBookEntryRoute(s),
+		addressbook.PostApproveAddressBookEntryRoute(s),
+		addressbook.PostCreateAddressBookEntryRoute(s),
+		audit.GetDownloadAuditLogsRoute(s),
+		audit.GetExportAuditLogsRoute(s),
+		audit.GetListAuditLogsRoute(s),
 		auth.DeleteUserAccountRoute(s),
 		auth.GetCompleteRegisterRoute(s),
 		auth.GetUserInfoRoute(s),
@@ -27,21 +43,75 @@ func AttachAllRoutes(s *api.Server) {
 		auth.PostLogoutRoute(s),
 		auth.PostRefreshRoute(s),
 		auth.PostRegisterRoute(s),
+		backup.GetBackupHealthRoute(s),
+		backup.GetKeyRecoveryRoute(s),
+		backup.GetListKeyRecoveriesRoute(s),
+		backup.GetListShareBackupsRoute(s),
+		backup.GetShareBackupRoute(s),
+		backup.PostConfirmShareBackupRoute(s),
+		backup.PostDeliverShareBackupRoute(s),
+		backup.PostRequestKeyRecoveryRoute(s),
+		backup.PostVoteKeyRecoveryRoute(s),
+		catalog.GetAssetMetadataRoute(s),
+		catalog.GetListAssetsRoute(s),
+		catalog.GetListChainsRoute(s),
+		catalog.PatchUpdateAssetRoute(s),
+		catalog.PostCreateAssetRoute(s),
 		common.GetHealthyRoute(s),
 		common.GetReadyRoute(s),
 		common.GetSwaggerRoute(s),
 		common.GetVersionRoute(s),
-	organization.DeleteOrganizationMemberRoute(s),
-	organization.GetListOrganizationMembersRoute(s),
-	organization.GetListOrganizationsRoute(s),
-	organization.PostAddOrganizationMemberRoute(s),
-	organization.PostCreateOrganizationRoute(s),
+		device.DeleteRemoveCredentialRoute(s),
+		device.DeleteRevokeDeviceRoute(s),
+		device.GetListDevicesRoute(s),
+		device.PostDeviceHeartbeatRoute(s),
+		device.PostEnrollDeviceRoute(s),
+		device.PostPasskeyChallengeRoute(s),
+		key.GetListKeyRefreshesRoute(s),
+		key.GetListRootKeysRoute(s),
+		key.GetRootKeyRoute(s),
+		key.PatchUpdateRootKeyRoute(s),
+		node.GetListMpcNodesRoute(s),
+		node.GetMpcKeyNodesRoute(s),
+		organization.DeleteOrganizationInvitationRoute(s),
+		organization.DeleteOrganizationMemberRoute(s),
+		organization.GetListOrganizationInvitationsRoute(s),
+		organization.GetListOrganizationMembersRoute(s),
+		organization.GetListOrganizationsRoute(s),
+		organization.PostAcceptOrganizationInvitationRoute(s),
+		organization.PostAddOrganizationMemberRoute(s),
+		organization.PostCreateOrganizationInvitationRoute(s),
+		organization.PostCreateOrganizationRoute(s),
+		organization.PostTransferOrganizationOwnershipRoute(s),
+		organization.PutDefaultOrganizationRoute(s),
+		push.GetNotificationPreferencesRoute(s),
+		push.PutUpdateNotificationPreferencesRoute(s),
+		push.PutUpdatePushTokenRoute(s),
 		signing.GetListSigningRequestsRoute(s),
-	push.PutUpdatePushTokenRoute(s),
-	signing.PostApproveSigningRequestRoute(s),
-	signing.PostCreateSigningRequestRoute(s),
-	vault.PostCreateVaultRoute(s),
-	vault.PostCreateWalletRoute(s),
+		signing.PostApproveSigningRequestRoute(s),
+		signing.PostBroadcastSigningRequestRoute(s),
+		signing.PostCreateSigningRequestRoute(s),
+		vault.GetListOrganizationVaultsRoute(s),
+		vault.GetListVaultDepositsRoute(s),
+		vault.GetListVaultsRoute(s),
+		vault.GetVaultRoute(s),
+		vault.PatchUpdateVaultRoute(s),
+		vault.PostApproveVaultProposalRoute(s),
+		vault.PostArchiveVaultRoute(s),
+		vault.PostCompleteRootKeyRetirementRoute(s),
+		vault.PostCreateOrganizationVaultRoute(s),
+		vault.PostCreateVaultRoute(s),
+		vault.PostCreateWalletRoute(s),
+		vault.PostRefreshRootKeyRoute(s),
+		vault.PostRetireRootKeyRoute(s),
+		webhook.DeleteWebhookEndpointRoute(s),
+		webhook.GetListWebhookDeliveriesRoute(s),
+		webhook.GetListWebhookEndpointsRoute(s),
+		webhook.GetListWebhookEventsRoute(s),
+		webhook.PostCreateWebhookEndpointRoute(s),
+		webhook.PostReceiveWebhookRoute(s),
+		webhook.PostRedeliverWebhookRoute(s),
+		webhook.PostReprocessWebhookEventRoute(s),
 		wellknown.GetAndroidDigitalAssetLinksRoute(s),
 		wellknown.GetAppleAppSiteAssociationRoute(s),
 	}
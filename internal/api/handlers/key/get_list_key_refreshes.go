@@ -0,0 +1,51 @@
+package key
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/key"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListKeyRefreshesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.GET("/:vaultId/keys/:keyId/refreshes", getListKeyRefreshesHandler(s))
+}
+
+func getListKeyRefreshesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := key.NewGetListKeyRefreshesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		if _, _, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID); err != nil {
+			return err
+		}
+
+		refreshes, err := s.Key.ListRefreshes(ctx, vaultID, params.KeyID)
+		if err != nil {
+			return err
+		}
+
+		res := &types.ListKeyRefreshesResponse{
+			Refreshes: make([]*types.KeyRefresh, 0, len(refreshes)),
+		}
+		for _, r := range refreshes {
+			res.Refreshes = append(res.Refreshes, mapKeyRefresh(r))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, res)
+	}
+}
@@ -0,0 +1,51 @@
+package key
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/key"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListRootKeysRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.GET("/:vaultId/keys", getListRootKeysHandler(s))
+}
+
+func getListRootKeysHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := key.NewGetListRootKeysRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		if _, _, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID); err != nil {
+			return err
+		}
+
+		keys, err := s.Key.ListKeys(ctx, vaultID)
+		if err != nil {
+			return err
+		}
+
+		resp := &types.ListRootKeysResponse{
+			Keys: make([]*types.RootKey, 0, len(keys)),
+		}
+		for _, k := range keys {
+			resp.Keys = append(resp.Keys, mapRootKey(k))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,43 @@
+package key
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/key"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetRootKeyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.GET("/:vaultId/keys/:keyId", getRootKeyHandler(s))
+}
+
+func getRootKeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := key.NewGetRootKeyRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		if _, _, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID); err != nil {
+			return err
+		}
+
+		k, err := s.Key.GetKey(ctx, vaultID, params.KeyID)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapRootKey(k))
+	}
+}
@@ -0,0 +1,87 @@
+package key
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/key"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+func mapRootKey(k *key.Key) *types.RootKey {
+	res := &types.RootKey{
+		KeyID:       swag.String(k.KeyID),
+		VaultID:     (*strfmt.UUID4)(swag.String(k.VaultID)),
+		Status:      swag.String(k.Status()),
+		WalletCount: swag.Int64(int64(k.Wallets)),
+		Tags:        map[string]string{},
+		Refreshing:  k.Refreshing,
+	}
+
+	if vk := k.VaultKey; vk != nil {
+		res.RefreshEpoch = int64(vk.RefreshEpoch)
+		if vk.RefreshedAt.Valid {
+			res.RefreshedAt = strfmt.DateTime(vk.RefreshedAt.Time)
+		}
+	}
+
+	if k.Info != nil {
+		res.MpcStatus = k.Info.Status
+		res.PublicKey = k.Info.PublicKey
+		res.Algorithm = k.Info.Algorithm
+		res.Curve = k.Info.Curve
+		res.Protocol = k.Info.Protocol
+		res.Threshold = int64(k.Info.Threshold)
+		res.TotalNodes = int64(k.Info.TotalNodes)
+		res.Description = k.Info.Description
+		for name, value := range k.Info.Tags {
+			res.Tags[name] = value
+		}
+	}
+
+	// Annotations kept by the vault take precedence over those set on creation of the key.
+	if r := k.Record; r != nil {
+		if r.Description.Valid {
+			res.Description = r.Description.String
+		}
+		var tags map[string]string
+		if err := r.Tags.Unmarshal(&tags); err == nil {
+			for name, value := range tags {
+				res.Tags[name] = value
+			}
+		}
+		res.SuccessorKeyID = r.SuccessorKeyID.String
+		res.RetirementProposalID = strfmt.UUID4(r.RetirementProposalID.String)
+		res.CompletionProposalID = strfmt.UUID4(r.CompletionProposalID.String)
+		if r.RetiringAt.Valid {
+			res.RetiringAt = strfmt.DateTime(r.RetiringAt.Time)
+		}
+		if r.RetiredAt.Valid {
+			res.RetiredAt = strfmt.DateTime(r.RetiredAt.Time)
+		}
+	}
+
+	return res
+}
+
+func mapKeyRefresh(r *models.KeyRefresh) *types.KeyRefresh {
+	res := &types.KeyRefresh{
+		ID:            (*strfmt.UUID4)(swag.String(r.ID)),
+		VaultID:       (*strfmt.UUID4)(swag.String(r.VaultID)),
+		KeyID:         swag.String(r.KeyID),
+		ProposalID:    strfmt.UUID4(r.ProposalID.String),
+		Trigger:       swag.String(r.Trigger),
+		Status:        swag.String(r.Status),
+		Epoch:         swag.Int64(int64(r.Epoch)),
+		FailureReason: r.FailureReason.String,
+		CreatedAt:     (*strfmt.DateTime)(&r.CreatedAt),
+	}
+	if r.StartedAt.Valid {
+		res.StartedAt = strfmt.DateTime(r.StartedAt.Time)
+	}
+	if r.CompletedAt.Valid {
+		res.CompletedAt = strfmt.DateTime(r.CompletedAt.Time)
+	}
+
+	return res
+}
@@ -0,0 +1,55 @@
+package key
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	keyService "github.com/kashguard/go-mpc-vault/internal/service/key"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/key"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PatchUpdateRootKeyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.PATCH("/:vaultId/keys/:keyId", patchUpdateRootKeyHandler(s))
+}
+
+func patchUpdateRootKeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := key.NewPatchUpdateRootKeyRouteParams()
+		var body types.UpdateRootKeyPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID)
+		if err != nil {
+			return err
+		}
+		if role != organization.RoleOwner && role != organization.RoleAdmin {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		k, err := s.Key.UpdateKey(ctx, vaultID, params.KeyID, user.ID, keyService.UpdateParams{
+			Description: body.Description,
+			Tags:        body.Tags,
+		})
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapRootKey(k))
+	}
+}
@@ -0,0 +1,45 @@
+package node
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/node"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListMpcNodesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1NodeAdmin.GET("", getListMpcNodesHandler(s))
+}
+
+func getListMpcNodesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := node.NewGetListMpcNodesRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		nodes, err := s.Node.ListNodes(ctx, mpc.NodeFilter{
+			Type:   swag.StringValue(params.Type),
+			Status: swag.StringValue(params.Status),
+		})
+		if err != nil {
+			return err
+		}
+
+		resp := &types.ListMpcNodesResponse{
+			Nodes: make([]*types.MpcNode, 0, len(nodes)),
+		}
+		for _, n := range nodes {
+			resp.Nodes = append(resp.Nodes, mapNode(n, s.Node.Online(n)))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,55 @@
+package node
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/node"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetMpcKeyNodesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1NodeAdmin.GET("/keys/:keyId", getMpcKeyNodesHandler(s))
+}
+
+func getMpcKeyNodesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := node.NewGetMpcKeyNodesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		keyNodes, err := s.Node.GetKeyNodes(ctx, params.KeyID)
+		if err != nil {
+			return err
+		}
+
+		resp := &types.MpcKeyNodes{
+			KeyID:      swag.String(keyNodes.KeyID),
+			VaultID:    strfmt.UUID4(keyNodes.VaultID),
+			Threshold:  swag.Int64(int64(keyNodes.Threshold)),
+			TotalNodes: swag.Int64(int64(keyNodes.TotalNodes)),
+			Online:     swag.Int64(int64(keyNodes.OnlineCount())),
+			Nodes:      make([]*types.MpcKeyNode, 0, len(keyNodes.Nodes)),
+		}
+		for _, n := range keyNodes.Nodes {
+			keyNode := &types.MpcKeyNode{
+				NodeID:     swag.String(n.NodeID),
+				Registered: swag.Bool(n.Node != nil),
+				Online:     swag.Bool(n.Online),
+			}
+			if n.Node != nil {
+				keyNode.Node = mapNode(*n.Node, n.Online)
+			}
+			resp.Nodes = append(resp.Nodes, keyNode)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
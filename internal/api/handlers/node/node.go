@@ -0,0 +1,23 @@
+package node
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+func mapNode(n mpc.Node, online bool) *types.MpcNode {
+	res := &types.MpcNode{
+		NodeID:  swag.String(n.ID),
+		Type:    swag.String(n.Type),
+		Status:  swag.String(n.Status),
+		Version: n.Version,
+		Address: n.Address,
+		Online:  swag.Bool(online),
+	}
+	if !n.LastHeartbeat.IsZero() {
+		res.LastHeartbeat = strfmt.DateTime(n.LastHeartbeat)
+	}
+	return res
+}
@@ -0,0 +1,36 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/organization"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteOrganizationInvitationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.DELETE("/:orgId/invitations/:invitationId", deleteOrganizationInvitationHandler(s))
+}
+
+func deleteOrganizationInvitationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := organization.NewDeleteOrganizationInvitationRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+		if err := s.Organization.RevokeInvitation(ctx, orgID, params.InvitationID.String(), auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.AddOrganizationMemberResponse{
+			Ok: true,
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
 	"github.com/kashguard/go-mpc-vault/internal/types"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"github.com/labstack/echo/v4"
@@ -18,7 +19,10 @@ func deleteOrganizationMemberHandler(s *api.Server) echo.HandlerFunc {
 		ctx := c.Request().Context()
 		orgID := c.Param("orgId")
 		userID := c.Param("userId")
-		if err := s.Organization.RemoveMember(ctx, orgID, userID); err != nil {
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+		if err := s.Organization.RemoveMember(ctx, orgID, userID, auth.UserFromContext(ctx).ID); err != nil {
 			return err
 		}
 		return util.ValidateAndReturn(c, http.StatusOK, &types.AddOrganizationMemberResponse{
@@ -0,0 +1,41 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/organization"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListOrganizationInvitationsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/invitations", getListOrganizationInvitationsHandler(s))
+}
+
+func getListOrganizationInvitationsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := organization.NewGetListOrganizationInvitationsRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+		invitations, err := s.Organization.ListPendingInvitations(ctx, orgID)
+		if err != nil {
+			return err
+		}
+		items := make([]*types.OrganizationInvitation, 0, len(invitations))
+		for _, i := range invitations {
+			items = append(items, mapInvitation(i))
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListOrganizationInvitationsResponse{
+			Invitations: items,
+		})
+	}
+}
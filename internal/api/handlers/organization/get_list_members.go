@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
 	"github.com/kashguard/go-mpc-vault/internal/types"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"github.com/labstack/echo/v4"
@@ -17,6 +18,10 @@ func getListOrganizationMembersHandler(s *api.Server) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
 		orgID := c.Param("orgId")
+		u := auth.UserFromContext(ctx)
+		if _, err := s.Organization.MemberRole(ctx, orgID, u.ID); err != nil {
+			return err
+		}
 		members, err := s.Organization.ListMembers(ctx, orgID)
 		if err != nil {
 			return err
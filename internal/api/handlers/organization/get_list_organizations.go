@@ -29,8 +29,13 @@ func getListOrganizationsHandler(s *api.Server) echo.HandlerFunc {
 				Name: o.Name,
 			})
 		}
+		defaultOrgID, err := s.Organization.GetDefaultOrganization(ctx, u.ID)
+		if err != nil {
+			return err
+		}
 		return util.ValidateAndReturn(c, http.StatusOK, &types.ListOrganizationsResponse{
-			Organizations: items,
+			Organizations:         items,
+			DefaultOrganizationID: defaultOrgID,
 		})
 	}
 }
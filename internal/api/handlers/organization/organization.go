@@ -0,0 +1,23 @@
+package organization
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+func mapInvitation(i *models.OrganizationInvitation) *types.OrganizationInvitation {
+	return &types.OrganizationInvitation{
+		ID:             conv.UUID4(strfmt.UUID4(i.ID)),
+		OrganizationID: conv.UUID4(strfmt.UUID4(i.OrganizationID)),
+		Email:          swag.String(i.Email),
+		Role:           swag.String(i.Role),
+		Status:         swag.String(i.Status),
+		InvitedBy:      strfmt.UUID4(i.InvitedBy.String),
+		ValidUntil:     conv.DateTime(strfmt.DateTime(i.ValidUntil)),
+		CreatedAt:      strfmt.DateTime(i.CreatedAt),
+	}
+}
@@ -0,0 +1,39 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/organization"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAcceptOrganizationInvitationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Invite.POST("/:token/accept", postAcceptOrganizationInvitationHandler(s))
+}
+
+func postAcceptOrganizationInvitationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := organization.NewPostAcceptOrganizationInvitationRouteParams()
+		var body types.AcceptOrganizationInvitationPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+		result, err := s.Organization.AcceptInvitation(ctx, params.Token.String(), body.Password)
+		if err != nil {
+			return err
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.AcceptOrganizationInvitationResponse{
+			OrganizationID: conv.UUID4(strfmt.UUID4(result.Member.OrganizationID)),
+			UserID:         conv.UUID4(strfmt.UUID4(result.Member.UserID)),
+			Role:           swag.String(result.Member.Role),
+			Registered:     swag.Bool(result.Registered),
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
 	"github.com/kashguard/go-mpc-vault/internal/types"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"github.com/labstack/echo/v4"
@@ -22,7 +23,10 @@ func postAddOrganizationMemberHandler(s *api.Server) echo.HandlerFunc {
 		if err := util.BindAndValidateBody(c, &body); err != nil {
 			return err
 		}
-		_, err := s.Organization.AddMember(ctx, orgID, swag.StringValue(body.UserID), swag.StringValue(body.Role))
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+		_, err := s.Organization.AddMember(ctx, orgID, swag.StringValue(body.UserID), swag.StringValue(body.Role), auth.UserFromContext(ctx).ID)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,67 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/data/dto"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/organization"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/url"
+	"github.com/labstack/echo/v4"
+)
+
+func PostCreateOrganizationInvitationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/invitations", postCreateOrganizationInvitationHandler(s))
+}
+
+func postCreateOrganizationInvitationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := organization.NewPostCreateOrganizationInvitationRouteParams()
+		var body types.CreateOrganizationInvitationPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		org, err := s.Organization.GetOrganization(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		u := auth.UserFromContext(ctx)
+		email := dto.NewUsername(body.Email.String()).String()
+		invitation, err := s.Organization.CreateInvitation(ctx, orgID, u.ID, email, swag.StringValue(body.Role))
+		if err != nil {
+			return err
+		}
+
+		link, err := url.InvitationDeeplinkURL(s.Config, invitation.Token)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate invitation link")
+			return err
+		}
+
+		if err := s.Mailer.SendOrganizationInvitation(ctx, email, dto.InvitationNotificationPayload{
+			OrganizationName: org.Name,
+			Role:             invitation.Role,
+			InvitationLink:   link.String(),
+			ValidUntil:       invitation.ValidUntil,
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to send invitation email")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapInvitation(invitation))
+	}
+}
@@ -0,0 +1,52 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	organizationService "github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/organization"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostTransferOrganizationOwnershipRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/transfer-ownership", postTransferOrganizationOwnershipHandler(s))
+}
+
+func postTransferOrganizationOwnershipHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := organization.NewPostTransferOrganizationOwnershipRouteParams()
+		var body types.TransferOrganizationOwnershipPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		u := auth.UserFromContext(ctx)
+		if _, err := s.Organization.MemberRole(ctx, orgID, u.ID); err != nil {
+			return err
+		}
+
+		if err := s.Organization.TransferOwnership(ctx, orgID, organizationService.TransferOwnershipParams{
+			UserID:            u.ID,
+			NewOwnerID:        body.NewOwnerID.String(),
+			CredentialID:      *body.CredentialID,
+			Signature:         *body.Signature,
+			AuthenticatorData: *body.AuthenticatorData,
+			ClientDataJSON:    *body.ClientDataJSON,
+		}); err != nil {
+			log.Debug().Err(err).Str("organization_id", orgID).Msg("Failed to transfer organization ownership")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.AddOrganizationMemberResponse{
+			Ok: true,
+		})
+	}
+}
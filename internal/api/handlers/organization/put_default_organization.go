@@ -0,0 +1,33 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/organization"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PutDefaultOrganizationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.PUT("/:orgId/default", putDefaultOrganizationHandler(s))
+}
+
+func putDefaultOrganizationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := organization.NewPutDefaultOrganizationRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		u := auth.UserFromContext(ctx)
+		if err := s.Organization.SetDefaultOrganization(ctx, u.ID, params.OrgID.String()); err != nil {
+			return err
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, &types.AddOrganizationMemberResponse{
+			Ok: true,
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package push
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/push"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetNotificationPreferencesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/notification-preferences", getNotificationPreferencesHandler(s))
+}
+
+func getNotificationPreferencesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+
+		params := push.NewGetNotificationPreferencesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if _, err := s.Organization.MemberRole(ctx, orgID, user.ID); err != nil {
+			return err
+		}
+
+		events, err := s.Notification.GetPreferences(ctx, orgID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapPreferences(events))
+	}
+}
+
+func mapPreferences(events []string) *types.NotificationPreferences {
+	res := &types.NotificationPreferences{
+		Events: make([]types.NotificationEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		res.Events = append(res.Events, types.NotificationEvent(event))
+	}
+	return res
+}
@@ -0,0 +1,49 @@
+package push
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/push"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PutUpdateNotificationPreferencesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.PUT("/:orgId/notification-preferences", putUpdateNotificationPreferencesHandler(s))
+}
+
+func putUpdateNotificationPreferencesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+
+		params := push.NewPutUpdateNotificationPreferencesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		var body types.UpdateNotificationPreferencesPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if _, err := s.Organization.MemberRole(ctx, orgID, user.ID); err != nil {
+			return err
+		}
+
+		events := make([]string, 0, len(body.Events))
+		for _, event := range body.Events {
+			events = append(events, string(event))
+		}
+
+		events, err := s.Notification.UpdatePreferences(ctx, orgID, user.ID, events)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapPreferences(events))
+	}
+}
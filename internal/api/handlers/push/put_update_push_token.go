@@ -33,6 +33,7 @@ func putUpdatePushTokenHandler(s *api.Server) echo.HandlerFunc {
 			Token:         swag.StringValue(body.NewToken),
 			Provider:      swag.StringValue(body.Provider),
 			ExistingToken: null.StringFromPtr(body.OldToken),
+			Language:      null.StringFromPtr(body.Language),
 		})
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to update push token")
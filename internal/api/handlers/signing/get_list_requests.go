@@ -13,18 +13,22 @@ import (
 )
 
 type ListSigningRequestsParams struct {
-	Status  string `query:"status"`
-	VaultID string `query:"vaultId"`
-	Page    int    `query:"page"`
-	Limit   int    `query:"limit"`
+	Status         string `query:"status"`
+	OrganizationID string `query:"organizationId"`
+	VaultID        string `query:"vaultId"`
+	Page           int    `query:"page"`
+	Limit          int    `query:"limit"`
 }
 
 func (p *ListSigningRequestsParams) Validate(_ strfmt.Registry) error {
 	switch p.Status {
-	case "", "pending", "completed", "rejected":
+	case "", "pending", "completed", "broadcast", "rejected", "cancelled":
 	default:
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid status")
 	}
+	if p.OrganizationID != "" && !strfmt.IsUUID4(p.OrganizationID) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid organizationId")
+	}
 	if p.Page < 0 || p.Limit < 0 {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid pagination")
 	}
@@ -69,7 +73,13 @@ func getListSigningRequestsHandler(s *api.Server) echo.HandlerFunc {
 		}
 		userID := user.ID
 
-		items, total, err := s.Signing.ListRequests(ctx, userID, params.VaultID, params.Status, params.Page, params.Limit)
+		if params.OrganizationID != "" {
+			if _, err := s.Organization.MemberRole(ctx, params.OrganizationID, userID); err != nil {
+				return err
+			}
+		}
+
+		items, total, err := s.Signing.ListRequests(ctx, userID, params.OrganizationID, params.VaultID, params.Status, params.Page, params.Limit)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to list signing requests")
 			return err
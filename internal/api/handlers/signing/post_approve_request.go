@@ -5,7 +5,9 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
 	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
 	"github.com/kashguard/go-mpc-vault/internal/service/signing"
 	"github.com/kashguard/go-mpc-vault/internal/types"
 	"github.com/kashguard/go-mpc-vault/internal/util"
@@ -34,6 +36,18 @@ func postApproveSigningRequestHandler(s *api.Server) echo.HandlerFunc {
 		}
 		userID := user.ID
 
+		req, err := s.Signing.GetRequest(ctx, requestID)
+		if err != nil {
+			return echo.ErrNotFound
+		}
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, req.VaultID.String, userID)
+		if err != nil {
+			return err
+		}
+		if role == organization.RoleAuditor {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
 		action := swag.StringValue(body.Action)
 		if action == "reject" {
 			if err := s.Signing.RejectRequest(ctx, requestID, userID); err != nil {
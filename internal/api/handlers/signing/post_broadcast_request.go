@@ -0,0 +1,61 @@
+package signing
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostBroadcastSigningRequestRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Sign.POST("/requests/:requestId/broadcast", postBroadcastSigningRequestHandler(s))
+}
+
+func postBroadcastSigningRequestHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		requestID := c.Param("requestId")
+
+		var body types.BroadcastSigningRequestPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		req, err := s.Signing.GetRequest(ctx, requestID)
+		if err != nil {
+			return echo.ErrNotFound
+		}
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, req.VaultID.String, user.ID)
+		if err != nil {
+			return err
+		}
+		if role == organization.RoleAuditor {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		req, err = s.Signing.MarkBroadcast(ctx, requestID, user.ID, swag.StringValue(body.TxHash))
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to record broadcast of signing request")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.CreateSigningResponse{
+			RequestID: strfmt.UUID4(req.ID),
+			Status:    req.Status.String,
+		})
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
 	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	signingService "github.com/kashguard/go-mpc-vault/internal/service/signing"
 	"github.com/kashguard/go-mpc-vault/internal/types"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"github.com/labstack/echo/v4"
@@ -20,7 +24,7 @@ func postCreateSigningRequestHandler(s *api.Server) echo.HandlerFunc {
 		ctx := c.Request().Context()
 		log := util.LogFromContext(ctx)
 
-		// vaultID := c.Param("vaultId") // Unused in service create request, but good for validation
+		vaultID := c.Param("vaultId")
 
 		var body types.CreateSigningRequestPayload
 		if err := util.BindAndValidateBody(c, &body); err != nil {
@@ -33,7 +37,22 @@ func postCreateSigningRequestHandler(s *api.Server) echo.HandlerFunc {
 		}
 		userID := user.ID
 
-		req, err := s.Signing.CreateRequest(ctx, string(*body.WalletID), body.TxData, body.Note, userID)
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, vaultID, userID)
+		if err != nil {
+			return err
+		}
+		if role == organization.RoleAuditor {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		req, err := s.Signing.CreateRequest(ctx, signingService.CreateRequestParams{
+			VaultID:   vaultID,
+			WalletID:  string(*body.WalletID),
+			ToAddress: swag.StringValue(body.ToAddress),
+			TxData:    body.TxData,
+			Note:      body.Note,
+			UserID:    userID,
+		})
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to create signing request")
 			return err
@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	depositService "github.com/kashguard/go-mpc-vault/internal/service/deposit"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListVaultDepositsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.GET("/:vaultId/deposits", getListVaultDepositsHandler(s))
+}
+
+func getListVaultDepositsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewGetListVaultDepositsRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		if _, _, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID); err != nil {
+			return err
+		}
+
+		deposits, total, err := s.Deposit.ListDeposits(ctx, depositService.ListDepositsParams{
+			VaultID: vaultID,
+			Status:  swag.StringValue(params.Status),
+			Page:    int(swag.Int64Value(params.Page)),
+			Limit:   int(swag.Int64Value(params.Limit)),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list deposits")
+			return err
+		}
+
+		resp := &types.ListVaultDepositsResponse{
+			Deposits: make([]*types.VaultDeposit, 0, len(deposits)),
+			Total:    swag.Int64(total),
+		}
+		for _, d := range deposits {
+			resp.Deposits = append(resp.Deposits, mapDeposit(d))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListVaultsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.GET("", getListVaultsHandler(s))
+}
+
+func getListVaultsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewGetListVaultsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		var requested string
+		if params.OrganizationID != nil {
+			requested = params.OrganizationID.String()
+		}
+
+		orgID, err := s.Organization.ResolveOrganization(ctx, user.ID, requested)
+		if err != nil {
+			return err
+		}
+
+		vaults, total, err := s.Vault.ListVaults(ctx, orgID, int(swag.Int64Value(params.Page)), int(swag.Int64Value(params.Limit)))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list vaults")
+			return err
+		}
+
+		resp := &types.ListVaultsResponse{
+			Vaults: make([]*types.Vault, 0, len(vaults)),
+			Total:  swag.Int64(total),
+		}
+		for _, v := range vaults {
+			resp.Vaults = append(resp.Vaults, mapVault(v))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
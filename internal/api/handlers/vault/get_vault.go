@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetVaultRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.GET("/:vaultId", getVaultHandler(s))
+}
+
+func getVaultHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewGetVaultRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		if _, _, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID); err != nil {
+			return err
+		}
+
+		v, err := s.Vault.GetVault(ctx, vaultID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get vault")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapVault(v))
+	}
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PatchUpdateVaultRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.PATCH("/:vaultId", patchUpdateVaultHandler(s))
+}
+
+func patchUpdateVaultHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewPatchUpdateVaultRouteParams()
+		var body types.UpdateVaultPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID)
+		if err != nil {
+			return err
+		}
+		if role == organization.RoleAuditor {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		resp := &types.UpdateVaultResponse{}
+
+		if body.Name != "" {
+			if _, err := s.Vault.RenameVault(ctx, vaultID, body.Name, user.ID); err != nil {
+				log.Error().Err(err).Msg("Failed to rename vault")
+				return err
+			}
+		}
+
+		if body.WhitelistOnly != nil {
+			// Lifting the restriction weakens the vault's policy, it is reserved for owners and admins.
+			if role != organization.RoleOwner && role != organization.RoleAdmin {
+				return httperrors.ErrForbiddenInsufficientRole
+			}
+			if _, err := s.Vault.SetWhitelistOnly(ctx, vaultID, *body.WhitelistOnly, user.ID); err != nil {
+				log.Error().Err(err).Msg("Failed to update vault whitelist mode")
+				return err
+			}
+		}
+
+		if body.Threshold != 0 {
+			proposal, err := s.Vault.ProposeThresholdChange(ctx, vaultID, user.ID, int(body.Threshold))
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to propose threshold change")
+				return err
+			}
+			resp.ThresholdChange = mapProposal(proposal)
+		}
+
+		v, err := s.Vault.GetVault(ctx, vaultID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get vault")
+			return err
+		}
+		resp.Vault = mapVault(v)
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	vaultService "github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostApproveVaultProposalRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.POST("/:vaultId/proposals/:proposalId/approve", postApproveVaultProposalHandler(s))
+}
+
+func postApproveVaultProposalHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewPostApproveVaultProposalRouteParams()
+		var body types.ApproveVaultProposalPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		if _, _, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID); err != nil {
+			return err
+		}
+
+		proposalID := params.ProposalID.String()
+		proposal, err := s.Vault.GetProposal(ctx, proposalID)
+		if err != nil || proposal.VaultID != vaultID {
+			return echo.ErrNotFound
+		}
+
+		if swag.StringValue(body.Action) == "reject" {
+			_, err = s.Vault.RejectProposal(ctx, proposalID, user.ID)
+		} else {
+			_, err = s.Vault.ApproveProposal(ctx, proposalID, vaultService.ApprovalParams{
+				UserID:            user.ID,
+				CredentialID:      body.CredentialID,
+				Signature:         body.Signature,
+				AuthenticatorData: body.AuthenticatorData,
+				ClientDataJSON:    body.ClientDataJSON,
+			})
+		}
+		if err != nil {
+			log.Debug().Err(err).Str("proposal_id", proposalID).Msg("Failed to vote on vault proposal")
+			return err
+		}
+
+		proposal, err = s.Vault.GetProposal(ctx, proposalID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to reload vault proposal")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapProposal(proposal))
+	}
+}
@@ -0,0 +1,177 @@
+package vault_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withThresholdChange creates a vault of an organization of User1 and User2 requiring both of them to approve, and
+// proposes lowering its threshold to one.
+func withThresholdChange(t *testing.T, s *api.Server) (*models.Vault, *types.VaultProposal) {
+	t.Helper()
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+	require.NoError(t, err)
+	_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+	require.NoError(t, err)
+
+	v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 2, fix.User1.ID, []vault.KeyConfig{
+		{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+	})
+	require.NoError(t, err)
+
+	res := test.PerformRequest(t, s, "PATCH", "/api/v1/vaults/"+v.ID, test.GenericPayload{"threshold": 1}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.UpdateVaultResponse
+	test.ParseResponseAndValidate(t, res, &response)
+	require.NotNil(t, response.ThresholdChange)
+	assert.Equal(t, vault.ProposalStatusPending, *response.ThresholdChange.Status)
+	assert.Equal(t, int64(2), *response.ThresholdChange.RequiredApprovals)
+
+	return v, response.ThresholdChange
+}
+
+func approvePayload(assertion test.GenericPayload, action string) test.GenericPayload {
+	payload := test.GenericPayload{"action": action}
+	for k, v := range assertion {
+		payload[k] = v
+	}
+
+	return payload
+}
+
+func TestPostApproveVaultProposalQuorum(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, proposal := withThresholdChange(t, s)
+		path := fmt.Sprintf("/api/v1/vaults/%s/proposals/%s/approve", v.ID, proposal.ID.String())
+		passkey1 := test.NewPasskey(t, s, fix.User1.ID)
+		passkey2 := test.NewPasskey(t, s, fix.User2.ID)
+
+		res := test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey1.Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var response types.VaultProposal
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, vault.ProposalStatusPending, *response.Status)
+
+		// Votes must be confirmed with a passkey assertion verifying against a challenge issued to the voter.
+		res = test.PerformRequest(t, s, "POST", path, test.GenericPayload{"action": "approve"}, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasskeyRequired)
+
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey1.Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		tampered := passkey2.Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID.String())
+		tampered.Signature[len(tampered.Signature)-1] ^= 0x01
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(tampered), "approve"), test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		// Challenges issued for another operation or another proposal do not confirm the vote.
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey2.Assert(t, s, auth.AssertionActionApproveRecovery, proposal.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey2.Assert(t, s, auth.AssertionActionApproveProposal, uuid.NewString())), "approve"), test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		v2, err := s.Vault.GetVault(ctx, v.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, v2.Threshold)
+
+		// The second approval reaches the quorum and applies the change.
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey2.Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, vault.ProposalStatusExecuted, *response.Status)
+
+		v2, err = s.Vault.GetVault(ctx, v.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, v2.Threshold)
+
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey1.Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictProposalNotPending)
+	})
+}
+
+func TestPostApproveVaultProposalReject(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, proposal := withThresholdChange(t, s)
+		path := fmt.Sprintf("/api/v1/vaults/%s/proposals/%s/approve", v.ID, proposal.ID.String())
+		passkey1 := test.NewPasskey(t, s, fix.User1.ID)
+
+		res := test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey1.Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// A single rejection closes the proposal.
+		res = test.PerformRequest(t, s, "POST", path, test.GenericPayload{"action": "reject"}, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var response types.VaultProposal
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, vault.ProposalStatusRejected, *response.Status)
+
+		res = test.PerformRequest(t, s, "POST", path, test.GenericPayload{"action": "reject"}, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictProposalNotPending)
+
+		v2, err := s.Vault.GetVault(ctx, v.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, v2.Threshold)
+	})
+}
+
+func TestPostApproveVaultProposalInvalidThreshold(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+		require.NoError(t, err)
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "PATCH", "/api/v1/vaults/"+v.ID, test.GenericPayload{"threshold": 2}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var update types.UpdateVaultResponse
+		test.ParseResponseAndValidate(t, res, &update)
+		require.NotNil(t, update.ThresholdChange)
+
+		// The proposed threshold exceeds the approvers left, the approval cancels the proposal.
+		require.NoError(t, s.Organization.RemoveMember(ctx, org.ID, fix.User2.ID, fix.User1.ID))
+		path := fmt.Sprintf("/api/v1/vaults/%s/proposals/%s/approve", v.ID, update.ThresholdChange.ID.String())
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey.Assert(t, s, auth.AssertionActionApproveProposal, update.ThresholdChange.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var response types.VaultProposal
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, vault.ProposalStatusCancelled, *response.Status)
+		assert.Equal(t, "threshold 2 exceeds the 1 eligible approvers", response.StatusReason)
+
+		require.NoError(t, v.Reload(ctx, s.DB))
+		assert.Equal(t, 1, v.Threshold)
+
+		res = test.PerformRequest(t, s, "POST", path, approvePayload(test.AssertionPayload(passkey.Assert(t, s, auth.AssertionActionApproveProposal, update.ThresholdChange.ID.String())), "approve"), test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		test.RequireHTTPError(t, res, httperrors.ErrConflictProposalNotPending)
+	})
+}
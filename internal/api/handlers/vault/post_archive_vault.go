@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostArchiveVaultRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.POST("/:vaultId/archive", postArchiveVaultHandler(s))
+}
+
+func postArchiveVaultHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewPostArchiveVaultRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID)
+		if err != nil {
+			return err
+		}
+		if role != organization.RoleOwner && role != organization.RoleAdmin {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		if _, err := s.Vault.ArchiveVault(ctx, vaultID, user.ID); err != nil {
+			log.Debug().Err(err).Msg("Failed to archive vault")
+			return err
+		}
+
+		v, err := s.Vault.GetVault(ctx, vaultID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get vault")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapVault(v))
+	}
+}
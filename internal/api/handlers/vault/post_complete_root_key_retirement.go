@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostCompleteRootKeyRetirementRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Vault.POST("/:vaultId/keys/:keyId/complete-retirement", postCompleteRootKeyRetirementHandler(s))
+}
+
+func postCompleteRootKeyRetirementHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewPostCompleteRootKeyRetirementRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		vaultID := params.VaultID.String()
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID)
+		if err != nil {
+			return err
+		}
+		if role != organization.RoleOwner && role != organization.RoleAdmin {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		proposal, err := s.Vault.ProposeKeyRetirementCompletion(ctx, vaultID, user.ID, params.KeyID)
+		if err != nil {
+			log.Debug().Err(err).Str("key_id", params.KeyID).Msg("Failed to propose completion of key retirement")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, mapProposal(proposal))
+	}
+}
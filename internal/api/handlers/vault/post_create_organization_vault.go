@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostCreateOrganizationVaultRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/vaults", postCreateOrganizationVaultHandler(s))
+}
+
+func postCreateOrganizationVaultHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := vault.NewPostCreateOrganizationVaultRouteParams()
+		var body types.CreateVaultPayload
+		if err := util.BindAndValidate(c, &body, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		// the organization of the path always wins over the one of the payload
+		res, err := createVault(ctx, s, params.OrgID.String(), user.ID, &body)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to create vault")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, res)
+	}
+}
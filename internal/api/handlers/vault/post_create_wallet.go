@@ -6,8 +6,9 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
 	"github.com/kashguard/go-mpc-vault/internal/auth"
-	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
 	"github.com/kashguard/go-mpc-vault/internal/types"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"github.com/labstack/echo/v4"
@@ -33,22 +34,15 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 		if user == nil {
 			return echo.ErrUnauthorized
 		}
-		v, err := models.FindVault(ctx, s.DB, vaultID)
-		if err != nil {
-			return echo.ErrNotFound
-		}
-		ok, err := models.OrganizationMembers(
-			models.OrganizationMemberWhere.OrganizationID.EQ(v.OrganizationID.String),
-			models.OrganizationMemberWhere.UserID.EQ(user.ID),
-		).Exists(ctx, s.DB)
+		_, role, err := s.Vault.GetVaultMemberRole(ctx, vaultID, user.ID)
 		if err != nil {
 			return err
 		}
-		if !ok && v.OrganizationID.Valid {
-			return echo.ErrForbidden
+		if role == organization.RoleAuditor {
+			return httperrors.ErrForbiddenInsufficientRole
 		}
 
-		wallet, err := s.Vault.CreateWallet(ctx, vaultID, swag.StringValue(body.ChainID))
+		wallet, err := s.Vault.CreateWallet(ctx, vaultID, swag.StringValue(body.ChainID), user.ID)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to create wallet")
 			return err
@@ -0,0 +1,184 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	vaultService "github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+)
+
+// createVault creates a vault within the given organization on behalf of the user, including a
+// wallet for each of the requested chains. Auditors are not allowed to create vaults.
+func createVault(ctx context.Context, s *api.Server, orgID string, userID string, body *types.CreateVaultPayload) (*types.CreateVaultResponse, error) {
+	role, err := s.Organization.MemberRole(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == organization.RoleAuditor {
+		return nil, httperrors.ErrForbiddenInsufficientRole
+	}
+
+	keys := make([]vaultService.KeyConfig, 0, len(body.Keys))
+	for _, key := range body.Keys {
+		keys = append(keys, vaultService.KeyConfig{
+			Curve:      swag.StringValue(key.Curve),
+			Protocol:   swag.StringValue(key.Protocol),
+			Threshold:  int(key.Threshold),
+			TotalNodes: int(key.TotalNodes),
+		})
+	}
+
+	v, err := s.Vault.CreateVault(ctx, swag.StringValue(body.Name), orgID, int(body.Threshold), userID, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, chainID := range body.Chains {
+		if _, err := s.Vault.CreateWallet(ctx, v.ID, chainID, userID); err != nil {
+			util.LogFromContext(ctx).Error().Err(err).Str("chain_id", chainID).Msg("Failed to create initial wallet")
+		}
+	}
+
+	return &types.CreateVaultResponse{
+		VaultID: strfmt.UUID4(v.ID),
+		Status:  v.Status,
+	}, nil
+}
+
+func mapVault(v *models.Vault) *types.Vault {
+	res := &types.Vault{
+		ID:               (*strfmt.UUID4)(swag.String(v.ID)),
+		Name:             swag.String(v.Name),
+		Threshold:        swag.Int64(int64(v.Threshold)),
+		Status:           swag.String(v.Status),
+		WhitelistOnly:    v.WhitelistOnly,
+		OrganizationID:   strfmt.UUID4(v.OrganizationID.String),
+		Wallets:          []*types.VaultWallet{},
+		Keys:             []*types.VaultKey{},
+		PendingProposals: []*types.VaultProposal{},
+	}
+	if v.ArchivedAt.Valid {
+		res.ArchivedAt = strfmt.DateTime(v.ArchivedAt.Time)
+	}
+	if v.CreatedAt.Valid {
+		res.CreatedAt = strfmt.DateTime(v.CreatedAt.Time)
+	}
+	if v.UpdatedAt.Valid {
+		res.UpdatedAt = strfmt.DateTime(v.UpdatedAt.Time)
+	}
+
+	if v.R == nil {
+		return res
+	}
+
+	for _, w := range v.R.Wallets {
+		wallet := &types.VaultWallet{
+			ID:         (*strfmt.UUID4)(swag.String(w.ID)),
+			Address:    swag.String(w.Address),
+			ChainID:    w.ChainID.String,
+			KeyID:      w.KeyID,
+			DerivePath: w.DerivePath,
+		}
+		if w.CreatedAt.Valid {
+			wallet.CreatedAt = strfmt.DateTime(w.CreatedAt.Time)
+		}
+		res.Wallets = append(res.Wallets, wallet)
+	}
+
+	for _, k := range v.R.VaultKeys {
+		key := &types.VaultKey{
+			ID:           (*strfmt.UUID4)(swag.String(k.ID)),
+			KeyID:        swag.String(k.KeyID),
+			Algorithm:    k.Algorithm,
+			Curve:        k.Curve,
+			PublicKeyHex: k.PublicKeyHex,
+			Protocol:     k.Protocol.String,
+			Threshold:    int64(k.Threshold.Int),
+			TotalNodes:   int64(k.TotalNodes.Int),
+			RefreshEpoch: int64(k.RefreshEpoch),
+		}
+		if k.RefreshedAt.Valid {
+			key.RefreshedAt = strfmt.DateTime(k.RefreshedAt.Time)
+		}
+		if k.CreatedAt.Valid {
+			key.CreatedAt = strfmt.DateTime(k.CreatedAt.Time)
+		}
+		res.Keys = append(res.Keys, key)
+	}
+
+	for _, p := range v.R.VaultProposals {
+		res.PendingProposals = append(res.PendingProposals, mapProposal(p))
+	}
+
+	return res
+}
+
+func mapProposal(p *models.VaultProposal) *types.VaultProposal {
+	res := &types.VaultProposal{
+		ID:                (*strfmt.UUID4)(swag.String(p.ID)),
+		VaultID:           (*strfmt.UUID4)(swag.String(p.VaultID)),
+		Kind:              swag.String(p.Kind),
+		Status:            swag.String(p.Status),
+		RequiredApprovals: swag.Int64(int64(p.RequiredApprovals)),
+		InitiatorID:       strfmt.UUID4(p.InitiatorID.String),
+		StatusReason:      p.StatusReason.String,
+	}
+	if p.CreatedAt.Valid {
+		res.CreatedAt = strfmt.DateTime(p.CreatedAt.Time)
+	}
+
+	var payload struct {
+		Threshold      int64  `json:"threshold"`
+		KeyID          string `json:"key_id"`
+		SuccessorKeyID string `json:"successor_key_id"`
+		Trigger        string `json:"trigger"`
+	}
+	if err := json.Unmarshal(p.Payload, &payload); err == nil {
+		res.Threshold = payload.Threshold
+		res.KeyID = payload.KeyID
+		res.SuccessorKeyID = payload.SuccessorKeyID
+		res.Trigger = payload.Trigger
+	}
+
+	if p.R != nil {
+		for _, a := range p.R.VaultProposalApprovals {
+			if a.Action == "approve" {
+				res.CurrentApprovals++
+			}
+		}
+	}
+
+	return res
+}
+
+func mapDeposit(d *models.Deposit) *types.VaultDeposit {
+	res := &types.VaultDeposit{
+		ID:            (*strfmt.UUID4)(swag.String(d.ID)),
+		WalletID:      (*strfmt.UUID4)(swag.String(d.WalletID)),
+		ChainID:       swag.String(d.ChainID),
+		AssetID:       strfmt.UUID4(d.AssetID.String),
+		TxHash:        swag.String(d.TXHash),
+		FromAddress:   swag.String(d.FromAddress),
+		Amount:        swag.String(d.Amount),
+		BlockNumber:   swag.Int64(d.BlockNumber),
+		Confirmations: swag.Int64(int64(d.Confirmations)),
+		Status:        swag.String(d.Status),
+		CreatedAt:     (*strfmt.DateTime)(&d.CreatedAt),
+	}
+	if d.ConfirmedAt.Valid {
+		res.ConfirmedAt = strfmt.DateTime(d.ConfirmedAt.Time)
+	}
+	if asset := d.R.GetAsset(); asset != nil {
+		res.Symbol = asset.Symbol
+		res.Decimals = int64(asset.Decimals)
+	}
+	return res
+}
@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteWebhookEndpointRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.DELETE("/:orgId/webhooks/:endpointId", deleteWebhookEndpointHandler(s))
+}
+
+func deleteWebhookEndpointHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := webhook.NewDeleteWebhookEndpointRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		if err := s.Outbox.DeleteEndpoint(ctx, orgID, params.EndpointID.String(), auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	outboxService "github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListWebhookDeliveriesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/webhooks/:endpointId/deliveries", getListWebhookDeliveriesHandler(s))
+}
+
+func getListWebhookDeliveriesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := webhook.NewGetListWebhookDeliveriesRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		deliveries, total, err := s.Outbox.ListDeliveries(ctx, outboxService.ListDeliveriesParams{
+			OrganizationID: orgID,
+			EndpointID:     params.EndpointID.String(),
+			Status:         swag.StringValue(params.Status),
+			Page:           int(swag.Int64Value(params.Page)),
+			Limit:          int(swag.Int64Value(params.Limit)),
+		})
+		if err != nil {
+			return err
+		}
+
+		resp := &types.ListWebhookDeliveriesResponse{
+			Deliveries: make([]*types.WebhookDelivery, 0, len(deliveries)),
+			Total:      swag.Int64(total),
+		}
+		for _, d := range deliveries {
+			resp.Deliveries = append(resp.Deliveries, mapDelivery(d))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListWebhookEndpointsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.GET("/:orgId/webhooks", getListWebhookEndpointsHandler(s))
+}
+
+func getListWebhookEndpointsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := webhook.NewGetListWebhookEndpointsRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		endpoints, err := s.Outbox.ListEndpoints(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		resp := &types.ListWebhookEndpointsResponse{
+			Endpoints: make([]*types.WebhookEndpoint, 0, len(endpoints)),
+		}
+		for _, e := range endpoints {
+			resp.Endpoints = append(resp.Endpoints, mapEndpoint(e))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	webhookService "github.com/kashguard/go-mpc-vault/internal/service/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetListWebhookEventsRoute(s *api.Server) *echo.Route {
+	return s.Router.Management.GET("/webhooks/events", getListWebhookEventsHandler(s))
+}
+
+func getListWebhookEventsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := webhook.NewGetListWebhookEventsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		events, total, err := s.Webhook.ListEvents(ctx, webhookService.ListEventsParams{
+			Provider: swag.StringValue(params.Provider),
+			Status:   swag.StringValue(params.Status),
+			Page:     int(swag.Int64Value(params.Page)),
+			Limit:    int(swag.Int64Value(params.Limit)),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list webhook events")
+			return err
+		}
+
+		resp := &types.ListWebhookEventsResponse{
+			Events: make([]*types.WebhookEvent, 0, len(events)),
+			Total:  swag.Int64(total),
+		}
+		for _, e := range events {
+			resp.Events = append(resp.Events, mapEvent(e))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	outboxService "github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostCreateWebhookEndpointRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/webhooks", postCreateWebhookEndpointHandler(s))
+}
+
+func postCreateWebhookEndpointHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := webhook.NewPostCreateWebhookEndpointRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		var body types.CreateWebhookEndpointPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		eventTypes := make([]string, 0, len(body.EventTypes))
+		for _, t := range body.EventTypes {
+			eventTypes = append(eventTypes, string(t))
+		}
+
+		endpoint, err := s.Outbox.CreateEndpoint(ctx, outboxService.CreateEndpointParams{
+			OrganizationID: orgID,
+			URL:            swag.StringValue(body.URL),
+			Description:    body.Description,
+			EventTypes:     eventTypes,
+			UserID:         auth.UserFromContext(ctx).ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		resp := mapEndpoint(endpoint)
+		resp.Secret = endpoint.Secret
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostReceiveWebhookRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Webhook.POST("/:provider", postReceiveWebhookHandler(s))
+}
+
+func postReceiveWebhookHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := webhook.NewPostReceiveWebhookRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		// The signature covers the raw body, so it is read as is instead of binding it.
+		body, err := io.ReadAll(http.MaxBytesReader(c.Response(), c.Request().Body, s.Config.Webhook.MaxPayloadBytes))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return echo.ErrStatusRequestEntityTooLarge
+			}
+			return err
+		}
+
+		event, duplicate, err := s.Webhook.Receive(ctx, params.Provider, c.Request().Header, body)
+		if err != nil {
+			log.Debug().Err(err).Str("provider", params.Provider).Msg("Failed to receive webhook")
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.WebhookEventReceipt{
+			ID:        (*strfmt.UUID4)(swag.String(event.ID)),
+			Status:    swag.String(event.Status),
+			Duplicate: swag.Bool(duplicate),
+		})
+	}
+}
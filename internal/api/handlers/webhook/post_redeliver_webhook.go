@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostRedeliverWebhookRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Org.POST("/:orgId/webhooks/:endpointId/deliveries/:deliveryId/redeliver", postRedeliverWebhookHandler(s))
+}
+
+func postRedeliverWebhookHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := webhook.NewPostRedeliverWebhookRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+		orgID := params.OrgID.String()
+		if err := s.Organization.RequireManager(ctx, orgID, auth.UserFromContext(ctx).ID); err != nil {
+			return err
+		}
+
+		delivery, err := s.Outbox.Redeliver(ctx, orgID, params.EndpointID.String(), params.DeliveryID.String(), auth.UserFromContext(ctx).ID)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapDelivery(delivery))
+	}
+}
@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/types/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostReprocessWebhookEventRoute(s *api.Server) *echo.Route {
+	return s.Router.Management.POST("/webhooks/events/:eventId/reprocess", postReprocessWebhookEventHandler(s))
+}
+
+func postReprocessWebhookEventHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := webhook.NewPostReprocessWebhookEventRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		event, err := s.Webhook.Reprocess(ctx, params.EventID.String())
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, mapEvent(event))
+	}
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+func mapEvent(e *models.WebhookEvent) *types.WebhookEvent {
+	res := &types.WebhookEvent{
+		ID:        (*strfmt.UUID4)(swag.String(e.ID)),
+		Provider:  swag.String(e.Provider),
+		EventID:   swag.String(e.EventID),
+		EventType: swag.String(e.EventType),
+		Status:    swag.String(e.Status),
+		Attempts:  swag.Int64(int64(e.Attempts)),
+		LastError: e.LastError.String,
+		CreatedAt: (*strfmt.DateTime)(&e.CreatedAt),
+	}
+	if e.ProcessedAt.Valid {
+		res.ProcessedAt = strfmt.DateTime(e.ProcessedAt.Time)
+	}
+	return res
+}
+
+// mapEndpoint maps the endpoint without its secret, which is only returned once created.
+func mapEndpoint(e *models.WebhookEndpoint) *types.WebhookEndpoint {
+	eventTypes := make([]string, 0, len(e.EventTypes))
+	eventTypes = append(eventTypes, e.EventTypes...)
+
+	return &types.WebhookEndpoint{
+		ID:             (*strfmt.UUID4)(swag.String(e.ID)),
+		OrganizationID: (*strfmt.UUID4)(swag.String(e.OrganizationID)),
+		URL:            swag.String(e.URL),
+		Description:    e.Description.String,
+		EventTypes:     eventTypes,
+		CreatedAt:      (*strfmt.DateTime)(&e.CreatedAt),
+	}
+}
+
+func mapDelivery(d *models.WebhookDelivery) *types.WebhookDelivery {
+	res := &types.WebhookDelivery{
+		ID:             (*strfmt.UUID4)(swag.String(d.ID)),
+		EndpointID:     (*strfmt.UUID4)(swag.String(d.EndpointID)),
+		EventID:        (*strfmt.UUID4)(swag.String(d.EventID)),
+		EventType:      swag.String(""),
+		Status:         swag.String(d.Status),
+		Attempts:       swag.Int64(int64(d.Attempts)),
+		NextAttemptAt:  (*strfmt.DateTime)(&d.NextAttemptAt),
+		LastStatusCode: int64(d.LastStatusCode.Int),
+		LastError:      d.LastError.String,
+		CreatedAt:      (*strfmt.DateTime)(&d.CreatedAt),
+	}
+	if d.DeliveredAt.Valid {
+		res.DeliveredAt = strfmt.DateTime(d.DeliveredAt.Time)
+	}
+	if event := d.R.GetEvent(); event != nil {
+		res.EventType = swag.String(event.EventType)
+		res.ResourceType = event.ResourceType
+		res.ResourceID = event.ResourceID
+	}
+	return res
+}
@@ -0,0 +1,18 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrBadRequestUnknownChain             = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeUNKNOWNCHAIN, "Chain is not supported")
+	ErrBadRequestInvalidAddress           = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDADDRESS, "Address is not valid for the chain")
+	ErrNotFoundAddressBookEntry           = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeADDRESSBOOKENTRYNOTFOUND, "Address book entry was not found")
+	ErrConflictAddressBookEntryExists     = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeADDRESSBOOKENTRYEXISTS, "Address is already part of the address book")
+	ErrConflictAddressBookEntryNotPending = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeADDRESSBOOKENTRYNOTPENDING, "Address book entry is not pending approval")
+	ErrForbiddenSelfApproval              = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeSELFAPPROVALFORBIDDEN, "Address book entry must be approved by another admin")
+	ErrForbiddenDestinationNotWhitelisted = NewHTTPErrorWithDetail(http.StatusForbidden, types.PublicHTTPErrorTypeDESTINATIONNOTWHITELISTED, "Destination is not whitelisted", "Vault only allows destinations which are whitelisted within the address book")
+	ErrConflictDestinationCoolingOff      = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeDESTINATIONCOOLINGOFF, "Destination is still cooling off", "Address book entry was added recently and cannot be used yet")
+)
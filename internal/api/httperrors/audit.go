@@ -0,0 +1,11 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrBadRequestInvalidCursor = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDCURSOR, "Cursor is not valid")
+)
@@ -13,4 +13,6 @@ var (
 	ErrNotFoundTokenNotFound     = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeTOKENNOTFOUND, "Provided token was not found")
 	ErrConflictTokenExpired      = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeTOKENEXPIRED, "Provided token has expired and is no longer valid")
 	ErrConflictUserAlreadyExists = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeUSERALREADYEXISTS, "User with given username already exists")
+
+	ErrForbiddenPasskeyAssertionInvalid = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypePASSKEYASSERTIONINVALID, "The passkey assertion does not verify against a challenge issued to the user")
 )
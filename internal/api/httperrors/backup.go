@@ -0,0 +1,24 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrBadRequestInvalidDevicePublicKey = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDDEVICEPUBLICKEY, "Device public key is not valid")
+	ErrNotFoundKeyShare                 = NewHTTPErrorWithDetail(http.StatusNotFound, types.PublicHTTPErrorTypeKEYSHARENOTFOUND, "Key share was not found", "Only users eligible to approve within the vault of the key hold a share")
+	ErrConflictShareNotDelivered        = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeSHARENOTDELIVERED, "Key share was not delivered", "The share must be delivered to the device before its receipt can be confirmed")
+	ErrBadGatewayInvalidShareDelivery   = NewHTTPErrorWithDetail(http.StatusBadGateway, types.PublicHTTPErrorTypeINVALIDSHAREDELIVERY, "Key share delivery could not be verified", "The signature or timestamp of the delivery of the MPC server is not valid")
+	ErrServiceUnavailableShareDelivery  = NewHTTPError(http.StatusServiceUnavailable, types.PublicHTTPErrorTypeSHAREDELIVERYUNAVAILABLE, "Key share delivery is not configured")
+)
+
+var (
+	ErrNotFoundKeyRecovery             = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeKEYRECOVERYNOTFOUND, "Key recovery was not found")
+	ErrConflictKeyRecoveryInProgress   = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeKEYRECOVERYINPROGRESS, "Key recovery is in progress", "The key is frozen until the recovery of its share completed, failed or was rejected")
+	ErrConflictKeyRecoveryNotPending   = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeKEYRECOVERYNOTPENDING, "Key recovery is not pending")
+	ErrConflictKeyRecoveryAlreadyVoted = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeKEYRECOVERYALREADYVOTED, "User already voted on the key recovery")
+	ErrForbiddenNotRecoveryApprover    = NewHTTPErrorWithDetail(http.StatusForbidden, types.PublicHTTPErrorTypeNOTRECOVERYAPPROVER, "User may not vote on the key recovery", "Recoveries are approved by owners and admins of the organization other than the initiator and the user whose share is recovered")
+	ErrConflictNoRecoveryApprovers     = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeNORECOVERYAPPROVERS, "Key recovery cannot be approved", "No owner or admin other than the initiator and the user whose share is recovered may approve the recovery")
+)
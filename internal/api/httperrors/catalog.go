@@ -0,0 +1,20 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrBadRequestChainInactive            = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeCHAININACTIVE, "Chain is not active")
+	ErrNotFoundAsset                      = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeASSETNOTFOUND, "Asset was not found")
+	ErrConflictAssetExists                = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeASSETEXISTS, "Asset already exists on the chain")
+	ErrBadRequestUnsupportedAssetType     = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeUNSUPPORTEDASSETTYPE, "Asset type is not supported on the chain", "ERC20 assets require an EVM chain, SPL assets a SOLANA chain and TRC20 assets a TRON chain")
+	ErrBadRequestInvalidDecimals          = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDDECIMALS, "Decimals are out of range for the asset type")
+	ErrBadRequestNotAToken                = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeNOTATOKEN, "Contract address does not hold a token of the asset type")
+	ErrBadRequestAssetMetadataUnavailable = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeASSETMETADATAUNAVAILABLE, "Token metadata is not available", "The chain has no RPC endpoint or the token does not publish its symbol and name, provide symbol, name and decimals")
+	ErrBadGatewayAssetMetadataUnavailable = NewHTTPError(http.StatusBadGateway, types.PublicHTTPErrorTypeASSETMETADATAUNAVAILABLE, "Token metadata could not be read from the chain")
+	ErrBadRequestAssetMetadataMismatch    = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeASSETMETADATAMISMATCH, "Token metadata does not match the chain", "Symbol and decimals must match the values reported by the token contract, omit them to use the values of the chain")
+	ErrConflictAssetSymbol                = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeASSETSYMBOLCONFLICT, "Symbol is already used by another asset on the chain", "Confirm the asset by setting acknowledge_conflicts")
+)
@@ -0,0 +1,14 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrNotFoundDevice                = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeDEVICENOTFOUND, "Device was not found")
+	ErrConflictDeviceAlreadyEnrolled = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeDEVICEALREADYENROLLED, "Device is already enrolled", "Revoke the device before enrolling it again")
+	ErrConflictDeviceRevoked         = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeDEVICEREVOKED, "Device was revoked")
+	ErrNotFoundCredential            = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeCREDENTIALNOTFOUND, "Passkey was not found")
+)
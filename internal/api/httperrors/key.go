@@ -0,0 +1,22 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrBadRequestInvalidSuccessorKey      = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDSUCCESSORKEY, "Invalid successor key", "The successor has to be another active key of the same vault")
+	ErrConflictKeyNotActive               = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeKEYNOTACTIVE, "Key is being retired or was retired")
+	ErrConflictKeyNotRetiring             = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeKEYNOTRETIRING, "Retirement of key was not approved")
+	ErrConflictKeyRetirementPending       = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeKEYRETIREMENTPENDING, "Retirement of key is already proposed")
+	ErrConflictKeyFundsNotSwept           = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeKEYFUNDSNOTSWEPT, "Funds of key were not swept", "Every wallet of the key holding funds needs a completed signing request to a wallet of the successor key")
+	ErrBadRequestSweepDestinationRequired = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeSWEEPDESTINATIONREQUIRED, "Destination is not a wallet of the successor key", "Keys being retired may only sign transfers to wallets of their successor key on the same chain")
+	ErrBadRequestInvalidMpcProtocol       = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDMPCPROTOCOL, "Protocol does not support curve", "gg18 and gg20 support secp256k1 and secp256r1, frost supports secp256k1 and ed25519")
+	ErrBadRequestInvalidKeyThreshold      = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDKEYTHRESHOLD, "Threshold of key exceeds its total nodes")
+	ErrBadRequestDuplicateKeyCurve        = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeDUPLICATEKEYCURVE, "Only one key per curve may be configured")
+	ErrBadGatewayKeySpecMismatch          = NewHTTPErrorWithDetail(http.StatusBadGateway, types.PublicHTTPErrorTypeKEYSPECMISMATCH, "Key was not created as requested", "The MPC server reported a key with a different configuration than requested")
+	ErrConflictKeyRefreshPending          = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeKEYREFRESHPENDING, "Refresh of key is already proposed or running")
+	ErrConflictKeyRefreshInProgress       = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeKEYREFRESHINPROGRESS, "Shares of key are being refreshed", "Signing with the key resumes once the refresh of its shares completed or failed")
+)
@@ -0,0 +1,12 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrNotFoundKey                    = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeKEYNOTFOUND, "Key was not found")
+	ErrServiceUnavailableNodesOffline = NewHTTPErrorWithDetail(http.StatusServiceUnavailable, types.PublicHTTPErrorTypeMPCNODESOFFLINE, "Not enough MPC nodes are online", "Fewer nodes holding a share of the key are online than required to sign, retry once they are back")
+)
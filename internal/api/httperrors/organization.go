@@ -0,0 +1,21 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrForbiddenNotOrganizationMember    = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeNOTORGANIZATIONMEMBER, "User is not a member of the organization")
+	ErrForbiddenInsufficientRole         = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeINSUFFICIENTROLE, "User's role within the organization does not permit this action")
+	ErrBadRequestOrganizationRequired    = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeORGANIZATIONREQUIRED, "No organization selected", "User belongs to several organizations and has no default organization set")
+	ErrConflictAlreadyOrganizationMember = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeALREADYORGANIZATIONMEMBER, "User is already a member of the organization")
+	ErrNotFoundInvitation                = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeINVITATIONNOTFOUND, "Invitation was not found")
+	ErrConflictInvitationExpired         = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeINVITATIONEXPIRED, "Invitation has expired and is no longer valid")
+	ErrConflictInvitationNotPending      = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeINVITATIONNOTPENDING, "Invitation was already accepted or revoked")
+	ErrBadRequestRegistrationPassword    = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeREGISTRATIONPASSWORDREQUIRED, "Password required", "No user exists for the invited email yet, a password is required to register")
+	ErrConflictLastAdmin                 = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeLASTADMIN, "Organization requires an admin", "The last active admin of an organization cannot be removed or demoted")
+	ErrConflictOwnerMembershipImmutable  = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeOWNERMEMBERSHIPIMMUTABLE, "Owner membership cannot be changed", "Transfer the ownership of the organization first")
+	ErrBadRequestNewOwnerNotMember       = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeNEWOWNERNOTMEMBER, "New owner must be a member of the organization")
+)
@@ -0,0 +1,13 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrBadRequestInvalidTransaction           = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDTRANSACTION, "Transaction data could not be decoded", "Transaction data must be a hex encoded unsigned transaction; policies restricting destinations require a chain whose transactions are decoded")
+	ErrBadRequestTransactionRecipientMismatch = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeTRANSACTIONRECIPIENTMISMATCH, "Recipient of the transaction does not match the destination")
+	ErrConflictSigningRequestNotSigned        = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeSIGNINGREQUESTNOTSIGNED, "Signing request is not signed or was already broadcast")
+)
@@ -0,0 +1,19 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrNotFoundVault                  = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeVAULTNOTFOUND, "Vault not found")
+	ErrBadRequestWalletNotInVault     = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeWALLETNOTINVAULT, "Wallet does not belong to the given vault")
+	ErrConflictVaultArchived          = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeVAULTARCHIVED, "Vault is archived")
+	ErrBadRequestInvalidThreshold     = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDTHRESHOLD, "The threshold provided was invalid", "Threshold must differ from the current one and lie between 1 and the number of eligible approvers")
+	ErrConflictThresholdChangePending = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeTHRESHOLDCHANGEPENDING, "A threshold change is already pending for this vault")
+	ErrConflictProposalNotPending     = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypePROPOSALNOTPENDING, "Proposal is no longer pending")
+	ErrConflictProposalAlreadyVoted   = NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypePROPOSALALREADYVOTED, "User already voted on this proposal")
+	ErrForbiddenNotEligibleApprover   = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeNOTELIGIBLEAPPROVER, "User is not part of the vault quorum")
+	ErrForbiddenPasskeyRequired       = NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypePASSKEYREQUIRED, "A passkey assertion of a registered credential is required")
+)
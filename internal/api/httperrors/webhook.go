@@ -0,0 +1,18 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/types"
+)
+
+var (
+	ErrNotFoundWebhookProvider              = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeWEBHOOKPROVIDERNOTFOUND, "Webhook provider is unknown or not configured")
+	ErrUnauthorizedWebhookSignature         = NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeINVALIDWEBHOOKSIGNATURE, "Webhook signature is not valid")
+	ErrBadRequestInvalidWebhookPayload      = NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDWEBHOOKPAYLOAD, "Webhook payload is not a valid event of the provider")
+	ErrNotFoundWebhookEvent                 = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeWEBHOOKEVENTNOTFOUND, "Webhook event was not found")
+	ErrBadRequestInvalidWebhookURL          = NewHTTPErrorWithDetail(http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDWEBHOOKURL, "Webhook URL is not valid", "Webhook endpoints must use https and must not be within private networks")
+	ErrNotFoundWebhookEndpoint              = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeWEBHOOKENDPOINTNOTFOUND, "Webhook endpoint was not found")
+	ErrNotFoundWebhookDelivery              = NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeWEBHOOKDELIVERYNOTFOUND, "Webhook delivery was not found")
+	ErrConflictWebhookEventNotReprocessable = NewHTTPErrorWithDetail(http.StatusConflict, types.PublicHTTPErrorTypeWEBHOOKEVENTNOTREPROCESSABLE, "Webhook event cannot be processed again", "Only failed events, events which were not processed yet and events processing beyond their lease can be processed again")
+)
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+var (
+	DefaultRequestOriginConfig = RequestOriginConfig{
+		Skipper: middleware.DefaultSkipper,
+	}
+)
+
+type RequestOriginConfig struct {
+	Skipper middleware.Skipper
+}
+
+// RequestOrigin stores the IP address and user agent of the client in the request context,
+// so services can record them in the audit log.
+func RequestOrigin() echo.MiddlewareFunc {
+	return RequestOriginWithConfig(DefaultRequestOriginConfig)
+}
+
+func RequestOriginWithConfig(config RequestOriginConfig) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = DefaultRequestOriginConfig.Skipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			origin := util.NewRequestOrigin(c.RealIP(), c.Request().UserAgent())
+			ctx := util.WithRequestOrigin(c.Request().Context(), origin)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
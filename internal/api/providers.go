@@ -33,6 +33,14 @@ func NewPush(cfg config.Server, db *sql.DB) (*push.Service, error) {
 		pusher.RegisterProvider(fcmProvider)
 	}
 
+	if cfg.Push.UseAPNSProvider {
+		apnsProvider, err := provider.NewAPNS(cfg.Push.APNS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create APNs provider: %w", err)
+		}
+		pusher.RegisterProvider(apnsProvider)
+	}
+
 	if cfg.Push.UseMockProvider {
 		log.Warn().Msg("Initializing mock push provider")
 		mockProvider := provider.NewMock(push.ProviderTypeFCM)
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"database/sql"
+
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/service/addressbook"
+)
+
+func NewAddressBookService(cfg config.Server, db *sql.DB, clock time2.Clock) addressbook.Service {
+	return addressbook.NewService(cfg, db, clock)
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/tokenmeta"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/catalog"
+)
+
+func NewTokenMetadataResolver(cfg config.Server) tokenmeta.Resolver {
+	return tokenmeta.NewResolver(&http.Client{
+		Timeout: cfg.Catalog.MetadataResolveTimeout,
+	})
+}
+
+func NewCatalogService(db *sql.DB, resolver tokenmeta.Resolver, passkeys auth.AssertionVerifier) catalog.Service {
+	return catalog.NewService(db, resolver, passkeys)
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/evm"
+	"github.com/kashguard/go-mpc-vault/internal/mailer"
+	"github.com/kashguard/go-mpc-vault/internal/push"
+	"github.com/kashguard/go-mpc-vault/internal/service/deposit"
+)
+
+func NewEVMClient(cfg config.Server) evm.Client {
+	return evm.NewClient(&http.Client{
+		Timeout: cfg.Deposit.RPCTimeout,
+	})
+}
+
+func NewDepositService(cfg config.Server, db *sql.DB, clock time2.Clock, evmClient evm.Client, pusher *push.Service, m *mailer.Mailer) deposit.Service {
+	return deposit.NewService(cfg, db, clock, evmClient, pusher, m)
+}
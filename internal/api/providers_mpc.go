@@ -2,16 +2,28 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/dropbox/godropbox/time2"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/google/wire"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 
 	"github.com/kashguard/go-mpc-vault/internal/api/grpc/server"
 	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/evm"
 	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc/fake"
 	mpcAuth "github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/service/device"
+	"github.com/kashguard/go-mpc-vault/internal/service/key"
+	"github.com/kashguard/go-mpc-vault/internal/service/node"
+	"github.com/kashguard/go-mpc-vault/internal/service/notification"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
 	"github.com/kashguard/go-mpc-vault/internal/service/signing"
 	"github.com/kashguard/go-mpc-vault/internal/service/vault"
 )
@@ -20,43 +32,82 @@ var MpcProviderSet = wire.NewSet(
 	NewMpcClientConnection,
 	NewKeyClient,
 	NewSigningClient,
+	NewBackupClient,
+	NewNodeClient,
 	NewMpcAuthService,
+	NewAssertionVerifier,
 	NewVaultService,
 	NewSigningService,
+	NewBackupService,
+	NewNodeService,
+	NewDeviceService,
+	NewKeyService,
 	NewGrpcServer,
 )
 
-func NewMpcClientConnection(cfg config.Server) (*grpc.ClientConn, error) {
-	if cfg.Mpc.CACertFile == "" {
-		// In test environment or when MPC is not configured, return nil connection.
-		// Services depending on this should handle nil connection or not be invoked.
-		return nil, nil
+func NewMpcClientConnection(cfg config.Server) (*mpc.Connection, error) {
+	if cfg.Mpc.Fake {
+		if !cfg.Mpc.AllowFake {
+			return nil, errors.New("fake MPC server is only available in development environments setting SERVER_MPC_ALLOW_FAKE")
+		}
+		log.Warn().Msg("Using fake MPC server, keys are held in memory and signing requests are not authorized")
+		return fake.NewConnection()
 	}
 
-	mpcCfg := mpc.Config{
-		Address:    cfg.Mpc.Address,
-		ServerName: cfg.Mpc.ServerName,
-		CACertFile: cfg.Mpc.CACertFile,
-		CertFile:   cfg.Mpc.CertFile,
-		KeyFile:    cfg.Mpc.KeyFile,
+	if cfg.Mpc.CACertFile == "" {
+		// In test environment or when MPC is not configured, calls to the MPC server fail as unavailable.
+		log.Warn().Msg("No MPC server CA configured, calls to the MPC server will fail")
+		return mpc.NewUnconfiguredConnection(), nil
 	}
-	return mpc.NewClientConnection(mpcCfg)
+
+	return mpc.NewClientConnection(mpc.Config{
+		Address:                 cfg.Mpc.Address,
+		ServerName:              cfg.Mpc.ServerName,
+		CACertFile:              cfg.Mpc.CACertFile,
+		CertFile:                cfg.Mpc.CertFile,
+		KeyFile:                 cfg.Mpc.KeyFile,
+		CallTimeout:             cfg.Mpc.CallTimeout,
+		ProtocolTimeout:         cfg.Mpc.ProtocolTimeout,
+		MaxRetryAttempts:        cfg.Mpc.MaxRetryAttempts,
+		KeepaliveTime:           cfg.Mpc.KeepaliveTime,
+		KeepaliveTimeout:        cfg.Mpc.KeepaliveTimeout,
+		BreakerFailureThreshold: cfg.Mpc.BreakerFailureThreshold,
+		BreakerOpenTimeout:      cfg.Mpc.BreakerOpenTimeout,
+	})
 }
 
-func NewKeyClient(conn *grpc.ClientConn) *mpc.KeyClient {
+func NewKeyClient(conn *mpc.Connection) *mpc.KeyClient {
 	return mpc.NewKeyClient(conn)
 }
 
-func NewSigningClient(conn *grpc.ClientConn) *mpc.SigningClient {
+func NewSigningClient(conn *mpc.Connection) *mpc.SigningClient {
 	return mpc.NewSigningClient(conn)
 }
 
+func NewNodeClient(conn *mpc.Connection) *mpc.NodeClient {
+	return mpc.NewNodeClient(conn)
+}
+
+func NewBackupClient(cfg config.Server, conn *mpc.Connection) (*mpc.BackupClient, error) {
+	return mpc.NewBackupClient(conn, mpc.BackupConfig{
+		VerifyKeyFile: cfg.Backup.DeliveryVerifyKeyFile,
+		MaxClockSkew:  cfg.Backup.MaxClockSkew,
+	})
+}
+
 func NewMpcAuthService(cfg config.Server, db *sql.DB) (mpcAuth.AuthService, error) {
 	// TODO: Move these to config
 	wconfig := &webauthn.Config{
 		RPDisplayName: "MPC Vault",
 		RPID:          "localhost",
 		RPOrigins:     []string{"http://localhost:3000"},
+		// Challenges issued for passkey assertions expire, the session data stored along with them carries the expiry.
+		Timeouts: webauthn.TimeoutsConfig{
+			Login: webauthn.TimeoutConfig{
+				Enforce: true,
+				Timeout: 5 * time.Minute,
+			},
+		},
 	}
 	w, err := webauthn.New(wconfig)
 	if err != nil {
@@ -65,31 +116,64 @@ func NewMpcAuthService(cfg config.Server, db *sql.DB) (mpcAuth.AuthService, erro
 	return mpcAuth.NewService(db, w), nil
 }
 
+// NewAssertionVerifier shares the WebAuthn instance of the auth service with the services verifying passkey assertions.
+//
+//nolint:ireturn
+func NewAssertionVerifier(authService mpcAuth.AuthService) mpcAuth.AssertionVerifier {
+	return authService
+}
+
+//nolint:ireturn
+func NewVaultService(db *sql.DB, keyClient *mpc.KeyClient, passkeys mpcAuth.AssertionVerifier) vault.Service {
+	return vault.NewService(db, keyClient, passkeys)
+}
+
+//nolint:ireturn
+func NewSigningService(db *sql.DB, clock time2.Clock, signingClient *mpc.SigningClient, notificationService notification.Service, nodeService node.Service) signing.Service {
+	return signing.NewService(db, clock, signingClient, notificationService, nodeService)
+}
+
+//nolint:ireturn
+func NewBackupService(cfg config.Server, db *sql.DB, clock time2.Clock, backupClient *mpc.BackupClient, notificationService notification.Service, passkeys mpcAuth.AssertionVerifier) backup.Service {
+	return backup.NewService(cfg, db, clock, backupClient, notificationService, passkeys)
+}
+
+//nolint:ireturn
+func NewNodeService(cfg config.Server, db *sql.DB, clock time2.Clock, nodeClient *mpc.NodeClient, keyClient *mpc.KeyClient, backupClient *mpc.BackupClient) node.Service {
+	return node.NewService(cfg, db, clock, nodeClient, keyClient, backupClient)
+}
+
 //nolint:ireturn
-func NewVaultService(db *sql.DB, keyClient *mpc.KeyClient) vault.Service {
-	return vault.NewService(db, keyClient)
+func NewDeviceService(cfg config.Server, db *sql.DB, clock time2.Clock, nodeClient *mpc.NodeClient, passkeys mpcAuth.AssertionVerifier) device.Service {
+	return device.NewService(cfg, db, clock, nodeClient, passkeys)
 }
 
 //nolint:ireturn
-func NewSigningService(db *sql.DB, signingClient *mpc.SigningClient) signing.Service {
-	return signing.NewService(db, signingClient)
+func NewKeyService(cfg config.Server, db *sql.DB, clock time2.Clock, keyClient *mpc.KeyClient, evmClient evm.Client) key.Service {
+	return key.NewService(cfg, db, clock, keyClient, evmClient)
 }
 
 func NewGrpcServer(
 	cfg config.Server,
+	db *sql.DB,
+	clock time2.Clock,
 	authSvc mpcAuth.AuthService,
 	vaultSvc vault.Service,
 	signingSvc signing.Service,
+	orgSvc organization.Service,
 ) *grpc.Server {
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		server.RequestOriginUnaryInterceptor(),
+		server.AuthUnaryInterceptor(db, clock),
+	))
 
 	authServer := server.NewAuthServer(authSvc)
 	server.RegisterAuthServer(s, authServer)
 
-	vaultServer := server.NewVaultServer(vaultSvc)
+	vaultServer := server.NewVaultServer(vaultSvc, orgSvc)
 	server.RegisterVaultServer(s, vaultServer)
 
-	signingServer := server.NewSigningServer(signingSvc)
+	signingServer := server.NewSigningServer(signingSvc, vaultSvc)
 	server.RegisterSigningServer(s, signingServer)
 
 	return s
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"database/sql"
+
+	"github.com/kashguard/go-mpc-vault/internal/i18n"
+	"github.com/kashguard/go-mpc-vault/internal/push"
+	"github.com/kashguard/go-mpc-vault/internal/service/notification"
+)
+
+func NewNotificationService(db *sql.DB, pusher *push.Service, i18nService *i18n.Service) notification.Service {
+	return notification.NewService(db, pusher, i18nService)
+}
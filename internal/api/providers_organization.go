@@ -3,9 +3,13 @@ package api
 import (
 	"database/sql"
 
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/notification"
 	"github.com/kashguard/go-mpc-vault/internal/service/organization"
 )
 
-func NewOrganizationService(db *sql.DB) organization.Service {
-	return organization.NewService(db)
+func NewOrganizationService(cfg config.Server, db *sql.DB, clock time2.Clock, passkeys auth.AssertionVerifier, notificationService notification.Service) organization.Service {
+	return organization.NewService(cfg, db, clock, passkeys, notificationService)
 }
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"database/sql"
+
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/webhookclient"
+	"github.com/kashguard/go-mpc-vault/internal/service/deposit"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/webhook"
+)
+
+func NewWebhookService(cfg config.Server, db *sql.DB, clock time2.Clock, depositService deposit.Service) webhook.Service {
+	return webhook.NewService(cfg, db, clock, depositService)
+}
+
+func NewWebhookClient(cfg config.Server) webhookclient.Client {
+	return webhookclient.NewClient(webhookclient.Config{
+		Timeout:       cfg.Outbox.Timeout,
+		AllowInsecure: cfg.Outbox.AllowInsecure,
+	})
+}
+
+func NewOutboxService(cfg config.Server, db *sql.DB, clock time2.Clock, client webhookclient.Client) outbox.Service {
+	return outbox.NewService(cfg, db, clock, client)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"html/template"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 	"github.com/kashguard/go-mpc-vault/internal/api/handlers"
 	"github.com/kashguard/go-mpc-vault/internal/api/middleware"
 	"github.com/kashguard/go-mpc-vault/internal/api/router/templates"
+	"github.com/kashguard/go-mpc-vault/internal/auth"
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
@@ -53,6 +55,12 @@ func Init(s *api.Server) error {
 
 	s.Echo.Debug = s.Config.Echo.Debug
 	s.Echo.HideBanner = true
+
+	// Client IPs are recorded in the audit log, forwarded headers are only trusted if set by a configured proxy.
+	s.Echo.IPExtractor, err = ipExtractor(s.Config.Echo.TrustedProxies)
+	if err != nil {
+		return err
+	}
 	s.Echo.Logger.SetOutput(&echoLogger{level: s.Config.Logger.RequestLevel, log: log.With().Str("component", "echo").Logger()})
 	echo.NotFoundHandler = NotFoundHandler(s.Config)
 
@@ -110,6 +118,9 @@ func Init(s *api.Server) error {
 		log.Warn().Msg("Disabling request ID middleware due to environment config")
 	}
 
+	// The origin of requests is part of every audit log entry, it can't be disabled.
+	s.Echo.Use(middleware.RequestOrigin())
+
 	if s.Config.Echo.EnableLoggerMiddleware {
 		s.Echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
 			Level:             s.Config.Logger.RequestLevel,
@@ -191,6 +202,10 @@ func Init(s *api.Server) error {
 	// Add your custom / additional middlewares here.
 	// see https://echo.labstack.com/middleware
 
+	adminAuthConfig := middleware.DefaultAuthConfig
+	adminAuthConfig.S = s
+	adminAuthConfig.Scopes = []string{auth.ScopeAdmin.String()}
+
 	// ---
 	// Initialize our general groups and set middleware to use above them
 	s.Router = &api.Router{
@@ -224,6 +239,29 @@ func Init(s *api.Server) error {
 		APIV1Sign:  s.Echo.Group("/api/v1", middleware.Auth(s)), // Base for signing (has /vaults/... and /requests/...)
 		WellKnown:  s.Echo.Group("/.well-known"),
 		APIV1Org:   s.Echo.Group("/api/v1/organizations", middleware.Auth(s)),
+
+		// Organization invitations, secured by the invitation token itself, available at /api/v1/invitations/**
+		APIV1Invite: s.Echo.Group("/api/v1/invitations"),
+
+		// Chains and assets catalog, available at /api/v1/chains/** and /api/v1/assets/**
+		APIV1Chain: s.Echo.Group("/api/v1/chains", middleware.Auth(s)),
+		APIV1Asset: s.Echo.Group("/api/v1/assets", middleware.Auth(s)),
+
+		// Catalog management, secured by bearer auth of users with the admin scope, available at /api/v1/assets/**
+		APIV1AssetAdmin: s.Echo.Group("/api/v1/assets", middleware.AuthWithConfig(adminAuthConfig)),
+
+		// Webhooks of blockchain data providers, secured by the signature of the provider, available at /api/v1/webhooks/**
+		APIV1Webhook: s.Echo.Group("/api/v1/webhooks"),
+
+		// Backups of the key shares of users, available at /api/v1/backups/**
+		APIV1Backup: s.Echo.Group("/api/v1/backups", middleware.Auth(s)),
+
+		// MPC node registry, secured by bearer auth of users with the admin scope, available at /api/v1/nodes/**
+		APIV1NodeAdmin: s.Echo.Group("/api/v1/nodes", middleware.AuthWithConfig(adminAuthConfig)),
+
+		// Signer devices and passkeys of the current user, available at /api/v1/devices/** and /api/v1/credentials/**
+		APIV1Device:     s.Echo.Group("/api/v1/devices", middleware.Auth(s)),
+		APIV1Credential: s.Echo.Group("/api/v1/credentials", middleware.Auth(s)),
 	}
 
 	// ---
@@ -237,3 +275,22 @@ func Init(s *api.Server) error {
 
 	return nil
 }
+
+// ipExtractor returns the extractor of the client IP, taken from the X-Forwarded-For header if the request passed the
+// trusted proxies and from the peer otherwise.
+func ipExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q: %w", proxy, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
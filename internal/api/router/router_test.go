@@ -3,6 +3,7 @@ package router_test
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/kashguard/go-mpc-vault/internal/api"
@@ -126,3 +127,28 @@ func TestNotFound(t *testing.T) {
 		})
 	})
 }
+
+func TestClientIP(t *testing.T) {
+	request := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "10.0.0.2:4711"
+		req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.7, 10.0.0.1")
+		return req
+	}
+
+	// Forwarded headers are ignored unless proxies are configured.
+	test.WithTestServer(t, func(s *api.Server) {
+		assert.Equal(t, "10.0.0.2", s.Echo.IPExtractor(request()))
+	})
+
+	config := config.DefaultServiceConfigFromEnv()
+	config.Echo.TrustedProxies = []string{"10.0.0.0/24"}
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		assert.Equal(t, "203.0.113.7", s.Echo.IPExtractor(request()))
+
+		// Requests not passing the trusted proxies report their peer.
+		req := request()
+		req.RemoteAddr = "198.51.100.9:4711"
+		assert.Equal(t, "198.51.100.9", s.Echo.IPExtractor(req))
+	})
+}
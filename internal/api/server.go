@@ -13,6 +13,7 @@ import (
 	"github.com/kashguard/go-mpc-vault/internal/data/dto"
 	"github.com/kashguard/go-mpc-vault/internal/data/local"
 	"github.com/kashguard/go-mpc-vault/internal/i18n"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
 	"github.com/kashguard/go-mpc-vault/internal/mailer"
 	"github.com/kashguard/go-mpc-vault/internal/metrics"
 	"github.com/kashguard/go-mpc-vault/internal/push"
@@ -21,25 +22,47 @@ import (
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 
+	"github.com/kashguard/go-mpc-vault/internal/service/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
 	mpcAuth "github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/service/deposit"
+	"github.com/kashguard/go-mpc-vault/internal/service/device"
+	"github.com/kashguard/go-mpc-vault/internal/service/key"
+	"github.com/kashguard/go-mpc-vault/internal/service/node"
+	"github.com/kashguard/go-mpc-vault/internal/service/notification"
 	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
 	"github.com/kashguard/go-mpc-vault/internal/service/signing"
 	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/service/webhook"
 
 	// Import postgres driver for database/sql package
 	_ "github.com/lib/pq"
 )
 
 type Router struct {
-	Routes     []*echo.Route
-	Root       *echo.Group
-	Management *echo.Group
-	APIV1Auth  *echo.Group
-	APIV1Push  *echo.Group
-	APIV1Vault *echo.Group
-	APIV1Sign  *echo.Group
-	WellKnown  *echo.Group
-	APIV1Org   *echo.Group
+	Routes      []*echo.Route
+	Root        *echo.Group
+	Management  *echo.Group
+	APIV1Auth   *echo.Group
+	APIV1Push   *echo.Group
+	APIV1Vault  *echo.Group
+	APIV1Sign   *echo.Group
+	WellKnown   *echo.Group
+	APIV1Org    *echo.Group
+	APIV1Invite *echo.Group
+	APIV1Chain  *echo.Group
+	APIV1Asset  *echo.Group
+	// APIV1AssetAdmin shares the path of APIV1Asset, but requires the admin scope.
+	APIV1AssetAdmin *echo.Group
+	APIV1Webhook    *echo.Group
+	APIV1Backup     *echo.Group
+	// APIV1NodeAdmin requires the admin scope.
+	APIV1NodeAdmin  *echo.Group
+	APIV1Device     *echo.Group
+	APIV1Credential *echo.Group
 }
 
 // Server is a central struct keeping all the dependencies.
@@ -69,10 +92,23 @@ type Server struct {
 	Metrics *metrics.Service
 
 	// MPC Services
+	// MpcConn is the connection to the MPC server shared by the MPC clients, probed for readiness.
+	MpcConn      *mpc.Connection
 	WebAuthn     mpcAuth.AuthService
 	Vault        vault.Service
 	Signing      signing.Service
 	Organization organization.Service
+	AddressBook  addressbook.Service
+	Audit        audit.Service
+	Catalog      catalog.Service
+	Deposit      deposit.Service
+	Webhook      webhook.Service
+	Outbox       outbox.Service
+	Notification notification.Service
+	Backup       backup.Service
+	Node         node.Service
+	Device       device.Service
+	Key          key.Service
 }
 
 // newServerWithComponents is used by wire to initialize the server components.
@@ -88,10 +124,22 @@ func newServerWithComponents(
 	auth AuthService,
 	local *local.Service,
 	metrics *metrics.Service,
+	mpcConn *mpc.Connection,
 	webAuthn mpcAuth.AuthService,
 	vault vault.Service,
 	signing signing.Service,
 	org organization.Service,
+	addressBook addressbook.Service,
+	audit audit.Service,
+	catalog catalog.Service,
+	deposit deposit.Service,
+	webhook webhook.Service,
+	outbox outbox.Service,
+	notification notification.Service,
+	backup backup.Service,
+	node node.Service,
+	device device.Service,
+	key key.Service,
 	grpcServer *grpc.Server,
 ) *Server {
 	return &Server{
@@ -104,10 +152,22 @@ func newServerWithComponents(
 		Auth:         auth,
 		Local:        local,
 		Metrics:      metrics,
+		MpcConn:      mpcConn,
 		WebAuthn:     webAuthn,
 		Vault:        vault,
 		Signing:      signing,
 		Organization: org,
+		AddressBook:  addressBook,
+		Audit:        audit,
+		Catalog:      catalog,
+		Deposit:      deposit,
+		Webhook:      webhook,
+		Outbox:       outbox,
+		Notification: notification,
+		Backup:       backup,
+		Node:         node,
+		Device:       device,
+		Key:          key,
 		GRPC:         grpcServer,
 	}
 }
@@ -178,6 +238,15 @@ func (s *Server) Shutdown(ctx context.Context) []error {
 		s.GRPC.GracefulStop()
 	}
 
+	if s.MpcConn != nil {
+		log.Debug().Msg("Closing MPC server connection")
+
+		if err := s.MpcConn.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close MPC server connection")
+			errs = append(errs, err)
+		}
+	}
+
 	if s.DB != nil {
 		log.Debug().Msg("Closing database connection")
 
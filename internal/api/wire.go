@@ -29,6 +29,16 @@ var serviceSet = wire.NewSet(
 	NewClock,
 	MpcProviderSet,
 	NewOrganizationService,
+	NewAddressBookService,
+	NewAuditService,
+	NewTokenMetadataResolver,
+	NewCatalogService,
+	NewEVMClient,
+	NewDepositService,
+	NewWebhookService,
+	NewWebhookClient,
+	NewOutboxService,
+	NewNotificationService,
 )
 
 var authServiceSet = wire.NewSet(
@@ -48,21 +48,44 @@ func InitNewServer(server config.Server) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	connection, err := NewMpcClientConnection(server)
+	if err != nil {
+		return nil, err
+	}
 	authAuthService, err := NewMpcAuthService(server, db)
 	if err != nil {
 		return nil, err
 	}
-	clientConn, err := NewMpcClientConnection(server)
+	keyClient := NewKeyClient(connection)
+	assertionVerifier := NewAssertionVerifier(authAuthService)
+	vaultService := NewVaultService(db, keyClient, assertionVerifier)
+	signingClient := NewSigningClient(connection)
+	notificationService := NewNotificationService(db, service, i18nService)
+	nodeClient := NewNodeClient(connection)
+	backupClient, err := NewBackupClient(server, connection)
+	if err != nil {
+		return nil, err
+	}
+	nodeService := NewNodeService(server, db, clock, nodeClient, keyClient, backupClient)
+	signingService := NewSigningService(db, clock, signingClient, notificationService, nodeService)
+	organizationService := NewOrganizationService(server, db, clock, assertionVerifier, notificationService)
+	addressbookService := NewAddressBookService(server, db, clock)
+	auditService, err := NewAuditService(server, db, clock)
 	if err != nil {
 		return nil, err
 	}
-	keyClient := NewKeyClient(clientConn)
-	vaultService := NewVaultService(db, keyClient)
-	signingClient := NewSigningClient(clientConn)
-	signingService := NewSigningService(db, signingClient)
-	organizationService := NewOrganizationService(db)
-	grpcServer := NewGrpcServer(server, authAuthService, vaultService, signingService)
-	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, authAuthService, vaultService, signingService, organizationService, grpcServer)
+	resolver := NewTokenMetadataResolver(server)
+	catalogService := NewCatalogService(db, resolver, assertionVerifier)
+	client := NewEVMClient(server)
+	depositService := NewDepositService(server, db, clock, client, service, mailer)
+	webhookService := NewWebhookService(server, db, clock, depositService)
+	webhookclientClient := NewWebhookClient(server)
+	outboxService := NewOutboxService(server, db, clock, webhookclientClient)
+	backupService := NewBackupService(server, db, clock, backupClient, notificationService, assertionVerifier)
+	deviceService := NewDeviceService(server, db, clock, nodeClient, assertionVerifier)
+	keyService := NewKeyService(server, db, clock, keyClient, client)
+	grpcServer := NewGrpcServer(server, db, clock, authAuthService, vaultService, signingService, organizationService)
+	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, connection, authAuthService, vaultService, signingService, organizationService, addressbookService, auditService, catalogService, depositService, webhookService, outboxService, notificationService, backupService, nodeService, deviceService, keyService, grpcServer)
 	return apiServer, nil
 }
 
@@ -88,21 +111,44 @@ func InitNewServerWithDB(server config.Server, db *sql.DB, t ...*testing.T) (*Se
 	if err != nil {
 		return nil, err
 	}
+	connection, err := NewMpcClientConnection(server)
+	if err != nil {
+		return nil, err
+	}
 	authAuthService, err := NewMpcAuthService(server, db)
 	if err != nil {
 		return nil, err
 	}
-	clientConn, err := NewMpcClientConnection(server)
+	keyClient := NewKeyClient(connection)
+	assertionVerifier := NewAssertionVerifier(authAuthService)
+	vaultService := NewVaultService(db, keyClient, assertionVerifier)
+	signingClient := NewSigningClient(connection)
+	notificationService := NewNotificationService(db, service, i18nService)
+	nodeClient := NewNodeClient(connection)
+	backupClient, err := NewBackupClient(server, connection)
+	if err != nil {
+		return nil, err
+	}
+	nodeService := NewNodeService(server, db, clock, nodeClient, keyClient, backupClient)
+	signingService := NewSigningService(db, clock, signingClient, notificationService, nodeService)
+	organizationService := NewOrganizationService(server, db, clock, assertionVerifier, notificationService)
+	addressbookService := NewAddressBookService(server, db, clock)
+	auditService, err := NewAuditService(server, db, clock)
 	if err != nil {
 		return nil, err
 	}
-	keyClient := NewKeyClient(clientConn)
-	vaultService := NewVaultService(db, keyClient)
-	signingClient := NewSigningClient(clientConn)
-	signingService := NewSigningService(db, signingClient)
-	organizationService := NewOrganizationService(db)
-	grpcServer := NewGrpcServer(server, authAuthService, vaultService, signingService)
-	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, authAuthService, vaultService, signingService, organizationService, grpcServer)
+	resolver := NewTokenMetadataResolver(server)
+	catalogService := NewCatalogService(db, resolver, assertionVerifier)
+	client := NewEVMClient(server)
+	depositService := NewDepositService(server, db, clock, client, service, mailer)
+	webhookService := NewWebhookService(server, db, clock, depositService)
+	webhookclientClient := NewWebhookClient(server)
+	outboxService := NewOutboxService(server, db, clock, webhookclientClient)
+	backupService := NewBackupService(server, db, clock, backupClient, notificationService, assertionVerifier)
+	deviceService := NewDeviceService(server, db, clock, nodeClient, assertionVerifier)
+	keyService := NewKeyService(server, db, clock, keyClient, client)
+	grpcServer := NewGrpcServer(server, db, clock, authAuthService, vaultService, signingService, organizationService)
+	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, connection, authAuthService, vaultService, signingService, organizationService, addressbookService, auditService, catalogService, depositService, webhookService, outboxService, notificationService, backupService, nodeService, deviceService, keyService, grpcServer)
 	return apiServer, nil
 }
 
@@ -117,6 +163,16 @@ var serviceSet = wire.NewSet(
 	authServiceSet, local.NewService, metrics.New, NewClock,
 	MpcProviderSet,
 	NewOrganizationService,
+	NewAddressBookService,
+	NewAuditService,
+	NewTokenMetadataResolver,
+	NewCatalogService,
+	NewEVMClient,
+	NewDepositService,
+	NewWebhookService,
+	NewWebhookClient,
+	NewOutboxService,
+	NewNotificationService,
 )
 
 var authServiceSet = wire.NewSet(
@@ -4,6 +4,8 @@ type Scope string
 
 const (
 	ScopeApp Scope = "app"
+	// ScopeAdmin grants access to the platform administration, e.g. managing the chains and assets catalog.
+	ScopeAdmin Scope = "admin"
 )
 
 func (s Scope) String() string {
@@ -10,6 +10,7 @@ import (
 	"github.com/kashguard/go-mpc-vault/internal/data/dto"
 	"github.com/kashguard/go-mpc-vault/internal/data/mapper"
 	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"github.com/kashguard/go-mpc-vault/internal/util/db"
 	"github.com/kashguard/go-mpc-vault/internal/util/hashing"
@@ -105,6 +106,13 @@ func (s *Service) UpdatePassword(ctx context.Context, request dto.UpdatePassword
 			return err
 		}
 
+		if err := s.recordAudit(ctx, exec, request.User.ID, audit.ActionChangePassword, map[string]interface{}{
+			"reset": request.SkipCurrentPasswordVerification,
+		}); err != nil {
+			log.Err(err).Msg("Failed to record password change")
+			return err
+		}
+
 		return nil
 	}); err != nil {
 		log.Debug().Err(err).Msg("Failed to change password")
@@ -212,6 +220,11 @@ func (s *Service) InitPasswordReset(ctx context.Context, request dto.InitPasswor
 
 		result.ResetToken = null.StringFrom(passwordResetToken.Token)
 
+		if err := s.recordAudit(ctx, exec, user.ID, audit.ActionRequestPasswordReset, nil); err != nil {
+			log.Err(err).Msg("Failed to record password reset request")
+			return err
+		}
+
 		return nil
 	}); err != nil {
 		log.Debug().Err(err).Msg("Failed to initiate password reset")
@@ -225,17 +238,30 @@ func (s *Service) Logout(ctx context.Context, request dto.LogoutRequest) error {
 	log := util.LogFromContext(ctx)
 
 	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		accessToken, err := models.FindAccessToken(ctx, exec, request.AccessToken)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Err(err).Msg("Failed to load access token")
+			return err
+		}
+
 		if _, err := models.AccessTokens(models.AccessTokenWhere.Token.EQ(request.AccessToken)).DeleteAll(ctx, exec); err != nil {
 			log.Err(err).Msg("Failed to delete access token")
 			return err
 		}
 
-		if request.RefreshToken.IsZero() {
+		if !request.RefreshToken.IsZero() {
+			if _, err := models.RefreshTokens(models.RefreshTokenWhere.Token.EQ(request.RefreshToken.String)).DeleteAll(ctx, exec); err != nil {
+				log.Err(err).Msg("Failed to delete refresh token")
+				return err
+			}
+		}
+
+		if accessToken == nil {
 			return nil
 		}
 
-		if _, err := models.RefreshTokens(models.RefreshTokenWhere.Token.EQ(request.RefreshToken.String)).DeleteAll(ctx, exec); err != nil {
-			log.Err(err).Msg("Failed to delete refresh token")
+		if err := s.recordAudit(ctx, exec, accessToken.UserID, audit.ActionLogout, nil); err != nil {
+			log.Err(err).Msg("Failed to record logout")
 			return err
 		}
 
@@ -296,6 +322,11 @@ func (s *Service) Login(ctx context.Context, request dto.LoginRequest) (dto.Logi
 			return err
 		}
 
+		if err := s.recordAudit(ctx, exec, user.ID, audit.ActionLogin, nil); err != nil {
+			log.Err(err).Msg("Failed to record login")
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -453,6 +484,13 @@ func (s *Service) Register(ctx context.Context, request dto.RegisterRequest) (dt
 			result.ConfirmationToken = null.StringFrom(confirmationToken.Token)
 		}
 
+		if err := s.recordAudit(ctx, exec, user.ID, audit.ActionRegister, map[string]interface{}{
+			"requires_confirmation": result.RequiresConfirmation,
+		}); err != nil {
+			log.Err(err).Msg("Failed to record registration")
+			return err
+		}
+
 		return nil
 	}); err != nil {
 		log.Debug().Err(err).Msg("Failed to register user")
@@ -496,6 +534,11 @@ func (s *Service) DeleteUserAccount(ctx context.Context, request dto.DeleteUserA
 			return err
 		}
 
+		if err := s.recordAudit(ctx, exec, request.User.ID, audit.ActionDeleteAccount, nil); err != nil {
+			log.Err(err).Msg("Failed to record account deletion")
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -552,6 +595,11 @@ func (s *Service) CompleteRegister(ctx context.Context, request dto.CompleteRegi
 			return err
 		}
 
+		if err := s.recordAudit(ctx, exec, user.ID, audit.ActionConfirmRegistration, nil); err != nil {
+			log.Err(err).Msg("Failed to record registration confirmation")
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -618,3 +666,14 @@ func (s *Service) authenticateUser(ctx context.Context, exec boil.ContextExecuto
 
 	return result, nil
 }
+
+// recordAudit records an action of the user on their own account within the given transaction.
+func (s *Service) recordAudit(ctx context.Context, exec boil.ContextExecutor, userID string, action string, details map[string]interface{}) error {
+	return audit.Record(ctx, exec, audit.Entry{
+		UserID:       userID,
+		Action:       action,
+		ResourceType: audit.ResourceTypeUser,
+		ResourceID:   userID,
+		Details:      details,
+	})
+}
@@ -28,6 +28,9 @@ type EchoServer struct {
 	EnableCacheControlMiddleware   bool
 	SecureMiddleware               EchoServerSecureMiddleware
 	WebTemplatesViewsBaseDirAbs    string
+	// TrustedProxies lists the CIDR ranges of the proxies whose X-Forwarded-For header is trusted to carry the client
+	// IP. Without any, the IP of the peer is used.
+	TrustedProxies []string
 }
 
 type PprofServer struct {
@@ -61,6 +64,7 @@ type AuthServer struct {
 	RegistrationRequiresConfirmation   bool
 	ConfirmationTokenValidity          time.Duration
 	ConfirmationTokenDebounceDuration  time.Duration
+	InvitationTokenValidity            time.Duration
 }
 
 type PathsServer struct {
@@ -82,6 +86,7 @@ type ManagementServer struct {
 type FrontendServer struct {
 	BaseURL               string
 	PasswordResetEndpoint string
+	InvitationEndpoint    string
 }
 
 type LoggerServer struct {
@@ -106,29 +111,143 @@ type GrpcServer struct {
 }
 
 type MpcServer struct {
+	// Fake serves the in-memory fake MPC server in process instead of connecting to Address, for tests and local
+	// development only. It is refused unless AllowFake is set by the environment of a development setup.
+	Fake       bool
+	AllowFake  bool
 	Address    string
 	ServerName string
 	CACertFile string
 	CertFile   string
 	KeyFile    string
+	// NodeHeartbeatTimeout is the maximum age of the last heartbeat of a node considered online, heartbeats are not
+	// considered if zero.
+	NodeHeartbeatTimeout time.Duration
+	// NodeCheckTimeout bounds the queries checking enough nodes are online before signing.
+	NodeCheckTimeout time.Duration
+	// NodeRevocationInterval is the time between two retries of reporting the client nodes of revoked devices as
+	// revoked, retries are disabled if zero.
+	NodeRevocationInterval time.Duration
+	// CallTimeout bounds calls to the MPC server without deadline, ProtocolTimeout those running an MPC protocol among
+	// the nodes, i.e. key generation, derivation, signing, refreshes and recoveries.
+	CallTimeout     time.Duration
+	ProtocolTimeout time.Duration
+	// MaxRetryAttempts of idempotent calls failing as the MPC server is unavailable, including the first attempt.
+	MaxRetryAttempts int
+	// KeepaliveTime is the time without activity after which the MPC server is pinged, pinging more often than every
+	// five minutes has to be permitted by the MPC server. The connection is closed if the ping is not acknowledged
+	// within KeepaliveTimeout.
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+	// BreakerFailureThreshold is the number of consecutive calls failing as the MPC server is unavailable or too slow
+	// after which calls are refused for BreakerOpenTimeout, the breaker is disabled if zero.
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
+}
+
+type AddressBookServer struct {
+	// CoolingOffPeriod is the time a new address book entry must wait before it can be used as destination.
+	CoolingOffPeriod time.Duration
+}
+
+type AuditServer struct {
+	// CheckpointInterval is the time between two signed checkpoints of the audit log chains.
+	CheckpointInterval time.Duration
+	// CheckpointSigningKey is the base64 encoded Ed25519 seed used to sign checkpoints, checkpoints are disabled if empty.
+	CheckpointSigningKey string
+}
+
+type CatalogServer struct {
+	// MetadataResolveTimeout bounds the JSON-RPC calls reading token metadata from the chains.
+	MetadataResolveTimeout time.Duration
+}
+
+type WebhookServer struct {
+	// AlchemySigningKeys verify the signatures of Alchemy webhooks, one per webhook. Alchemy webhooks are rejected if empty.
+	AlchemySigningKeys []string `json:"-"` // sensitive
+	// MaxPayloadBytes limits the size of webhook requests.
+	MaxPayloadBytes int64
+	// ProcessingLease is the time an event stays claimed for processing, events processing for longer are assumed to be
+	// abandoned by a crashed instance and may be claimed again.
+	ProcessingLease time.Duration
+}
+
+// OutboxServer configures the delivery of the events of the outbox to the webhook endpoints of organizations.
+type OutboxServer struct {
+	// PollInterval is the time between two dispatches of the outbox, delivery is disabled if zero.
+	PollInterval time.Duration
+	// BatchSize limits the events dispatched and the deliveries attempted per poll.
+	BatchSize int
+	// Timeout bounds a delivery, slower endpoints are retried.
+	Timeout time.Duration
+	// MaxAttempts after which deliveries are dead, to be redelivered manually.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubling with every further attempt up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// AllowInsecure permits http URLs and endpoints within private networks, e.g. for development.
+	AllowInsecure bool
+}
+
+type DepositServer struct {
+	// ScanInterval is the time between two scans of the chains for deposits into wallets, scanning is disabled if zero.
+	ScanInterval time.Duration
+	// MaxBlocksPerScan limits the blocks processed per chain and scan, so scans catching up stay short.
+	MaxBlocksPerScan int
+	// RPCTimeout bounds the JSON-RPC calls to the chain nodes.
+	RPCTimeout time.Duration
+}
+
+type BackupServer struct {
+	// DeliveryVerifyKeyFile is the PEM encoded public key verifying the share deliveries signed by the MPC server,
+	// deliveries are refused if empty.
+	DeliveryVerifyKeyFile string
+	// MaxClockSkew is the maximum difference between the timestamp of a share delivery and the time it is received.
+	MaxClockSkew time.Duration
+	// RecoveryTimeout bounds the recovery of a share once approved, the recovery fails if the MPC server is slower.
+	RecoveryTimeout time.Duration
+	// HealthCheckInterval is the time between two checks of the backup health of all keys, checks are disabled if
+	// zero.
+	HealthCheckInterval time.Duration
+	// HealthCheckTimeout bounds the queries to the MPC server checking the backup health of a key.
+	HealthCheckTimeout time.Duration
+}
+
+// KeyRefreshServer configures the proactive refresh of the shares of the keys of the vaults.
+type KeyRefreshServer struct {
+	// Interval is the time between two runs of approved refreshes and retirements, both are disabled if zero.
+	Interval time.Duration
+	// MaxAge is the age of the shares of a key after which a refresh is proposed to the quorum of its vault, refreshes
+	// are only proposed manually if zero.
+	MaxAge time.Duration
+	// Timeout bounds a refresh by the MPC server, running refreshes not finished within twice the timeout are failed.
+	Timeout time.Duration
 }
 
 type Server struct {
-	Database   Database
-	Echo       EchoServer
-	Grpc       GrpcServer
-	Mpc        MpcServer
-	Pprof      PprofServer
-	Paths      PathsServer
-	Auth       AuthServer
-	Management ManagementServer
-	Mailer     Mailer
-	SMTP       transport.SMTPMailTransportConfig
-	Frontend   FrontendServer
-	Logger     LoggerServer
-	Push       PushService
-	FCMConfig  provider.FCMConfig
-	I18n       I18n
+	Database    Database
+	Echo        EchoServer
+	Grpc        GrpcServer
+	Mpc         MpcServer
+	Backup      BackupServer
+	KeyRefresh  KeyRefreshServer
+	AddressBook AddressBookServer
+	Audit       AuditServer
+	Catalog     CatalogServer
+	Deposit     DepositServer
+	Webhook     WebhookServer
+	Outbox      OutboxServer
+	Pprof       PprofServer
+	Paths       PathsServer
+	Auth        AuthServer
+	Management  ManagementServer
+	Mailer      Mailer
+	SMTP        transport.SMTPMailTransportConfig
+	Frontend    FrontendServer
+	Logger      LoggerServer
+	Push        PushService
+	FCMConfig   provider.FCMConfig
+	I18n        I18n
 }
 
 // DefaultServiceConfigFromEnv returns the server config as parsed from environment variables
@@ -190,16 +309,69 @@ func DefaultServiceConfigFromEnv() Server {
 				ReferrerPolicy:        util.GetEnv("SERVER_ECHO_SECURE_MIDDLEWARE_REFERRER_POLICY", ""),
 			},
 			WebTemplatesViewsBaseDirAbs: util.GetEnv("SERVER_ECHO_WEB_TEMPLATES_VIEWS_BASE_DIR_ABS", filepath.Join(util.GetProjectRootDir(), "/web/templates/views")),
+			TrustedProxies:              util.GetEnvAsStringArrTrimmed("SERVER_ECHO_TRUSTED_PROXIES", []string{}),
 		},
 		Grpc: GrpcServer{
 			ListenAddress: util.GetEnv("SERVER_GRPC_LISTEN_ADDRESS", ":9090"),
 		},
 		Mpc: MpcServer{
-			Address:    util.GetEnv("SERVER_MPC_ADDRESS", "localhost:9000"),
-			ServerName: util.GetEnv("SERVER_MPC_SERVER_NAME", "mpc-server"),
-			CACertFile: util.GetEnv("SERVER_MPC_CA_CERT_FILE", ""),
-			CertFile:   util.GetEnv("SERVER_MPC_CERT_FILE", ""),
-			KeyFile:    util.GetEnv("SERVER_MPC_KEY_FILE", ""),
+			AllowFake:               util.GetEnvAsBool("SERVER_MPC_ALLOW_FAKE", false),
+			Address:                 util.GetEnv("SERVER_MPC_ADDRESS", "localhost:9000"),
+			ServerName:              util.GetEnv("SERVER_MPC_SERVER_NAME", "mpc-server"),
+			CACertFile:              util.GetEnv("SERVER_MPC_CA_CERT_FILE", ""),
+			CertFile:                util.GetEnv("SERVER_MPC_CERT_FILE", ""),
+			KeyFile:                 util.GetEnv("SERVER_MPC_KEY_FILE", ""),
+			NodeHeartbeatTimeout:    time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_NODE_HEARTBEAT_TIMEOUT_SECONDS", 60)),
+			NodeCheckTimeout:        time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_NODE_CHECK_TIMEOUT_SECONDS", 5)),
+			NodeRevocationInterval:  time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_NODE_REVOCATION_INTERVAL_SECONDS", 60)),
+			CallTimeout:             time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_CALL_TIMEOUT_SECONDS", 10)),
+			ProtocolTimeout:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_PROTOCOL_TIMEOUT_SECONDS", 120)),
+			MaxRetryAttempts:        util.GetEnvAsInt("SERVER_MPC_MAX_RETRY_ATTEMPTS", 3),
+			KeepaliveTime:           time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_KEEPALIVE_TIME_SECONDS", 300)),
+			KeepaliveTimeout:        time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_KEEPALIVE_TIMEOUT_SECONDS", 20)),
+			BreakerFailureThreshold: util.GetEnvAsInt("SERVER_MPC_BREAKER_FAILURE_THRESHOLD", 5),
+			BreakerOpenTimeout:      time.Second * time.Duration(util.GetEnvAsInt("SERVER_MPC_BREAKER_OPEN_TIMEOUT_SECONDS", 30)),
+		},
+		Backup: BackupServer{
+			DeliveryVerifyKeyFile: util.GetEnv("SERVER_BACKUP_DELIVERY_VERIFY_KEY_FILE", ""),
+			MaxClockSkew:          time.Second * time.Duration(util.GetEnvAsInt("SERVER_BACKUP_MAX_CLOCK_SKEW_SECONDS", 300)),
+			RecoveryTimeout:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_BACKUP_RECOVERY_TIMEOUT_SECONDS", 120)),
+			HealthCheckInterval:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_BACKUP_HEALTH_CHECK_INTERVAL_SECONDS", 900)),
+			HealthCheckTimeout:    time.Second * time.Duration(util.GetEnvAsInt("SERVER_BACKUP_HEALTH_CHECK_TIMEOUT_SECONDS", 30)),
+		},
+		KeyRefresh: KeyRefreshServer{
+			Interval: time.Second * time.Duration(util.GetEnvAsInt("SERVER_KEY_REFRESH_INTERVAL_SECONDS", 60)),
+			MaxAge:   time.Hour * 24 * time.Duration(util.GetEnvAsInt("SERVER_KEY_REFRESH_MAX_AGE_DAYS", 90)),
+			Timeout:  time.Second * time.Duration(util.GetEnvAsInt("SERVER_KEY_REFRESH_TIMEOUT_SECONDS", 300)),
+		},
+		AddressBook: AddressBookServer{
+			CoolingOffPeriod: time.Second * time.Duration(util.GetEnvAsInt("SERVER_ADDRESS_BOOK_COOLING_OFF_PERIOD_SECONDS", 86400)),
+		},
+		Audit: AuditServer{
+			CheckpointInterval:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUDIT_CHECKPOINT_INTERVAL_SECONDS", 3600)),
+			CheckpointSigningKey: util.GetEnv("SERVER_AUDIT_CHECKPOINT_SIGNING_KEY", ""),
+		},
+		Catalog: CatalogServer{
+			MetadataResolveTimeout: time.Second * time.Duration(util.GetEnvAsInt("SERVER_CATALOG_METADATA_RESOLVE_TIMEOUT_SECONDS", 10)),
+		},
+		Deposit: DepositServer{
+			ScanInterval:     time.Second * time.Duration(util.GetEnvAsInt("SERVER_DEPOSIT_SCAN_INTERVAL_SECONDS", 15)),
+			MaxBlocksPerScan: util.GetEnvAsInt("SERVER_DEPOSIT_MAX_BLOCKS_PER_SCAN", 100),
+			RPCTimeout:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_DEPOSIT_RPC_TIMEOUT_SECONDS", 10)),
+		},
+		Webhook: WebhookServer{
+			AlchemySigningKeys: util.GetEnvAsStringArrTrimmed("SERVER_WEBHOOK_ALCHEMY_SIGNING_KEYS", []string{}),
+			MaxPayloadBytes:    int64(util.GetEnvAsInt("SERVER_WEBHOOK_MAX_PAYLOAD_BYTES", 1<<20)),
+			ProcessingLease:    time.Second * time.Duration(util.GetEnvAsInt("SERVER_WEBHOOK_PROCESSING_LEASE_SECONDS", 300)),
+		},
+		Outbox: OutboxServer{
+			PollInterval:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_OUTBOX_POLL_INTERVAL_SECONDS", 5)),
+			BatchSize:      util.GetEnvAsInt("SERVER_OUTBOX_BATCH_SIZE", 100),
+			Timeout:        time.Second * time.Duration(util.GetEnvAsInt("SERVER_OUTBOX_TIMEOUT_SECONDS", 10)),
+			MaxAttempts:    util.GetEnvAsInt("SERVER_OUTBOX_MAX_ATTEMPTS", 12),
+			InitialBackoff: time.Second * time.Duration(util.GetEnvAsInt("SERVER_OUTBOX_INITIAL_BACKOFF_SECONDS", 30)),
+			MaxBackoff:     time.Second * time.Duration(util.GetEnvAsInt("SERVER_OUTBOX_MAX_BACKOFF_SECONDS", 6*60*60)),
+			AllowInsecure:  util.GetEnvAsBool("SERVER_OUTBOX_ALLOW_INSECURE", false),
 		},
 		Pprof: PprofServer{
 			// https://golang.org/pkg/net/http/pprof/
@@ -225,6 +397,7 @@ func DefaultServiceConfigFromEnv() Server {
 			RegistrationRequiresConfirmation:   util.GetEnvAsBool("SERVER_AUTH_REGISTRATION_REQUIRES_CONFIRMATION", false),
 			ConfirmationTokenValidity:          time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_CONFIRMATION_TOKEN_VALIDITY_SECONDS", 86400)),
 			ConfirmationTokenDebounceDuration:  time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_CONFIRMATION_TOKEN_DEBOUNCE_DURATION_SECONDS", 60)),
+			InvitationTokenValidity:            time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_INVITATION_TOKEN_VALIDITY_SECONDS", 604800)),
 		},
 		Management: ManagementServer{
 			Secret:           util.GetMgmtSecret("SERVER_MANAGEMENT_SECRET"),
@@ -253,6 +426,7 @@ func DefaultServiceConfigFromEnv() Server {
 		Frontend: FrontendServer{
 			BaseURL:               util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
 			PasswordResetEndpoint: util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			InvitationEndpoint:    util.GetEnv("SERVER_FRONTEND_INVITATION_ENDPOINT", "/accept-invitation"),
 		},
 		Logger: LoggerServer{
 			Level:              util.LogLevelFromString(util.GetEnv("SERVER_LOGGER_LEVEL", zerolog.DebugLevel.String())),
@@ -267,7 +441,17 @@ func DefaultServiceConfigFromEnv() Server {
 		},
 		Push: PushService{
 			UseFCMProvider:  util.GetEnvAsBool("SERVER_PUSH_USE_FCM", false),
+			UseAPNSProvider: util.GetEnvAsBool("SERVER_PUSH_USE_APNS", false),
 			UseMockProvider: util.GetEnvAsBool("SERVER_PUSH_USE_MOCK", true),
+			APNS: provider.APNSConfig{
+				PrivateKeyPath: util.GetEnv("SERVER_APNS_PRIVATE_KEY_PATH", ""),
+				KeyID:          util.GetEnv("SERVER_APNS_KEY_ID", ""),
+				TeamID:         util.GetEnv("SERVER_APNS_TEAM_ID", ""),
+				Topic:          util.GetEnv("SERVER_APNS_TOPIC", ""),
+				Production:     util.GetEnvAsBool("SERVER_APNS_PRODUCTION", false),
+				Endpoint:       util.GetEnv("SERVER_APNS_ENDPOINT", ""),
+				Timeout:        time.Second * time.Duration(util.GetEnvAsInt("SERVER_APNS_TIMEOUT_SEC", 10)),
+			},
 		},
 		FCMConfig: provider.FCMConfig{
 			GoogleApplicationCredentials: util.GetEnv("GOOGLE_APPLICATION_CREDENTIALS", ""),
@@ -1,6 +1,10 @@
 package config
 
+import "github.com/kashguard/go-mpc-vault/internal/push/provider"
+
 type PushService struct {
 	UseFCMProvider  bool
+	UseAPNSProvider bool
 	UseMockProvider bool
+	APNS            provider.APNSConfig
 }
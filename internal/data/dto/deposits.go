@@ -0,0 +1,12 @@
+package dto
+
+type DepositNotificationPayload struct {
+	VaultName string
+	ChainName string
+	Address   string
+	Amount    string
+	Symbol    string
+	TxHash    string
+	// TxLink points to the transaction within the explorer of the chain, empty if the chain has no explorer.
+	TxLink string
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+type InvitationNotificationPayload struct {
+	OrganizationName string
+	Role             string
+	InvitationLink   string
+	ValidUntil       time.Time
+}
@@ -7,4 +7,5 @@ type UpdatePushTokenRequest struct {
 	Token         string
 	Provider      string
 	ExistingToken null.String
+	Language      null.String
 }
@@ -56,9 +56,10 @@ func (u User) ToModels() *models.User {
 }
 
 type AppUserProfile struct {
-	UserID          string
-	LegalAcceptedAt null.Time
-	UpdatedAt       time.Time
+	UserID                string
+	LegalAcceptedAt       null.Time
+	DefaultOrganizationID null.String
+	UpdatedAt             time.Time
 }
 
 func (aup AppUserProfile) Ptr() *AppUserProfile {
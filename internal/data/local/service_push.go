@@ -34,6 +34,7 @@ func (s *Service) UpdatePushToken(ctx context.Context, request dto.UpdatePushTok
 			UserID:   request.User.ID,
 			Token:    request.Token,
 			Provider: request.Provider,
+			Language: request.Language,
 		}
 
 		if err := newToken.Insert(ctx, s.db, boil.Infer()); err != nil {
@@ -7,9 +7,10 @@ import (
 
 func LocalAppUserProfileToDTO(appUserProfile *models.AppUserProfile) dto.AppUserProfile {
 	return dto.AppUserProfile{
-		UserID:          appUserProfile.UserID,
-		LegalAcceptedAt: appUserProfile.LegalAcceptedAt,
-		UpdatedAt:       appUserProfile.UpdatedAt,
+		UserID:                appUserProfile.UserID,
+		LegalAcceptedAt:       appUserProfile.LegalAcceptedAt,
+		DefaultOrganizationID: appUserProfile.DefaultOrganizationID,
+		UpdatedAt:             appUserProfile.UpdatedAt,
 	}
 }
 
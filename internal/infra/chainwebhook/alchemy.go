@@ -0,0 +1,192 @@
+package chainwebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	ProviderAlchemy = "alchemy"
+
+	// AlchemySignatureHeader holds the hex encoded HMAC-SHA256 of the request body keyed with the signing key of the webhook.
+	AlchemySignatureHeader = "X-Alchemy-Signature"
+
+	// AlchemyEventTypeAddressActivity is the type of the events of address activity webhooks.
+	AlchemyEventTypeAddressActivity = "ADDRESS_ACTIVITY"
+)
+
+// alchemyNetworks maps the networks of Alchemy to their EVM chain IDs.
+var alchemyNetworks = map[string]string{
+	"ETH_MAINNET":   "1",
+	"ETH_SEPOLIA":   "11155111",
+	"ETH_HOLESKY":   "17000",
+	"MATIC_MAINNET": "137",
+	"MATIC_AMOY":    "80002",
+	"ARB_MAINNET":   "42161",
+	"ARB_SEPOLIA":   "421614",
+	"OPT_MAINNET":   "10",
+	"OPT_SEPOLIA":   "11155420",
+	"BASE_MAINNET":  "8453",
+	"BASE_SEPOLIA":  "84532",
+	"BNB_MAINNET":   "56",
+	"BNB_TESTNET":   "97",
+	"AVAX_MAINNET":  "43114",
+	"AVAX_FUJI":     "43113",
+}
+
+type alchemyEvent struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Event struct {
+		Network  string            `json:"network"`
+		Activity []alchemyActivity `json:"activity"`
+	} `json:"event"`
+}
+
+type alchemyActivity struct {
+	BlockNum    string `json:"blockNum"`
+	Hash        string `json:"hash"`
+	FromAddress string `json:"fromAddress"`
+	ToAddress   string `json:"toAddress"`
+	Category    string `json:"category"`
+	RawContract struct {
+		RawValue string  `json:"rawValue"`
+		Address  *string `json:"address"`
+	} `json:"rawContract"`
+	Log *struct {
+		BlockHash string `json:"blockHash"`
+		LogIndex  string `json:"logIndex"`
+		Removed   bool   `json:"removed"`
+	} `json:"log"`
+}
+
+type alchemy struct {
+	signingKeys [][]byte
+}
+
+// NewAlchemy returns the provider of Alchemy address activity webhooks, accepting requests signed with any of the
+// signing keys.
+func NewAlchemy(signingKeys []string) Provider {
+	keys := make([][]byte, 0, len(signingKeys))
+	for _, key := range signingKeys {
+		if key != "" {
+			keys = append(keys, []byte(key))
+		}
+	}
+
+	return &alchemy{
+		signingKeys: keys,
+	}
+}
+
+func (p *alchemy) Name() string {
+	return ProviderAlchemy
+}
+
+func (p *alchemy) Verify(header http.Header, body []byte) error {
+	signature, err := hex.DecodeString(header.Get(AlchemySignatureHeader))
+	if err != nil || len(signature) == 0 {
+		return ErrInvalidSignature
+	}
+
+	for _, key := range p.signingKeys {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(body)
+		if hmac.Equal(mac.Sum(nil), signature) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func (p *alchemy) Parse(body []byte) (*Event, error) {
+	var payload alchemyEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if payload.ID == "" || payload.Type == "" {
+		return nil, fmt.Errorf("%w: missing event id or type", ErrInvalidPayload)
+	}
+
+	event := &Event{
+		ID:        payload.ID,
+		Type:      payload.Type,
+		Network:   payload.Event.Network,
+		ChainID:   alchemyNetworks[payload.Event.Network],
+		Transfers: make([]Transfer, 0),
+	}
+	if payload.Type != AlchemyEventTypeAddressActivity {
+		return event, nil
+	}
+
+	for _, activity := range payload.Event.Activity {
+		transfer, ok, err := alchemyTransfer(activity)
+		if err != nil {
+			return nil, fmt.Errorf("%w: activity of %s: %v", ErrInvalidPayload, activity.Hash, err)
+		}
+		if ok {
+			event.Transfers = append(event.Transfers, transfer)
+		}
+	}
+
+	return event, nil
+}
+
+// alchemyTransfer converts the activity into a transfer. Internal transfers (value sent by contracts) and transfers of
+// non-fungible tokens are skipped, as well as token transfers of logs removed by a reorg.
+func alchemyTransfer(activity alchemyActivity) (Transfer, bool, error) {
+	transfer := Transfer{
+		TxHash: strings.ToLower(activity.Hash),
+		From:   strings.ToLower(activity.FromAddress),
+		To:     strings.ToLower(activity.ToAddress),
+	}
+
+	switch activity.Category {
+	case "external":
+		transfer.LogIndex = NativeLogIndex
+	case "token", "erc20":
+		if activity.Log == nil || activity.Log.Removed || activity.RawContract.Address == nil {
+			return Transfer{}, false, nil
+		}
+		logIndex, err := parseHexUint(activity.Log.LogIndex)
+		if err != nil {
+			return Transfer{}, false, fmt.Errorf("log index: %w", err)
+		}
+		transfer.LogIndex = int(logIndex)
+		transfer.BlockHash = strings.ToLower(activity.Log.BlockHash)
+		transfer.Token = strings.ToLower(*activity.RawContract.Address)
+	default:
+		return Transfer{}, false, nil
+	}
+
+	blockNumber, err := parseHexUint(activity.BlockNum)
+	if err != nil {
+		return Transfer{}, false, fmt.Errorf("block number: %w", err)
+	}
+	transfer.BlockNumber = blockNumber
+
+	// Transfers of zero value are reported as "0x".
+	rawValue := strings.TrimPrefix(activity.RawContract.RawValue, "0x")
+	if rawValue == "" {
+		rawValue = "0"
+	}
+	amount, ok := new(big.Int).SetString(rawValue, 16)
+	if !ok {
+		return Transfer{}, false, fmt.Errorf("raw value %q is not a hex number", activity.RawContract.RawValue)
+	}
+	transfer.Amount = amount
+
+	return transfer, true, nil
+}
+
+func parseHexUint(value string) (uint64, error) {
+	return strconv.ParseUint(strings.TrimPrefix(value, "0x"), 16, 64)
+}
@@ -0,0 +1,173 @@
+package chainwebhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/chainwebhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const addressActivity = `{
+	"webhookId": "wh_octjglnywaupz6th",
+	"id": "whevt_ogrc5v64myey69ux",
+	"createdAt": "2024-06-01T12:00:00.000Z",
+	"type": "ADDRESS_ACTIVITY",
+	"event": {
+		"network": "ETH_MAINNET",
+		"activity": [
+			{
+				"blockNum": "0x1312d00",
+				"hash": "0x5A4BF6970980A9381E6CD03E7BE4F4E0C0C0D7A5A9F5D2F3C0A8B1D3E5F7A9C1",
+				"fromAddress": "0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359",
+				"toAddress": "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+				"value": 1.5,
+				"asset": "ETH",
+				"category": "external",
+				"rawContract": {"rawValue": "0x14d1120d7b160000", "address": null, "decimals": 18}
+			},
+			{
+				"blockNum": "0x1312d00",
+				"hash": "0x7c4b0e5f2b8e8d3a1f6c9e2d4b7a0c3e5f8a1b4d6c9e2f5a8b1c4d7e0a3b6c9f",
+				"fromAddress": "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+				"toAddress": "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				"value": 250,
+				"asset": "USDT",
+				"category": "token",
+				"rawContract": {"rawValue": "0x000000000000000000000000000000000000000000000000000000000ee6b280", "address": "0xDAC17F958D2EE523A2206206994597C13D831EC7", "decimals": 6},
+				"log": {
+					"address": "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					"blockHash": "0x9B83C12C69EDB74F6C8DD5D052765C1ADF940E320BD1291696E6FA07829EEE71",
+					"blockNumber": "0x1312d00",
+					"logIndex": "0x1a",
+					"removed": false
+				}
+			},
+			{
+				"blockNum": "0x1312d00",
+				"hash": "0x8d5c1f6a3c9f9e4b2a7d0f3e5c8b1d4f6a9b2c5e7d0f3a6b9c2d5e8f1b4c7d0a",
+				"fromAddress": "0x1f9840a85d5af5bf1d1762f925bdaddc4201f984",
+				"toAddress": "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				"value": 0.1,
+				"asset": "ETH",
+				"category": "internal",
+				"rawContract": {"rawValue": "0x16345785d8a0000", "address": null, "decimals": 18}
+			},
+			{
+				"blockNum": "0x1312d00",
+				"hash": "0x9e6d2a7b4d0a0f5c3b8e1a4f6d9c2e5a7b0c3d6f8e1a4b7c0d3e6f9a2c5d8e1b",
+				"fromAddress": "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+				"toAddress": "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				"category": "erc721",
+				"erc721TokenId": "0x1",
+				"rawContract": {"rawValue": "0x", "address": "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d"},
+				"log": {"blockHash": "0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71", "logIndex": "0x1b", "removed": false}
+			},
+			{
+				"blockNum": "0x1312d00",
+				"hash": "0xa07e3b8c5e1b1a6d4c9f2b5a7e0d3f6b8c1d4e7a9f2b5c8d1e4f7a0b3d6e9f2c",
+				"fromAddress": "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+				"toAddress": "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				"category": "token",
+				"rawContract": {"rawValue": "0x01", "address": "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+				"log": {"blockHash": "0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71", "logIndex": "0x1c", "removed": true}
+			}
+		]
+	}
+}`
+
+func sign(key string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestAlchemyVerify(t *testing.T) {
+	provider := chainwebhook.NewAlchemy([]string{"", "whsec_first", "whsec_second"})
+	assert.Equal(t, chainwebhook.ProviderAlchemy, provider.Name())
+
+	body := []byte(addressActivity)
+
+	header := http.Header{}
+	header.Set(chainwebhook.AlchemySignatureHeader, sign("whsec_second", body))
+	require.NoError(t, provider.Verify(header, body))
+
+	header.Set(chainwebhook.AlchemySignatureHeader, sign("whsec_other", body))
+	assert.ErrorIs(t, provider.Verify(header, body), chainwebhook.ErrInvalidSignature)
+
+	header.Set(chainwebhook.AlchemySignatureHeader, sign("whsec_first", body))
+	assert.ErrorIs(t, provider.Verify(header, append(body, ' ')), chainwebhook.ErrInvalidSignature)
+
+	header.Set(chainwebhook.AlchemySignatureHeader, "not hex")
+	assert.ErrorIs(t, provider.Verify(header, body), chainwebhook.ErrInvalidSignature)
+
+	assert.ErrorIs(t, provider.Verify(http.Header{}, body), chainwebhook.ErrInvalidSignature)
+}
+
+func TestAlchemyVerifyWithoutKeys(t *testing.T) {
+	provider := chainwebhook.NewAlchemy([]string{""})
+
+	body := []byte(addressActivity)
+	header := http.Header{}
+	header.Set(chainwebhook.AlchemySignatureHeader, sign("", body))
+	assert.ErrorIs(t, provider.Verify(header, body), chainwebhook.ErrInvalidSignature)
+}
+
+func TestAlchemyParse(t *testing.T) {
+	provider := chainwebhook.NewAlchemy(nil)
+
+	event, err := provider.Parse([]byte(addressActivity))
+	require.NoError(t, err)
+
+	assert.Equal(t, "whevt_ogrc5v64myey69ux", event.ID)
+	assert.Equal(t, chainwebhook.AlchemyEventTypeAddressActivity, event.Type)
+	assert.Equal(t, "ETH_MAINNET", event.Network)
+	assert.Equal(t, "1", event.ChainID)
+	require.Len(t, event.Transfers, 2)
+
+	native := event.Transfers[0]
+	assert.Equal(t, "0x5a4bf6970980a9381e6cd03e7be4f4e0c0c0d7a5a9f5d2f3c0a8b1d3e5f7a9c1", native.TxHash)
+	assert.Equal(t, chainwebhook.NativeLogIndex, native.LogIndex)
+	assert.Equal(t, uint64(20000000), native.BlockNumber)
+	assert.Empty(t, native.BlockHash)
+	assert.Equal(t, "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359", native.From)
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", native.To)
+	assert.Empty(t, native.Token)
+	assert.Equal(t, 0, native.Amount.Cmp(big.NewInt(1500000000000000000)))
+
+	token := event.Transfers[1]
+	assert.Equal(t, 26, token.LogIndex)
+	assert.Equal(t, "0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71", token.BlockHash)
+	assert.Equal(t, "0xdac17f958d2ee523a2206206994597c13d831ec7", token.Token)
+	assert.Equal(t, 0, token.Amount.Cmp(big.NewInt(250000000)))
+}
+
+func TestAlchemyParseOtherEvents(t *testing.T) {
+	provider := chainwebhook.NewAlchemy(nil)
+
+	event, err := provider.Parse([]byte(`{"id": "whevt_1", "type": "NFT_ACTIVITY", "event": {"network": "UNKNOWN_NETWORK"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "NFT_ACTIVITY", event.Type)
+	assert.Empty(t, event.ChainID)
+	assert.Empty(t, event.Transfers)
+}
+
+func TestAlchemyParseInvalid(t *testing.T) {
+	provider := chainwebhook.NewAlchemy(nil)
+
+	payloads := []string{
+		`not json`,
+		`{"type": "ADDRESS_ACTIVITY"}`,
+		`{"id": "whevt_1", "type": "ADDRESS_ACTIVITY", "event": {"network": "ETH_MAINNET", "activity": [{"blockNum": "zz", "category": "external", "rawContract": {"rawValue": "0x1"}}]}}`,
+		`{"id": "whevt_1", "type": "ADDRESS_ACTIVITY", "event": {"network": "ETH_MAINNET", "activity": [{"blockNum": "0x1", "category": "external", "rawContract": {"rawValue": "0xzz"}}]}}`,
+	}
+	for _, payload := range payloads {
+		_, err := provider.Parse([]byte(payload))
+		assert.ErrorIs(t, err, chainwebhook.ErrInvalidPayload, payload)
+	}
+}
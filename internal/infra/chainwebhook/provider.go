@@ -0,0 +1,55 @@
+// Package chainwebhook verifies and decodes the webhooks of blockchain data providers notifying about address activity.
+package chainwebhook
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+)
+
+var (
+	// ErrInvalidSignature is returned if the request is not signed by the provider.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrInvalidPayload is returned if the request body is not an event of the provider.
+	ErrInvalidPayload = errors.New("invalid webhook payload")
+)
+
+// NativeLogIndex is the log index of native transfers, which do not emit logs.
+const NativeLogIndex = -1
+
+// Event delivered by a provider.
+type Event struct {
+	// ID of the event, unique per provider and kept across redeliveries.
+	ID   string
+	Type string
+	// Network is the name of the network of the event at the provider.
+	Network string
+	// ChainID is the EVM chain ID of the network, empty if the network is unknown.
+	ChainID string
+	// Transfers into or out of the addresses watched, empty for events without transfers.
+	Transfers []Transfer
+}
+
+// Transfer of native currency or fungible tokens reported by an event.
+type Transfer struct {
+	TxHash string
+	// LogIndex of token transfers, NativeLogIndex for native transfers.
+	LogIndex    int
+	BlockNumber uint64
+	// BlockHash is empty if the provider does not report it.
+	BlockHash string
+	From      string
+	To        string
+	// Token is the contract address of the token transferred, empty for native transfers.
+	Token  string
+	Amount *big.Int
+}
+
+type Provider interface {
+	// Name of the provider, as used within the webhook URL.
+	Name() string
+	// Verify checks the signature of the request body.
+	Verify(header http.Header, body []byte) error
+	// Parse decodes the event within the request body.
+	Parse(body []byte) (*Event, error)
+}
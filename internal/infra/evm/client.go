@@ -0,0 +1,330 @@
+// Package evm reads blocks, token transfers, receipts and balances from the JSON-RPC endpoint of EVM chains.
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+)
+
+// balanceOfSelector is the selector of the ERC20 balanceOf(address) call.
+const balanceOfSelector = "0x70a08231"
+
+// TransferTopic is the keccak256 of Transfer(address,address,uint256), the first topic of ERC20 transfer logs.
+const TransferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+var (
+	// ErrInvalidResponse is returned if the node replied with malformed data.
+	ErrInvalidResponse = errors.New("invalid response")
+	// ErrReceiptNotFound is returned if the node does not know the receipt of a transaction (yet).
+	ErrReceiptNotFound = errors.New("receipt not found")
+)
+
+// Block of the chain with the transactions it contains. Hashes and addresses are lower case hex.
+type Block struct {
+	Number       uint64
+	Hash         string
+	ParentHash   string
+	Transactions []Transaction
+}
+
+// Transaction of a block, To is empty for contract creations.
+type Transaction struct {
+	Hash  string
+	From  string
+	To    string
+	Value *big.Int
+}
+
+// Transfer of an ERC20 token, Token is the address of the token contract.
+type Transfer struct {
+	TxHash   string
+	LogIndex int
+	Token    string
+	From     string
+	To       string
+	Amount   *big.Int
+}
+
+type Client interface {
+	// BlockNumber returns the number of the most recent block.
+	BlockNumber(ctx context.Context, rpcURL string) (uint64, error)
+	// BlockByNumber returns the block including its transactions, nil if the node does not know the block (yet).
+	BlockByNumber(ctx context.Context, rpcURL string, number uint64) (*Block, error)
+	// BlockHash returns the hash of the canonical block at the number, empty if the node does not know the block (yet).
+	BlockHash(ctx context.Context, rpcURL string, number uint64) (string, error)
+	// TokenTransfers returns the ERC20 transfers of the tokens within the block.
+	TokenTransfers(ctx context.Context, rpcURL string, blockHash string, tokens []string) ([]Transfer, error)
+	// TransactionSucceeded reports whether the mined transaction was executed successfully.
+	TransactionSucceeded(ctx context.Context, rpcURL string, txHash string) (bool, error)
+	// Balance returns the native balance of the address at the most recent block.
+	Balance(ctx context.Context, rpcURL string, address string) (*big.Int, error)
+	// TokenBalance returns the ERC20 balance of the address at the most recent block.
+	TokenBalance(ctx context.Context, rpcURL string, token string, address string) (*big.Int, error)
+}
+
+type client struct {
+	rpc *jsonrpc.Client
+}
+
+func NewClient(httpClient *http.Client) Client {
+	return &client{
+		rpc: jsonrpc.NewClient(httpClient),
+	}
+}
+
+type rpcBlock struct {
+	Number     string `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parentHash"`
+	// Transactions holds transaction objects or hashes, depending on the request.
+	Transactions json.RawMessage `json:"transactions"`
+}
+
+type rpcTransaction struct {
+	Hash  string  `json:"hash"`
+	From  string  `json:"from"`
+	To    *string `json:"to"`
+	Value string  `json:"value"`
+}
+
+type rpcLog struct {
+	Address         string   `json:"address"`
+	Topics          []string `json:"topics"`
+	Data            string   `json:"data"`
+	TransactionHash string   `json:"transactionHash"`
+	LogIndex        string   `json:"logIndex"`
+	Removed         bool     `json:"removed"`
+}
+
+type rpcReceipt struct {
+	Status string `json:"status"`
+}
+
+func (c *client) BlockNumber(ctx context.Context, rpcURL string) (uint64, error) {
+	var result string
+	if err := c.rpc.Call(ctx, rpcURL, "eth_blockNumber", []interface{}{}, &result); err != nil {
+		return 0, err
+	}
+	return parseUint64(result)
+}
+
+func (c *client) BlockByNumber(ctx context.Context, rpcURL string, number uint64) (*Block, error) {
+	result, err := c.getBlock(ctx, rpcURL, number, true)
+	if err != nil || result == nil {
+		return nil, err
+	}
+
+	var txs []rpcTransaction
+	if len(result.Transactions) > 0 {
+		if err := json.Unmarshal(result.Transactions, &txs); err != nil {
+			return nil, fmt.Errorf("block %d transactions: %w", number, ErrInvalidResponse)
+		}
+	}
+
+	block := &Block{
+		Number:       number,
+		Hash:         strings.ToLower(result.Hash),
+		ParentHash:   strings.ToLower(result.ParentHash),
+		Transactions: make([]Transaction, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		value, err := parseBig(tx.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		var to string
+		if tx.To != nil {
+			to = strings.ToLower(*tx.To)
+		}
+		block.Transactions = append(block.Transactions, Transaction{
+			Hash:  strings.ToLower(tx.Hash),
+			From:  strings.ToLower(tx.From),
+			To:    to,
+			Value: value,
+		})
+	}
+
+	return block, nil
+}
+
+func (c *client) BlockHash(ctx context.Context, rpcURL string, number uint64) (string, error) {
+	result, err := c.getBlock(ctx, rpcURL, number, false)
+	if err != nil || result == nil {
+		return "", err
+	}
+	return strings.ToLower(result.Hash), nil
+}
+
+// getBlock fetches the block at the number, with full transaction objects if requested and their hashes otherwise.
+func (c *client) getBlock(ctx context.Context, rpcURL string, number uint64, full bool) (*rpcBlock, error) {
+	var result *rpcBlock
+	if err := c.rpc.Call(ctx, rpcURL, "eth_getBlockByNumber", []interface{}{
+		"0x" + strconv.FormatUint(number, 16),
+		full,
+	}, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	n, err := parseUint64(result.Number)
+	if err != nil {
+		return nil, err
+	}
+	if n != number || result.Hash == "" || result.ParentHash == "" {
+		return nil, fmt.Errorf("block %d: %w", number, ErrInvalidResponse)
+	}
+	return result, nil
+}
+
+func (c *client) TokenTransfers(ctx context.Context, rpcURL string, blockHash string, tokens []string) ([]Transfer, error) {
+	// Without contracts the filter would match the transfer logs of all tokens.
+	if len(tokens) == 0 {
+		return []Transfer{}, nil
+	}
+
+	var result []rpcLog
+	if err := c.rpc.Call(ctx, rpcURL, "eth_getLogs", []interface{}{
+		map[string]interface{}{
+			"blockHash": blockHash,
+			"address":   tokens,
+			"topics":    []interface{}{TransferTopic},
+		},
+	}, &result); err != nil {
+		return nil, err
+	}
+
+	transfers := make([]Transfer, 0, len(result))
+	for _, l := range result {
+		// ERC721 transfers index the token ID as fourth topic, their data is empty.
+		if l.Removed || len(l.Topics) != 3 || !strings.EqualFold(l.Topics[0], TransferTopic) {
+			continue
+		}
+
+		from, err := topicAddress(l.Topics[1])
+		if err != nil {
+			return nil, err
+		}
+		to, err := topicAddress(l.Topics[2])
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseBig(l.Data)
+		if err != nil {
+			return nil, err
+		}
+		logIndex, err := parseUint64(l.LogIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, Transfer{
+			TxHash:   strings.ToLower(l.TransactionHash),
+			LogIndex: int(logIndex),
+			Token:    strings.ToLower(l.Address),
+			From:     from,
+			To:       to,
+			Amount:   amount,
+		})
+	}
+
+	return transfers, nil
+}
+
+func (c *client) TransactionSucceeded(ctx context.Context, rpcURL string, txHash string) (bool, error) {
+	var result *rpcReceipt
+	if err := c.rpc.Call(ctx, rpcURL, "eth_getTransactionReceipt", []interface{}{txHash}, &result); err != nil {
+		return false, err
+	}
+	if result == nil {
+		return false, ErrReceiptNotFound
+	}
+	// Receipts before Byzantium carry no status, their transactions only fail by running out of gas.
+	if result.Status == "" {
+		return true, nil
+	}
+
+	status, err := parseUint64(result.Status)
+	if err != nil {
+		return false, err
+	}
+	return status == 1, nil
+}
+
+func (c *client) Balance(ctx context.Context, rpcURL string, address string) (*big.Int, error) {
+	var result string
+	if err := c.rpc.Call(ctx, rpcURL, "eth_getBalance", []interface{}{address, "latest"}, &result); err != nil {
+		return nil, err
+	}
+	return parseBig(result)
+}
+
+func (c *client) TokenBalance(ctx context.Context, rpcURL string, token string, address string) (*big.Int, error) {
+	addr := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	if len(addr) != 40 {
+		return nil, fmt.Errorf("invalid address %q", address)
+	}
+
+	var result string
+	if err := c.rpc.Call(ctx, rpcURL, "eth_call", []interface{}{
+		map[string]interface{}{
+			"to":   token,
+			"data": balanceOfSelector + strings.Repeat("0", 24) + addr,
+		},
+		"latest",
+	}, &result); err != nil {
+		return nil, err
+	}
+	// The uint256 is returned as 32 bytes, calls of addresses without code return no data at all.
+	if len(result) != 2+64 {
+		return nil, fmt.Errorf("balance %q: %w", result, ErrInvalidResponse)
+	}
+	return parseBig(result)
+}
+
+// topicAddress returns the address of an indexed address parameter, padded to 32 bytes.
+func topicAddress(topic string) (string, error) {
+	topic = strings.ToLower(strings.TrimPrefix(topic, "0x"))
+	if len(topic) != 64 || strings.Trim(topic[:24], "0") != "" {
+		return "", fmt.Errorf("topic %q: %w", topic, ErrInvalidResponse)
+	}
+	return "0x" + topic[24:], nil
+}
+
+func parseUint64(quantity string) (uint64, error) {
+	n, err := parseBig(quantity)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsUint64() {
+		return 0, fmt.Errorf("quantity %q: %w", quantity, ErrInvalidResponse)
+	}
+	return n.Uint64(), nil
+}
+
+// parseBig parses a hex encoded quantity, "0x" denotes zero.
+func parseBig(quantity string) (*big.Int, error) {
+	digits, ok := strings.CutPrefix(quantity, "0x")
+	if !ok {
+		return nil, fmt.Errorf("quantity %q: %w", quantity, ErrInvalidResponse)
+	}
+	if digits == "" {
+		return new(big.Int), nil
+	}
+
+	n, ok := new(big.Int).SetString(digits, 16)
+	if !ok {
+		return nil, fmt.Errorf("quantity %q: %w", quantity, ErrInvalidResponse)
+	}
+	return n, nil
+}
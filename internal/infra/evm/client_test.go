@@ -0,0 +1,207 @@
+package evm_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/evm"
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	blockHash  = "0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71"
+	parentHash = "0x1f68ac259155e2f38211ddad0f0a15394d55417b185a93923e2abe71bb7a4d6d"
+	wallet     = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	sender     = "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"
+	token      = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+)
+
+func paddedTopic(addr string) string {
+	return "0x000000000000000000000000" + addr[2:]
+}
+
+func TestBlockByNumber(t *testing.T) {
+	srv := test.NewTestJSONRPCServer(t, func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		switch method {
+		case "eth_blockNumber":
+			return "0x1312d02", nil
+		case "eth_getBlockByNumber":
+			require.Len(t, params, 2)
+			if string(params[0]) != `"0x1312d00"` {
+				return nil, nil
+			}
+			if string(params[1]) == `false` {
+				return map[string]interface{}{
+					"number":       "0x1312d00",
+					"hash":         blockHash,
+					"parentHash":   parentHash,
+					"transactions": []string{"0x01", "0x02"},
+				}, nil
+			}
+			return map[string]interface{}{
+				"number":     "0x1312d00",
+				"hash":       "0x9B83C12C69EDB74F6C8DD5D052765C1ADF940E320BD1291696E6FA07829EEE71",
+				"parentHash": parentHash,
+				"transactions": []map[string]interface{}{
+					{"hash": "0x01", "from": sender, "to": "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "value": "0xde0b6b3a7640000"},
+					{"hash": "0x02", "from": sender, "to": nil, "value": "0x0"},
+				},
+			}, nil
+		default:
+			return nil, &jsonrpc.RPCError{Code: -32601, Message: "method not found"}
+		}
+	})
+	client := evm.NewClient(srv.Client())
+
+	head, err := client.BlockNumber(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20000002), head)
+
+	block, err := client.BlockByNumber(context.Background(), srv.URL, 20000000)
+	require.NoError(t, err)
+	require.NotNil(t, block)
+	assert.Equal(t, uint64(20000000), block.Number)
+	assert.Equal(t, blockHash, block.Hash)
+	assert.Equal(t, parentHash, block.ParentHash)
+	require.Len(t, block.Transactions, 2)
+	assert.Equal(t, wallet, block.Transactions[0].To)
+	assert.Equal(t, big.NewInt(1_000_000_000_000_000_000), block.Transactions[0].Value)
+	assert.Empty(t, block.Transactions[1].To)
+	assert.Equal(t, 0, block.Transactions[1].Value.Sign())
+
+	hash, err := client.BlockHash(context.Background(), srv.URL, 20000000)
+	require.NoError(t, err)
+	assert.Equal(t, blockHash, hash)
+
+	block, err = client.BlockByNumber(context.Background(), srv.URL, 20000003)
+	require.NoError(t, err)
+	assert.Nil(t, block)
+
+	hash, err = client.BlockHash(context.Background(), srv.URL, 20000003)
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+}
+
+func TestTokenTransfers(t *testing.T) {
+	srv := test.NewTestJSONRPCServer(t, func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		require.Equal(t, "eth_getLogs", method)
+		require.Len(t, params, 1)
+		assert.JSONEq(t, `{"blockHash":"`+blockHash+`","address":["`+token+`"],"topics":["`+evm.TransferTopic+`"]}`, string(params[0]))
+
+		return []map[string]interface{}{
+			{
+				"address":         token,
+				"topics":          []string{evm.TransferTopic, paddedTopic(sender), paddedTopic(wallet)},
+				"data":            "0x00000000000000000000000000000000000000000000000000000000000f4240",
+				"transactionHash": "0x03",
+				"logIndex":        "0x1a",
+			},
+			{
+				// removed by a reorg
+				"address":         token,
+				"topics":          []string{evm.TransferTopic, paddedTopic(sender), paddedTopic(wallet)},
+				"data":            "0x01",
+				"transactionHash": "0x04",
+				"logIndex":        "0x1b",
+				"removed":         true,
+			},
+			{
+				// ERC721 transfer indexing the token ID
+				"address":         token,
+				"topics":          []string{evm.TransferTopic, paddedTopic(sender), paddedTopic(wallet), "0x01"},
+				"data":            "0x",
+				"transactionHash": "0x05",
+				"logIndex":        "0x1c",
+			},
+		}, nil
+	})
+	client := evm.NewClient(srv.Client())
+
+	transfers, err := client.TokenTransfers(context.Background(), srv.URL, blockHash, []string{token})
+	require.NoError(t, err)
+	assert.Equal(t, []evm.Transfer{{
+		TxHash:   "0x03",
+		LogIndex: 26,
+		Token:    token,
+		From:     sender,
+		To:       wallet,
+		Amount:   big.NewInt(1_000_000),
+	}}, transfers)
+
+	transfers, err = client.TokenTransfers(context.Background(), srv.URL, blockHash, nil)
+	require.NoError(t, err)
+	assert.Empty(t, transfers)
+}
+
+func TestTransactionSucceeded(t *testing.T) {
+	receipts := map[string]interface{}{
+		`"0x01"`: map[string]string{"status": "0x1"},
+		`"0x02"`: map[string]string{"status": "0x0"},
+		`"0x03"`: map[string]string{},
+		`"0x04"`: nil,
+	}
+	srv := test.NewTestJSONRPCServer(t, func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		require.Equal(t, "eth_getTransactionReceipt", method)
+		require.Len(t, params, 1)
+		return receipts[string(params[0])], nil
+	})
+	client := evm.NewClient(srv.Client())
+
+	ok, err := client.TransactionSucceeded(context.Background(), srv.URL, "0x01")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = client.TransactionSucceeded(context.Background(), srv.URL, "0x02")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = client.TransactionSucceeded(context.Background(), srv.URL, "0x03")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = client.TransactionSucceeded(context.Background(), srv.URL, "0x04")
+	assert.ErrorIs(t, err, evm.ErrReceiptNotFound)
+}
+
+func TestBalances(t *testing.T) {
+	srv := test.NewTestJSONRPCServer(t, func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		switch method {
+		case "eth_getBalance":
+			require.Len(t, params, 2)
+			assert.JSONEq(t, `"`+wallet+`"`, string(params[0]))
+			assert.JSONEq(t, `"latest"`, string(params[1]))
+			return "0xde0b6b3a7640000", nil
+		case "eth_call":
+			require.Len(t, params, 2)
+			var call map[string]string
+			require.NoError(t, json.Unmarshal(params[0], &call))
+			assert.Equal(t, "0x70a08231"+paddedTopic(wallet)[2:], call["data"])
+			switch call["to"] {
+			case token:
+				return "0x00000000000000000000000000000000000000000000000000000000000f4240", nil
+			default:
+				// Addresses without code return no data.
+				return "0x", nil
+			}
+		default:
+			return nil, &jsonrpc.RPCError{Code: -32601, Message: "method not found"}
+		}
+	})
+	client := evm.NewClient(srv.Client())
+
+	balance, err := client.Balance(context.Background(), srv.URL, wallet)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1_000_000_000_000_000_000), balance)
+
+	balance, err = client.TokenBalance(context.Background(), srv.URL, token, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1_000_000), balance)
+
+	_, err = client.TokenBalance(context.Background(), srv.URL, sender, wallet)
+	require.ErrorIs(t, err, evm.ErrInvalidResponse)
+}
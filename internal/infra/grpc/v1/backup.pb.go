@@ -658,8 +658,14 @@ type ShareDeliveryResponse struct {
 
 	// delivery_id removed as we use composite key
 	EncryptedShare []byte `protobuf:"bytes,2,opt,name=encrypted_share,json=encryptedShare,proto3" json:"encrypted_share,omitempty"`
-	Signature      []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"` // 服务端签名
-	Timestamp      int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 服务端签名: Ed25519 signature, or ASN.1 encoded ECDSA signature, over the SHA-256 digest of
+	//
+	//   client_id 0x00 key_id 0x00 node_id 0x00 share_index (uint32 BE) timestamp (uint64 BE) encrypted_share
+	//
+	// where the IDs and share_index are those of the request, encoded as UTF-8 without length prefix.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Unix seconds the delivery was issued at, part of the signed digest.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *ShareDeliveryResponse) Reset() {
@@ -30,6 +30,8 @@ type KeyServiceClient interface {
 	DeleteRootKey(ctx context.Context, in *DeleteRootKeyRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	// 列出根密钥
 	ListRootKeys(ctx context.Context, in *ListRootKeysRequest, opts ...grpc.CallOption) (*ListRootKeysResponse, error)
+	// 刷新根密钥分片（Proactive Refresh，公钥不变）
+	RefreshRootKey(ctx context.Context, in *RefreshRootKeyRequest, opts ...grpc.CallOption) (*RefreshRootKeyResponse, error)
 	// 派生钱包密钥（Hardened Derivation）
 	DeriveWalletKey(ctx context.Context, in *DeriveWalletKeyRequest, opts ...grpc.CallOption) (*DeriveWalletKeyResponse, error)
 	// 获取钱包密钥信息
@@ -84,6 +86,15 @@ func (c *keyServiceClient) ListRootKeys(ctx context.Context, in *ListRootKeysReq
 	return out, nil
 }
 
+func (c *keyServiceClient) RefreshRootKey(ctx context.Context, in *RefreshRootKeyRequest, opts ...grpc.CallOption) (*RefreshRootKeyResponse, error) {
+	out := new(RefreshRootKeyResponse)
+	err := c.cc.Invoke(ctx, "/infra.v1.KeyService/RefreshRootKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keyServiceClient) DeriveWalletKey(ctx context.Context, in *DeriveWalletKeyRequest, opts ...grpc.CallOption) (*DeriveWalletKeyResponse, error) {
 	out := new(DeriveWalletKeyResponse)
 	err := c.cc.Invoke(ctx, "/infra.v1.KeyService/DeriveWalletKey", in, out, opts...)
@@ -132,6 +143,8 @@ type KeyServiceServer interface {
 	DeleteRootKey(context.Context, *DeleteRootKeyRequest) (*StatusResponse, error)
 	// 列出根密钥
 	ListRootKeys(context.Context, *ListRootKeysRequest) (*ListRootKeysResponse, error)
+	// 刷新根密钥分片（Proactive Refresh，公钥不变）
+	RefreshRootKey(context.Context, *RefreshRootKeyRequest) (*RefreshRootKeyResponse, error)
 	// 派生钱包密钥（Hardened Derivation）
 	DeriveWalletKey(context.Context, *DeriveWalletKeyRequest) (*DeriveWalletKeyResponse, error)
 	// 获取钱包密钥信息
@@ -159,6 +172,9 @@ func (UnimplementedKeyServiceServer) DeleteRootKey(context.Context, *DeleteRootK
 func (UnimplementedKeyServiceServer) ListRootKeys(context.Context, *ListRootKeysRequest) (*ListRootKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRootKeys not implemented")
 }
+func (UnimplementedKeyServiceServer) RefreshRootKey(context.Context, *RefreshRootKeyRequest) (*RefreshRootKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshRootKey not implemented")
+}
 func (UnimplementedKeyServiceServer) DeriveWalletKey(context.Context, *DeriveWalletKeyRequest) (*DeriveWalletKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeriveWalletKey not implemented")
 }
@@ -256,6 +272,24 @@ func _KeyService_ListRootKeys_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyService_RefreshRootKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRootKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).RefreshRootKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infra.v1.KeyService/RefreshRootKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).RefreshRootKey(ctx, req.(*RefreshRootKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KeyService_DeriveWalletKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeriveWalletKeyRequest)
 	if err := dec(in); err != nil {
@@ -351,6 +385,10 @@ var KeyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRootKeys",
 			Handler:    _KeyService_ListRootKeys_Handler,
 		},
+		{
+			MethodName: "RefreshRootKey",
+			Handler:    _KeyService_RefreshRootKey_Handler,
+		},
 		{
 			MethodName: "DeriveWalletKey",
 			Handler:    _KeyService_DeriveWalletKey_Handler,
@@ -0,0 +1,97 @@
+// Package jsonrpc implements a minimal JSON-RPC 2.0 client for talking to chain nodes.
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxResponseSize limits the size of JSON-RPC responses read from a node.
+const maxResponseSize = 1 << 20
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+}
+
+// RPCError is an error returned by the node in reply to a JSON-RPC request.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message)
+}
+
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		httpClient: httpClient,
+	}
+}
+
+// Call performs a single JSON-RPC 2.0 request, decoding its result into result.
+// A missing result is decoded as null, leaving pointers nil.
+func (c *Client) Call(ctx context.Context, rpcURL string, method string, params []interface{}, result interface{}) error {
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal %s request: %w", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send %s request: %w", method, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s request failed with status %d", method, res.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("read %s response: %w", method, err)
+	}
+
+	var rpcRes rpcResponse
+	if err := json.Unmarshal(raw, &rpcRes); err != nil {
+		return fmt.Errorf("decode %s response: %w", method, err)
+	}
+	if rpcRes.Error != nil {
+		return fmt.Errorf("%s: %w", method, rpcRes.Error)
+	}
+
+	if len(rpcRes.Result) == 0 {
+		rpcRes.Result = json.RawMessage("null")
+	}
+	if err := json.Unmarshal(rpcRes.Result, result); err != nil {
+		return fmt.Errorf("decode %s result: %w", method, err)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package mpc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"google.golang.org/grpc"
+)
+
+var (
+	// ErrShareVerificationKeyMissing is returned for deliveries if no key to verify them is configured.
+	ErrShareVerificationKeyMissing = errors.New("no share delivery verification key configured")
+	ErrInvalidShareSignature       = errors.New("share delivery signature is not valid")
+	ErrStaleShareDelivery          = errors.New("share delivery timestamp is outside the allowed clock skew")
+	ErrShareNotConfirmed           = errors.New("share delivery was not confirmed")
+	ErrShareNotRecovered           = errors.New("share was not recovered")
+)
+
+type BackupConfig struct {
+	// PEM encoded public key (Ed25519 or ECDSA) of the MPC server signing share deliveries
+	VerifyKeyFile string
+	MaxClockSkew  time.Duration
+}
+
+type BackupClient struct {
+	client    infra.BackupDeliveryServiceClient
+	backup    infra.BackupServiceClient
+	verifyKey crypto.PublicKey
+	maxSkew   time.Duration
+}
+
+// ShareRef identifies the share of a client within a key.
+type ShareRef struct {
+	ClientID   string
+	KeyID      string
+	NodeID     string
+	ShareIndex int32
+}
+
+type DeliveredShare struct {
+	EncryptedShare []byte
+	Signature      []byte
+	Timestamp      time.Time
+}
+
+// NodeBackupStatus reports whether the share of a node can be recovered from its backup shares.
+type NodeBackupStatus struct {
+	NodeID         string
+	TotalShares    int
+	RequiredShares int
+	Recoverable    bool
+}
+
+type ShareStatus struct {
+	Status        string
+	DeliveredAt   time.Time
+	ConfirmedAt   time.Time
+	FailureReason string
+}
+
+func NewBackupClient(conn grpc.ClientConnInterface, cfg BackupConfig) (*BackupClient, error) {
+	c := &BackupClient{
+		client:  infra.NewBackupDeliveryServiceClient(conn),
+		backup:  infra.NewBackupServiceClient(conn),
+		maxSkew: cfg.MaxClockSkew,
+	}
+
+	if cfg.VerifyKeyFile != "" {
+		raw, err := os.ReadFile(cfg.VerifyKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read share delivery verification key: %w", err)
+		}
+		c.verifyKey, err = ParseVerifyKey(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// ClientNodeID returns the ID of the node holding the share of the user.
+func ClientNodeID(userID string) string {
+	return "client-" + userID
+}
+
+// RequestShareDelivery requests the share encrypted to the public key of the device and verifies it was signed by
+// the MPC server recently.
+func (c *BackupClient) RequestShareDelivery(ctx context.Context, ref ShareRef, devicePublicKey []byte) (*DeliveredShare, error) {
+	if c.verifyKey == nil {
+		return nil, ErrShareVerificationKeyMissing
+	}
+
+	resp, err := c.client.RequestShareDelivery(ctx, &infra.ShareDeliveryRequest{
+		ClientId:        ref.ClientID,
+		KeyId:           ref.KeyID,
+		NodeId:          ref.NodeID,
+		ShareIndex:      ref.ShareIndex,
+		ClientPublicKey: devicePublicKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyShareDelivery(c.verifyKey, ref, resp, time.Now(), c.maxSkew); err != nil {
+		return nil, err
+	}
+
+	return &DeliveredShare{
+		EncryptedShare: resp.GetEncryptedShare(),
+		Signature:      resp.GetSignature(),
+		Timestamp:      time.Unix(resp.GetTimestamp(), 0),
+	}, nil
+}
+
+func (c *BackupClient) ConfirmShareDelivery(ctx context.Context, ref ShareRef, received bool, failureReason string) error {
+	resp, err := c.client.ConfirmShareDelivery(ctx, &infra.ShareConfirmationRequest{
+		ClientId:             ref.ClientID,
+		KeyId:                ref.KeyID,
+		NodeId:               ref.NodeID,
+		ShareIndex:           ref.ShareIndex,
+		ReceivedSuccessfully: received,
+		FailureReason:        failureReason,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.GetConfirmed() {
+		return fmt.Errorf("%w: %s", ErrShareNotConfirmed, resp.GetMessage())
+	}
+
+	return nil
+}
+
+func (c *BackupClient) QueryShareStatus(ctx context.Context, ref ShareRef) (*ShareStatus, error) {
+	resp, err := c.client.QueryShareStatus(ctx, &infra.ShareStatusQuery{
+		ClientId:   ref.ClientID,
+		KeyId:      ref.KeyID,
+		NodeId:     ref.NodeID,
+		ShareIndex: ref.ShareIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status := &ShareStatus{
+		Status:        resp.GetStatus(),
+		FailureReason: resp.GetFailureReason(),
+	}
+	if resp.GetDeliveredAt() > 0 {
+		status.DeliveredAt = time.Unix(resp.GetDeliveredAt(), 0)
+	}
+	if resp.GetConfirmedAt() > 0 {
+		status.ConfirmedAt = time.Unix(resp.GetConfirmedAt(), 0)
+	}
+
+	return status, nil
+}
+
+// GetBackupStatus returns the backup state of the shares of every node of the key.
+func (c *BackupClient) GetBackupStatus(ctx context.Context, keyID string) ([]NodeBackupStatus, error) {
+	resp, err := c.backup.GetBackupStatus(ctx, &infra.GetBackupStatusRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]NodeBackupStatus, 0, len(resp.GetStatuses()))
+	for _, s := range resp.GetStatuses() {
+		statuses = append(statuses, NodeBackupStatus{
+			NodeID:         s.GetNodeId(),
+			TotalShares:    int(s.GetTotalShares()),
+			RequiredShares: int(s.GetRequiredShares()),
+			Recoverable:    s.GetRecoverable(),
+		})
+	}
+
+	return statuses, nil
+}
+
+// ListBackupShares returns the number of backup shares stored for every node of the key.
+func (c *BackupClient) ListBackupShares(ctx context.Context, keyID string) (map[string]int, error) {
+	resp, err := c.backup.ListBackupShares(ctx, &infra.ListBackupSharesRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(resp.GetSharesByNode()))
+	for nodeID, shares := range resp.GetSharesByNode() {
+		counts[nodeID] = len(shares.GetShares())
+	}
+
+	return counts, nil
+}
+
+// RecoverShare restores the share of the node of the key from its backup shares.
+func (c *BackupClient) RecoverShare(ctx context.Context, keyID string, nodeID string) error {
+	resp, err := c.backup.RecoverMPCShare(ctx, &infra.RecoverMPCShareRequest{
+		KeyId:  keyID,
+		NodeId: nodeID,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.GetSuccess() {
+		return fmt.Errorf("%w: %s", ErrShareNotRecovered, resp.GetMessage())
+	}
+
+	return nil
+}
+
+// ShareDeliveryDigest returns the SHA-256 digest the MPC server signs for a delivery, binding the encrypted share to
+// the share it belongs to and the time it was issued:
+//
+//	client_id 0x00 key_id 0x00 node_id 0x00 share_index (uint32 BE) timestamp (uint64 BE, unix seconds) encrypted_share
+func ShareDeliveryDigest(ref ShareRef, timestamp int64, encryptedShare []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(ref.ClientID))
+	h.Write([]byte{0})
+	h.Write([]byte(ref.KeyID))
+	h.Write([]byte{0})
+	h.Write([]byte(ref.NodeID))
+	h.Write([]byte{0})
+
+	var buf [12]byte
+	binary.BigEndian.PutUint32(buf[:4], uint32(ref.ShareIndex)) //nolint:gosec
+	binary.BigEndian.PutUint64(buf[4:], uint64(timestamp))      //nolint:gosec
+	h.Write(buf[:])
+	h.Write(encryptedShare)
+
+	return h.Sum(nil)
+}
+
+// VerifyShareDelivery checks the delivery was signed by the key for the share requested and its timestamp is within
+// the allowed clock skew of now.
+func VerifyShareDelivery(key crypto.PublicKey, ref ShareRef, resp *infra.ShareDeliveryResponse, now time.Time, maxSkew time.Duration) error {
+	if len(resp.GetEncryptedShare()) == 0 {
+		return fmt.Errorf("%w: empty share", ErrInvalidShareSignature)
+	}
+
+	digest := ShareDeliveryDigest(ref, resp.GetTimestamp(), resp.GetEncryptedShare())
+
+	var valid bool
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, digest, resp.GetSignature())
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, digest, resp.GetSignature())
+	default:
+		return fmt.Errorf("unsupported share delivery verification key %T", key)
+	}
+	if !valid {
+		return ErrInvalidShareSignature
+	}
+
+	skew := now.Sub(time.Unix(resp.GetTimestamp(), 0))
+	if skew < -maxSkew || skew > maxSkew {
+		return ErrStaleShareDelivery
+	}
+
+	return nil
+}
+
+// ParseVerifyKey parses the PEM encoded PKIX public key verifying share deliveries.
+func ParseVerifyKey(raw []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("failed to decode share delivery verification key: no PEM block found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse share delivery verification key: %w", err)
+	}
+
+	switch key.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported share delivery verification key %T", key)
+	}
+}
@@ -0,0 +1,108 @@
+package mpc_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var shareRef = mpc.ShareRef{
+	ClientID:   "f6ede5d8-e22a-4ca5-aa12-67821865a3e5",
+	KeyID:      "5f4c0a2e-6f39-4d3b-9a59-5b0d7f6b5f0e",
+	NodeID:     mpc.ClientNodeID("f6ede5d8-e22a-4ca5-aa12-67821865a3e5"),
+	ShareIndex: 3,
+}
+
+func TestVerifyShareDeliveryEd25519(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Unix(1717243200, 0)
+	share := []byte("encrypted share")
+	resp := &infra.ShareDeliveryResponse{
+		EncryptedShare: share,
+		Signature:      ed25519.Sign(priv, mpc.ShareDeliveryDigest(shareRef, now.Unix(), share)),
+		Timestamp:      now.Unix(),
+	}
+
+	require.NoError(t, mpc.VerifyShareDelivery(pub, shareRef, resp, now.Add(time.Minute), 5*time.Minute))
+	require.NoError(t, mpc.VerifyShareDelivery(pub, shareRef, resp, now.Add(-time.Minute), 5*time.Minute))
+
+	// Deliveries outside the clock skew are refused.
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(pub, shareRef, resp, now.Add(6*time.Minute), 5*time.Minute), mpc.ErrStaleShareDelivery)
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(pub, shareRef, resp, now.Add(-6*time.Minute), 5*time.Minute), mpc.ErrStaleShareDelivery)
+
+	// The signature covers the share requested.
+	other := shareRef
+	other.ShareIndex = 4
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(pub, other, resp, now, 5*time.Minute), mpc.ErrInvalidShareSignature)
+	other = shareRef
+	other.ClientID = "a4f6a4b8-7a8c-4f4b-9d0f-8f0f3e2c1b6a"
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(pub, other, resp, now, 5*time.Minute), mpc.ErrInvalidShareSignature)
+
+	// The signature covers the timestamp and the share.
+	tampered := &infra.ShareDeliveryResponse{EncryptedShare: share, Signature: resp.Signature, Timestamp: now.Unix() + 1}
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(pub, shareRef, tampered, now, 5*time.Minute), mpc.ErrInvalidShareSignature)
+	tampered = &infra.ShareDeliveryResponse{EncryptedShare: []byte("encrypted shard"), Signature: resp.Signature, Timestamp: now.Unix()}
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(pub, shareRef, tampered, now, 5*time.Minute), mpc.ErrInvalidShareSignature)
+
+	// Signatures of other keys are refused.
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(otherPub, shareRef, resp, now, 5*time.Minute), mpc.ErrInvalidShareSignature)
+}
+
+func TestVerifyShareDeliveryECDSA(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Unix(1717243200, 0)
+	share := []byte("encrypted share")
+	signature, err := ecdsa.SignASN1(rand.Reader, key, mpc.ShareDeliveryDigest(shareRef, now.Unix(), share))
+	require.NoError(t, err)
+
+	resp := &infra.ShareDeliveryResponse{
+		EncryptedShare: share,
+		Signature:      signature,
+		Timestamp:      now.Unix(),
+	}
+	require.NoError(t, mpc.VerifyShareDelivery(&key.PublicKey, shareRef, resp, now, time.Minute))
+
+	resp.Signature = []byte("not a signature")
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(&key.PublicKey, shareRef, resp, now, time.Minute), mpc.ErrInvalidShareSignature)
+}
+
+func TestVerifyShareDeliveryEmptyShare(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	resp := &infra.ShareDeliveryResponse{
+		Signature: ed25519.Sign(priv, mpc.ShareDeliveryDigest(shareRef, 1717243200, nil)),
+		Timestamp: 1717243200,
+	}
+	assert.ErrorIs(t, mpc.VerifyShareDelivery(pub, shareRef, resp, time.Unix(1717243200, 0), time.Minute), mpc.ErrInvalidShareSignature)
+}
+
+func TestParseVerifyKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	key, err := mpc.ParseVerifyKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+	assert.Equal(t, pub, key)
+
+	_, err = mpc.ParseVerifyKey([]byte("not a key"))
+	require.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package mpc
+
+import (
+	"sync"
+	"time"
+)
+
+// States of the breaker. Once the open timeout elapsed, the breaker is half open and lets a single call through,
+// closing again if it succeeds.
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half_open"
+)
+
+// Breaker refuses calls to the MPC server for a while after consecutive failures, so callers fail fast instead of
+// waiting for their deadlines while the server is down.
+type Breaker struct {
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	// openedAt is zero while the breaker is closed.
+	openedAt time.Time
+	// probing is set while the call let through by the half open breaker is running.
+	probing bool
+}
+
+// NewBreaker returns a breaker opening after threshold consecutive failures, it never opens if the threshold is zero.
+func NewBreaker(threshold int, openTimeout time.Duration, now func() time.Time) *Breaker {
+	return &Breaker{
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		now:         now,
+	}
+}
+
+// Allow reports whether a call may proceed, its outcome has to be recorded if so.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state() {
+	case BreakerOpen:
+		return false
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+	}
+	return true
+}
+
+// Record records the outcome of a call allowed before.
+func (b *Breaker) Record(failed bool) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !failed {
+		b.failures = 0
+		b.openedAt = time.Time{}
+		b.probing = false
+		return
+	}
+
+	b.failures++
+	switch {
+	case b.probing:
+		// The server is still failing, wait for another open timeout.
+		b.openedAt = b.now()
+		b.probing = false
+	case b.openedAt.IsZero() && b.failures >= b.threshold:
+		b.openedAt = b.now()
+	}
+}
+
+func (b *Breaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state()
+}
+
+func (b *Breaker) state() string {
+	switch {
+	case b.threshold <= 0 || b.openedAt.IsZero():
+		return BreakerClosed
+	case b.now().Before(b.openedAt.Add(b.openTimeout)):
+		return BreakerOpen
+	default:
+		return BreakerHalfOpen
+	}
+}
@@ -0,0 +1,55 @@
+package mpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Unix(1717243200, 0)
+	breaker := mpc.NewBreaker(3, 30*time.Second, func() time.Time { return now })
+
+	// Successes reset the consecutive failures.
+	breaker.Record(true)
+	breaker.Record(true)
+	breaker.Record(false)
+	breaker.Record(true)
+	breaker.Record(true)
+	assert.Equal(t, mpc.BreakerClosed, breaker.State())
+	assert.True(t, breaker.Allow())
+
+	breaker.Record(true)
+	assert.Equal(t, mpc.BreakerOpen, breaker.State())
+	assert.False(t, breaker.Allow())
+
+	// A single call is let through once the open timeout elapsed.
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, mpc.BreakerHalfOpen, breaker.State())
+	assert.True(t, breaker.Allow())
+	assert.False(t, breaker.Allow())
+
+	// The breaker opens again if the call fails.
+	breaker.Record(true)
+	assert.Equal(t, mpc.BreakerOpen, breaker.State())
+	assert.False(t, breaker.Allow())
+
+	now = now.Add(30 * time.Second)
+	assert.True(t, breaker.Allow())
+	breaker.Record(false)
+	assert.Equal(t, mpc.BreakerClosed, breaker.State())
+	assert.True(t, breaker.Allow())
+	assert.True(t, breaker.Allow())
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	breaker := mpc.NewBreaker(0, 30*time.Second, time.Now)
+
+	for range 10 {
+		breaker.Record(true)
+	}
+	assert.Equal(t, mpc.BreakerClosed, breaker.State())
+	assert.True(t, breaker.Allow())
+}
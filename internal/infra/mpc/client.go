@@ -1,54 +1,275 @@
 package mpc
 
 import (
-	"crypto/tls"
-	"crypto/x509"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
+	"strings"
+	"time"
 
-	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
+// ErrNotConfigured is reported by calls through a connection without MPC server configured.
+var ErrNotConfigured = status.Error(codes.Unavailable, "MPC server is not configured")
+
+// idempotentMethods are retried by the connection if the MPC server is unavailable, they do not change its state.
+var idempotentMethods = []string{
+	"/infra.v1.KeyService/GetRootKey",
+	"/infra.v1.KeyService/ListRootKeys",
+	"/infra.v1.KeyService/GetWalletKey",
+	"/infra.v1.KeyService/ListWalletKeys",
+	"/infra.v1.SigningService/GetSigningSession",
+	"/infra.v1.SigningService/VerifySignature",
+	"/infra.v1.NodeService/ListNodes",
+	"/infra.v1.NodeService/GetNodeConnectionInfo",
+	"/infra.v1.BackupService/GetBackupStatus",
+	"/infra.v1.BackupService/ListBackupShares",
+	"/infra.v1.BackupDeliveryService/QueryShareStatus",
+}
+
+// protocolMethods run an MPC protocol among the nodes and are bounded by the protocol timeout instead of the call
+// timeout.
+var protocolMethods = map[string]struct{}{
+	"/infra.v1.KeyService/CreateRootKey":                   {},
+	"/infra.v1.KeyService/RefreshRootKey":                  {},
+	"/infra.v1.KeyService/DeriveWalletKey":                 {},
+	"/infra.v1.SigningService/ThresholdSign":               {},
+	"/infra.v1.SigningService/BatchSign":                   {},
+	"/infra.v1.BackupService/RecoverMPCShare":              {},
+	"/infra.v1.BackupDeliveryService/RequestShareDelivery": {},
+}
+
 type Config struct {
 	Address    string
 	ServerName string
 	CACertFile string
 	CertFile   string
 	KeyFile    string
+	// CallTimeout bounds calls whose context has no deadline, ProtocolTimeout those running an MPC protocol.
+	CallTimeout     time.Duration
+	ProtocolTimeout time.Duration
+	// MaxRetryAttempts of idempotent calls failing as the MPC server is unavailable, including the first attempt.
+	// Calls are not retried if below two.
+	MaxRetryAttempts int
+	// KeepaliveTime is the time without activity after which the MPC server is pinged, the connection is closed if
+	// the ping is not acknowledged within KeepaliveTimeout.
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+	// BreakerFailureThreshold is the number of consecutive failures after which calls are refused for
+	// BreakerOpenTimeout, the breaker is disabled if zero.
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
+}
+
+// Connection is the connection to the MPC server shared by its clients. Calls are bounded by deadlines, retried if
+// idempotent and refused while the breaker is open. The client certificate and CA are reloaded once changed on disk.
+type Connection struct {
+	conn    *grpc.ClientConn
+	breaker *Breaker
+	// stop is called once the connection is closed, if set.
+	stop func()
 }
 
-func NewClientConnection(cfg Config) (*grpc.ClientConn, error) {
-	// Load CA cert
-	caCert, err := os.ReadFile(cfg.CACertFile)
+var _ grpc.ClientConnInterface = (*Connection)(nil)
+
+// NewClientConnection creates the connection to the MPC server, connecting in the background.
+func NewClientConnection(cfg Config) (*Connection, error) {
+	certs, err := newCertReloader(cfg.CACertFile, cfg.CertFile, cfg.KeyFile)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to read CA cert")
+		return nil, err
 	}
-	caCertPool := x509.NewCertPool()
-	if !caCertPool.AppendCertsFromPEM(caCert) {
-		return nil, errors.New("failed to append CA cert")
+
+	serviceConfig, err := retryServiceConfig(cfg.MaxRetryAttempts)
+	if err != nil {
+		return nil, err
 	}
 
-	// Load client cert/key
-	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	breaker := NewBreaker(cfg.BreakerFailureThreshold, cfg.BreakerOpenTimeout, time.Now)
+	conn, err := grpc.NewClient(cfg.Address,
+		grpc.WithTransportCredentials(credentials.NewTLS(certs.tlsConfig(cfg.ServerName))),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: cfg.KeepaliveTimeout,
+		}),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithUnaryInterceptor(unaryInterceptor(cfg.CallTimeout, cfg.ProtocolTimeout, breaker)),
+	)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to load client cert/key")
+		return nil, fmt.Errorf("failed to connect to MPC server: %w", err)
+	}
+	conn.Connect()
+
+	return &Connection{
+		conn:    conn,
+		breaker: breaker,
+	}, nil
+}
+
+// NewUnconfiguredConnection returns a connection failing all calls with ErrNotConfigured, used if no MPC server is
+// configured, e.g. in tests.
+func NewUnconfiguredConnection() *Connection {
+	return &Connection{}
+}
+
+// NewLocalConnection wraps the connection to an MPC server running in process, e.g. the fake one used in tests. Calls
+// are passed through as is, stop is called once the connection is closed.
+func NewLocalConnection(conn *grpc.ClientConn, stop func()) *Connection {
+	return &Connection{
+		conn:    conn,
+		breaker: NewBreaker(0, 0, time.Now),
+		stop:    stop,
+	}
+}
+
+// Configured reports whether the connection was created for a configured MPC server.
+func (c *Connection) Configured() bool {
+	return c.conn != nil
+}
+
+func (c *Connection) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	if c.conn == nil {
+		return ErrNotConfigured
+	}
+	return c.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (c *Connection) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if c.conn == nil {
+		return nil, ErrNotConfigured
+	}
+	return c.conn.NewStream(ctx, desc, method, opts...)
+}
+
+// Probe reports whether the MPC server can be called, waiting for the connection to be established until the
+// context is done. Unconfigured connections are not probed.
+func (c *Connection) Probe(ctx context.Context) error {
+	if c.conn == nil {
+		return nil
+	}
+	if c.breaker.State() == BreakerOpen {
+		return errors.New("circuit breaker is open")
+	}
+
+	for {
+		state := c.conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Idle:
+			c.conn.Connect()
+		case connectivity.Shutdown:
+			return errors.New("connection is closed")
+		case connectivity.Connecting, connectivity.TransientFailure:
+		}
+
+		if !c.conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection is %s: %w", state, ctx.Err())
+		}
+	}
+}
+
+// State describes the state of the connection and its breaker, e.g. for probes.
+func (c *Connection) State() string {
+	if c.conn == nil {
+		return "not configured"
+	}
+	return fmt.Sprintf("%s, breaker %s", c.conn.GetState(), c.breaker.State())
+}
+
+func (c *Connection) Close() error {
+	if c.conn == nil {
+		return nil
 	}
+	err := c.conn.Close()
+	if c.stop != nil {
+		c.stop()
+	}
+	return err
+}
+
+// unaryInterceptor bounds calls without deadline by the timeout of their method and refuses them while the breaker
+// is open. Calls failing as the MPC server is unavailable or too slow count as failures of the breaker.
+func unaryInterceptor(callTimeout time.Duration, protocolTimeout time.Duration, breaker *Breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !breaker.Allow() {
+			return status.Errorf(codes.Unavailable, "MPC server is unavailable, circuit breaker is open")
+		}
 
-	// Create TLS credentials
-	creds := credentials.NewTLS(&tls.Config{
-		Certificates: []tls.Certificate{cert},
-		RootCAs:      caCertPool,
-		ServerName:   cfg.ServerName,
-		MinVersion:   tls.VersionTLS12,
-	})
+		timeout := callTimeout
+		if _, ok := protocolMethods[method]; ok {
+			timeout = protocolTimeout
+		}
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
 
-	//nolint:staticcheck // grpc.Dial is deprecated but used here for simplicity in PoC
-	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(creds))
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			breaker.Record(true)
+		default:
+			breaker.Record(false)
+		}
+
+		return err
+	}
+}
+
+// retryServiceConfig returns the service config retrying the idempotent methods if the MPC server is unavailable.
+func retryServiceConfig(maxAttempts int) (string, error) {
+	type retryPolicy struct {
+		MaxAttempts          int      `json:"maxAttempts"`
+		InitialBackoff       string   `json:"initialBackoff"`
+		MaxBackoff           string   `json:"maxBackoff"`
+		BackoffMultiplier    float64  `json:"backoffMultiplier"`
+		RetryableStatusCodes []string `json:"retryableStatusCodes"`
+	}
+	type methodName struct {
+		Service string `json:"service"`
+		Method  string `json:"method"`
+	}
+	type methodConfig struct {
+		Name        []methodName `json:"name"`
+		RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+	}
+
+	config := struct {
+		MethodConfig []methodConfig `json:"methodConfig"`
+	}{
+		MethodConfig: []methodConfig{},
+	}
+	if maxAttempts >= 2 {
+		names := make([]methodName, 0, len(idempotentMethods))
+		for _, method := range idempotentMethods {
+			i := strings.LastIndex(method, "/")
+			names = append(names, methodName{Service: method[1:i], Method: method[i+1:]})
+		}
+
+		config.MethodConfig = append(config.MethodConfig, methodConfig{
+			Name: names,
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          maxAttempts,
+				InitialBackoff:       "0.1s",
+				MaxBackoff:           "1s",
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		})
+	}
+
+	res, err := json.Marshal(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to MPC server: %w", err)
+		return "", fmt.Errorf("failed to marshal service config: %w", err)
 	}
 
-	return conn, nil
+	return string(res), nil
 }
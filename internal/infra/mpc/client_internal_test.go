@@ -0,0 +1,104 @@
+package mpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryInterceptorDeadline(t *testing.T) {
+	interceptor := unaryInterceptor(10*time.Second, 2*time.Minute, NewBreaker(0, 0, time.Now))
+
+	var remaining time.Duration
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		remaining = time.Until(deadline)
+		return nil
+	}
+
+	require.NoError(t, interceptor(t.Context(), "/infra.v1.KeyService/GetRootKey", nil, nil, nil, invoker))
+	assert.InDelta(t, 10*time.Second, remaining, float64(time.Second))
+
+	require.NoError(t, interceptor(t.Context(), "/infra.v1.SigningService/ThresholdSign", nil, nil, nil, invoker))
+	assert.InDelta(t, 2*time.Minute, remaining, float64(time.Second))
+
+	// Deadlines of the caller are kept.
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Minute)
+	defer cancel()
+	require.NoError(t, interceptor(ctx, "/infra.v1.SigningService/ThresholdSign", nil, nil, nil, invoker))
+	assert.InDelta(t, 5*time.Minute, remaining, float64(time.Second))
+}
+
+func TestUnaryInterceptorBreaker(t *testing.T) {
+	breaker := NewBreaker(2, time.Minute, time.Now)
+	interceptor := unaryInterceptor(time.Second, time.Second, breaker)
+
+	calls := 0
+	code := codes.Unavailable
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		calls++
+		return status.Error(code, "failed")
+	}
+
+	// Errors reported by a responding server do not open the breaker.
+	code = codes.NotFound
+	for range 3 {
+		require.Error(t, interceptor(t.Context(), "/infra.v1.KeyService/GetRootKey", nil, nil, nil, invoker))
+	}
+	assert.Equal(t, BreakerClosed, breaker.State())
+
+	code = codes.Unavailable
+	for range 2 {
+		require.Error(t, interceptor(t.Context(), "/infra.v1.KeyService/GetRootKey", nil, nil, nil, invoker))
+	}
+	assert.Equal(t, BreakerOpen, breaker.State())
+
+	err := interceptor(t.Context(), "/infra.v1.KeyService/GetRootKey", nil, nil, nil, invoker)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 5, calls)
+}
+
+func TestRetryServiceConfig(t *testing.T) {
+	raw, err := retryServiceConfig(3)
+	require.NoError(t, err)
+
+	var config struct {
+		MethodConfig []struct {
+			Name []struct {
+				Service string `json:"service"`
+				Method  string `json:"method"`
+			} `json:"name"`
+			RetryPolicy struct {
+				MaxAttempts int `json:"maxAttempts"`
+			} `json:"retryPolicy"`
+		} `json:"methodConfig"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(raw), &config))
+	require.Len(t, config.MethodConfig, 1)
+	assert.Equal(t, 3, config.MethodConfig[0].RetryPolicy.MaxAttempts)
+	require.Len(t, config.MethodConfig[0].Name, len(idempotentMethods))
+	assert.Equal(t, "infra.v1.KeyService", config.MethodConfig[0].Name[0].Service)
+	assert.Equal(t, "GetRootKey", config.MethodConfig[0].Name[0].Method)
+
+	raw, err = retryServiceConfig(1)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"methodConfig":[]}`, raw)
+}
+
+func TestUnconfiguredConnection(t *testing.T) {
+	conn := NewUnconfiguredConnection()
+	assert.False(t, conn.Configured())
+	require.NoError(t, conn.Probe(t.Context()))
+
+	_, err := NewKeyClient(conn).GetKey(t.Context(), "key-1")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	require.NoError(t, conn.Close())
+}
@@ -0,0 +1,116 @@
+package fake
+
+import (
+	"context"
+	"slices"
+
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The share of every node of a key is backed up in backupShares shares, requiredBackupShares of them recover it.
+const (
+	backupShares         = 3
+	requiredBackupShares = 2
+)
+
+type backupServer struct {
+	infra.UnimplementedBackupServiceServer
+	server *Server
+}
+
+// RecoverMPCShare reports the share of a node of the key recovered, the single-party key is left as is. Shares whose
+// backup shares were lost are not recoverable.
+func (b *backupServer) RecoverMPCShare(_ context.Context, req *infra.RecoverMPCShareRequest) (*infra.RecoverMPCShareResponse, error) {
+	b.server.mu.Lock()
+	defer b.server.mu.Unlock()
+
+	found, err := b.server.key(req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(found.nodes, req.GetNodeId()) {
+		return nil, status.Errorf(codes.NotFound, "node %s holds no share of key %s", req.GetNodeId(), req.GetKeyId())
+	}
+	if found.backupShares(req.GetNodeId()) < requiredBackupShares {
+		return nil, status.Errorf(codes.FailedPrecondition, "too few backup shares of node %s remain", req.GetNodeId())
+	}
+
+	return &infra.RecoverMPCShareResponse{
+		KeyId:   req.GetKeyId(),
+		NodeId:  req.GetNodeId(),
+		Success: true,
+	}, nil
+}
+
+// GetBackupStatus reports the shares of all nodes of the key recoverable, unless their backup shares were lost.
+func (b *backupServer) GetBackupStatus(_ context.Context, req *infra.GetBackupStatusRequest) (*infra.GetBackupStatusResponse, error) {
+	b.server.mu.Lock()
+	defer b.server.mu.Unlock()
+
+	found, err := b.server.key(req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &infra.GetBackupStatusResponse{
+		KeyId:    req.GetKeyId(),
+		Statuses: make([]*infra.BackupStatus, 0, len(found.nodes)),
+	}
+	for _, nodeID := range found.nodes {
+		total := found.backupShares(nodeID)
+		resp.Statuses = append(resp.Statuses, &infra.BackupStatus{
+			NodeId:         nodeID,
+			TotalShares:    int32(total), //nolint:gosec
+			RequiredShares: requiredBackupShares,
+			Recoverable:    total >= requiredBackupShares,
+		})
+	}
+
+	return resp, nil
+}
+
+func (b *backupServer) ListBackupShares(_ context.Context, req *infra.ListBackupSharesRequest) (*infra.ListBackupSharesResponse, error) {
+	b.server.mu.Lock()
+	defer b.server.mu.Unlock()
+
+	found, err := b.server.key(req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &infra.ListBackupSharesResponse{
+		KeyId:        req.GetKeyId(),
+		SharesByNode: make(map[string]*infra.BackupShares, len(found.nodes)),
+	}
+	for _, nodeID := range found.nodes {
+		if req.GetNodeId() != "" && req.GetNodeId() != nodeID {
+			continue
+		}
+
+		shares := &infra.BackupShares{
+			NodeId: nodeID,
+			Shares: make([]*infra.BackupShare, 0, backupShares),
+		}
+		for i := 1; i <= found.backupShares(nodeID); i++ {
+			shares.Shares = append(shares.Shares, &infra.BackupShare{
+				KeyId:      req.GetKeyId(),
+				NodeId:     nodeID,
+				ShareIndex: int32(i), //nolint:gosec
+				CreatedAt:  found.meta.GetCreatedAt(),
+			})
+		}
+		resp.SharesByNode[nodeID] = shares
+	}
+
+	return resp, nil
+}
+
+// backupShares returns the number of backup shares of the node of the key. The caller must hold the lock.
+func (k *key) backupShares(nodeID string) int {
+	if _, ok := k.lostBackups[nodeID]; ok {
+		return 1
+	}
+	return backupShares
+}
@@ -0,0 +1,191 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Defaults of keys created without threshold or total nodes, as applied by the MPC server.
+const (
+	defaultThreshold  = 2
+	defaultTotalNodes = 3
+)
+
+const keyStatusActive = "Active"
+
+type keyServer struct {
+	infra.UnimplementedKeyServiceServer
+	server *Server
+}
+
+func (k *keyServer) CreateRootKey(_ context.Context, req *infra.CreateRootKeyRequest) (*infra.CreateRootKeyResponse, error) {
+	protocol := strings.ToLower(req.GetProtocol())
+	if protocol == "" {
+		protocol = mpc.ProtocolGG20
+		if strings.EqualFold(req.GetCurve(), mpc.CurveEd25519) {
+			protocol = mpc.ProtocolFROST
+		}
+	}
+	algorithm, ok := mpc.Algorithm(protocol, strings.ToLower(req.GetCurve()))
+	if !ok || !strings.EqualFold(algorithm, req.GetAlgorithm()) {
+		return nil, status.Errorf(codes.InvalidArgument, "protocol %s does not support %s on %s", protocol, req.GetAlgorithm(), req.GetCurve())
+	}
+
+	threshold, totalNodes := int(req.GetThreshold()), int(req.GetTotalNodes())
+	if threshold == 0 {
+		threshold = defaultThreshold
+	}
+	if totalNodes == 0 {
+		totalNodes = defaultTotalNodes
+	}
+	if threshold < 1 || threshold > totalNodes {
+		return nil, status.Errorf(codes.InvalidArgument, "threshold %d of %d nodes is invalid", threshold, totalNodes)
+	}
+
+	signer, err := newSigner(algorithm, req.GetCurve())
+	if err != nil {
+		if errors.Is(err, ErrUnsupportedKey) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	keyID := req.GetKeyId()
+	if keyID == "" {
+		keyID = uuid.New().String()
+	}
+
+	k.server.mu.Lock()
+	defer k.server.mu.Unlock()
+
+	if _, ok := k.server.keys[keyID]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "key %s already exists", keyID)
+	}
+
+	now := k.server.timestamp()
+	created := &key{
+		meta: &infra.RootKeyMetadata{
+			KeyId:       keyID,
+			PublicKey:   signer.publicKey(),
+			Algorithm:   algorithm,
+			Curve:       strings.ToLower(req.GetCurve()),
+			Threshold:   int32(threshold),  //nolint:gosec
+			TotalNodes:  int32(totalNodes), //nolint:gosec
+			Protocol:    protocol,
+			Status:      keyStatusActive,
+			Description: req.GetDescription(),
+			Tags:        req.GetTags(),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		signer: signer,
+		nodes:  make([]string, 0, totalNodes),
+	}
+	for i := 1; i <= totalNodes; i++ {
+		nodeID := fmt.Sprintf("server-proxy-%d", i)
+		created.nodes = append(created.nodes, nodeID)
+		k.server.registerServerNode(nodeID)
+	}
+	k.server.keys[keyID] = created
+
+	return &infra.CreateRootKeyResponse{
+		Key: proto.CloneOf(created.meta),
+	}, nil
+}
+
+func (k *keyServer) GetRootKey(_ context.Context, req *infra.GetRootKeyRequest) (*infra.GetRootKeyResponse, error) {
+	k.server.mu.Lock()
+	defer k.server.mu.Unlock()
+
+	found, err := k.server.key(req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &infra.GetRootKeyResponse{
+		Key: proto.CloneOf(found.meta),
+	}, nil
+}
+
+func (k *keyServer) DeleteRootKey(_ context.Context, req *infra.DeleteRootKeyRequest) (*infra.StatusResponse, error) {
+	k.server.mu.Lock()
+	defer k.server.mu.Unlock()
+
+	if _, err := k.server.key(req.GetKeyId()); err != nil {
+		return nil, err
+	}
+	delete(k.server.keys, req.GetKeyId())
+
+	return &infra.StatusResponse{
+		Success: true,
+	}, nil
+}
+
+func (k *keyServer) ListRootKeys(_ context.Context, req *infra.ListRootKeysRequest) (*infra.ListRootKeysResponse, error) {
+	k.server.mu.Lock()
+	defer k.server.mu.Unlock()
+
+	var keys []*infra.RootKeyMetadata
+	for _, found := range k.server.sortedKeys() {
+		if req.GetStatus() == "" || strings.EqualFold(found.meta.GetStatus(), req.GetStatus()) {
+			keys = append(keys, proto.CloneOf(found.meta))
+		}
+	}
+
+	total := len(keys)
+	offset := min(max(int(req.GetPagination().GetOffset()), 0), total)
+	end := total
+	if limit := int(req.GetPagination().GetLimit()); limit > 0 {
+		end = min(offset+limit, total)
+	}
+
+	return &infra.ListRootKeysResponse{
+		Keys: keys[offset:end],
+		Pagination: &infra.PaginationResponse{
+			Total:  int32(total), //nolint:gosec
+			Limit:  req.GetPagination().GetLimit(),
+			Offset: int32(offset), //nolint:gosec
+		},
+	}, nil
+}
+
+// RefreshRootKey moves the key to the requested epoch. The single-party key is kept, as is the public key of shares
+// refreshed by the MPC server.
+func (k *keyServer) RefreshRootKey(_ context.Context, req *infra.RefreshRootKeyRequest) (*infra.RefreshRootKeyResponse, error) {
+	k.server.mu.Lock()
+	defer k.server.mu.Unlock()
+
+	found, err := k.server.key(req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetEpoch() <= found.epoch {
+		return nil, status.Errorf(codes.FailedPrecondition, "key %s is at epoch %d already", req.GetKeyId(), found.epoch)
+	}
+
+	found.epoch = req.GetEpoch()
+	found.meta.UpdatedAt = k.server.timestamp()
+
+	return &infra.RefreshRootKeyResponse{
+		Key:   proto.CloneOf(found.meta),
+		Epoch: found.epoch,
+	}, nil
+}
+
+// key returns the key, failing with codes.NotFound if it does not exist. The caller must hold the lock.
+func (s *Server) key(keyID string) (*key, error) {
+	found, ok := s.keys[keyID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", keyID)
+	}
+	return found, nil
+}
@@ -0,0 +1,161 @@
+package fake
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secpecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+)
+
+// ErrUnsupportedKey is returned for keys the fake MPC server cannot generate, e.g. Schnorr keys.
+var ErrUnsupportedKey = errors.New("key is not supported by the fake MPC server")
+
+// ErrInvalidSignature is returned if a signature does not verify.
+var ErrInvalidSignature = errors.New("signature is not valid")
+
+// signer is the single-party key standing in for the shares of a key of the MPC server.
+type signer interface {
+	// publicKey returns the hex encoded public key, compressed for ECDSA keys.
+	publicKey() string
+	// sign returns the hex encoded signature of the message.
+	sign(message []byte) (string, error)
+}
+
+type secp256k1Signer struct {
+	key *secp256k1.PrivateKey
+}
+
+func (s *secp256k1Signer) publicKey() string {
+	return hex.EncodeToString(s.key.PubKey().SerializeCompressed())
+}
+
+func (s *secp256k1Signer) sign(message []byte) (string, error) {
+	digest := sha256.Sum256(message)
+	sig := secpecdsa.Sign(s.key, digest[:])
+	r, ss := sig.R(), sig.S()
+	rb, sb := r.Bytes(), ss.Bytes()
+
+	return hex.EncodeToString(append(rb[:], sb[:]...)), nil
+}
+
+type p256Signer struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *p256Signer) publicKey() string {
+	return hex.EncodeToString(elliptic.MarshalCompressed(elliptic.P256(), s.key.X, s.key.Y))
+}
+
+func (s *p256Signer) sign(message []byte) (string, error) {
+	digest := sha256.Sum256(message)
+	r, ss, err := ecdsa.Sign(rand.Reader, s.key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign: %w", err)
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	ss.FillBytes(sig[32:])
+	return hex.EncodeToString(sig), nil
+}
+
+type ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+func (s *ed25519Signer) publicKey() string {
+	pub, _ := s.key.Public().(ed25519.PublicKey)
+	return hex.EncodeToString(pub)
+}
+
+func (s *ed25519Signer) sign(message []byte) (string, error) {
+	return hex.EncodeToString(ed25519.Sign(s.key, message)), nil
+}
+
+// newSigner generates the key of the algorithm on the curve.
+func newSigner(algorithm string, curve string) (signer, error) {
+	switch {
+	case strings.EqualFold(algorithm, mpc.AlgorithmECDSA) && strings.EqualFold(curve, mpc.CurveSecp256k1):
+		key, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate secp256k1 key: %w", err)
+		}
+		return &secp256k1Signer{key: key}, nil
+	case strings.EqualFold(algorithm, mpc.AlgorithmECDSA) && strings.EqualFold(curve, mpc.CurveSecp256r1):
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate secp256r1 key: %w", err)
+		}
+		return &p256Signer{key: key}, nil
+	case strings.EqualFold(algorithm, mpc.AlgorithmEdDSA) && strings.EqualFold(curve, mpc.CurveEd25519):
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ed25519 key: %w", err)
+		}
+		return &ed25519Signer{key: key}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s on %s", ErrUnsupportedKey, algorithm, curve)
+	}
+}
+
+// Verify verifies the hex encoded signature of the message by the hex encoded public key of a key on the curve, as
+// created by the fake MPC server. ECDSA signatures are the 64 bytes of r and s over the SHA-256 digest of the
+// message, EdDSA signatures are over the message itself.
+func Verify(curve string, publicKey string, message []byte, signature string) error {
+	pub, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return fmt.Errorf("failed to decode public key: %w", err)
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(sig) != 64 {
+		return fmt.Errorf("%w: %d bytes", ErrInvalidSignature, len(sig))
+	}
+
+	var valid bool
+	switch strings.ToLower(curve) {
+	case mpc.CurveSecp256k1:
+		key, err := secp256k1.ParsePubKey(pub)
+		if err != nil {
+			return fmt.Errorf("failed to parse secp256k1 public key: %w", err)
+		}
+		var r, s secp256k1.ModNScalar
+		if r.SetByteSlice(sig[:32]) || s.SetByteSlice(sig[32:]) {
+			return fmt.Errorf("%w: scalar overflows", ErrInvalidSignature)
+		}
+		digest := sha256.Sum256(message)
+		valid = secpecdsa.NewSignature(&r, &s).Verify(digest[:], key)
+	case mpc.CurveSecp256r1:
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pub)
+		if x == nil {
+			return errors.New("failed to parse secp256r1 public key")
+		}
+		digest := sha256.Sum256(message)
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		valid = ecdsa.Verify(key, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]))
+	case mpc.CurveEd25519:
+		if len(pub) != ed25519.PublicKeySize {
+			return fmt.Errorf("failed to parse ed25519 public key: %d bytes", len(pub))
+		}
+		valid = ed25519.Verify(pub, message, sig)
+	default:
+		return fmt.Errorf("%w: curve %s", ErrUnsupportedKey, curve)
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package fake
+
+import (
+	"context"
+	"sort"
+
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Types of the nodes registered with the fake MPC server.
+const (
+	nodeTypeServer = "server"
+	nodeTypeClient = "client"
+)
+
+type nodeServer struct {
+	infra.UnimplementedNodeServiceServer
+	server *Server
+}
+
+// registerServerNode registers the server node holding a share of a key, if not yet registered. The caller must hold
+// the lock.
+func (s *Server) registerServerNode(nodeID string) {
+	if _, ok := s.nodes[nodeID]; ok {
+		return
+	}
+
+	s.nodes[nodeID] = &node{
+		info: &infra.NodeInfo{
+			NodeId: nodeID,
+			Type:   nodeTypeServer,
+			Status: mpc.NodeStatusOnline,
+		},
+		server: true,
+	}
+}
+
+// RegisterNode registers the device as client node, registering it again replaces the node.
+func (n *nodeServer) RegisterNode(_ context.Context, req *infra.RegisterNodeRequest) (*infra.RegisterNodeResponse, error) {
+	if req.GetDeviceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "device ID is required")
+	}
+
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	nodeType := req.GetType()
+	if nodeType == "" {
+		nodeType = nodeTypeClient
+	}
+	nodeID := nodeType + "-" + req.GetDeviceId()
+	now := n.server.now()
+	n.server.nodes[nodeID] = &node{
+		info: &infra.NodeInfo{
+			NodeId:        nodeID,
+			Type:          nodeType,
+			Status:        mpc.NodeStatusOnline,
+			Version:       req.GetVersion(),
+			LastHeartbeat: timestamppb.New(now),
+			Metadata:      req.GetMetadata(),
+		},
+		publicKey: req.GetPublicKey(),
+	}
+
+	return &infra.RegisterNodeResponse{
+		NodeId:       nodeID,
+		Status:       mpc.NodeStatusOnline,
+		RegisteredAt: timestamppb.New(now),
+	}, nil
+}
+
+func (n *nodeServer) Heartbeat(_ context.Context, req *infra.HeartbeatRequest) (*infra.HeartbeatResponse, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	found, ok := n.server.nodes[req.GetNodeId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "node %s not found", req.GetNodeId())
+	}
+	if !found.server {
+		found.info.Status = req.GetStatus()
+		found.info.LastHeartbeat = timestamppb.New(n.server.now())
+	}
+
+	return &infra.HeartbeatResponse{
+		Success: true,
+	}, nil
+}
+
+// ListNodes lists all nodes matching the filter in a single page, server nodes are reported with a heartbeat just
+// sent.
+func (n *nodeServer) ListNodes(_ context.Context, req *infra.ListNodesRequest) (*infra.ListNodesResponse, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	now := timestamppb.New(n.server.now())
+	resp := &infra.ListNodesResponse{}
+	for _, found := range n.server.nodes {
+		if (req.GetType() != "" && found.info.GetType() != req.GetType()) ||
+			(req.GetStatus() != "" && found.info.GetStatus() != req.GetStatus()) {
+			continue
+		}
+
+		info := proto.CloneOf(found.info)
+		if found.server {
+			info.LastHeartbeat = now
+		}
+		resp.Nodes = append(resp.Nodes, info)
+	}
+	sort.Slice(resp.Nodes, func(i, j int) bool {
+		return resp.Nodes[i].GetNodeId() < resp.Nodes[j].GetNodeId()
+	})
+
+	return resp, nil
+}
+
+func (n *nodeServer) GetNodeConnectionInfo(_ context.Context, req *infra.GetNodeConnectionInfoRequest) (*infra.GetNodeConnectionInfoResponse, error) {
+	n.server.mu.Lock()
+	defer n.server.mu.Unlock()
+
+	found, ok := n.server.nodes[req.GetNodeId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "node %s not found", req.GetNodeId())
+	}
+
+	return &infra.GetNodeConnectionInfoResponse{
+		Address:   found.info.GetAddress(),
+		Protocol:  "grpc",
+		PublicKey: found.publicKey,
+	}, nil
+}
@@ -0,0 +1,141 @@
+// Package fake implements an in-memory MPC server for integration tests and local development.
+//
+// Keys are single-party secp256k1, secp256r1 and ed25519 keys standing in for the shares of the nodes, so signatures
+// are real and verify against the public keys reported. Nothing is persisted and WebAuthn tokens passed for signing
+// are not verified, the fake must never be used in production.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// bufferSize of the in-process connection to the fake MPC server.
+const bufferSize = 1 << 20
+
+// Server holds the keys and nodes of the fake MPC server.
+type Server struct {
+	now func() time.Time
+
+	mu    sync.Mutex
+	keys  map[string]*key
+	nodes map[string]*node
+}
+
+type key struct {
+	meta   *infra.RootKeyMetadata
+	signer signer
+	epoch  int64
+	// nodes holding a share of the key, those are reported as online server nodes.
+	nodes []string
+	// lostBackups are the nodes of the key whose share is no longer recoverable from its backup shares.
+	lostBackups map[string]struct{}
+}
+
+type node struct {
+	info *infra.NodeInfo
+	// server nodes are always online, they are reported with a heartbeat at the time they are listed.
+	server    bool
+	publicKey string
+}
+
+// NewServer returns an empty fake MPC server.
+func NewServer() *Server {
+	return &Server{
+		now:   time.Now,
+		keys:  make(map[string]*key),
+		nodes: make(map[string]*node),
+	}
+}
+
+// Register registers the services of the fake MPC server.
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	infra.RegisterKeyServiceServer(registrar, &keyServer{server: s})
+	infra.RegisterSigningServiceServer(registrar, &signingServer{server: s})
+	infra.RegisterBackupServiceServer(registrar, &backupServer{server: s})
+	infra.RegisterNodeServiceServer(registrar, &nodeServer{server: s})
+}
+
+// Connect serves the fake MPC server in process, returning the connection to it. The server is stopped once the
+// connection is closed.
+func (s *Server) Connect() (*mpc.Connection, error) {
+	listener := bufconn.Listen(bufferSize)
+	srv := grpc.NewServer()
+	s.Register(srv)
+	go func() {
+		// Serve only returns once the server is stopped.
+		_ = srv.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///fake-mpc",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		srv.Stop()
+		return nil, fmt.Errorf("failed to connect to fake MPC server: %w", err)
+	}
+
+	return mpc.NewLocalConnection(conn, srv.Stop), nil
+}
+
+// NewConnection serves a new fake MPC server in process, returning the connection to it.
+func NewConnection() (*mpc.Connection, error) {
+	return NewServer().Connect()
+}
+
+// Epoch returns the epoch of the shares of the key, zero until refreshed.
+func (s *Server) Epoch(keyID string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if k, ok := s.keys[keyID]; ok {
+		return k.epoch
+	}
+	return 0
+}
+
+// LoseBackupShares drops all backup shares of the node of the key but one, so its share is no longer recoverable.
+func (s *Server) LoseBackupShares(keyID string, nodeID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if k, ok := s.keys[keyID]; ok {
+		if k.lostBackups == nil {
+			k.lostBackups = make(map[string]struct{})
+		}
+		k.lostBackups[nodeID] = struct{}{}
+	}
+}
+
+// timestamp formats the time as reported by the fake MPC server.
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+// sortedKeys returns the keys ordered by creation, then ID. The caller must hold the lock.
+func (s *Server) sortedKeys() []*key {
+	keys := make([]*key, 0, len(s.keys))
+	for _, k := range s.keys {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].meta.GetCreatedAt() != keys[j].meta.GetCreatedAt() {
+			return keys[i].meta.GetCreatedAt() < keys[j].meta.GetCreatedAt()
+		}
+		return keys[i].meta.GetKeyId() < keys[j].meta.GetKeyId()
+	})
+	return keys
+}
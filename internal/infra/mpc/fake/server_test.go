@@ -0,0 +1,163 @@
+package fake_test
+
+import (
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func connect(t *testing.T) (*fake.Server, *mpc.Connection) {
+	t.Helper()
+
+	server := fake.NewServer()
+	conn, err := server.Connect()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return server, conn
+}
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name string
+		spec mpc.KeySpec
+	}{
+		{
+			name: "ECDSA secp256k1",
+			spec: mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20, Threshold: 2, TotalNodes: 3},
+		},
+		{
+			name: "ECDSA secp256r1",
+			spec: mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256r1, Protocol: mpc.ProtocolGG18},
+		},
+		{
+			name: "EdDSA ed25519",
+			spec: mpc.KeySpec{Algorithm: mpc.AlgorithmEdDSA, Curve: mpc.CurveEd25519, Protocol: mpc.ProtocolFROST, Threshold: 3, TotalNodes: 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			_, conn := connect(t)
+			keys := mpc.NewKeyClient(conn)
+
+			key, err := keys.CreateKey(ctx, "key-1", tt.spec)
+			require.NoError(t, err)
+			require.NoError(t, mpc.CheckKey(key, tt.spec))
+			assert.Equal(t, "key-1", key.KeyID)
+
+			signature, err := mpc.NewSigningClient(conn).ThresholdSign(ctx, key.KeyID, "0xdeadbeef", "evm", nil)
+			require.NoError(t, err)
+
+			require.NoError(t, fake.Verify(key.Curve, key.PublicKey, []byte{0xde, 0xad, 0xbe, 0xef}, signature))
+			require.ErrorIs(t, fake.Verify(key.Curve, key.PublicKey, []byte{0xde, 0xad, 0xbe, 0xee}, signature), fake.ErrInvalidSignature)
+		})
+	}
+}
+
+func TestCreateKeyInvalid(t *testing.T) {
+	ctx := t.Context()
+	_, conn := connect(t)
+	keys := mpc.NewKeyClient(conn)
+
+	_, err := keys.CreateKey(ctx, "", mpc.KeySpec{Algorithm: mpc.AlgorithmEdDSA, Curve: mpc.CurveEd25519, Protocol: mpc.ProtocolGG20})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keys.CreateKey(ctx, "", mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1, Threshold: 4, TotalNodes: 3})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keys.CreateKey(ctx, "", mpc.KeySpec{Algorithm: mpc.AlgorithmSchnorr, Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolFROST})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = keys.CreateKey(ctx, "key-1", mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1})
+	require.NoError(t, err)
+	_, err = keys.CreateKey(ctx, "key-1", mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestKeyLifecycle(t *testing.T) {
+	ctx := t.Context()
+	server, conn := connect(t)
+	keys := mpc.NewKeyClient(conn)
+
+	spec := mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1}
+	key, err := keys.CreateKey(ctx, "", spec)
+	require.NoError(t, err)
+	assert.NotEmpty(t, key.KeyID)
+	assert.Equal(t, mpc.ProtocolGG20, key.Protocol)
+	assert.Equal(t, 2, key.Threshold)
+	assert.Equal(t, 3, key.TotalNodes)
+	_, err = keys.CreateKey(ctx, "key-2", spec)
+	require.NoError(t, err)
+
+	listed, err := keys.ListKeys(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, listed, 2)
+
+	refreshed, err := keys.RefreshKey(ctx, key.KeyID, key.PublicKey, 1)
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey, refreshed.PublicKey)
+	assert.Equal(t, int64(1), server.Epoch(key.KeyID))
+	_, err = keys.RefreshKey(ctx, key.KeyID, key.PublicKey, 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	require.NoError(t, keys.DeleteKey(ctx, key.KeyID))
+	_, err = keys.GetKey(ctx, key.KeyID)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = mpc.NewSigningClient(conn).ThresholdSign(ctx, key.KeyID, "deadbeef", "evm", nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBackupAndNodes(t *testing.T) {
+	ctx := t.Context()
+	server, conn := connect(t)
+
+	key, err := mpc.NewKeyClient(conn).CreateKey(ctx, "key-1", mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1})
+	require.NoError(t, err)
+
+	backups, err := mpc.NewBackupClient(conn, mpc.BackupConfig{})
+	require.NoError(t, err)
+	statuses, err := backups.GetBackupStatus(ctx, key.KeyID)
+	require.NoError(t, err)
+	require.Len(t, statuses, key.TotalNodes)
+	for _, s := range statuses {
+		assert.True(t, s.Recoverable)
+	}
+	shares, err := backups.ListBackupShares(ctx, key.KeyID)
+	require.NoError(t, err)
+	assert.Len(t, shares, key.TotalNodes)
+	require.NoError(t, backups.RecoverShare(ctx, key.KeyID, statuses[0].NodeID))
+	require.Error(t, backups.RecoverShare(ctx, key.KeyID, "unknown"))
+
+	// Shares of nodes whose backup shares were lost are no longer recoverable.
+	server.LoseBackupShares(key.KeyID, statuses[0].NodeID)
+	statuses, err = backups.GetBackupStatus(ctx, key.KeyID)
+	require.NoError(t, err)
+	assert.False(t, statuses[0].Recoverable)
+	assert.Less(t, statuses[0].TotalShares, statuses[0].RequiredShares)
+	assert.True(t, statuses[1].Recoverable)
+	shares, err = backups.ListBackupShares(ctx, key.KeyID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, shares[statuses[0].NodeID])
+	require.Error(t, backups.RecoverShare(ctx, key.KeyID, statuses[0].NodeID))
+
+	nodes := mpc.NewNodeClient(conn)
+	nodeID, err := nodes.RegisterNode(ctx, "device-1", "public-key", "client", "1.0.0", nil)
+	require.NoError(t, err)
+	_, err = nodes.Heartbeat(ctx, nodeID, mpc.NodeStatusOnline)
+	require.NoError(t, err)
+
+	listed, err := nodes.ListNodes(ctx, mpc.NodeFilter{Status: mpc.NodeStatusOnline})
+	require.NoError(t, err)
+	require.Len(t, listed, key.TotalNodes+1)
+	for _, n := range listed {
+		assert.False(t, n.LastHeartbeat.IsZero())
+	}
+}
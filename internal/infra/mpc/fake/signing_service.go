@@ -0,0 +1,120 @@
+package fake
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type signingServer struct {
+	infra.UnimplementedSigningServiceServer
+	server *Server
+}
+
+// ThresholdSign signs the message with the key, the nodes of the threshold are reported as participating. The auth
+// tokens are not verified.
+func (g *signingServer) ThresholdSign(_ context.Context, req *infra.ThresholdSignRequest) (*infra.ThresholdSignResponse, error) {
+	message, err := signedMessage(req.GetMessage(), req.GetMessageHex())
+	if err != nil {
+		return nil, err
+	}
+
+	g.server.mu.Lock()
+	defer g.server.mu.Unlock()
+
+	found, err := g.server.key(req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := found.signer.sign(message)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &infra.ThresholdSignResponse{
+		Signature:          signature,
+		KeyId:              found.meta.GetKeyId(),
+		PublicKey:          found.meta.GetPublicKey(),
+		Message:            hex.EncodeToString(message),
+		ChainType:          req.GetChainType(),
+		SessionId:          uuid.New().String(),
+		SignedAt:           g.server.timestamp(),
+		ParticipatingNodes: append([]string(nil), found.nodes[:found.meta.GetThreshold()]...),
+	}, nil
+}
+
+// BatchSign signs every message, messages failing to be signed are counted as failed.
+func (g *signingServer) BatchSign(ctx context.Context, req *infra.BatchSignRequest) (*infra.BatchSignResponse, error) {
+	resp := &infra.BatchSignResponse{
+		Total: int32(len(req.GetMessages())), //nolint:gosec
+	}
+	for _, msg := range req.GetMessages() {
+		signed, err := g.ThresholdSign(ctx, msg)
+		if err != nil {
+			resp.Failed++
+			continue
+		}
+		resp.Signatures = append(resp.Signatures, signed)
+		resp.Success++
+	}
+
+	return resp, nil
+}
+
+// VerifySignature verifies the signature by the public key of a key of the fake MPC server.
+func (g *signingServer) VerifySignature(_ context.Context, req *infra.VerifySignatureRequest) (*infra.VerifySignatureResponse, error) {
+	message, err := signedMessage(req.GetMessage(), req.GetMessageHex())
+	if err != nil {
+		return nil, err
+	}
+
+	g.server.mu.Lock()
+	var curve string
+	for _, k := range g.server.keys {
+		if k.meta.GetPublicKey() == req.GetPublicKey() {
+			curve = k.meta.GetCurve()
+			break
+		}
+	}
+	verifiedAt := g.server.timestamp()
+	g.server.mu.Unlock()
+
+	if curve == "" {
+		return nil, status.Error(codes.NotFound, "no key with the public key")
+	}
+
+	err = Verify(curve, req.GetPublicKey(), message, req.GetSignature())
+	if err != nil && !errors.Is(err, ErrInvalidSignature) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &infra.VerifySignatureResponse{
+		Valid:      err == nil,
+		PublicKey:  req.GetPublicKey(),
+		VerifiedAt: verifiedAt,
+	}, nil
+}
+
+// signedMessage returns the message to sign, passed either as is or hex encoded with optional 0x prefix.
+func signedMessage(message []byte, messageHex string) ([]byte, error) {
+	if len(message) > 0 {
+		return message, nil
+	}
+
+	decoded, err := hex.DecodeString(strings.TrimPrefix(messageHex, "0x"))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "message is not hex encoded: %v", err)
+	}
+	if len(decoded) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "message is empty")
+	}
+
+	return decoded, nil
+}
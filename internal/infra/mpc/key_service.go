@@ -2,32 +2,240 @@ package mpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
 	"google.golang.org/grpc"
 )
 
+// listKeysPageSize is the number of root keys requested per page.
+const listKeysPageSize = 100
+
+var (
+	// ErrKeyNotDeleted is returned if the MPC server refused to delete a root key.
+	ErrKeyNotDeleted = errors.New("key was not deleted")
+	// ErrKeySpecMismatch is returned if a key was not created as requested.
+	ErrKeySpecMismatch = errors.New("key does not match the requested spec")
+	// ErrKeyRefreshMismatch is returned if a refresh of the shares of a root key did not report the expected epoch or
+	// changed its public key.
+	ErrKeyRefreshMismatch = errors.New("key refresh does not match the requested epoch or key")
+)
+
+// Protocols supported by the MPC server.
+const (
+	ProtocolGG18  = "gg18"
+	ProtocolGG20  = "gg20"
+	ProtocolFROST = "frost"
+)
+
+// Curves and algorithms supported by the MPC server.
+const (
+	CurveSecp256k1 = "secp256k1"
+	CurveSecp256r1 = "secp256r1"
+	CurveEd25519   = "ed25519"
+
+	AlgorithmECDSA   = "ECDSA"
+	AlgorithmEdDSA   = "EdDSA"
+	AlgorithmSchnorr = "Schnorr"
+)
+
+// KeySpec configures the DKG of a root key. Protocol, Threshold and TotalNodes are left to the defaults of the MPC
+// server if empty.
+type KeySpec struct {
+	Algorithm  string
+	Curve      string
+	Protocol   string
+	Threshold  int
+	TotalNodes int
+}
+
 type KeyClient struct {
 	client infra.KeyServiceClient
 }
 
-func NewKeyClient(conn *grpc.ClientConn) *KeyClient {
+func NewKeyClient(conn grpc.ClientConnInterface) *KeyClient {
 	return &KeyClient{
 		client: infra.NewKeyServiceClient(conn),
 	}
 }
 
-func (c *KeyClient) CreateKey(ctx context.Context, keyID string, algorithm string, curve string) (string, error) {
-	req := &infra.CreateRootKeyRequest{
-		KeyId:     keyID,
-		Algorithm: algorithm,
-		Curve:     curve,
+// KeyInfo is the metadata of a root key as reported by the MPC server.
+type KeyInfo struct {
+	KeyID     string
+	PublicKey string
+	Algorithm string
+	Curve     string
+	// Threshold is the number of nodes required to sign with the key out of its TotalNodes.
+	Threshold   int
+	TotalNodes  int
+	Protocol    string
+	Status      string
+	Description string
+	Tags        map[string]string
+	// CreatedAt is passed on as reported, the MPC server does not specify its format.
+	CreatedAt string
+}
+
+// CreateKey runs the DKG of a root key with the given spec, returning its metadata as reported by the MPC server.
+func (c *KeyClient) CreateKey(ctx context.Context, keyID string, spec KeySpec) (*KeyInfo, error) {
+	resp, err := c.client.CreateRootKey(ctx, &infra.CreateRootKeyRequest{
+		KeyId:      keyID,
+		Algorithm:  spec.Algorithm,
+		Curve:      spec.Curve,
+		Protocol:   spec.Protocol,
+		Threshold:  int32(spec.Threshold),  //nolint:gosec
+		TotalNodes: int32(spec.TotalNodes), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapKeyInfo(resp.GetKey()), nil
+}
+
+func (c *KeyClient) GetKey(ctx context.Context, keyID string) (*KeyInfo, error) {
+	resp, err := c.client.GetRootKey(ctx, &infra.GetRootKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapKeyInfo(resp.GetKey()), nil
+}
+
+// ListKeys returns all root keys of the MPC server with the given status (empty for all), following its pages.
+func (c *KeyClient) ListKeys(ctx context.Context, status string) ([]*KeyInfo, error) {
+	var keys []*KeyInfo
+	for {
+		resp, err := c.client.ListRootKeys(ctx, &infra.ListRootKeysRequest{
+			Status: status,
+			Pagination: &infra.PaginationRequest{
+				Limit:  listKeysPageSize,
+				Offset: int32(len(keys)), //nolint:gosec
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range resp.GetKeys() {
+			keys = append(keys, mapKeyInfo(key))
+		}
+
+		if len(resp.GetKeys()) == 0 || len(keys) >= int(resp.GetPagination().GetTotal()) {
+			return keys, nil
+		}
+	}
+}
+
+// DeleteKey deletes the root key and its shares from the MPC server.
+func (c *KeyClient) DeleteKey(ctx context.Context, keyID string) error {
+	resp, err := c.client.DeleteRootKey(ctx, &infra.DeleteRootKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.GetSuccess() {
+		return fmt.Errorf("%w: %s", ErrKeyNotDeleted, resp.GetMessage())
 	}
 
-	resp, err := c.client.CreateRootKey(ctx, req)
+	return nil
+}
+
+// RefreshKey re-shares the root key among its nodes, moving its shares to the given epoch. The shares of earlier
+// epochs are invalidated by the MPC server while the public key, and thus all addresses derived from it, is kept.
+// The key is verified against the given public key, so a refresh never silently replaces a key.
+func (c *KeyClient) RefreshKey(ctx context.Context, keyID string, publicKey string, epoch int) (*KeyInfo, error) {
+	resp, err := c.client.RefreshRootKey(ctx, &infra.RefreshRootKeyRequest{
+		KeyId: keyID,
+		Epoch: int64(epoch),
+	})
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	if resp.GetEpoch() != int64(epoch) {
+		return nil, fmt.Errorf("%w: epoch %d instead of %d", ErrKeyRefreshMismatch, resp.GetEpoch(), epoch)
+	}
+	key := mapKeyInfo(resp.GetKey())
+	if err := CheckRefresh(key, keyID, publicKey); err != nil {
+		return nil, err
 	}
 
-	return resp.GetKey().GetPublicKey(), nil
+	return key, nil
+}
+
+// Algorithm returns the signature algorithm the protocol implements on the curve, false if the protocol does not
+// support the curve. Threshold ECDSA protocols work on the ECDSA curves, FROST signs Schnorr on secp256k1 and EdDSA on
+// ed25519.
+func Algorithm(protocol string, curve string) (string, bool) {
+	switch protocol {
+	case ProtocolGG18, ProtocolGG20:
+		if curve == CurveSecp256k1 || curve == CurveSecp256r1 {
+			return AlgorithmECDSA, true
+		}
+	case ProtocolFROST:
+		switch curve {
+		case CurveSecp256k1:
+			return AlgorithmSchnorr, true
+		case CurveEd25519:
+			return AlgorithmEdDSA, true
+		}
+	}
+	return "", false
+}
+
+// CheckKey verifies the key was created as requested by the spec, fields left to the defaults are not compared.
+func CheckKey(key *KeyInfo, spec KeySpec) error {
+	switch {
+	case key == nil:
+		return fmt.Errorf("%w: no key reported", ErrKeySpecMismatch)
+	case !strings.EqualFold(key.Curve, spec.Curve):
+		return fmt.Errorf("%w: curve %q instead of %q", ErrKeySpecMismatch, key.Curve, spec.Curve)
+	case !strings.EqualFold(key.Algorithm, spec.Algorithm):
+		return fmt.Errorf("%w: algorithm %q instead of %q", ErrKeySpecMismatch, key.Algorithm, spec.Algorithm)
+	case spec.Protocol != "" && !strings.EqualFold(key.Protocol, spec.Protocol):
+		return fmt.Errorf("%w: protocol %q instead of %q", ErrKeySpecMismatch, key.Protocol, spec.Protocol)
+	case spec.Threshold != 0 && key.Threshold != spec.Threshold:
+		return fmt.Errorf("%w: threshold %d instead of %d", ErrKeySpecMismatch, key.Threshold, spec.Threshold)
+	case spec.TotalNodes != 0 && key.TotalNodes != spec.TotalNodes:
+		return fmt.Errorf("%w: %d total nodes instead of %d", ErrKeySpecMismatch, key.TotalNodes, spec.TotalNodes)
+	case key.PublicKey == "":
+		return fmt.Errorf("%w: no public key reported", ErrKeySpecMismatch)
+	}
+	return nil
+}
+
+// CheckRefresh verifies the key reported after a refresh of its shares is the refreshed key with its public key
+// unchanged.
+func CheckRefresh(key *KeyInfo, keyID string, publicKey string) error {
+	switch {
+	case key == nil:
+		return fmt.Errorf("%w: no key reported", ErrKeyRefreshMismatch)
+	case key.KeyID != keyID:
+		return fmt.Errorf("%w: key %q instead of %q", ErrKeyRefreshMismatch, key.KeyID, keyID)
+	case key.PublicKey == "" || !strings.EqualFold(key.PublicKey, publicKey):
+		return fmt.Errorf("%w: public key changed", ErrKeyRefreshMismatch)
+	}
+	return nil
+}
+
+func mapKeyInfo(key *infra.RootKeyMetadata) *KeyInfo {
+	return &KeyInfo{
+		KeyID:       key.GetKeyId(),
+		PublicKey:   key.GetPublicKey(),
+		Algorithm:   key.GetAlgorithm(),
+		Curve:       key.GetCurve(),
+		Threshold:   int(key.GetThreshold()),
+		TotalNodes:  int(key.GetTotalNodes()),
+		Protocol:    key.GetProtocol(),
+		Status:      key.GetStatus(),
+		Description: key.GetDescription(),
+		Tags:        key.GetTags(),
+		CreatedAt:   key.GetCreatedAt(),
+	}
 }
@@ -0,0 +1,94 @@
+package mpc_test
+
+import (
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlgorithm(t *testing.T) {
+	algorithm, ok := mpc.Algorithm(mpc.ProtocolGG20, mpc.CurveSecp256k1)
+	assert.True(t, ok)
+	assert.Equal(t, mpc.AlgorithmECDSA, algorithm)
+
+	algorithm, ok = mpc.Algorithm(mpc.ProtocolGG18, mpc.CurveSecp256r1)
+	assert.True(t, ok)
+	assert.Equal(t, mpc.AlgorithmECDSA, algorithm)
+
+	algorithm, ok = mpc.Algorithm(mpc.ProtocolFROST, mpc.CurveEd25519)
+	assert.True(t, ok)
+	assert.Equal(t, mpc.AlgorithmEdDSA, algorithm)
+
+	algorithm, ok = mpc.Algorithm(mpc.ProtocolFROST, mpc.CurveSecp256k1)
+	assert.True(t, ok)
+	assert.Equal(t, mpc.AlgorithmSchnorr, algorithm)
+
+	_, ok = mpc.Algorithm(mpc.ProtocolGG20, mpc.CurveEd25519)
+	assert.False(t, ok)
+
+	_, ok = mpc.Algorithm(mpc.ProtocolFROST, mpc.CurveSecp256r1)
+	assert.False(t, ok)
+
+	_, ok = mpc.Algorithm("cmp", mpc.CurveSecp256k1)
+	assert.False(t, ok)
+}
+
+func TestCheckKey(t *testing.T) {
+	spec := mpc.KeySpec{
+		Algorithm:  mpc.AlgorithmECDSA,
+		Curve:      mpc.CurveSecp256k1,
+		Protocol:   mpc.ProtocolGG20,
+		Threshold:  2,
+		TotalNodes: 3,
+	}
+	key := &mpc.KeyInfo{
+		KeyID:      "key-1",
+		PublicKey:  "02a1b2",
+		Algorithm:  "ecdsa",
+		Curve:      mpc.CurveSecp256k1,
+		Protocol:   "GG20",
+		Threshold:  2,
+		TotalNodes: 3,
+	}
+	require.NoError(t, mpc.CheckKey(key, spec))
+
+	mismatch := *key
+	mismatch.TotalNodes = 2
+	assert.ErrorIs(t, mpc.CheckKey(&mismatch, spec), mpc.ErrKeySpecMismatch)
+
+	mismatch = *key
+	mismatch.Protocol = mpc.ProtocolGG18
+	assert.ErrorIs(t, mpc.CheckKey(&mismatch, spec), mpc.ErrKeySpecMismatch)
+
+	mismatch = *key
+	mismatch.PublicKey = ""
+	assert.ErrorIs(t, mpc.CheckKey(&mismatch, spec), mpc.ErrKeySpecMismatch)
+
+	assert.ErrorIs(t, mpc.CheckKey(nil, spec), mpc.ErrKeySpecMismatch)
+
+	// Fields left to the defaults of the MPC server are not compared.
+	defaults := *key
+	defaults.Protocol = mpc.ProtocolGG18
+	defaults.Threshold = 3
+	defaults.TotalNodes = 5
+	require.NoError(t, mpc.CheckKey(&defaults, mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1}))
+}
+
+func TestCheckRefresh(t *testing.T) {
+	key := &mpc.KeyInfo{
+		KeyID:     "key-1",
+		PublicKey: "02A1B2",
+	}
+	require.NoError(t, mpc.CheckRefresh(key, "key-1", "02a1b2"))
+
+	assert.ErrorIs(t, mpc.CheckRefresh(key, "key-2", "02a1b2"), mpc.ErrKeyRefreshMismatch)
+	assert.ErrorIs(t, mpc.CheckRefresh(key, "key-1", "03c4d5"), mpc.ErrKeyRefreshMismatch)
+	assert.ErrorIs(t, mpc.CheckRefresh(nil, "key-1", "02a1b2"), mpc.ErrKeyRefreshMismatch)
+
+	// Keys reported without public key were replaced.
+	missing := *key
+	missing.PublicKey = ""
+	assert.ErrorIs(t, mpc.CheckRefresh(&missing, "key-1", ""), mpc.ErrKeyRefreshMismatch)
+}
@@ -0,0 +1,121 @@
+package mpc
+
+import (
+	"context"
+	"time"
+
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"google.golang.org/grpc"
+)
+
+// NodeStatusOnline is reported by nodes ready to take part in protocol sessions.
+const NodeStatusOnline = "online"
+
+// listNodesPageSize is the number of nodes requested per page of the registry.
+const listNodesPageSize = 100
+
+type NodeClient struct {
+	client infra.NodeServiceClient
+}
+
+// Node is a participant registered with the MPC server.
+type Node struct {
+	ID      string
+	Type    string
+	Status  string
+	Version string
+	Address string
+	// LastHeartbeat is zero if the node never sent a heartbeat.
+	LastHeartbeat time.Time
+	Metadata      map[string]string
+}
+
+type NodeFilter struct {
+	// Type and Status filter the nodes, empty for all.
+	Type   string
+	Status string
+}
+
+func NewNodeClient(conn grpc.ClientConnInterface) *NodeClient {
+	return &NodeClient{
+		client: infra.NewNodeServiceClient(conn),
+	}
+}
+
+// Online reports whether the node is online and sent its last heartbeat within maxAge of now. Heartbeats are not
+// considered if maxAge is zero.
+func (n Node) Online(now time.Time, maxAge time.Duration) bool {
+	if n.Status != NodeStatusOnline {
+		return false
+	}
+	if maxAge <= 0 {
+		return true
+	}
+	return !n.LastHeartbeat.IsZero() && now.Sub(n.LastHeartbeat) <= maxAge
+}
+
+// RegisterNode registers the device as node of the given type, returning the ID assigned.
+func (c *NodeClient) RegisterNode(ctx context.Context, deviceID string, publicKey string, nodeType string, version string, metadata map[string]string) (string, error) {
+	resp, err := c.client.RegisterNode(ctx, &infra.RegisterNodeRequest{
+		DeviceId:  deviceID,
+		PublicKey: publicKey,
+		Type:      nodeType,
+		Version:   version,
+		Metadata:  metadata,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetNodeId(), nil
+}
+
+// Heartbeat reports the status of the node, returning the commands the MPC server has for it.
+func (c *NodeClient) Heartbeat(ctx context.Context, nodeID string, status string) ([]string, error) {
+	resp, err := c.client.Heartbeat(ctx, &infra.HeartbeatRequest{
+		NodeId: nodeID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetCommands(), nil
+}
+
+// ListNodes returns all nodes of the registry matching the filter, following its pages.
+func (c *NodeClient) ListNodes(ctx context.Context, filter NodeFilter) ([]Node, error) {
+	var nodes []Node
+	var pageToken string
+	for {
+		resp, err := c.client.ListNodes(ctx, &infra.ListNodesRequest{
+			Type:      filter.Type,
+			Status:    filter.Status,
+			PageSize:  listNodesPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range resp.GetNodes() {
+			node := Node{
+				ID:       n.GetNodeId(),
+				Type:     n.GetType(),
+				Status:   n.GetStatus(),
+				Version:  n.GetVersion(),
+				Address:  n.GetAddress(),
+				Metadata: n.GetMetadata(),
+			}
+			if n.GetLastHeartbeat() != nil {
+				node.LastHeartbeat = n.GetLastHeartbeat().AsTime()
+			}
+			nodes = append(nodes, node)
+		}
+
+		pageToken = resp.GetNextPageToken()
+		if pageToken == "" {
+			return nodes, nil
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package mpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeOnline(t *testing.T) {
+	now := time.Unix(1717243200, 0)
+	node := mpc.Node{
+		ID:            "server-1",
+		Status:        mpc.NodeStatusOnline,
+		LastHeartbeat: now.Add(-30 * time.Second),
+	}
+
+	assert.True(t, node.Online(now, time.Minute))
+	assert.False(t, node.Online(now, 10*time.Second))
+
+	// Heartbeats are not considered without maximum age.
+	assert.True(t, node.Online(now, 0))
+
+	// Nodes never sending a heartbeat are offline.
+	node.LastHeartbeat = time.Time{}
+	assert.False(t, node.Online(now, time.Minute))
+
+	node.LastHeartbeat = now
+	node.Status = "busy"
+	assert.False(t, node.Online(now, time.Minute))
+	assert.False(t, node.Online(now, 0))
+}
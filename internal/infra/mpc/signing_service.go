@@ -12,7 +12,7 @@ type SigningClient struct {
 	client infra.SigningServiceClient
 }
 
-func NewSigningClient(conn *grpc.ClientConn) *SigningClient {
+func NewSigningClient(conn grpc.ClientConnInterface) *SigningClient {
 	return &SigningClient{
 		client: infra.NewSigningServiceClient(conn),
 	}
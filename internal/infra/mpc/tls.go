@@ -0,0 +1,143 @@
+package mpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// certReloader holds the CA and client certificate of the connection to the MPC server, reloading them once one of
+// their files changed on disk. Rotated certificates are thus picked up by the next handshake without a restart.
+type certReloader struct {
+	caCertFile string
+	certFile   string
+	keyFile    string
+
+	mu      sync.Mutex
+	modTime time.Time
+	roots   *x509.CertPool
+	cert    *tls.Certificate
+}
+
+func newCertReloader(caCertFile string, certFile string, keyFile string) (*certReloader, error) {
+	r := &certReloader{
+		caCertFile: caCertFile,
+		certFile:   certFile,
+		keyFile:    keyFile,
+	}
+	if _, _, err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// load returns the CA pool and client certificate, reloading them if a file was modified since they were loaded last.
+// The certificates loaded before are kept if reloading fails, e.g. while the files are being replaced.
+func (r *certReloader) load() (*x509.CertPool, *tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var modTime time.Time
+	for _, file := range []string{r.caCertFile, r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			if r.cert != nil {
+				return r.roots, r.cert, nil
+			}
+			return nil, nil, fmt.Errorf("failed to stat %s: %w", file, err)
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	if r.cert != nil && !modTime.After(r.modTime) {
+		return r.roots, r.cert, nil
+	}
+
+	roots, cert, err := loadCerts(r.caCertFile, r.certFile, r.keyFile)
+	if err != nil {
+		if r.cert != nil {
+			return r.roots, r.cert, nil
+		}
+		return nil, nil, err
+	}
+
+	r.modTime = modTime
+	r.roots = roots
+	r.cert = cert
+	return roots, cert, nil
+}
+
+// tlsConfig returns the TLS config presenting the current client certificate and verifying the MPC server against
+// the current CA. Without serverName the server is verified against the name of the handshake, i.e. the host of the
+// address dialed.
+func (r *certReloader) tlsConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			_, cert, err := r.load()
+			return cert, err
+		},
+		// The default verification is replaced by VerifyConnection, as it cannot pick up a reloaded CA.
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			roots, _, err := r.load()
+			if err != nil {
+				return err
+			}
+			name := serverName
+			if name == "" {
+				name = cs.ServerName
+			}
+			return verifyServerCert(cs, roots, name)
+		},
+	}
+}
+
+func verifyServerCert(cs tls.ConnectionState, roots *x509.CertPool, serverName string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("MPC server presented no certificate")
+	}
+	// An empty name would skip the hostname check, accepting any certificate issued by the CA.
+	if serverName == "" {
+		return errors.New("no server name to verify the MPC server certificate against")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       serverName,
+	}); err != nil {
+		return fmt.Errorf("failed to verify MPC server certificate: %w", err)
+	}
+
+	return nil
+}
+
+func loadCerts(caCertFile string, certFile string, keyFile string) (*x509.CertPool, *tls.Certificate, error) {
+	caCert, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CA cert: %w", err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCert) {
+		return nil, nil, errors.New("failed to append CA cert")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load client cert/key: %w", err)
+	}
+
+	return roots, &cert, nil
+}
@@ -0,0 +1,162 @@
+package mpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{name},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) write(t *testing.T, certFile string, keyFile string, modTime time.Time) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	if keyFile == "" {
+		return
+	}
+
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+
+	modTime := time.Now().Add(-time.Minute)
+	ca := newTestCert(t, "ca", nil)
+	ca.write(t, caFile, "", modTime)
+	client := newTestCert(t, "client", ca)
+	client.write(t, certFile, keyFile, modTime)
+
+	reloader, err := newCertReloader(caFile, certFile, keyFile)
+	require.NoError(t, err)
+
+	roots, cert, err := reloader.load()
+	require.NoError(t, err)
+	assert.Equal(t, client.cert.Raw, cert.Certificate[0])
+
+	server := newTestCert(t, "mpc-server", ca)
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{server.cert}}
+	require.NoError(t, verifyServerCert(state, roots, "mpc-server"))
+	require.Error(t, verifyServerCert(state, roots, "other-server"))
+	require.Error(t, verifyServerCert(state, roots, ""))
+
+	// Without configured server name the name of the handshake is verified.
+	verify := reloader.tlsConfig("").VerifyConnection
+	require.NoError(t, verify(tls.ConnectionState{PeerCertificates: state.PeerCertificates, ServerName: "mpc-server"}))
+	require.Error(t, verify(tls.ConnectionState{PeerCertificates: state.PeerCertificates, ServerName: "other-server"}))
+	require.Error(t, verify(state))
+
+	// Rotated certificates are picked up once modified.
+	rotated := newTestCert(t, "client", ca)
+	rotated.write(t, certFile, keyFile, modTime.Add(30*time.Second))
+	_, cert, err = reloader.load()
+	require.NoError(t, err)
+	assert.Equal(t, rotated.cert.Raw, cert.Certificate[0])
+
+	// Servers of a rotated CA are verified against it.
+	rotatedCA := newTestCert(t, "ca", nil)
+	rotatedCA.write(t, caFile, "", modTime.Add(40*time.Second))
+	roots, _, err = reloader.load()
+	require.NoError(t, err)
+	require.Error(t, verifyServerCert(state, roots, "mpc-server"))
+	rotatedServer := newTestCert(t, "mpc-server", rotatedCA)
+	require.NoError(t, verifyServerCert(tls.ConnectionState{PeerCertificates: []*x509.Certificate{rotatedServer.cert}}, roots, "mpc-server"))
+
+	// Certificates failing to load are ignored in favor of those loaded before.
+	require.NoError(t, os.WriteFile(keyFile, []byte("invalid"), 0o600))
+	_, cert, err = reloader.load()
+	require.NoError(t, err)
+	assert.Equal(t, rotated.cert.Raw, cert.Certificate[0])
+
+	_, err = newCertReloader(caFile, certFile, keyFile)
+	require.Error(t, err)
+}
+
+func TestNewClientConnection(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+
+	ca := newTestCert(t, "ca", nil)
+	ca.write(t, caFile, "", time.Now())
+	newTestCert(t, "client", ca).write(t, certFile, keyFile, time.Now())
+
+	conn, err := NewClientConnection(Config{
+		Address:                 "127.0.0.1:1",
+		ServerName:              "mpc-server",
+		CACertFile:              caFile,
+		CertFile:                certFile,
+		KeyFile:                 keyFile,
+		CallTimeout:             time.Second,
+		ProtocolTimeout:         time.Second,
+		MaxRetryAttempts:        3,
+		KeepaliveTime:           5 * time.Minute,
+		KeepaliveTimeout:        20 * time.Second,
+		BreakerFailureThreshold: 5,
+		BreakerOpenTimeout:      30 * time.Second,
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.True(t, conn.Configured())
+
+	// Nothing listens on the address.
+	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancel()
+	require.Error(t, conn.Probe(ctx))
+}
@@ -0,0 +1,138 @@
+package tokenmeta
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+)
+
+// Selectors of the optional ERC20 metadata functions, the first 4 bytes of the keccak256 of their signature.
+const (
+	selectorName     = "0x06fdde03" // name()
+	selectorSymbol   = "0x95d89b41" // symbol()
+	selectorDecimals = "0x313ce567" // decimals()
+)
+
+func (r *resolver) resolveEVM(ctx context.Context, rpcURL string, contractAddress string) (*Metadata, error) {
+	raw, err := r.ethCall(ctx, rpcURL, contractAddress, selectorDecimals)
+	if err != nil {
+		var rpcErr *jsonrpc.RPCError
+		if errors.As(err, &rpcErr) {
+			// Reverted calls are reported as RPC errors, the contract does not implement decimals().
+			return nil, ErrNotToken
+		}
+		return nil, err
+	}
+	decimals, err := decodeUint8(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// Name and symbol are optional within ERC20, some tokens return bytes32 instead of string.
+	name, err := r.ethCallString(ctx, rpcURL, contractAddress, selectorName)
+	if err != nil {
+		return nil, err
+	}
+	symbol, err := r.ethCallString(ctx, rpcURL, contractAddress, selectorSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metadata{
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: decimals,
+	}, nil
+}
+
+func (r *resolver) ethCall(ctx context.Context, rpcURL string, to string, data string) ([]byte, error) {
+	var result string
+	if err := r.client.Call(ctx, rpcURL, "eth_call", []interface{}{
+		map[string]string{"to": to, "data": data},
+		"latest",
+	}, &result); err != nil {
+		return nil, err
+	}
+
+	raw, err := hex.DecodeString(strings.TrimPrefix(result, "0x"))
+	if err != nil {
+		return nil, ErrNotToken
+	}
+	return raw, nil
+}
+
+// ethCallString calls a function returning a string, empty if the function is not implemented.
+func (r *resolver) ethCallString(ctx context.Context, rpcURL string, to string, data string) (string, error) {
+	raw, err := r.ethCall(ctx, rpcURL, to, data)
+	if err != nil {
+		var rpcErr *jsonrpc.RPCError
+		if errors.As(err, &rpcErr) {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(raw) == 0 {
+		return "", nil
+	}
+	return decodeString(raw)
+}
+
+// decodeUint8 decodes an ABI encoded uint8, which is padded to a 32 bytes word.
+func decodeUint8(raw []byte) (int, error) {
+	if len(raw) != 32 {
+		return 0, ErrNotToken
+	}
+	for _, b := range raw[:31] {
+		if b != 0 {
+			return 0, ErrNotToken
+		}
+	}
+	return int(raw[31]), nil
+}
+
+// decodeString decodes an ABI encoded string (offset, length and data) or a bytes32 padded with zeros.
+func decodeString(raw []byte) (string, error) {
+	var res []byte
+	switch {
+	case len(raw) == 32:
+		res = []byte(strings.TrimRight(string(raw), "\x00"))
+	case len(raw) >= 64:
+		offset := new(big.Int).SetBytes(raw[:32])
+		if !offset.IsInt64() || offset.Int64()+32 > int64(len(raw)) {
+			return "", ErrNotToken
+		}
+		start := offset.Int64() + 32
+		length := new(big.Int).SetBytes(raw[offset.Int64():start])
+		if !length.IsInt64() || start+length.Int64() > int64(len(raw)) {
+			return "", ErrNotToken
+		}
+		res = raw[start : start+length.Int64()]
+	default:
+		return "", ErrNotToken
+	}
+
+	if !utf8.Valid(res) {
+		return "", ErrNotToken
+	}
+	return strings.TrimSpace(string(res)), nil
+}
+
+// tronHexAddress converts a Base58Check Tron address into the hex representation of its account hash.
+func tronHexAddress(addr string) (string, error) {
+	addr, err := address.Normalize(address.ChainTypeTron, addr)
+	if err != nil {
+		return "", err
+	}
+	decoded, err := address.DecodeBase58(addr)
+	if err != nil {
+		return "", err
+	}
+	// Version byte 0x41 followed by the 20 bytes account hash and the checksum.
+	return "0x" + hex.EncodeToString(decoded[1:21]), nil
+}
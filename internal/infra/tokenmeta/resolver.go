@@ -0,0 +1,60 @@
+// Package tokenmeta resolves the metadata of tokens (name, symbol and decimals) from the chains they live on.
+package tokenmeta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+)
+
+var (
+	// ErrUnsupportedChainType is returned for chains without token metadata support.
+	ErrUnsupportedChainType = errors.New("unsupported chain type")
+	// ErrNotToken is returned if the address does not hold a token contract (or mint).
+	ErrNotToken = errors.New("address is not a token")
+)
+
+// Metadata of a token as reported by its chain. Name and Symbol are empty if the chain does not provide them.
+type Metadata struct {
+	Name     string
+	Symbol   string
+	Decimals int
+}
+
+type Resolver interface {
+	// Resolve reads the metadata of the token at contractAddress using the JSON-RPC endpoint of its chain.
+	// The chain type is one of the chain types of the address package.
+	Resolve(ctx context.Context, chainType string, rpcURL string, contractAddress string) (*Metadata, error)
+}
+
+type resolver struct {
+	client *jsonrpc.Client
+}
+
+func NewResolver(httpClient *http.Client) Resolver {
+	return &resolver{
+		client: jsonrpc.NewClient(httpClient),
+	}
+}
+
+func (r *resolver) Resolve(ctx context.Context, chainType string, rpcURL string, contractAddress string) (*Metadata, error) {
+	switch strings.ToUpper(chainType) {
+	case address.ChainTypeEVM:
+		return r.resolveEVM(ctx, rpcURL, contractAddress)
+	case address.ChainTypeTron:
+		// Tron nodes expose the EVM compatible eth_call, addressing contracts by their 20 bytes account hash.
+		hexAddress, err := tronHexAddress(contractAddress)
+		if err != nil {
+			return nil, err
+		}
+		return r.resolveEVM(ctx, rpcURL, hexAddress)
+	case address.ChainTypeSolana:
+		return r.resolveSolana(ctx, rpcURL, contractAddress)
+	default:
+		return nil, ErrUnsupportedChainType
+	}
+}
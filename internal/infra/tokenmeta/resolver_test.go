@@ -0,0 +1,256 @@
+package tokenmeta_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/kashguard/go-mpc-vault/internal/infra/tokenmeta"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func abiWord(n int) []byte {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint64(word[24:], uint64(n))
+	return word
+}
+
+func abiString(s string) string {
+	data := append(abiWord(32), abiWord(len(s))...)
+	padded := make([]byte, (len(s)+31)/32*32)
+	copy(padded, s)
+	return "0x" + hex.EncodeToString(append(data, padded...))
+}
+
+func bytes32(s string) string {
+	word := make([]byte, 32)
+	copy(word, s)
+	return "0x" + hex.EncodeToString(word)
+}
+
+// ethCallHandler answers eth_call requests by selector, recording the called contracts.
+func ethCallHandler(t *testing.T, results map[string]string, contracts *[]string) test.JSONRPCHandler {
+	var mu sync.Mutex
+
+	return func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		require.Equal(t, "eth_call", method)
+		require.Len(t, params, 2)
+
+		var call struct {
+			To   string `json:"to"`
+			Data string `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(params[0], &call))
+
+		mu.Lock()
+		*contracts = append(*contracts, call.To)
+		mu.Unlock()
+
+		result, ok := results[call.Data]
+		if !ok {
+			return nil, &jsonrpc.RPCError{Code: 3, Message: "execution reverted"}
+		}
+		return result, nil
+	}
+}
+
+func TestResolveEVM(t *testing.T) {
+	var contracts []string
+	srv := test.NewTestJSONRPCServer(t, ethCallHandler(t, map[string]string{
+		"0x06fdde03": abiString("Tether USD"),
+		"0x95d89b41": abiString("USDT"),
+		"0x313ce567": "0x" + hex.EncodeToString(abiWord(6)),
+	}, &contracts))
+
+	res, err := tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "EVM", srv.URL, "0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	require.NoError(t, err)
+	assert.Equal(t, &tokenmeta.Metadata{Name: "Tether USD", Symbol: "USDT", Decimals: 6}, res)
+
+	for _, contract := range contracts {
+		assert.Equal(t, "0xdAC17F958D2ee523a2206206994597C13D831ec7", contract)
+	}
+}
+
+func TestResolveEVMBytes32AndMissingName(t *testing.T) {
+	var contracts []string
+	srv := test.NewTestJSONRPCServer(t, ethCallHandler(t, map[string]string{
+		"0x95d89b41": bytes32("MKR"),
+		"0x313ce567": "0x" + hex.EncodeToString(abiWord(18)),
+	}, &contracts))
+
+	res, err := tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "EVM", srv.URL, "0x9f8F72aA9304c8B593d555F12eF6589cC3A579A2")
+	require.NoError(t, err)
+	assert.Equal(t, &tokenmeta.Metadata{Name: "", Symbol: "MKR", Decimals: 18}, res)
+}
+
+func TestResolveEVMNotToken(t *testing.T) {
+	var contracts []string
+	srv := test.NewTestJSONRPCServer(t, ethCallHandler(t, map[string]string{}, &contracts))
+
+	_, err := tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "EVM", srv.URL, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	assert.ErrorIs(t, err, tokenmeta.ErrNotToken)
+
+	// Decimals beyond uint8 are not a valid ERC20 response.
+	srv = test.NewTestJSONRPCServer(t, ethCallHandler(t, map[string]string{
+		"0x313ce567": "0x" + hex.EncodeToString(abiWord(256)),
+	}, &contracts))
+
+	_, err = tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "EVM", srv.URL, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	assert.ErrorIs(t, err, tokenmeta.ErrNotToken)
+}
+
+func TestResolveTron(t *testing.T) {
+	var contracts []string
+	srv := test.NewTestJSONRPCServer(t, ethCallHandler(t, map[string]string{
+		"0x06fdde03": abiString("Tether USD"),
+		"0x95d89b41": abiString("USDT"),
+		"0x313ce567": "0x" + hex.EncodeToString(abiWord(6)),
+	}, &contracts))
+
+	res, err := tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "TRON", srv.URL, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	require.NoError(t, err)
+	assert.Equal(t, &tokenmeta.Metadata{Name: "Tether USD", Symbol: "USDT", Decimals: 6}, res)
+
+	decoded, err := address.DecodeBase58("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	require.NoError(t, err)
+	require.NotEmpty(t, contracts)
+	for _, contract := range contracts {
+		assert.Equal(t, "0x"+hex.EncodeToString(decoded[1:21]), contract)
+	}
+
+	_, err = tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "TRON", srv.URL, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u")
+	assert.ErrorIs(t, err, address.ErrInvalidAddress)
+}
+
+const (
+	usdcMint          = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+	splTokenProgramID = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	metaplexProgramID = "metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s"
+)
+
+func mintAccount(decimals byte) []byte {
+	data := make([]byte, 82)
+	data[44] = decimals
+	data[45] = 1
+	return data
+}
+
+func borshString(s string, size int) []byte {
+	res := make([]byte, 4+size)
+	binary.LittleEndian.PutUint32(res, uint32(size))
+	copy(res[4:], s)
+	return res
+}
+
+func metadataAccount(name string, symbol string) []byte {
+	data := make([]byte, 1+32+32)
+	data[0] = 4 // metadata account key
+	data = append(data, borshString(name, 32)...)
+	data = append(data, borshString(symbol, 10)...)
+	return append(data, borshString("https://example.com/token.json", 200)...)
+}
+
+func accountInfo(data []byte, owner string) map[string]interface{} {
+	return map[string]interface{}{
+		"context": map[string]interface{}{"slot": 1},
+		"value": map[string]interface{}{
+			"data":     []string{base64.StdEncoding.EncodeToString(data), "base64"},
+			"owner":    owner,
+			"lamports": 1461600,
+		},
+	}
+}
+
+// solanaHandler answers getAccountInfo with the mint for the mint address and the metadata for any other account.
+func solanaHandler(t *testing.T, mint []byte, metadata []byte, accounts *[]string) test.JSONRPCHandler {
+	var mu sync.Mutex
+
+	return func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		require.Equal(t, "getAccountInfo", method)
+		require.Len(t, params, 2)
+
+		var account string
+		require.NoError(t, json.Unmarshal(params[0], &account))
+		assert.JSONEq(t, `{"encoding":"base64"}`, string(params[1]))
+
+		mu.Lock()
+		*accounts = append(*accounts, account)
+		mu.Unlock()
+
+		if account == usdcMint {
+			return accountInfo(mint, splTokenProgramID), nil
+		}
+		if metadata == nil {
+			return map[string]interface{}{"context": map[string]interface{}{"slot": 1}, "value": nil}, nil
+		}
+		return accountInfo(metadata, metaplexProgramID), nil
+	}
+}
+
+func TestResolveSolana(t *testing.T) {
+	var accounts []string
+	srv := test.NewTestJSONRPCServer(t, solanaHandler(t, mintAccount(6), metadataAccount("USD Coin", "USDC"), &accounts))
+
+	res, err := tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "SOLANA", srv.URL, usdcMint)
+	require.NoError(t, err)
+	assert.Equal(t, &tokenmeta.Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6}, res)
+
+	// The metadata account is derived from the mint.
+	require.Len(t, accounts, 2)
+	assert.Equal(t, usdcMint, accounts[0])
+	assert.NotEqual(t, usdcMint, accounts[1])
+	derived, err := address.DecodeBase58(accounts[1])
+	require.NoError(t, err)
+	assert.Len(t, derived, 32)
+}
+
+func TestResolveSolanaWithoutMetadata(t *testing.T) {
+	var accounts []string
+	srv := test.NewTestJSONRPCServer(t, solanaHandler(t, mintAccount(9), nil, &accounts))
+
+	res, err := tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "SOLANA", srv.URL, usdcMint)
+	require.NoError(t, err)
+	assert.Equal(t, &tokenmeta.Metadata{Decimals: 9}, res)
+}
+
+func TestResolveSolanaNotToken(t *testing.T) {
+	var accounts []string
+
+	// Uninitialized mint
+	srv := test.NewTestJSONRPCServer(t, solanaHandler(t, make([]byte, 82), nil, &accounts))
+	_, err := tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "SOLANA", srv.URL, usdcMint)
+	assert.ErrorIs(t, err, tokenmeta.ErrNotToken)
+
+	// Account of another program
+	srv = test.NewTestJSONRPCServer(t, func(_ string, _ []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		return accountInfo(mintAccount(6), "11111111111111111111111111111111"), nil
+	})
+	_, err = tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "SOLANA", srv.URL, usdcMint)
+	assert.ErrorIs(t, err, tokenmeta.ErrNotToken)
+}
+
+func TestResolveErrors(t *testing.T) {
+	_, err := tokenmeta.NewResolver(http.DefaultClient).Resolve(context.Background(), "UTXO", "http://localhost", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")
+	assert.ErrorIs(t, err, tokenmeta.ErrUnsupportedChainType)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	_, err = tokenmeta.NewResolver(srv.Client()).Resolve(context.Background(), "EVM", srv.URL, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), fmt.Sprint(http.StatusBadGateway)), err.Error())
+}
@@ -0,0 +1,178 @@
+package tokenmeta
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"filippo.io/edwards25519"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+)
+
+const (
+	splTokenProgramID     = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	splToken2022ProgramID = "TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb"
+	// metaplexProgramID owns the metadata accounts holding name and symbol of SPL tokens.
+	metaplexProgramID = "metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s"
+
+	// Layout of SPL mint accounts: mint authority (COption<Pubkey>, 36 bytes), supply (u64),
+	// decimals (u8), is initialized (bool) and freeze authority (COption<Pubkey>, 36 bytes).
+	mintAccountSize      = 82
+	mintDecimalsOffset   = 44
+	mintInitializedFlag  = 45
+	metadataHeaderLength = 1 + 32 + 32 // key, update authority and mint
+)
+
+var errOnCurve = errors.New("address is on the ed25519 curve")
+
+type solanaAccountInfo struct {
+	Value *struct {
+		Data  []string `json:"data"`
+		Owner string   `json:"owner"`
+	} `json:"value"`
+}
+
+func (r *resolver) resolveSolana(ctx context.Context, rpcURL string, mintAddress string) (*Metadata, error) {
+	mint, owner, err := r.getAccountData(ctx, rpcURL, mintAddress)
+	if err != nil {
+		return nil, err
+	}
+	if mint == nil || (owner != splTokenProgramID && owner != splToken2022ProgramID) {
+		return nil, ErrNotToken
+	}
+	// Token-2022 mints carry extensions after the base layout.
+	if len(mint) < mintAccountSize || mint[mintInitializedFlag] != 1 {
+		return nil, ErrNotToken
+	}
+
+	res := &Metadata{
+		Decimals: int(mint[mintDecimalsOffset]),
+	}
+
+	metadataAddress, err := metaplexMetadataAddress(mintAddress)
+	if err != nil {
+		return nil, err
+	}
+	metadata, owner, err := r.getAccountData(ctx, rpcURL, metadataAddress)
+	if err != nil {
+		return nil, err
+	}
+	// Mints without Metaplex metadata have no name and symbol.
+	if metadata == nil || owner != metaplexProgramID {
+		return res, nil
+	}
+
+	res.Name, res.Symbol, err = decodeMetaplexMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// getAccountData returns the data and the owning program of the account, nil data if the account does not exist.
+func (r *resolver) getAccountData(ctx context.Context, rpcURL string, account string) ([]byte, string, error) {
+	var info solanaAccountInfo
+	if err := r.client.Call(ctx, rpcURL, "getAccountInfo", []interface{}{
+		account,
+		map[string]string{"encoding": "base64"},
+	}, &info); err != nil {
+		return nil, "", err
+	}
+	if info.Value == nil {
+		return nil, "", nil
+	}
+	if len(info.Value.Data) != 2 || info.Value.Data[1] != "base64" {
+		return nil, "", ErrNotToken
+	}
+
+	data, err := base64.StdEncoding.DecodeString(info.Value.Data[0])
+	if err != nil {
+		return nil, "", ErrNotToken
+	}
+	return data, info.Value.Owner, nil
+}
+
+// decodeMetaplexMetadata reads name and symbol of a Metaplex metadata account, both are Borsh strings
+// (u32 little endian length and data) padded with zero bytes.
+func decodeMetaplexMetadata(data []byte) (string, string, error) {
+	if len(data) < metadataHeaderLength {
+		return "", "", ErrNotToken
+	}
+	rest := data[metadataHeaderLength:]
+
+	name, rest, err := readBorshString(rest)
+	if err != nil {
+		return "", "", err
+	}
+	symbol, _, err := readBorshString(rest)
+	if err != nil {
+		return "", "", err
+	}
+	return name, symbol, nil
+}
+
+func readBorshString(data []byte) (string, []byte, error) {
+	if len(data) < 4 {
+		return "", nil, ErrNotToken
+	}
+	length := binary.LittleEndian.Uint32(data[:4])
+	if uint64(length) > uint64(len(data)-4) {
+		return "", nil, ErrNotToken
+	}
+
+	value := data[4 : 4+length]
+	if !utf8.Valid(value) {
+		return "", nil, ErrNotToken
+	}
+	return strings.TrimSpace(strings.TrimRight(string(value), "\x00")), data[4+length:], nil
+}
+
+// metaplexMetadataAddress derives the metadata account of the mint, the program derived address of
+// the seeds "metadata", the Metaplex program ID and the mint.
+func metaplexMetadataAddress(mintAddress string) (string, error) {
+	program, err := address.DecodeBase58(metaplexProgramID)
+	if err != nil {
+		return "", err
+	}
+	mint, err := address.DecodeBase58(mintAddress)
+	if err != nil || len(mint) != 32 {
+		return "", ErrNotToken
+	}
+
+	pda, err := findProgramAddress([][]byte{[]byte("metadata"), program, mint}, program)
+	if err != nil {
+		return "", err
+	}
+	return address.EncodeBase58(pda), nil
+}
+
+// findProgramAddress returns the first program derived address of the seeds, trying bump seeds from 255 downwards.
+func findProgramAddress(seeds [][]byte, programID []byte) ([]byte, error) {
+	for bump := 255; bump >= 0; bump-- {
+		pda, err := createProgramAddress(append(seeds, []byte{byte(bump)}), programID)
+		if err == nil {
+			return pda, nil
+		}
+	}
+	return nil, errors.New("no viable bump seed")
+}
+
+// createProgramAddress hashes the seeds and program, valid program derived addresses must not be ed25519 public keys.
+func createProgramAddress(seeds [][]byte, programID []byte) ([]byte, error) {
+	h := sha256.New()
+	for _, seed := range seeds {
+		h.Write(seed)
+	}
+	h.Write(programID)
+	h.Write([]byte("ProgramDerivedAddress"))
+	hash := h.Sum(nil)
+
+	if _, err := new(edwards25519.Point).SetBytes(hash); err == nil {
+		return nil, errOnCurve
+	}
+	return hash, nil
+}
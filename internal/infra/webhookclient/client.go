@@ -0,0 +1,166 @@
+// Package webhookclient delivers signed webhook payloads to the endpoints of organizations.
+package webhookclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the signature of the payload, see Sign.
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader holds the unix time the payload was signed at, receivers should reject stale payloads.
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventIDHeader   = "X-Webhook-Event-Id"
+	EventTypeHeader = "X-Webhook-Event-Type"
+
+	signatureVersion = "v1"
+)
+
+var (
+	// ErrInsecureURL is returned for URLs other than https, unless insecure endpoints are allowed.
+	ErrInsecureURL = errors.New("webhook url must use https")
+	// ErrPrivateAddress is returned for endpoints within private networks, unless insecure endpoints are allowed.
+	ErrPrivateAddress = errors.New("webhook endpoint resolves to a private network address")
+)
+
+// Message is a payload to deliver.
+type Message struct {
+	EventID   string
+	EventType string
+	Body      []byte
+}
+
+type Client interface {
+	// ValidateURL checks the URL is suitable as webhook endpoint.
+	ValidateURL(rawURL string) error
+	// Send posts the message signed with the secret to the URL, returning the status code of the response.
+	// Redirects are not followed.
+	Send(ctx context.Context, rawURL string, secret string, msg Message) (int, error)
+}
+
+type Config struct {
+	Timeout time.Duration
+	// AllowInsecure permits http URLs and endpoints within private networks, e.g. for development.
+	AllowInsecure bool
+}
+
+type client struct {
+	config     Config
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func NewClient(config Config) Client {
+	dialer := &net.Dialer{
+		Timeout: config.Timeout,
+	}
+	if !config.AllowInsecure {
+		// Checking the address dialed rather than the host of the URL covers DNS rebinding and redirects alike.
+		dialer.Control = func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivate(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+
+	return &client{
+		config: config,
+		httpClient: &http.Client{
+			Timeout:   config.Timeout,
+			Transport: transport,
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// Sign returns the signature of the body sent at the unix timestamp: the hex encoded HMAC-SHA256 of
+// "<timestamp>.<body>" keyed with the secret, prefixed with its version, e.g. "v1=5257a869...".
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *client) ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid webhook url: %q", rawURL)
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !c.config.AllowInsecure {
+			return ErrInsecureURL
+		}
+	default:
+		return ErrInsecureURL
+	}
+
+	if ip := net.ParseIP(u.Hostname()); ip != nil && isPrivate(ip) && !c.config.AllowInsecure {
+		return ErrPrivateAddress
+	}
+
+	return nil
+}
+
+func (c *client) Send(ctx context.Context, rawURL string, secret string, msg Message) (int, error) {
+	if err := c.ValidateURL(rawURL); err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(msg.Body))
+	if err != nil {
+		return 0, fmt.Errorf("create webhook request: %w", err)
+	}
+
+	timestamp := c.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, msg.EventID)
+	req.Header.Set(EventTypeHeader, msg.EventType)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, msg.Body))
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Draining the body allows reusing the connection, responses are not of interest beyond their status.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	return res.StatusCode, nil
+}
+
+func isPrivate(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast()
+}
@@ -0,0 +1,121 @@
+package webhookclient_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/webhookclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"type":"signing_request.approved"}`)
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte("1717243200." + string(body)))
+	expected := "v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, expected, webhookclient.Sign("whsec_test", 1717243200, body))
+	assert.NotEqual(t, expected, webhookclient.Sign("whsec_other", 1717243200, body))
+	assert.NotEqual(t, expected, webhookclient.Sign("whsec_test", 1717243201, body))
+}
+
+func TestSend(t *testing.T) {
+	body := []byte(`{"id":"5f4c0a2e-6f39-4d3b-9a59-5b0d7f6b5f0e","type":"signing_request.approved"}`)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "5f4c0a2e-6f39-4d3b-9a59-5b0d7f6b5f0e", r.Header.Get(webhookclient.EventIDHeader))
+		assert.Equal(t, "signing_request.approved", r.Header.Get(webhookclient.EventTypeHeader))
+
+		received, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, body, received)
+
+		timestamp := r.Header.Get(webhookclient.TimestampHeader)
+		require.NotEmpty(t, timestamp)
+		mac := hmac.New(sha256.New, []byte("whsec_test"))
+		mac.Write([]byte(timestamp + "." + string(received)))
+		assert.Equal(t, "v1="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(webhookclient.SignatureHeader))
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := webhookclient.NewClient(webhookclient.Config{
+		Timeout:       time.Second * 5,
+		AllowInsecure: true,
+	})
+
+	status, err := client.Send(context.Background(), srv.URL, "whsec_test", webhookclient.Message{
+		EventID:   "5f4c0a2e-6f39-4d3b-9a59-5b0d7f6b5f0e",
+		EventType: "signing_request.approved",
+		Body:      body,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, status)
+}
+
+func TestSendDoesNotFollowRedirects(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			t.Error("redirect was followed")
+			return
+		}
+		http.Redirect(w, r, "/moved", http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	client := webhookclient.NewClient(webhookclient.Config{
+		Timeout:       time.Second * 5,
+		AllowInsecure: true,
+	})
+
+	status, err := client.Send(context.Background(), srv.URL, "whsec_test", webhookclient.Message{Body: []byte(`{}`)})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, status)
+}
+
+func TestSendRefusesPrivateNetworks(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("request reached the private endpoint")
+	}))
+	defer srv.Close()
+
+	client := webhookclient.NewClient(webhookclient.Config{
+		Timeout: time.Second * 5,
+	})
+
+	// Addresses given literally are refused upfront.
+	_, err := client.Send(context.Background(), srv.URL, "whsec_test", webhookclient.Message{Body: []byte(`{}`)})
+	assert.ErrorIs(t, err, webhookclient.ErrPrivateAddress)
+
+	// Host names are refused once they resolve to a private address.
+	_, err = client.Send(context.Background(), strings.Replace(srv.URL, "127.0.0.1", "localhost", 1), "whsec_test", webhookclient.Message{Body: []byte(`{}`)})
+	assert.ErrorIs(t, err, webhookclient.ErrPrivateAddress)
+}
+
+func TestValidateURL(t *testing.T) {
+	client := webhookclient.NewClient(webhookclient.Config{})
+
+	require.NoError(t, client.ValidateURL("https://hooks.example.com/vault"))
+	assert.ErrorIs(t, client.ValidateURL("http://hooks.example.com/vault"), webhookclient.ErrInsecureURL)
+	assert.ErrorIs(t, client.ValidateURL("ftp://hooks.example.com/vault"), webhookclient.ErrInsecureURL)
+	assert.ErrorIs(t, client.ValidateURL("https://10.0.0.1/vault"), webhookclient.ErrPrivateAddress)
+	assert.ErrorIs(t, client.ValidateURL("https://[::1]/vault"), webhookclient.ErrPrivateAddress)
+	assert.Error(t, client.ValidateURL("hooks.example.com/vault"))
+
+	insecure := webhookclient.NewClient(webhookclient.Config{AllowInsecure: true})
+	require.NoError(t, insecure.ValidateURL("http://localhost:8080/vault"))
+	require.NoError(t, insecure.ValidateURL("https://10.0.0.1/vault"))
+}
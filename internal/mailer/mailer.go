@@ -8,19 +8,22 @@ import (
 	"html/template"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/jordan-wright/email"
 	"github.com/kashguard/go-mpc-vault/internal/config"
 	"github.com/kashguard/go-mpc-vault/internal/data/dto"
 	"github.com/kashguard/go-mpc-vault/internal/mailer/transport"
 	"github.com/kashguard/go-mpc-vault/internal/util"
-	"github.com/jordan-wright/email"
 	"github.com/rs/zerolog/log"
 )
 
 var (
 	ErrEmailTemplateNotFound         = errors.New("email template not found")
-	emailTemplatePasswordReset       = "password_reset"       // /app/templates/email/password_reset/**.
-	emailTemplateAccountConfirmation = "account_confirmation" // /app/templates/email/account_confirmation/**
+	emailTemplatePasswordReset       = "password_reset"          // /app/templates/email/password_reset/**.
+	emailTemplateAccountConfirmation = "account_confirmation"    // /app/templates/email/account_confirmation/**
+	emailTemplateInvitation          = "organization_invitation" // /app/templates/email/organization_invitation/**
+	emailTemplateDepositConfirmed    = "deposit_confirmed"       // /app/templates/email/deposit_confirmed/**
 )
 
 type Mailer struct {
@@ -160,3 +163,94 @@ func (m *Mailer) SendAccountConfirmation(ctx context.Context, to string, payload
 
 	return nil
 }
+
+func (m *Mailer) SendOrganizationInvitation(ctx context.Context, to string, payload dto.InvitationNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateInvitation).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateInvitation]
+	if !ok {
+		log.Error().Msg("Organization invitation email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"organizationName": payload.OrganizationName,
+		"role":             payload.Role,
+		"invitationLink":   payload.InvitationLink,
+		"validUntil":       payload.ValidUntil.UTC().Format(time.RFC1123),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute organization invitation email template")
+		return fmt.Errorf("failed to execute organization invitation email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = fmt.Sprintf("Invitation to join %s", payload.OrganizationName)
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Msg("Sending has been disabled in mailer config, skipping organization invitation email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send organization invitation email")
+		return fmt.Errorf("failed to send organization invitation email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent organization invitation email")
+
+	return nil
+}
+
+func (m *Mailer) SendDepositConfirmed(ctx context.Context, to string, payload dto.DepositNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateDepositConfirmed).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateDepositConfirmed]
+	if !ok {
+		log.Error().Msg("Deposit confirmed email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"vaultName": payload.VaultName,
+		"chainName": payload.ChainName,
+		"address":   payload.Address,
+		"amount":    payload.Amount,
+		"symbol":    payload.Symbol,
+		"txHash":    payload.TxHash,
+		"txLink":    payload.TxLink,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute deposit confirmed email template")
+		return fmt.Errorf("failed to execute deposit confirmed email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = fmt.Sprintf("Deposit of %s %s received", payload.Amount, payload.Symbol)
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Msg("Sending has been disabled in mailer config, skipping deposit confirmed email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send deposit confirmed email")
+		return fmt.Errorf("failed to send deposit confirmed email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent deposit confirmed email")
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kashguard/go-mpc-vault/internal/data/dto"
 	"github.com/kashguard/go-mpc-vault/internal/test"
 	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
 	"github.com/stretchr/testify/assert"
@@ -36,3 +37,70 @@ func TestMailerSendPasswordReset(t *testing.T) {
 	assert.Equal(t, "Password reset", mail.Subject)
 	assert.Contains(t, string(mail.HTML), passwordResetLink)
 }
+
+func TestMailerSendOrganizationInvitation(t *testing.T) {
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(1)
+
+	//nolint:gosec
+	invitationLink := "http://localhost/accept-invitation?token=12345"
+	err := mailer.SendOrganizationInvitation(ctx, fix.User1.Username.String, dto.InvitationNotificationPayload{
+		OrganizationName: "Acme Treasury",
+		Role:             "operator",
+		InvitationLink:   invitationLink,
+		ValidUntil:       time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mail := mailTransport.GetLastSentMail()
+	require.NotNil(t, mail)
+	require.Len(t, mailTransport.GetSentMails(), 1)
+	assert.Equal(t, test.TestMailerDefaultSender, mail.From)
+	require.Len(t, mail.To, 1)
+	assert.Equal(t, fix.User1.Username.String, mail.To[0])
+	assert.Equal(t, "Invitation to join Acme Treasury", mail.Subject)
+	assert.Contains(t, string(mail.HTML), "Acme Treasury")
+	assert.Contains(t, string(mail.HTML), "operator")
+	assert.Contains(t, string(mail.HTML), "Sun, 01 Jun 2025 12:00:00 UTC")
+	assert.Contains(t, string(mail.HTML), "http://localhost/accept-invitation?token=12345")
+}
+
+func TestMailerSendDepositConfirmed(t *testing.T) {
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(1)
+
+	err := mailer.SendDepositConfirmed(ctx, fix.User1.Username.String, dto.DepositNotificationPayload{
+		VaultName: "Treasury",
+		ChainName: "Ethereum Mainnet",
+		Address:   "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Amount:    "1.5",
+		Symbol:    "USDT",
+		TxHash:    "0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71",
+		TxLink:    "https://etherscan.io/tx/0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71",
+	})
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mail := mailTransport.GetLastSentMail()
+	require.NotNil(t, mail)
+	require.Len(t, mailTransport.GetSentMails(), 1)
+	assert.Equal(t, test.TestMailerDefaultSender, mail.From)
+	require.Len(t, mail.To, 1)
+	assert.Equal(t, fix.User1.Username.String, mail.To[0])
+	assert.Equal(t, "Deposit of 1.5 USDT received", mail.Subject)
+	assert.Contains(t, string(mail.HTML), "Treasury")
+	assert.Contains(t, string(mail.HTML), "Ethereum Mainnet")
+	assert.Contains(t, string(mail.HTML), "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	assert.Contains(t, string(mail.HTML), "https://etherscan.io/tx/0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71")
+}
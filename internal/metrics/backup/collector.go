@@ -0,0 +1,59 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+)
+
+type DatabaseMetricsCollector struct {
+	db *sql.DB
+}
+
+func NewDatabaseMetricsCollector(db *sql.DB) *DatabaseMetricsCollector {
+	return &DatabaseMetricsCollector{db: db}
+}
+
+func (c DatabaseMetricsCollector) GetKeysCheckedCount(ctx context.Context) float64 {
+	log := util.LogFromContext(ctx)
+
+	count, err := models.KeyBackupHealths().Count(ctx, c.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to count keys checked for backup health")
+		return 0
+	}
+
+	return float64(count)
+}
+
+func (c DatabaseMetricsCollector) GetKeysAtRiskCount(ctx context.Context) float64 {
+	log := util.LogFromContext(ctx)
+
+	count, err := models.KeyBackupHealths(models.KeyBackupHealthWhere.AtRisk.EQ(true)).Count(ctx, c.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to count keys with backup at risk")
+		return 0
+	}
+
+	return float64(count)
+}
+
+func (c DatabaseMetricsCollector) GetLastCheckTimestamp(ctx context.Context) float64 {
+	log := util.LogFromContext(ctx)
+
+	health, err := models.KeyBackupHealths(
+		qm.OrderBy(models.KeyBackupHealthColumns.CheckedAt+" DESC"),
+	).One(ctx, c.db)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Error().Err(err).Msg("Failed to find last backup health check")
+		}
+		return 0
+	}
+
+	return float64(health.CheckedAt.Unix())
+}
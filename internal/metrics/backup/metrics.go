@@ -0,0 +1,45 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type MetricsCollector interface {
+	GetKeysCheckedCount(ctx context.Context) float64
+	GetKeysAtRiskCount(ctx context.Context) float64
+	GetLastCheckTimestamp(ctx context.Context) float64
+}
+
+const (
+	MetricNameBackupKeysChecked        = "backup_keys_checked"
+	MetricNameBackupKeysAtRisk         = "backup_keys_at_risk"
+	MetricNameBackupLastCheckTimestamp = "backup_health_last_check_timestamp_seconds"
+)
+
+func Metrics(ctx context.Context, collector MetricsCollector) []prometheus.Collector {
+	return []prometheus.Collector{
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: MetricNameBackupKeysChecked,
+				Help: "Keys of active vaults checked for backup health",
+			},
+			func() float64 { return collector.GetKeysCheckedCount(ctx) },
+		),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: MetricNameBackupKeysAtRisk,
+				Help: "Keys whose shares cannot be recovered from their backup shares",
+			},
+			func() float64 { return collector.GetKeysAtRiskCount(ctx) },
+		),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: MetricNameBackupLastCheckTimestamp,
+				Help: "Unix time of the last backup health check of a key",
+			},
+			func() float64 { return collector.GetLastCheckTimestamp(ctx) },
+		),
+	}
+}
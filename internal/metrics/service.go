@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/metrics/backup"
 	"github.com/kashguard/go-mpc-vault/internal/metrics/users"
 	"github.com/kashguard/go-mpc-vault/internal/util"
 	"github.com/dlmiddlecote/sqlstats"
@@ -31,6 +32,7 @@ func (s *Service) RegisterMetrics(ctx context.Context) error {
 
 	// custom metrics
 	metrics = append(metrics, users.Metrics(ctx, users.NewDatabaseMetricsCollector(s.db))...)
+	metrics = append(metrics, backup.Metrics(ctx, backup.NewDatabaseMetricsCollector(s.db))...)
 
 	// sqlstats metrics, see https://github.com/dlmiddlecote/sqlstats?tab=readme-ov-file#exposed-metrics for the exposed metrics
 	metrics = append(metrics, sqlstats.NewStatsCollector(s.config.Database.Database, s.db))
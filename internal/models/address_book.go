@@ -33,6 +33,10 @@ type AddressBook struct {
 	CreatedBy      null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
 	CreatedAt      null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	UsableAfter    null.Time   `boil:"usable_after" json:"usable_after,omitempty" toml:"usable_after" yaml:"usable_after,omitempty"`
+	ApprovedBy     null.String `boil:"approved_by" json:"approved_by,omitempty" toml:"approved_by" yaml:"approved_by,omitempty"`
+	ApprovedAt     null.Time   `boil:"approved_at" json:"approved_at,omitempty" toml:"approved_at" yaml:"approved_at,omitempty"`
 
 	R *addressBookR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L addressBookL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +52,10 @@ var AddressBookColumns = struct {
 	CreatedBy      string
 	CreatedAt      string
 	UpdatedAt      string
+	Status         string
+	UsableAfter    string
+	ApprovedBy     string
+	ApprovedAt     string
 }{
 	ID:             "id",
 	OrganizationID: "organization_id",
@@ -58,6 +66,10 @@ var AddressBookColumns = struct {
 	CreatedBy:      "created_by",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
+	Status:         "status",
+	UsableAfter:    "usable_after",
+	ApprovedBy:     "approved_by",
+	ApprovedAt:     "approved_at",
 }
 
 var AddressBookTableColumns = struct {
@@ -70,6 +82,10 @@ var AddressBookTableColumns = struct {
 	CreatedBy      string
 	CreatedAt      string
 	UpdatedAt      string
+	Status         string
+	UsableAfter    string
+	ApprovedBy     string
+	ApprovedAt     string
 }{
 	ID:             "address_book.id",
 	OrganizationID: "address_book.organization_id",
@@ -80,6 +96,10 @@ var AddressBookTableColumns = struct {
 	CreatedBy:      "address_book.created_by",
 	CreatedAt:      "address_book.created_at",
 	UpdatedAt:      "address_book.updated_at",
+	Status:         "address_book.status",
+	UsableAfter:    "address_book.usable_after",
+	ApprovedBy:     "address_book.approved_by",
+	ApprovedAt:     "address_book.approved_at",
 }
 
 // Generated where
@@ -198,6 +218,10 @@ var AddressBookWhere = struct {
 	CreatedBy      whereHelpernull_String
 	CreatedAt      whereHelpernull_Time
 	UpdatedAt      whereHelpernull_Time
+	Status         whereHelperstring
+	UsableAfter    whereHelpernull_Time
+	ApprovedBy     whereHelpernull_String
+	ApprovedAt     whereHelpernull_Time
 }{
 	ID:             whereHelperstring{field: "\"address_book\".\"id\""},
 	OrganizationID: whereHelpernull_String{field: "\"address_book\".\"organization_id\""},
@@ -208,24 +232,31 @@ var AddressBookWhere = struct {
 	CreatedBy:      whereHelpernull_String{field: "\"address_book\".\"created_by\""},
 	CreatedAt:      whereHelpernull_Time{field: "\"address_book\".\"created_at\""},
 	UpdatedAt:      whereHelpernull_Time{field: "\"address_book\".\"updated_at\""},
+	Status:         whereHelperstring{field: "\"address_book\".\"status\""},
+	UsableAfter:    whereHelpernull_Time{field: "\"address_book\".\"usable_after\""},
+	ApprovedBy:     whereHelpernull_String{field: "\"address_book\".\"approved_by\""},
+	ApprovedAt:     whereHelpernull_Time{field: "\"address_book\".\"approved_at\""},
 }
 
 // AddressBookRels is where relationship names are stored.
 var AddressBookRels = struct {
-	Chain         string
-	CreatedByUser string
-	Organization  string
+	ApprovedByUser string
+	Chain          string
+	CreatedByUser  string
+	Organization   string
 }{
-	Chain:         "Chain",
-	CreatedByUser: "CreatedByUser",
-	Organization:  "Organization",
+	ApprovedByUser: "ApprovedByUser",
+	Chain:          "Chain",
+	CreatedByUser:  "CreatedByUser",
+	Organization:   "Organization",
 }
 
 // addressBookR is where relationships are stored.
 type addressBookR struct {
-	Chain         *Chain        `boil:"Chain" json:"Chain" toml:"Chain" yaml:"Chain"`
-	CreatedByUser *User         `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
-	Organization  *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	ApprovedByUser *User         `boil:"ApprovedByUser" json:"ApprovedByUser" toml:"ApprovedByUser" yaml:"ApprovedByUser"`
+	Chain          *Chain        `boil:"Chain" json:"Chain" toml:"Chain" yaml:"Chain"`
+	CreatedByUser  *User         `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	Organization   *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
 }
 
 // NewStruct creates a new relationship struct
@@ -233,6 +264,22 @@ func (*addressBookR) NewStruct() *addressBookR {
 	return &addressBookR{}
 }
 
+func (o *AddressBook) GetApprovedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApprovedByUser()
+}
+
+func (r *addressBookR) GetApprovedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.ApprovedByUser
+}
+
 func (o *AddressBook) GetChain() *Chain {
 	if o == nil {
 		return nil
@@ -285,9 +332,9 @@ func (r *addressBookR) GetOrganization() *Organization {
 type addressBookL struct{}
 
 var (
-	addressBookAllColumns            = []string{"id", "organization_id", "chain_id", "address", "name", "is_whitelisted", "created_by", "created_at", "updated_at"}
+	addressBookAllColumns            = []string{"id", "organization_id", "chain_id", "address", "name", "is_whitelisted", "created_by", "created_at", "updated_at", "status", "usable_after", "approved_by", "approved_at"}
 	addressBookColumnsWithoutDefault = []string{"address", "name"}
-	addressBookColumnsWithDefault    = []string{"id", "organization_id", "chain_id", "is_whitelisted", "created_by", "created_at", "updated_at"}
+	addressBookColumnsWithDefault    = []string{"id", "organization_id", "chain_id", "is_whitelisted", "created_by", "created_at", "updated_at", "status", "usable_after", "approved_by", "approved_at"}
 	addressBookPrimaryKeyColumns     = []string{"id"}
 	addressBookGeneratedColumns      = []string{}
 )
@@ -383,6 +430,17 @@ func (q addressBookQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	return count > 0, nil
 }
 
+// ApprovedByUser pointed to by the foreign key.
+func (o *AddressBook) ApprovedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ApprovedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // Chain pointed to by the foreign key.
 func (o *AddressBook) Chain(mods ...qm.QueryMod) chainQuery {
 	queryMods := []qm.QueryMod{
@@ -416,6 +474,122 @@ func (o *AddressBook) Organization(mods ...qm.QueryMod) organizationQuery {
 	return Organizations(queryMods...)
 }
 
+// LoadApprovedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (addressBookL) LoadApprovedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAddressBook interface{}, mods queries.Applicator) error {
+	var slice []*AddressBook
+	var object *AddressBook
+
+	if singular {
+		var ok bool
+		object, ok = maybeAddressBook.(*AddressBook)
+		if !ok {
+			object = new(AddressBook)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAddressBook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAddressBook))
+			}
+		}
+	} else {
+		s, ok := maybeAddressBook.(*[]*AddressBook)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAddressBook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAddressBook))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &addressBookR{}
+		}
+		if !queries.IsNil(object.ApprovedBy) {
+			args[object.ApprovedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &addressBookR{}
+			}
+
+			if !queries.IsNil(obj.ApprovedBy) {
+				args[obj.ApprovedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ApprovedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ApprovedByAddressBooks = append(foreign.R.ApprovedByAddressBooks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ApprovedBy, foreign.ID) {
+				local.R.ApprovedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ApprovedByAddressBooks = append(foreign.R.ApprovedByAddressBooks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadChain allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (addressBookL) LoadChain(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAddressBook interface{}, mods queries.Applicator) error {
@@ -764,6 +938,86 @@ func (addressBookL) LoadOrganization(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
+// SetApprovedByUser of the addressBook to the related item.
+// Sets o.R.ApprovedByUser to related.
+// Adds o to related.R.ApprovedByAddressBooks.
+func (o *AddressBook) SetApprovedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"address_book\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"approved_by"}),
+		strmangle.WhereClause("\"", "\"", 2, addressBookPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ApprovedBy, related.ID)
+	if o.R == nil {
+		o.R = &addressBookR{
+			ApprovedByUser: related,
+		}
+	} else {
+		o.R.ApprovedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ApprovedByAddressBooks: AddressBookSlice{o},
+		}
+	} else {
+		related.R.ApprovedByAddressBooks = append(related.R.ApprovedByAddressBooks, o)
+	}
+
+	return nil
+}
+
+// RemoveApprovedByUser relationship.
+// Sets o.R.ApprovedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *AddressBook) RemoveApprovedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ApprovedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("approved_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ApprovedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ApprovedByAddressBooks {
+		if queries.Equal(o.ApprovedBy, ri.ApprovedBy) {
+			continue
+		}
+
+		ln := len(related.R.ApprovedByAddressBooks)
+		if ln > 1 && i < ln-1 {
+			related.R.ApprovedByAddressBooks[i] = related.R.ApprovedByAddressBooks[ln-1]
+		}
+		related.R.ApprovedByAddressBooks = related.R.ApprovedByAddressBooks[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetChain of the addressBook to the related item.
 // Sets o.R.Chain to related.
 // Adds o to related.R.AddressBooks.
@@ -353,6 +353,58 @@ func testAddressBooksInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testAddressBookToOneUserUsingApprovedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AddressBook
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, addressBookDBTypes, true, addressBookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AddressBook struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ApprovedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ApprovedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AddressBookSlice{&local}
+	if err = local.L.LoadApprovedByUser(ctx, tx, false, (*[]*AddressBook)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ApprovedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ApprovedByUser = nil
+	if err = local.L.LoadApprovedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ApprovedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
 func testAddressBookToOneChainUsingChain(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -509,6 +561,115 @@ func testAddressBookToOneOrganizationUsingOrganization(t *testing.T) {
 
 }
 
+func testAddressBookToOneSetOpUserUsingApprovedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AddressBook
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetApprovedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ApprovedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ApprovedByAddressBooks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ApprovedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ApprovedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ApprovedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ApprovedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ApprovedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ApprovedBy, x.ID)
+		}
+	}
+}
+
+func testAddressBookToOneRemoveOpUserUsingApprovedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AddressBook
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetApprovedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveApprovedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.ApprovedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.ApprovedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ApprovedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ApprovedByAddressBooks) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testAddressBookToOneSetOpChainUsingChain(t *testing.T) {
 	var err error
 
@@ -910,7 +1071,7 @@ func testAddressBooksSelect(t *testing.T) {
 }
 
 var (
-	addressBookDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `ChainID`: `character varying`, `Address`: `character varying`, `Name`: `character varying`, `IsWhitelisted`: `boolean`, `CreatedBy`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	addressBookDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `ChainID`: `character varying`, `Address`: `character varying`, `Name`: `character varying`, `IsWhitelisted`: `boolean`, `CreatedBy`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Status`: `character varying`, `UsableAfter`: `timestamp with time zone`, `ApprovedBy`: `uuid`, `ApprovedAt`: `timestamp with time zone`}
 	_                  = bytes.MinRead
 )
 
@@ -24,63 +24,73 @@ import (
 
 // AppUserProfile is an object representing the database table.
 type AppUserProfile struct {
-	UserID          string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	LegalAcceptedAt null.Time `boil:"legal_accepted_at" json:"legal_accepted_at,omitempty" toml:"legal_accepted_at" yaml:"legal_accepted_at,omitempty"`
-	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UserID                string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	LegalAcceptedAt       null.Time   `boil:"legal_accepted_at" json:"legal_accepted_at,omitempty" toml:"legal_accepted_at" yaml:"legal_accepted_at,omitempty"`
+	CreatedAt             time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DefaultOrganizationID null.String `boil:"default_organization_id" json:"default_organization_id,omitempty" toml:"default_organization_id" yaml:"default_organization_id,omitempty"`
 
 	R *appUserProfileR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L appUserProfileL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AppUserProfileColumns = struct {
-	UserID          string
-	LegalAcceptedAt string
-	CreatedAt       string
-	UpdatedAt       string
+	UserID                string
+	LegalAcceptedAt       string
+	CreatedAt             string
+	UpdatedAt             string
+	DefaultOrganizationID string
 }{
-	UserID:          "user_id",
-	LegalAcceptedAt: "legal_accepted_at",
-	CreatedAt:       "created_at",
-	UpdatedAt:       "updated_at",
+	UserID:                "user_id",
+	LegalAcceptedAt:       "legal_accepted_at",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	DefaultOrganizationID: "default_organization_id",
 }
 
 var AppUserProfileTableColumns = struct {
-	UserID          string
-	LegalAcceptedAt string
-	CreatedAt       string
-	UpdatedAt       string
+	UserID                string
+	LegalAcceptedAt       string
+	CreatedAt             string
+	UpdatedAt             string
+	DefaultOrganizationID string
 }{
-	UserID:          "app_user_profiles.user_id",
-	LegalAcceptedAt: "app_user_profiles.legal_accepted_at",
-	CreatedAt:       "app_user_profiles.created_at",
-	UpdatedAt:       "app_user_profiles.updated_at",
+	UserID:                "app_user_profiles.user_id",
+	LegalAcceptedAt:       "app_user_profiles.legal_accepted_at",
+	CreatedAt:             "app_user_profiles.created_at",
+	UpdatedAt:             "app_user_profiles.updated_at",
+	DefaultOrganizationID: "app_user_profiles.default_organization_id",
 }
 
 // Generated where
 
 var AppUserProfileWhere = struct {
-	UserID          whereHelperstring
-	LegalAcceptedAt whereHelpernull_Time
-	CreatedAt       whereHelpertime_Time
-	UpdatedAt       whereHelpertime_Time
+	UserID                whereHelperstring
+	LegalAcceptedAt       whereHelpernull_Time
+	CreatedAt             whereHelpertime_Time
+	UpdatedAt             whereHelpertime_Time
+	DefaultOrganizationID whereHelpernull_String
 }{
-	UserID:          whereHelperstring{field: "\"app_user_profiles\".\"user_id\""},
-	LegalAcceptedAt: whereHelpernull_Time{field: "\"app_user_profiles\".\"legal_accepted_at\""},
-	CreatedAt:       whereHelpertime_Time{field: "\"app_user_profiles\".\"created_at\""},
-	UpdatedAt:       whereHelpertime_Time{field: "\"app_user_profiles\".\"updated_at\""},
+	UserID:                whereHelperstring{field: "\"app_user_profiles\".\"user_id\""},
+	LegalAcceptedAt:       whereHelpernull_Time{field: "\"app_user_profiles\".\"legal_accepted_at\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"app_user_profiles\".\"created_at\""},
+	UpdatedAt:             whereHelpertime_Time{field: "\"app_user_profiles\".\"updated_at\""},
+	DefaultOrganizationID: whereHelpernull_String{field: "\"app_user_profiles\".\"default_organization_id\""},
 }
 
 // AppUserProfileRels is where relationship names are stored.
 var AppUserProfileRels = struct {
-	User string
+	DefaultOrganization string
+	User                string
 }{
-	User: "User",
+	DefaultOrganization: "DefaultOrganization",
+	User:                "User",
 }
 
 // appUserProfileR is where relationships are stored.
 type appUserProfileR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	DefaultOrganization *Organization `boil:"DefaultOrganization" json:"DefaultOrganization" toml:"DefaultOrganization" yaml:"DefaultOrganization"`
+	User                *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
 }
 
 // NewStruct creates a new relationship struct
@@ -88,6 +98,22 @@ func (*appUserProfileR) NewStruct() *appUserProfileR {
 	return &appUserProfileR{}
 }
 
+func (o *AppUserProfile) GetDefaultOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDefaultOrganization()
+}
+
+func (r *appUserProfileR) GetDefaultOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.DefaultOrganization
+}
+
 func (o *AppUserProfile) GetUser() *User {
 	if o == nil {
 		return nil
@@ -108,9 +134,9 @@ func (r *appUserProfileR) GetUser() *User {
 type appUserProfileL struct{}
 
 var (
-	appUserProfileAllColumns            = []string{"user_id", "legal_accepted_at", "created_at", "updated_at"}
+	appUserProfileAllColumns            = []string{"user_id", "legal_accepted_at", "created_at", "updated_at", "default_organization_id"}
 	appUserProfileColumnsWithoutDefault = []string{"user_id", "created_at", "updated_at"}
-	appUserProfileColumnsWithDefault    = []string{"legal_accepted_at"}
+	appUserProfileColumnsWithDefault    = []string{"legal_accepted_at", "default_organization_id"}
 	appUserProfilePrimaryKeyColumns     = []string{"user_id"}
 	appUserProfileGeneratedColumns      = []string{}
 )
@@ -206,6 +232,17 @@ func (q appUserProfileQuery) Exists(ctx context.Context, exec boil.ContextExecut
 	return count > 0, nil
 }
 
+// DefaultOrganization pointed to by the foreign key.
+func (o *AppUserProfile) DefaultOrganization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DefaultOrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *AppUserProfile) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -217,6 +254,122 @@ func (o *AppUserProfile) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// LoadDefaultOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (appUserProfileL) LoadDefaultOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAppUserProfile interface{}, mods queries.Applicator) error {
+	var slice []*AppUserProfile
+	var object *AppUserProfile
+
+	if singular {
+		var ok bool
+		object, ok = maybeAppUserProfile.(*AppUserProfile)
+		if !ok {
+			object = new(AppUserProfile)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAppUserProfile)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAppUserProfile))
+			}
+		}
+	} else {
+		s, ok := maybeAppUserProfile.(*[]*AppUserProfile)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAppUserProfile)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAppUserProfile))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &appUserProfileR{}
+		}
+		if !queries.IsNil(object.DefaultOrganizationID) {
+			args[object.DefaultOrganizationID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &appUserProfileR{}
+			}
+
+			if !queries.IsNil(obj.DefaultOrganizationID) {
+				args[obj.DefaultOrganizationID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DefaultOrganization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.DefaultOrganizationAppUserProfiles = append(foreign.R.DefaultOrganizationAppUserProfiles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DefaultOrganizationID, foreign.ID) {
+				local.R.DefaultOrganization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.DefaultOrganizationAppUserProfiles = append(foreign.R.DefaultOrganizationAppUserProfiles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (appUserProfileL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAppUserProfile interface{}, mods queries.Applicator) error {
@@ -329,6 +482,86 @@ func (appUserProfileL) LoadUser(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
+// SetDefaultOrganization of the appUserProfile to the related item.
+// Sets o.R.DefaultOrganization to related.
+// Adds o to related.R.DefaultOrganizationAppUserProfiles.
+func (o *AppUserProfile) SetDefaultOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"app_user_profiles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"default_organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DefaultOrganizationID, related.ID)
+	if o.R == nil {
+		o.R = &appUserProfileR{
+			DefaultOrganization: related,
+		}
+	} else {
+		o.R.DefaultOrganization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			DefaultOrganizationAppUserProfiles: AppUserProfileSlice{o},
+		}
+	} else {
+		related.R.DefaultOrganizationAppUserProfiles = append(related.R.DefaultOrganizationAppUserProfiles, o)
+	}
+
+	return nil
+}
+
+// RemoveDefaultOrganization relationship.
+// Sets o.R.DefaultOrganization to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *AppUserProfile) RemoveDefaultOrganization(ctx context.Context, exec boil.ContextExecutor, related *Organization) error {
+	var err error
+
+	queries.SetScanner(&o.DefaultOrganizationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("default_organization_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.DefaultOrganization = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DefaultOrganizationAppUserProfiles {
+		if queries.Equal(o.DefaultOrganizationID, ri.DefaultOrganizationID) {
+			continue
+		}
+
+		ln := len(related.R.DefaultOrganizationAppUserProfiles)
+		if ln > 1 && i < ln-1 {
+			related.R.DefaultOrganizationAppUserProfiles[i] = related.R.DefaultOrganizationAppUserProfiles[ln-1]
+		}
+		related.R.DefaultOrganizationAppUserProfiles = related.R.DefaultOrganizationAppUserProfiles[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUser of the appUserProfile to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.AppUserProfile.
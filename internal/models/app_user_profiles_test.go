@@ -353,6 +353,58 @@ func testAppUserProfilesInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testAppUserProfileToOneOrganizationUsingDefaultOrganization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AppUserProfile
+	var foreign Organization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, appUserProfileDBTypes, true, appUserProfileColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AppUserProfile struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.DefaultOrganizationID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.DefaultOrganization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AppUserProfileSlice{&local}
+	if err = local.L.LoadDefaultOrganization(ctx, tx, false, (*[]*AppUserProfile)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DefaultOrganization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.DefaultOrganization = nil
+	if err = local.L.LoadDefaultOrganization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DefaultOrganization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
 func testAppUserProfileToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -405,6 +457,115 @@ func testAppUserProfileToOneUserUsingUser(t *testing.T) {
 
 }
 
+func testAppUserProfileToOneSetOpOrganizationUsingDefaultOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AppUserProfile
+	var b, c Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, appUserProfileDBTypes, false, strmangle.SetComplement(appUserProfilePrimaryKeyColumns, appUserProfileColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Organization{&b, &c} {
+		err = a.SetDefaultOrganization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.DefaultOrganization != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.DefaultOrganizationAppUserProfiles[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.DefaultOrganizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.DefaultOrganizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.DefaultOrganizationID))
+		reflect.Indirect(reflect.ValueOf(&a.DefaultOrganizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.DefaultOrganizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.DefaultOrganizationID, x.ID)
+		}
+	}
+}
+
+func testAppUserProfileToOneRemoveOpOrganizationUsingDefaultOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AppUserProfile
+	var b Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, appUserProfileDBTypes, false, strmangle.SetComplement(appUserProfilePrimaryKeyColumns, appUserProfileColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetDefaultOrganization(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveDefaultOrganization(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.DefaultOrganization().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.DefaultOrganization != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.DefaultOrganizationID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.DefaultOrganizationAppUserProfiles) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testAppUserProfileToOneSetOpUserUsingUser(t *testing.T) {
 	var err error
 
@@ -533,7 +694,7 @@ func testAppUserProfilesSelect(t *testing.T) {
 }
 
 var (
-	appUserProfileDBTypes = map[string]string{`UserID`: `uuid`, `LegalAcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	appUserProfileDBTypes = map[string]string{`UserID`: `uuid`, `LegalAcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `DefaultOrganizationID`: `uuid`}
 	_                     = bytes.MinRead
 )
 
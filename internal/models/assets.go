@@ -146,10 +146,12 @@ var AssetWhere = struct {
 // AssetRels is where relationship names are stored.
 var AssetRels = struct {
 	Chain          string
+	Deposits       string
 	SpendingLimits string
 	WalletBalances string
 }{
 	Chain:          "Chain",
+	Deposits:       "Deposits",
 	SpendingLimits: "SpendingLimits",
 	WalletBalances: "WalletBalances",
 }
@@ -157,6 +159,7 @@ var AssetRels = struct {
 // assetR is where relationships are stored.
 type assetR struct {
 	Chain          *Chain             `boil:"Chain" json:"Chain" toml:"Chain" yaml:"Chain"`
+	Deposits       DepositSlice       `boil:"Deposits" json:"Deposits" toml:"Deposits" yaml:"Deposits"`
 	SpendingLimits SpendingLimitSlice `boil:"SpendingLimits" json:"SpendingLimits" toml:"SpendingLimits" yaml:"SpendingLimits"`
 	WalletBalances WalletBalanceSlice `boil:"WalletBalances" json:"WalletBalances" toml:"WalletBalances" yaml:"WalletBalances"`
 }
@@ -182,6 +185,22 @@ func (r *assetR) GetChain() *Chain {
 	return r.Chain
 }
 
+func (o *Asset) GetDeposits() DepositSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDeposits()
+}
+
+func (r *assetR) GetDeposits() DepositSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Deposits
+}
+
 func (o *Asset) GetSpendingLimits() SpendingLimitSlice {
 	if o == nil {
 		return nil
@@ -327,6 +346,20 @@ func (o *Asset) Chain(mods ...qm.QueryMod) chainQuery {
 	return Chains(queryMods...)
 }
 
+// Deposits retrieves all the deposit's Deposits with an executor.
+func (o *Asset) Deposits(mods ...qm.QueryMod) depositQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deposits\".\"asset_id\"=?", o.ID),
+	)
+
+	return Deposits(queryMods...)
+}
+
 // SpendingLimits retrieves all the spending_limit's SpendingLimits with an executor.
 func (o *Asset) SpendingLimits(mods ...qm.QueryMod) spendingLimitQuery {
 	var queryMods []qm.QueryMod
@@ -471,6 +504,112 @@ func (assetL) LoadChain(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
+// LoadDeposits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (assetL) LoadDeposits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAsset interface{}, mods queries.Applicator) error {
+	var slice []*Asset
+	var object *Asset
+
+	if singular {
+		var ok bool
+		object, ok = maybeAsset.(*Asset)
+		if !ok {
+			object = new(Asset)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAsset)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAsset))
+			}
+		}
+	} else {
+		s, ok := maybeAsset.(*[]*Asset)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAsset)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAsset))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &assetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`deposits`),
+		qm.WhereIn(`deposits.asset_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deposits")
+	}
+
+	var resultSlice []*Deposit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deposits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deposits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposits")
+	}
+
+	if singular {
+		object.R.Deposits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &depositR{}
+			}
+			foreign.R.Asset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AssetID) {
+				local.R.Deposits = append(local.R.Deposits, foreign)
+				if foreign.R == nil {
+					foreign.R = &depositR{}
+				}
+				foreign.R.Asset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSpendingLimits allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (assetL) LoadSpendingLimits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAsset interface{}, mods queries.Applicator) error {
@@ -763,6 +902,133 @@ func (o *Asset) RemoveChain(ctx context.Context, exec boil.ContextExecutor, rela
 	return nil
 }
 
+// AddDeposits adds the given related objects to the existing relationships
+// of the asset, optionally inserting them as new records.
+// Appends related to o.R.Deposits.
+// Sets related.R.Asset appropriately.
+func (o *Asset) AddDeposits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Deposit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AssetID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deposits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, depositPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AssetID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &assetR{
+			Deposits: related,
+		}
+	} else {
+		o.R.Deposits = append(o.R.Deposits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &depositR{
+				Asset: o,
+			}
+		} else {
+			rel.R.Asset = o
+		}
+	}
+	return nil
+}
+
+// SetDeposits removes all previously related items of the
+// asset replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Asset's Deposits accordingly.
+// Replaces o.R.Deposits with related.
+// Sets related.R.Asset's Deposits accordingly.
+func (o *Asset) SetDeposits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Deposit) error {
+	query := "update \"deposits\" set \"asset_id\" = null where \"asset_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Deposits {
+			queries.SetScanner(&rel.AssetID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Asset = nil
+		}
+		o.R.Deposits = nil
+	}
+
+	return o.AddDeposits(ctx, exec, insert, related...)
+}
+
+// RemoveDeposits relationships from objects passed in.
+// Removes related items from R.Deposits (uses pointer comparison, removal does not keep order)
+// Sets related.R.Asset.
+func (o *Asset) RemoveDeposits(ctx context.Context, exec boil.ContextExecutor, related ...*Deposit) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AssetID, nil)
+		if rel.R != nil {
+			rel.R.Asset = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("asset_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Deposits {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Deposits)
+			if ln > 1 && i < ln-1 {
+				o.R.Deposits[i] = o.R.Deposits[ln-1]
+			}
+			o.R.Deposits = o.R.Deposits[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddSpendingLimits adds the given related objects to the existing relationships
 // of the asset, optionally inserting them as new records.
 // Appends related to o.R.SpendingLimits.
@@ -353,6 +353,83 @@ func testAssetsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testAssetToManyDeposits(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Asset
+	var b, c Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assetDBTypes, true, assetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Asset struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.AssetID, a.ID)
+	queries.Assign(&c.AssetID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Deposits().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.AssetID, b.AssetID) {
+			bFound = true
+		}
+		if queries.Equal(v.AssetID, c.AssetID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AssetSlice{&a}
+	if err = a.L.LoadDeposits(ctx, tx, false, (*[]*Asset)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Deposits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Deposits = nil
+	if err = a.L.LoadDeposits(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Deposits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testAssetToManySpendingLimits(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -507,6 +584,257 @@ func testAssetToManyWalletBalances(t *testing.T) {
 	}
 }
 
+func testAssetToManyAddOpDeposits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Asset
+	var b, c, d, e Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assetDBTypes, false, strmangle.SetComplement(assetPrimaryKeyColumns, assetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Deposit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Deposit{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDeposits(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.AssetID) {
+			t.Error("foreign key was wrong value", a.ID, first.AssetID)
+		}
+		if !queries.Equal(a.ID, second.AssetID) {
+			t.Error("foreign key was wrong value", a.ID, second.AssetID)
+		}
+
+		if first.R.Asset != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Asset != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Deposits[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Deposits[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Deposits().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testAssetToManySetOpDeposits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Asset
+	var b, c, d, e Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assetDBTypes, false, strmangle.SetComplement(assetPrimaryKeyColumns, assetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Deposit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetDeposits(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetDeposits(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.AssetID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.AssetID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.AssetID) {
+		t.Error("foreign key was wrong value", a.ID, d.AssetID)
+	}
+	if !queries.Equal(a.ID, e.AssetID) {
+		t.Error("foreign key was wrong value", a.ID, e.AssetID)
+	}
+
+	if b.R.Asset != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Asset != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Asset != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Asset != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Deposits[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Deposits[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testAssetToManyRemoveOpDeposits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Asset
+	var b, c, d, e Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assetDBTypes, false, strmangle.SetComplement(assetPrimaryKeyColumns, assetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Deposit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddDeposits(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveDeposits(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.AssetID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.AssetID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Asset != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Asset != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Asset != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Asset != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Deposits) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Deposits[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Deposits[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testAssetToManyAddOpSpendingLimits(t *testing.T) {
 	var err error
 
@@ -0,0 +1,975 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AuditCheckpoint is an object representing the database table.
+type AuditCheckpoint struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrganizationID null.String `boil:"organization_id" json:"organization_id,omitempty" toml:"organization_id" yaml:"organization_id,omitempty"`
+	Sequence       int64       `boil:"sequence" json:"sequence" toml:"sequence" yaml:"sequence"`
+	Hash           string      `boil:"hash" json:"hash" toml:"hash" yaml:"hash"`
+	KeyID          string      `boil:"key_id" json:"key_id" toml:"key_id" yaml:"key_id"`
+	Signature      string      `boil:"signature" json:"signature" toml:"signature" yaml:"signature"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *auditCheckpointR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L auditCheckpointL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuditCheckpointColumns = struct {
+	ID             string
+	OrganizationID string
+	Sequence       string
+	Hash           string
+	KeyID          string
+	Signature      string
+	CreatedAt      string
+}{
+	ID:             "id",
+	OrganizationID: "organization_id",
+	Sequence:       "sequence",
+	Hash:           "hash",
+	KeyID:          "key_id",
+	Signature:      "signature",
+	CreatedAt:      "created_at",
+}
+
+var AuditCheckpointTableColumns = struct {
+	ID             string
+	OrganizationID string
+	Sequence       string
+	Hash           string
+	KeyID          string
+	Signature      string
+	CreatedAt      string
+}{
+	ID:             "audit_checkpoints.id",
+	OrganizationID: "audit_checkpoints.organization_id",
+	Sequence:       "audit_checkpoints.sequence",
+	Hash:           "audit_checkpoints.hash",
+	KeyID:          "audit_checkpoints.key_id",
+	Signature:      "audit_checkpoints.signature",
+	CreatedAt:      "audit_checkpoints.created_at",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var AuditCheckpointWhere = struct {
+	ID             whereHelperstring
+	OrganizationID whereHelpernull_String
+	Sequence       whereHelperint64
+	Hash           whereHelperstring
+	KeyID          whereHelperstring
+	Signature      whereHelperstring
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"audit_checkpoints\".\"id\""},
+	OrganizationID: whereHelpernull_String{field: "\"audit_checkpoints\".\"organization_id\""},
+	Sequence:       whereHelperint64{field: "\"audit_checkpoints\".\"sequence\""},
+	Hash:           whereHelperstring{field: "\"audit_checkpoints\".\"hash\""},
+	KeyID:          whereHelperstring{field: "\"audit_checkpoints\".\"key_id\""},
+	Signature:      whereHelperstring{field: "\"audit_checkpoints\".\"signature\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"audit_checkpoints\".\"created_at\""},
+}
+
+// AuditCheckpointRels is where relationship names are stored.
+var AuditCheckpointRels = struct {
+	Organization string
+}{
+	Organization: "Organization",
+}
+
+// auditCheckpointR is where relationships are stored.
+type auditCheckpointR struct {
+	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+}
+
+// NewStruct creates a new relationship struct
+func (*auditCheckpointR) NewStruct() *auditCheckpointR {
+	return &auditCheckpointR{}
+}
+
+func (o *AuditCheckpoint) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganization()
+}
+
+func (r *auditCheckpointR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.Organization
+}
+
+// auditCheckpointL is where Load methods for each relationship are stored.
+type auditCheckpointL struct{}
+
+var (
+	auditCheckpointAllColumns            = []string{"id", "organization_id", "sequence", "hash", "key_id", "signature", "created_at"}
+	auditCheckpointColumnsWithoutDefault = []string{"sequence", "hash", "key_id", "signature"}
+	auditCheckpointColumnsWithDefault    = []string{"id", "organization_id", "created_at"}
+	auditCheckpointPrimaryKeyColumns     = []string{"id"}
+	auditCheckpointGeneratedColumns      = []string{}
+)
+
+type (
+	// AuditCheckpointSlice is an alias for a slice of pointers to AuditCheckpoint.
+	// This should almost always be used instead of []AuditCheckpoint.
+	AuditCheckpointSlice []*AuditCheckpoint
+
+	auditCheckpointQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	auditCheckpointType                 = reflect.TypeOf(&AuditCheckpoint{})
+	auditCheckpointMapping              = queries.MakeStructMapping(auditCheckpointType)
+	auditCheckpointPrimaryKeyMapping, _ = queries.BindMapping(auditCheckpointType, auditCheckpointMapping, auditCheckpointPrimaryKeyColumns)
+	auditCheckpointInsertCacheMut       sync.RWMutex
+	auditCheckpointInsertCache          = make(map[string]insertCache)
+	auditCheckpointUpdateCacheMut       sync.RWMutex
+	auditCheckpointUpdateCache          = make(map[string]updateCache)
+	auditCheckpointUpsertCacheMut       sync.RWMutex
+	auditCheckpointUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single auditCheckpoint record from the query.
+func (q auditCheckpointQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuditCheckpoint, error) {
+	o := &AuditCheckpoint{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for audit_checkpoints")
+	}
+
+	return o, nil
+}
+
+// All returns all AuditCheckpoint records from the query.
+func (q auditCheckpointQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuditCheckpointSlice, error) {
+	var o []*AuditCheckpoint
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AuditCheckpoint slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AuditCheckpoint records in the query.
+func (q auditCheckpointQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count audit_checkpoints rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q auditCheckpointQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if audit_checkpoints exists")
+	}
+
+	return count > 0, nil
+}
+
+// Organization pointed to by the foreign key.
+func (o *AuditCheckpoint) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (auditCheckpointL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditCheckpoint interface{}, mods queries.Applicator) error {
+	var slice []*AuditCheckpoint
+	var object *AuditCheckpoint
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuditCheckpoint.(*AuditCheckpoint)
+		if !ok {
+			object = new(AuditCheckpoint)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditCheckpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditCheckpoint))
+			}
+		}
+	} else {
+		s, ok := maybeAuditCheckpoint.(*[]*AuditCheckpoint)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditCheckpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditCheckpoint))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &auditCheckpointR{}
+		}
+		if !queries.IsNil(object.OrganizationID) {
+			args[object.OrganizationID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &auditCheckpointR{}
+			}
+
+			if !queries.IsNil(obj.OrganizationID) {
+				args[obj.OrganizationID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.AuditCheckpoints = append(foreign.R.AuditCheckpoints, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OrganizationID, foreign.ID) {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.AuditCheckpoints = append(foreign.R.AuditCheckpoints, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrganization of the auditCheckpoint to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.AuditCheckpoints.
+func (o *AuditCheckpoint) SetOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"audit_checkpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, auditCheckpointPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OrganizationID, related.ID)
+	if o.R == nil {
+		o.R = &auditCheckpointR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			AuditCheckpoints: AuditCheckpointSlice{o},
+		}
+	} else {
+		related.R.AuditCheckpoints = append(related.R.AuditCheckpoints, o)
+	}
+
+	return nil
+}
+
+// RemoveOrganization relationship.
+// Sets o.R.Organization to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *AuditCheckpoint) RemoveOrganization(ctx context.Context, exec boil.ContextExecutor, related *Organization) error {
+	var err error
+
+	queries.SetScanner(&o.OrganizationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Organization = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AuditCheckpoints {
+		if queries.Equal(o.OrganizationID, ri.OrganizationID) {
+			continue
+		}
+
+		ln := len(related.R.AuditCheckpoints)
+		if ln > 1 && i < ln-1 {
+			related.R.AuditCheckpoints[i] = related.R.AuditCheckpoints[ln-1]
+		}
+		related.R.AuditCheckpoints = related.R.AuditCheckpoints[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AuditCheckpoints retrieves all the records using an executor.
+func AuditCheckpoints(mods ...qm.QueryMod) auditCheckpointQuery {
+	mods = append(mods, qm.From("\"audit_checkpoints\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"audit_checkpoints\".*"})
+	}
+
+	return auditCheckpointQuery{q}
+}
+
+// FindAuditCheckpoint retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuditCheckpoint(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AuditCheckpoint, error) {
+	auditCheckpointObj := &AuditCheckpoint{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"audit_checkpoints\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, auditCheckpointObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from audit_checkpoints")
+	}
+
+	return auditCheckpointObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuditCheckpoint) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no audit_checkpoints provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditCheckpointColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	auditCheckpointInsertCacheMut.RLock()
+	cache, cached := auditCheckpointInsertCache[key]
+	auditCheckpointInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			auditCheckpointAllColumns,
+			auditCheckpointColumnsWithDefault,
+			auditCheckpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditCheckpointType, auditCheckpointMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(auditCheckpointType, auditCheckpointMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"audit_checkpoints\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"audit_checkpoints\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into audit_checkpoints")
+	}
+
+	if !cached {
+		auditCheckpointInsertCacheMut.Lock()
+		auditCheckpointInsertCache[key] = cache
+		auditCheckpointInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the AuditCheckpoint.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuditCheckpoint) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	auditCheckpointUpdateCacheMut.RLock()
+	cache, cached := auditCheckpointUpdateCache[key]
+	auditCheckpointUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			auditCheckpointAllColumns,
+			auditCheckpointPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update audit_checkpoints, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"audit_checkpoints\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, auditCheckpointPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(auditCheckpointType, auditCheckpointMapping, append(wl, auditCheckpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update audit_checkpoints row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for audit_checkpoints")
+	}
+
+	if !cached {
+		auditCheckpointUpdateCacheMut.Lock()
+		auditCheckpointUpdateCache[key] = cache
+		auditCheckpointUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q auditCheckpointQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for audit_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for audit_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuditCheckpointSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"audit_checkpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, auditCheckpointPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in auditCheckpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all auditCheckpoint")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuditCheckpoint) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no audit_checkpoints provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditCheckpointColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	auditCheckpointUpsertCacheMut.RLock()
+	cache, cached := auditCheckpointUpsertCache[key]
+	auditCheckpointUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			auditCheckpointAllColumns,
+			auditCheckpointColumnsWithDefault,
+			auditCheckpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			auditCheckpointAllColumns,
+			auditCheckpointPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert audit_checkpoints, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(auditCheckpointAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(auditCheckpointPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert audit_checkpoints, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(auditCheckpointPrimaryKeyColumns))
+			copy(conflict, auditCheckpointPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"audit_checkpoints\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(auditCheckpointType, auditCheckpointMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(auditCheckpointType, auditCheckpointMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert audit_checkpoints")
+	}
+
+	if !cached {
+		auditCheckpointUpsertCacheMut.Lock()
+		auditCheckpointUpsertCache[key] = cache
+		auditCheckpointUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single AuditCheckpoint record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuditCheckpoint) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AuditCheckpoint provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), auditCheckpointPrimaryKeyMapping)
+	sql := "DELETE FROM \"audit_checkpoints\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from audit_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for audit_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q auditCheckpointQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no auditCheckpointQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from audit_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for audit_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuditCheckpointSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"audit_checkpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, auditCheckpointPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from auditCheckpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for audit_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuditCheckpoint) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuditCheckpoint(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditCheckpointSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuditCheckpointSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"audit_checkpoints\".* FROM \"audit_checkpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, auditCheckpointPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AuditCheckpointSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuditCheckpointExists checks if the AuditCheckpoint row exists.
+func AuditCheckpointExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"audit_checkpoints\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if audit_checkpoints exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuditCheckpoint row exists.
+func (o *AuditCheckpoint) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuditCheckpointExists(ctx, exec, o.ID)
+}
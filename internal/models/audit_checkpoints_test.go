@@ -0,0 +1,752 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAuditCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	query := AuditCheckpoints()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAuditCheckpointsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditCheckpointsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AuditCheckpoints().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditCheckpointsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AuditCheckpointSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditCheckpointsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AuditCheckpointExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AuditCheckpoint exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AuditCheckpointExists to return true, but got false.")
+	}
+}
+
+func testAuditCheckpointsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	auditCheckpointFound, err := FindAuditCheckpoint(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if auditCheckpointFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAuditCheckpointsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AuditCheckpoints().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditCheckpointsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AuditCheckpoints().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAuditCheckpointsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	auditCheckpointOne := &AuditCheckpoint{}
+	auditCheckpointTwo := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, auditCheckpointOne, auditCheckpointDBTypes, false, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+	if err = randomize.Struct(seed, auditCheckpointTwo, auditCheckpointDBTypes, false, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = auditCheckpointOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = auditCheckpointTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AuditCheckpoints().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAuditCheckpointsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	auditCheckpointOne := &AuditCheckpoint{}
+	auditCheckpointTwo := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, auditCheckpointOne, auditCheckpointDBTypes, false, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+	if err = randomize.Struct(seed, auditCheckpointTwo, auditCheckpointDBTypes, false, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = auditCheckpointOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = auditCheckpointTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAuditCheckpointsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAuditCheckpointsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(auditCheckpointPrimaryKeyColumns, auditCheckpointColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAuditCheckpointToOneOrganizationUsingOrganization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AuditCheckpoint
+	var foreign Organization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.OrganizationID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Organization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AuditCheckpointSlice{&local}
+	if err = local.L.LoadOrganization(ctx, tx, false, (*[]*AuditCheckpoint)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Organization = nil
+	if err = local.L.LoadOrganization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testAuditCheckpointToOneSetOpOrganizationUsingOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AuditCheckpoint
+	var b, c Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, auditCheckpointDBTypes, false, strmangle.SetComplement(auditCheckpointPrimaryKeyColumns, auditCheckpointColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Organization{&b, &c} {
+		err = a.SetOrganization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Organization != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AuditCheckpoints[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.OrganizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.OrganizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrganizationID))
+		reflect.Indirect(reflect.ValueOf(&a.OrganizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.OrganizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.OrganizationID, x.ID)
+		}
+	}
+}
+
+func testAuditCheckpointToOneRemoveOpOrganizationUsingOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AuditCheckpoint
+	var b Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, auditCheckpointDBTypes, false, strmangle.SetComplement(auditCheckpointPrimaryKeyColumns, auditCheckpointColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetOrganization(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveOrganization(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Organization().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Organization != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.OrganizationID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AuditCheckpoints) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAuditCheckpointsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditCheckpointsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AuditCheckpointSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditCheckpointsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AuditCheckpoints().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	auditCheckpointDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `Sequence`: `bigint`, `Hash`: `text`, `KeyID`: `character varying`, `Signature`: `text`, `CreatedAt`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testAuditCheckpointsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(auditCheckpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(auditCheckpointAllColumns) == len(auditCheckpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAuditCheckpointsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(auditCheckpointAllColumns) == len(auditCheckpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditCheckpoint{}
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, auditCheckpointDBTypes, true, auditCheckpointPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(auditCheckpointAllColumns, auditCheckpointPrimaryKeyColumns) {
+		fields = auditCheckpointAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			auditCheckpointAllColumns,
+			auditCheckpointPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AuditCheckpointSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAuditCheckpointsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(auditCheckpointAllColumns) == len(auditCheckpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AuditCheckpoint{}
+	if err = randomize.Struct(seed, &o, auditCheckpointDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AuditCheckpoint: %s", err)
+	}
+
+	count, err := AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, auditCheckpointDBTypes, false, auditCheckpointPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditCheckpoint struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AuditCheckpoint: %s", err)
+	}
+
+	count, err = AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -33,7 +33,11 @@ type AuditLog struct {
 	IPAddress      null.String `boil:"ip_address" json:"ip_address,omitempty" toml:"ip_address" yaml:"ip_address,omitempty"`
 	UserAgent      null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
 	Details        null.JSON   `boil:"details" json:"details,omitempty" toml:"details" yaml:"details,omitempty"`
-	CreatedAt      null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	CredentialID   null.String `boil:"credential_id" json:"credential_id,omitempty" toml:"credential_id" yaml:"credential_id,omitempty"`
+	Sequence       null.Int64  `boil:"sequence" json:"sequence,omitempty" toml:"sequence" yaml:"sequence,omitempty"`
+	PrevHash       null.String `boil:"prev_hash" json:"prev_hash,omitempty" toml:"prev_hash" yaml:"prev_hash,omitempty"`
+	Hash           null.String `boil:"hash" json:"hash,omitempty" toml:"hash" yaml:"hash,omitempty"`
 
 	R *auditLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L auditLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +54,10 @@ var AuditLogColumns = struct {
 	UserAgent      string
 	Details        string
 	CreatedAt      string
+	CredentialID   string
+	Sequence       string
+	PrevHash       string
+	Hash           string
 }{
 	ID:             "id",
 	OrganizationID: "organization_id",
@@ -61,6 +69,10 @@ var AuditLogColumns = struct {
 	UserAgent:      "user_agent",
 	Details:        "details",
 	CreatedAt:      "created_at",
+	CredentialID:   "credential_id",
+	Sequence:       "sequence",
+	PrevHash:       "prev_hash",
+	Hash:           "hash",
 }
 
 var AuditLogTableColumns = struct {
@@ -74,6 +86,10 @@ var AuditLogTableColumns = struct {
 	UserAgent      string
 	Details        string
 	CreatedAt      string
+	CredentialID   string
+	Sequence       string
+	PrevHash       string
+	Hash           string
 }{
 	ID:             "audit_logs.id",
 	OrganizationID: "audit_logs.organization_id",
@@ -85,6 +101,10 @@ var AuditLogTableColumns = struct {
 	UserAgent:      "audit_logs.user_agent",
 	Details:        "audit_logs.details",
 	CreatedAt:      "audit_logs.created_at",
+	CredentialID:   "audit_logs.credential_id",
+	Sequence:       "audit_logs.sequence",
+	PrevHash:       "audit_logs.prev_hash",
+	Hash:           "audit_logs.hash",
 }
 
 // Generated where
@@ -113,6 +133,44 @@ func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
 func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var AuditLogWhere = struct {
 	ID             whereHelperstring
 	OrganizationID whereHelpernull_String
@@ -123,7 +181,11 @@ var AuditLogWhere = struct {
 	IPAddress      whereHelpernull_String
 	UserAgent      whereHelpernull_String
 	Details        whereHelpernull_JSON
-	CreatedAt      whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	CredentialID   whereHelpernull_String
+	Sequence       whereHelpernull_Int64
+	PrevHash       whereHelpernull_String
+	Hash           whereHelpernull_String
 }{
 	ID:             whereHelperstring{field: "\"audit_logs\".\"id\""},
 	OrganizationID: whereHelpernull_String{field: "\"audit_logs\".\"organization_id\""},
@@ -134,22 +196,23 @@ var AuditLogWhere = struct {
 	IPAddress:      whereHelpernull_String{field: "\"audit_logs\".\"ip_address\""},
 	UserAgent:      whereHelpernull_String{field: "\"audit_logs\".\"user_agent\""},
 	Details:        whereHelpernull_JSON{field: "\"audit_logs\".\"details\""},
-	CreatedAt:      whereHelpernull_Time{field: "\"audit_logs\".\"created_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"audit_logs\".\"created_at\""},
+	CredentialID:   whereHelpernull_String{field: "\"audit_logs\".\"credential_id\""},
+	Sequence:       whereHelpernull_Int64{field: "\"audit_logs\".\"sequence\""},
+	PrevHash:       whereHelpernull_String{field: "\"audit_logs\".\"prev_hash\""},
+	Hash:           whereHelpernull_String{field: "\"audit_logs\".\"hash\""},
 }
 
 // AuditLogRels is where relationship names are stored.
 var AuditLogRels = struct {
 	Organization string
-	User         string
 }{
 	Organization: "Organization",
-	User:         "User",
 }
 
 // auditLogR is where relationships are stored.
 type auditLogR struct {
 	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
-	User         *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
 }
 
 // NewStruct creates a new relationship struct
@@ -173,29 +236,13 @@ func (r *auditLogR) GetOrganization() *Organization {
 	return r.Organization
 }
 
-func (o *AuditLog) GetUser() *User {
-	if o == nil {
-		return nil
-	}
-
-	return o.R.GetUser()
-}
-
-func (r *auditLogR) GetUser() *User {
-	if r == nil {
-		return nil
-	}
-
-	return r.User
-}
-
 // auditLogL is where Load methods for each relationship are stored.
 type auditLogL struct{}
 
 var (
-	auditLogAllColumns            = []string{"id", "organization_id", "user_id", "action", "resource_type", "resource_id", "ip_address", "user_agent", "details", "created_at"}
+	auditLogAllColumns            = []string{"id", "organization_id", "user_id", "action", "resource_type", "resource_id", "ip_address", "user_agent", "details", "created_at", "credential_id", "sequence", "prev_hash", "hash"}
 	auditLogColumnsWithoutDefault = []string{"action"}
-	auditLogColumnsWithDefault    = []string{"id", "organization_id", "user_id", "resource_type", "resource_id", "ip_address", "user_agent", "details", "created_at"}
+	auditLogColumnsWithDefault    = []string{"id", "organization_id", "user_id", "resource_type", "resource_id", "ip_address", "user_agent", "details", "created_at", "credential_id", "sequence", "prev_hash", "hash"}
 	auditLogPrimaryKeyColumns     = []string{"id"}
 	auditLogGeneratedColumns      = []string{}
 )
@@ -302,17 +349,6 @@ func (o *AuditLog) Organization(mods ...qm.QueryMod) organizationQuery {
 	return Organizations(queryMods...)
 }
 
-// User pointed to by the foreign key.
-func (o *AuditLog) User(mods ...qm.QueryMod) userQuery {
-	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.UserID),
-	}
-
-	queryMods = append(queryMods, mods...)
-
-	return Users(queryMods...)
-}
-
 // LoadOrganization allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (auditLogL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLog interface{}, mods queries.Applicator) error {
@@ -429,122 +465,6 @@ func (auditLogL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadUser allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (auditLogL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLog interface{}, mods queries.Applicator) error {
-	var slice []*AuditLog
-	var object *AuditLog
-
-	if singular {
-		var ok bool
-		object, ok = maybeAuditLog.(*AuditLog)
-		if !ok {
-			object = new(AuditLog)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditLog)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditLog))
-			}
-		}
-	} else {
-		s, ok := maybeAuditLog.(*[]*AuditLog)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditLog)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditLog))
-			}
-		}
-	}
-
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &auditLogR{}
-		}
-		if !queries.IsNil(object.UserID) {
-			args[object.UserID] = struct{}{}
-		}
-
-	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &auditLogR{}
-			}
-
-			if !queries.IsNil(obj.UserID) {
-				args[obj.UserID] = struct{}{}
-			}
-
-		}
-	}
-
-	if len(args) == 0 {
-		return nil
-	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
-
-	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.id in ?`, argsSlice...),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load User")
-	}
-
-	var resultSlice []*User
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice User")
-	}
-
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for users")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
-	}
-
-	if len(resultSlice) == 0 {
-		return nil
-	}
-
-	if singular {
-		foreign := resultSlice[0]
-		object.R.User = foreign
-		if foreign.R == nil {
-			foreign.R = &userR{}
-		}
-		foreign.R.AuditLogs = append(foreign.R.AuditLogs, object)
-		return nil
-	}
-
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.UserID, foreign.ID) {
-				local.R.User = foreign
-				if foreign.R == nil {
-					foreign.R = &userR{}
-				}
-				foreign.R.AuditLogs = append(foreign.R.AuditLogs, local)
-				break
-			}
-		}
-	}
-
-	return nil
-}
-
 // SetOrganization of the auditLog to the related item.
 // Sets o.R.Organization to related.
 // Adds o to related.R.AuditLogs.
@@ -625,86 +545,6 @@ func (o *AuditLog) RemoveOrganization(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// SetUser of the auditLog to the related item.
-// Sets o.R.User to related.
-// Adds o to related.R.AuditLogs.
-func (o *AuditLog) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"audit_logs\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-		strmangle.WhereClause("\"", "\"", 2, auditLogPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
-
-	queries.Assign(&o.UserID, related.ID)
-	if o.R == nil {
-		o.R = &auditLogR{
-			User: related,
-		}
-	} else {
-		o.R.User = related
-	}
-
-	if related.R == nil {
-		related.R = &userR{
-			AuditLogs: AuditLogSlice{o},
-		}
-	} else {
-		related.R.AuditLogs = append(related.R.AuditLogs, o)
-	}
-
-	return nil
-}
-
-// RemoveUser relationship.
-// Sets o.R.User to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *AuditLog) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
-	var err error
-
-	queries.SetScanner(&o.UserID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
-
-	if o.R != nil {
-		o.R.User = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
-	}
-
-	for i, ri := range related.R.AuditLogs {
-		if queries.Equal(o.UserID, ri.UserID) {
-			continue
-		}
-
-		ln := len(related.R.AuditLogs)
-		if ln > 1 && i < ln-1 {
-			related.R.AuditLogs[i] = related.R.AuditLogs[ln-1]
-		}
-		related.R.AuditLogs = related.R.AuditLogs[:ln-1]
-		break
-	}
-	return nil
-}
-
 // AuditLogs retrieves all the records using an executor.
 func AuditLogs(mods ...qm.QueryMod) auditLogQuery {
 	mods = append(mods, qm.From("\"audit_logs\""))
@@ -753,8 +593,8 @@ func (o *AuditLog) Insert(ctx context.Context, exec boil.ContextExecutor, column
 	if !boil.TimestampsAreSkipped(ctx) {
 		currTime := time.Now().In(boil.GetLocation())
 
-		if queries.MustTime(o.CreatedAt).IsZero() {
-			queries.SetScanner(&o.CreatedAt, currTime)
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
 		}
 	}
 
@@ -958,8 +798,8 @@ func (o *AuditLog) Upsert(ctx context.Context, exec boil.ContextExecutor, update
 	if !boil.TimestampsAreSkipped(ctx) {
 		currTime := time.Now().In(boil.GetLocation())
 
-		if queries.MustTime(o.CreatedAt).IsZero() {
-			queries.SetScanner(&o.CreatedAt, currTime)
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
 		}
 	}
 
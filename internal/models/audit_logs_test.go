@@ -405,58 +405,6 @@ func testAuditLogToOneOrganizationUsingOrganization(t *testing.T) {
 
 }
 
-func testAuditLogToOneUserUsingUser(t *testing.T) {
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var local AuditLog
-	var foreign User
-
-	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize AuditLog struct: %s", err)
-	}
-	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize User struct: %s", err)
-	}
-
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	queries.Assign(&local.UserID, foreign.ID)
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	check, err := local.User().One(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if !queries.Equal(check.ID, foreign.ID) {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
-	}
-
-	slice := AuditLogSlice{&local}
-	if err = local.L.LoadUser(ctx, tx, false, (*[]*AuditLog)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.User == nil {
-		t.Error("struct should have been eager loaded")
-	}
-
-	local.R.User = nil
-	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.User == nil {
-		t.Error("struct should have been eager loaded")
-	}
-
-}
-
 func testAuditLogToOneSetOpOrganizationUsingOrganization(t *testing.T) {
 	var err error
 
@@ -566,115 +514,6 @@ func testAuditLogToOneRemoveOpOrganizationUsingOrganization(t *testing.T) {
 	}
 }
 
-func testAuditLogToOneSetOpUserUsingUser(t *testing.T) {
-	var err error
-
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var a AuditLog
-	var b, c User
-
-	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	for i, x := range []*User{&b, &c} {
-		err = a.SetUser(ctx, tx, i != 0, x)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		if a.R.User != x {
-			t.Error("relationship struct not set to correct value")
-		}
-
-		if x.R.AuditLogs[0] != &a {
-			t.Error("failed to append to foreign relationship struct")
-		}
-		if !queries.Equal(a.UserID, x.ID) {
-			t.Error("foreign key was wrong value", a.UserID)
-		}
-
-		zero := reflect.Zero(reflect.TypeOf(a.UserID))
-		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
-
-		if err = a.Reload(ctx, tx); err != nil {
-			t.Fatal("failed to reload", err)
-		}
-
-		if !queries.Equal(a.UserID, x.ID) {
-			t.Error("foreign key was wrong value", a.UserID, x.ID)
-		}
-	}
-}
-
-func testAuditLogToOneRemoveOpUserUsingUser(t *testing.T) {
-	var err error
-
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var a AuditLog
-	var b User
-
-	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-
-	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
-	}
-
-	if err = a.SetUser(ctx, tx, true, &b); err != nil {
-		t.Fatal(err)
-	}
-
-	if err = a.RemoveUser(ctx, tx, &b); err != nil {
-		t.Error("failed to remove relationship")
-	}
-
-	count, err := a.User().Count(ctx, tx)
-	if err != nil {
-		t.Error(err)
-	}
-	if count != 0 {
-		t.Error("want no relationships remaining")
-	}
-
-	if a.R.User != nil {
-		t.Error("R struct entry should be nil")
-	}
-
-	if !queries.IsValuerNil(a.UserID) {
-		t.Error("foreign key value should be nil")
-	}
-
-	if len(b.R.AuditLogs) != 0 {
-		t.Error("failed to remove a from b's relationships")
-	}
-}
-
 func testAuditLogsReload(t *testing.T) {
 	t.Parallel()
 
@@ -749,7 +588,7 @@ func testAuditLogsSelect(t *testing.T) {
 }
 
 var (
-	auditLogDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `UserID`: `uuid`, `Action`: `character varying`, `ResourceType`: `character varying`, `ResourceID`: `character varying`, `IPAddress`: `character varying`, `UserAgent`: `text`, `Details`: `jsonb`, `CreatedAt`: `timestamp with time zone`}
+	auditLogDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `UserID`: `uuid`, `Action`: `character varying`, `ResourceType`: `character varying`, `ResourceID`: `character varying`, `IPAddress`: `character varying`, `UserAgent`: `text`, `Details`: `jsonb`, `CreatedAt`: `timestamp with time zone`, `CredentialID`: `text`, `Sequence`: `bigint`, `PrevHash`: `text`, `Hash`: `text`}
 	_               = bytes.MinRead
 )
 
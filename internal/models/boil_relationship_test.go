@@ -9,136 +9,263 @@ import "testing"
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
 	t.Run("AccessTokenToUserUsingUser", testAccessTokenToOneUserUsingUser)
+	t.Run("AddressBookToUserUsingApprovedByUser", testAddressBookToOneUserUsingApprovedByUser)
 	t.Run("AddressBookToChainUsingChain", testAddressBookToOneChainUsingChain)
 	t.Run("AddressBookToUserUsingCreatedByUser", testAddressBookToOneUserUsingCreatedByUser)
 	t.Run("AddressBookToOrganizationUsingOrganization", testAddressBookToOneOrganizationUsingOrganization)
+	t.Run("AppUserProfileToOrganizationUsingDefaultOrganization", testAppUserProfileToOneOrganizationUsingDefaultOrganization)
 	t.Run("AppUserProfileToUserUsingUser", testAppUserProfileToOneUserUsingUser)
 	t.Run("ApprovalToSigningRequestUsingRequest", testApprovalToOneSigningRequestUsingRequest)
 	t.Run("ApprovalToUserUsingUser", testApprovalToOneUserUsingUser)
 	t.Run("AssetToChainUsingChain", testAssetToOneChainUsingChain)
+	t.Run("AuditCheckpointToOrganizationUsingOrganization", testAuditCheckpointToOneOrganizationUsingOrganization)
 	t.Run("AuditLogToOrganizationUsingOrganization", testAuditLogToOneOrganizationUsingOrganization)
-	t.Run("AuditLogToUserUsingUser", testAuditLogToOneUserUsingUser)
+	t.Run("ChainScanCursorToChainUsingChain", testChainScanCursorToOneChainUsingChain)
 	t.Run("ConfirmationTokenToUserUsingUser", testConfirmationTokenToOneUserUsingUser)
+	t.Run("DepositToAssetUsingAsset", testDepositToOneAssetUsingAsset)
+	t.Run("DepositToChainUsingChain", testDepositToOneChainUsingChain)
+	t.Run("DepositToWalletUsingWallet", testDepositToOneWalletUsingWallet)
+	t.Run("KeyBackupHealthToOrganizationUsingOrganization", testKeyBackupHealthToOneOrganizationUsingOrganization)
+	t.Run("KeyBackupHealthToVaultUsingVault", testKeyBackupHealthToOneVaultUsingVault)
+	t.Run("KeyRefreshToVaultProposalUsingProposal", testKeyRefreshToOneVaultProposalUsingProposal)
+	t.Run("KeyRefreshToVaultUsingVault", testKeyRefreshToOneVaultUsingVault)
+	t.Run("KeyShareBackupToOrganizationUsingOrganization", testKeyShareBackupToOneOrganizationUsingOrganization)
+	t.Run("KeyShareBackupToUserUsingUser", testKeyShareBackupToOneUserUsingUser)
+	t.Run("KeyShareRecoveryToUserUsingInitiator", testKeyShareRecoveryToOneUserUsingInitiator)
+	t.Run("KeyShareRecoveryToOrganizationUsingOrganization", testKeyShareRecoveryToOneOrganizationUsingOrganization)
+	t.Run("KeyShareRecoveryToUserUsingSubjectUser", testKeyShareRecoveryToOneUserUsingSubjectUser)
+	t.Run("KeyShareRecoveryToVaultUsingVault", testKeyShareRecoveryToOneVaultUsingVault)
+	t.Run("KeyShareRecoveryApprovalToKeyShareRecoveryUsingRecovery", testKeyShareRecoveryApprovalToOneKeyShareRecoveryUsingRecovery)
+	t.Run("KeyShareRecoveryApprovalToUserUsingUser", testKeyShareRecoveryApprovalToOneUserUsingUser)
+	t.Run("NotificationPreferenceToOrganizationUsingOrganization", testNotificationPreferenceToOneOrganizationUsingOrganization)
+	t.Run("NotificationPreferenceToUserUsingUser", testNotificationPreferenceToOneUserUsingUser)
+	t.Run("OrganizationInvitationToUserUsingAcceptedByUser", testOrganizationInvitationToOneUserUsingAcceptedByUser)
+	t.Run("OrganizationInvitationToUserUsingInvitedByUser", testOrganizationInvitationToOneUserUsingInvitedByUser)
+	t.Run("OrganizationInvitationToOrganizationUsingOrganization", testOrganizationInvitationToOneOrganizationUsingOrganization)
 	t.Run("OrganizationMemberToOrganizationUsingOrganization", testOrganizationMemberToOneOrganizationUsingOrganization)
 	t.Run("OrganizationMemberToUserUsingUser", testOrganizationMemberToOneUserUsingUser)
 	t.Run("OrganizationToUserUsingOwner", testOrganizationToOneUserUsingOwner)
+	t.Run("OutboxEventToOrganizationUsingOrganization", testOutboxEventToOneOrganizationUsingOrganization)
+	t.Run("PasskeyChallengeToUserUsingUser", testPasskeyChallengeToOneUserUsingUser)
 	t.Run("PasswordResetTokenToUserUsingUser", testPasswordResetTokenToOneUserUsingUser)
 	t.Run("PushTokenToUserUsingUser", testPushTokenToOneUserUsingUser)
 	t.Run("RefreshTokenToUserUsingUser", testRefreshTokenToOneUserUsingUser)
+	t.Run("RootKeyToVaultProposalUsingCompletionProposal", testRootKeyToOneVaultProposalUsingCompletionProposal)
+	t.Run("RootKeyToVaultProposalUsingRetirementProposal", testRootKeyToOneVaultProposalUsingRetirementProposal)
+	t.Run("RootKeyToVaultUsingVault", testRootKeyToOneVaultUsingVault)
 	t.Run("SigningRequestToUserUsingInitiator", testSigningRequestToOneUserUsingInitiator)
 	t.Run("SigningRequestToVaultUsingVault", testSigningRequestToOneVaultUsingVault)
 	t.Run("SigningRequestToWalletUsingWallet", testSigningRequestToOneWalletUsingWallet)
 	t.Run("SpendingLimitToAssetUsingAsset", testSpendingLimitToOneAssetUsingAsset)
 	t.Run("SpendingLimitToVaultUsingVault", testSpendingLimitToOneVaultUsingVault)
 	t.Run("UserCredentialToUserUsingUser", testUserCredentialToOneUserUsingUser)
+	t.Run("UserDeviceToUserCredentialUsingCredential", testUserDeviceToOneUserCredentialUsingCredential)
+	t.Run("UserDeviceToUserUsingUser", testUserDeviceToOneUserUsingUser)
 	t.Run("VaultKeyToVaultUsingVault", testVaultKeyToOneVaultUsingVault)
+	t.Run("VaultProposalApprovalToUserUsingUser", testVaultProposalApprovalToOneUserUsingUser)
+	t.Run("VaultProposalApprovalToVaultProposalUsingVaultProposal", testVaultProposalApprovalToOneVaultProposalUsingVaultProposal)
+	t.Run("VaultProposalToUserUsingInitiator", testVaultProposalToOneUserUsingInitiator)
+	t.Run("VaultProposalToVaultUsingVault", testVaultProposalToOneVaultUsingVault)
 	t.Run("VaultToOrganizationUsingOrganization", testVaultToOneOrganizationUsingOrganization)
 	t.Run("WalletBalanceToAssetUsingAsset", testWalletBalanceToOneAssetUsingAsset)
 	t.Run("WalletBalanceToWalletUsingWallet", testWalletBalanceToOneWalletUsingWallet)
 	t.Run("WalletToChainUsingChain", testWalletToOneChainUsingChain)
 	t.Run("WalletToVaultUsingVault", testWalletToOneVaultUsingVault)
+	t.Run("WebhookDeliveryToWebhookEndpointUsingEndpoint", testWebhookDeliveryToOneWebhookEndpointUsingEndpoint)
+	t.Run("WebhookDeliveryToOutboxEventUsingEvent", testWebhookDeliveryToOneOutboxEventUsingEvent)
+	t.Run("WebhookEndpointToUserUsingCreatedByUser", testWebhookEndpointToOneUserUsingCreatedByUser)
+	t.Run("WebhookEndpointToOrganizationUsingOrganization", testWebhookEndpointToOneOrganizationUsingOrganization)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
+	t.Run("ChainToChainScanCursorUsingChainScanCursor", testChainOneToOneChainScanCursorUsingChainScanCursor)
 	t.Run("UserToAppUserProfileUsingAppUserProfile", testUserOneToOneAppUserProfileUsingAppUserProfile)
 }
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("AssetToDeposits", testAssetToManyDeposits)
 	t.Run("AssetToSpendingLimits", testAssetToManySpendingLimits)
 	t.Run("AssetToWalletBalances", testAssetToManyWalletBalances)
 	t.Run("ChainToAddressBooks", testChainToManyAddressBooks)
 	t.Run("ChainToAssets", testChainToManyAssets)
+	t.Run("ChainToDeposits", testChainToManyDeposits)
 	t.Run("ChainToWallets", testChainToManyWallets)
+	t.Run("KeyShareRecoveryToRecoveryKeyShareRecoveryApprovals", testKeyShareRecoveryToManyRecoveryKeyShareRecoveryApprovals)
 	t.Run("OrganizationToAddressBooks", testOrganizationToManyAddressBooks)
+	t.Run("OrganizationToDefaultOrganizationAppUserProfiles", testOrganizationToManyDefaultOrganizationAppUserProfiles)
+	t.Run("OrganizationToAuditCheckpoints", testOrganizationToManyAuditCheckpoints)
 	t.Run("OrganizationToAuditLogs", testOrganizationToManyAuditLogs)
+	t.Run("OrganizationToKeyBackupHealths", testOrganizationToManyKeyBackupHealths)
+	t.Run("OrganizationToKeyShareBackups", testOrganizationToManyKeyShareBackups)
+	t.Run("OrganizationToKeyShareRecoveries", testOrganizationToManyKeyShareRecoveries)
+	t.Run("OrganizationToNotificationPreferences", testOrganizationToManyNotificationPreferences)
+	t.Run("OrganizationToOrganizationInvitations", testOrganizationToManyOrganizationInvitations)
 	t.Run("OrganizationToOrganizationMembers", testOrganizationToManyOrganizationMembers)
+	t.Run("OrganizationToOutboxEvents", testOrganizationToManyOutboxEvents)
 	t.Run("OrganizationToVaults", testOrganizationToManyVaults)
+	t.Run("OrganizationToWebhookEndpoints", testOrganizationToManyWebhookEndpoints)
+	t.Run("OutboxEventToEventWebhookDeliveries", testOutboxEventToManyEventWebhookDeliveries)
 	t.Run("SigningRequestToRequestApprovals", testSigningRequestToManyRequestApprovals)
+	t.Run("UserCredentialToCredentialUserDevices", testUserCredentialToManyCredentialUserDevices)
 	t.Run("UserToAccessTokens", testUserToManyAccessTokens)
+	t.Run("UserToApprovedByAddressBooks", testUserToManyApprovedByAddressBooks)
 	t.Run("UserToCreatedByAddressBooks", testUserToManyCreatedByAddressBooks)
 	t.Run("UserToApprovals", testUserToManyApprovals)
-	t.Run("UserToAuditLogs", testUserToManyAuditLogs)
 	t.Run("UserToConfirmationTokens", testUserToManyConfirmationTokens)
+	t.Run("UserToKeyShareBackups", testUserToManyKeyShareBackups)
+	t.Run("UserToInitiatorKeyShareRecoveries", testUserToManyInitiatorKeyShareRecoveries)
+	t.Run("UserToSubjectUserKeyShareRecoveries", testUserToManySubjectUserKeyShareRecoveries)
+	t.Run("UserToKeyShareRecoveryApprovals", testUserToManyKeyShareRecoveryApprovals)
+	t.Run("UserToNotificationPreferences", testUserToManyNotificationPreferences)
+	t.Run("UserToAcceptedByOrganizationInvitations", testUserToManyAcceptedByOrganizationInvitations)
+	t.Run("UserToInvitedByOrganizationInvitations", testUserToManyInvitedByOrganizationInvitations)
 	t.Run("UserToOrganizationMembers", testUserToManyOrganizationMembers)
 	t.Run("UserToOwnerOrganizations", testUserToManyOwnerOrganizations)
+	t.Run("UserToPasskeyChallenges", testUserToManyPasskeyChallenges)
 	t.Run("UserToPasswordResetTokens", testUserToManyPasswordResetTokens)
 	t.Run("UserToPushTokens", testUserToManyPushTokens)
 	t.Run("UserToRefreshTokens", testUserToManyRefreshTokens)
 	t.Run("UserToInitiatorSigningRequests", testUserToManyInitiatorSigningRequests)
 	t.Run("UserToUserCredentials", testUserToManyUserCredentials)
+	t.Run("UserToUserDevices", testUserToManyUserDevices)
+	t.Run("UserToVaultProposalApprovals", testUserToManyVaultProposalApprovals)
+	t.Run("UserToInitiatorVaultProposals", testUserToManyInitiatorVaultProposals)
+	t.Run("UserToCreatedByWebhookEndpoints", testUserToManyCreatedByWebhookEndpoints)
+	t.Run("VaultProposalToProposalKeyRefreshes", testVaultProposalToManyProposalKeyRefreshes)
+	t.Run("VaultProposalToCompletionProposalRootKeys", testVaultProposalToManyCompletionProposalRootKeys)
+	t.Run("VaultProposalToRetirementProposalRootKeys", testVaultProposalToManyRetirementProposalRootKeys)
+	t.Run("VaultProposalToVaultProposalApprovals", testVaultProposalToManyVaultProposalApprovals)
+	t.Run("VaultToKeyBackupHealths", testVaultToManyKeyBackupHealths)
+	t.Run("VaultToKeyRefreshes", testVaultToManyKeyRefreshes)
+	t.Run("VaultToKeyShareRecoveries", testVaultToManyKeyShareRecoveries)
+	t.Run("VaultToRootKeys", testVaultToManyRootKeys)
 	t.Run("VaultToSigningRequests", testVaultToManySigningRequests)
 	t.Run("VaultToSpendingLimits", testVaultToManySpendingLimits)
 	t.Run("VaultToVaultKeys", testVaultToManyVaultKeys)
+	t.Run("VaultToVaultProposals", testVaultToManyVaultProposals)
 	t.Run("VaultToWallets", testVaultToManyWallets)
+	t.Run("WalletToDeposits", testWalletToManyDeposits)
 	t.Run("WalletToSigningRequests", testWalletToManySigningRequests)
 	t.Run("WalletToWalletBalances", testWalletToManyWalletBalances)
+	t.Run("WebhookEndpointToEndpointWebhookDeliveries", testWebhookEndpointToManyEndpointWebhookDeliveries)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
 	t.Run("AccessTokenToUserUsingAccessTokens", testAccessTokenToOneSetOpUserUsingUser)
+	t.Run("AddressBookToUserUsingApprovedByAddressBooks", testAddressBookToOneSetOpUserUsingApprovedByUser)
 	t.Run("AddressBookToChainUsingAddressBooks", testAddressBookToOneSetOpChainUsingChain)
 	t.Run("AddressBookToUserUsingCreatedByAddressBooks", testAddressBookToOneSetOpUserUsingCreatedByUser)
 	t.Run("AddressBookToOrganizationUsingAddressBooks", testAddressBookToOneSetOpOrganizationUsingOrganization)
+	t.Run("AppUserProfileToOrganizationUsingDefaultOrganizationAppUserProfiles", testAppUserProfileToOneSetOpOrganizationUsingDefaultOrganization)
 	t.Run("AppUserProfileToUserUsingAppUserProfile", testAppUserProfileToOneSetOpUserUsingUser)
 	t.Run("ApprovalToSigningRequestUsingRequestApprovals", testApprovalToOneSetOpSigningRequestUsingRequest)
 	t.Run("ApprovalToUserUsingApprovals", testApprovalToOneSetOpUserUsingUser)
 	t.Run("AssetToChainUsingAssets", testAssetToOneSetOpChainUsingChain)
+	t.Run("AuditCheckpointToOrganizationUsingAuditCheckpoints", testAuditCheckpointToOneSetOpOrganizationUsingOrganization)
 	t.Run("AuditLogToOrganizationUsingAuditLogs", testAuditLogToOneSetOpOrganizationUsingOrganization)
-	t.Run("AuditLogToUserUsingAuditLogs", testAuditLogToOneSetOpUserUsingUser)
+	t.Run("ChainScanCursorToChainUsingChainScanCursor", testChainScanCursorToOneSetOpChainUsingChain)
 	t.Run("ConfirmationTokenToUserUsingConfirmationTokens", testConfirmationTokenToOneSetOpUserUsingUser)
+	t.Run("DepositToAssetUsingDeposits", testDepositToOneSetOpAssetUsingAsset)
+	t.Run("DepositToChainUsingDeposits", testDepositToOneSetOpChainUsingChain)
+	t.Run("DepositToWalletUsingDeposits", testDepositToOneSetOpWalletUsingWallet)
+	t.Run("KeyBackupHealthToOrganizationUsingKeyBackupHealths", testKeyBackupHealthToOneSetOpOrganizationUsingOrganization)
+	t.Run("KeyBackupHealthToVaultUsingKeyBackupHealths", testKeyBackupHealthToOneSetOpVaultUsingVault)
+	t.Run("KeyRefreshToVaultProposalUsingProposalKeyRefreshes", testKeyRefreshToOneSetOpVaultProposalUsingProposal)
+	t.Run("KeyRefreshToVaultUsingKeyRefreshes", testKeyRefreshToOneSetOpVaultUsingVault)
+	t.Run("KeyShareBackupToOrganizationUsingKeyShareBackups", testKeyShareBackupToOneSetOpOrganizationUsingOrganization)
+	t.Run("KeyShareBackupToUserUsingKeyShareBackups", testKeyShareBackupToOneSetOpUserUsingUser)
+	t.Run("KeyShareRecoveryToUserUsingInitiatorKeyShareRecoveries", testKeyShareRecoveryToOneSetOpUserUsingInitiator)
+	t.Run("KeyShareRecoveryToOrganizationUsingKeyShareRecoveries", testKeyShareRecoveryToOneSetOpOrganizationUsingOrganization)
+	t.Run("KeyShareRecoveryToUserUsingSubjectUserKeyShareRecoveries", testKeyShareRecoveryToOneSetOpUserUsingSubjectUser)
+	t.Run("KeyShareRecoveryToVaultUsingKeyShareRecoveries", testKeyShareRecoveryToOneSetOpVaultUsingVault)
+	t.Run("KeyShareRecoveryApprovalToKeyShareRecoveryUsingRecoveryKeyShareRecoveryApprovals", testKeyShareRecoveryApprovalToOneSetOpKeyShareRecoveryUsingRecovery)
+	t.Run("KeyShareRecoveryApprovalToUserUsingKeyShareRecoveryApprovals", testKeyShareRecoveryApprovalToOneSetOpUserUsingUser)
+	t.Run("NotificationPreferenceToOrganizationUsingNotificationPreferences", testNotificationPreferenceToOneSetOpOrganizationUsingOrganization)
+	t.Run("NotificationPreferenceToUserUsingNotificationPreferences", testNotificationPreferenceToOneSetOpUserUsingUser)
+	t.Run("OrganizationInvitationToUserUsingAcceptedByOrganizationInvitations", testOrganizationInvitationToOneSetOpUserUsingAcceptedByUser)
+	t.Run("OrganizationInvitationToUserUsingInvitedByOrganizationInvitations", testOrganizationInvitationToOneSetOpUserUsingInvitedByUser)
+	t.Run("OrganizationInvitationToOrganizationUsingOrganizationInvitations", testOrganizationInvitationToOneSetOpOrganizationUsingOrganization)
 	t.Run("OrganizationMemberToOrganizationUsingOrganizationMembers", testOrganizationMemberToOneSetOpOrganizationUsingOrganization)
 	t.Run("OrganizationMemberToUserUsingOrganizationMembers", testOrganizationMemberToOneSetOpUserUsingUser)
 	t.Run("OrganizationToUserUsingOwnerOrganizations", testOrganizationToOneSetOpUserUsingOwner)
+	t.Run("OutboxEventToOrganizationUsingOutboxEvents", testOutboxEventToOneSetOpOrganizationUsingOrganization)
+	t.Run("PasskeyChallengeToUserUsingPasskeyChallenges", testPasskeyChallengeToOneSetOpUserUsingUser)
 	t.Run("PasswordResetTokenToUserUsingPasswordResetTokens", testPasswordResetTokenToOneSetOpUserUsingUser)
 	t.Run("PushTokenToUserUsingPushTokens", testPushTokenToOneSetOpUserUsingUser)
 	t.Run("RefreshTokenToUserUsingRefreshTokens", testRefreshTokenToOneSetOpUserUsingUser)
+	t.Run("RootKeyToVaultProposalUsingCompletionProposalRootKeys", testRootKeyToOneSetOpVaultProposalUsingCompletionProposal)
+	t.Run("RootKeyToVaultProposalUsingRetirementProposalRootKeys", testRootKeyToOneSetOpVaultProposalUsingRetirementProposal)
+	t.Run("RootKeyToVaultUsingRootKeys", testRootKeyToOneSetOpVaultUsingVault)
 	t.Run("SigningRequestToUserUsingInitiatorSigningRequests", testSigningRequestToOneSetOpUserUsingInitiator)
 	t.Run("SigningRequestToVaultUsingSigningRequests", testSigningRequestToOneSetOpVaultUsingVault)
 	t.Run("SigningRequestToWalletUsingSigningRequests", testSigningRequestToOneSetOpWalletUsingWallet)
 	t.Run("SpendingLimitToAssetUsingSpendingLimits", testSpendingLimitToOneSetOpAssetUsingAsset)
 	t.Run("SpendingLimitToVaultUsingSpendingLimits", testSpendingLimitToOneSetOpVaultUsingVault)
 	t.Run("UserCredentialToUserUsingUserCredentials", testUserCredentialToOneSetOpUserUsingUser)
+	t.Run("UserDeviceToUserCredentialUsingCredentialUserDevices", testUserDeviceToOneSetOpUserCredentialUsingCredential)
+	t.Run("UserDeviceToUserUsingUserDevices", testUserDeviceToOneSetOpUserUsingUser)
 	t.Run("VaultKeyToVaultUsingVaultKeys", testVaultKeyToOneSetOpVaultUsingVault)
+	t.Run("VaultProposalApprovalToUserUsingVaultProposalApprovals", testVaultProposalApprovalToOneSetOpUserUsingUser)
+	t.Run("VaultProposalApprovalToVaultProposalUsingVaultProposalApprovals", testVaultProposalApprovalToOneSetOpVaultProposalUsingVaultProposal)
+	t.Run("VaultProposalToUserUsingInitiatorVaultProposals", testVaultProposalToOneSetOpUserUsingInitiator)
+	t.Run("VaultProposalToVaultUsingVaultProposals", testVaultProposalToOneSetOpVaultUsingVault)
 	t.Run("VaultToOrganizationUsingVaults", testVaultToOneSetOpOrganizationUsingOrganization)
 	t.Run("WalletBalanceToAssetUsingWalletBalances", testWalletBalanceToOneSetOpAssetUsingAsset)
 	t.Run("WalletBalanceToWalletUsingWalletBalances", testWalletBalanceToOneSetOpWalletUsingWallet)
 	t.Run("WalletToChainUsingWallets", testWalletToOneSetOpChainUsingChain)
 	t.Run("WalletToVaultUsingWallets", testWalletToOneSetOpVaultUsingVault)
+	t.Run("WebhookDeliveryToWebhookEndpointUsingEndpointWebhookDeliveries", testWebhookDeliveryToOneSetOpWebhookEndpointUsingEndpoint)
+	t.Run("WebhookDeliveryToOutboxEventUsingEventWebhookDeliveries", testWebhookDeliveryToOneSetOpOutboxEventUsingEvent)
+	t.Run("WebhookEndpointToUserUsingCreatedByWebhookEndpoints", testWebhookEndpointToOneSetOpUserUsingCreatedByUser)
+	t.Run("WebhookEndpointToOrganizationUsingWebhookEndpoints", testWebhookEndpointToOneSetOpOrganizationUsingOrganization)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
+	t.Run("AddressBookToUserUsingApprovedByAddressBooks", testAddressBookToOneRemoveOpUserUsingApprovedByUser)
 	t.Run("AddressBookToChainUsingAddressBooks", testAddressBookToOneRemoveOpChainUsingChain)
 	t.Run("AddressBookToUserUsingCreatedByAddressBooks", testAddressBookToOneRemoveOpUserUsingCreatedByUser)
 	t.Run("AddressBookToOrganizationUsingAddressBooks", testAddressBookToOneRemoveOpOrganizationUsingOrganization)
+	t.Run("AppUserProfileToOrganizationUsingDefaultOrganizationAppUserProfiles", testAppUserProfileToOneRemoveOpOrganizationUsingDefaultOrganization)
 	t.Run("ApprovalToSigningRequestUsingRequestApprovals", testApprovalToOneRemoveOpSigningRequestUsingRequest)
 	t.Run("ApprovalToUserUsingApprovals", testApprovalToOneRemoveOpUserUsingUser)
 	t.Run("AssetToChainUsingAssets", testAssetToOneRemoveOpChainUsingChain)
+	t.Run("AuditCheckpointToOrganizationUsingAuditCheckpoints", testAuditCheckpointToOneRemoveOpOrganizationUsingOrganization)
 	t.Run("AuditLogToOrganizationUsingAuditLogs", testAuditLogToOneRemoveOpOrganizationUsingOrganization)
-	t.Run("AuditLogToUserUsingAuditLogs", testAuditLogToOneRemoveOpUserUsingUser)
+	t.Run("DepositToAssetUsingDeposits", testDepositToOneRemoveOpAssetUsingAsset)
+	t.Run("KeyRefreshToVaultProposalUsingProposalKeyRefreshes", testKeyRefreshToOneRemoveOpVaultProposalUsingProposal)
+	t.Run("KeyShareRecoveryToUserUsingInitiatorKeyShareRecoveries", testKeyShareRecoveryToOneRemoveOpUserUsingInitiator)
+	t.Run("OrganizationInvitationToUserUsingAcceptedByOrganizationInvitations", testOrganizationInvitationToOneRemoveOpUserUsingAcceptedByUser)
+	t.Run("OrganizationInvitationToUserUsingInvitedByOrganizationInvitations", testOrganizationInvitationToOneRemoveOpUserUsingInvitedByUser)
+	t.Run("RootKeyToVaultProposalUsingCompletionProposalRootKeys", testRootKeyToOneRemoveOpVaultProposalUsingCompletionProposal)
+	t.Run("RootKeyToVaultProposalUsingRetirementProposalRootKeys", testRootKeyToOneRemoveOpVaultProposalUsingRetirementProposal)
 	t.Run("SigningRequestToUserUsingInitiatorSigningRequests", testSigningRequestToOneRemoveOpUserUsingInitiator)
 	t.Run("SigningRequestToVaultUsingSigningRequests", testSigningRequestToOneRemoveOpVaultUsingVault)
 	t.Run("SigningRequestToWalletUsingSigningRequests", testSigningRequestToOneRemoveOpWalletUsingWallet)
 	t.Run("SpendingLimitToAssetUsingSpendingLimits", testSpendingLimitToOneRemoveOpAssetUsingAsset)
 	t.Run("SpendingLimitToVaultUsingSpendingLimits", testSpendingLimitToOneRemoveOpVaultUsingVault)
+	t.Run("UserDeviceToUserCredentialUsingCredentialUserDevices", testUserDeviceToOneRemoveOpUserCredentialUsingCredential)
 	t.Run("VaultKeyToVaultUsingVaultKeys", testVaultKeyToOneRemoveOpVaultUsingVault)
+	t.Run("VaultProposalToUserUsingInitiatorVaultProposals", testVaultProposalToOneRemoveOpUserUsingInitiator)
 	t.Run("VaultToOrganizationUsingVaults", testVaultToOneRemoveOpOrganizationUsingOrganization)
 	t.Run("WalletBalanceToAssetUsingWalletBalances", testWalletBalanceToOneRemoveOpAssetUsingAsset)
 	t.Run("WalletBalanceToWalletUsingWalletBalances", testWalletBalanceToOneRemoveOpWalletUsingWallet)
 	t.Run("WalletToChainUsingWallets", testWalletToOneRemoveOpChainUsingChain)
 	t.Run("WalletToVaultUsingWallets", testWalletToOneRemoveOpVaultUsingVault)
+	t.Run("WebhookEndpointToUserUsingCreatedByWebhookEndpoints", testWebhookEndpointToOneRemoveOpUserUsingCreatedByUser)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
+	t.Run("ChainToChainScanCursorUsingChainScanCursor", testChainOneToOneSetOpChainScanCursorUsingChainScanCursor)
 	t.Run("UserToAppUserProfileUsingAppUserProfile", testUserOneToOneSetOpAppUserProfileUsingAppUserProfile)
 }
 
@@ -149,52 +276,101 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("AssetToDeposits", testAssetToManyAddOpDeposits)
 	t.Run("AssetToSpendingLimits", testAssetToManyAddOpSpendingLimits)
 	t.Run("AssetToWalletBalances", testAssetToManyAddOpWalletBalances)
 	t.Run("ChainToAddressBooks", testChainToManyAddOpAddressBooks)
 	t.Run("ChainToAssets", testChainToManyAddOpAssets)
+	t.Run("ChainToDeposits", testChainToManyAddOpDeposits)
 	t.Run("ChainToWallets", testChainToManyAddOpWallets)
+	t.Run("KeyShareRecoveryToRecoveryKeyShareRecoveryApprovals", testKeyShareRecoveryToManyAddOpRecoveryKeyShareRecoveryApprovals)
 	t.Run("OrganizationToAddressBooks", testOrganizationToManyAddOpAddressBooks)
+	t.Run("OrganizationToDefaultOrganizationAppUserProfiles", testOrganizationToManyAddOpDefaultOrganizationAppUserProfiles)
+	t.Run("OrganizationToAuditCheckpoints", testOrganizationToManyAddOpAuditCheckpoints)
 	t.Run("OrganizationToAuditLogs", testOrganizationToManyAddOpAuditLogs)
+	t.Run("OrganizationToKeyBackupHealths", testOrganizationToManyAddOpKeyBackupHealths)
+	t.Run("OrganizationToKeyShareBackups", testOrganizationToManyAddOpKeyShareBackups)
+	t.Run("OrganizationToKeyShareRecoveries", testOrganizationToManyAddOpKeyShareRecoveries)
+	t.Run("OrganizationToNotificationPreferences", testOrganizationToManyAddOpNotificationPreferences)
+	t.Run("OrganizationToOrganizationInvitations", testOrganizationToManyAddOpOrganizationInvitations)
 	t.Run("OrganizationToOrganizationMembers", testOrganizationToManyAddOpOrganizationMembers)
+	t.Run("OrganizationToOutboxEvents", testOrganizationToManyAddOpOutboxEvents)
 	t.Run("OrganizationToVaults", testOrganizationToManyAddOpVaults)
+	t.Run("OrganizationToWebhookEndpoints", testOrganizationToManyAddOpWebhookEndpoints)
+	t.Run("OutboxEventToEventWebhookDeliveries", testOutboxEventToManyAddOpEventWebhookDeliveries)
 	t.Run("SigningRequestToRequestApprovals", testSigningRequestToManyAddOpRequestApprovals)
+	t.Run("UserCredentialToCredentialUserDevices", testUserCredentialToManyAddOpCredentialUserDevices)
 	t.Run("UserToAccessTokens", testUserToManyAddOpAccessTokens)
+	t.Run("UserToApprovedByAddressBooks", testUserToManyAddOpApprovedByAddressBooks)
 	t.Run("UserToCreatedByAddressBooks", testUserToManyAddOpCreatedByAddressBooks)
 	t.Run("UserToApprovals", testUserToManyAddOpApprovals)
-	t.Run("UserToAuditLogs", testUserToManyAddOpAuditLogs)
 	t.Run("UserToConfirmationTokens", testUserToManyAddOpConfirmationTokens)
+	t.Run("UserToKeyShareBackups", testUserToManyAddOpKeyShareBackups)
+	t.Run("UserToInitiatorKeyShareRecoveries", testUserToManyAddOpInitiatorKeyShareRecoveries)
+	t.Run("UserToSubjectUserKeyShareRecoveries", testUserToManyAddOpSubjectUserKeyShareRecoveries)
+	t.Run("UserToKeyShareRecoveryApprovals", testUserToManyAddOpKeyShareRecoveryApprovals)
+	t.Run("UserToNotificationPreferences", testUserToManyAddOpNotificationPreferences)
+	t.Run("UserToAcceptedByOrganizationInvitations", testUserToManyAddOpAcceptedByOrganizationInvitations)
+	t.Run("UserToInvitedByOrganizationInvitations", testUserToManyAddOpInvitedByOrganizationInvitations)
 	t.Run("UserToOrganizationMembers", testUserToManyAddOpOrganizationMembers)
 	t.Run("UserToOwnerOrganizations", testUserToManyAddOpOwnerOrganizations)
+	t.Run("UserToPasskeyChallenges", testUserToManyAddOpPasskeyChallenges)
 	t.Run("UserToPasswordResetTokens", testUserToManyAddOpPasswordResetTokens)
 	t.Run("UserToPushTokens", testUserToManyAddOpPushTokens)
 	t.Run("UserToRefreshTokens", testUserToManyAddOpRefreshTokens)
 	t.Run("UserToInitiatorSigningRequests", testUserToManyAddOpInitiatorSigningRequests)
 	t.Run("UserToUserCredentials", testUserToManyAddOpUserCredentials)
+	t.Run("UserToUserDevices", testUserToManyAddOpUserDevices)
+	t.Run("UserToVaultProposalApprovals", testUserToManyAddOpVaultProposalApprovals)
+	t.Run("UserToInitiatorVaultProposals", testUserToManyAddOpInitiatorVaultProposals)
+	t.Run("UserToCreatedByWebhookEndpoints", testUserToManyAddOpCreatedByWebhookEndpoints)
+	t.Run("VaultProposalToProposalKeyRefreshes", testVaultProposalToManyAddOpProposalKeyRefreshes)
+	t.Run("VaultProposalToCompletionProposalRootKeys", testVaultProposalToManyAddOpCompletionProposalRootKeys)
+	t.Run("VaultProposalToRetirementProposalRootKeys", testVaultProposalToManyAddOpRetirementProposalRootKeys)
+	t.Run("VaultProposalToVaultProposalApprovals", testVaultProposalToManyAddOpVaultProposalApprovals)
+	t.Run("VaultToKeyBackupHealths", testVaultToManyAddOpKeyBackupHealths)
+	t.Run("VaultToKeyRefreshes", testVaultToManyAddOpKeyRefreshes)
+	t.Run("VaultToKeyShareRecoveries", testVaultToManyAddOpKeyShareRecoveries)
+	t.Run("VaultToRootKeys", testVaultToManyAddOpRootKeys)
 	t.Run("VaultToSigningRequests", testVaultToManyAddOpSigningRequests)
 	t.Run("VaultToSpendingLimits", testVaultToManyAddOpSpendingLimits)
 	t.Run("VaultToVaultKeys", testVaultToManyAddOpVaultKeys)
+	t.Run("VaultToVaultProposals", testVaultToManyAddOpVaultProposals)
 	t.Run("VaultToWallets", testVaultToManyAddOpWallets)
+	t.Run("WalletToDeposits", testWalletToManyAddOpDeposits)
 	t.Run("WalletToSigningRequests", testWalletToManyAddOpSigningRequests)
 	t.Run("WalletToWalletBalances", testWalletToManyAddOpWalletBalances)
+	t.Run("WebhookEndpointToEndpointWebhookDeliveries", testWebhookEndpointToManyAddOpEndpointWebhookDeliveries)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
+	t.Run("AssetToDeposits", testAssetToManySetOpDeposits)
 	t.Run("AssetToSpendingLimits", testAssetToManySetOpSpendingLimits)
 	t.Run("AssetToWalletBalances", testAssetToManySetOpWalletBalances)
 	t.Run("ChainToAddressBooks", testChainToManySetOpAddressBooks)
 	t.Run("ChainToAssets", testChainToManySetOpAssets)
 	t.Run("ChainToWallets", testChainToManySetOpWallets)
 	t.Run("OrganizationToAddressBooks", testOrganizationToManySetOpAddressBooks)
+	t.Run("OrganizationToDefaultOrganizationAppUserProfiles", testOrganizationToManySetOpDefaultOrganizationAppUserProfiles)
+	t.Run("OrganizationToAuditCheckpoints", testOrganizationToManySetOpAuditCheckpoints)
 	t.Run("OrganizationToAuditLogs", testOrganizationToManySetOpAuditLogs)
 	t.Run("OrganizationToVaults", testOrganizationToManySetOpVaults)
 	t.Run("SigningRequestToRequestApprovals", testSigningRequestToManySetOpRequestApprovals)
+	t.Run("UserCredentialToCredentialUserDevices", testUserCredentialToManySetOpCredentialUserDevices)
+	t.Run("UserToApprovedByAddressBooks", testUserToManySetOpApprovedByAddressBooks)
 	t.Run("UserToCreatedByAddressBooks", testUserToManySetOpCreatedByAddressBooks)
 	t.Run("UserToApprovals", testUserToManySetOpApprovals)
-	t.Run("UserToAuditLogs", testUserToManySetOpAuditLogs)
+	t.Run("UserToInitiatorKeyShareRecoveries", testUserToManySetOpInitiatorKeyShareRecoveries)
+	t.Run("UserToAcceptedByOrganizationInvitations", testUserToManySetOpAcceptedByOrganizationInvitations)
+	t.Run("UserToInvitedByOrganizationInvitations", testUserToManySetOpInvitedByOrganizationInvitations)
 	t.Run("UserToInitiatorSigningRequests", testUserToManySetOpInitiatorSigningRequests)
+	t.Run("UserToInitiatorVaultProposals", testUserToManySetOpInitiatorVaultProposals)
+	t.Run("UserToCreatedByWebhookEndpoints", testUserToManySetOpCreatedByWebhookEndpoints)
+	t.Run("VaultProposalToProposalKeyRefreshes", testVaultProposalToManySetOpProposalKeyRefreshes)
+	t.Run("VaultProposalToCompletionProposalRootKeys", testVaultProposalToManySetOpCompletionProposalRootKeys)
+	t.Run("VaultProposalToRetirementProposalRootKeys", testVaultProposalToManySetOpRetirementProposalRootKeys)
 	t.Run("VaultToSigningRequests", testVaultToManySetOpSigningRequests)
 	t.Run("VaultToSpendingLimits", testVaultToManySetOpSpendingLimits)
 	t.Run("VaultToVaultKeys", testVaultToManySetOpVaultKeys)
@@ -206,19 +382,31 @@ func TestToManySet(t *testing.T) {
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
+	t.Run("AssetToDeposits", testAssetToManyRemoveOpDeposits)
 	t.Run("AssetToSpendingLimits", testAssetToManyRemoveOpSpendingLimits)
 	t.Run("AssetToWalletBalances", testAssetToManyRemoveOpWalletBalances)
 	t.Run("ChainToAddressBooks", testChainToManyRemoveOpAddressBooks)
 	t.Run("ChainToAssets", testChainToManyRemoveOpAssets)
 	t.Run("ChainToWallets", testChainToManyRemoveOpWallets)
 	t.Run("OrganizationToAddressBooks", testOrganizationToManyRemoveOpAddressBooks)
+	t.Run("OrganizationToDefaultOrganizationAppUserProfiles", testOrganizationToManyRemoveOpDefaultOrganizationAppUserProfiles)
+	t.Run("OrganizationToAuditCheckpoints", testOrganizationToManyRemoveOpAuditCheckpoints)
 	t.Run("OrganizationToAuditLogs", testOrganizationToManyRemoveOpAuditLogs)
 	t.Run("OrganizationToVaults", testOrganizationToManyRemoveOpVaults)
 	t.Run("SigningRequestToRequestApprovals", testSigningRequestToManyRemoveOpRequestApprovals)
+	t.Run("UserCredentialToCredentialUserDevices", testUserCredentialToManyRemoveOpCredentialUserDevices)
+	t.Run("UserToApprovedByAddressBooks", testUserToManyRemoveOpApprovedByAddressBooks)
 	t.Run("UserToCreatedByAddressBooks", testUserToManyRemoveOpCreatedByAddressBooks)
 	t.Run("UserToApprovals", testUserToManyRemoveOpApprovals)
-	t.Run("UserToAuditLogs", testUserToManyRemoveOpAuditLogs)
+	t.Run("UserToInitiatorKeyShareRecoveries", testUserToManyRemoveOpInitiatorKeyShareRecoveries)
+	t.Run("UserToAcceptedByOrganizationInvitations", testUserToManyRemoveOpAcceptedByOrganizationInvitations)
+	t.Run("UserToInvitedByOrganizationInvitations", testUserToManyRemoveOpInvitedByOrganizationInvitations)
 	t.Run("UserToInitiatorSigningRequests", testUserToManyRemoveOpInitiatorSigningRequests)
+	t.Run("UserToInitiatorVaultProposals", testUserToManyRemoveOpInitiatorVaultProposals)
+	t.Run("UserToCreatedByWebhookEndpoints", testUserToManyRemoveOpCreatedByWebhookEndpoints)
+	t.Run("VaultProposalToProposalKeyRefreshes", testVaultProposalToManyRemoveOpProposalKeyRefreshes)
+	t.Run("VaultProposalToCompletionProposalRootKeys", testVaultProposalToManyRemoveOpCompletionProposalRootKeys)
+	t.Run("VaultProposalToRetirementProposalRootKeys", testVaultProposalToManyRemoveOpRetirementProposalRootKeys)
 	t.Run("VaultToSigningRequests", testVaultToManyRemoveOpSigningRequests)
 	t.Run("VaultToSpendingLimits", testVaultToManyRemoveOpSpendingLimits)
 	t.Run("VaultToVaultKeys", testVaultToManyRemoveOpVaultKeys)
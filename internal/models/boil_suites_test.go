@@ -17,22 +17,41 @@ func TestParent(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfiles)
 	t.Run("Approvals", testApprovals)
 	t.Run("Assets", testAssets)
+	t.Run("AuditCheckpoints", testAuditCheckpoints)
 	t.Run("AuditLogs", testAuditLogs)
+	t.Run("ChainScanCursors", testChainScanCursors)
 	t.Run("Chains", testChains)
 	t.Run("ConfirmationTokens", testConfirmationTokens)
+	t.Run("Deposits", testDeposits)
+	t.Run("KeyBackupHealths", testKeyBackupHealths)
+	t.Run("KeyRefreshes", testKeyRefreshes)
+	t.Run("KeyShareBackups", testKeyShareBackups)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveries)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovals)
+	t.Run("NotificationPreferences", testNotificationPreferences)
+	t.Run("OrganizationInvitations", testOrganizationInvitations)
 	t.Run("OrganizationMembers", testOrganizationMembers)
 	t.Run("Organizations", testOrganizations)
+	t.Run("OutboxEvents", testOutboxEvents)
+	t.Run("PasskeyChallenges", testPasskeyChallenges)
 	t.Run("PasswordResetTokens", testPasswordResetTokens)
 	t.Run("PushTokens", testPushTokens)
 	t.Run("RefreshTokens", testRefreshTokens)
+	t.Run("RootKeys", testRootKeys)
 	t.Run("SigningRequests", testSigningRequests)
 	t.Run("SpendingLimits", testSpendingLimits)
 	t.Run("UserCredentials", testUserCredentials)
+	t.Run("UserDevices", testUserDevices)
 	t.Run("Users", testUsers)
 	t.Run("VaultKeys", testVaultKeys)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovals)
+	t.Run("VaultProposals", testVaultProposals)
 	t.Run("Vaults", testVaults)
 	t.Run("WalletBalances", testWalletBalances)
 	t.Run("Wallets", testWallets)
+	t.Run("WebhookDeliveries", testWebhookDeliveries)
+	t.Run("WebhookEndpoints", testWebhookEndpoints)
+	t.Run("WebhookEvents", testWebhookEvents)
 }
 
 func TestDelete(t *testing.T) {
@@ -41,22 +60,41 @@ func TestDelete(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesDelete)
 	t.Run("Approvals", testApprovalsDelete)
 	t.Run("Assets", testAssetsDelete)
+	t.Run("AuditCheckpoints", testAuditCheckpointsDelete)
 	t.Run("AuditLogs", testAuditLogsDelete)
+	t.Run("ChainScanCursors", testChainScanCursorsDelete)
 	t.Run("Chains", testChainsDelete)
 	t.Run("ConfirmationTokens", testConfirmationTokensDelete)
+	t.Run("Deposits", testDepositsDelete)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsDelete)
+	t.Run("KeyRefreshes", testKeyRefreshesDelete)
+	t.Run("KeyShareBackups", testKeyShareBackupsDelete)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesDelete)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsDelete)
+	t.Run("NotificationPreferences", testNotificationPreferencesDelete)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsDelete)
 	t.Run("OrganizationMembers", testOrganizationMembersDelete)
 	t.Run("Organizations", testOrganizationsDelete)
+	t.Run("OutboxEvents", testOutboxEventsDelete)
+	t.Run("PasskeyChallenges", testPasskeyChallengesDelete)
 	t.Run("PasswordResetTokens", testPasswordResetTokensDelete)
 	t.Run("PushTokens", testPushTokensDelete)
 	t.Run("RefreshTokens", testRefreshTokensDelete)
+	t.Run("RootKeys", testRootKeysDelete)
 	t.Run("SigningRequests", testSigningRequestsDelete)
 	t.Run("SpendingLimits", testSpendingLimitsDelete)
 	t.Run("UserCredentials", testUserCredentialsDelete)
+	t.Run("UserDevices", testUserDevicesDelete)
 	t.Run("Users", testUsersDelete)
 	t.Run("VaultKeys", testVaultKeysDelete)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsDelete)
+	t.Run("VaultProposals", testVaultProposalsDelete)
 	t.Run("Vaults", testVaultsDelete)
 	t.Run("WalletBalances", testWalletBalancesDelete)
 	t.Run("Wallets", testWalletsDelete)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesDelete)
+	t.Run("WebhookEndpoints", testWebhookEndpointsDelete)
+	t.Run("WebhookEvents", testWebhookEventsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
@@ -65,22 +103,41 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesQueryDeleteAll)
 	t.Run("Approvals", testApprovalsQueryDeleteAll)
 	t.Run("Assets", testAssetsQueryDeleteAll)
+	t.Run("AuditCheckpoints", testAuditCheckpointsQueryDeleteAll)
 	t.Run("AuditLogs", testAuditLogsQueryDeleteAll)
+	t.Run("ChainScanCursors", testChainScanCursorsQueryDeleteAll)
 	t.Run("Chains", testChainsQueryDeleteAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensQueryDeleteAll)
+	t.Run("Deposits", testDepositsQueryDeleteAll)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsQueryDeleteAll)
+	t.Run("KeyRefreshes", testKeyRefreshesQueryDeleteAll)
+	t.Run("KeyShareBackups", testKeyShareBackupsQueryDeleteAll)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesQueryDeleteAll)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsQueryDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesQueryDeleteAll)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsQueryDeleteAll)
 	t.Run("OrganizationMembers", testOrganizationMembersQueryDeleteAll)
 	t.Run("Organizations", testOrganizationsQueryDeleteAll)
+	t.Run("OutboxEvents", testOutboxEventsQueryDeleteAll)
+	t.Run("PasskeyChallenges", testPasskeyChallengesQueryDeleteAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensQueryDeleteAll)
 	t.Run("PushTokens", testPushTokensQueryDeleteAll)
 	t.Run("RefreshTokens", testRefreshTokensQueryDeleteAll)
+	t.Run("RootKeys", testRootKeysQueryDeleteAll)
 	t.Run("SigningRequests", testSigningRequestsQueryDeleteAll)
 	t.Run("SpendingLimits", testSpendingLimitsQueryDeleteAll)
 	t.Run("UserCredentials", testUserCredentialsQueryDeleteAll)
+	t.Run("UserDevices", testUserDevicesQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 	t.Run("VaultKeys", testVaultKeysQueryDeleteAll)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsQueryDeleteAll)
+	t.Run("VaultProposals", testVaultProposalsQueryDeleteAll)
 	t.Run("Vaults", testVaultsQueryDeleteAll)
 	t.Run("WalletBalances", testWalletBalancesQueryDeleteAll)
 	t.Run("Wallets", testWalletsQueryDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesQueryDeleteAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsQueryDeleteAll)
+	t.Run("WebhookEvents", testWebhookEventsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
@@ -89,22 +146,41 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesSliceDeleteAll)
 	t.Run("Approvals", testApprovalsSliceDeleteAll)
 	t.Run("Assets", testAssetsSliceDeleteAll)
+	t.Run("AuditCheckpoints", testAuditCheckpointsSliceDeleteAll)
 	t.Run("AuditLogs", testAuditLogsSliceDeleteAll)
+	t.Run("ChainScanCursors", testChainScanCursorsSliceDeleteAll)
 	t.Run("Chains", testChainsSliceDeleteAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensSliceDeleteAll)
+	t.Run("Deposits", testDepositsSliceDeleteAll)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsSliceDeleteAll)
+	t.Run("KeyRefreshes", testKeyRefreshesSliceDeleteAll)
+	t.Run("KeyShareBackups", testKeyShareBackupsSliceDeleteAll)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesSliceDeleteAll)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsSliceDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceDeleteAll)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsSliceDeleteAll)
 	t.Run("OrganizationMembers", testOrganizationMembersSliceDeleteAll)
 	t.Run("Organizations", testOrganizationsSliceDeleteAll)
+	t.Run("OutboxEvents", testOutboxEventsSliceDeleteAll)
+	t.Run("PasskeyChallenges", testPasskeyChallengesSliceDeleteAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSliceDeleteAll)
 	t.Run("PushTokens", testPushTokensSliceDeleteAll)
 	t.Run("RefreshTokens", testRefreshTokensSliceDeleteAll)
+	t.Run("RootKeys", testRootKeysSliceDeleteAll)
 	t.Run("SigningRequests", testSigningRequestsSliceDeleteAll)
 	t.Run("SpendingLimits", testSpendingLimitsSliceDeleteAll)
 	t.Run("UserCredentials", testUserCredentialsSliceDeleteAll)
+	t.Run("UserDevices", testUserDevicesSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 	t.Run("VaultKeys", testVaultKeysSliceDeleteAll)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsSliceDeleteAll)
+	t.Run("VaultProposals", testVaultProposalsSliceDeleteAll)
 	t.Run("Vaults", testVaultsSliceDeleteAll)
 	t.Run("WalletBalances", testWalletBalancesSliceDeleteAll)
 	t.Run("Wallets", testWalletsSliceDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceDeleteAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSliceDeleteAll)
+	t.Run("WebhookEvents", testWebhookEventsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
@@ -113,22 +189,41 @@ func TestExists(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesExists)
 	t.Run("Approvals", testApprovalsExists)
 	t.Run("Assets", testAssetsExists)
+	t.Run("AuditCheckpoints", testAuditCheckpointsExists)
 	t.Run("AuditLogs", testAuditLogsExists)
+	t.Run("ChainScanCursors", testChainScanCursorsExists)
 	t.Run("Chains", testChainsExists)
 	t.Run("ConfirmationTokens", testConfirmationTokensExists)
+	t.Run("Deposits", testDepositsExists)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsExists)
+	t.Run("KeyRefreshes", testKeyRefreshesExists)
+	t.Run("KeyShareBackups", testKeyShareBackupsExists)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesExists)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsExists)
+	t.Run("NotificationPreferences", testNotificationPreferencesExists)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsExists)
 	t.Run("OrganizationMembers", testOrganizationMembersExists)
 	t.Run("Organizations", testOrganizationsExists)
+	t.Run("OutboxEvents", testOutboxEventsExists)
+	t.Run("PasskeyChallenges", testPasskeyChallengesExists)
 	t.Run("PasswordResetTokens", testPasswordResetTokensExists)
 	t.Run("PushTokens", testPushTokensExists)
 	t.Run("RefreshTokens", testRefreshTokensExists)
+	t.Run("RootKeys", testRootKeysExists)
 	t.Run("SigningRequests", testSigningRequestsExists)
 	t.Run("SpendingLimits", testSpendingLimitsExists)
 	t.Run("UserCredentials", testUserCredentialsExists)
+	t.Run("UserDevices", testUserDevicesExists)
 	t.Run("Users", testUsersExists)
 	t.Run("VaultKeys", testVaultKeysExists)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsExists)
+	t.Run("VaultProposals", testVaultProposalsExists)
 	t.Run("Vaults", testVaultsExists)
 	t.Run("WalletBalances", testWalletBalancesExists)
 	t.Run("Wallets", testWalletsExists)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesExists)
+	t.Run("WebhookEndpoints", testWebhookEndpointsExists)
+	t.Run("WebhookEvents", testWebhookEventsExists)
 }
 
 func TestFind(t *testing.T) {
@@ -137,22 +232,41 @@ func TestFind(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesFind)
 	t.Run("Approvals", testApprovalsFind)
 	t.Run("Assets", testAssetsFind)
+	t.Run("AuditCheckpoints", testAuditCheckpointsFind)
 	t.Run("AuditLogs", testAuditLogsFind)
+	t.Run("ChainScanCursors", testChainScanCursorsFind)
 	t.Run("Chains", testChainsFind)
 	t.Run("ConfirmationTokens", testConfirmationTokensFind)
+	t.Run("Deposits", testDepositsFind)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsFind)
+	t.Run("KeyRefreshes", testKeyRefreshesFind)
+	t.Run("KeyShareBackups", testKeyShareBackupsFind)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesFind)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsFind)
+	t.Run("NotificationPreferences", testNotificationPreferencesFind)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsFind)
 	t.Run("OrganizationMembers", testOrganizationMembersFind)
 	t.Run("Organizations", testOrganizationsFind)
+	t.Run("OutboxEvents", testOutboxEventsFind)
+	t.Run("PasskeyChallenges", testPasskeyChallengesFind)
 	t.Run("PasswordResetTokens", testPasswordResetTokensFind)
 	t.Run("PushTokens", testPushTokensFind)
 	t.Run("RefreshTokens", testRefreshTokensFind)
+	t.Run("RootKeys", testRootKeysFind)
 	t.Run("SigningRequests", testSigningRequestsFind)
 	t.Run("SpendingLimits", testSpendingLimitsFind)
 	t.Run("UserCredentials", testUserCredentialsFind)
+	t.Run("UserDevices", testUserDevicesFind)
 	t.Run("Users", testUsersFind)
 	t.Run("VaultKeys", testVaultKeysFind)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsFind)
+	t.Run("VaultProposals", testVaultProposalsFind)
 	t.Run("Vaults", testVaultsFind)
 	t.Run("WalletBalances", testWalletBalancesFind)
 	t.Run("Wallets", testWalletsFind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesFind)
+	t.Run("WebhookEndpoints", testWebhookEndpointsFind)
+	t.Run("WebhookEvents", testWebhookEventsFind)
 }
 
 func TestBind(t *testing.T) {
@@ -161,22 +275,41 @@ func TestBind(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesBind)
 	t.Run("Approvals", testApprovalsBind)
 	t.Run("Assets", testAssetsBind)
+	t.Run("AuditCheckpoints", testAuditCheckpointsBind)
 	t.Run("AuditLogs", testAuditLogsBind)
+	t.Run("ChainScanCursors", testChainScanCursorsBind)
 	t.Run("Chains", testChainsBind)
 	t.Run("ConfirmationTokens", testConfirmationTokensBind)
+	t.Run("Deposits", testDepositsBind)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsBind)
+	t.Run("KeyRefreshes", testKeyRefreshesBind)
+	t.Run("KeyShareBackups", testKeyShareBackupsBind)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesBind)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsBind)
+	t.Run("NotificationPreferences", testNotificationPreferencesBind)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsBind)
 	t.Run("OrganizationMembers", testOrganizationMembersBind)
 	t.Run("Organizations", testOrganizationsBind)
+	t.Run("OutboxEvents", testOutboxEventsBind)
+	t.Run("PasskeyChallenges", testPasskeyChallengesBind)
 	t.Run("PasswordResetTokens", testPasswordResetTokensBind)
 	t.Run("PushTokens", testPushTokensBind)
 	t.Run("RefreshTokens", testRefreshTokensBind)
+	t.Run("RootKeys", testRootKeysBind)
 	t.Run("SigningRequests", testSigningRequestsBind)
 	t.Run("SpendingLimits", testSpendingLimitsBind)
 	t.Run("UserCredentials", testUserCredentialsBind)
+	t.Run("UserDevices", testUserDevicesBind)
 	t.Run("Users", testUsersBind)
 	t.Run("VaultKeys", testVaultKeysBind)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsBind)
+	t.Run("VaultProposals", testVaultProposalsBind)
 	t.Run("Vaults", testVaultsBind)
 	t.Run("WalletBalances", testWalletBalancesBind)
 	t.Run("Wallets", testWalletsBind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesBind)
+	t.Run("WebhookEndpoints", testWebhookEndpointsBind)
+	t.Run("WebhookEvents", testWebhookEventsBind)
 }
 
 func TestOne(t *testing.T) {
@@ -185,22 +318,41 @@ func TestOne(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesOne)
 	t.Run("Approvals", testApprovalsOne)
 	t.Run("Assets", testAssetsOne)
+	t.Run("AuditCheckpoints", testAuditCheckpointsOne)
 	t.Run("AuditLogs", testAuditLogsOne)
+	t.Run("ChainScanCursors", testChainScanCursorsOne)
 	t.Run("Chains", testChainsOne)
 	t.Run("ConfirmationTokens", testConfirmationTokensOne)
+	t.Run("Deposits", testDepositsOne)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsOne)
+	t.Run("KeyRefreshes", testKeyRefreshesOne)
+	t.Run("KeyShareBackups", testKeyShareBackupsOne)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesOne)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsOne)
+	t.Run("NotificationPreferences", testNotificationPreferencesOne)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsOne)
 	t.Run("OrganizationMembers", testOrganizationMembersOne)
 	t.Run("Organizations", testOrganizationsOne)
+	t.Run("OutboxEvents", testOutboxEventsOne)
+	t.Run("PasskeyChallenges", testPasskeyChallengesOne)
 	t.Run("PasswordResetTokens", testPasswordResetTokensOne)
 	t.Run("PushTokens", testPushTokensOne)
 	t.Run("RefreshTokens", testRefreshTokensOne)
+	t.Run("RootKeys", testRootKeysOne)
 	t.Run("SigningRequests", testSigningRequestsOne)
 	t.Run("SpendingLimits", testSpendingLimitsOne)
 	t.Run("UserCredentials", testUserCredentialsOne)
+	t.Run("UserDevices", testUserDevicesOne)
 	t.Run("Users", testUsersOne)
 	t.Run("VaultKeys", testVaultKeysOne)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsOne)
+	t.Run("VaultProposals", testVaultProposalsOne)
 	t.Run("Vaults", testVaultsOne)
 	t.Run("WalletBalances", testWalletBalancesOne)
 	t.Run("Wallets", testWalletsOne)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesOne)
+	t.Run("WebhookEndpoints", testWebhookEndpointsOne)
+	t.Run("WebhookEvents", testWebhookEventsOne)
 }
 
 func TestAll(t *testing.T) {
@@ -209,22 +361,41 @@ func TestAll(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesAll)
 	t.Run("Approvals", testApprovalsAll)
 	t.Run("Assets", testAssetsAll)
+	t.Run("AuditCheckpoints", testAuditCheckpointsAll)
 	t.Run("AuditLogs", testAuditLogsAll)
+	t.Run("ChainScanCursors", testChainScanCursorsAll)
 	t.Run("Chains", testChainsAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensAll)
+	t.Run("Deposits", testDepositsAll)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsAll)
+	t.Run("KeyRefreshes", testKeyRefreshesAll)
+	t.Run("KeyShareBackups", testKeyShareBackupsAll)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesAll)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesAll)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsAll)
 	t.Run("OrganizationMembers", testOrganizationMembersAll)
 	t.Run("Organizations", testOrganizationsAll)
+	t.Run("OutboxEvents", testOutboxEventsAll)
+	t.Run("PasskeyChallenges", testPasskeyChallengesAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensAll)
 	t.Run("PushTokens", testPushTokensAll)
 	t.Run("RefreshTokens", testRefreshTokensAll)
+	t.Run("RootKeys", testRootKeysAll)
 	t.Run("SigningRequests", testSigningRequestsAll)
 	t.Run("SpendingLimits", testSpendingLimitsAll)
 	t.Run("UserCredentials", testUserCredentialsAll)
+	t.Run("UserDevices", testUserDevicesAll)
 	t.Run("Users", testUsersAll)
 	t.Run("VaultKeys", testVaultKeysAll)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsAll)
+	t.Run("VaultProposals", testVaultProposalsAll)
 	t.Run("Vaults", testVaultsAll)
 	t.Run("WalletBalances", testWalletBalancesAll)
 	t.Run("Wallets", testWalletsAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsAll)
+	t.Run("WebhookEvents", testWebhookEventsAll)
 }
 
 func TestCount(t *testing.T) {
@@ -233,22 +404,41 @@ func TestCount(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesCount)
 	t.Run("Approvals", testApprovalsCount)
 	t.Run("Assets", testAssetsCount)
+	t.Run("AuditCheckpoints", testAuditCheckpointsCount)
 	t.Run("AuditLogs", testAuditLogsCount)
+	t.Run("ChainScanCursors", testChainScanCursorsCount)
 	t.Run("Chains", testChainsCount)
 	t.Run("ConfirmationTokens", testConfirmationTokensCount)
+	t.Run("Deposits", testDepositsCount)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsCount)
+	t.Run("KeyRefreshes", testKeyRefreshesCount)
+	t.Run("KeyShareBackups", testKeyShareBackupsCount)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesCount)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsCount)
+	t.Run("NotificationPreferences", testNotificationPreferencesCount)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsCount)
 	t.Run("OrganizationMembers", testOrganizationMembersCount)
 	t.Run("Organizations", testOrganizationsCount)
+	t.Run("OutboxEvents", testOutboxEventsCount)
+	t.Run("PasskeyChallenges", testPasskeyChallengesCount)
 	t.Run("PasswordResetTokens", testPasswordResetTokensCount)
 	t.Run("PushTokens", testPushTokensCount)
 	t.Run("RefreshTokens", testRefreshTokensCount)
+	t.Run("RootKeys", testRootKeysCount)
 	t.Run("SigningRequests", testSigningRequestsCount)
 	t.Run("SpendingLimits", testSpendingLimitsCount)
 	t.Run("UserCredentials", testUserCredentialsCount)
+	t.Run("UserDevices", testUserDevicesCount)
 	t.Run("Users", testUsersCount)
 	t.Run("VaultKeys", testVaultKeysCount)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsCount)
+	t.Run("VaultProposals", testVaultProposalsCount)
 	t.Run("Vaults", testVaultsCount)
 	t.Run("WalletBalances", testWalletBalancesCount)
 	t.Run("Wallets", testWalletsCount)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesCount)
+	t.Run("WebhookEndpoints", testWebhookEndpointsCount)
+	t.Run("WebhookEvents", testWebhookEventsCount)
 }
 
 func TestInsert(t *testing.T) {
@@ -262,38 +452,76 @@ func TestInsert(t *testing.T) {
 	t.Run("Approvals", testApprovalsInsertWhitelist)
 	t.Run("Assets", testAssetsInsert)
 	t.Run("Assets", testAssetsInsertWhitelist)
+	t.Run("AuditCheckpoints", testAuditCheckpointsInsert)
+	t.Run("AuditCheckpoints", testAuditCheckpointsInsertWhitelist)
 	t.Run("AuditLogs", testAuditLogsInsert)
 	t.Run("AuditLogs", testAuditLogsInsertWhitelist)
+	t.Run("ChainScanCursors", testChainScanCursorsInsert)
+	t.Run("ChainScanCursors", testChainScanCursorsInsertWhitelist)
 	t.Run("Chains", testChainsInsert)
 	t.Run("Chains", testChainsInsertWhitelist)
 	t.Run("ConfirmationTokens", testConfirmationTokensInsert)
 	t.Run("ConfirmationTokens", testConfirmationTokensInsertWhitelist)
+	t.Run("Deposits", testDepositsInsert)
+	t.Run("Deposits", testDepositsInsertWhitelist)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsInsert)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsInsertWhitelist)
+	t.Run("KeyRefreshes", testKeyRefreshesInsert)
+	t.Run("KeyRefreshes", testKeyRefreshesInsertWhitelist)
+	t.Run("KeyShareBackups", testKeyShareBackupsInsert)
+	t.Run("KeyShareBackups", testKeyShareBackupsInsertWhitelist)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesInsert)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesInsertWhitelist)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsInsert)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsInsertWhitelist)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsert)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsertWhitelist)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsInsert)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsInsertWhitelist)
 	t.Run("OrganizationMembers", testOrganizationMembersInsert)
 	t.Run("OrganizationMembers", testOrganizationMembersInsertWhitelist)
 	t.Run("Organizations", testOrganizationsInsert)
 	t.Run("Organizations", testOrganizationsInsertWhitelist)
+	t.Run("OutboxEvents", testOutboxEventsInsert)
+	t.Run("OutboxEvents", testOutboxEventsInsertWhitelist)
+	t.Run("PasskeyChallenges", testPasskeyChallengesInsert)
+	t.Run("PasskeyChallenges", testPasskeyChallengesInsertWhitelist)
 	t.Run("PasswordResetTokens", testPasswordResetTokensInsert)
 	t.Run("PasswordResetTokens", testPasswordResetTokensInsertWhitelist)
 	t.Run("PushTokens", testPushTokensInsert)
 	t.Run("PushTokens", testPushTokensInsertWhitelist)
 	t.Run("RefreshTokens", testRefreshTokensInsert)
 	t.Run("RefreshTokens", testRefreshTokensInsertWhitelist)
+	t.Run("RootKeys", testRootKeysInsert)
+	t.Run("RootKeys", testRootKeysInsertWhitelist)
 	t.Run("SigningRequests", testSigningRequestsInsert)
 	t.Run("SigningRequests", testSigningRequestsInsertWhitelist)
 	t.Run("SpendingLimits", testSpendingLimitsInsert)
 	t.Run("SpendingLimits", testSpendingLimitsInsertWhitelist)
 	t.Run("UserCredentials", testUserCredentialsInsert)
 	t.Run("UserCredentials", testUserCredentialsInsertWhitelist)
+	t.Run("UserDevices", testUserDevicesInsert)
+	t.Run("UserDevices", testUserDevicesInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
 	t.Run("VaultKeys", testVaultKeysInsert)
 	t.Run("VaultKeys", testVaultKeysInsertWhitelist)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsInsert)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsInsertWhitelist)
+	t.Run("VaultProposals", testVaultProposalsInsert)
+	t.Run("VaultProposals", testVaultProposalsInsertWhitelist)
 	t.Run("Vaults", testVaultsInsert)
 	t.Run("Vaults", testVaultsInsertWhitelist)
 	t.Run("WalletBalances", testWalletBalancesInsert)
 	t.Run("WalletBalances", testWalletBalancesInsertWhitelist)
 	t.Run("Wallets", testWalletsInsert)
 	t.Run("Wallets", testWalletsInsertWhitelist)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsert)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsertWhitelist)
+	t.Run("WebhookEndpoints", testWebhookEndpointsInsert)
+	t.Run("WebhookEndpoints", testWebhookEndpointsInsertWhitelist)
+	t.Run("WebhookEvents", testWebhookEventsInsert)
+	t.Run("WebhookEvents", testWebhookEventsInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
@@ -302,22 +530,41 @@ func TestReload(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesReload)
 	t.Run("Approvals", testApprovalsReload)
 	t.Run("Assets", testAssetsReload)
+	t.Run("AuditCheckpoints", testAuditCheckpointsReload)
 	t.Run("AuditLogs", testAuditLogsReload)
+	t.Run("ChainScanCursors", testChainScanCursorsReload)
 	t.Run("Chains", testChainsReload)
 	t.Run("ConfirmationTokens", testConfirmationTokensReload)
+	t.Run("Deposits", testDepositsReload)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsReload)
+	t.Run("KeyRefreshes", testKeyRefreshesReload)
+	t.Run("KeyShareBackups", testKeyShareBackupsReload)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesReload)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsReload)
+	t.Run("NotificationPreferences", testNotificationPreferencesReload)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsReload)
 	t.Run("OrganizationMembers", testOrganizationMembersReload)
 	t.Run("Organizations", testOrganizationsReload)
+	t.Run("OutboxEvents", testOutboxEventsReload)
+	t.Run("PasskeyChallenges", testPasskeyChallengesReload)
 	t.Run("PasswordResetTokens", testPasswordResetTokensReload)
 	t.Run("PushTokens", testPushTokensReload)
 	t.Run("RefreshTokens", testRefreshTokensReload)
+	t.Run("RootKeys", testRootKeysReload)
 	t.Run("SigningRequests", testSigningRequestsReload)
 	t.Run("SpendingLimits", testSpendingLimitsReload)
 	t.Run("UserCredentials", testUserCredentialsReload)
+	t.Run("UserDevices", testUserDevicesReload)
 	t.Run("Users", testUsersReload)
 	t.Run("VaultKeys", testVaultKeysReload)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsReload)
+	t.Run("VaultProposals", testVaultProposalsReload)
 	t.Run("Vaults", testVaultsReload)
 	t.Run("WalletBalances", testWalletBalancesReload)
 	t.Run("Wallets", testWalletsReload)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReload)
+	t.Run("WebhookEndpoints", testWebhookEndpointsReload)
+	t.Run("WebhookEvents", testWebhookEventsReload)
 }
 
 func TestReloadAll(t *testing.T) {
@@ -326,22 +573,41 @@ func TestReloadAll(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesReloadAll)
 	t.Run("Approvals", testApprovalsReloadAll)
 	t.Run("Assets", testAssetsReloadAll)
+	t.Run("AuditCheckpoints", testAuditCheckpointsReloadAll)
 	t.Run("AuditLogs", testAuditLogsReloadAll)
+	t.Run("ChainScanCursors", testChainScanCursorsReloadAll)
 	t.Run("Chains", testChainsReloadAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensReloadAll)
+	t.Run("Deposits", testDepositsReloadAll)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsReloadAll)
+	t.Run("KeyRefreshes", testKeyRefreshesReloadAll)
+	t.Run("KeyShareBackups", testKeyShareBackupsReloadAll)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesReloadAll)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsReloadAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesReloadAll)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsReloadAll)
 	t.Run("OrganizationMembers", testOrganizationMembersReloadAll)
 	t.Run("Organizations", testOrganizationsReloadAll)
+	t.Run("OutboxEvents", testOutboxEventsReloadAll)
+	t.Run("PasskeyChallenges", testPasskeyChallengesReloadAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensReloadAll)
 	t.Run("PushTokens", testPushTokensReloadAll)
 	t.Run("RefreshTokens", testRefreshTokensReloadAll)
+	t.Run("RootKeys", testRootKeysReloadAll)
 	t.Run("SigningRequests", testSigningRequestsReloadAll)
 	t.Run("SpendingLimits", testSpendingLimitsReloadAll)
 	t.Run("UserCredentials", testUserCredentialsReloadAll)
+	t.Run("UserDevices", testUserDevicesReloadAll)
 	t.Run("Users", testUsersReloadAll)
 	t.Run("VaultKeys", testVaultKeysReloadAll)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsReloadAll)
+	t.Run("VaultProposals", testVaultProposalsReloadAll)
 	t.Run("Vaults", testVaultsReloadAll)
 	t.Run("WalletBalances", testWalletBalancesReloadAll)
 	t.Run("Wallets", testWalletsReloadAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReloadAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsReloadAll)
+	t.Run("WebhookEvents", testWebhookEventsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
@@ -350,22 +616,41 @@ func TestSelect(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesSelect)
 	t.Run("Approvals", testApprovalsSelect)
 	t.Run("Assets", testAssetsSelect)
+	t.Run("AuditCheckpoints", testAuditCheckpointsSelect)
 	t.Run("AuditLogs", testAuditLogsSelect)
+	t.Run("ChainScanCursors", testChainScanCursorsSelect)
 	t.Run("Chains", testChainsSelect)
 	t.Run("ConfirmationTokens", testConfirmationTokensSelect)
+	t.Run("Deposits", testDepositsSelect)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsSelect)
+	t.Run("KeyRefreshes", testKeyRefreshesSelect)
+	t.Run("KeyShareBackups", testKeyShareBackupsSelect)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesSelect)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsSelect)
+	t.Run("NotificationPreferences", testNotificationPreferencesSelect)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsSelect)
 	t.Run("OrganizationMembers", testOrganizationMembersSelect)
 	t.Run("Organizations", testOrganizationsSelect)
+	t.Run("OutboxEvents", testOutboxEventsSelect)
+	t.Run("PasskeyChallenges", testPasskeyChallengesSelect)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSelect)
 	t.Run("PushTokens", testPushTokensSelect)
 	t.Run("RefreshTokens", testRefreshTokensSelect)
+	t.Run("RootKeys", testRootKeysSelect)
 	t.Run("SigningRequests", testSigningRequestsSelect)
 	t.Run("SpendingLimits", testSpendingLimitsSelect)
 	t.Run("UserCredentials", testUserCredentialsSelect)
+	t.Run("UserDevices", testUserDevicesSelect)
 	t.Run("Users", testUsersSelect)
 	t.Run("VaultKeys", testVaultKeysSelect)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsSelect)
+	t.Run("VaultProposals", testVaultProposalsSelect)
 	t.Run("Vaults", testVaultsSelect)
 	t.Run("WalletBalances", testWalletBalancesSelect)
 	t.Run("Wallets", testWalletsSelect)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSelect)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSelect)
+	t.Run("WebhookEvents", testWebhookEventsSelect)
 }
 
 func TestUpdate(t *testing.T) {
@@ -374,22 +659,41 @@ func TestUpdate(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesUpdate)
 	t.Run("Approvals", testApprovalsUpdate)
 	t.Run("Assets", testAssetsUpdate)
+	t.Run("AuditCheckpoints", testAuditCheckpointsUpdate)
 	t.Run("AuditLogs", testAuditLogsUpdate)
+	t.Run("ChainScanCursors", testChainScanCursorsUpdate)
 	t.Run("Chains", testChainsUpdate)
 	t.Run("ConfirmationTokens", testConfirmationTokensUpdate)
+	t.Run("Deposits", testDepositsUpdate)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsUpdate)
+	t.Run("KeyRefreshes", testKeyRefreshesUpdate)
+	t.Run("KeyShareBackups", testKeyShareBackupsUpdate)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesUpdate)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsUpdate)
+	t.Run("NotificationPreferences", testNotificationPreferencesUpdate)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsUpdate)
 	t.Run("OrganizationMembers", testOrganizationMembersUpdate)
 	t.Run("Organizations", testOrganizationsUpdate)
+	t.Run("OutboxEvents", testOutboxEventsUpdate)
+	t.Run("PasskeyChallenges", testPasskeyChallengesUpdate)
 	t.Run("PasswordResetTokens", testPasswordResetTokensUpdate)
 	t.Run("PushTokens", testPushTokensUpdate)
 	t.Run("RefreshTokens", testRefreshTokensUpdate)
+	t.Run("RootKeys", testRootKeysUpdate)
 	t.Run("SigningRequests", testSigningRequestsUpdate)
 	t.Run("SpendingLimits", testSpendingLimitsUpdate)
 	t.Run("UserCredentials", testUserCredentialsUpdate)
+	t.Run("UserDevices", testUserDevicesUpdate)
 	t.Run("Users", testUsersUpdate)
 	t.Run("VaultKeys", testVaultKeysUpdate)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsUpdate)
+	t.Run("VaultProposals", testVaultProposalsUpdate)
 	t.Run("Vaults", testVaultsUpdate)
 	t.Run("WalletBalances", testWalletBalancesUpdate)
 	t.Run("Wallets", testWalletsUpdate)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpdate)
+	t.Run("WebhookEndpoints", testWebhookEndpointsUpdate)
+	t.Run("WebhookEvents", testWebhookEventsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
@@ -398,20 +702,39 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AppUserProfiles", testAppUserProfilesSliceUpdateAll)
 	t.Run("Approvals", testApprovalsSliceUpdateAll)
 	t.Run("Assets", testAssetsSliceUpdateAll)
+	t.Run("AuditCheckpoints", testAuditCheckpointsSliceUpdateAll)
 	t.Run("AuditLogs", testAuditLogsSliceUpdateAll)
+	t.Run("ChainScanCursors", testChainScanCursorsSliceUpdateAll)
 	t.Run("Chains", testChainsSliceUpdateAll)
 	t.Run("ConfirmationTokens", testConfirmationTokensSliceUpdateAll)
+	t.Run("Deposits", testDepositsSliceUpdateAll)
+	t.Run("KeyBackupHealths", testKeyBackupHealthsSliceUpdateAll)
+	t.Run("KeyRefreshes", testKeyRefreshesSliceUpdateAll)
+	t.Run("KeyShareBackups", testKeyShareBackupsSliceUpdateAll)
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesSliceUpdateAll)
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsSliceUpdateAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceUpdateAll)
+	t.Run("OrganizationInvitations", testOrganizationInvitationsSliceUpdateAll)
 	t.Run("OrganizationMembers", testOrganizationMembersSliceUpdateAll)
 	t.Run("Organizations", testOrganizationsSliceUpdateAll)
+	t.Run("OutboxEvents", testOutboxEventsSliceUpdateAll)
+	t.Run("PasskeyChallenges", testPasskeyChallengesSliceUpdateAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSliceUpdateAll)
 	t.Run("PushTokens", testPushTokensSliceUpdateAll)
 	t.Run("RefreshTokens", testRefreshTokensSliceUpdateAll)
+	t.Run("RootKeys", testRootKeysSliceUpdateAll)
 	t.Run("SigningRequests", testSigningRequestsSliceUpdateAll)
 	t.Run("SpendingLimits", testSpendingLimitsSliceUpdateAll)
 	t.Run("UserCredentials", testUserCredentialsSliceUpdateAll)
+	t.Run("UserDevices", testUserDevicesSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 	t.Run("VaultKeys", testVaultKeysSliceUpdateAll)
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsSliceUpdateAll)
+	t.Run("VaultProposals", testVaultProposalsSliceUpdateAll)
 	t.Run("Vaults", testVaultsSliceUpdateAll)
 	t.Run("WalletBalances", testWalletBalancesSliceUpdateAll)
 	t.Run("Wallets", testWalletsSliceUpdateAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceUpdateAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSliceUpdateAll)
+	t.Run("WebhookEvents", testWebhookEventsSliceUpdateAll)
 }
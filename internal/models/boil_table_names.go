@@ -4,47 +4,85 @@
 package models
 
 var TableNames = struct {
-	AccessTokens        string
-	AddressBook         string
-	AppUserProfiles     string
-	Approvals           string
-	Assets              string
-	AuditLogs           string
-	Chains              string
-	ConfirmationTokens  string
-	OrganizationMembers string
-	Organizations       string
-	PasswordResetTokens string
-	PushTokens          string
-	RefreshTokens       string
-	SigningRequests     string
-	SpendingLimits      string
-	UserCredentials     string
-	Users               string
-	VaultKeys           string
-	Vaults              string
-	WalletBalances      string
-	Wallets             string
+	AccessTokens              string
+	AddressBook               string
+	AppUserProfiles           string
+	Approvals                 string
+	Assets                    string
+	AuditCheckpoints          string
+	AuditLogs                 string
+	ChainScanCursors          string
+	Chains                    string
+	ConfirmationTokens        string
+	Deposits                  string
+	KeyBackupHealth           string
+	KeyRefreshes              string
+	KeyShareBackups           string
+	KeyShareRecoveries        string
+	KeyShareRecoveryApprovals string
+	NotificationPreferences   string
+	OrganizationInvitations   string
+	OrganizationMembers       string
+	Organizations             string
+	OutboxEvents              string
+	PasskeyChallenges         string
+	PasswordResetTokens       string
+	PushTokens                string
+	RefreshTokens             string
+	RootKeys                  string
+	SigningRequests           string
+	SpendingLimits            string
+	UserCredentials           string
+	UserDevices               string
+	Users                     string
+	VaultKeys                 string
+	VaultProposalApprovals    string
+	VaultProposals            string
+	Vaults                    string
+	WalletBalances            string
+	Wallets                   string
+	WebhookDeliveries         string
+	WebhookEndpoints          string
+	WebhookEvents             string
 }{
-	AccessTokens:        "access_tokens",
-	AddressBook:         "address_book",
-	AppUserProfiles:     "app_user_profiles",
-	Approvals:           "approvals",
-	Assets:              "assets",
-	AuditLogs:           "audit_logs",
-	Chains:              "chains",
-	ConfirmationTokens:  "confirmation_tokens",
-	OrganizationMembers: "organization_members",
-	Organizations:       "organizations",
-	PasswordResetTokens: "password_reset_tokens",
-	PushTokens:          "push_tokens",
-	RefreshTokens:       "refresh_tokens",
-	SigningRequests:     "signing_requests",
-	SpendingLimits:      "spending_limits",
-	UserCredentials:     "user_credentials",
-	Users:               "users",
-	VaultKeys:           "vault_keys",
-	Vaults:              "vaults",
-	WalletBalances:      "wallet_balances",
-	Wallets:             "wallets",
+	AccessTokens:              "access_tokens",
+	AddressBook:               "address_book",
+	AppUserProfiles:           "app_user_profiles",
+	Approvals:                 "approvals",
+	Assets:                    "assets",
+	AuditCheckpoints:          "audit_checkpoints",
+	AuditLogs:                 "audit_logs",
+	ChainScanCursors:          "chain_scan_cursors",
+	Chains:                    "chains",
+	ConfirmationTokens:        "confirmation_tokens",
+	Deposits:                  "deposits",
+	KeyBackupHealth:           "key_backup_health",
+	KeyRefreshes:              "key_refreshes",
+	KeyShareBackups:           "key_share_backups",
+	KeyShareRecoveries:        "key_share_recoveries",
+	KeyShareRecoveryApprovals: "key_share_recovery_approvals",
+	NotificationPreferences:   "notification_preferences",
+	OrganizationInvitations:   "organization_invitations",
+	OrganizationMembers:       "organization_members",
+	Organizations:             "organizations",
+	OutboxEvents:              "outbox_events",
+	PasskeyChallenges:         "passkey_challenges",
+	PasswordResetTokens:       "password_reset_tokens",
+	PushTokens:                "push_tokens",
+	RefreshTokens:             "refresh_tokens",
+	RootKeys:                  "root_keys",
+	SigningRequests:           "signing_requests",
+	SpendingLimits:            "spending_limits",
+	UserCredentials:           "user_credentials",
+	UserDevices:               "user_devices",
+	Users:                     "users",
+	VaultKeys:                 "vault_keys",
+	VaultProposalApprovals:    "vault_proposal_approvals",
+	VaultProposals:            "vault_proposals",
+	Vaults:                    "vaults",
+	WalletBalances:            "wallet_balances",
+	Wallets:                   "wallets",
+	WebhookDeliveries:         "webhook_deliveries",
+	WebhookEndpoints:          "webhook_endpoints",
+	WebhookEvents:             "webhook_events",
 }
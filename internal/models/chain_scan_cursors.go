@@ -0,0 +1,897 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ChainScanCursor is an object representing the database table.
+type ChainScanCursor struct {
+	ChainID     string    `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	BlockNumber int64     `boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
+	BlockHash   string    `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *chainScanCursorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chainScanCursorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChainScanCursorColumns = struct {
+	ChainID     string
+	BlockNumber string
+	BlockHash   string
+	UpdatedAt   string
+}{
+	ChainID:     "chain_id",
+	BlockNumber: "block_number",
+	BlockHash:   "block_hash",
+	UpdatedAt:   "updated_at",
+}
+
+var ChainScanCursorTableColumns = struct {
+	ChainID     string
+	BlockNumber string
+	BlockHash   string
+	UpdatedAt   string
+}{
+	ChainID:     "chain_scan_cursors.chain_id",
+	BlockNumber: "chain_scan_cursors.block_number",
+	BlockHash:   "chain_scan_cursors.block_hash",
+	UpdatedAt:   "chain_scan_cursors.updated_at",
+}
+
+// Generated where
+
+var ChainScanCursorWhere = struct {
+	ChainID     whereHelperstring
+	BlockNumber whereHelperint64
+	BlockHash   whereHelperstring
+	UpdatedAt   whereHelpertime_Time
+}{
+	ChainID:     whereHelperstring{field: "\"chain_scan_cursors\".\"chain_id\""},
+	BlockNumber: whereHelperint64{field: "\"chain_scan_cursors\".\"block_number\""},
+	BlockHash:   whereHelperstring{field: "\"chain_scan_cursors\".\"block_hash\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"chain_scan_cursors\".\"updated_at\""},
+}
+
+// ChainScanCursorRels is where relationship names are stored.
+var ChainScanCursorRels = struct {
+	Chain string
+}{
+	Chain: "Chain",
+}
+
+// chainScanCursorR is where relationships are stored.
+type chainScanCursorR struct {
+	Chain *Chain `boil:"Chain" json:"Chain" toml:"Chain" yaml:"Chain"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chainScanCursorR) NewStruct() *chainScanCursorR {
+	return &chainScanCursorR{}
+}
+
+func (o *ChainScanCursor) GetChain() *Chain {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChain()
+}
+
+func (r *chainScanCursorR) GetChain() *Chain {
+	if r == nil {
+		return nil
+	}
+
+	return r.Chain
+}
+
+// chainScanCursorL is where Load methods for each relationship are stored.
+type chainScanCursorL struct{}
+
+var (
+	chainScanCursorAllColumns            = []string{"chain_id", "block_number", "block_hash", "updated_at"}
+	chainScanCursorColumnsWithoutDefault = []string{"chain_id", "block_number", "block_hash"}
+	chainScanCursorColumnsWithDefault    = []string{"updated_at"}
+	chainScanCursorPrimaryKeyColumns     = []string{"chain_id"}
+	chainScanCursorGeneratedColumns      = []string{}
+)
+
+type (
+	// ChainScanCursorSlice is an alias for a slice of pointers to ChainScanCursor.
+	// This should almost always be used instead of []ChainScanCursor.
+	ChainScanCursorSlice []*ChainScanCursor
+
+	chainScanCursorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chainScanCursorType                 = reflect.TypeOf(&ChainScanCursor{})
+	chainScanCursorMapping              = queries.MakeStructMapping(chainScanCursorType)
+	chainScanCursorPrimaryKeyMapping, _ = queries.BindMapping(chainScanCursorType, chainScanCursorMapping, chainScanCursorPrimaryKeyColumns)
+	chainScanCursorInsertCacheMut       sync.RWMutex
+	chainScanCursorInsertCache          = make(map[string]insertCache)
+	chainScanCursorUpdateCacheMut       sync.RWMutex
+	chainScanCursorUpdateCache          = make(map[string]updateCache)
+	chainScanCursorUpsertCacheMut       sync.RWMutex
+	chainScanCursorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single chainScanCursor record from the query.
+func (q chainScanCursorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChainScanCursor, error) {
+	o := &ChainScanCursor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chain_scan_cursors")
+	}
+
+	return o, nil
+}
+
+// All returns all ChainScanCursor records from the query.
+func (q chainScanCursorQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChainScanCursorSlice, error) {
+	var o []*ChainScanCursor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChainScanCursor slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ChainScanCursor records in the query.
+func (q chainScanCursorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chain_scan_cursors rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q chainScanCursorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chain_scan_cursors exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chain pointed to by the foreign key.
+func (o *ChainScanCursor) Chain(mods ...qm.QueryMod) chainQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChainID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chains(queryMods...)
+}
+
+// LoadChain allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chainScanCursorL) LoadChain(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChainScanCursor interface{}, mods queries.Applicator) error {
+	var slice []*ChainScanCursor
+	var object *ChainScanCursor
+
+	if singular {
+		var ok bool
+		object, ok = maybeChainScanCursor.(*ChainScanCursor)
+		if !ok {
+			object = new(ChainScanCursor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChainScanCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChainScanCursor))
+			}
+		}
+	} else {
+		s, ok := maybeChainScanCursor.(*[]*ChainScanCursor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChainScanCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChainScanCursor))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &chainScanCursorR{}
+		}
+		args[object.ChainID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chainScanCursorR{}
+			}
+
+			args[obj.ChainID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`chains`),
+		qm.WhereIn(`chains.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chain")
+	}
+
+	var resultSlice []*Chain
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chain")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chains")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chains")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chain = foreign
+		if foreign.R == nil {
+			foreign.R = &chainR{}
+		}
+		foreign.R.ChainScanCursor = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChainID == foreign.ID {
+				local.R.Chain = foreign
+				if foreign.R == nil {
+					foreign.R = &chainR{}
+				}
+				foreign.R.ChainScanCursor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChain of the chainScanCursor to the related item.
+// Sets o.R.Chain to related.
+// Adds o to related.R.ChainScanCursor.
+func (o *ChainScanCursor) SetChain(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chain) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chain_scan_cursors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chain_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chainScanCursorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ChainID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChainID = related.ID
+	if o.R == nil {
+		o.R = &chainScanCursorR{
+			Chain: related,
+		}
+	} else {
+		o.R.Chain = related
+	}
+
+	if related.R == nil {
+		related.R = &chainR{
+			ChainScanCursor: o,
+		}
+	} else {
+		related.R.ChainScanCursor = o
+	}
+
+	return nil
+}
+
+// ChainScanCursors retrieves all the records using an executor.
+func ChainScanCursors(mods ...qm.QueryMod) chainScanCursorQuery {
+	mods = append(mods, qm.From("\"chain_scan_cursors\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chain_scan_cursors\".*"})
+	}
+
+	return chainScanCursorQuery{q}
+}
+
+// FindChainScanCursor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChainScanCursor(ctx context.Context, exec boil.ContextExecutor, chainID string, selectCols ...string) (*ChainScanCursor, error) {
+	chainScanCursorObj := &ChainScanCursor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chain_scan_cursors\" where \"chain_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, chainID)
+
+	err := q.Bind(ctx, exec, chainScanCursorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chain_scan_cursors")
+	}
+
+	return chainScanCursorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChainScanCursor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chain_scan_cursors provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chainScanCursorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chainScanCursorInsertCacheMut.RLock()
+	cache, cached := chainScanCursorInsertCache[key]
+	chainScanCursorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chainScanCursorAllColumns,
+			chainScanCursorColumnsWithDefault,
+			chainScanCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chainScanCursorType, chainScanCursorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chainScanCursorType, chainScanCursorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chain_scan_cursors\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chain_scan_cursors\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chain_scan_cursors")
+	}
+
+	if !cached {
+		chainScanCursorInsertCacheMut.Lock()
+		chainScanCursorInsertCache[key] = cache
+		chainScanCursorInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the ChainScanCursor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChainScanCursor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	chainScanCursorUpdateCacheMut.RLock()
+	cache, cached := chainScanCursorUpdateCache[key]
+	chainScanCursorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chainScanCursorAllColumns,
+			chainScanCursorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chain_scan_cursors, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chain_scan_cursors\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chainScanCursorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chainScanCursorType, chainScanCursorMapping, append(wl, chainScanCursorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chain_scan_cursors row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chain_scan_cursors")
+	}
+
+	if !cached {
+		chainScanCursorUpdateCacheMut.Lock()
+		chainScanCursorUpdateCache[key] = cache
+		chainScanCursorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chainScanCursorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chain_scan_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chain_scan_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChainScanCursorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chainScanCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chain_scan_cursors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chainScanCursorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chainScanCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chainScanCursor")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChainScanCursor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no chain_scan_cursors provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chainScanCursorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chainScanCursorUpsertCacheMut.RLock()
+	cache, cached := chainScanCursorUpsertCache[key]
+	chainScanCursorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			chainScanCursorAllColumns,
+			chainScanCursorColumnsWithDefault,
+			chainScanCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chainScanCursorAllColumns,
+			chainScanCursorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chain_scan_cursors, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(chainScanCursorAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(chainScanCursorPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert chain_scan_cursors, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(chainScanCursorPrimaryKeyColumns))
+			copy(conflict, chainScanCursorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chain_scan_cursors\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(chainScanCursorType, chainScanCursorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chainScanCursorType, chainScanCursorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chain_scan_cursors")
+	}
+
+	if !cached {
+		chainScanCursorUpsertCacheMut.Lock()
+		chainScanCursorUpsertCache[key] = cache
+		chainScanCursorUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single ChainScanCursor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChainScanCursor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChainScanCursor provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chainScanCursorPrimaryKeyMapping)
+	sql := "DELETE FROM \"chain_scan_cursors\" WHERE \"chain_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chain_scan_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chain_scan_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q chainScanCursorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chainScanCursorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chain_scan_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chain_scan_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChainScanCursorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chainScanCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chain_scan_cursors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chainScanCursorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chainScanCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chain_scan_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChainScanCursor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChainScanCursor(ctx, exec, o.ChainID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChainScanCursorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChainScanCursorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chainScanCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chain_scan_cursors\".* FROM \"chain_scan_cursors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chainScanCursorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChainScanCursorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChainScanCursorExists checks if the ChainScanCursor row exists.
+func ChainScanCursorExists(ctx context.Context, exec boil.ContextExecutor, chainID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chain_scan_cursors\" where \"chain_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chainID)
+	}
+	row := exec.QueryRowContext(ctx, sql, chainID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chain_scan_cursors exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChainScanCursor row exists.
+func (o *ChainScanCursor) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChainScanCursorExists(ctx, exec, o.ChainID)
+}
@@ -0,0 +1,697 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testChainScanCursors(t *testing.T) {
+	t.Parallel()
+
+	query := ChainScanCursors()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testChainScanCursorsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChainScanCursorsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ChainScanCursors().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChainScanCursorsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChainScanCursorSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChainScanCursorsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ChainScanCursorExists(ctx, tx, o.ChainID)
+	if err != nil {
+		t.Errorf("Unable to check if ChainScanCursor exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ChainScanCursorExists to return true, but got false.")
+	}
+}
+
+func testChainScanCursorsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	chainScanCursorFound, err := FindChainScanCursor(ctx, tx, o.ChainID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if chainScanCursorFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testChainScanCursorsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ChainScanCursors().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChainScanCursorsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ChainScanCursors().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testChainScanCursorsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	chainScanCursorOne := &ChainScanCursor{}
+	chainScanCursorTwo := &ChainScanCursor{}
+	if err = randomize.Struct(seed, chainScanCursorOne, chainScanCursorDBTypes, false, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chainScanCursorTwo, chainScanCursorDBTypes, false, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chainScanCursorOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chainScanCursorTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChainScanCursors().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testChainScanCursorsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	chainScanCursorOne := &ChainScanCursor{}
+	chainScanCursorTwo := &ChainScanCursor{}
+	if err = randomize.Struct(seed, chainScanCursorOne, chainScanCursorDBTypes, false, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chainScanCursorTwo, chainScanCursorDBTypes, false, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chainScanCursorOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chainScanCursorTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testChainScanCursorsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChainScanCursorsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(chainScanCursorPrimaryKeyColumns, chainScanCursorColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChainScanCursorToOneChainUsingChain(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ChainScanCursor
+	var foreign Chain
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, chainScanCursorDBTypes, false, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, chainDBTypes, false, chainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chain struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ChainID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Chain().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ChainScanCursorSlice{&local}
+	if err = local.L.LoadChain(ctx, tx, false, (*[]*ChainScanCursor)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Chain == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Chain = nil
+	if err = local.L.LoadChain(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Chain == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testChainScanCursorToOneSetOpChainUsingChain(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ChainScanCursor
+	var b, c Chain
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chainScanCursorDBTypes, false, strmangle.SetComplement(chainScanCursorPrimaryKeyColumns, chainScanCursorColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, chainDBTypes, false, strmangle.SetComplement(chainPrimaryKeyColumns, chainColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, chainDBTypes, false, strmangle.SetComplement(chainPrimaryKeyColumns, chainColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Chain{&b, &c} {
+		err = a.SetChain(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Chain != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ChainScanCursor != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ChainID != x.ID {
+			t.Error("foreign key was wrong value", a.ChainID)
+		}
+
+		if exists, err := ChainScanCursorExists(ctx, tx, a.ChainID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testChainScanCursorsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChainScanCursorsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChainScanCursorSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChainScanCursorsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChainScanCursors().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	chainScanCursorDBTypes = map[string]string{`ChainID`: `character varying`, `BlockNumber`: `bigint`, `BlockHash`: `character varying`, `UpdatedAt`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testChainScanCursorsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(chainScanCursorPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(chainScanCursorAllColumns) == len(chainScanCursorPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testChainScanCursorsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(chainScanCursorAllColumns) == len(chainScanCursorPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChainScanCursor{}
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chainScanCursorDBTypes, true, chainScanCursorPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(chainScanCursorAllColumns, chainScanCursorPrimaryKeyColumns) {
+		fields = chainScanCursorAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			chainScanCursorAllColumns,
+			chainScanCursorPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ChainScanCursorSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testChainScanCursorsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(chainScanCursorAllColumns) == len(chainScanCursorPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ChainScanCursor{}
+	if err = randomize.Struct(seed, &o, chainScanCursorDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChainScanCursor: %s", err)
+	}
+
+	count, err := ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, chainScanCursorDBTypes, false, chainScanCursorPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChainScanCursor: %s", err)
+	}
+
+	count, err = ChainScanCursors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
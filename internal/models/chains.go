@@ -24,132 +24,161 @@ import (
 
 // Chain is an object representing the database table.
 type Chain struct {
-	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name           string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Type           string      `boil:"type" json:"type" toml:"type" yaml:"type"`
-	ChainID        null.String `boil:"chain_id" json:"chain_id,omitempty" toml:"chain_id" yaml:"chain_id,omitempty"`
-	Algorithm      string      `boil:"algorithm" json:"algorithm" toml:"algorithm" yaml:"algorithm"`
-	Curve          string      `boil:"curve" json:"curve" toml:"curve" yaml:"curve"`
-	CurrencySymbol string      `boil:"currency_symbol" json:"currency_symbol" toml:"currency_symbol" yaml:"currency_symbol"`
-	RPCURL         null.String `boil:"rpc_url" json:"rpc_url,omitempty" toml:"rpc_url" yaml:"rpc_url,omitempty"`
-	ExplorerURL    null.String `boil:"explorer_url" json:"explorer_url,omitempty" toml:"explorer_url" yaml:"explorer_url,omitempty"`
-	IconURL        null.String `boil:"icon_url" json:"icon_url,omitempty" toml:"icon_url" yaml:"icon_url,omitempty"`
-	IsTestnet      null.Bool   `boil:"is_testnet" json:"is_testnet,omitempty" toml:"is_testnet" yaml:"is_testnet,omitempty"`
-	CreatedAt      null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID                    string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                  string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Type                  string      `boil:"type" json:"type" toml:"type" yaml:"type"`
+	ChainID               null.String `boil:"chain_id" json:"chain_id,omitempty" toml:"chain_id" yaml:"chain_id,omitempty"`
+	Algorithm             string      `boil:"algorithm" json:"algorithm" toml:"algorithm" yaml:"algorithm"`
+	Curve                 string      `boil:"curve" json:"curve" toml:"curve" yaml:"curve"`
+	CurrencySymbol        string      `boil:"currency_symbol" json:"currency_symbol" toml:"currency_symbol" yaml:"currency_symbol"`
+	RPCURL                null.String `boil:"rpc_url" json:"rpc_url,omitempty" toml:"rpc_url" yaml:"rpc_url,omitempty"`
+	ExplorerURL           null.String `boil:"explorer_url" json:"explorer_url,omitempty" toml:"explorer_url" yaml:"explorer_url,omitempty"`
+	IconURL               null.String `boil:"icon_url" json:"icon_url,omitempty" toml:"icon_url" yaml:"icon_url,omitempty"`
+	IsTestnet             null.Bool   `boil:"is_testnet" json:"is_testnet,omitempty" toml:"is_testnet" yaml:"is_testnet,omitempty"`
+	CreatedAt             null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt             null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	IsActive              bool        `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	RequiredConfirmations int         `boil:"required_confirmations" json:"required_confirmations" toml:"required_confirmations" yaml:"required_confirmations"`
 
 	R *chainR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L chainL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ChainColumns = struct {
-	ID             string
-	Name           string
-	Type           string
-	ChainID        string
-	Algorithm      string
-	Curve          string
-	CurrencySymbol string
-	RPCURL         string
-	ExplorerURL    string
-	IconURL        string
-	IsTestnet      string
-	CreatedAt      string
-	UpdatedAt      string
+	ID                    string
+	Name                  string
+	Type                  string
+	ChainID               string
+	Algorithm             string
+	Curve                 string
+	CurrencySymbol        string
+	RPCURL                string
+	ExplorerURL           string
+	IconURL               string
+	IsTestnet             string
+	CreatedAt             string
+	UpdatedAt             string
+	IsActive              string
+	RequiredConfirmations string
 }{
-	ID:             "id",
-	Name:           "name",
-	Type:           "type",
-	ChainID:        "chain_id",
-	Algorithm:      "algorithm",
-	Curve:          "curve",
-	CurrencySymbol: "currency_symbol",
-	RPCURL:         "rpc_url",
-	ExplorerURL:    "explorer_url",
-	IconURL:        "icon_url",
-	IsTestnet:      "is_testnet",
-	CreatedAt:      "created_at",
-	UpdatedAt:      "updated_at",
+	ID:                    "id",
+	Name:                  "name",
+	Type:                  "type",
+	ChainID:               "chain_id",
+	Algorithm:             "algorithm",
+	Curve:                 "curve",
+	CurrencySymbol:        "currency_symbol",
+	RPCURL:                "rpc_url",
+	ExplorerURL:           "explorer_url",
+	IconURL:               "icon_url",
+	IsTestnet:             "is_testnet",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	IsActive:              "is_active",
+	RequiredConfirmations: "required_confirmations",
 }
 
 var ChainTableColumns = struct {
-	ID             string
-	Name           string
-	Type           string
-	ChainID        string
-	Algorithm      string
-	Curve          string
-	CurrencySymbol string
-	RPCURL         string
-	ExplorerURL    string
-	IconURL        string
-	IsTestnet      string
-	CreatedAt      string
-	UpdatedAt      string
+	ID                    string
+	Name                  string
+	Type                  string
+	ChainID               string
+	Algorithm             string
+	Curve                 string
+	CurrencySymbol        string
+	RPCURL                string
+	ExplorerURL           string
+	IconURL               string
+	IsTestnet             string
+	CreatedAt             string
+	UpdatedAt             string
+	IsActive              string
+	RequiredConfirmations string
 }{
-	ID:             "chains.id",
-	Name:           "chains.name",
-	Type:           "chains.type",
-	ChainID:        "chains.chain_id",
-	Algorithm:      "chains.algorithm",
-	Curve:          "chains.curve",
-	CurrencySymbol: "chains.currency_symbol",
-	RPCURL:         "chains.rpc_url",
-	ExplorerURL:    "chains.explorer_url",
-	IconURL:        "chains.icon_url",
-	IsTestnet:      "chains.is_testnet",
-	CreatedAt:      "chains.created_at",
-	UpdatedAt:      "chains.updated_at",
+	ID:                    "chains.id",
+	Name:                  "chains.name",
+	Type:                  "chains.type",
+	ChainID:               "chains.chain_id",
+	Algorithm:             "chains.algorithm",
+	Curve:                 "chains.curve",
+	CurrencySymbol:        "chains.currency_symbol",
+	RPCURL:                "chains.rpc_url",
+	ExplorerURL:           "chains.explorer_url",
+	IconURL:               "chains.icon_url",
+	IsTestnet:             "chains.is_testnet",
+	CreatedAt:             "chains.created_at",
+	UpdatedAt:             "chains.updated_at",
+	IsActive:              "chains.is_active",
+	RequiredConfirmations: "chains.required_confirmations",
 }
 
 // Generated where
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var ChainWhere = struct {
-	ID             whereHelperstring
-	Name           whereHelperstring
-	Type           whereHelperstring
-	ChainID        whereHelpernull_String
-	Algorithm      whereHelperstring
-	Curve          whereHelperstring
-	CurrencySymbol whereHelperstring
-	RPCURL         whereHelpernull_String
-	ExplorerURL    whereHelpernull_String
-	IconURL        whereHelpernull_String
-	IsTestnet      whereHelpernull_Bool
-	CreatedAt      whereHelpernull_Time
-	UpdatedAt      whereHelpernull_Time
+	ID                    whereHelperstring
+	Name                  whereHelperstring
+	Type                  whereHelperstring
+	ChainID               whereHelpernull_String
+	Algorithm             whereHelperstring
+	Curve                 whereHelperstring
+	CurrencySymbol        whereHelperstring
+	RPCURL                whereHelpernull_String
+	ExplorerURL           whereHelpernull_String
+	IconURL               whereHelpernull_String
+	IsTestnet             whereHelpernull_Bool
+	CreatedAt             whereHelpernull_Time
+	UpdatedAt             whereHelpernull_Time
+	IsActive              whereHelperbool
+	RequiredConfirmations whereHelperint
 }{
-	ID:             whereHelperstring{field: "\"chains\".\"id\""},
-	Name:           whereHelperstring{field: "\"chains\".\"name\""},
-	Type:           whereHelperstring{field: "\"chains\".\"type\""},
-	ChainID:        whereHelpernull_String{field: "\"chains\".\"chain_id\""},
-	Algorithm:      whereHelperstring{field: "\"chains\".\"algorithm\""},
-	Curve:          whereHelperstring{field: "\"chains\".\"curve\""},
-	CurrencySymbol: whereHelperstring{field: "\"chains\".\"currency_symbol\""},
-	RPCURL:         whereHelpernull_String{field: "\"chains\".\"rpc_url\""},
-	ExplorerURL:    whereHelpernull_String{field: "\"chains\".\"explorer_url\""},
-	IconURL:        whereHelpernull_String{field: "\"chains\".\"icon_url\""},
-	IsTestnet:      whereHelpernull_Bool{field: "\"chains\".\"is_testnet\""},
-	CreatedAt:      whereHelpernull_Time{field: "\"chains\".\"created_at\""},
-	UpdatedAt:      whereHelpernull_Time{field: "\"chains\".\"updated_at\""},
+	ID:                    whereHelperstring{field: "\"chains\".\"id\""},
+	Name:                  whereHelperstring{field: "\"chains\".\"name\""},
+	Type:                  whereHelperstring{field: "\"chains\".\"type\""},
+	ChainID:               whereHelpernull_String{field: "\"chains\".\"chain_id\""},
+	Algorithm:             whereHelperstring{field: "\"chains\".\"algorithm\""},
+	Curve:                 whereHelperstring{field: "\"chains\".\"curve\""},
+	CurrencySymbol:        whereHelperstring{field: "\"chains\".\"currency_symbol\""},
+	RPCURL:                whereHelpernull_String{field: "\"chains\".\"rpc_url\""},
+	ExplorerURL:           whereHelpernull_String{field: "\"chains\".\"explorer_url\""},
+	IconURL:               whereHelpernull_String{field: "\"chains\".\"icon_url\""},
+	IsTestnet:             whereHelpernull_Bool{field: "\"chains\".\"is_testnet\""},
+	CreatedAt:             whereHelpernull_Time{field: "\"chains\".\"created_at\""},
+	UpdatedAt:             whereHelpernull_Time{field: "\"chains\".\"updated_at\""},
+	IsActive:              whereHelperbool{field: "\"chains\".\"is_active\""},
+	RequiredConfirmations: whereHelperint{field: "\"chains\".\"required_confirmations\""},
 }
 
 // ChainRels is where relationship names are stored.
 var ChainRels = struct {
-	AddressBooks string
-	Assets       string
-	Wallets      string
+	ChainScanCursor string
+	AddressBooks    string
+	Assets          string
+	Deposits        string
+	Wallets         string
 }{
-	AddressBooks: "AddressBooks",
-	Assets:       "Assets",
-	Wallets:      "Wallets",
+	ChainScanCursor: "ChainScanCursor",
+	AddressBooks:    "AddressBooks",
+	Assets:          "Assets",
+	Deposits:        "Deposits",
+	Wallets:         "Wallets",
 }
 
 // chainR is where relationships are stored.
 type chainR struct {
-	AddressBooks AddressBookSlice `boil:"AddressBooks" json:"AddressBooks" toml:"AddressBooks" yaml:"AddressBooks"`
-	Assets       AssetSlice       `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
-	Wallets      WalletSlice      `boil:"Wallets" json:"Wallets" toml:"Wallets" yaml:"Wallets"`
+	ChainScanCursor *ChainScanCursor `boil:"ChainScanCursor" json:"ChainScanCursor" toml:"ChainScanCursor" yaml:"ChainScanCursor"`
+	AddressBooks    AddressBookSlice `boil:"AddressBooks" json:"AddressBooks" toml:"AddressBooks" yaml:"AddressBooks"`
+	Assets          AssetSlice       `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	Deposits        DepositSlice     `boil:"Deposits" json:"Deposits" toml:"Deposits" yaml:"Deposits"`
+	Wallets         WalletSlice      `boil:"Wallets" json:"Wallets" toml:"Wallets" yaml:"Wallets"`
 }
 
 // NewStruct creates a new relationship struct
@@ -157,6 +186,22 @@ func (*chainR) NewStruct() *chainR {
 	return &chainR{}
 }
 
+func (o *Chain) GetChainScanCursor() *ChainScanCursor {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChainScanCursor()
+}
+
+func (r *chainR) GetChainScanCursor() *ChainScanCursor {
+	if r == nil {
+		return nil
+	}
+
+	return r.ChainScanCursor
+}
+
 func (o *Chain) GetAddressBooks() AddressBookSlice {
 	if o == nil {
 		return nil
@@ -189,6 +234,22 @@ func (r *chainR) GetAssets() AssetSlice {
 	return r.Assets
 }
 
+func (o *Chain) GetDeposits() DepositSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDeposits()
+}
+
+func (r *chainR) GetDeposits() DepositSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Deposits
+}
+
 func (o *Chain) GetWallets() WalletSlice {
 	if o == nil {
 		return nil
@@ -209,9 +270,9 @@ func (r *chainR) GetWallets() WalletSlice {
 type chainL struct{}
 
 var (
-	chainAllColumns            = []string{"id", "name", "type", "chain_id", "algorithm", "curve", "currency_symbol", "rpc_url", "explorer_url", "icon_url", "is_testnet", "created_at", "updated_at"}
+	chainAllColumns            = []string{"id", "name", "type", "chain_id", "algorithm", "curve", "currency_symbol", "rpc_url", "explorer_url", "icon_url", "is_testnet", "created_at", "updated_at", "is_active", "required_confirmations"}
 	chainColumnsWithoutDefault = []string{"id", "name", "type", "algorithm", "curve", "currency_symbol"}
-	chainColumnsWithDefault    = []string{"chain_id", "rpc_url", "explorer_url", "icon_url", "is_testnet", "created_at", "updated_at"}
+	chainColumnsWithDefault    = []string{"chain_id", "rpc_url", "explorer_url", "icon_url", "is_testnet", "created_at", "updated_at", "is_active", "required_confirmations"}
 	chainPrimaryKeyColumns     = []string{"id"}
 	chainGeneratedColumns      = []string{}
 )
@@ -307,6 +368,17 @@ func (q chainQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// ChainScanCursor pointed to by the foreign key.
+func (o *Chain) ChainScanCursor(mods ...qm.QueryMod) chainScanCursorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"chain_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ChainScanCursors(queryMods...)
+}
+
 // AddressBooks retrieves all the address_book's AddressBooks with an executor.
 func (o *Chain) AddressBooks(mods ...qm.QueryMod) addressBookQuery {
 	var queryMods []qm.QueryMod
@@ -335,6 +407,20 @@ func (o *Chain) Assets(mods ...qm.QueryMod) assetQuery {
 	return Assets(queryMods...)
 }
 
+// Deposits retrieves all the deposit's Deposits with an executor.
+func (o *Chain) Deposits(mods ...qm.QueryMod) depositQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deposits\".\"chain_id\"=?", o.ID),
+	)
+
+	return Deposits(queryMods...)
+}
+
 // Wallets retrieves all the wallet's Wallets with an executor.
 func (o *Chain) Wallets(mods ...qm.QueryMod) walletQuery {
 	var queryMods []qm.QueryMod
@@ -349,6 +435,115 @@ func (o *Chain) Wallets(mods ...qm.QueryMod) walletQuery {
 	return Wallets(queryMods...)
 }
 
+// LoadChainScanCursor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (chainL) LoadChainScanCursor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChain interface{}, mods queries.Applicator) error {
+	var slice []*Chain
+	var object *Chain
+
+	if singular {
+		var ok bool
+		object, ok = maybeChain.(*Chain)
+		if !ok {
+			object = new(Chain)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChain))
+			}
+		}
+	} else {
+		s, ok := maybeChain.(*[]*Chain)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChain))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &chainR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chainR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`chain_scan_cursors`),
+		qm.WhereIn(`chain_scan_cursors.chain_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ChainScanCursor")
+	}
+
+	var resultSlice []*ChainScanCursor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ChainScanCursor")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chain_scan_cursors")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chain_scan_cursors")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ChainScanCursor = foreign
+		if foreign.R == nil {
+			foreign.R = &chainScanCursorR{}
+		}
+		foreign.R.Chain = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ChainID {
+				local.R.ChainScanCursor = foreign
+				if foreign.R == nil {
+					foreign.R = &chainScanCursorR{}
+				}
+				foreign.R.Chain = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadAddressBooks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (chainL) LoadAddressBooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChain interface{}, mods queries.Applicator) error {
@@ -561,6 +756,112 @@ func (chainL) LoadAssets(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
+// LoadDeposits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chainL) LoadDeposits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChain interface{}, mods queries.Applicator) error {
+	var slice []*Chain
+	var object *Chain
+
+	if singular {
+		var ok bool
+		object, ok = maybeChain.(*Chain)
+		if !ok {
+			object = new(Chain)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChain))
+			}
+		}
+	} else {
+		s, ok := maybeChain.(*[]*Chain)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChain))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &chainR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chainR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`deposits`),
+		qm.WhereIn(`deposits.chain_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deposits")
+	}
+
+	var resultSlice []*Deposit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deposits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deposits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposits")
+	}
+
+	if singular {
+		object.R.Deposits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &depositR{}
+			}
+			foreign.R.Chain = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChainID {
+				local.R.Deposits = append(local.R.Deposits, foreign)
+				if foreign.R == nil {
+					foreign.R = &depositR{}
+				}
+				foreign.R.Chain = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadWallets allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (chainL) LoadWallets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChain interface{}, mods queries.Applicator) error {
@@ -667,6 +968,56 @@ func (chainL) LoadWallets(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// SetChainScanCursor of the chain to the related item.
+// Sets o.R.ChainScanCursor to related.
+// Adds o to related.R.Chain.
+func (o *Chain) SetChainScanCursor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ChainScanCursor) error {
+	var err error
+
+	if insert {
+		related.ChainID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"chain_scan_cursors\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"chain_id"}),
+			strmangle.WhereClause("\"", "\"", 2, chainScanCursorPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ChainID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ChainID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &chainR{
+			ChainScanCursor: related,
+		}
+	} else {
+		o.R.ChainScanCursor = related
+	}
+
+	if related.R == nil {
+		related.R = &chainScanCursorR{
+			Chain: o,
+		}
+	} else {
+		related.R.Chain = o
+	}
+	return nil
+}
+
 // AddAddressBooks adds the given related objects to the existing relationships
 // of the chain, optionally inserting them as new records.
 // Appends related to o.R.AddressBooks.
@@ -921,6 +1272,59 @@ func (o *Chain) RemoveAssets(ctx context.Context, exec boil.ContextExecutor, rel
 	return nil
 }
 
+// AddDeposits adds the given related objects to the existing relationships
+// of the chain, optionally inserting them as new records.
+// Appends related to o.R.Deposits.
+// Sets related.R.Chain appropriately.
+func (o *Chain) AddDeposits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Deposit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChainID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deposits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chain_id"}),
+				strmangle.WhereClause("\"", "\"", 2, depositPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChainID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chainR{
+			Deposits: related,
+		}
+	} else {
+		o.R.Deposits = append(o.R.Deposits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &depositR{
+				Chain: o,
+			}
+		} else {
+			rel.R.Chain = o
+		}
+	}
+	return nil
+}
+
 // AddWallets adds the given related objects to the existing relationships
 // of the chain, optionally inserting them as new records.
 // Appends related to o.R.Wallets.
@@ -353,6 +353,119 @@ func testChainsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testChainOneToOneChainScanCursorUsingChainScanCursor(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign ChainScanCursor
+	var local Chain
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, chainScanCursorDBTypes, true, chainScanCursorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChainScanCursor struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, chainDBTypes, true, chainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chain struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.ChainID = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ChainScanCursor().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ChainID != foreign.ChainID {
+		t.Errorf("want: %v, got %v", foreign.ChainID, check.ChainID)
+	}
+
+	slice := ChainSlice{&local}
+	if err = local.L.LoadChainScanCursor(ctx, tx, false, (*[]*Chain)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ChainScanCursor == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ChainScanCursor = nil
+	if err = local.L.LoadChainScanCursor(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ChainScanCursor == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testChainOneToOneSetOpChainScanCursorUsingChainScanCursor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Chain
+	var b, c ChainScanCursor
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chainDBTypes, false, strmangle.SetComplement(chainPrimaryKeyColumns, chainColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, chainScanCursorDBTypes, false, strmangle.SetComplement(chainScanCursorPrimaryKeyColumns, chainScanCursorColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, chainScanCursorDBTypes, false, strmangle.SetComplement(chainScanCursorPrimaryKeyColumns, chainScanCursorColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ChainScanCursor{&b, &c} {
+		err = a.SetChainScanCursor(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ChainScanCursor != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Chain != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.ChainID {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		if exists, err := ChainScanCursorExists(ctx, tx, x.ChainID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'x' to exist")
+		}
+
+		if a.ID != x.ChainID {
+			t.Error("foreign key was wrong value", a.ID, x.ChainID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
 func testChainToManyAddressBooks(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -507,6 +620,84 @@ func testChainToManyAssets(t *testing.T) {
 	}
 }
 
+func testChainToManyDeposits(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Chain
+	var b, c Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chainDBTypes, true, chainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chain struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ChainID = a.ID
+	c.ChainID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Deposits().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ChainID == b.ChainID {
+			bFound = true
+		}
+		if v.ChainID == c.ChainID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ChainSlice{&a}
+	if err = a.L.LoadDeposits(ctx, tx, false, (*[]*Chain)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Deposits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Deposits = nil
+	if err = a.L.LoadDeposits(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Deposits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testChainToManyWallets(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -1086,6 +1277,81 @@ func testChainToManyRemoveOpAssets(t *testing.T) {
 	}
 }
 
+func testChainToManyAddOpDeposits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Chain
+	var b, c, d, e Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chainDBTypes, false, strmangle.SetComplement(chainPrimaryKeyColumns, chainColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Deposit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Deposit{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDeposits(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ChainID {
+			t.Error("foreign key was wrong value", a.ID, first.ChainID)
+		}
+		if a.ID != second.ChainID {
+			t.Error("foreign key was wrong value", a.ID, second.ChainID)
+		}
+
+		if first.R.Chain != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Chain != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Deposits[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Deposits[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Deposits().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testChainToManyAddOpWallets(t *testing.T) {
 	var err error
 
@@ -1411,7 +1677,7 @@ func testChainsSelect(t *testing.T) {
 }
 
 var (
-	chainDBTypes = map[string]string{`ID`: `character varying`, `Name`: `character varying`, `Type`: `character varying`, `ChainID`: `character varying`, `Algorithm`: `character varying`, `Curve`: `character varying`, `CurrencySymbol`: `character varying`, `RPCURL`: `text`, `ExplorerURL`: `text`, `IconURL`: `text`, `IsTestnet`: `boolean`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	chainDBTypes = map[string]string{`ID`: `character varying`, `Name`: `character varying`, `Type`: `character varying`, `ChainID`: `character varying`, `Algorithm`: `character varying`, `Curve`: `character varying`, `CurrencySymbol`: `character varying`, `RPCURL`: `text`, `ExplorerURL`: `text`, `IconURL`: `text`, `IsTestnet`: `boolean`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `IsActive`: `boolean`, `RequiredConfirmations`: `integer`}
 	_            = bytes.MinRead
 )
 
@@ -0,0 +1,1396 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Deposit is an object representing the database table.
+type Deposit struct {
+	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WalletID      string      `boil:"wallet_id" json:"wallet_id" toml:"wallet_id" yaml:"wallet_id"`
+	ChainID       string      `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	AssetID       null.String `boil:"asset_id" json:"asset_id,omitempty" toml:"asset_id" yaml:"asset_id,omitempty"`
+	TXHash        string      `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	LogIndex      int         `boil:"log_index" json:"log_index" toml:"log_index" yaml:"log_index"`
+	FromAddress   string      `boil:"from_address" json:"from_address" toml:"from_address" yaml:"from_address"`
+	Amount        string      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	BlockNumber   int64       `boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
+	BlockHash     string      `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
+	Confirmations int         `boil:"confirmations" json:"confirmations" toml:"confirmations" yaml:"confirmations"`
+	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ConfirmedAt   null.Time   `boil:"confirmed_at" json:"confirmed_at,omitempty" toml:"confirmed_at" yaml:"confirmed_at,omitempty"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *depositR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L depositL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DepositColumns = struct {
+	ID            string
+	WalletID      string
+	ChainID       string
+	AssetID       string
+	TXHash        string
+	LogIndex      string
+	FromAddress   string
+	Amount        string
+	BlockNumber   string
+	BlockHash     string
+	Confirmations string
+	Status        string
+	ConfirmedAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	WalletID:      "wallet_id",
+	ChainID:       "chain_id",
+	AssetID:       "asset_id",
+	TXHash:        "tx_hash",
+	LogIndex:      "log_index",
+	FromAddress:   "from_address",
+	Amount:        "amount",
+	BlockNumber:   "block_number",
+	BlockHash:     "block_hash",
+	Confirmations: "confirmations",
+	Status:        "status",
+	ConfirmedAt:   "confirmed_at",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var DepositTableColumns = struct {
+	ID            string
+	WalletID      string
+	ChainID       string
+	AssetID       string
+	TXHash        string
+	LogIndex      string
+	FromAddress   string
+	Amount        string
+	BlockNumber   string
+	BlockHash     string
+	Confirmations string
+	Status        string
+	ConfirmedAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "deposits.id",
+	WalletID:      "deposits.wallet_id",
+	ChainID:       "deposits.chain_id",
+	AssetID:       "deposits.asset_id",
+	TXHash:        "deposits.tx_hash",
+	LogIndex:      "deposits.log_index",
+	FromAddress:   "deposits.from_address",
+	Amount:        "deposits.amount",
+	BlockNumber:   "deposits.block_number",
+	BlockHash:     "deposits.block_hash",
+	Confirmations: "deposits.confirmations",
+	Status:        "deposits.status",
+	ConfirmedAt:   "deposits.confirmed_at",
+	CreatedAt:     "deposits.created_at",
+	UpdatedAt:     "deposits.updated_at",
+}
+
+// Generated where
+
+var DepositWhere = struct {
+	ID            whereHelperstring
+	WalletID      whereHelperstring
+	ChainID       whereHelperstring
+	AssetID       whereHelpernull_String
+	TXHash        whereHelperstring
+	LogIndex      whereHelperint
+	FromAddress   whereHelperstring
+	Amount        whereHelperstring
+	BlockNumber   whereHelperint64
+	BlockHash     whereHelperstring
+	Confirmations whereHelperint
+	Status        whereHelperstring
+	ConfirmedAt   whereHelpernull_Time
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"deposits\".\"id\""},
+	WalletID:      whereHelperstring{field: "\"deposits\".\"wallet_id\""},
+	ChainID:       whereHelperstring{field: "\"deposits\".\"chain_id\""},
+	AssetID:       whereHelpernull_String{field: "\"deposits\".\"asset_id\""},
+	TXHash:        whereHelperstring{field: "\"deposits\".\"tx_hash\""},
+	LogIndex:      whereHelperint{field: "\"deposits\".\"log_index\""},
+	FromAddress:   whereHelperstring{field: "\"deposits\".\"from_address\""},
+	Amount:        whereHelperstring{field: "\"deposits\".\"amount\""},
+	BlockNumber:   whereHelperint64{field: "\"deposits\".\"block_number\""},
+	BlockHash:     whereHelperstring{field: "\"deposits\".\"block_hash\""},
+	Confirmations: whereHelperint{field: "\"deposits\".\"confirmations\""},
+	Status:        whereHelperstring{field: "\"deposits\".\"status\""},
+	ConfirmedAt:   whereHelpernull_Time{field: "\"deposits\".\"confirmed_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"deposits\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"deposits\".\"updated_at\""},
+}
+
+// DepositRels is where relationship names are stored.
+var DepositRels = struct {
+	Asset  string
+	Chain  string
+	Wallet string
+}{
+	Asset:  "Asset",
+	Chain:  "Chain",
+	Wallet: "Wallet",
+}
+
+// depositR is where relationships are stored.
+type depositR struct {
+	Asset  *Asset  `boil:"Asset" json:"Asset" toml:"Asset" yaml:"Asset"`
+	Chain  *Chain  `boil:"Chain" json:"Chain" toml:"Chain" yaml:"Chain"`
+	Wallet *Wallet `boil:"Wallet" json:"Wallet" toml:"Wallet" yaml:"Wallet"`
+}
+
+// NewStruct creates a new relationship struct
+func (*depositR) NewStruct() *depositR {
+	return &depositR{}
+}
+
+func (o *Deposit) GetAsset() *Asset {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAsset()
+}
+
+func (r *depositR) GetAsset() *Asset {
+	if r == nil {
+		return nil
+	}
+
+	return r.Asset
+}
+
+func (o *Deposit) GetChain() *Chain {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChain()
+}
+
+func (r *depositR) GetChain() *Chain {
+	if r == nil {
+		return nil
+	}
+
+	return r.Chain
+}
+
+func (o *Deposit) GetWallet() *Wallet {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWallet()
+}
+
+func (r *depositR) GetWallet() *Wallet {
+	if r == nil {
+		return nil
+	}
+
+	return r.Wallet
+}
+
+// depositL is where Load methods for each relationship are stored.
+type depositL struct{}
+
+var (
+	depositAllColumns            = []string{"id", "wallet_id", "chain_id", "asset_id", "tx_hash", "log_index", "from_address", "amount", "block_number", "block_hash", "confirmations", "status", "confirmed_at", "created_at", "updated_at"}
+	depositColumnsWithoutDefault = []string{"wallet_id", "chain_id", "tx_hash", "log_index", "from_address", "amount", "block_number", "block_hash"}
+	depositColumnsWithDefault    = []string{"id", "asset_id", "confirmations", "status", "confirmed_at", "created_at", "updated_at"}
+	depositPrimaryKeyColumns     = []string{"id"}
+	depositGeneratedColumns      = []string{}
+)
+
+type (
+	// DepositSlice is an alias for a slice of pointers to Deposit.
+	// This should almost always be used instead of []Deposit.
+	DepositSlice []*Deposit
+
+	depositQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	depositType                 = reflect.TypeOf(&Deposit{})
+	depositMapping              = queries.MakeStructMapping(depositType)
+	depositPrimaryKeyMapping, _ = queries.BindMapping(depositType, depositMapping, depositPrimaryKeyColumns)
+	depositInsertCacheMut       sync.RWMutex
+	depositInsertCache          = make(map[string]insertCache)
+	depositUpdateCacheMut       sync.RWMutex
+	depositUpdateCache          = make(map[string]updateCache)
+	depositUpsertCacheMut       sync.RWMutex
+	depositUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single deposit record from the query.
+func (q depositQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Deposit, error) {
+	o := &Deposit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for deposits")
+	}
+
+	return o, nil
+}
+
+// All returns all Deposit records from the query.
+func (q depositQuery) All(ctx context.Context, exec boil.ContextExecutor) (DepositSlice, error) {
+	var o []*Deposit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Deposit slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Deposit records in the query.
+func (q depositQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count deposits rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q depositQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if deposits exists")
+	}
+
+	return count > 0, nil
+}
+
+// Asset pointed to by the foreign key.
+func (o *Deposit) Asset(mods ...qm.QueryMod) assetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AssetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Assets(queryMods...)
+}
+
+// Chain pointed to by the foreign key.
+func (o *Deposit) Chain(mods ...qm.QueryMod) chainQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChainID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chains(queryMods...)
+}
+
+// Wallet pointed to by the foreign key.
+func (o *Deposit) Wallet(mods ...qm.QueryMod) walletQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WalletID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Wallets(queryMods...)
+}
+
+// LoadAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (depositL) LoadAsset(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeposit interface{}, mods queries.Applicator) error {
+	var slice []*Deposit
+	var object *Deposit
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeposit.(*Deposit)
+		if !ok {
+			object = new(Deposit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeposit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeposit))
+			}
+		}
+	} else {
+		s, ok := maybeDeposit.(*[]*Deposit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeposit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeposit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &depositR{}
+		}
+		if !queries.IsNil(object.AssetID) {
+			args[object.AssetID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &depositR{}
+			}
+
+			if !queries.IsNil(obj.AssetID) {
+				args[obj.AssetID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`assets`),
+		qm.WhereIn(`assets.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Asset")
+	}
+
+	var resultSlice []*Asset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Asset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for assets")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Asset = foreign
+		if foreign.R == nil {
+			foreign.R = &assetR{}
+		}
+		foreign.R.Deposits = append(foreign.R.Deposits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AssetID, foreign.ID) {
+				local.R.Asset = foreign
+				if foreign.R == nil {
+					foreign.R = &assetR{}
+				}
+				foreign.R.Deposits = append(foreign.R.Deposits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChain allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (depositL) LoadChain(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeposit interface{}, mods queries.Applicator) error {
+	var slice []*Deposit
+	var object *Deposit
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeposit.(*Deposit)
+		if !ok {
+			object = new(Deposit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeposit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeposit))
+			}
+		}
+	} else {
+		s, ok := maybeDeposit.(*[]*Deposit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeposit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeposit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &depositR{}
+		}
+		args[object.ChainID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &depositR{}
+			}
+
+			args[obj.ChainID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`chains`),
+		qm.WhereIn(`chains.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chain")
+	}
+
+	var resultSlice []*Chain
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chain")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chains")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chains")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chain = foreign
+		if foreign.R == nil {
+			foreign.R = &chainR{}
+		}
+		foreign.R.Deposits = append(foreign.R.Deposits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChainID == foreign.ID {
+				local.R.Chain = foreign
+				if foreign.R == nil {
+					foreign.R = &chainR{}
+				}
+				foreign.R.Deposits = append(foreign.R.Deposits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWallet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (depositL) LoadWallet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeposit interface{}, mods queries.Applicator) error {
+	var slice []*Deposit
+	var object *Deposit
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeposit.(*Deposit)
+		if !ok {
+			object = new(Deposit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeposit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeposit))
+			}
+		}
+	} else {
+		s, ok := maybeDeposit.(*[]*Deposit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeposit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeposit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &depositR{}
+		}
+		args[object.WalletID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &depositR{}
+			}
+
+			args[obj.WalletID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`wallets`),
+		qm.WhereIn(`wallets.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Wallet")
+	}
+
+	var resultSlice []*Wallet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Wallet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for wallets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallets")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Wallet = foreign
+		if foreign.R == nil {
+			foreign.R = &walletR{}
+		}
+		foreign.R.Deposits = append(foreign.R.Deposits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WalletID == foreign.ID {
+				local.R.Wallet = foreign
+				if foreign.R == nil {
+					foreign.R = &walletR{}
+				}
+				foreign.R.Deposits = append(foreign.R.Deposits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAsset of the deposit to the related item.
+// Sets o.R.Asset to related.
+// Adds o to related.R.Deposits.
+func (o *Deposit) SetAsset(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Asset) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"deposits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, depositPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AssetID, related.ID)
+	if o.R == nil {
+		o.R = &depositR{
+			Asset: related,
+		}
+	} else {
+		o.R.Asset = related
+	}
+
+	if related.R == nil {
+		related.R = &assetR{
+			Deposits: DepositSlice{o},
+		}
+	} else {
+		related.R.Deposits = append(related.R.Deposits, o)
+	}
+
+	return nil
+}
+
+// RemoveAsset relationship.
+// Sets o.R.Asset to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Deposit) RemoveAsset(ctx context.Context, exec boil.ContextExecutor, related *Asset) error {
+	var err error
+
+	queries.SetScanner(&o.AssetID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("asset_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Asset = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Deposits {
+		if queries.Equal(o.AssetID, ri.AssetID) {
+			continue
+		}
+
+		ln := len(related.R.Deposits)
+		if ln > 1 && i < ln-1 {
+			related.R.Deposits[i] = related.R.Deposits[ln-1]
+		}
+		related.R.Deposits = related.R.Deposits[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetChain of the deposit to the related item.
+// Sets o.R.Chain to related.
+// Adds o to related.R.Deposits.
+func (o *Deposit) SetChain(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chain) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"deposits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chain_id"}),
+		strmangle.WhereClause("\"", "\"", 2, depositPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChainID = related.ID
+	if o.R == nil {
+		o.R = &depositR{
+			Chain: related,
+		}
+	} else {
+		o.R.Chain = related
+	}
+
+	if related.R == nil {
+		related.R = &chainR{
+			Deposits: DepositSlice{o},
+		}
+	} else {
+		related.R.Deposits = append(related.R.Deposits, o)
+	}
+
+	return nil
+}
+
+// SetWallet of the deposit to the related item.
+// Sets o.R.Wallet to related.
+// Adds o to related.R.Deposits.
+func (o *Deposit) SetWallet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Wallet) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"deposits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"wallet_id"}),
+		strmangle.WhereClause("\"", "\"", 2, depositPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WalletID = related.ID
+	if o.R == nil {
+		o.R = &depositR{
+			Wallet: related,
+		}
+	} else {
+		o.R.Wallet = related
+	}
+
+	if related.R == nil {
+		related.R = &walletR{
+			Deposits: DepositSlice{o},
+		}
+	} else {
+		related.R.Deposits = append(related.R.Deposits, o)
+	}
+
+	return nil
+}
+
+// Deposits retrieves all the records using an executor.
+func Deposits(mods ...qm.QueryMod) depositQuery {
+	mods = append(mods, qm.From("\"deposits\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"deposits\".*"})
+	}
+
+	return depositQuery{q}
+}
+
+// FindDeposit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDeposit(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Deposit, error) {
+	depositObj := &Deposit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"deposits\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, depositObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from deposits")
+	}
+
+	return depositObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Deposit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no deposits provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(depositColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	depositInsertCacheMut.RLock()
+	cache, cached := depositInsertCache[key]
+	depositInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			depositAllColumns,
+			depositColumnsWithDefault,
+			depositColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(depositType, depositMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(depositType, depositMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"deposits\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"deposits\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into deposits")
+	}
+
+	if !cached {
+		depositInsertCacheMut.Lock()
+		depositInsertCache[key] = cache
+		depositInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the Deposit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Deposit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	depositUpdateCacheMut.RLock()
+	cache, cached := depositUpdateCache[key]
+	depositUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			depositAllColumns,
+			depositPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update deposits, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"deposits\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, depositPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(depositType, depositMapping, append(wl, depositPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update deposits row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for deposits")
+	}
+
+	if !cached {
+		depositUpdateCacheMut.Lock()
+		depositUpdateCache[key] = cache
+		depositUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q depositQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for deposits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for deposits")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DepositSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), depositPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"deposits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, depositPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in deposit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all deposit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Deposit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no deposits provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(depositColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	depositUpsertCacheMut.RLock()
+	cache, cached := depositUpsertCache[key]
+	depositUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			depositAllColumns,
+			depositColumnsWithDefault,
+			depositColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			depositAllColumns,
+			depositPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert deposits, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(depositAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(depositPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert deposits, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(depositPrimaryKeyColumns))
+			copy(conflict, depositPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"deposits\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(depositType, depositMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(depositType, depositMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert deposits")
+	}
+
+	if !cached {
+		depositUpsertCacheMut.Lock()
+		depositUpsertCache[key] = cache
+		depositUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single Deposit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Deposit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Deposit provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), depositPrimaryKeyMapping)
+	sql := "DELETE FROM \"deposits\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from deposits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for deposits")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q depositQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no depositQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from deposits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for deposits")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DepositSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), depositPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"deposits\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, depositPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from deposit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for deposits")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Deposit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDeposit(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DepositSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DepositSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), depositPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"deposits\".* FROM \"deposits\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, depositPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DepositSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DepositExists checks if the Deposit row exists.
+func DepositExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"deposits\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if deposits exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Deposit row exists.
+func (o *Deposit) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DepositExists(ctx, exec, o.ID)
+}
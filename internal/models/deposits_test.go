@@ -0,0 +1,971 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDeposits(t *testing.T) {
+	t.Parallel()
+
+	query := Deposits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDepositsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDepositsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Deposits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDepositsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DepositSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDepositsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DepositExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Deposit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DepositExists to return true, but got false.")
+	}
+}
+
+func testDepositsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	depositFound, err := FindDeposit(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if depositFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDepositsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Deposits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDepositsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Deposits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDepositsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	depositOne := &Deposit{}
+	depositTwo := &Deposit{}
+	if err = randomize.Struct(seed, depositOne, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, depositTwo, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = depositOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = depositTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Deposits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDepositsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	depositOne := &Deposit{}
+	depositTwo := &Deposit{}
+	if err = randomize.Struct(seed, depositOne, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, depositTwo, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = depositOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = depositTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testDepositsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDepositsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDepositToOneAssetUsingAsset(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Deposit
+	var foreign Asset
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, assetDBTypes, false, assetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Asset struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AssetID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Asset().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := DepositSlice{&local}
+	if err = local.L.LoadAsset(ctx, tx, false, (*[]*Deposit)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Asset == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Asset = nil
+	if err = local.L.LoadAsset(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Asset == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testDepositToOneChainUsingChain(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Deposit
+	var foreign Chain
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, chainDBTypes, false, chainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chain struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ChainID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Chain().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := DepositSlice{&local}
+	if err = local.L.LoadChain(ctx, tx, false, (*[]*Deposit)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Chain == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Chain = nil
+	if err = local.L.LoadChain(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Chain == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testDepositToOneWalletUsingWallet(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Deposit
+	var foreign Wallet
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, walletDBTypes, false, walletColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Wallet struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.WalletID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Wallet().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := DepositSlice{&local}
+	if err = local.L.LoadWallet(ctx, tx, false, (*[]*Deposit)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Wallet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Wallet = nil
+	if err = local.L.LoadWallet(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Wallet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testDepositToOneSetOpAssetUsingAsset(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Deposit
+	var b, c Asset
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, assetDBTypes, false, strmangle.SetComplement(assetPrimaryKeyColumns, assetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, assetDBTypes, false, strmangle.SetComplement(assetPrimaryKeyColumns, assetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Asset{&b, &c} {
+		err = a.SetAsset(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Asset != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Deposits[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AssetID, x.ID) {
+			t.Error("foreign key was wrong value", a.AssetID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AssetID))
+		reflect.Indirect(reflect.ValueOf(&a.AssetID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AssetID, x.ID) {
+			t.Error("foreign key was wrong value", a.AssetID, x.ID)
+		}
+	}
+}
+
+func testDepositToOneRemoveOpAssetUsingAsset(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Deposit
+	var b Asset
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, assetDBTypes, false, strmangle.SetComplement(assetPrimaryKeyColumns, assetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAsset(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAsset(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Asset().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Asset != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AssetID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Deposits) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testDepositToOneSetOpChainUsingChain(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Deposit
+	var b, c Chain
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, chainDBTypes, false, strmangle.SetComplement(chainPrimaryKeyColumns, chainColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, chainDBTypes, false, strmangle.SetComplement(chainPrimaryKeyColumns, chainColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Chain{&b, &c} {
+		err = a.SetChain(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Chain != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Deposits[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ChainID != x.ID {
+			t.Error("foreign key was wrong value", a.ChainID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ChainID))
+		reflect.Indirect(reflect.ValueOf(&a.ChainID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ChainID != x.ID {
+			t.Error("foreign key was wrong value", a.ChainID, x.ID)
+		}
+	}
+}
+func testDepositToOneSetOpWalletUsingWallet(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Deposit
+	var b, c Wallet
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, walletDBTypes, false, strmangle.SetComplement(walletPrimaryKeyColumns, walletColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, walletDBTypes, false, strmangle.SetComplement(walletPrimaryKeyColumns, walletColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Wallet{&b, &c} {
+		err = a.SetWallet(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Wallet != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Deposits[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.WalletID != x.ID {
+			t.Error("foreign key was wrong value", a.WalletID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.WalletID))
+		reflect.Indirect(reflect.ValueOf(&a.WalletID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.WalletID != x.ID {
+			t.Error("foreign key was wrong value", a.WalletID, x.ID)
+		}
+	}
+}
+
+func testDepositsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDepositsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DepositSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDepositsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Deposits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	depositDBTypes = map[string]string{`ID`: `uuid`, `WalletID`: `uuid`, `ChainID`: `character varying`, `AssetID`: `uuid`, `TXHash`: `character varying`, `LogIndex`: `integer`, `FromAddress`: `character varying`, `Amount`: `character varying`, `BlockNumber`: `bigint`, `BlockHash`: `character varying`, `Confirmations`: `integer`, `Status`: `character varying`, `ConfirmedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_              = bytes.MinRead
+)
+
+func testDepositsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(depositPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(depositAllColumns) == len(depositPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDepositsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(depositAllColumns) == len(depositPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Deposit{}
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, depositDBTypes, true, depositPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(depositAllColumns, depositPrimaryKeyColumns) {
+		fields = depositAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			depositAllColumns,
+			depositPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DepositSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDepositsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(depositAllColumns) == len(depositPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Deposit{}
+	if err = randomize.Struct(seed, &o, depositDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Deposit: %s", err)
+	}
+
+	count, err := Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, depositDBTypes, false, depositPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Deposit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Deposit: %s", err)
+	}
+
+	count, err = Deposits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
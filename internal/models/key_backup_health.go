@@ -0,0 +1,1157 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// KeyBackupHealth is an object representing the database table.
+type KeyBackupHealth struct {
+	KeyID          string      `boil:"key_id" json:"key_id" toml:"key_id" yaml:"key_id"`
+	OrganizationID string      `boil:"organization_id" json:"organization_id" toml:"organization_id" yaml:"organization_id"`
+	VaultID        string      `boil:"vault_id" json:"vault_id" toml:"vault_id" yaml:"vault_id"`
+	AtRisk         bool        `boil:"at_risk" json:"at_risk" toml:"at_risk" yaml:"at_risk"`
+	Nodes          types.JSON  `boil:"nodes" json:"nodes" toml:"nodes" yaml:"nodes"`
+	Error          null.String `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	CheckedAt      time.Time   `boil:"checked_at" json:"checked_at" toml:"checked_at" yaml:"checked_at"`
+	DegradedAt     null.Time   `boil:"degraded_at" json:"degraded_at,omitempty" toml:"degraded_at" yaml:"degraded_at,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *keyBackupHealthR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L keyBackupHealthL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var KeyBackupHealthColumns = struct {
+	KeyID          string
+	OrganizationID string
+	VaultID        string
+	AtRisk         string
+	Nodes          string
+	Error          string
+	CheckedAt      string
+	DegradedAt     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	KeyID:          "key_id",
+	OrganizationID: "organization_id",
+	VaultID:        "vault_id",
+	AtRisk:         "at_risk",
+	Nodes:          "nodes",
+	Error:          "error",
+	CheckedAt:      "checked_at",
+	DegradedAt:     "degraded_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var KeyBackupHealthTableColumns = struct {
+	KeyID          string
+	OrganizationID string
+	VaultID        string
+	AtRisk         string
+	Nodes          string
+	Error          string
+	CheckedAt      string
+	DegradedAt     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	KeyID:          "key_backup_health.key_id",
+	OrganizationID: "key_backup_health.organization_id",
+	VaultID:        "key_backup_health.vault_id",
+	AtRisk:         "key_backup_health.at_risk",
+	Nodes:          "key_backup_health.nodes",
+	Error:          "key_backup_health.error",
+	CheckedAt:      "key_backup_health.checked_at",
+	DegradedAt:     "key_backup_health.degraded_at",
+	CreatedAt:      "key_backup_health.created_at",
+	UpdatedAt:      "key_backup_health.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var KeyBackupHealthWhere = struct {
+	KeyID          whereHelperstring
+	OrganizationID whereHelperstring
+	VaultID        whereHelperstring
+	AtRisk         whereHelperbool
+	Nodes          whereHelpertypes_JSON
+	Error          whereHelpernull_String
+	CheckedAt      whereHelpertime_Time
+	DegradedAt     whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	KeyID:          whereHelperstring{field: "\"key_backup_health\".\"key_id\""},
+	OrganizationID: whereHelperstring{field: "\"key_backup_health\".\"organization_id\""},
+	VaultID:        whereHelperstring{field: "\"key_backup_health\".\"vault_id\""},
+	AtRisk:         whereHelperbool{field: "\"key_backup_health\".\"at_risk\""},
+	Nodes:          whereHelpertypes_JSON{field: "\"key_backup_health\".\"nodes\""},
+	Error:          whereHelpernull_String{field: "\"key_backup_health\".\"error\""},
+	CheckedAt:      whereHelpertime_Time{field: "\"key_backup_health\".\"checked_at\""},
+	DegradedAt:     whereHelpernull_Time{field: "\"key_backup_health\".\"degraded_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"key_backup_health\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"key_backup_health\".\"updated_at\""},
+}
+
+// KeyBackupHealthRels is where relationship names are stored.
+var KeyBackupHealthRels = struct {
+	Organization string
+	Vault        string
+}{
+	Organization: "Organization",
+	Vault:        "Vault",
+}
+
+// keyBackupHealthR is where relationships are stored.
+type keyBackupHealthR struct {
+	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	Vault        *Vault        `boil:"Vault" json:"Vault" toml:"Vault" yaml:"Vault"`
+}
+
+// NewStruct creates a new relationship struct
+func (*keyBackupHealthR) NewStruct() *keyBackupHealthR {
+	return &keyBackupHealthR{}
+}
+
+func (o *KeyBackupHealth) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganization()
+}
+
+func (r *keyBackupHealthR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.Organization
+}
+
+func (o *KeyBackupHealth) GetVault() *Vault {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVault()
+}
+
+func (r *keyBackupHealthR) GetVault() *Vault {
+	if r == nil {
+		return nil
+	}
+
+	return r.Vault
+}
+
+// keyBackupHealthL is where Load methods for each relationship are stored.
+type keyBackupHealthL struct{}
+
+var (
+	keyBackupHealthAllColumns            = []string{"key_id", "organization_id", "vault_id", "at_risk", "nodes", "error", "checked_at", "degraded_at", "created_at", "updated_at"}
+	keyBackupHealthColumnsWithoutDefault = []string{"key_id", "organization_id", "vault_id", "at_risk", "checked_at"}
+	keyBackupHealthColumnsWithDefault    = []string{"nodes", "error", "degraded_at", "created_at", "updated_at"}
+	keyBackupHealthPrimaryKeyColumns     = []string{"key_id"}
+	keyBackupHealthGeneratedColumns      = []string{}
+)
+
+type (
+	// KeyBackupHealthSlice is an alias for a slice of pointers to KeyBackupHealth.
+	// This should almost always be used instead of []KeyBackupHealth.
+	KeyBackupHealthSlice []*KeyBackupHealth
+
+	keyBackupHealthQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	keyBackupHealthType                 = reflect.TypeOf(&KeyBackupHealth{})
+	keyBackupHealthMapping              = queries.MakeStructMapping(keyBackupHealthType)
+	keyBackupHealthPrimaryKeyMapping, _ = queries.BindMapping(keyBackupHealthType, keyBackupHealthMapping, keyBackupHealthPrimaryKeyColumns)
+	keyBackupHealthInsertCacheMut       sync.RWMutex
+	keyBackupHealthInsertCache          = make(map[string]insertCache)
+	keyBackupHealthUpdateCacheMut       sync.RWMutex
+	keyBackupHealthUpdateCache          = make(map[string]updateCache)
+	keyBackupHealthUpsertCacheMut       sync.RWMutex
+	keyBackupHealthUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single keyBackupHealth record from the query.
+func (q keyBackupHealthQuery) One(ctx context.Context, exec boil.ContextExecutor) (*KeyBackupHealth, error) {
+	o := &KeyBackupHealth{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for key_backup_health")
+	}
+
+	return o, nil
+}
+
+// All returns all KeyBackupHealth records from the query.
+func (q keyBackupHealthQuery) All(ctx context.Context, exec boil.ContextExecutor) (KeyBackupHealthSlice, error) {
+	var o []*KeyBackupHealth
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to KeyBackupHealth slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all KeyBackupHealth records in the query.
+func (q keyBackupHealthQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count key_backup_health rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q keyBackupHealthQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if key_backup_health exists")
+	}
+
+	return count > 0, nil
+}
+
+// Organization pointed to by the foreign key.
+func (o *KeyBackupHealth) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// Vault pointed to by the foreign key.
+func (o *KeyBackupHealth) Vault(mods ...qm.QueryMod) vaultQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VaultID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Vaults(queryMods...)
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyBackupHealthL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyBackupHealth interface{}, mods queries.Applicator) error {
+	var slice []*KeyBackupHealth
+	var object *KeyBackupHealth
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyBackupHealth.(*KeyBackupHealth)
+		if !ok {
+			object = new(KeyBackupHealth)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyBackupHealth)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyBackupHealth))
+			}
+		}
+	} else {
+		s, ok := maybeKeyBackupHealth.(*[]*KeyBackupHealth)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyBackupHealth)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyBackupHealth))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyBackupHealthR{}
+		}
+		args[object.OrganizationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyBackupHealthR{}
+			}
+
+			args[obj.OrganizationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.KeyBackupHealths = append(foreign.R.KeyBackupHealths, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrganizationID == foreign.ID {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.KeyBackupHealths = append(foreign.R.KeyBackupHealths, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVault allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyBackupHealthL) LoadVault(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyBackupHealth interface{}, mods queries.Applicator) error {
+	var slice []*KeyBackupHealth
+	var object *KeyBackupHealth
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyBackupHealth.(*KeyBackupHealth)
+		if !ok {
+			object = new(KeyBackupHealth)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyBackupHealth)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyBackupHealth))
+			}
+		}
+	} else {
+		s, ok := maybeKeyBackupHealth.(*[]*KeyBackupHealth)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyBackupHealth)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyBackupHealth))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyBackupHealthR{}
+		}
+		args[object.VaultID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyBackupHealthR{}
+			}
+
+			args[obj.VaultID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vaults`),
+		qm.WhereIn(`vaults.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Vault")
+	}
+
+	var resultSlice []*Vault
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Vault")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vaults")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vaults")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Vault = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultR{}
+		}
+		foreign.R.KeyBackupHealths = append(foreign.R.KeyBackupHealths, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VaultID == foreign.ID {
+				local.R.Vault = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultR{}
+				}
+				foreign.R.KeyBackupHealths = append(foreign.R.KeyBackupHealths, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrganization of the keyBackupHealth to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.KeyBackupHealths.
+func (o *KeyBackupHealth) SetOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_backup_health\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyBackupHealthPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.KeyID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrganizationID = related.ID
+	if o.R == nil {
+		o.R = &keyBackupHealthR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			KeyBackupHealths: KeyBackupHealthSlice{o},
+		}
+	} else {
+		related.R.KeyBackupHealths = append(related.R.KeyBackupHealths, o)
+	}
+
+	return nil
+}
+
+// SetVault of the keyBackupHealth to the related item.
+// Sets o.R.Vault to related.
+// Adds o to related.R.KeyBackupHealths.
+func (o *KeyBackupHealth) SetVault(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Vault) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_backup_health\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyBackupHealthPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.KeyID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VaultID = related.ID
+	if o.R == nil {
+		o.R = &keyBackupHealthR{
+			Vault: related,
+		}
+	} else {
+		o.R.Vault = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultR{
+			KeyBackupHealths: KeyBackupHealthSlice{o},
+		}
+	} else {
+		related.R.KeyBackupHealths = append(related.R.KeyBackupHealths, o)
+	}
+
+	return nil
+}
+
+// KeyBackupHealths retrieves all the records using an executor.
+func KeyBackupHealths(mods ...qm.QueryMod) keyBackupHealthQuery {
+	mods = append(mods, qm.From("\"key_backup_health\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"key_backup_health\".*"})
+	}
+
+	return keyBackupHealthQuery{q}
+}
+
+// FindKeyBackupHealth retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindKeyBackupHealth(ctx context.Context, exec boil.ContextExecutor, keyID string, selectCols ...string) (*KeyBackupHealth, error) {
+	keyBackupHealthObj := &KeyBackupHealth{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"key_backup_health\" where \"key_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, keyID)
+
+	err := q.Bind(ctx, exec, keyBackupHealthObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from key_backup_health")
+	}
+
+	return keyBackupHealthObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *KeyBackupHealth) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no key_backup_health provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyBackupHealthColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	keyBackupHealthInsertCacheMut.RLock()
+	cache, cached := keyBackupHealthInsertCache[key]
+	keyBackupHealthInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			keyBackupHealthAllColumns,
+			keyBackupHealthColumnsWithDefault,
+			keyBackupHealthColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(keyBackupHealthType, keyBackupHealthMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(keyBackupHealthType, keyBackupHealthMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"key_backup_health\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"key_backup_health\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into key_backup_health")
+	}
+
+	if !cached {
+		keyBackupHealthInsertCacheMut.Lock()
+		keyBackupHealthInsertCache[key] = cache
+		keyBackupHealthInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the KeyBackupHealth.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *KeyBackupHealth) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	keyBackupHealthUpdateCacheMut.RLock()
+	cache, cached := keyBackupHealthUpdateCache[key]
+	keyBackupHealthUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			keyBackupHealthAllColumns,
+			keyBackupHealthPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update key_backup_health, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"key_backup_health\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, keyBackupHealthPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(keyBackupHealthType, keyBackupHealthMapping, append(wl, keyBackupHealthPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update key_backup_health row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for key_backup_health")
+	}
+
+	if !cached {
+		keyBackupHealthUpdateCacheMut.Lock()
+		keyBackupHealthUpdateCache[key] = cache
+		keyBackupHealthUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q keyBackupHealthQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for key_backup_health")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for key_backup_health")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o KeyBackupHealthSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyBackupHealthPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"key_backup_health\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, keyBackupHealthPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in keyBackupHealth slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all keyBackupHealth")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *KeyBackupHealth) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no key_backup_health provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyBackupHealthColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	keyBackupHealthUpsertCacheMut.RLock()
+	cache, cached := keyBackupHealthUpsertCache[key]
+	keyBackupHealthUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			keyBackupHealthAllColumns,
+			keyBackupHealthColumnsWithDefault,
+			keyBackupHealthColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			keyBackupHealthAllColumns,
+			keyBackupHealthPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert key_backup_health, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(keyBackupHealthAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(keyBackupHealthPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert key_backup_health, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(keyBackupHealthPrimaryKeyColumns))
+			copy(conflict, keyBackupHealthPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"key_backup_health\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(keyBackupHealthType, keyBackupHealthMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(keyBackupHealthType, keyBackupHealthMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert key_backup_health")
+	}
+
+	if !cached {
+		keyBackupHealthUpsertCacheMut.Lock()
+		keyBackupHealthUpsertCache[key] = cache
+		keyBackupHealthUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single KeyBackupHealth record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *KeyBackupHealth) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no KeyBackupHealth provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), keyBackupHealthPrimaryKeyMapping)
+	sql := "DELETE FROM \"key_backup_health\" WHERE \"key_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from key_backup_health")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for key_backup_health")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q keyBackupHealthQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no keyBackupHealthQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from key_backup_health")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_backup_health")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o KeyBackupHealthSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyBackupHealthPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"key_backup_health\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyBackupHealthPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from keyBackupHealth slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_backup_health")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *KeyBackupHealth) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindKeyBackupHealth(ctx, exec, o.KeyID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *KeyBackupHealthSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := KeyBackupHealthSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyBackupHealthPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"key_backup_health\".* FROM \"key_backup_health\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyBackupHealthPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in KeyBackupHealthSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// KeyBackupHealthExists checks if the KeyBackupHealth row exists.
+func KeyBackupHealthExists(ctx context.Context, exec boil.ContextExecutor, keyID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"key_backup_health\" where \"key_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, keyID)
+	}
+	row := exec.QueryRowContext(ctx, sql, keyID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if key_backup_health exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the KeyBackupHealth row exists.
+func (o *KeyBackupHealth) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return KeyBackupHealthExists(ctx, exec, o.KeyID)
+}
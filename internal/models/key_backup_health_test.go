@@ -0,0 +1,810 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testKeyBackupHealths(t *testing.T) {
+	t.Parallel()
+
+	query := KeyBackupHealths()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testKeyBackupHealthsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyBackupHealthsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := KeyBackupHealths().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyBackupHealthsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyBackupHealthSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyBackupHealthsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := KeyBackupHealthExists(ctx, tx, o.KeyID)
+	if err != nil {
+		t.Errorf("Unable to check if KeyBackupHealth exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected KeyBackupHealthExists to return true, but got false.")
+	}
+}
+
+func testKeyBackupHealthsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	keyBackupHealthFound, err := FindKeyBackupHealth(ctx, tx, o.KeyID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if keyBackupHealthFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testKeyBackupHealthsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = KeyBackupHealths().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyBackupHealthsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := KeyBackupHealths().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testKeyBackupHealthsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	keyBackupHealthOne := &KeyBackupHealth{}
+	keyBackupHealthTwo := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, keyBackupHealthOne, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyBackupHealthTwo, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyBackupHealthOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyBackupHealthTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyBackupHealths().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testKeyBackupHealthsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	keyBackupHealthOne := &KeyBackupHealth{}
+	keyBackupHealthTwo := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, keyBackupHealthOne, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyBackupHealthTwo, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyBackupHealthOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyBackupHealthTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testKeyBackupHealthsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyBackupHealthsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(keyBackupHealthPrimaryKeyColumns, keyBackupHealthColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyBackupHealthToOneOrganizationUsingOrganization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyBackupHealth
+	var foreign Organization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OrganizationID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Organization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyBackupHealthSlice{&local}
+	if err = local.L.LoadOrganization(ctx, tx, false, (*[]*KeyBackupHealth)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Organization = nil
+	if err = local.L.LoadOrganization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyBackupHealthToOneVaultUsingVault(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyBackupHealth
+	var foreign Vault
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VaultID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Vault().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyBackupHealthSlice{&local}
+	if err = local.L.LoadVault(ctx, tx, false, (*[]*KeyBackupHealth)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Vault = nil
+	if err = local.L.LoadVault(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyBackupHealthToOneSetOpOrganizationUsingOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyBackupHealth
+	var b, c Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyBackupHealthDBTypes, false, strmangle.SetComplement(keyBackupHealthPrimaryKeyColumns, keyBackupHealthColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Organization{&b, &c} {
+		err = a.SetOrganization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Organization != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.KeyBackupHealths[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OrganizationID != x.ID {
+			t.Error("foreign key was wrong value", a.OrganizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrganizationID))
+		reflect.Indirect(reflect.ValueOf(&a.OrganizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.OrganizationID != x.ID {
+			t.Error("foreign key was wrong value", a.OrganizationID, x.ID)
+		}
+	}
+}
+func testKeyBackupHealthToOneSetOpVaultUsingVault(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyBackupHealth
+	var b, c Vault
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyBackupHealthDBTypes, false, strmangle.SetComplement(keyBackupHealthPrimaryKeyColumns, keyBackupHealthColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Vault{&b, &c} {
+		err = a.SetVault(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Vault != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.KeyBackupHealths[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VaultID))
+		reflect.Indirect(reflect.ValueOf(&a.VaultID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID, x.ID)
+		}
+	}
+}
+
+func testKeyBackupHealthsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyBackupHealthsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyBackupHealthSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyBackupHealthsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyBackupHealths().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	keyBackupHealthDBTypes = map[string]string{`KeyID`: `character varying`, `OrganizationID`: `uuid`, `VaultID`: `uuid`, `AtRisk`: `boolean`, `Nodes`: `jsonb`, `Error`: `text`, `CheckedAt`: `timestamp with time zone`, `DegradedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testKeyBackupHealthsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(keyBackupHealthPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(keyBackupHealthAllColumns) == len(keyBackupHealthPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testKeyBackupHealthsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(keyBackupHealthAllColumns) == len(keyBackupHealthPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyBackupHealth{}
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyBackupHealthDBTypes, true, keyBackupHealthPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(keyBackupHealthAllColumns, keyBackupHealthPrimaryKeyColumns) {
+		fields = keyBackupHealthAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			keyBackupHealthAllColumns,
+			keyBackupHealthPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := KeyBackupHealthSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testKeyBackupHealthsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(keyBackupHealthAllColumns) == len(keyBackupHealthPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := KeyBackupHealth{}
+	if err = randomize.Struct(seed, &o, keyBackupHealthDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyBackupHealth: %s", err)
+	}
+
+	count, err := KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, keyBackupHealthDBTypes, false, keyBackupHealthPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyBackupHealth struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyBackupHealth: %s", err)
+	}
+
+	count, err = KeyBackupHealths().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1186 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// KeyRefresh is an object representing the database table.
+type KeyRefresh struct {
+	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	VaultID       string      `boil:"vault_id" json:"vault_id" toml:"vault_id" yaml:"vault_id"`
+	KeyID         string      `boil:"key_id" json:"key_id" toml:"key_id" yaml:"key_id"`
+	ProposalID    null.String `boil:"proposal_id" json:"proposal_id,omitempty" toml:"proposal_id" yaml:"proposal_id,omitempty"`
+	Trigger       string      `boil:"trigger" json:"trigger" toml:"trigger" yaml:"trigger"`
+	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Epoch         int         `boil:"epoch" json:"epoch" toml:"epoch" yaml:"epoch"`
+	FailureReason null.String `boil:"failure_reason" json:"failure_reason,omitempty" toml:"failure_reason" yaml:"failure_reason,omitempty"`
+	StartedAt     null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	CompletedAt   null.Time   `boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *keyRefreshR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L keyRefreshL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var KeyRefreshColumns = struct {
+	ID            string
+	VaultID       string
+	KeyID         string
+	ProposalID    string
+	Trigger       string
+	Status        string
+	Epoch         string
+	FailureReason string
+	StartedAt     string
+	CompletedAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	VaultID:       "vault_id",
+	KeyID:         "key_id",
+	ProposalID:    "proposal_id",
+	Trigger:       "trigger",
+	Status:        "status",
+	Epoch:         "epoch",
+	FailureReason: "failure_reason",
+	StartedAt:     "started_at",
+	CompletedAt:   "completed_at",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var KeyRefreshTableColumns = struct {
+	ID            string
+	VaultID       string
+	KeyID         string
+	ProposalID    string
+	Trigger       string
+	Status        string
+	Epoch         string
+	FailureReason string
+	StartedAt     string
+	CompletedAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "key_refreshes.id",
+	VaultID:       "key_refreshes.vault_id",
+	KeyID:         "key_refreshes.key_id",
+	ProposalID:    "key_refreshes.proposal_id",
+	Trigger:       "key_refreshes.trigger",
+	Status:        "key_refreshes.status",
+	Epoch:         "key_refreshes.epoch",
+	FailureReason: "key_refreshes.failure_reason",
+	StartedAt:     "key_refreshes.started_at",
+	CompletedAt:   "key_refreshes.completed_at",
+	CreatedAt:     "key_refreshes.created_at",
+	UpdatedAt:     "key_refreshes.updated_at",
+}
+
+// Generated where
+
+var KeyRefreshWhere = struct {
+	ID            whereHelperstring
+	VaultID       whereHelperstring
+	KeyID         whereHelperstring
+	ProposalID    whereHelpernull_String
+	Trigger       whereHelperstring
+	Status        whereHelperstring
+	Epoch         whereHelperint
+	FailureReason whereHelpernull_String
+	StartedAt     whereHelpernull_Time
+	CompletedAt   whereHelpernull_Time
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"key_refreshes\".\"id\""},
+	VaultID:       whereHelperstring{field: "\"key_refreshes\".\"vault_id\""},
+	KeyID:         whereHelperstring{field: "\"key_refreshes\".\"key_id\""},
+	ProposalID:    whereHelpernull_String{field: "\"key_refreshes\".\"proposal_id\""},
+	Trigger:       whereHelperstring{field: "\"key_refreshes\".\"trigger\""},
+	Status:        whereHelperstring{field: "\"key_refreshes\".\"status\""},
+	Epoch:         whereHelperint{field: "\"key_refreshes\".\"epoch\""},
+	FailureReason: whereHelpernull_String{field: "\"key_refreshes\".\"failure_reason\""},
+	StartedAt:     whereHelpernull_Time{field: "\"key_refreshes\".\"started_at\""},
+	CompletedAt:   whereHelpernull_Time{field: "\"key_refreshes\".\"completed_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"key_refreshes\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"key_refreshes\".\"updated_at\""},
+}
+
+// KeyRefreshRels is where relationship names are stored.
+var KeyRefreshRels = struct {
+	Proposal string
+	Vault    string
+}{
+	Proposal: "Proposal",
+	Vault:    "Vault",
+}
+
+// keyRefreshR is where relationships are stored.
+type keyRefreshR struct {
+	Proposal *VaultProposal `boil:"Proposal" json:"Proposal" toml:"Proposal" yaml:"Proposal"`
+	Vault    *Vault         `boil:"Vault" json:"Vault" toml:"Vault" yaml:"Vault"`
+}
+
+// NewStruct creates a new relationship struct
+func (*keyRefreshR) NewStruct() *keyRefreshR {
+	return &keyRefreshR{}
+}
+
+func (o *KeyRefresh) GetProposal() *VaultProposal {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetProposal()
+}
+
+func (r *keyRefreshR) GetProposal() *VaultProposal {
+	if r == nil {
+		return nil
+	}
+
+	return r.Proposal
+}
+
+func (o *KeyRefresh) GetVault() *Vault {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVault()
+}
+
+func (r *keyRefreshR) GetVault() *Vault {
+	if r == nil {
+		return nil
+	}
+
+	return r.Vault
+}
+
+// keyRefreshL is where Load methods for each relationship are stored.
+type keyRefreshL struct{}
+
+var (
+	keyRefreshAllColumns            = []string{"id", "vault_id", "key_id", "proposal_id", "trigger", "status", "epoch", "failure_reason", "started_at", "completed_at", "created_at", "updated_at"}
+	keyRefreshColumnsWithoutDefault = []string{"vault_id", "key_id", "trigger", "status", "epoch"}
+	keyRefreshColumnsWithDefault    = []string{"id", "proposal_id", "failure_reason", "started_at", "completed_at", "created_at", "updated_at"}
+	keyRefreshPrimaryKeyColumns     = []string{"id"}
+	keyRefreshGeneratedColumns      = []string{}
+)
+
+type (
+	// KeyRefreshSlice is an alias for a slice of pointers to KeyRefresh.
+	// This should almost always be used instead of []KeyRefresh.
+	KeyRefreshSlice []*KeyRefresh
+
+	keyRefreshQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	keyRefreshType                 = reflect.TypeOf(&KeyRefresh{})
+	keyRefreshMapping              = queries.MakeStructMapping(keyRefreshType)
+	keyRefreshPrimaryKeyMapping, _ = queries.BindMapping(keyRefreshType, keyRefreshMapping, keyRefreshPrimaryKeyColumns)
+	keyRefreshInsertCacheMut       sync.RWMutex
+	keyRefreshInsertCache          = make(map[string]insertCache)
+	keyRefreshUpdateCacheMut       sync.RWMutex
+	keyRefreshUpdateCache          = make(map[string]updateCache)
+	keyRefreshUpsertCacheMut       sync.RWMutex
+	keyRefreshUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single keyRefresh record from the query.
+func (q keyRefreshQuery) One(ctx context.Context, exec boil.ContextExecutor) (*KeyRefresh, error) {
+	o := &KeyRefresh{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for key_refreshes")
+	}
+
+	return o, nil
+}
+
+// All returns all KeyRefresh records from the query.
+func (q keyRefreshQuery) All(ctx context.Context, exec boil.ContextExecutor) (KeyRefreshSlice, error) {
+	var o []*KeyRefresh
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to KeyRefresh slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all KeyRefresh records in the query.
+func (q keyRefreshQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count key_refreshes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q keyRefreshQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if key_refreshes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Proposal pointed to by the foreign key.
+func (o *KeyRefresh) Proposal(mods ...qm.QueryMod) vaultProposalQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProposalID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return VaultProposals(queryMods...)
+}
+
+// Vault pointed to by the foreign key.
+func (o *KeyRefresh) Vault(mods ...qm.QueryMod) vaultQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VaultID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Vaults(queryMods...)
+}
+
+// LoadProposal allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyRefreshL) LoadProposal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyRefresh interface{}, mods queries.Applicator) error {
+	var slice []*KeyRefresh
+	var object *KeyRefresh
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyRefresh.(*KeyRefresh)
+		if !ok {
+			object = new(KeyRefresh)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyRefresh)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyRefresh))
+			}
+		}
+	} else {
+		s, ok := maybeKeyRefresh.(*[]*KeyRefresh)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyRefresh)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyRefresh))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyRefreshR{}
+		}
+		if !queries.IsNil(object.ProposalID) {
+			args[object.ProposalID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyRefreshR{}
+			}
+
+			if !queries.IsNil(obj.ProposalID) {
+				args[obj.ProposalID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vault_proposals`),
+		qm.WhereIn(`vault_proposals.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load VaultProposal")
+	}
+
+	var resultSlice []*VaultProposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice VaultProposal")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vault_proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposals")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Proposal = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultProposalR{}
+		}
+		foreign.R.ProposalKeyRefreshes = append(foreign.R.ProposalKeyRefreshes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ProposalID, foreign.ID) {
+				local.R.Proposal = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultProposalR{}
+				}
+				foreign.R.ProposalKeyRefreshes = append(foreign.R.ProposalKeyRefreshes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVault allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyRefreshL) LoadVault(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyRefresh interface{}, mods queries.Applicator) error {
+	var slice []*KeyRefresh
+	var object *KeyRefresh
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyRefresh.(*KeyRefresh)
+		if !ok {
+			object = new(KeyRefresh)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyRefresh)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyRefresh))
+			}
+		}
+	} else {
+		s, ok := maybeKeyRefresh.(*[]*KeyRefresh)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyRefresh)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyRefresh))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyRefreshR{}
+		}
+		args[object.VaultID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyRefreshR{}
+			}
+
+			args[obj.VaultID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vaults`),
+		qm.WhereIn(`vaults.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Vault")
+	}
+
+	var resultSlice []*Vault
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Vault")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vaults")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vaults")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Vault = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultR{}
+		}
+		foreign.R.KeyRefreshes = append(foreign.R.KeyRefreshes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VaultID == foreign.ID {
+				local.R.Vault = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultR{}
+				}
+				foreign.R.KeyRefreshes = append(foreign.R.KeyRefreshes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProposal of the keyRefresh to the related item.
+// Sets o.R.Proposal to related.
+// Adds o to related.R.ProposalKeyRefreshes.
+func (o *KeyRefresh) SetProposal(ctx context.Context, exec boil.ContextExecutor, insert bool, related *VaultProposal) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_refreshes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"proposal_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyRefreshPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ProposalID, related.ID)
+	if o.R == nil {
+		o.R = &keyRefreshR{
+			Proposal: related,
+		}
+	} else {
+		o.R.Proposal = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultProposalR{
+			ProposalKeyRefreshes: KeyRefreshSlice{o},
+		}
+	} else {
+		related.R.ProposalKeyRefreshes = append(related.R.ProposalKeyRefreshes, o)
+	}
+
+	return nil
+}
+
+// RemoveProposal relationship.
+// Sets o.R.Proposal to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *KeyRefresh) RemoveProposal(ctx context.Context, exec boil.ContextExecutor, related *VaultProposal) error {
+	var err error
+
+	queries.SetScanner(&o.ProposalID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("proposal_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Proposal = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ProposalKeyRefreshes {
+		if queries.Equal(o.ProposalID, ri.ProposalID) {
+			continue
+		}
+
+		ln := len(related.R.ProposalKeyRefreshes)
+		if ln > 1 && i < ln-1 {
+			related.R.ProposalKeyRefreshes[i] = related.R.ProposalKeyRefreshes[ln-1]
+		}
+		related.R.ProposalKeyRefreshes = related.R.ProposalKeyRefreshes[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetVault of the keyRefresh to the related item.
+// Sets o.R.Vault to related.
+// Adds o to related.R.KeyRefreshes.
+func (o *KeyRefresh) SetVault(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Vault) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_refreshes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyRefreshPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VaultID = related.ID
+	if o.R == nil {
+		o.R = &keyRefreshR{
+			Vault: related,
+		}
+	} else {
+		o.R.Vault = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultR{
+			KeyRefreshes: KeyRefreshSlice{o},
+		}
+	} else {
+		related.R.KeyRefreshes = append(related.R.KeyRefreshes, o)
+	}
+
+	return nil
+}
+
+// KeyRefreshes retrieves all the records using an executor.
+func KeyRefreshes(mods ...qm.QueryMod) keyRefreshQuery {
+	mods = append(mods, qm.From("\"key_refreshes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"key_refreshes\".*"})
+	}
+
+	return keyRefreshQuery{q}
+}
+
+// FindKeyRefresh retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindKeyRefresh(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*KeyRefresh, error) {
+	keyRefreshObj := &KeyRefresh{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"key_refreshes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, keyRefreshObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from key_refreshes")
+	}
+
+	return keyRefreshObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *KeyRefresh) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no key_refreshes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyRefreshColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	keyRefreshInsertCacheMut.RLock()
+	cache, cached := keyRefreshInsertCache[key]
+	keyRefreshInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			keyRefreshAllColumns,
+			keyRefreshColumnsWithDefault,
+			keyRefreshColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(keyRefreshType, keyRefreshMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(keyRefreshType, keyRefreshMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"key_refreshes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"key_refreshes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into key_refreshes")
+	}
+
+	if !cached {
+		keyRefreshInsertCacheMut.Lock()
+		keyRefreshInsertCache[key] = cache
+		keyRefreshInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the KeyRefresh.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *KeyRefresh) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	keyRefreshUpdateCacheMut.RLock()
+	cache, cached := keyRefreshUpdateCache[key]
+	keyRefreshUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			keyRefreshAllColumns,
+			keyRefreshPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update key_refreshes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"key_refreshes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, keyRefreshPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(keyRefreshType, keyRefreshMapping, append(wl, keyRefreshPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update key_refreshes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for key_refreshes")
+	}
+
+	if !cached {
+		keyRefreshUpdateCacheMut.Lock()
+		keyRefreshUpdateCache[key] = cache
+		keyRefreshUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q keyRefreshQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for key_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for key_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o KeyRefreshSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"key_refreshes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, keyRefreshPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in keyRefresh slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all keyRefresh")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *KeyRefresh) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no key_refreshes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyRefreshColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	keyRefreshUpsertCacheMut.RLock()
+	cache, cached := keyRefreshUpsertCache[key]
+	keyRefreshUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			keyRefreshAllColumns,
+			keyRefreshColumnsWithDefault,
+			keyRefreshColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			keyRefreshAllColumns,
+			keyRefreshPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert key_refreshes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(keyRefreshAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(keyRefreshPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert key_refreshes, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(keyRefreshPrimaryKeyColumns))
+			copy(conflict, keyRefreshPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"key_refreshes\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(keyRefreshType, keyRefreshMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(keyRefreshType, keyRefreshMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert key_refreshes")
+	}
+
+	if !cached {
+		keyRefreshUpsertCacheMut.Lock()
+		keyRefreshUpsertCache[key] = cache
+		keyRefreshUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single KeyRefresh record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *KeyRefresh) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no KeyRefresh provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), keyRefreshPrimaryKeyMapping)
+	sql := "DELETE FROM \"key_refreshes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from key_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for key_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q keyRefreshQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no keyRefreshQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from key_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o KeyRefreshSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"key_refreshes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyRefreshPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from keyRefresh slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *KeyRefresh) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindKeyRefresh(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *KeyRefreshSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := KeyRefreshSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"key_refreshes\".* FROM \"key_refreshes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyRefreshPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in KeyRefreshSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// KeyRefreshExists checks if the KeyRefresh row exists.
+func KeyRefreshExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"key_refreshes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if key_refreshes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the KeyRefresh row exists.
+func (o *KeyRefresh) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return KeyRefreshExists(ctx, exec, o.ID)
+}
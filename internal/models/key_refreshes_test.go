@@ -0,0 +1,862 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testKeyRefreshes(t *testing.T) {
+	t.Parallel()
+
+	query := KeyRefreshes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testKeyRefreshesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyRefreshesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := KeyRefreshes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyRefreshesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyRefreshSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyRefreshesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := KeyRefreshExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if KeyRefresh exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected KeyRefreshExists to return true, but got false.")
+	}
+}
+
+func testKeyRefreshesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	keyRefreshFound, err := FindKeyRefresh(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if keyRefreshFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testKeyRefreshesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = KeyRefreshes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyRefreshesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := KeyRefreshes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testKeyRefreshesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	keyRefreshOne := &KeyRefresh{}
+	keyRefreshTwo := &KeyRefresh{}
+	if err = randomize.Struct(seed, keyRefreshOne, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyRefreshTwo, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyRefreshOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyRefreshTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyRefreshes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testKeyRefreshesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	keyRefreshOne := &KeyRefresh{}
+	keyRefreshTwo := &KeyRefresh{}
+	if err = randomize.Struct(seed, keyRefreshOne, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyRefreshTwo, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyRefreshOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyRefreshTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testKeyRefreshesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyRefreshesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyRefreshToOneVaultProposalUsingProposal(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyRefresh
+	var foreign VaultProposal
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ProposalID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Proposal().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyRefreshSlice{&local}
+	if err = local.L.LoadProposal(ctx, tx, false, (*[]*KeyRefresh)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Proposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Proposal = nil
+	if err = local.L.LoadProposal(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Proposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyRefreshToOneVaultUsingVault(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyRefresh
+	var foreign Vault
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VaultID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Vault().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyRefreshSlice{&local}
+	if err = local.L.LoadVault(ctx, tx, false, (*[]*KeyRefresh)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Vault = nil
+	if err = local.L.LoadVault(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyRefreshToOneSetOpVaultProposalUsingProposal(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyRefresh
+	var b, c VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyRefreshDBTypes, false, strmangle.SetComplement(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*VaultProposal{&b, &c} {
+		err = a.SetProposal(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Proposal != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ProposalKeyRefreshes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ProposalID, x.ID) {
+			t.Error("foreign key was wrong value", a.ProposalID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ProposalID))
+		reflect.Indirect(reflect.ValueOf(&a.ProposalID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ProposalID, x.ID) {
+			t.Error("foreign key was wrong value", a.ProposalID, x.ID)
+		}
+	}
+}
+
+func testKeyRefreshToOneRemoveOpVaultProposalUsingProposal(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyRefresh
+	var b VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyRefreshDBTypes, false, strmangle.SetComplement(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetProposal(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveProposal(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Proposal().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Proposal != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ProposalID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ProposalKeyRefreshes) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testKeyRefreshToOneSetOpVaultUsingVault(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyRefresh
+	var b, c Vault
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyRefreshDBTypes, false, strmangle.SetComplement(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Vault{&b, &c} {
+		err = a.SetVault(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Vault != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.KeyRefreshes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VaultID))
+		reflect.Indirect(reflect.ValueOf(&a.VaultID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID, x.ID)
+		}
+	}
+}
+
+func testKeyRefreshesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyRefreshesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyRefreshSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyRefreshesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyRefreshes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	keyRefreshDBTypes = map[string]string{`ID`: `uuid`, `VaultID`: `uuid`, `KeyID`: `character varying`, `ProposalID`: `uuid`, `Trigger`: `character varying`, `Status`: `character varying`, `Epoch`: `integer`, `FailureReason`: `text`, `StartedAt`: `timestamp with time zone`, `CompletedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testKeyRefreshesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(keyRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(keyRefreshAllColumns) == len(keyRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testKeyRefreshesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(keyRefreshAllColumns) == len(keyRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyRefresh{}
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyRefreshDBTypes, true, keyRefreshPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(keyRefreshAllColumns, keyRefreshPrimaryKeyColumns) {
+		fields = keyRefreshAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			keyRefreshAllColumns,
+			keyRefreshPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := KeyRefreshSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testKeyRefreshesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(keyRefreshAllColumns) == len(keyRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := KeyRefresh{}
+	if err = randomize.Struct(seed, &o, keyRefreshDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyRefresh: %s", err)
+	}
+
+	count, err := KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, keyRefreshDBTypes, false, keyRefreshPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyRefresh struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyRefresh: %s", err)
+	}
+
+	count, err = KeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1158 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// KeyShareBackup is an object representing the database table.
+type KeyShareBackup struct {
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrganizationID  string      `boil:"organization_id" json:"organization_id" toml:"organization_id" yaml:"organization_id"`
+	UserID          string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	KeyID           string      `boil:"key_id" json:"key_id" toml:"key_id" yaml:"key_id"`
+	ShareIndex      int         `boil:"share_index" json:"share_index" toml:"share_index" yaml:"share_index"`
+	Status          string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	DevicePublicKey []byte      `boil:"device_public_key" json:"device_public_key" toml:"device_public_key" yaml:"device_public_key"`
+	DeliveredAt     time.Time   `boil:"delivered_at" json:"delivered_at" toml:"delivered_at" yaml:"delivered_at"`
+	ConfirmedAt     null.Time   `boil:"confirmed_at" json:"confirmed_at,omitempty" toml:"confirmed_at" yaml:"confirmed_at,omitempty"`
+	FailureReason   null.String `boil:"failure_reason" json:"failure_reason,omitempty" toml:"failure_reason" yaml:"failure_reason,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *keyShareBackupR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L keyShareBackupL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var KeyShareBackupColumns = struct {
+	ID              string
+	OrganizationID  string
+	UserID          string
+	KeyID           string
+	ShareIndex      string
+	Status          string
+	DevicePublicKey string
+	DeliveredAt     string
+	ConfirmedAt     string
+	FailureReason   string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	OrganizationID:  "organization_id",
+	UserID:          "user_id",
+	KeyID:           "key_id",
+	ShareIndex:      "share_index",
+	Status:          "status",
+	DevicePublicKey: "device_public_key",
+	DeliveredAt:     "delivered_at",
+	ConfirmedAt:     "confirmed_at",
+	FailureReason:   "failure_reason",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var KeyShareBackupTableColumns = struct {
+	ID              string
+	OrganizationID  string
+	UserID          string
+	KeyID           string
+	ShareIndex      string
+	Status          string
+	DevicePublicKey string
+	DeliveredAt     string
+	ConfirmedAt     string
+	FailureReason   string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "key_share_backups.id",
+	OrganizationID:  "key_share_backups.organization_id",
+	UserID:          "key_share_backups.user_id",
+	KeyID:           "key_share_backups.key_id",
+	ShareIndex:      "key_share_backups.share_index",
+	Status:          "key_share_backups.status",
+	DevicePublicKey: "key_share_backups.device_public_key",
+	DeliveredAt:     "key_share_backups.delivered_at",
+	ConfirmedAt:     "key_share_backups.confirmed_at",
+	FailureReason:   "key_share_backups.failure_reason",
+	CreatedAt:       "key_share_backups.created_at",
+	UpdatedAt:       "key_share_backups.updated_at",
+}
+
+// Generated where
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var KeyShareBackupWhere = struct {
+	ID              whereHelperstring
+	OrganizationID  whereHelperstring
+	UserID          whereHelperstring
+	KeyID           whereHelperstring
+	ShareIndex      whereHelperint
+	Status          whereHelperstring
+	DevicePublicKey whereHelper__byte
+	DeliveredAt     whereHelpertime_Time
+	ConfirmedAt     whereHelpernull_Time
+	FailureReason   whereHelpernull_String
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperstring{field: "\"key_share_backups\".\"id\""},
+	OrganizationID:  whereHelperstring{field: "\"key_share_backups\".\"organization_id\""},
+	UserID:          whereHelperstring{field: "\"key_share_backups\".\"user_id\""},
+	KeyID:           whereHelperstring{field: "\"key_share_backups\".\"key_id\""},
+	ShareIndex:      whereHelperint{field: "\"key_share_backups\".\"share_index\""},
+	Status:          whereHelperstring{field: "\"key_share_backups\".\"status\""},
+	DevicePublicKey: whereHelper__byte{field: "\"key_share_backups\".\"device_public_key\""},
+	DeliveredAt:     whereHelpertime_Time{field: "\"key_share_backups\".\"delivered_at\""},
+	ConfirmedAt:     whereHelpernull_Time{field: "\"key_share_backups\".\"confirmed_at\""},
+	FailureReason:   whereHelpernull_String{field: "\"key_share_backups\".\"failure_reason\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"key_share_backups\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"key_share_backups\".\"updated_at\""},
+}
+
+// KeyShareBackupRels is where relationship names are stored.
+var KeyShareBackupRels = struct {
+	Organization string
+	User         string
+}{
+	Organization: "Organization",
+	User:         "User",
+}
+
+// keyShareBackupR is where relationships are stored.
+type keyShareBackupR struct {
+	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	User         *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*keyShareBackupR) NewStruct() *keyShareBackupR {
+	return &keyShareBackupR{}
+}
+
+func (o *KeyShareBackup) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganization()
+}
+
+func (r *keyShareBackupR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.Organization
+}
+
+func (o *KeyShareBackup) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *keyShareBackupR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// keyShareBackupL is where Load methods for each relationship are stored.
+type keyShareBackupL struct{}
+
+var (
+	keyShareBackupAllColumns            = []string{"id", "organization_id", "user_id", "key_id", "share_index", "status", "device_public_key", "delivered_at", "confirmed_at", "failure_reason", "created_at", "updated_at"}
+	keyShareBackupColumnsWithoutDefault = []string{"organization_id", "user_id", "key_id", "share_index", "status", "device_public_key", "delivered_at"}
+	keyShareBackupColumnsWithDefault    = []string{"id", "confirmed_at", "failure_reason", "created_at", "updated_at"}
+	keyShareBackupPrimaryKeyColumns     = []string{"id"}
+	keyShareBackupGeneratedColumns      = []string{}
+)
+
+type (
+	// KeyShareBackupSlice is an alias for a slice of pointers to KeyShareBackup.
+	// This should almost always be used instead of []KeyShareBackup.
+	KeyShareBackupSlice []*KeyShareBackup
+
+	keyShareBackupQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	keyShareBackupType                 = reflect.TypeOf(&KeyShareBackup{})
+	keyShareBackupMapping              = queries.MakeStructMapping(keyShareBackupType)
+	keyShareBackupPrimaryKeyMapping, _ = queries.BindMapping(keyShareBackupType, keyShareBackupMapping, keyShareBackupPrimaryKeyColumns)
+	keyShareBackupInsertCacheMut       sync.RWMutex
+	keyShareBackupInsertCache          = make(map[string]insertCache)
+	keyShareBackupUpdateCacheMut       sync.RWMutex
+	keyShareBackupUpdateCache          = make(map[string]updateCache)
+	keyShareBackupUpsertCacheMut       sync.RWMutex
+	keyShareBackupUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single keyShareBackup record from the query.
+func (q keyShareBackupQuery) One(ctx context.Context, exec boil.ContextExecutor) (*KeyShareBackup, error) {
+	o := &KeyShareBackup{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for key_share_backups")
+	}
+
+	return o, nil
+}
+
+// All returns all KeyShareBackup records from the query.
+func (q keyShareBackupQuery) All(ctx context.Context, exec boil.ContextExecutor) (KeyShareBackupSlice, error) {
+	var o []*KeyShareBackup
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to KeyShareBackup slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all KeyShareBackup records in the query.
+func (q keyShareBackupQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count key_share_backups rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q keyShareBackupQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if key_share_backups exists")
+	}
+
+	return count > 0, nil
+}
+
+// Organization pointed to by the foreign key.
+func (o *KeyShareBackup) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *KeyShareBackup) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareBackupL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareBackup interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareBackup
+	var object *KeyShareBackup
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareBackup.(*KeyShareBackup)
+		if !ok {
+			object = new(KeyShareBackup)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareBackup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareBackup))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareBackup.(*[]*KeyShareBackup)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareBackup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareBackup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareBackupR{}
+		}
+		args[object.OrganizationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareBackupR{}
+			}
+
+			args[obj.OrganizationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.KeyShareBackups = append(foreign.R.KeyShareBackups, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrganizationID == foreign.ID {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.KeyShareBackups = append(foreign.R.KeyShareBackups, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareBackupL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareBackup interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareBackup
+	var object *KeyShareBackup
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareBackup.(*KeyShareBackup)
+		if !ok {
+			object = new(KeyShareBackup)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareBackup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareBackup))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareBackup.(*[]*KeyShareBackup)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareBackup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareBackup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareBackupR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareBackupR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.KeyShareBackups = append(foreign.R.KeyShareBackups, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.KeyShareBackups = append(foreign.R.KeyShareBackups, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrganization of the keyShareBackup to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.KeyShareBackups.
+func (o *KeyShareBackup) SetOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_backups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareBackupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrganizationID = related.ID
+	if o.R == nil {
+		o.R = &keyShareBackupR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			KeyShareBackups: KeyShareBackupSlice{o},
+		}
+	} else {
+		related.R.KeyShareBackups = append(related.R.KeyShareBackups, o)
+	}
+
+	return nil
+}
+
+// SetUser of the keyShareBackup to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.KeyShareBackups.
+func (o *KeyShareBackup) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_backups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareBackupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &keyShareBackupR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			KeyShareBackups: KeyShareBackupSlice{o},
+		}
+	} else {
+		related.R.KeyShareBackups = append(related.R.KeyShareBackups, o)
+	}
+
+	return nil
+}
+
+// KeyShareBackups retrieves all the records using an executor.
+func KeyShareBackups(mods ...qm.QueryMod) keyShareBackupQuery {
+	mods = append(mods, qm.From("\"key_share_backups\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"key_share_backups\".*"})
+	}
+
+	return keyShareBackupQuery{q}
+}
+
+// FindKeyShareBackup retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindKeyShareBackup(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*KeyShareBackup, error) {
+	keyShareBackupObj := &KeyShareBackup{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"key_share_backups\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, keyShareBackupObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from key_share_backups")
+	}
+
+	return keyShareBackupObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *KeyShareBackup) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no key_share_backups provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyShareBackupColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	keyShareBackupInsertCacheMut.RLock()
+	cache, cached := keyShareBackupInsertCache[key]
+	keyShareBackupInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			keyShareBackupAllColumns,
+			keyShareBackupColumnsWithDefault,
+			keyShareBackupColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(keyShareBackupType, keyShareBackupMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(keyShareBackupType, keyShareBackupMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"key_share_backups\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"key_share_backups\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into key_share_backups")
+	}
+
+	if !cached {
+		keyShareBackupInsertCacheMut.Lock()
+		keyShareBackupInsertCache[key] = cache
+		keyShareBackupInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the KeyShareBackup.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *KeyShareBackup) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	keyShareBackupUpdateCacheMut.RLock()
+	cache, cached := keyShareBackupUpdateCache[key]
+	keyShareBackupUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			keyShareBackupAllColumns,
+			keyShareBackupPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update key_share_backups, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"key_share_backups\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, keyShareBackupPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(keyShareBackupType, keyShareBackupMapping, append(wl, keyShareBackupPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update key_share_backups row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for key_share_backups")
+	}
+
+	if !cached {
+		keyShareBackupUpdateCacheMut.Lock()
+		keyShareBackupUpdateCache[key] = cache
+		keyShareBackupUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q keyShareBackupQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for key_share_backups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for key_share_backups")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o KeyShareBackupSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareBackupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"key_share_backups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, keyShareBackupPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in keyShareBackup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all keyShareBackup")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *KeyShareBackup) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no key_share_backups provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyShareBackupColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	keyShareBackupUpsertCacheMut.RLock()
+	cache, cached := keyShareBackupUpsertCache[key]
+	keyShareBackupUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			keyShareBackupAllColumns,
+			keyShareBackupColumnsWithDefault,
+			keyShareBackupColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			keyShareBackupAllColumns,
+			keyShareBackupPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert key_share_backups, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(keyShareBackupAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(keyShareBackupPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert key_share_backups, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(keyShareBackupPrimaryKeyColumns))
+			copy(conflict, keyShareBackupPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"key_share_backups\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(keyShareBackupType, keyShareBackupMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(keyShareBackupType, keyShareBackupMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert key_share_backups")
+	}
+
+	if !cached {
+		keyShareBackupUpsertCacheMut.Lock()
+		keyShareBackupUpsertCache[key] = cache
+		keyShareBackupUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single KeyShareBackup record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *KeyShareBackup) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no KeyShareBackup provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), keyShareBackupPrimaryKeyMapping)
+	sql := "DELETE FROM \"key_share_backups\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from key_share_backups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for key_share_backups")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q keyShareBackupQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no keyShareBackupQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from key_share_backups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_share_backups")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o KeyShareBackupSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareBackupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"key_share_backups\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyShareBackupPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from keyShareBackup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_share_backups")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *KeyShareBackup) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindKeyShareBackup(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *KeyShareBackupSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := KeyShareBackupSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareBackupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"key_share_backups\".* FROM \"key_share_backups\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyShareBackupPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in KeyShareBackupSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// KeyShareBackupExists checks if the KeyShareBackup row exists.
+func KeyShareBackupExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"key_share_backups\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if key_share_backups exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the KeyShareBackup row exists.
+func (o *KeyShareBackup) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return KeyShareBackupExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1770 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// KeyShareRecovery is an object representing the database table.
+type KeyShareRecovery struct {
+	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrganizationID    string      `boil:"organization_id" json:"organization_id" toml:"organization_id" yaml:"organization_id"`
+	VaultID           string      `boil:"vault_id" json:"vault_id" toml:"vault_id" yaml:"vault_id"`
+	KeyID             string      `boil:"key_id" json:"key_id" toml:"key_id" yaml:"key_id"`
+	NodeID            string      `boil:"node_id" json:"node_id" toml:"node_id" yaml:"node_id"`
+	SubjectUserID     string      `boil:"subject_user_id" json:"subject_user_id" toml:"subject_user_id" yaml:"subject_user_id"`
+	InitiatorID       null.String `boil:"initiator_id" json:"initiator_id,omitempty" toml:"initiator_id" yaml:"initiator_id,omitempty"`
+	Reason            null.String `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
+	RequiredApprovals int         `boil:"required_approvals" json:"required_approvals" toml:"required_approvals" yaml:"required_approvals"`
+	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	FailureReason     null.String `boil:"failure_reason" json:"failure_reason,omitempty" toml:"failure_reason" yaml:"failure_reason,omitempty"`
+	CompletedAt       null.Time   `boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *keyShareRecoveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L keyShareRecoveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var KeyShareRecoveryColumns = struct {
+	ID                string
+	OrganizationID    string
+	VaultID           string
+	KeyID             string
+	NodeID            string
+	SubjectUserID     string
+	InitiatorID       string
+	Reason            string
+	RequiredApprovals string
+	Status            string
+	FailureReason     string
+	CompletedAt       string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "id",
+	OrganizationID:    "organization_id",
+	VaultID:           "vault_id",
+	KeyID:             "key_id",
+	NodeID:            "node_id",
+	SubjectUserID:     "subject_user_id",
+	InitiatorID:       "initiator_id",
+	Reason:            "reason",
+	RequiredApprovals: "required_approvals",
+	Status:            "status",
+	FailureReason:     "failure_reason",
+	CompletedAt:       "completed_at",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+}
+
+var KeyShareRecoveryTableColumns = struct {
+	ID                string
+	OrganizationID    string
+	VaultID           string
+	KeyID             string
+	NodeID            string
+	SubjectUserID     string
+	InitiatorID       string
+	Reason            string
+	RequiredApprovals string
+	Status            string
+	FailureReason     string
+	CompletedAt       string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "key_share_recoveries.id",
+	OrganizationID:    "key_share_recoveries.organization_id",
+	VaultID:           "key_share_recoveries.vault_id",
+	KeyID:             "key_share_recoveries.key_id",
+	NodeID:            "key_share_recoveries.node_id",
+	SubjectUserID:     "key_share_recoveries.subject_user_id",
+	InitiatorID:       "key_share_recoveries.initiator_id",
+	Reason:            "key_share_recoveries.reason",
+	RequiredApprovals: "key_share_recoveries.required_approvals",
+	Status:            "key_share_recoveries.status",
+	FailureReason:     "key_share_recoveries.failure_reason",
+	CompletedAt:       "key_share_recoveries.completed_at",
+	CreatedAt:         "key_share_recoveries.created_at",
+	UpdatedAt:         "key_share_recoveries.updated_at",
+}
+
+// Generated where
+
+var KeyShareRecoveryWhere = struct {
+	ID                whereHelperstring
+	OrganizationID    whereHelperstring
+	VaultID           whereHelperstring
+	KeyID             whereHelperstring
+	NodeID            whereHelperstring
+	SubjectUserID     whereHelperstring
+	InitiatorID       whereHelpernull_String
+	Reason            whereHelpernull_String
+	RequiredApprovals whereHelperint
+	Status            whereHelperstring
+	FailureReason     whereHelpernull_String
+	CompletedAt       whereHelpernull_Time
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+}{
+	ID:                whereHelperstring{field: "\"key_share_recoveries\".\"id\""},
+	OrganizationID:    whereHelperstring{field: "\"key_share_recoveries\".\"organization_id\""},
+	VaultID:           whereHelperstring{field: "\"key_share_recoveries\".\"vault_id\""},
+	KeyID:             whereHelperstring{field: "\"key_share_recoveries\".\"key_id\""},
+	NodeID:            whereHelperstring{field: "\"key_share_recoveries\".\"node_id\""},
+	SubjectUserID:     whereHelperstring{field: "\"key_share_recoveries\".\"subject_user_id\""},
+	InitiatorID:       whereHelpernull_String{field: "\"key_share_recoveries\".\"initiator_id\""},
+	Reason:            whereHelpernull_String{field: "\"key_share_recoveries\".\"reason\""},
+	RequiredApprovals: whereHelperint{field: "\"key_share_recoveries\".\"required_approvals\""},
+	Status:            whereHelperstring{field: "\"key_share_recoveries\".\"status\""},
+	FailureReason:     whereHelpernull_String{field: "\"key_share_recoveries\".\"failure_reason\""},
+	CompletedAt:       whereHelpernull_Time{field: "\"key_share_recoveries\".\"completed_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"key_share_recoveries\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"key_share_recoveries\".\"updated_at\""},
+}
+
+// KeyShareRecoveryRels is where relationship names are stored.
+var KeyShareRecoveryRels = struct {
+	Initiator                         string
+	Organization                      string
+	SubjectUser                       string
+	Vault                             string
+	RecoveryKeyShareRecoveryApprovals string
+}{
+	Initiator:                         "Initiator",
+	Organization:                      "Organization",
+	SubjectUser:                       "SubjectUser",
+	Vault:                             "Vault",
+	RecoveryKeyShareRecoveryApprovals: "RecoveryKeyShareRecoveryApprovals",
+}
+
+// keyShareRecoveryR is where relationships are stored.
+type keyShareRecoveryR struct {
+	Initiator                         *User                         `boil:"Initiator" json:"Initiator" toml:"Initiator" yaml:"Initiator"`
+	Organization                      *Organization                 `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	SubjectUser                       *User                         `boil:"SubjectUser" json:"SubjectUser" toml:"SubjectUser" yaml:"SubjectUser"`
+	Vault                             *Vault                        `boil:"Vault" json:"Vault" toml:"Vault" yaml:"Vault"`
+	RecoveryKeyShareRecoveryApprovals KeyShareRecoveryApprovalSlice `boil:"RecoveryKeyShareRecoveryApprovals" json:"RecoveryKeyShareRecoveryApprovals" toml:"RecoveryKeyShareRecoveryApprovals" yaml:"RecoveryKeyShareRecoveryApprovals"`
+}
+
+// NewStruct creates a new relationship struct
+func (*keyShareRecoveryR) NewStruct() *keyShareRecoveryR {
+	return &keyShareRecoveryR{}
+}
+
+func (o *KeyShareRecovery) GetInitiator() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInitiator()
+}
+
+func (r *keyShareRecoveryR) GetInitiator() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Initiator
+}
+
+func (o *KeyShareRecovery) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganization()
+}
+
+func (r *keyShareRecoveryR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.Organization
+}
+
+func (o *KeyShareRecovery) GetSubjectUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubjectUser()
+}
+
+func (r *keyShareRecoveryR) GetSubjectUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubjectUser
+}
+
+func (o *KeyShareRecovery) GetVault() *Vault {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVault()
+}
+
+func (r *keyShareRecoveryR) GetVault() *Vault {
+	if r == nil {
+		return nil
+	}
+
+	return r.Vault
+}
+
+func (o *KeyShareRecovery) GetRecoveryKeyShareRecoveryApprovals() KeyShareRecoveryApprovalSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRecoveryKeyShareRecoveryApprovals()
+}
+
+func (r *keyShareRecoveryR) GetRecoveryKeyShareRecoveryApprovals() KeyShareRecoveryApprovalSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RecoveryKeyShareRecoveryApprovals
+}
+
+// keyShareRecoveryL is where Load methods for each relationship are stored.
+type keyShareRecoveryL struct{}
+
+var (
+	keyShareRecoveryAllColumns            = []string{"id", "organization_id", "vault_id", "key_id", "node_id", "subject_user_id", "initiator_id", "reason", "required_approvals", "status", "failure_reason", "completed_at", "created_at", "updated_at"}
+	keyShareRecoveryColumnsWithoutDefault = []string{"organization_id", "vault_id", "key_id", "node_id", "subject_user_id", "required_approvals", "status"}
+	keyShareRecoveryColumnsWithDefault    = []string{"id", "initiator_id", "reason", "failure_reason", "completed_at", "created_at", "updated_at"}
+	keyShareRecoveryPrimaryKeyColumns     = []string{"id"}
+	keyShareRecoveryGeneratedColumns      = []string{}
+)
+
+type (
+	// KeyShareRecoverySlice is an alias for a slice of pointers to KeyShareRecovery.
+	// This should almost always be used instead of []KeyShareRecovery.
+	KeyShareRecoverySlice []*KeyShareRecovery
+
+	keyShareRecoveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	keyShareRecoveryType                 = reflect.TypeOf(&KeyShareRecovery{})
+	keyShareRecoveryMapping              = queries.MakeStructMapping(keyShareRecoveryType)
+	keyShareRecoveryPrimaryKeyMapping, _ = queries.BindMapping(keyShareRecoveryType, keyShareRecoveryMapping, keyShareRecoveryPrimaryKeyColumns)
+	keyShareRecoveryInsertCacheMut       sync.RWMutex
+	keyShareRecoveryInsertCache          = make(map[string]insertCache)
+	keyShareRecoveryUpdateCacheMut       sync.RWMutex
+	keyShareRecoveryUpdateCache          = make(map[string]updateCache)
+	keyShareRecoveryUpsertCacheMut       sync.RWMutex
+	keyShareRecoveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single keyShareRecovery record from the query.
+func (q keyShareRecoveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*KeyShareRecovery, error) {
+	o := &KeyShareRecovery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for key_share_recoveries")
+	}
+
+	return o, nil
+}
+
+// All returns all KeyShareRecovery records from the query.
+func (q keyShareRecoveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (KeyShareRecoverySlice, error) {
+	var o []*KeyShareRecovery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to KeyShareRecovery slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all KeyShareRecovery records in the query.
+func (q keyShareRecoveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count key_share_recoveries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q keyShareRecoveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if key_share_recoveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Initiator pointed to by the foreign key.
+func (o *KeyShareRecovery) Initiator(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InitiatorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Organization pointed to by the foreign key.
+func (o *KeyShareRecovery) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// SubjectUser pointed to by the foreign key.
+func (o *KeyShareRecovery) SubjectUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SubjectUserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Vault pointed to by the foreign key.
+func (o *KeyShareRecovery) Vault(mods ...qm.QueryMod) vaultQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VaultID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Vaults(queryMods...)
+}
+
+// RecoveryKeyShareRecoveryApprovals retrieves all the key_share_recovery_approval's KeyShareRecoveryApprovals with an executor via recovery_id column.
+func (o *KeyShareRecovery) RecoveryKeyShareRecoveryApprovals(mods ...qm.QueryMod) keyShareRecoveryApprovalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_share_recovery_approvals\".\"recovery_id\"=?", o.ID),
+	)
+
+	return KeyShareRecoveryApprovals(queryMods...)
+}
+
+// LoadInitiator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareRecoveryL) LoadInitiator(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareRecovery interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareRecovery
+	var object *KeyShareRecovery
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareRecovery.(*KeyShareRecovery)
+		if !ok {
+			object = new(KeyShareRecovery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareRecovery))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareRecovery.(*[]*KeyShareRecovery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareRecovery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareRecoveryR{}
+		}
+		if !queries.IsNil(object.InitiatorID) {
+			args[object.InitiatorID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareRecoveryR{}
+			}
+
+			if !queries.IsNil(obj.InitiatorID) {
+				args[obj.InitiatorID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Initiator = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InitiatorKeyShareRecoveries = append(foreign.R.InitiatorKeyShareRecoveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.InitiatorID, foreign.ID) {
+				local.R.Initiator = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InitiatorKeyShareRecoveries = append(foreign.R.InitiatorKeyShareRecoveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareRecoveryL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareRecovery interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareRecovery
+	var object *KeyShareRecovery
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareRecovery.(*KeyShareRecovery)
+		if !ok {
+			object = new(KeyShareRecovery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareRecovery))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareRecovery.(*[]*KeyShareRecovery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareRecovery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareRecoveryR{}
+		}
+		args[object.OrganizationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareRecoveryR{}
+			}
+
+			args[obj.OrganizationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.KeyShareRecoveries = append(foreign.R.KeyShareRecoveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrganizationID == foreign.ID {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.KeyShareRecoveries = append(foreign.R.KeyShareRecoveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubjectUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareRecoveryL) LoadSubjectUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareRecovery interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareRecovery
+	var object *KeyShareRecovery
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareRecovery.(*KeyShareRecovery)
+		if !ok {
+			object = new(KeyShareRecovery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareRecovery))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareRecovery.(*[]*KeyShareRecovery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareRecovery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareRecoveryR{}
+		}
+		args[object.SubjectUserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareRecoveryR{}
+			}
+
+			args[obj.SubjectUserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SubjectUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SubjectUserKeyShareRecoveries = append(foreign.R.SubjectUserKeyShareRecoveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubjectUserID == foreign.ID {
+				local.R.SubjectUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SubjectUserKeyShareRecoveries = append(foreign.R.SubjectUserKeyShareRecoveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVault allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareRecoveryL) LoadVault(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareRecovery interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareRecovery
+	var object *KeyShareRecovery
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareRecovery.(*KeyShareRecovery)
+		if !ok {
+			object = new(KeyShareRecovery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareRecovery))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareRecovery.(*[]*KeyShareRecovery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareRecovery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareRecoveryR{}
+		}
+		args[object.VaultID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareRecoveryR{}
+			}
+
+			args[obj.VaultID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vaults`),
+		qm.WhereIn(`vaults.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Vault")
+	}
+
+	var resultSlice []*Vault
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Vault")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vaults")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vaults")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Vault = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultR{}
+		}
+		foreign.R.KeyShareRecoveries = append(foreign.R.KeyShareRecoveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VaultID == foreign.ID {
+				local.R.Vault = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultR{}
+				}
+				foreign.R.KeyShareRecoveries = append(foreign.R.KeyShareRecoveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecoveryKeyShareRecoveryApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (keyShareRecoveryL) LoadRecoveryKeyShareRecoveryApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareRecovery interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareRecovery
+	var object *KeyShareRecovery
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareRecovery.(*KeyShareRecovery)
+		if !ok {
+			object = new(KeyShareRecovery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareRecovery))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareRecovery.(*[]*KeyShareRecovery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareRecovery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareRecovery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareRecoveryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareRecoveryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_share_recovery_approvals`),
+		qm.WhereIn(`key_share_recovery_approvals.recovery_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load key_share_recovery_approvals")
+	}
+
+	var resultSlice []*KeyShareRecoveryApproval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_recovery_approvals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on key_share_recovery_approvals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_recovery_approvals")
+	}
+
+	if singular {
+		object.R.RecoveryKeyShareRecoveryApprovals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &keyShareRecoveryApprovalR{}
+			}
+			foreign.R.Recovery = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RecoveryID {
+				local.R.RecoveryKeyShareRecoveryApprovals = append(local.R.RecoveryKeyShareRecoveryApprovals, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyShareRecoveryApprovalR{}
+				}
+				foreign.R.Recovery = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetInitiator of the keyShareRecovery to the related item.
+// Sets o.R.Initiator to related.
+// Adds o to related.R.InitiatorKeyShareRecoveries.
+func (o *KeyShareRecovery) SetInitiator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"initiator_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.InitiatorID, related.ID)
+	if o.R == nil {
+		o.R = &keyShareRecoveryR{
+			Initiator: related,
+		}
+	} else {
+		o.R.Initiator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InitiatorKeyShareRecoveries: KeyShareRecoverySlice{o},
+		}
+	} else {
+		related.R.InitiatorKeyShareRecoveries = append(related.R.InitiatorKeyShareRecoveries, o)
+	}
+
+	return nil
+}
+
+// RemoveInitiator relationship.
+// Sets o.R.Initiator to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *KeyShareRecovery) RemoveInitiator(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.InitiatorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("initiator_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Initiator = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.InitiatorKeyShareRecoveries {
+		if queries.Equal(o.InitiatorID, ri.InitiatorID) {
+			continue
+		}
+
+		ln := len(related.R.InitiatorKeyShareRecoveries)
+		if ln > 1 && i < ln-1 {
+			related.R.InitiatorKeyShareRecoveries[i] = related.R.InitiatorKeyShareRecoveries[ln-1]
+		}
+		related.R.InitiatorKeyShareRecoveries = related.R.InitiatorKeyShareRecoveries[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetOrganization of the keyShareRecovery to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.KeyShareRecoveries.
+func (o *KeyShareRecovery) SetOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrganizationID = related.ID
+	if o.R == nil {
+		o.R = &keyShareRecoveryR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			KeyShareRecoveries: KeyShareRecoverySlice{o},
+		}
+	} else {
+		related.R.KeyShareRecoveries = append(related.R.KeyShareRecoveries, o)
+	}
+
+	return nil
+}
+
+// SetSubjectUser of the keyShareRecovery to the related item.
+// Sets o.R.SubjectUser to related.
+// Adds o to related.R.SubjectUserKeyShareRecoveries.
+func (o *KeyShareRecovery) SetSubjectUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"subject_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SubjectUserID = related.ID
+	if o.R == nil {
+		o.R = &keyShareRecoveryR{
+			SubjectUser: related,
+		}
+	} else {
+		o.R.SubjectUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SubjectUserKeyShareRecoveries: KeyShareRecoverySlice{o},
+		}
+	} else {
+		related.R.SubjectUserKeyShareRecoveries = append(related.R.SubjectUserKeyShareRecoveries, o)
+	}
+
+	return nil
+}
+
+// SetVault of the keyShareRecovery to the related item.
+// Sets o.R.Vault to related.
+// Adds o to related.R.KeyShareRecoveries.
+func (o *KeyShareRecovery) SetVault(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Vault) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VaultID = related.ID
+	if o.R == nil {
+		o.R = &keyShareRecoveryR{
+			Vault: related,
+		}
+	} else {
+		o.R.Vault = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultR{
+			KeyShareRecoveries: KeyShareRecoverySlice{o},
+		}
+	} else {
+		related.R.KeyShareRecoveries = append(related.R.KeyShareRecoveries, o)
+	}
+
+	return nil
+}
+
+// AddRecoveryKeyShareRecoveryApprovals adds the given related objects to the existing relationships
+// of the key_share_recovery, optionally inserting them as new records.
+// Appends related to o.R.RecoveryKeyShareRecoveryApprovals.
+// Sets related.R.Recovery appropriately.
+func (o *KeyShareRecovery) AddRecoveryKeyShareRecoveryApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareRecoveryApproval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RecoveryID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_share_recovery_approvals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"recovery_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryApprovalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RecoveryID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &keyShareRecoveryR{
+			RecoveryKeyShareRecoveryApprovals: related,
+		}
+	} else {
+		o.R.RecoveryKeyShareRecoveryApprovals = append(o.R.RecoveryKeyShareRecoveryApprovals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyShareRecoveryApprovalR{
+				Recovery: o,
+			}
+		} else {
+			rel.R.Recovery = o
+		}
+	}
+	return nil
+}
+
+// KeyShareRecoveries retrieves all the records using an executor.
+func KeyShareRecoveries(mods ...qm.QueryMod) keyShareRecoveryQuery {
+	mods = append(mods, qm.From("\"key_share_recoveries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"key_share_recoveries\".*"})
+	}
+
+	return keyShareRecoveryQuery{q}
+}
+
+// FindKeyShareRecovery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindKeyShareRecovery(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*KeyShareRecovery, error) {
+	keyShareRecoveryObj := &KeyShareRecovery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"key_share_recoveries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, keyShareRecoveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from key_share_recoveries")
+	}
+
+	return keyShareRecoveryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *KeyShareRecovery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no key_share_recoveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyShareRecoveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	keyShareRecoveryInsertCacheMut.RLock()
+	cache, cached := keyShareRecoveryInsertCache[key]
+	keyShareRecoveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			keyShareRecoveryAllColumns,
+			keyShareRecoveryColumnsWithDefault,
+			keyShareRecoveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(keyShareRecoveryType, keyShareRecoveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(keyShareRecoveryType, keyShareRecoveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"key_share_recoveries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"key_share_recoveries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into key_share_recoveries")
+	}
+
+	if !cached {
+		keyShareRecoveryInsertCacheMut.Lock()
+		keyShareRecoveryInsertCache[key] = cache
+		keyShareRecoveryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the KeyShareRecovery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *KeyShareRecovery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	keyShareRecoveryUpdateCacheMut.RLock()
+	cache, cached := keyShareRecoveryUpdateCache[key]
+	keyShareRecoveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			keyShareRecoveryAllColumns,
+			keyShareRecoveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update key_share_recoveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, keyShareRecoveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(keyShareRecoveryType, keyShareRecoveryMapping, append(wl, keyShareRecoveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update key_share_recoveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for key_share_recoveries")
+	}
+
+	if !cached {
+		keyShareRecoveryUpdateCacheMut.Lock()
+		keyShareRecoveryUpdateCache[key] = cache
+		keyShareRecoveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q keyShareRecoveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for key_share_recoveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for key_share_recoveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o KeyShareRecoverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareRecoveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, keyShareRecoveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in keyShareRecovery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all keyShareRecovery")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *KeyShareRecovery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no key_share_recoveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyShareRecoveryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	keyShareRecoveryUpsertCacheMut.RLock()
+	cache, cached := keyShareRecoveryUpsertCache[key]
+	keyShareRecoveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			keyShareRecoveryAllColumns,
+			keyShareRecoveryColumnsWithDefault,
+			keyShareRecoveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			keyShareRecoveryAllColumns,
+			keyShareRecoveryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert key_share_recoveries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(keyShareRecoveryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(keyShareRecoveryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert key_share_recoveries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(keyShareRecoveryPrimaryKeyColumns))
+			copy(conflict, keyShareRecoveryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"key_share_recoveries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(keyShareRecoveryType, keyShareRecoveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(keyShareRecoveryType, keyShareRecoveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert key_share_recoveries")
+	}
+
+	if !cached {
+		keyShareRecoveryUpsertCacheMut.Lock()
+		keyShareRecoveryUpsertCache[key] = cache
+		keyShareRecoveryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single KeyShareRecovery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *KeyShareRecovery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no KeyShareRecovery provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), keyShareRecoveryPrimaryKeyMapping)
+	sql := "DELETE FROM \"key_share_recoveries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from key_share_recoveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for key_share_recoveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q keyShareRecoveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no keyShareRecoveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from key_share_recoveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_share_recoveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o KeyShareRecoverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareRecoveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"key_share_recoveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyShareRecoveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from keyShareRecovery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_share_recoveries")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *KeyShareRecovery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindKeyShareRecovery(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *KeyShareRecoverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := KeyShareRecoverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareRecoveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"key_share_recoveries\".* FROM \"key_share_recoveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyShareRecoveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in KeyShareRecoverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// KeyShareRecoveryExists checks if the KeyShareRecovery row exists.
+func KeyShareRecoveryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"key_share_recoveries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if key_share_recoveries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the KeyShareRecovery row exists.
+func (o *KeyShareRecovery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return KeyShareRecoveryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1233 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testKeyShareRecoveries(t *testing.T) {
+	t.Parallel()
+
+	query := KeyShareRecoveries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testKeyShareRecoveriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyShareRecoveriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := KeyShareRecoveries().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyShareRecoveriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyShareRecoverySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyShareRecoveriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := KeyShareRecoveryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if KeyShareRecovery exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected KeyShareRecoveryExists to return true, but got false.")
+	}
+}
+
+func testKeyShareRecoveriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	keyShareRecoveryFound, err := FindKeyShareRecovery(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if keyShareRecoveryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testKeyShareRecoveriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = KeyShareRecoveries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyShareRecoveriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := KeyShareRecoveries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testKeyShareRecoveriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	keyShareRecoveryOne := &KeyShareRecovery{}
+	keyShareRecoveryTwo := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, keyShareRecoveryOne, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyShareRecoveryTwo, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyShareRecoveryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyShareRecoveryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyShareRecoveries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testKeyShareRecoveriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	keyShareRecoveryOne := &KeyShareRecovery{}
+	keyShareRecoveryTwo := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, keyShareRecoveryOne, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyShareRecoveryTwo, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyShareRecoveryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyShareRecoveryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testKeyShareRecoveriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyShareRecoveriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyShareRecoveryToManyRecoveryKeyShareRecoveryApprovals(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecovery
+	var b, c KeyShareRecoveryApproval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.RecoveryID = a.ID
+	c.RecoveryID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RecoveryKeyShareRecoveryApprovals().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.RecoveryID == b.RecoveryID {
+			bFound = true
+		}
+		if v.RecoveryID == c.RecoveryID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := KeyShareRecoverySlice{&a}
+	if err = a.L.LoadRecoveryKeyShareRecoveryApprovals(ctx, tx, false, (*[]*KeyShareRecovery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RecoveryKeyShareRecoveryApprovals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RecoveryKeyShareRecoveryApprovals = nil
+	if err = a.L.LoadRecoveryKeyShareRecoveryApprovals(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RecoveryKeyShareRecoveryApprovals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testKeyShareRecoveryToManyAddOpRecoveryKeyShareRecoveryApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecovery
+	var b, c, d, e KeyShareRecoveryApproval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareRecoveryApproval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryApprovalDBTypes, false, strmangle.SetComplement(keyShareRecoveryApprovalPrimaryKeyColumns, keyShareRecoveryApprovalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyShareRecoveryApproval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRecoveryKeyShareRecoveryApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.RecoveryID {
+			t.Error("foreign key was wrong value", a.ID, first.RecoveryID)
+		}
+		if a.ID != second.RecoveryID {
+			t.Error("foreign key was wrong value", a.ID, second.RecoveryID)
+		}
+
+		if first.R.Recovery != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Recovery != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RecoveryKeyShareRecoveryApprovals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RecoveryKeyShareRecoveryApprovals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RecoveryKeyShareRecoveryApprovals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testKeyShareRecoveryToOneUserUsingInitiator(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyShareRecovery
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.InitiatorID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Initiator().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyShareRecoverySlice{&local}
+	if err = local.L.LoadInitiator(ctx, tx, false, (*[]*KeyShareRecovery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Initiator == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Initiator = nil
+	if err = local.L.LoadInitiator(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Initiator == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyShareRecoveryToOneOrganizationUsingOrganization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyShareRecovery
+	var foreign Organization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OrganizationID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Organization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyShareRecoverySlice{&local}
+	if err = local.L.LoadOrganization(ctx, tx, false, (*[]*KeyShareRecovery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Organization = nil
+	if err = local.L.LoadOrganization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyShareRecoveryToOneUserUsingSubjectUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyShareRecovery
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SubjectUserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.SubjectUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyShareRecoverySlice{&local}
+	if err = local.L.LoadSubjectUser(ctx, tx, false, (*[]*KeyShareRecovery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SubjectUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.SubjectUser = nil
+	if err = local.L.LoadSubjectUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SubjectUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyShareRecoveryToOneVaultUsingVault(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyShareRecovery
+	var foreign Vault
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VaultID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Vault().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyShareRecoverySlice{&local}
+	if err = local.L.LoadVault(ctx, tx, false, (*[]*KeyShareRecovery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Vault = nil
+	if err = local.L.LoadVault(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyShareRecoveryToOneSetOpUserUsingInitiator(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecovery
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetInitiator(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Initiator != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.InitiatorKeyShareRecoveries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.InitiatorID, x.ID) {
+			t.Error("foreign key was wrong value", a.InitiatorID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.InitiatorID))
+		reflect.Indirect(reflect.ValueOf(&a.InitiatorID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.InitiatorID, x.ID) {
+			t.Error("foreign key was wrong value", a.InitiatorID, x.ID)
+		}
+	}
+}
+
+func testKeyShareRecoveryToOneRemoveOpUserUsingInitiator(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecovery
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetInitiator(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveInitiator(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Initiator().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Initiator != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.InitiatorID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.InitiatorKeyShareRecoveries) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testKeyShareRecoveryToOneSetOpOrganizationUsingOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecovery
+	var b, c Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Organization{&b, &c} {
+		err = a.SetOrganization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Organization != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.KeyShareRecoveries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OrganizationID != x.ID {
+			t.Error("foreign key was wrong value", a.OrganizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrganizationID))
+		reflect.Indirect(reflect.ValueOf(&a.OrganizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.OrganizationID != x.ID {
+			t.Error("foreign key was wrong value", a.OrganizationID, x.ID)
+		}
+	}
+}
+func testKeyShareRecoveryToOneSetOpUserUsingSubjectUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecovery
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetSubjectUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.SubjectUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SubjectUserKeyShareRecoveries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SubjectUserID != x.ID {
+			t.Error("foreign key was wrong value", a.SubjectUserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SubjectUserID))
+		reflect.Indirect(reflect.ValueOf(&a.SubjectUserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SubjectUserID != x.ID {
+			t.Error("foreign key was wrong value", a.SubjectUserID, x.ID)
+		}
+	}
+}
+func testKeyShareRecoveryToOneSetOpVaultUsingVault(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecovery
+	var b, c Vault
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Vault{&b, &c} {
+		err = a.SetVault(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Vault != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.KeyShareRecoveries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VaultID))
+		reflect.Indirect(reflect.ValueOf(&a.VaultID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID, x.ID)
+		}
+	}
+}
+
+func testKeyShareRecoveriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyShareRecoveriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyShareRecoverySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyShareRecoveriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyShareRecoveries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	keyShareRecoveryDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `VaultID`: `uuid`, `KeyID`: `character varying`, `NodeID`: `character varying`, `SubjectUserID`: `uuid`, `InitiatorID`: `uuid`, `Reason`: `text`, `RequiredApprovals`: `integer`, `Status`: `character varying`, `FailureReason`: `text`, `CompletedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                       = bytes.MinRead
+)
+
+func testKeyShareRecoveriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(keyShareRecoveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(keyShareRecoveryAllColumns) == len(keyShareRecoveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testKeyShareRecoveriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(keyShareRecoveryAllColumns) == len(keyShareRecoveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecovery{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyShareRecoveryDBTypes, true, keyShareRecoveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(keyShareRecoveryAllColumns, keyShareRecoveryPrimaryKeyColumns) {
+		fields = keyShareRecoveryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			keyShareRecoveryAllColumns,
+			keyShareRecoveryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := KeyShareRecoverySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testKeyShareRecoveriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(keyShareRecoveryAllColumns) == len(keyShareRecoveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := KeyShareRecovery{}
+	if err = randomize.Struct(seed, &o, keyShareRecoveryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyShareRecovery: %s", err)
+	}
+
+	count, err := KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, keyShareRecoveryDBTypes, false, keyShareRecoveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyShareRecovery: %s", err)
+	}
+
+	count, err = KeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1097 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// KeyShareRecoveryApproval is an object representing the database table.
+type KeyShareRecoveryApproval struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RecoveryID   string      `boil:"recovery_id" json:"recovery_id" toml:"recovery_id" yaml:"recovery_id"`
+	UserID       string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Action       string      `boil:"action" json:"action" toml:"action" yaml:"action"`
+	CredentialID null.String `boil:"credential_id" json:"credential_id,omitempty" toml:"credential_id" yaml:"credential_id,omitempty"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *keyShareRecoveryApprovalR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L keyShareRecoveryApprovalL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var KeyShareRecoveryApprovalColumns = struct {
+	ID           string
+	RecoveryID   string
+	UserID       string
+	Action       string
+	CredentialID string
+	CreatedAt    string
+}{
+	ID:           "id",
+	RecoveryID:   "recovery_id",
+	UserID:       "user_id",
+	Action:       "action",
+	CredentialID: "credential_id",
+	CreatedAt:    "created_at",
+}
+
+var KeyShareRecoveryApprovalTableColumns = struct {
+	ID           string
+	RecoveryID   string
+	UserID       string
+	Action       string
+	CredentialID string
+	CreatedAt    string
+}{
+	ID:           "key_share_recovery_approvals.id",
+	RecoveryID:   "key_share_recovery_approvals.recovery_id",
+	UserID:       "key_share_recovery_approvals.user_id",
+	Action:       "key_share_recovery_approvals.action",
+	CredentialID: "key_share_recovery_approvals.credential_id",
+	CreatedAt:    "key_share_recovery_approvals.created_at",
+}
+
+// Generated where
+
+var KeyShareRecoveryApprovalWhere = struct {
+	ID           whereHelperstring
+	RecoveryID   whereHelperstring
+	UserID       whereHelperstring
+	Action       whereHelperstring
+	CredentialID whereHelpernull_String
+	CreatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"key_share_recovery_approvals\".\"id\""},
+	RecoveryID:   whereHelperstring{field: "\"key_share_recovery_approvals\".\"recovery_id\""},
+	UserID:       whereHelperstring{field: "\"key_share_recovery_approvals\".\"user_id\""},
+	Action:       whereHelperstring{field: "\"key_share_recovery_approvals\".\"action\""},
+	CredentialID: whereHelpernull_String{field: "\"key_share_recovery_approvals\".\"credential_id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"key_share_recovery_approvals\".\"created_at\""},
+}
+
+// KeyShareRecoveryApprovalRels is where relationship names are stored.
+var KeyShareRecoveryApprovalRels = struct {
+	Recovery string
+	User     string
+}{
+	Recovery: "Recovery",
+	User:     "User",
+}
+
+// keyShareRecoveryApprovalR is where relationships are stored.
+type keyShareRecoveryApprovalR struct {
+	Recovery *KeyShareRecovery `boil:"Recovery" json:"Recovery" toml:"Recovery" yaml:"Recovery"`
+	User     *User             `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*keyShareRecoveryApprovalR) NewStruct() *keyShareRecoveryApprovalR {
+	return &keyShareRecoveryApprovalR{}
+}
+
+func (o *KeyShareRecoveryApproval) GetRecovery() *KeyShareRecovery {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRecovery()
+}
+
+func (r *keyShareRecoveryApprovalR) GetRecovery() *KeyShareRecovery {
+	if r == nil {
+		return nil
+	}
+
+	return r.Recovery
+}
+
+func (o *KeyShareRecoveryApproval) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *keyShareRecoveryApprovalR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// keyShareRecoveryApprovalL is where Load methods for each relationship are stored.
+type keyShareRecoveryApprovalL struct{}
+
+var (
+	keyShareRecoveryApprovalAllColumns            = []string{"id", "recovery_id", "user_id", "action", "credential_id", "created_at"}
+	keyShareRecoveryApprovalColumnsWithoutDefault = []string{"recovery_id", "user_id", "action"}
+	keyShareRecoveryApprovalColumnsWithDefault    = []string{"id", "credential_id", "created_at"}
+	keyShareRecoveryApprovalPrimaryKeyColumns     = []string{"id"}
+	keyShareRecoveryApprovalGeneratedColumns      = []string{}
+)
+
+type (
+	// KeyShareRecoveryApprovalSlice is an alias for a slice of pointers to KeyShareRecoveryApproval.
+	// This should almost always be used instead of []KeyShareRecoveryApproval.
+	KeyShareRecoveryApprovalSlice []*KeyShareRecoveryApproval
+
+	keyShareRecoveryApprovalQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	keyShareRecoveryApprovalType                 = reflect.TypeOf(&KeyShareRecoveryApproval{})
+	keyShareRecoveryApprovalMapping              = queries.MakeStructMapping(keyShareRecoveryApprovalType)
+	keyShareRecoveryApprovalPrimaryKeyMapping, _ = queries.BindMapping(keyShareRecoveryApprovalType, keyShareRecoveryApprovalMapping, keyShareRecoveryApprovalPrimaryKeyColumns)
+	keyShareRecoveryApprovalInsertCacheMut       sync.RWMutex
+	keyShareRecoveryApprovalInsertCache          = make(map[string]insertCache)
+	keyShareRecoveryApprovalUpdateCacheMut       sync.RWMutex
+	keyShareRecoveryApprovalUpdateCache          = make(map[string]updateCache)
+	keyShareRecoveryApprovalUpsertCacheMut       sync.RWMutex
+	keyShareRecoveryApprovalUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single keyShareRecoveryApproval record from the query.
+func (q keyShareRecoveryApprovalQuery) One(ctx context.Context, exec boil.ContextExecutor) (*KeyShareRecoveryApproval, error) {
+	o := &KeyShareRecoveryApproval{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for key_share_recovery_approvals")
+	}
+
+	return o, nil
+}
+
+// All returns all KeyShareRecoveryApproval records from the query.
+func (q keyShareRecoveryApprovalQuery) All(ctx context.Context, exec boil.ContextExecutor) (KeyShareRecoveryApprovalSlice, error) {
+	var o []*KeyShareRecoveryApproval
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to KeyShareRecoveryApproval slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all KeyShareRecoveryApproval records in the query.
+func (q keyShareRecoveryApprovalQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count key_share_recovery_approvals rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q keyShareRecoveryApprovalQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if key_share_recovery_approvals exists")
+	}
+
+	return count > 0, nil
+}
+
+// Recovery pointed to by the foreign key.
+func (o *KeyShareRecoveryApproval) Recovery(mods ...qm.QueryMod) keyShareRecoveryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RecoveryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return KeyShareRecoveries(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *KeyShareRecoveryApproval) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadRecovery allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareRecoveryApprovalL) LoadRecovery(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareRecoveryApproval interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareRecoveryApproval
+	var object *KeyShareRecoveryApproval
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareRecoveryApproval.(*KeyShareRecoveryApproval)
+		if !ok {
+			object = new(KeyShareRecoveryApproval)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareRecoveryApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareRecoveryApproval))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareRecoveryApproval.(*[]*KeyShareRecoveryApproval)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareRecoveryApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareRecoveryApproval))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareRecoveryApprovalR{}
+		}
+		args[object.RecoveryID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareRecoveryApprovalR{}
+			}
+
+			args[obj.RecoveryID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_share_recoveries`),
+		qm.WhereIn(`key_share_recoveries.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load KeyShareRecovery")
+	}
+
+	var resultSlice []*KeyShareRecovery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice KeyShareRecovery")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for key_share_recoveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_recoveries")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Recovery = foreign
+		if foreign.R == nil {
+			foreign.R = &keyShareRecoveryR{}
+		}
+		foreign.R.RecoveryKeyShareRecoveryApprovals = append(foreign.R.RecoveryKeyShareRecoveryApprovals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RecoveryID == foreign.ID {
+				local.R.Recovery = foreign
+				if foreign.R == nil {
+					foreign.R = &keyShareRecoveryR{}
+				}
+				foreign.R.RecoveryKeyShareRecoveryApprovals = append(foreign.R.RecoveryKeyShareRecoveryApprovals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (keyShareRecoveryApprovalL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeKeyShareRecoveryApproval interface{}, mods queries.Applicator) error {
+	var slice []*KeyShareRecoveryApproval
+	var object *KeyShareRecoveryApproval
+
+	if singular {
+		var ok bool
+		object, ok = maybeKeyShareRecoveryApproval.(*KeyShareRecoveryApproval)
+		if !ok {
+			object = new(KeyShareRecoveryApproval)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeKeyShareRecoveryApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeKeyShareRecoveryApproval))
+			}
+		}
+	} else {
+		s, ok := maybeKeyShareRecoveryApproval.(*[]*KeyShareRecoveryApproval)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeKeyShareRecoveryApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeKeyShareRecoveryApproval))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &keyShareRecoveryApprovalR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &keyShareRecoveryApprovalR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.KeyShareRecoveryApprovals = append(foreign.R.KeyShareRecoveryApprovals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.KeyShareRecoveryApprovals = append(foreign.R.KeyShareRecoveryApprovals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRecovery of the keyShareRecoveryApproval to the related item.
+// Sets o.R.Recovery to related.
+// Adds o to related.R.RecoveryKeyShareRecoveryApprovals.
+func (o *KeyShareRecoveryApproval) SetRecovery(ctx context.Context, exec boil.ContextExecutor, insert bool, related *KeyShareRecovery) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_recovery_approvals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"recovery_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryApprovalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RecoveryID = related.ID
+	if o.R == nil {
+		o.R = &keyShareRecoveryApprovalR{
+			Recovery: related,
+		}
+	} else {
+		o.R.Recovery = related
+	}
+
+	if related.R == nil {
+		related.R = &keyShareRecoveryR{
+			RecoveryKeyShareRecoveryApprovals: KeyShareRecoveryApprovalSlice{o},
+		}
+	} else {
+		related.R.RecoveryKeyShareRecoveryApprovals = append(related.R.RecoveryKeyShareRecoveryApprovals, o)
+	}
+
+	return nil
+}
+
+// SetUser of the keyShareRecoveryApproval to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.KeyShareRecoveryApprovals.
+func (o *KeyShareRecoveryApproval) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"key_share_recovery_approvals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryApprovalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &keyShareRecoveryApprovalR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			KeyShareRecoveryApprovals: KeyShareRecoveryApprovalSlice{o},
+		}
+	} else {
+		related.R.KeyShareRecoveryApprovals = append(related.R.KeyShareRecoveryApprovals, o)
+	}
+
+	return nil
+}
+
+// KeyShareRecoveryApprovals retrieves all the records using an executor.
+func KeyShareRecoveryApprovals(mods ...qm.QueryMod) keyShareRecoveryApprovalQuery {
+	mods = append(mods, qm.From("\"key_share_recovery_approvals\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"key_share_recovery_approvals\".*"})
+	}
+
+	return keyShareRecoveryApprovalQuery{q}
+}
+
+// FindKeyShareRecoveryApproval retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindKeyShareRecoveryApproval(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*KeyShareRecoveryApproval, error) {
+	keyShareRecoveryApprovalObj := &KeyShareRecoveryApproval{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"key_share_recovery_approvals\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, keyShareRecoveryApprovalObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from key_share_recovery_approvals")
+	}
+
+	return keyShareRecoveryApprovalObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *KeyShareRecoveryApproval) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no key_share_recovery_approvals provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyShareRecoveryApprovalColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	keyShareRecoveryApprovalInsertCacheMut.RLock()
+	cache, cached := keyShareRecoveryApprovalInsertCache[key]
+	keyShareRecoveryApprovalInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			keyShareRecoveryApprovalAllColumns,
+			keyShareRecoveryApprovalColumnsWithDefault,
+			keyShareRecoveryApprovalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(keyShareRecoveryApprovalType, keyShareRecoveryApprovalMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(keyShareRecoveryApprovalType, keyShareRecoveryApprovalMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"key_share_recovery_approvals\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"key_share_recovery_approvals\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into key_share_recovery_approvals")
+	}
+
+	if !cached {
+		keyShareRecoveryApprovalInsertCacheMut.Lock()
+		keyShareRecoveryApprovalInsertCache[key] = cache
+		keyShareRecoveryApprovalInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the KeyShareRecoveryApproval.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *KeyShareRecoveryApproval) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	keyShareRecoveryApprovalUpdateCacheMut.RLock()
+	cache, cached := keyShareRecoveryApprovalUpdateCache[key]
+	keyShareRecoveryApprovalUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			keyShareRecoveryApprovalAllColumns,
+			keyShareRecoveryApprovalPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update key_share_recovery_approvals, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"key_share_recovery_approvals\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, keyShareRecoveryApprovalPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(keyShareRecoveryApprovalType, keyShareRecoveryApprovalMapping, append(wl, keyShareRecoveryApprovalPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update key_share_recovery_approvals row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for key_share_recovery_approvals")
+	}
+
+	if !cached {
+		keyShareRecoveryApprovalUpdateCacheMut.Lock()
+		keyShareRecoveryApprovalUpdateCache[key] = cache
+		keyShareRecoveryApprovalUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q keyShareRecoveryApprovalQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for key_share_recovery_approvals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for key_share_recovery_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o KeyShareRecoveryApprovalSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareRecoveryApprovalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"key_share_recovery_approvals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, keyShareRecoveryApprovalPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in keyShareRecoveryApproval slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all keyShareRecoveryApproval")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *KeyShareRecoveryApproval) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no key_share_recovery_approvals provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(keyShareRecoveryApprovalColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	keyShareRecoveryApprovalUpsertCacheMut.RLock()
+	cache, cached := keyShareRecoveryApprovalUpsertCache[key]
+	keyShareRecoveryApprovalUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			keyShareRecoveryApprovalAllColumns,
+			keyShareRecoveryApprovalColumnsWithDefault,
+			keyShareRecoveryApprovalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			keyShareRecoveryApprovalAllColumns,
+			keyShareRecoveryApprovalPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert key_share_recovery_approvals, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(keyShareRecoveryApprovalAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(keyShareRecoveryApprovalPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert key_share_recovery_approvals, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(keyShareRecoveryApprovalPrimaryKeyColumns))
+			copy(conflict, keyShareRecoveryApprovalPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"key_share_recovery_approvals\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(keyShareRecoveryApprovalType, keyShareRecoveryApprovalMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(keyShareRecoveryApprovalType, keyShareRecoveryApprovalMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert key_share_recovery_approvals")
+	}
+
+	if !cached {
+		keyShareRecoveryApprovalUpsertCacheMut.Lock()
+		keyShareRecoveryApprovalUpsertCache[key] = cache
+		keyShareRecoveryApprovalUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single KeyShareRecoveryApproval record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *KeyShareRecoveryApproval) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no KeyShareRecoveryApproval provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), keyShareRecoveryApprovalPrimaryKeyMapping)
+	sql := "DELETE FROM \"key_share_recovery_approvals\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from key_share_recovery_approvals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for key_share_recovery_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q keyShareRecoveryApprovalQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no keyShareRecoveryApprovalQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from key_share_recovery_approvals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_share_recovery_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o KeyShareRecoveryApprovalSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareRecoveryApprovalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"key_share_recovery_approvals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyShareRecoveryApprovalPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from keyShareRecoveryApproval slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for key_share_recovery_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *KeyShareRecoveryApproval) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindKeyShareRecoveryApproval(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *KeyShareRecoveryApprovalSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := KeyShareRecoveryApprovalSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), keyShareRecoveryApprovalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"key_share_recovery_approvals\".* FROM \"key_share_recovery_approvals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, keyShareRecoveryApprovalPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in KeyShareRecoveryApprovalSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// KeyShareRecoveryApprovalExists checks if the KeyShareRecoveryApproval row exists.
+func KeyShareRecoveryApprovalExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"key_share_recovery_approvals\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if key_share_recovery_approvals exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the KeyShareRecoveryApproval row exists.
+func (o *KeyShareRecoveryApproval) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return KeyShareRecoveryApprovalExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,810 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testKeyShareRecoveryApprovals(t *testing.T) {
+	t.Parallel()
+
+	query := KeyShareRecoveryApprovals()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testKeyShareRecoveryApprovalsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyShareRecoveryApprovalsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := KeyShareRecoveryApprovals().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyShareRecoveryApprovalsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyShareRecoveryApprovalSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testKeyShareRecoveryApprovalsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := KeyShareRecoveryApprovalExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if KeyShareRecoveryApproval exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected KeyShareRecoveryApprovalExists to return true, but got false.")
+	}
+}
+
+func testKeyShareRecoveryApprovalsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	keyShareRecoveryApprovalFound, err := FindKeyShareRecoveryApproval(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if keyShareRecoveryApprovalFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testKeyShareRecoveryApprovalsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = KeyShareRecoveryApprovals().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyShareRecoveryApprovalsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := KeyShareRecoveryApprovals().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testKeyShareRecoveryApprovalsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	keyShareRecoveryApprovalOne := &KeyShareRecoveryApproval{}
+	keyShareRecoveryApprovalTwo := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, keyShareRecoveryApprovalOne, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyShareRecoveryApprovalTwo, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyShareRecoveryApprovalOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyShareRecoveryApprovalTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyShareRecoveryApprovals().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testKeyShareRecoveryApprovalsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	keyShareRecoveryApprovalOne := &KeyShareRecoveryApproval{}
+	keyShareRecoveryApprovalTwo := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, keyShareRecoveryApprovalOne, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+	if err = randomize.Struct(seed, keyShareRecoveryApprovalTwo, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = keyShareRecoveryApprovalOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = keyShareRecoveryApprovalTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testKeyShareRecoveryApprovalsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyShareRecoveryApprovalsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(keyShareRecoveryApprovalPrimaryKeyColumns, keyShareRecoveryApprovalColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testKeyShareRecoveryApprovalToOneKeyShareRecoveryUsingRecovery(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyShareRecoveryApproval
+	var foreign KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecovery struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RecoveryID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Recovery().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyShareRecoveryApprovalSlice{&local}
+	if err = local.L.LoadRecovery(ctx, tx, false, (*[]*KeyShareRecoveryApproval)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Recovery == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Recovery = nil
+	if err = local.L.LoadRecovery(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Recovery == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyShareRecoveryApprovalToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local KeyShareRecoveryApproval
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := KeyShareRecoveryApprovalSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*KeyShareRecoveryApproval)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testKeyShareRecoveryApprovalToOneSetOpKeyShareRecoveryUsingRecovery(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecoveryApproval
+	var b, c KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryApprovalDBTypes, false, strmangle.SetComplement(keyShareRecoveryApprovalPrimaryKeyColumns, keyShareRecoveryApprovalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*KeyShareRecovery{&b, &c} {
+		err = a.SetRecovery(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Recovery != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RecoveryKeyShareRecoveryApprovals[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RecoveryID != x.ID {
+			t.Error("foreign key was wrong value", a.RecoveryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RecoveryID))
+		reflect.Indirect(reflect.ValueOf(&a.RecoveryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.RecoveryID != x.ID {
+			t.Error("foreign key was wrong value", a.RecoveryID, x.ID)
+		}
+	}
+}
+func testKeyShareRecoveryApprovalToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a KeyShareRecoveryApproval
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, keyShareRecoveryApprovalDBTypes, false, strmangle.SetComplement(keyShareRecoveryApprovalPrimaryKeyColumns, keyShareRecoveryApprovalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.KeyShareRecoveryApprovals[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testKeyShareRecoveryApprovalsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyShareRecoveryApprovalsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := KeyShareRecoveryApprovalSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testKeyShareRecoveryApprovalsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := KeyShareRecoveryApprovals().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	keyShareRecoveryApprovalDBTypes = map[string]string{`ID`: `uuid`, `RecoveryID`: `uuid`, `UserID`: `uuid`, `Action`: `character varying`, `CredentialID`: `text`, `CreatedAt`: `timestamp with time zone`}
+	_                               = bytes.MinRead
+)
+
+func testKeyShareRecoveryApprovalsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(keyShareRecoveryApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(keyShareRecoveryApprovalAllColumns) == len(keyShareRecoveryApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testKeyShareRecoveryApprovalsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(keyShareRecoveryApprovalAllColumns) == len(keyShareRecoveryApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, keyShareRecoveryApprovalDBTypes, true, keyShareRecoveryApprovalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(keyShareRecoveryApprovalAllColumns, keyShareRecoveryApprovalPrimaryKeyColumns) {
+		fields = keyShareRecoveryApprovalAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			keyShareRecoveryApprovalAllColumns,
+			keyShareRecoveryApprovalPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := KeyShareRecoveryApprovalSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testKeyShareRecoveryApprovalsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(keyShareRecoveryApprovalAllColumns) == len(keyShareRecoveryApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := KeyShareRecoveryApproval{}
+	if err = randomize.Struct(seed, &o, keyShareRecoveryApprovalDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyShareRecoveryApproval: %s", err)
+	}
+
+	count, err := KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize KeyShareRecoveryApproval struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert KeyShareRecoveryApproval: %s", err)
+	}
+
+	count, err = KeyShareRecoveryApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1412 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OrganizationInvitation is an object representing the database table.
+type OrganizationInvitation struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrganizationID string      `boil:"organization_id" json:"organization_id" toml:"organization_id" yaml:"organization_id"`
+	Token          string      `boil:"token" json:"token" toml:"token" yaml:"token"`
+	Email          string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Role           string      `boil:"role" json:"role" toml:"role" yaml:"role"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	InvitedBy      null.String `boil:"invited_by" json:"invited_by,omitempty" toml:"invited_by" yaml:"invited_by,omitempty"`
+	AcceptedBy     null.String `boil:"accepted_by" json:"accepted_by,omitempty" toml:"accepted_by" yaml:"accepted_by,omitempty"`
+	ValidUntil     time.Time   `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	AcceptedAt     null.Time   `boil:"accepted_at" json:"accepted_at,omitempty" toml:"accepted_at" yaml:"accepted_at,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *organizationInvitationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L organizationInvitationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrganizationInvitationColumns = struct {
+	ID             string
+	OrganizationID string
+	Token          string
+	Email          string
+	Role           string
+	Status         string
+	InvitedBy      string
+	AcceptedBy     string
+	ValidUntil     string
+	AcceptedAt     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	OrganizationID: "organization_id",
+	Token:          "token",
+	Email:          "email",
+	Role:           "role",
+	Status:         "status",
+	InvitedBy:      "invited_by",
+	AcceptedBy:     "accepted_by",
+	ValidUntil:     "valid_until",
+	AcceptedAt:     "accepted_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var OrganizationInvitationTableColumns = struct {
+	ID             string
+	OrganizationID string
+	Token          string
+	Email          string
+	Role           string
+	Status         string
+	InvitedBy      string
+	AcceptedBy     string
+	ValidUntil     string
+	AcceptedAt     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "organization_invitations.id",
+	OrganizationID: "organization_invitations.organization_id",
+	Token:          "organization_invitations.token",
+	Email:          "organization_invitations.email",
+	Role:           "organization_invitations.role",
+	Status:         "organization_invitations.status",
+	InvitedBy:      "organization_invitations.invited_by",
+	AcceptedBy:     "organization_invitations.accepted_by",
+	ValidUntil:     "organization_invitations.valid_until",
+	AcceptedAt:     "organization_invitations.accepted_at",
+	CreatedAt:      "organization_invitations.created_at",
+	UpdatedAt:      "organization_invitations.updated_at",
+}
+
+// Generated where
+
+var OrganizationInvitationWhere = struct {
+	ID             whereHelperstring
+	OrganizationID whereHelperstring
+	Token          whereHelperstring
+	Email          whereHelperstring
+	Role           whereHelperstring
+	Status         whereHelperstring
+	InvitedBy      whereHelpernull_String
+	AcceptedBy     whereHelpernull_String
+	ValidUntil     whereHelpertime_Time
+	AcceptedAt     whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"organization_invitations\".\"id\""},
+	OrganizationID: whereHelperstring{field: "\"organization_invitations\".\"organization_id\""},
+	Token:          whereHelperstring{field: "\"organization_invitations\".\"token\""},
+	Email:          whereHelperstring{field: "\"organization_invitations\".\"email\""},
+	Role:           whereHelperstring{field: "\"organization_invitations\".\"role\""},
+	Status:         whereHelperstring{field: "\"organization_invitations\".\"status\""},
+	InvitedBy:      whereHelpernull_String{field: "\"organization_invitations\".\"invited_by\""},
+	AcceptedBy:     whereHelpernull_String{field: "\"organization_invitations\".\"accepted_by\""},
+	ValidUntil:     whereHelpertime_Time{field: "\"organization_invitations\".\"valid_until\""},
+	AcceptedAt:     whereHelpernull_Time{field: "\"organization_invitations\".\"accepted_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"organization_invitations\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"organization_invitations\".\"updated_at\""},
+}
+
+// OrganizationInvitationRels is where relationship names are stored.
+var OrganizationInvitationRels = struct {
+	AcceptedByUser string
+	InvitedByUser  string
+	Organization   string
+}{
+	AcceptedByUser: "AcceptedByUser",
+	InvitedByUser:  "InvitedByUser",
+	Organization:   "Organization",
+}
+
+// organizationInvitationR is where relationships are stored.
+type organizationInvitationR struct {
+	AcceptedByUser *User         `boil:"AcceptedByUser" json:"AcceptedByUser" toml:"AcceptedByUser" yaml:"AcceptedByUser"`
+	InvitedByUser  *User         `boil:"InvitedByUser" json:"InvitedByUser" toml:"InvitedByUser" yaml:"InvitedByUser"`
+	Organization   *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+}
+
+// NewStruct creates a new relationship struct
+func (*organizationInvitationR) NewStruct() *organizationInvitationR {
+	return &organizationInvitationR{}
+}
+
+func (o *OrganizationInvitation) GetAcceptedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAcceptedByUser()
+}
+
+func (r *organizationInvitationR) GetAcceptedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.AcceptedByUser
+}
+
+func (o *OrganizationInvitation) GetInvitedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInvitedByUser()
+}
+
+func (r *organizationInvitationR) GetInvitedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.InvitedByUser
+}
+
+func (o *OrganizationInvitation) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganization()
+}
+
+func (r *organizationInvitationR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.Organization
+}
+
+// organizationInvitationL is where Load methods for each relationship are stored.
+type organizationInvitationL struct{}
+
+var (
+	organizationInvitationAllColumns            = []string{"id", "organization_id", "token", "email", "role", "status", "invited_by", "accepted_by", "valid_until", "accepted_at", "created_at", "updated_at"}
+	organizationInvitationColumnsWithoutDefault = []string{"organization_id", "email", "role", "valid_until"}
+	organizationInvitationColumnsWithDefault    = []string{"id", "token", "status", "invited_by", "accepted_by", "accepted_at", "created_at", "updated_at"}
+	organizationInvitationPrimaryKeyColumns     = []string{"id"}
+	organizationInvitationGeneratedColumns      = []string{}
+)
+
+type (
+	// OrganizationInvitationSlice is an alias for a slice of pointers to OrganizationInvitation.
+	// This should almost always be used instead of []OrganizationInvitation.
+	OrganizationInvitationSlice []*OrganizationInvitation
+
+	organizationInvitationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	organizationInvitationType                 = reflect.TypeOf(&OrganizationInvitation{})
+	organizationInvitationMapping              = queries.MakeStructMapping(organizationInvitationType)
+	organizationInvitationPrimaryKeyMapping, _ = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, organizationInvitationPrimaryKeyColumns)
+	organizationInvitationInsertCacheMut       sync.RWMutex
+	organizationInvitationInsertCache          = make(map[string]insertCache)
+	organizationInvitationUpdateCacheMut       sync.RWMutex
+	organizationInvitationUpdateCache          = make(map[string]updateCache)
+	organizationInvitationUpsertCacheMut       sync.RWMutex
+	organizationInvitationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single organizationInvitation record from the query.
+func (q organizationInvitationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OrganizationInvitation, error) {
+	o := &OrganizationInvitation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for organization_invitations")
+	}
+
+	return o, nil
+}
+
+// All returns all OrganizationInvitation records from the query.
+func (q organizationInvitationQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrganizationInvitationSlice, error) {
+	var o []*OrganizationInvitation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OrganizationInvitation slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OrganizationInvitation records in the query.
+func (q organizationInvitationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count organization_invitations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q organizationInvitationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if organization_invitations exists")
+	}
+
+	return count > 0, nil
+}
+
+// AcceptedByUser pointed to by the foreign key.
+func (o *OrganizationInvitation) AcceptedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AcceptedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// InvitedByUser pointed to by the foreign key.
+func (o *OrganizationInvitation) InvitedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InvitedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Organization pointed to by the foreign key.
+func (o *OrganizationInvitation) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// LoadAcceptedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationInvitationL) LoadAcceptedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganizationInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationInvitation
+	var object *OrganizationInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationInvitation.(*OrganizationInvitation)
+		if !ok {
+			object = new(OrganizationInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationInvitation.(*[]*OrganizationInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationInvitationR{}
+		}
+		if !queries.IsNil(object.AcceptedBy) {
+			args[object.AcceptedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationInvitationR{}
+			}
+
+			if !queries.IsNil(obj.AcceptedBy) {
+				args[obj.AcceptedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AcceptedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AcceptedByOrganizationInvitations = append(foreign.R.AcceptedByOrganizationInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AcceptedBy, foreign.ID) {
+				local.R.AcceptedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AcceptedByOrganizationInvitations = append(foreign.R.AcceptedByOrganizationInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationInvitationL) LoadInvitedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganizationInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationInvitation
+	var object *OrganizationInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationInvitation.(*OrganizationInvitation)
+		if !ok {
+			object = new(OrganizationInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationInvitation.(*[]*OrganizationInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationInvitationR{}
+		}
+		if !queries.IsNil(object.InvitedBy) {
+			args[object.InvitedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationInvitationR{}
+			}
+
+			if !queries.IsNil(obj.InvitedBy) {
+				args[obj.InvitedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.InvitedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InvitedByOrganizationInvitations = append(foreign.R.InvitedByOrganizationInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.InvitedBy, foreign.ID) {
+				local.R.InvitedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InvitedByOrganizationInvitations = append(foreign.R.InvitedByOrganizationInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationInvitationL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganizationInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationInvitation
+	var object *OrganizationInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationInvitation.(*OrganizationInvitation)
+		if !ok {
+			object = new(OrganizationInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationInvitation.(*[]*OrganizationInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationInvitationR{}
+		}
+		args[object.OrganizationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationInvitationR{}
+			}
+
+			args[obj.OrganizationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.OrganizationInvitations = append(foreign.R.OrganizationInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrganizationID == foreign.ID {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.OrganizationInvitations = append(foreign.R.OrganizationInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAcceptedByUser of the organizationInvitation to the related item.
+// Sets o.R.AcceptedByUser to related.
+// Adds o to related.R.AcceptedByOrganizationInvitations.
+func (o *OrganizationInvitation) SetAcceptedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"accepted_by"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AcceptedBy, related.ID)
+	if o.R == nil {
+		o.R = &organizationInvitationR{
+			AcceptedByUser: related,
+		}
+	} else {
+		o.R.AcceptedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AcceptedByOrganizationInvitations: OrganizationInvitationSlice{o},
+		}
+	} else {
+		related.R.AcceptedByOrganizationInvitations = append(related.R.AcceptedByOrganizationInvitations, o)
+	}
+
+	return nil
+}
+
+// RemoveAcceptedByUser relationship.
+// Sets o.R.AcceptedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *OrganizationInvitation) RemoveAcceptedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AcceptedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("accepted_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AcceptedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AcceptedByOrganizationInvitations {
+		if queries.Equal(o.AcceptedBy, ri.AcceptedBy) {
+			continue
+		}
+
+		ln := len(related.R.AcceptedByOrganizationInvitations)
+		if ln > 1 && i < ln-1 {
+			related.R.AcceptedByOrganizationInvitations[i] = related.R.AcceptedByOrganizationInvitations[ln-1]
+		}
+		related.R.AcceptedByOrganizationInvitations = related.R.AcceptedByOrganizationInvitations[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetInvitedByUser of the organizationInvitation to the related item.
+// Sets o.R.InvitedByUser to related.
+// Adds o to related.R.InvitedByOrganizationInvitations.
+func (o *OrganizationInvitation) SetInvitedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"invited_by"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.InvitedBy, related.ID)
+	if o.R == nil {
+		o.R = &organizationInvitationR{
+			InvitedByUser: related,
+		}
+	} else {
+		o.R.InvitedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InvitedByOrganizationInvitations: OrganizationInvitationSlice{o},
+		}
+	} else {
+		related.R.InvitedByOrganizationInvitations = append(related.R.InvitedByOrganizationInvitations, o)
+	}
+
+	return nil
+}
+
+// RemoveInvitedByUser relationship.
+// Sets o.R.InvitedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *OrganizationInvitation) RemoveInvitedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.InvitedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("invited_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.InvitedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.InvitedByOrganizationInvitations {
+		if queries.Equal(o.InvitedBy, ri.InvitedBy) {
+			continue
+		}
+
+		ln := len(related.R.InvitedByOrganizationInvitations)
+		if ln > 1 && i < ln-1 {
+			related.R.InvitedByOrganizationInvitations[i] = related.R.InvitedByOrganizationInvitations[ln-1]
+		}
+		related.R.InvitedByOrganizationInvitations = related.R.InvitedByOrganizationInvitations[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetOrganization of the organizationInvitation to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.OrganizationInvitations.
+func (o *OrganizationInvitation) SetOrganization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrganizationID = related.ID
+	if o.R == nil {
+		o.R = &organizationInvitationR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			OrganizationInvitations: OrganizationInvitationSlice{o},
+		}
+	} else {
+		related.R.OrganizationInvitations = append(related.R.OrganizationInvitations, o)
+	}
+
+	return nil
+}
+
+// OrganizationInvitations retrieves all the records using an executor.
+func OrganizationInvitations(mods ...qm.QueryMod) organizationInvitationQuery {
+	mods = append(mods, qm.From("\"organization_invitations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"organization_invitations\".*"})
+	}
+
+	return organizationInvitationQuery{q}
+}
+
+// FindOrganizationInvitation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrganizationInvitation(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OrganizationInvitation, error) {
+	organizationInvitationObj := &OrganizationInvitation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"organization_invitations\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, organizationInvitationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from organization_invitations")
+	}
+
+	return organizationInvitationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrganizationInvitation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no organization_invitations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationInvitationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	organizationInvitationInsertCacheMut.RLock()
+	cache, cached := organizationInvitationInsertCache[key]
+	organizationInvitationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationColumnsWithDefault,
+			organizationInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"organization_invitations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"organization_invitations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into organization_invitations")
+	}
+
+	if !cached {
+		organizationInvitationInsertCacheMut.Lock()
+		organizationInvitationInsertCache[key] = cache
+		organizationInvitationInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the OrganizationInvitation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrganizationInvitation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	organizationInvitationUpdateCacheMut.RLock()
+	cache, cached := organizationInvitationUpdateCache[key]
+	organizationInvitationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update organization_invitations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"organization_invitations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, organizationInvitationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, append(wl, organizationInvitationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update organization_invitations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for organization_invitations")
+	}
+
+	if !cached {
+		organizationInvitationUpdateCacheMut.Lock()
+		organizationInvitationUpdateCache[key] = cache
+		organizationInvitationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q organizationInvitationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for organization_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for organization_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrganizationInvitationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"organization_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, organizationInvitationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in organizationInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all organizationInvitation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OrganizationInvitation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no organization_invitations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationInvitationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	organizationInvitationUpsertCacheMut.RLock()
+	cache, cached := organizationInvitationUpsertCache[key]
+	organizationInvitationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationColumnsWithDefault,
+			organizationInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert organization_invitations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(organizationInvitationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(organizationInvitationPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert organization_invitations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(organizationInvitationPrimaryKeyColumns))
+			copy(conflict, organizationInvitationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"organization_invitations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert organization_invitations")
+	}
+
+	if !cached {
+		organizationInvitationUpsertCacheMut.Lock()
+		organizationInvitationUpsertCache[key] = cache
+		organizationInvitationUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single OrganizationInvitation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrganizationInvitation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OrganizationInvitation provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), organizationInvitationPrimaryKeyMapping)
+	sql := "DELETE FROM \"organization_invitations\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from organization_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for organization_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q organizationInvitationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no organizationInvitationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from organization_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for organization_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrganizationInvitationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"organization_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationInvitationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from organizationInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for organization_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrganizationInvitation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrganizationInvitation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationInvitationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrganizationInvitationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"organization_invitations\".* FROM \"organization_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationInvitationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OrganizationInvitationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrganizationInvitationExists checks if the OrganizationInvitation row exists.
+func OrganizationInvitationExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"organization_invitations\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if organization_invitations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OrganizationInvitation row exists.
+func (o *OrganizationInvitation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OrganizationInvitationExists(ctx, exec, o.ID)
+}
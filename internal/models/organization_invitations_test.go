@@ -0,0 +1,1023 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrganizationInvitations(t *testing.T) {
+	t.Parallel()
+
+	query := OrganizationInvitations()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrganizationInvitationsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrganizationInvitationsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OrganizationInvitations().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrganizationInvitationsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrganizationInvitationSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrganizationInvitationsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrganizationInvitationExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OrganizationInvitation exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrganizationInvitationExists to return true, but got false.")
+	}
+}
+
+func testOrganizationInvitationsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	organizationInvitationFound, err := FindOrganizationInvitation(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if organizationInvitationFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrganizationInvitationsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OrganizationInvitations().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrganizationInvitationsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OrganizationInvitations().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrganizationInvitationsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	organizationInvitationOne := &OrganizationInvitation{}
+	organizationInvitationTwo := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, organizationInvitationOne, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, organizationInvitationTwo, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = organizationInvitationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = organizationInvitationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrganizationInvitations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrganizationInvitationsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	organizationInvitationOne := &OrganizationInvitation{}
+	organizationInvitationTwo := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, organizationInvitationOne, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, organizationInvitationTwo, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = organizationInvitationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = organizationInvitationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testOrganizationInvitationsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrganizationInvitationsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrganizationInvitationToOneUserUsingAcceptedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OrganizationInvitation
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AcceptedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.AcceptedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OrganizationInvitationSlice{&local}
+	if err = local.L.LoadAcceptedByUser(ctx, tx, false, (*[]*OrganizationInvitation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.AcceptedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.AcceptedByUser = nil
+	if err = local.L.LoadAcceptedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.AcceptedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testOrganizationInvitationToOneUserUsingInvitedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OrganizationInvitation
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.InvitedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.InvitedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OrganizationInvitationSlice{&local}
+	if err = local.L.LoadInvitedByUser(ctx, tx, false, (*[]*OrganizationInvitation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.InvitedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.InvitedByUser = nil
+	if err = local.L.LoadInvitedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.InvitedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testOrganizationInvitationToOneOrganizationUsingOrganization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OrganizationInvitation
+	var foreign Organization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OrganizationID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Organization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OrganizationInvitationSlice{&local}
+	if err = local.L.LoadOrganization(ctx, tx, false, (*[]*OrganizationInvitation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Organization = nil
+	if err = local.L.LoadOrganization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testOrganizationInvitationToOneSetOpUserUsingAcceptedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrganizationInvitation
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetAcceptedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.AcceptedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AcceptedByOrganizationInvitations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AcceptedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.AcceptedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AcceptedBy))
+		reflect.Indirect(reflect.ValueOf(&a.AcceptedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AcceptedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.AcceptedBy, x.ID)
+		}
+	}
+}
+
+func testOrganizationInvitationToOneRemoveOpUserUsingAcceptedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrganizationInvitation
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAcceptedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAcceptedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.AcceptedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.AcceptedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AcceptedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AcceptedByOrganizationInvitations) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testOrganizationInvitationToOneSetOpUserUsingInvitedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrganizationInvitation
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetInvitedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.InvitedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.InvitedByOrganizationInvitations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.InvitedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.InvitedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.InvitedBy))
+		reflect.Indirect(reflect.ValueOf(&a.InvitedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.InvitedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.InvitedBy, x.ID)
+		}
+	}
+}
+
+func testOrganizationInvitationToOneRemoveOpUserUsingInvitedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrganizationInvitation
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetInvitedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveInvitedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.InvitedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.InvitedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.InvitedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.InvitedByOrganizationInvitations) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testOrganizationInvitationToOneSetOpOrganizationUsingOrganization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OrganizationInvitation
+	var b, c Organization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Organization{&b, &c} {
+		err = a.SetOrganization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Organization != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OrganizationInvitations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OrganizationID != x.ID {
+			t.Error("foreign key was wrong value", a.OrganizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrganizationID))
+		reflect.Indirect(reflect.ValueOf(&a.OrganizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.OrganizationID != x.ID {
+			t.Error("foreign key was wrong value", a.OrganizationID, x.ID)
+		}
+	}
+}
+
+func testOrganizationInvitationsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrganizationInvitationsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrganizationInvitationSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrganizationInvitationsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrganizationInvitations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	organizationInvitationDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `Token`: `uuid`, `Email`: `character varying`, `Role`: `character varying`, `Status`: `character varying`, `InvitedBy`: `uuid`, `AcceptedBy`: `uuid`, `ValidUntil`: `timestamp with time zone`, `AcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                             = bytes.MinRead
+)
+
+func testOrganizationInvitationsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(organizationInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(organizationInvitationAllColumns) == len(organizationInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrganizationInvitationsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(organizationInvitationAllColumns) == len(organizationInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrganizationInvitation{}
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, organizationInvitationDBTypes, true, organizationInvitationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(organizationInvitationAllColumns, organizationInvitationPrimaryKeyColumns) {
+		fields = organizationInvitationAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			organizationInvitationAllColumns,
+			organizationInvitationPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrganizationInvitationSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOrganizationInvitationsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(organizationInvitationAllColumns) == len(organizationInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OrganizationInvitation{}
+	if err = randomize.Struct(seed, &o, organizationInvitationDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrganizationInvitation: %s", err)
+	}
+
+	count, err := OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, organizationInvitationDBTypes, false, organizationInvitationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrganizationInvitation struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrganizationInvitation: %s", err)
+	}
+
+	count, err = OrganizationInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -80,26 +80,53 @@ var OrganizationWhere = struct {
 
 // OrganizationRels is where relationship names are stored.
 var OrganizationRels = struct {
-	Owner               string
-	AddressBooks        string
-	AuditLogs           string
-	OrganizationMembers string
-	Vaults              string
+	Owner                              string
+	AddressBooks                       string
+	DefaultOrganizationAppUserProfiles string
+	AuditCheckpoints                   string
+	AuditLogs                          string
+	KeyBackupHealths                   string
+	KeyShareBackups                    string
+	KeyShareRecoveries                 string
+	NotificationPreferences            string
+	OrganizationInvitations            string
+	OrganizationMembers                string
+	OutboxEvents                       string
+	Vaults                             string
+	WebhookEndpoints                   string
 }{
-	Owner:               "Owner",
-	AddressBooks:        "AddressBooks",
-	AuditLogs:           "AuditLogs",
-	OrganizationMembers: "OrganizationMembers",
-	Vaults:              "Vaults",
+	Owner:                              "Owner",
+	AddressBooks:                       "AddressBooks",
+	DefaultOrganizationAppUserProfiles: "DefaultOrganizationAppUserProfiles",
+	AuditCheckpoints:                   "AuditCheckpoints",
+	AuditLogs:                          "AuditLogs",
+	KeyBackupHealths:                   "KeyBackupHealths",
+	KeyShareBackups:                    "KeyShareBackups",
+	KeyShareRecoveries:                 "KeyShareRecoveries",
+	NotificationPreferences:            "NotificationPreferences",
+	OrganizationInvitations:            "OrganizationInvitations",
+	OrganizationMembers:                "OrganizationMembers",
+	OutboxEvents:                       "OutboxEvents",
+	Vaults:                             "Vaults",
+	WebhookEndpoints:                   "WebhookEndpoints",
 }
 
 // organizationR is where relationships are stored.
 type organizationR struct {
-	Owner               *User                   `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
-	AddressBooks        AddressBookSlice        `boil:"AddressBooks" json:"AddressBooks" toml:"AddressBooks" yaml:"AddressBooks"`
-	AuditLogs           AuditLogSlice           `boil:"AuditLogs" json:"AuditLogs" toml:"AuditLogs" yaml:"AuditLogs"`
-	OrganizationMembers OrganizationMemberSlice `boil:"OrganizationMembers" json:"OrganizationMembers" toml:"OrganizationMembers" yaml:"OrganizationMembers"`
-	Vaults              VaultSlice              `boil:"Vaults" json:"Vaults" toml:"Vaults" yaml:"Vaults"`
+	Owner                              *User                       `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	AddressBooks                       AddressBookSlice            `boil:"AddressBooks" json:"AddressBooks" toml:"AddressBooks" yaml:"AddressBooks"`
+	DefaultOrganizationAppUserProfiles AppUserProfileSlice         `boil:"DefaultOrganizationAppUserProfiles" json:"DefaultOrganizationAppUserProfiles" toml:"DefaultOrganizationAppUserProfiles" yaml:"DefaultOrganizationAppUserProfiles"`
+	AuditCheckpoints                   AuditCheckpointSlice        `boil:"AuditCheckpoints" json:"AuditCheckpoints" toml:"AuditCheckpoints" yaml:"AuditCheckpoints"`
+	AuditLogs                          AuditLogSlice               `boil:"AuditLogs" json:"AuditLogs" toml:"AuditLogs" yaml:"AuditLogs"`
+	KeyBackupHealths                   KeyBackupHealthSlice        `boil:"KeyBackupHealths" json:"KeyBackupHealths" toml:"KeyBackupHealths" yaml:"KeyBackupHealths"`
+	KeyShareBackups                    KeyShareBackupSlice         `boil:"KeyShareBackups" json:"KeyShareBackups" toml:"KeyShareBackups" yaml:"KeyShareBackups"`
+	KeyShareRecoveries                 KeyShareRecoverySlice       `boil:"KeyShareRecoveries" json:"KeyShareRecoveries" toml:"KeyShareRecoveries" yaml:"KeyShareRecoveries"`
+	NotificationPreferences            NotificationPreferenceSlice `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	OrganizationInvitations            OrganizationInvitationSlice `boil:"OrganizationInvitations" json:"OrganizationInvitations" toml:"OrganizationInvitations" yaml:"OrganizationInvitations"`
+	OrganizationMembers                OrganizationMemberSlice     `boil:"OrganizationMembers" json:"OrganizationMembers" toml:"OrganizationMembers" yaml:"OrganizationMembers"`
+	OutboxEvents                       OutboxEventSlice            `boil:"OutboxEvents" json:"OutboxEvents" toml:"OutboxEvents" yaml:"OutboxEvents"`
+	Vaults                             VaultSlice                  `boil:"Vaults" json:"Vaults" toml:"Vaults" yaml:"Vaults"`
+	WebhookEndpoints                   WebhookEndpointSlice        `boil:"WebhookEndpoints" json:"WebhookEndpoints" toml:"WebhookEndpoints" yaml:"WebhookEndpoints"`
 }
 
 // NewStruct creates a new relationship struct
@@ -139,6 +166,38 @@ func (r *organizationR) GetAddressBooks() AddressBookSlice {
 	return r.AddressBooks
 }
 
+func (o *Organization) GetDefaultOrganizationAppUserProfiles() AppUserProfileSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDefaultOrganizationAppUserProfiles()
+}
+
+func (r *organizationR) GetDefaultOrganizationAppUserProfiles() AppUserProfileSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.DefaultOrganizationAppUserProfiles
+}
+
+func (o *Organization) GetAuditCheckpoints() AuditCheckpointSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAuditCheckpoints()
+}
+
+func (r *organizationR) GetAuditCheckpoints() AuditCheckpointSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AuditCheckpoints
+}
+
 func (o *Organization) GetAuditLogs() AuditLogSlice {
 	if o == nil {
 		return nil
@@ -155,6 +214,86 @@ func (r *organizationR) GetAuditLogs() AuditLogSlice {
 	return r.AuditLogs
 }
 
+func (o *Organization) GetKeyBackupHealths() KeyBackupHealthSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetKeyBackupHealths()
+}
+
+func (r *organizationR) GetKeyBackupHealths() KeyBackupHealthSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.KeyBackupHealths
+}
+
+func (o *Organization) GetKeyShareBackups() KeyShareBackupSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetKeyShareBackups()
+}
+
+func (r *organizationR) GetKeyShareBackups() KeyShareBackupSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.KeyShareBackups
+}
+
+func (o *Organization) GetKeyShareRecoveries() KeyShareRecoverySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetKeyShareRecoveries()
+}
+
+func (r *organizationR) GetKeyShareRecoveries() KeyShareRecoverySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.KeyShareRecoveries
+}
+
+func (o *Organization) GetNotificationPreferences() NotificationPreferenceSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotificationPreferences()
+}
+
+func (r *organizationR) GetNotificationPreferences() NotificationPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.NotificationPreferences
+}
+
+func (o *Organization) GetOrganizationInvitations() OrganizationInvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganizationInvitations()
+}
+
+func (r *organizationR) GetOrganizationInvitations() OrganizationInvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OrganizationInvitations
+}
+
 func (o *Organization) GetOrganizationMembers() OrganizationMemberSlice {
 	if o == nil {
 		return nil
@@ -171,6 +310,22 @@ func (r *organizationR) GetOrganizationMembers() OrganizationMemberSlice {
 	return r.OrganizationMembers
 }
 
+func (o *Organization) GetOutboxEvents() OutboxEventSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOutboxEvents()
+}
+
+func (r *organizationR) GetOutboxEvents() OutboxEventSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OutboxEvents
+}
+
 func (o *Organization) GetVaults() VaultSlice {
 	if o == nil {
 		return nil
@@ -187,6 +342,22 @@ func (r *organizationR) GetVaults() VaultSlice {
 	return r.Vaults
 }
 
+func (o *Organization) GetWebhookEndpoints() WebhookEndpointSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebhookEndpoints()
+}
+
+func (r *organizationR) GetWebhookEndpoints() WebhookEndpointSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebhookEndpoints
+}
+
 // organizationL is where Load methods for each relationship are stored.
 type organizationL struct{}
 
@@ -314,6 +485,34 @@ func (o *Organization) AddressBooks(mods ...qm.QueryMod) addressBookQuery {
 	return AddressBooks(queryMods...)
 }
 
+// DefaultOrganizationAppUserProfiles retrieves all the app_user_profile's AppUserProfiles with an executor via default_organization_id column.
+func (o *Organization) DefaultOrganizationAppUserProfiles(mods ...qm.QueryMod) appUserProfileQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"app_user_profiles\".\"default_organization_id\"=?", o.ID),
+	)
+
+	return AppUserProfiles(queryMods...)
+}
+
+// AuditCheckpoints retrieves all the audit_checkpoint's AuditCheckpoints with an executor.
+func (o *Organization) AuditCheckpoints(mods ...qm.QueryMod) auditCheckpointQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"audit_checkpoints\".\"organization_id\"=?", o.ID),
+	)
+
+	return AuditCheckpoints(queryMods...)
+}
+
 // AuditLogs retrieves all the audit_log's AuditLogs with an executor.
 func (o *Organization) AuditLogs(mods ...qm.QueryMod) auditLogQuery {
 	var queryMods []qm.QueryMod
@@ -328,6 +527,76 @@ func (o *Organization) AuditLogs(mods ...qm.QueryMod) auditLogQuery {
 	return AuditLogs(queryMods...)
 }
 
+// KeyBackupHealths retrieves all the key_backup_health's KeyBackupHealths with an executor.
+func (o *Organization) KeyBackupHealths(mods ...qm.QueryMod) keyBackupHealthQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_backup_health\".\"organization_id\"=?", o.ID),
+	)
+
+	return KeyBackupHealths(queryMods...)
+}
+
+// KeyShareBackups retrieves all the key_share_backup's KeyShareBackups with an executor.
+func (o *Organization) KeyShareBackups(mods ...qm.QueryMod) keyShareBackupQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_share_backups\".\"organization_id\"=?", o.ID),
+	)
+
+	return KeyShareBackups(queryMods...)
+}
+
+// KeyShareRecoveries retrieves all the key_share_recovery's KeyShareRecoveries with an executor.
+func (o *Organization) KeyShareRecoveries(mods ...qm.QueryMod) keyShareRecoveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_share_recoveries\".\"organization_id\"=?", o.ID),
+	)
+
+	return KeyShareRecoveries(queryMods...)
+}
+
+// NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
+func (o *Organization) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_preferences\".\"organization_id\"=?", o.ID),
+	)
+
+	return NotificationPreferences(queryMods...)
+}
+
+// OrganizationInvitations retrieves all the organization_invitation's OrganizationInvitations with an executor.
+func (o *Organization) OrganizationInvitations(mods ...qm.QueryMod) organizationInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organization_invitations\".\"organization_id\"=?", o.ID),
+	)
+
+	return OrganizationInvitations(queryMods...)
+}
+
 // OrganizationMembers retrieves all the organization_member's OrganizationMembers with an executor.
 func (o *Organization) OrganizationMembers(mods ...qm.QueryMod) organizationMemberQuery {
 	var queryMods []qm.QueryMod
@@ -342,6 +611,20 @@ func (o *Organization) OrganizationMembers(mods ...qm.QueryMod) organizationMemb
 	return OrganizationMembers(queryMods...)
 }
 
+// OutboxEvents retrieves all the outbox_event's OutboxEvents with an executor.
+func (o *Organization) OutboxEvents(mods ...qm.QueryMod) outboxEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"outbox_events\".\"organization_id\"=?", o.ID),
+	)
+
+	return OutboxEvents(queryMods...)
+}
+
 // Vaults retrieves all the vault's Vaults with an executor.
 func (o *Organization) Vaults(mods ...qm.QueryMod) vaultQuery {
 	var queryMods []qm.QueryMod
@@ -356,6 +639,20 @@ func (o *Organization) Vaults(mods ...qm.QueryMod) vaultQuery {
 	return Vaults(queryMods...)
 }
 
+// WebhookEndpoints retrieves all the webhook_endpoint's WebhookEndpoints with an executor.
+func (o *Organization) WebhookEndpoints(mods ...qm.QueryMod) webhookEndpointQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_endpoints\".\"organization_id\"=?", o.ID),
+	)
+
+	return WebhookEndpoints(queryMods...)
+}
+
 // LoadOwner allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (organizationL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
@@ -574,9 +871,9 @@ func (organizationL) LoadAddressBooks(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// LoadAuditLogs allows an eager lookup of values, cached into the
+// LoadDefaultOrganizationAppUserProfiles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (organizationL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+func (organizationL) LoadDefaultOrganizationAppUserProfiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
 	var slice []*Organization
 	var object *Organization
 
@@ -629,8 +926,8 @@ func (organizationL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`audit_logs`),
-		qm.WhereIn(`audit_logs.organization_id in ?`, argsSlice...),
+		qm.From(`app_user_profiles`),
+		qm.WhereIn(`app_user_profiles.default_organization_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -638,40 +935,40 @@ func (organizationL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load audit_logs")
+		return errors.Wrap(err, "failed to eager load app_user_profiles")
 	}
 
-	var resultSlice []*AuditLog
+	var resultSlice []*AppUserProfile
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice audit_logs")
+		return errors.Wrap(err, "failed to bind eager loaded slice app_user_profiles")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on audit_logs")
+		return errors.Wrap(err, "failed to close results in eager load on app_user_profiles")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for app_user_profiles")
 	}
 
 	if singular {
-		object.R.AuditLogs = resultSlice
+		object.R.DefaultOrganizationAppUserProfiles = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &auditLogR{}
+				foreign.R = &appUserProfileR{}
 			}
-			foreign.R.Organization = object
+			foreign.R.DefaultOrganization = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.OrganizationID) {
-				local.R.AuditLogs = append(local.R.AuditLogs, foreign)
+			if queries.Equal(local.ID, foreign.DefaultOrganizationID) {
+				local.R.DefaultOrganizationAppUserProfiles = append(local.R.DefaultOrganizationAppUserProfiles, foreign)
 				if foreign.R == nil {
-					foreign.R = &auditLogR{}
+					foreign.R = &appUserProfileR{}
 				}
-				foreign.R.Organization = local
+				foreign.R.DefaultOrganization = local
 				break
 			}
 		}
@@ -680,9 +977,9 @@ func (organizationL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadOrganizationMembers allows an eager lookup of values, cached into the
+// LoadAuditCheckpoints allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (organizationL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+func (organizationL) LoadAuditCheckpoints(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
 	var slice []*Organization
 	var object *Organization
 
@@ -735,8 +1032,8 @@ func (organizationL) LoadOrganizationMembers(ctx context.Context, e boil.Context
 	}
 
 	query := NewQuery(
-		qm.From(`organization_members`),
-		qm.WhereIn(`organization_members.organization_id in ?`, argsSlice...),
+		qm.From(`audit_checkpoints`),
+		qm.WhereIn(`audit_checkpoints.organization_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -744,26 +1041,26 @@ func (organizationL) LoadOrganizationMembers(ctx context.Context, e boil.Context
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load organization_members")
+		return errors.Wrap(err, "failed to eager load audit_checkpoints")
 	}
 
-	var resultSlice []*OrganizationMember
+	var resultSlice []*AuditCheckpoint
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice organization_members")
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_checkpoints")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on organization_members")
+		return errors.Wrap(err, "failed to close results in eager load on audit_checkpoints")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_members")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_checkpoints")
 	}
 
 	if singular {
-		object.R.OrganizationMembers = resultSlice
+		object.R.AuditCheckpoints = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &organizationMemberR{}
+				foreign.R = &auditCheckpointR{}
 			}
 			foreign.R.Organization = object
 		}
@@ -772,10 +1069,10 @@ func (organizationL) LoadOrganizationMembers(ctx context.Context, e boil.Context
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OrganizationID {
-				local.R.OrganizationMembers = append(local.R.OrganizationMembers, foreign)
+			if queries.Equal(local.ID, foreign.OrganizationID) {
+				local.R.AuditCheckpoints = append(local.R.AuditCheckpoints, foreign)
 				if foreign.R == nil {
-					foreign.R = &organizationMemberR{}
+					foreign.R = &auditCheckpointR{}
 				}
 				foreign.R.Organization = local
 				break
@@ -786,9 +1083,9 @@ func (organizationL) LoadOrganizationMembers(ctx context.Context, e boil.Context
 	return nil
 }
 
-// LoadVaults allows an eager lookup of values, cached into the
+// LoadAuditLogs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (organizationL) LoadVaults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+func (organizationL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
 	var slice []*Organization
 	var object *Organization
 
@@ -841,8 +1138,8 @@ func (organizationL) LoadVaults(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.From(`vaults`),
-		qm.WhereIn(`vaults.organization_id in ?`, argsSlice...),
+		qm.From(`audit_logs`),
+		qm.WhereIn(`audit_logs.organization_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -850,26 +1147,26 @@ func (organizationL) LoadVaults(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load vaults")
+		return errors.Wrap(err, "failed to eager load audit_logs")
 	}
 
-	var resultSlice []*Vault
+	var resultSlice []*AuditLog
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice vaults")
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_logs")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on vaults")
+		return errors.Wrap(err, "failed to close results in eager load on audit_logs")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vaults")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
 	}
 
 	if singular {
-		object.R.Vaults = resultSlice
+		object.R.AuditLogs = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &vaultR{}
+				foreign.R = &auditLogR{}
 			}
 			foreign.R.Organization = object
 		}
@@ -879,9 +1176,9 @@ func (organizationL) LoadVaults(ctx context.Context, e boil.ContextExecutor, sin
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if queries.Equal(local.ID, foreign.OrganizationID) {
-				local.R.Vaults = append(local.R.Vaults, foreign)
+				local.R.AuditLogs = append(local.R.AuditLogs, foreign)
 				if foreign.R == nil {
-					foreign.R = &vaultR{}
+					foreign.R = &auditLogR{}
 				}
 				foreign.R.Organization = local
 				break
@@ -892,70 +1189,1638 @@ func (organizationL) LoadVaults(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// SetOwner of the organization to the related item.
+// LoadKeyBackupHealths allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadKeyBackupHealths(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_backup_health`),
+		qm.WhereIn(`key_backup_health.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load key_backup_health")
+	}
+
+	var resultSlice []*KeyBackupHealth
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice key_backup_health")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on key_backup_health")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_backup_health")
+	}
+
+	if singular {
+		object.R.KeyBackupHealths = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &keyBackupHealthR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.KeyBackupHealths = append(local.R.KeyBackupHealths, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyBackupHealthR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadKeyShareBackups allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadKeyShareBackups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_share_backups`),
+		qm.WhereIn(`key_share_backups.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load key_share_backups")
+	}
+
+	var resultSlice []*KeyShareBackup
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_backups")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on key_share_backups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_backups")
+	}
+
+	if singular {
+		object.R.KeyShareBackups = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &keyShareBackupR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.KeyShareBackups = append(local.R.KeyShareBackups, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyShareBackupR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadKeyShareRecoveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadKeyShareRecoveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_share_recoveries`),
+		qm.WhereIn(`key_share_recoveries.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load key_share_recoveries")
+	}
+
+	var resultSlice []*KeyShareRecovery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_recoveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on key_share_recoveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_recoveries")
+	}
+
+	if singular {
+		object.R.KeyShareRecoveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &keyShareRecoveryR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.KeyShareRecoveries = append(local.R.KeyShareRecoveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyShareRecoveryR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_preferences`),
+		qm.WhereIn(`notification_preferences.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_preferences")
+	}
+
+	var resultSlice []*NotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preferences")
+	}
+
+	if singular {
+		object.R.NotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationPreferenceR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationPreferenceR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganizationInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadOrganizationInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organization_invitations`),
+		qm.WhereIn(`organization_invitations.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organization_invitations")
+	}
+
+	var resultSlice []*OrganizationInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organization_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_invitations")
+	}
+
+	if singular {
+		object.R.OrganizationInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationInvitationR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.OrganizationInvitations = append(local.R.OrganizationInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationInvitationR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganizationMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organization_members`),
+		qm.WhereIn(`organization_members.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organization_members")
+	}
+
+	var resultSlice []*OrganizationMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organization_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_members")
+	}
+
+	if singular {
+		object.R.OrganizationMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationMemberR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.OrganizationMembers = append(local.R.OrganizationMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationMemberR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOutboxEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadOutboxEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`outbox_events`),
+		qm.WhereIn(`outbox_events.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load outbox_events")
+	}
+
+	var resultSlice []*OutboxEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice outbox_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on outbox_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for outbox_events")
+	}
+
+	if singular {
+		object.R.OutboxEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &outboxEventR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.OutboxEvents = append(local.R.OutboxEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &outboxEventR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVaults allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadVaults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vaults`),
+		qm.WhereIn(`vaults.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load vaults")
+	}
+
+	var resultSlice []*Vault
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice vaults")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on vaults")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vaults")
+	}
+
+	if singular {
+		object.R.Vaults = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &vaultR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OrganizationID) {
+				local.R.Vaults = append(local.R.Vaults, foreign)
+				if foreign.R == nil {
+					foreign.R = &vaultR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhookEndpoints allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadWebhookEndpoints(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_endpoints`),
+		qm.WhereIn(`webhook_endpoints.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_endpoints")
+	}
+
+	var resultSlice []*WebhookEndpoint
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_endpoints")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_endpoints")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_endpoints")
+	}
+
+	if singular {
+		object.R.WebhookEndpoints = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookEndpointR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.WebhookEndpoints = append(local.R.WebhookEndpoints, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookEndpointR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOwner of the organization to the related item.
 // Sets o.R.Owner to related.
 // Adds o to related.R.OwnerOrganizations.
 func (o *Organization) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organizations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &organizationR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerOrganizations: OrganizationSlice{o},
+		}
+	} else {
+		related.R.OwnerOrganizations = append(related.R.OwnerOrganizations, o)
+	}
+
+	return nil
+}
+
+// AddAddressBooks adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.AddressBooks.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OrganizationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"address_book\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, addressBookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OrganizationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			AddressBooks: related,
+		}
+	} else {
+		o.R.AddressBooks = append(o.R.AddressBooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &addressBookR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
+	return nil
+}
+
+// SetAddressBooks removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Organization's AddressBooks accordingly.
+// Replaces o.R.AddressBooks with related.
+// Sets related.R.Organization's AddressBooks accordingly.
+func (o *Organization) SetAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+	query := "update \"address_book\" set \"organization_id\" = null where \"organization_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AddressBooks {
+			queries.SetScanner(&rel.OrganizationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Organization = nil
+		}
+		o.R.AddressBooks = nil
+	}
+
+	return o.AddAddressBooks(ctx, exec, insert, related...)
+}
+
+// RemoveAddressBooks relationships from objects passed in.
+// Removes related items from R.AddressBooks (uses pointer comparison, removal does not keep order)
+// Sets related.R.Organization.
+func (o *Organization) RemoveAddressBooks(ctx context.Context, exec boil.ContextExecutor, related ...*AddressBook) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OrganizationID, nil)
+		if rel.R != nil {
+			rel.R.Organization = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AddressBooks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AddressBooks)
+			if ln > 1 && i < ln-1 {
+				o.R.AddressBooks[i] = o.R.AddressBooks[ln-1]
+			}
+			o.R.AddressBooks = o.R.AddressBooks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddDefaultOrganizationAppUserProfiles adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.DefaultOrganizationAppUserProfiles.
+// Sets related.R.DefaultOrganization appropriately.
+func (o *Organization) AddDefaultOrganizationAppUserProfiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AppUserProfile) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DefaultOrganizationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"app_user_profiles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"default_organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DefaultOrganizationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			DefaultOrganizationAppUserProfiles: related,
+		}
+	} else {
+		o.R.DefaultOrganizationAppUserProfiles = append(o.R.DefaultOrganizationAppUserProfiles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &appUserProfileR{
+				DefaultOrganization: o,
+			}
+		} else {
+			rel.R.DefaultOrganization = o
+		}
+	}
+	return nil
+}
+
+// SetDefaultOrganizationAppUserProfiles removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DefaultOrganization's DefaultOrganizationAppUserProfiles accordingly.
+// Replaces o.R.DefaultOrganizationAppUserProfiles with related.
+// Sets related.R.DefaultOrganization's DefaultOrganizationAppUserProfiles accordingly.
+func (o *Organization) SetDefaultOrganizationAppUserProfiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AppUserProfile) error {
+	query := "update \"app_user_profiles\" set \"default_organization_id\" = null where \"default_organization_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DefaultOrganizationAppUserProfiles {
+			queries.SetScanner(&rel.DefaultOrganizationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DefaultOrganization = nil
+		}
+		o.R.DefaultOrganizationAppUserProfiles = nil
+	}
+
+	return o.AddDefaultOrganizationAppUserProfiles(ctx, exec, insert, related...)
+}
+
+// RemoveDefaultOrganizationAppUserProfiles relationships from objects passed in.
+// Removes related items from R.DefaultOrganizationAppUserProfiles (uses pointer comparison, removal does not keep order)
+// Sets related.R.DefaultOrganization.
+func (o *Organization) RemoveDefaultOrganizationAppUserProfiles(ctx context.Context, exec boil.ContextExecutor, related ...*AppUserProfile) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DefaultOrganizationID, nil)
+		if rel.R != nil {
+			rel.R.DefaultOrganization = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("default_organization_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DefaultOrganizationAppUserProfiles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DefaultOrganizationAppUserProfiles)
+			if ln > 1 && i < ln-1 {
+				o.R.DefaultOrganizationAppUserProfiles[i] = o.R.DefaultOrganizationAppUserProfiles[ln-1]
+			}
+			o.R.DefaultOrganizationAppUserProfiles = o.R.DefaultOrganizationAppUserProfiles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAuditCheckpoints adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.AuditCheckpoints.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddAuditCheckpoints(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditCheckpoint) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OrganizationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_checkpoints\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditCheckpointPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OrganizationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			AuditCheckpoints: related,
+		}
+	} else {
+		o.R.AuditCheckpoints = append(o.R.AuditCheckpoints, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditCheckpointR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
+	return nil
+}
+
+// SetAuditCheckpoints removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Organization's AuditCheckpoints accordingly.
+// Replaces o.R.AuditCheckpoints with related.
+// Sets related.R.Organization's AuditCheckpoints accordingly.
+func (o *Organization) SetAuditCheckpoints(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditCheckpoint) error {
+	query := "update \"audit_checkpoints\" set \"organization_id\" = null where \"organization_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AuditCheckpoints {
+			queries.SetScanner(&rel.OrganizationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Organization = nil
+		}
+		o.R.AuditCheckpoints = nil
+	}
+
+	return o.AddAuditCheckpoints(ctx, exec, insert, related...)
+}
+
+// RemoveAuditCheckpoints relationships from objects passed in.
+// Removes related items from R.AuditCheckpoints (uses pointer comparison, removal does not keep order)
+// Sets related.R.Organization.
+func (o *Organization) RemoveAuditCheckpoints(ctx context.Context, exec boil.ContextExecutor, related ...*AuditCheckpoint) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OrganizationID, nil)
+		if rel.R != nil {
+			rel.R.Organization = nil
 		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"organizations\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-		strmangle.WhereClause("\"", "\"", 2, organizationPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	for _, rel := range related {
+		for i, ri := range o.R.AuditCheckpoints {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AuditCheckpoints)
+			if ln > 1 && i < ln-1 {
+				o.R.AuditCheckpoints[i] = o.R.AuditCheckpoints[ln-1]
+			}
+			o.R.AuditCheckpoints = o.R.AuditCheckpoints[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAuditLogs adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.AuditLogs.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OrganizationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_logs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OrganizationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			AuditLogs: related,
+		}
+	} else {
+		o.R.AuditLogs = append(o.R.AuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
+	return nil
+}
 
+// SetAuditLogs removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Organization's AuditLogs accordingly.
+// Replaces o.R.AuditLogs with related.
+// Sets related.R.Organization's AuditLogs accordingly.
+func (o *Organization) SetAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
+	query := "update \"audit_logs\" set \"organization_id\" = null where \"organization_id\" = $1"
+	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AuditLogs {
+			queries.SetScanner(&rel.OrganizationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Organization = nil
+		}
+		o.R.AuditLogs = nil
+	}
+
+	return o.AddAuditLogs(ctx, exec, insert, related...)
+}
+
+// RemoveAuditLogs relationships from objects passed in.
+// Removes related items from R.AuditLogs (uses pointer comparison, removal does not keep order)
+// Sets related.R.Organization.
+func (o *Organization) RemoveAuditLogs(ctx context.Context, exec boil.ContextExecutor, related ...*AuditLog) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OrganizationID, nil)
+		if rel.R != nil {
+			rel.R.Organization = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AuditLogs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AuditLogs)
+			if ln > 1 && i < ln-1 {
+				o.R.AuditLogs[i] = o.R.AuditLogs[ln-1]
+			}
+			o.R.AuditLogs = o.R.AuditLogs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddKeyBackupHealths adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.KeyBackupHealths.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddKeyBackupHealths(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyBackupHealth) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_backup_health\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyBackupHealthPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.KeyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrganizationID = o.ID
+		}
 	}
 
-	o.OwnerID = related.ID
 	if o.R == nil {
 		o.R = &organizationR{
-			Owner: related,
+			KeyBackupHealths: related,
 		}
 	} else {
-		o.R.Owner = related
+		o.R.KeyBackupHealths = append(o.R.KeyBackupHealths, related...)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			OwnerOrganizations: OrganizationSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyBackupHealthR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
+	return nil
+}
+
+// AddKeyShareBackups adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.KeyShareBackups.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddKeyShareBackups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareBackup) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_share_backups\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyShareBackupPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrganizationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			KeyShareBackups: related,
 		}
 	} else {
-		related.R.OwnerOrganizations = append(related.R.OwnerOrganizations, o)
+		o.R.KeyShareBackups = append(o.R.KeyShareBackups, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyShareBackupR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
 	return nil
 }
 
-// AddAddressBooks adds the given related objects to the existing relationships
+// AddKeyShareRecoveries adds the given related objects to the existing relationships
 // of the organization, optionally inserting them as new records.
-// Appends related to o.R.AddressBooks.
+// Appends related to o.R.KeyShareRecoveries.
 // Sets related.R.Organization appropriately.
-func (o *Organization) AddAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+func (o *Organization) AddKeyShareRecoveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareRecovery) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.OrganizationID, o.ID)
+			rel.OrganizationID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"address_book\" SET %s WHERE %s",
+				"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
-				strmangle.WhereClause("\"", "\"", 2, addressBookPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -968,21 +2833,21 @@ func (o *Organization) AddAddressBooks(ctx context.Context, exec boil.ContextExe
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.OrganizationID, o.ID)
+			rel.OrganizationID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &organizationR{
-			AddressBooks: related,
+			KeyShareRecoveries: related,
 		}
 	} else {
-		o.R.AddressBooks = append(o.R.AddressBooks, related...)
+		o.R.KeyShareRecoveries = append(o.R.KeyShareRecoveries, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &addressBookR{
+			rel.R = &keyShareRecoveryR{
 				Organization: o,
 			}
 		} else {
@@ -992,97 +2857,76 @@ func (o *Organization) AddAddressBooks(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// SetAddressBooks removes all previously related items of the
-// organization replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Organization's AddressBooks accordingly.
-// Replaces o.R.AddressBooks with related.
-// Sets related.R.Organization's AddressBooks accordingly.
-func (o *Organization) SetAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
-	query := "update \"address_book\" set \"organization_id\" = null where \"organization_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
+// AddNotificationPreferences adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.NotificationPreferences.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.OrganizationID}
 
-	if o.R != nil {
-		for _, rel := range o.R.AddressBooks {
-			queries.SetScanner(&rel.OrganizationID, nil)
-			if rel.R == nil {
-				continue
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.R.Organization = nil
+			rel.OrganizationID = o.ID
 		}
-		o.R.AddressBooks = nil
-	}
-
-	return o.AddAddressBooks(ctx, exec, insert, related...)
-}
-
-// RemoveAddressBooks relationships from objects passed in.
-// Removes related items from R.AddressBooks (uses pointer comparison, removal does not keep order)
-// Sets related.R.Organization.
-func (o *Organization) RemoveAddressBooks(ctx context.Context, exec boil.ContextExecutor, related ...*AddressBook) error {
-	if len(related) == 0 {
-		return nil
 	}
 
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.OrganizationID, nil)
-		if rel.R != nil {
-			rel.R.Organization = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
-			return err
-		}
-	}
 	if o.R == nil {
-		return nil
+		o.R = &organizationR{
+			NotificationPreferences: related,
+		}
+	} else {
+		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.AddressBooks {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.AddressBooks)
-			if ln > 1 && i < ln-1 {
-				o.R.AddressBooks[i] = o.R.AddressBooks[ln-1]
+		if rel.R == nil {
+			rel.R = &notificationPreferenceR{
+				Organization: o,
 			}
-			o.R.AddressBooks = o.R.AddressBooks[:ln-1]
-			break
+		} else {
+			rel.R.Organization = o
 		}
 	}
-
 	return nil
 }
 
-// AddAuditLogs adds the given related objects to the existing relationships
+// AddOrganizationInvitations adds the given related objects to the existing relationships
 // of the organization, optionally inserting them as new records.
-// Appends related to o.R.AuditLogs.
+// Appends related to o.R.OrganizationInvitations.
 // Sets related.R.Organization appropriately.
-func (o *Organization) AddAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
+func (o *Organization) AddOrganizationInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationInvitation) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.OrganizationID, o.ID)
+			rel.OrganizationID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"audit_logs\" SET %s WHERE %s",
+				"UPDATE \"organization_invitations\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
-				strmangle.WhereClause("\"", "\"", 2, auditLogPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1095,21 +2939,21 @@ func (o *Organization) AddAuditLogs(ctx context.Context, exec boil.ContextExecut
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.OrganizationID, o.ID)
+			rel.OrganizationID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &organizationR{
-			AuditLogs: related,
+			OrganizationInvitations: related,
 		}
 	} else {
-		o.R.AuditLogs = append(o.R.AuditLogs, related...)
+		o.R.OrganizationInvitations = append(o.R.OrganizationInvitations, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &auditLogR{
+			rel.R = &organizationInvitationR{
 				Organization: o,
 			}
 		} else {
@@ -1119,85 +2963,64 @@ func (o *Organization) AddAuditLogs(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// SetAuditLogs removes all previously related items of the
-// organization replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Organization's AuditLogs accordingly.
-// Replaces o.R.AuditLogs with related.
-// Sets related.R.Organization's AuditLogs accordingly.
-func (o *Organization) SetAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
-	query := "update \"audit_logs\" set \"organization_id\" = null where \"organization_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
+// AddOrganizationMembers adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.OrganizationMembers.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddOrganizationMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organization_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OrganizationID, rel.UserID}
 
-	if o.R != nil {
-		for _, rel := range o.R.AuditLogs {
-			queries.SetScanner(&rel.OrganizationID, nil)
-			if rel.R == nil {
-				continue
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.R.Organization = nil
+			rel.OrganizationID = o.ID
 		}
-		o.R.AuditLogs = nil
-	}
-
-	return o.AddAuditLogs(ctx, exec, insert, related...)
-}
-
-// RemoveAuditLogs relationships from objects passed in.
-// Removes related items from R.AuditLogs (uses pointer comparison, removal does not keep order)
-// Sets related.R.Organization.
-func (o *Organization) RemoveAuditLogs(ctx context.Context, exec boil.ContextExecutor, related ...*AuditLog) error {
-	if len(related) == 0 {
-		return nil
 	}
 
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.OrganizationID, nil)
-		if rel.R != nil {
-			rel.R.Organization = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
-			return err
-		}
-	}
 	if o.R == nil {
-		return nil
+		o.R = &organizationR{
+			OrganizationMembers: related,
+		}
+	} else {
+		o.R.OrganizationMembers = append(o.R.OrganizationMembers, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.AuditLogs {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.AuditLogs)
-			if ln > 1 && i < ln-1 {
-				o.R.AuditLogs[i] = o.R.AuditLogs[ln-1]
+		if rel.R == nil {
+			rel.R = &organizationMemberR{
+				Organization: o,
 			}
-			o.R.AuditLogs = o.R.AuditLogs[:ln-1]
-			break
+		} else {
+			rel.R.Organization = o
 		}
 	}
-
 	return nil
 }
 
-// AddOrganizationMembers adds the given related objects to the existing relationships
+// AddOutboxEvents adds the given related objects to the existing relationships
 // of the organization, optionally inserting them as new records.
-// Appends related to o.R.OrganizationMembers.
+// Appends related to o.R.OutboxEvents.
 // Sets related.R.Organization appropriately.
-func (o *Organization) AddOrganizationMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationMember) error {
+func (o *Organization) AddOutboxEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OutboxEvent) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1207,11 +3030,11 @@ func (o *Organization) AddOrganizationMembers(ctx context.Context, exec boil.Con
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"organization_members\" SET %s WHERE %s",
+				"UPDATE \"outbox_events\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
-				strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, outboxEventPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.OrganizationID, rel.UserID}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1228,15 +3051,15 @@ func (o *Organization) AddOrganizationMembers(ctx context.Context, exec boil.Con
 
 	if o.R == nil {
 		o.R = &organizationR{
-			OrganizationMembers: related,
+			OutboxEvents: related,
 		}
 	} else {
-		o.R.OrganizationMembers = append(o.R.OrganizationMembers, related...)
+		o.R.OutboxEvents = append(o.R.OutboxEvents, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &organizationMemberR{
+			rel.R = &outboxEventR{
 				Organization: o,
 			}
 		} else {
@@ -1373,6 +3196,59 @@ func (o *Organization) RemoveVaults(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
+// AddWebhookEndpoints adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.WebhookEndpoints.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddWebhookEndpoints(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookEndpoint) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookEndpointPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrganizationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			WebhookEndpoints: related,
+		}
+	} else {
+		o.R.WebhookEndpoints = append(o.R.WebhookEndpoints, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookEndpointR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
+	return nil
+}
+
 // Organizations retrieves all the records using an executor.
 func Organizations(mods ...qm.QueryMod) organizationQuery {
 	mods = append(mods, qm.From("\"organizations\""))
@@ -430,14 +430,14 @@ func testOrganizationToManyAddressBooks(t *testing.T) {
 	}
 }
 
-func testOrganizationToManyAuditLogs(t *testing.T) {
+func testOrganizationToManyDefaultOrganizationAppUserProfiles(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c AuditLog
+	var b, c AppUserProfile
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
@@ -448,15 +448,15 @@ func testOrganizationToManyAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, appUserProfileDBTypes, false, appUserProfileColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, appUserProfileDBTypes, false, appUserProfileColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.OrganizationID, a.ID)
-	queries.Assign(&c.OrganizationID, a.ID)
+	queries.Assign(&b.DefaultOrganizationID, a.ID)
+	queries.Assign(&c.DefaultOrganizationID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -464,17 +464,17 @@ func testOrganizationToManyAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.AuditLogs().All(ctx, tx)
+	check, err := a.DefaultOrganizationAppUserProfiles().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.OrganizationID, b.OrganizationID) {
+		if queries.Equal(v.DefaultOrganizationID, b.DefaultOrganizationID) {
 			bFound = true
 		}
-		if queries.Equal(v.OrganizationID, c.OrganizationID) {
+		if queries.Equal(v.DefaultOrganizationID, c.DefaultOrganizationID) {
 			cFound = true
 		}
 	}
@@ -487,18 +487,18 @@ func testOrganizationToManyAuditLogs(t *testing.T) {
 	}
 
 	slice := OrganizationSlice{&a}
-	if err = a.L.LoadAuditLogs(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+	if err = a.L.LoadDefaultOrganizationAppUserProfiles(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.AuditLogs); got != 2 {
+	if got := len(a.R.DefaultOrganizationAppUserProfiles); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.AuditLogs = nil
-	if err = a.L.LoadAuditLogs(ctx, tx, true, &a, nil); err != nil {
+	a.R.DefaultOrganizationAppUserProfiles = nil
+	if err = a.L.LoadDefaultOrganizationAppUserProfiles(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.AuditLogs); got != 2 {
+	if got := len(a.R.DefaultOrganizationAppUserProfiles); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -507,14 +507,14 @@ func testOrganizationToManyAuditLogs(t *testing.T) {
 	}
 }
 
-func testOrganizationToManyOrganizationMembers(t *testing.T) {
+func testOrganizationToManyAuditCheckpoints(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c OrganizationMember
+	var b, c AuditCheckpoint
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
@@ -525,16 +525,15 @@ func testOrganizationToManyOrganizationMembers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, auditCheckpointDBTypes, false, auditCheckpointColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, auditCheckpointDBTypes, false, auditCheckpointColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.OrganizationID = a.ID
-	c.OrganizationID = a.ID
-
+	queries.Assign(&b.OrganizationID, a.ID)
+	queries.Assign(&c.OrganizationID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -542,17 +541,17 @@ func testOrganizationToManyOrganizationMembers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.OrganizationMembers().All(ctx, tx)
+	check, err := a.AuditCheckpoints().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.OrganizationID == b.OrganizationID {
+		if queries.Equal(v.OrganizationID, b.OrganizationID) {
 			bFound = true
 		}
-		if v.OrganizationID == c.OrganizationID {
+		if queries.Equal(v.OrganizationID, c.OrganizationID) {
 			cFound = true
 		}
 	}
@@ -565,18 +564,18 @@ func testOrganizationToManyOrganizationMembers(t *testing.T) {
 	}
 
 	slice := OrganizationSlice{&a}
-	if err = a.L.LoadOrganizationMembers(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+	if err = a.L.LoadAuditCheckpoints(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.OrganizationMembers); got != 2 {
+	if got := len(a.R.AuditCheckpoints); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.OrganizationMembers = nil
-	if err = a.L.LoadOrganizationMembers(ctx, tx, true, &a, nil); err != nil {
+	a.R.AuditCheckpoints = nil
+	if err = a.L.LoadAuditCheckpoints(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.OrganizationMembers); got != 2 {
+	if got := len(a.R.AuditCheckpoints); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -585,14 +584,14 @@ func testOrganizationToManyOrganizationMembers(t *testing.T) {
 	}
 }
 
-func testOrganizationToManyVaults(t *testing.T) {
+func testOrganizationToManyAuditLogs(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c Vault
+	var b, c AuditLog
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
@@ -603,10 +602,10 @@ func testOrganizationToManyVaults(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -619,7 +618,7 @@ func testOrganizationToManyVaults(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Vaults().All(ctx, tx)
+	check, err := a.AuditLogs().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -642,18 +641,18 @@ func testOrganizationToManyVaults(t *testing.T) {
 	}
 
 	slice := OrganizationSlice{&a}
-	if err = a.L.LoadVaults(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+	if err = a.L.LoadAuditLogs(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Vaults); got != 2 {
+	if got := len(a.R.AuditLogs); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Vaults = nil
-	if err = a.L.LoadVaults(ctx, tx, true, &a, nil); err != nil {
+	a.R.AuditLogs = nil
+	if err = a.L.LoadAuditLogs(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Vaults); got != 2 {
+	if got := len(a.R.AuditLogs); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -662,30 +661,34 @@ func testOrganizationToManyVaults(t *testing.T) {
 	}
 }
 
-func testOrganizationToManyAddOpAddressBooks(t *testing.T) {
+func testOrganizationToManyKeyBackupHealths(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c, d, e AddressBook
+	var b, c KeyBackupHealth
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*AddressBook{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -693,197 +696,1647 @@ func testOrganizationToManyAddOpAddressBooks(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*AddressBook{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.KeyBackupHealths().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddAddressBooks(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if !queries.Equal(a.ID, first.OrganizationID) {
-			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
 		}
-		if !queries.Equal(a.ID, second.OrganizationID) {
-			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
 		}
+	}
 
-		if first.R.Organization != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.Organization != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if a.R.AddressBooks[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.AddressBooks[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadKeyBackupHealths(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyBackupHealths); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		count, err := a.AddressBooks().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	a.R.KeyBackupHealths = nil
+	if err = a.L.LoadKeyBackupHealths(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyBackupHealths); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
 
-func testOrganizationToManySetOpAddressBooks(t *testing.T) {
+func testOrganizationToManyKeyShareBackups(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c, d, e AddressBook
+	var b, c KeyShareBackup
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*AddressBook{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
 	}
 
-	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+
+	if err = randomize.Struct(seed, &b, keyShareBackupDBTypes, false, keyShareBackupColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &c, keyShareBackupDBTypes, false, keyShareBackupColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	err = a.SetAddressBooks(ctx, tx, false, &b, &c)
-	if err != nil {
-		t.Fatal(err)
-	}
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
 
-	count, err := a.AddressBooks().Count(ctx, tx)
-	if err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
-
-	err = a.SetAddressBooks(ctx, tx, true, &d, &e)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.AddressBooks().Count(ctx, tx)
+	check, err := a.KeyShareBackups().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
 
-	if !queries.IsValuerNil(b.OrganizationID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.OrganizationID) {
-		t.Error("want c's foreign key value to be nil")
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
+		}
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
+		}
 	}
-	if !queries.Equal(a.ID, d.OrganizationID) {
-		t.Error("foreign key was wrong value", a.ID, d.OrganizationID)
+
+	if !bFound {
+		t.Error("expected to find b")
 	}
-	if !queries.Equal(a.ID, e.OrganizationID) {
-		t.Error("foreign key was wrong value", a.ID, e.OrganizationID)
+	if !cFound {
+		t.Error("expected to find c")
 	}
 
-	if b.R.Organization != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadKeyShareBackups(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
 	}
-	if c.R.Organization != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
+	if got := len(a.R.KeyShareBackups); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
-	if d.R.Organization != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+
+	a.R.KeyShareBackups = nil
+	if err = a.L.LoadKeyShareBackups(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
 	}
-	if e.R.Organization != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+	if got := len(a.R.KeyShareBackups); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	if a.R.AddressBooks[0] != &d {
-		t.Error("relationship struct slice not set to correct value")
-	}
-	if a.R.AddressBooks[1] != &e {
-		t.Error("relationship struct slice not set to correct value")
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
 
-func testOrganizationToManyRemoveOpAddressBooks(t *testing.T) {
+func testOrganizationToManyKeyShareRecoveries(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c, d, e AddressBook
+	var b, c KeyShareRecovery
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*AddressBook{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	err = a.AddAddressBooks(ctx, tx, true, foreigners...)
-	if err != nil {
+	if err = randomize.Struct(seed, &b, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-
-	count, err := a.AddressBooks().Count(ctx, tx)
-	if err != nil {
+	if err = randomize.Struct(seed, &c, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if count != 4 {
-		t.Error("count was wrong:", count)
-	}
 
-	err = a.RemoveAddressBooks(ctx, tx, foreigners[:2]...)
-	if err != nil {
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.AddressBooks().Count(ctx, tx)
+	check, err := a.KeyShareRecoveries().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
 
-	if !queries.IsValuerNil(b.OrganizationID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.OrganizationID) {
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
+		}
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadKeyShareRecoveries(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyShareRecoveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.KeyShareRecoveries = nil
+	if err = a.L.LoadKeyShareRecoveries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyShareRecoveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrganizationToManyNotificationPreferences(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c NotificationPreference
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.NotificationPreferences().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
+		}
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadNotificationPreferences(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.NotificationPreferences); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.NotificationPreferences = nil
+	if err = a.L.LoadNotificationPreferences(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.NotificationPreferences); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrganizationToManyOrganizationInvitations(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c OrganizationInvitation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OrganizationInvitations().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
+		}
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadOrganizationInvitations(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrganizationInvitations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OrganizationInvitations = nil
+	if err = a.L.LoadOrganizationInvitations(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrganizationInvitations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrganizationToManyOrganizationMembers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c OrganizationMember
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OrganizationMembers().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
+		}
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadOrganizationMembers(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrganizationMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OrganizationMembers = nil
+	if err = a.L.LoadOrganizationMembers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrganizationMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrganizationToManyOutboxEvents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c OutboxEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, outboxEventDBTypes, false, outboxEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, outboxEventDBTypes, false, outboxEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OutboxEvents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
+		}
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadOutboxEvents(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OutboxEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OutboxEvents = nil
+	if err = a.L.LoadOutboxEvents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OutboxEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrganizationToManyVaults(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c Vault
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.OrganizationID, a.ID)
+	queries.Assign(&c.OrganizationID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Vaults().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.OrganizationID, b.OrganizationID) {
+			bFound = true
+		}
+		if queries.Equal(v.OrganizationID, c.OrganizationID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadVaults(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Vaults); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Vaults = nil
+	if err = a.L.LoadVaults(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Vaults); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrganizationToManyWebhookEndpoints(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c WebhookEndpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, true, organizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrganizationID = a.ID
+	c.OrganizationID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WebhookEndpoints().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrganizationID == b.OrganizationID {
+			bFound = true
+		}
+		if v.OrganizationID == c.OrganizationID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrganizationSlice{&a}
+	if err = a.L.LoadWebhookEndpoints(ctx, tx, false, (*[]*Organization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookEndpoints); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WebhookEndpoints = nil
+	if err = a.L.LoadWebhookEndpoints(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookEndpoints); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrganizationToManyAddOpAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AddressBook{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAddressBooks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.OrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if !queries.Equal(a.ID, second.OrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
+
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AddressBooks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AddressBooks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AddressBooks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOrganizationToManySetOpAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAddressBooks(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAddressBooks(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OrganizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.OrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, d.OrganizationID)
+	}
+	if !queries.Equal(a.ID, e.OrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, e.OrganizationID)
+	}
+
+	if b.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Organization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Organization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.AddressBooks[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.AddressBooks[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testOrganizationToManyRemoveOpAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAddressBooks(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAddressBooks(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OrganizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Organization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Organization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.AddressBooks) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.AddressBooks[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.AddressBooks[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testOrganizationToManyAddOpDefaultOrganizationAppUserProfiles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AppUserProfile
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AppUserProfile{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, appUserProfileDBTypes, false, strmangle.SetComplement(appUserProfilePrimaryKeyColumns, appUserProfileColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AppUserProfile{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDefaultOrganizationAppUserProfiles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DefaultOrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, first.DefaultOrganizationID)
+		}
+		if !queries.Equal(a.ID, second.DefaultOrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, second.DefaultOrganizationID)
+		}
+
+		if first.R.DefaultOrganization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.DefaultOrganization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.DefaultOrganizationAppUserProfiles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.DefaultOrganizationAppUserProfiles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.DefaultOrganizationAppUserProfiles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOrganizationToManySetOpDefaultOrganizationAppUserProfiles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AppUserProfile
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AppUserProfile{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, appUserProfileDBTypes, false, strmangle.SetComplement(appUserProfilePrimaryKeyColumns, appUserProfileColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetDefaultOrganizationAppUserProfiles(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DefaultOrganizationAppUserProfiles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetDefaultOrganizationAppUserProfiles(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DefaultOrganizationAppUserProfiles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DefaultOrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DefaultOrganizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.DefaultOrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, d.DefaultOrganizationID)
+	}
+	if !queries.Equal(a.ID, e.DefaultOrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, e.DefaultOrganizationID)
+	}
+
+	if b.R.DefaultOrganization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DefaultOrganization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DefaultOrganization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.DefaultOrganization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.DefaultOrganizationAppUserProfiles[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.DefaultOrganizationAppUserProfiles[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testOrganizationToManyRemoveOpDefaultOrganizationAppUserProfiles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AppUserProfile
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AppUserProfile{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, appUserProfileDBTypes, false, strmangle.SetComplement(appUserProfilePrimaryKeyColumns, appUserProfileColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddDefaultOrganizationAppUserProfiles(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.DefaultOrganizationAppUserProfiles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveDefaultOrganizationAppUserProfiles(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.DefaultOrganizationAppUserProfiles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DefaultOrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DefaultOrganizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.DefaultOrganization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.DefaultOrganization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.DefaultOrganization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.DefaultOrganization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.DefaultOrganizationAppUserProfiles) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.DefaultOrganizationAppUserProfiles[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.DefaultOrganizationAppUserProfiles[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testOrganizationToManyAddOpAuditCheckpoints(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AuditCheckpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AuditCheckpoint{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, auditCheckpointDBTypes, false, strmangle.SetComplement(auditCheckpointPrimaryKeyColumns, auditCheckpointColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AuditCheckpoint{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAuditCheckpoints(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.OrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if !queries.Equal(a.ID, second.OrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
+
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AuditCheckpoints[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AuditCheckpoints[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AuditCheckpoints().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOrganizationToManySetOpAuditCheckpoints(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AuditCheckpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AuditCheckpoint{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, auditCheckpointDBTypes, false, strmangle.SetComplement(auditCheckpointPrimaryKeyColumns, auditCheckpointColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAuditCheckpoints(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAuditCheckpoints(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OrganizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.OrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, d.OrganizationID)
+	}
+	if !queries.Equal(a.ID, e.OrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, e.OrganizationID)
+	}
+
+	if b.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Organization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Organization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.AuditCheckpoints[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.AuditCheckpoints[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testOrganizationToManyRemoveOpAuditCheckpoints(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AuditCheckpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AuditCheckpoint{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, auditCheckpointDBTypes, false, strmangle.SetComplement(auditCheckpointPrimaryKeyColumns, auditCheckpointColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAuditCheckpoints(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAuditCheckpoints(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AuditCheckpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OrganizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Organization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Organization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.AuditCheckpoints) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.AuditCheckpoints[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.AuditCheckpoints[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testOrganizationToManyAddOpAuditLogs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AuditLog
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AuditLog{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AuditLog{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAuditLogs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.OrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if !queries.Equal(a.ID, second.OrganizationID) {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
+
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AuditLogs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AuditLogs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AuditLogs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOrganizationToManySetOpAuditLogs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AuditLog
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AuditLog{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAuditLogs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAuditLogs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OrganizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.OrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, d.OrganizationID)
+	}
+	if !queries.Equal(a.ID, e.OrganizationID) {
+		t.Error("foreign key was wrong value", a.ID, e.OrganizationID)
+	}
+
+	if b.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Organization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Organization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Organization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.AuditLogs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.AuditLogs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testOrganizationToManyRemoveOpAuditLogs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e AuditLog
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AuditLog{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAuditLogs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAuditLogs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OrganizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OrganizationID) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
@@ -900,20 +2353,20 @@ func testOrganizationToManyRemoveOpAddressBooks(t *testing.T) {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.AddressBooks) != 2 {
+	if len(a.R.AuditLogs) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.AddressBooks[1] != &d {
+	if a.R.AuditLogs[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.AddressBooks[0] != &e {
+	if a.R.AuditLogs[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testOrganizationToManyAddOpAuditLogs(t *testing.T) {
+func testOrganizationToManyAddOpKeyBackupHealths(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -921,15 +2374,15 @@ func testOrganizationToManyAddOpAuditLogs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c, d, e AuditLog
+	var b, c, d, e KeyBackupHealth
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AuditLog{&b, &c, &d, &e}
+	foreigners := []*KeyBackupHealth{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, keyBackupHealthDBTypes, false, strmangle.SetComplement(keyBackupHealthPrimaryKeyColumns, keyBackupHealthColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -944,13 +2397,13 @@ func testOrganizationToManyAddOpAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*AuditLog{
+	foreignersSplitByInsertion := [][]*KeyBackupHealth{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddAuditLogs(ctx, tx, i != 0, x...)
+		err = a.AddKeyBackupHealths(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -958,10 +2411,10 @@ func testOrganizationToManyAddOpAuditLogs(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.OrganizationID) {
+		if a.ID != first.OrganizationID {
 			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
 		}
-		if !queries.Equal(a.ID, second.OrganizationID) {
+		if a.ID != second.OrganizationID {
 			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
 		}
 
@@ -972,14 +2425,239 @@ func testOrganizationToManyAddOpAuditLogs(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.AuditLogs[i*2] != first {
+		if a.R.KeyBackupHealths[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.AuditLogs[i*2+1] != second {
+		if a.R.KeyBackupHealths[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.AuditLogs().Count(ctx, tx)
+		count, err := a.KeyBackupHealths().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOrganizationToManyAddOpKeyShareBackups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e KeyShareBackup
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareBackup{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareBackupDBTypes, false, strmangle.SetComplement(keyShareBackupPrimaryKeyColumns, keyShareBackupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyShareBackup{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKeyShareBackups(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if a.ID != second.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
+
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.KeyShareBackups[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KeyShareBackups[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.KeyShareBackups().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOrganizationToManyAddOpKeyShareRecoveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareRecovery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyShareRecovery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKeyShareRecoveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if a.ID != second.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
+
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.KeyShareRecoveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KeyShareRecoveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.KeyShareRecoveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOrganizationToManyAddOpNotificationPreferences(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e NotificationPreference
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*NotificationPreference{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, notificationPreferenceDBTypes, false, strmangle.SetComplement(notificationPreferencePrimaryKeyColumns, notificationPreferenceColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*NotificationPreference{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddNotificationPreferences(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if a.ID != second.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
+
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.NotificationPreferences[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.NotificationPreferences[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.NotificationPreferences().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -988,8 +2666,7 @@ func testOrganizationToManyAddOpAuditLogs(t *testing.T) {
 		}
 	}
 }
-
-func testOrganizationToManySetOpAuditLogs(t *testing.T) {
+func testOrganizationToManyAddOpOrganizationInvitations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -997,20 +2674,20 @@ func testOrganizationToManySetOpAuditLogs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c, d, e AuditLog
+	var b, c, d, e OrganizationInvitation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AuditLog{&b, &c, &d, &e}
+	foreigners := []*OrganizationInvitation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
 
-	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
@@ -1020,67 +2697,51 @@ func testOrganizationToManySetOpAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetAuditLogs(ctx, tx, false, &b, &c)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*OrganizationInvitation{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err := a.AuditLogs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOrganizationInvitations(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	err = a.SetAuditLogs(ctx, tx, true, &d, &e)
-	if err != nil {
-		t.Fatal(err)
-	}
+		first := x[0]
+		second := x[1]
 
-	count, err = a.AuditLogs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+		if a.ID != first.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if a.ID != second.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
 
-	if !queries.IsValuerNil(b.OrganizationID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.OrganizationID) {
-		t.Error("want c's foreign key value to be nil")
-	}
-	if !queries.Equal(a.ID, d.OrganizationID) {
-		t.Error("foreign key was wrong value", a.ID, d.OrganizationID)
-	}
-	if !queries.Equal(a.ID, e.OrganizationID) {
-		t.Error("foreign key was wrong value", a.ID, e.OrganizationID)
-	}
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	if b.R.Organization != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.Organization != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.Organization != &a {
-		t.Error("relationship was not added properly to the foreign struct")
-	}
-	if e.R.Organization != &a {
-		t.Error("relationship was not added properly to the foreign struct")
-	}
+		if a.R.OrganizationInvitations[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OrganizationInvitations[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
 
-	if a.R.AuditLogs[0] != &d {
-		t.Error("relationship struct slice not set to correct value")
-	}
-	if a.R.AuditLogs[1] != &e {
-		t.Error("relationship struct slice not set to correct value")
+		count, err := a.OrganizationInvitations().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testOrganizationToManyRemoveOpAuditLogs(t *testing.T) {
+func testOrganizationToManyAddOpOrganizationMembers(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1088,15 +2749,15 @@ func testOrganizationToManyRemoveOpAuditLogs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c, d, e AuditLog
+	var b, c, d, e OrganizationMember
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AuditLog{&b, &c, &d, &e}
+	foreigners := []*OrganizationMember{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationMemberDBTypes, false, strmangle.SetComplement(organizationMemberPrimaryKeyColumns, organizationMemberColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1104,67 +2765,58 @@ func testOrganizationToManyRemoveOpAuditLogs(t *testing.T) {
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	err = a.AddAuditLogs(ctx, tx, true, foreigners...)
-	if err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	count, err := a.AuditLogs().Count(ctx, tx)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if count != 4 {
-		t.Error("count was wrong:", count)
-	}
 
-	err = a.RemoveAuditLogs(ctx, tx, foreigners[:2]...)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*OrganizationMember{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err = a.AuditLogs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOrganizationMembers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	if !queries.IsValuerNil(b.OrganizationID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.OrganizationID) {
-		t.Error("want c's foreign key value to be nil")
-	}
+		first := x[0]
+		second := x[1]
 
-	if b.R.Organization != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.Organization != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.Organization != &a {
-		t.Error("relationship to a should have been preserved")
-	}
-	if e.R.Organization != &a {
-		t.Error("relationship to a should have been preserved")
-	}
+		if a.ID != first.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if a.ID != second.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
 
-	if len(a.R.AuditLogs) != 2 {
-		t.Error("should have preserved two relationships")
-	}
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.AuditLogs[1] != &d {
-		t.Error("relationship to d should have been preserved")
-	}
-	if a.R.AuditLogs[0] != &e {
-		t.Error("relationship to e should have been preserved")
+		if a.R.OrganizationMembers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OrganizationMembers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OrganizationMembers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testOrganizationToManyAddOpOrganizationMembers(t *testing.T) {
+func testOrganizationToManyAddOpOutboxEvents(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1172,15 +2824,15 @@ func testOrganizationToManyAddOpOrganizationMembers(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Organization
-	var b, c, d, e OrganizationMember
+	var b, c, d, e OutboxEvent
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*OrganizationMember{&b, &c, &d, &e}
+	foreigners := []*OutboxEvent{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, organizationMemberDBTypes, false, strmangle.SetComplement(organizationMemberPrimaryKeyColumns, organizationMemberColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, outboxEventDBTypes, false, strmangle.SetComplement(outboxEventPrimaryKeyColumns, outboxEventColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1195,13 +2847,13 @@ func testOrganizationToManyAddOpOrganizationMembers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*OrganizationMember{
+	foreignersSplitByInsertion := [][]*OutboxEvent{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddOrganizationMembers(ctx, tx, i != 0, x...)
+		err = a.AddOutboxEvents(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1223,14 +2875,14 @@ func testOrganizationToManyAddOpOrganizationMembers(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.OrganizationMembers[i*2] != first {
+		if a.R.OutboxEvents[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.OrganizationMembers[i*2+1] != second {
+		if a.R.OutboxEvents[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.OrganizationMembers().Count(ctx, tx)
+		count, err := a.OutboxEvents().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1490,6 +3142,81 @@ func testOrganizationToManyRemoveOpVaults(t *testing.T) {
 	}
 }
 
+func testOrganizationToManyAddOpWebhookEndpoints(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Organization
+	var b, c, d, e WebhookEndpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WebhookEndpoint{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WebhookEndpoint{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWebhookEndpoints(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, first.OrganizationID)
+		}
+		if a.ID != second.OrganizationID {
+			t.Error("foreign key was wrong value", a.ID, second.OrganizationID)
+		}
+
+		if first.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Organization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WebhookEndpoints[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WebhookEndpoints[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WebhookEndpoints().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testOrganizationToOneUserUsingOwner(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -0,0 +1,922 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PasskeyChallenge is an object representing the database table.
+type PasskeyChallenge struct {
+	ID          string     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID      string     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Challenge   string     `boil:"challenge" json:"challenge" toml:"challenge" yaml:"challenge"`
+	SessionData types.JSON `boil:"session_data" json:"session_data" toml:"session_data" yaml:"session_data"`
+	ExpiresAt   time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt   time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Action      string     `boil:"action" json:"action" toml:"action" yaml:"action"`
+	ResourceID  string     `boil:"resource_id" json:"resource_id" toml:"resource_id" yaml:"resource_id"`
+
+	R *passkeyChallengeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L passkeyChallengeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PasskeyChallengeColumns = struct {
+	ID          string
+	UserID      string
+	Challenge   string
+	SessionData string
+	ExpiresAt   string
+	CreatedAt   string
+	Action      string
+	ResourceID  string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	Challenge:   "challenge",
+	SessionData: "session_data",
+	ExpiresAt:   "expires_at",
+	CreatedAt:   "created_at",
+	Action:      "action",
+	ResourceID:  "resource_id",
+}
+
+var PasskeyChallengeTableColumns = struct {
+	ID          string
+	UserID      string
+	Challenge   string
+	SessionData string
+	ExpiresAt   string
+	CreatedAt   string
+	Action      string
+	ResourceID  string
+}{
+	ID:          "passkey_challenges.id",
+	UserID:      "passkey_challenges.user_id",
+	Challenge:   "passkey_challenges.challenge",
+	SessionData: "passkey_challenges.session_data",
+	ExpiresAt:   "passkey_challenges.expires_at",
+	CreatedAt:   "passkey_challenges.created_at",
+	Action:      "passkey_challenges.action",
+	ResourceID:  "passkey_challenges.resource_id",
+}
+
+// Generated where
+
+var PasskeyChallengeWhere = struct {
+	ID          whereHelperstring
+	UserID      whereHelperstring
+	Challenge   whereHelperstring
+	SessionData whereHelpertypes_JSON
+	ExpiresAt   whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+	Action      whereHelperstring
+	ResourceID  whereHelperstring
+}{
+	ID:          whereHelperstring{field: "\"passkey_challenges\".\"id\""},
+	UserID:      whereHelperstring{field: "\"passkey_challenges\".\"user_id\""},
+	Challenge:   whereHelperstring{field: "\"passkey_challenges\".\"challenge\""},
+	SessionData: whereHelpertypes_JSON{field: "\"passkey_challenges\".\"session_data\""},
+	ExpiresAt:   whereHelpertime_Time{field: "\"passkey_challenges\".\"expires_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"passkey_challenges\".\"created_at\""},
+	Action:      whereHelperstring{field: "\"passkey_challenges\".\"action\""},
+	ResourceID:  whereHelperstring{field: "\"passkey_challenges\".\"resource_id\""},
+}
+
+// PasskeyChallengeRels is where relationship names are stored.
+var PasskeyChallengeRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// passkeyChallengeR is where relationships are stored.
+type passkeyChallengeR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*passkeyChallengeR) NewStruct() *passkeyChallengeR {
+	return &passkeyChallengeR{}
+}
+
+func (o *PasskeyChallenge) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *passkeyChallengeR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// passkeyChallengeL is where Load methods for each relationship are stored.
+type passkeyChallengeL struct{}
+
+var (
+	passkeyChallengeAllColumns            = []string{"id", "user_id", "challenge", "session_data", "expires_at", "created_at", "action", "resource_id"}
+	passkeyChallengeColumnsWithoutDefault = []string{"user_id", "challenge", "session_data", "expires_at", "action", "resource_id"}
+	passkeyChallengeColumnsWithDefault    = []string{"id", "created_at"}
+	passkeyChallengePrimaryKeyColumns     = []string{"id"}
+	passkeyChallengeGeneratedColumns      = []string{}
+)
+
+type (
+	// PasskeyChallengeSlice is an alias for a slice of pointers to PasskeyChallenge.
+	// This should almost always be used instead of []PasskeyChallenge.
+	PasskeyChallengeSlice []*PasskeyChallenge
+
+	passkeyChallengeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	passkeyChallengeType                 = reflect.TypeOf(&PasskeyChallenge{})
+	passkeyChallengeMapping              = queries.MakeStructMapping(passkeyChallengeType)
+	passkeyChallengePrimaryKeyMapping, _ = queries.BindMapping(passkeyChallengeType, passkeyChallengeMapping, passkeyChallengePrimaryKeyColumns)
+	passkeyChallengeInsertCacheMut       sync.RWMutex
+	passkeyChallengeInsertCache          = make(map[string]insertCache)
+	passkeyChallengeUpdateCacheMut       sync.RWMutex
+	passkeyChallengeUpdateCache          = make(map[string]updateCache)
+	passkeyChallengeUpsertCacheMut       sync.RWMutex
+	passkeyChallengeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single passkeyChallenge record from the query.
+func (q passkeyChallengeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PasskeyChallenge, error) {
+	o := &PasskeyChallenge{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for passkey_challenges")
+	}
+
+	return o, nil
+}
+
+// All returns all PasskeyChallenge records from the query.
+func (q passkeyChallengeQuery) All(ctx context.Context, exec boil.ContextExecutor) (PasskeyChallengeSlice, error) {
+	var o []*PasskeyChallenge
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PasskeyChallenge slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PasskeyChallenge records in the query.
+func (q passkeyChallengeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count passkey_challenges rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q passkeyChallengeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if passkey_challenges exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *PasskeyChallenge) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (passkeyChallengeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePasskeyChallenge interface{}, mods queries.Applicator) error {
+	var slice []*PasskeyChallenge
+	var object *PasskeyChallenge
+
+	if singular {
+		var ok bool
+		object, ok = maybePasskeyChallenge.(*PasskeyChallenge)
+		if !ok {
+			object = new(PasskeyChallenge)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePasskeyChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePasskeyChallenge))
+			}
+		}
+	} else {
+		s, ok := maybePasskeyChallenge.(*[]*PasskeyChallenge)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePasskeyChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePasskeyChallenge))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &passkeyChallengeR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &passkeyChallengeR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PasskeyChallenges = append(foreign.R.PasskeyChallenges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PasskeyChallenges = append(foreign.R.PasskeyChallenges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the passkeyChallenge to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PasskeyChallenges.
+func (o *PasskeyChallenge) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"passkey_challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, passkeyChallengePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &passkeyChallengeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PasskeyChallenges: PasskeyChallengeSlice{o},
+		}
+	} else {
+		related.R.PasskeyChallenges = append(related.R.PasskeyChallenges, o)
+	}
+
+	return nil
+}
+
+// PasskeyChallenges retrieves all the records using an executor.
+func PasskeyChallenges(mods ...qm.QueryMod) passkeyChallengeQuery {
+	mods = append(mods, qm.From("\"passkey_challenges\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"passkey_challenges\".*"})
+	}
+
+	return passkeyChallengeQuery{q}
+}
+
+// FindPasskeyChallenge retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPasskeyChallenge(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PasskeyChallenge, error) {
+	passkeyChallengeObj := &PasskeyChallenge{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"passkey_challenges\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, passkeyChallengeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from passkey_challenges")
+	}
+
+	return passkeyChallengeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PasskeyChallenge) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no passkey_challenges provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(passkeyChallengeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	passkeyChallengeInsertCacheMut.RLock()
+	cache, cached := passkeyChallengeInsertCache[key]
+	passkeyChallengeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			passkeyChallengeAllColumns,
+			passkeyChallengeColumnsWithDefault,
+			passkeyChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(passkeyChallengeType, passkeyChallengeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(passkeyChallengeType, passkeyChallengeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"passkey_challenges\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"passkey_challenges\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into passkey_challenges")
+	}
+
+	if !cached {
+		passkeyChallengeInsertCacheMut.Lock()
+		passkeyChallengeInsertCache[key] = cache
+		passkeyChallengeInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the PasskeyChallenge.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PasskeyChallenge) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	passkeyChallengeUpdateCacheMut.RLock()
+	cache, cached := passkeyChallengeUpdateCache[key]
+	passkeyChallengeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			passkeyChallengeAllColumns,
+			passkeyChallengePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update passkey_challenges, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"passkey_challenges\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, passkeyChallengePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(passkeyChallengeType, passkeyChallengeMapping, append(wl, passkeyChallengePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update passkey_challenges row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for passkey_challenges")
+	}
+
+	if !cached {
+		passkeyChallengeUpdateCacheMut.Lock()
+		passkeyChallengeUpdateCache[key] = cache
+		passkeyChallengeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q passkeyChallengeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for passkey_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for passkey_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PasskeyChallengeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passkeyChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"passkey_challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, passkeyChallengePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in passkeyChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all passkeyChallenge")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PasskeyChallenge) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no passkey_challenges provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(passkeyChallengeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	passkeyChallengeUpsertCacheMut.RLock()
+	cache, cached := passkeyChallengeUpsertCache[key]
+	passkeyChallengeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			passkeyChallengeAllColumns,
+			passkeyChallengeColumnsWithDefault,
+			passkeyChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			passkeyChallengeAllColumns,
+			passkeyChallengePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert passkey_challenges, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(passkeyChallengeAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(passkeyChallengePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert passkey_challenges, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(passkeyChallengePrimaryKeyColumns))
+			copy(conflict, passkeyChallengePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"passkey_challenges\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(passkeyChallengeType, passkeyChallengeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(passkeyChallengeType, passkeyChallengeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert passkey_challenges")
+	}
+
+	if !cached {
+		passkeyChallengeUpsertCacheMut.Lock()
+		passkeyChallengeUpsertCache[key] = cache
+		passkeyChallengeUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single PasskeyChallenge record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PasskeyChallenge) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PasskeyChallenge provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), passkeyChallengePrimaryKeyMapping)
+	sql := "DELETE FROM \"passkey_challenges\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from passkey_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for passkey_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q passkeyChallengeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no passkeyChallengeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from passkey_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for passkey_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PasskeyChallengeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passkeyChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"passkey_challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, passkeyChallengePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from passkeyChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for passkey_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PasskeyChallenge) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPasskeyChallenge(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PasskeyChallengeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PasskeyChallengeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passkeyChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"passkey_challenges\".* FROM \"passkey_challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, passkeyChallengePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PasskeyChallengeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PasskeyChallengeExists checks if the PasskeyChallenge row exists.
+func PasskeyChallengeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"passkey_challenges\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if passkey_challenges exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PasskeyChallenge row exists.
+func (o *PasskeyChallenge) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PasskeyChallengeExists(ctx, exec, o.ID)
+}
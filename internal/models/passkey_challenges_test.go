@@ -0,0 +1,701 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPasskeyChallenges(t *testing.T) {
+	t.Parallel()
+
+	query := PasskeyChallenges()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPasskeyChallengesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasskeyChallengesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PasskeyChallenges().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasskeyChallengesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PasskeyChallengeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasskeyChallengesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PasskeyChallengeExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PasskeyChallenge exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PasskeyChallengeExists to return true, but got false.")
+	}
+}
+
+func testPasskeyChallengesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	passkeyChallengeFound, err := FindPasskeyChallenge(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if passkeyChallengeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPasskeyChallengesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PasskeyChallenges().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasskeyChallengesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PasskeyChallenges().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPasskeyChallengesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	passkeyChallengeOne := &PasskeyChallenge{}
+	passkeyChallengeTwo := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, passkeyChallengeOne, passkeyChallengeDBTypes, false, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+	if err = randomize.Struct(seed, passkeyChallengeTwo, passkeyChallengeDBTypes, false, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = passkeyChallengeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = passkeyChallengeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PasskeyChallenges().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPasskeyChallengesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	passkeyChallengeOne := &PasskeyChallenge{}
+	passkeyChallengeTwo := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, passkeyChallengeOne, passkeyChallengeDBTypes, false, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+	if err = randomize.Struct(seed, passkeyChallengeTwo, passkeyChallengeDBTypes, false, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = passkeyChallengeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = passkeyChallengeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPasskeyChallengesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPasskeyChallengesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(passkeyChallengePrimaryKeyColumns, passkeyChallengeColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPasskeyChallengeToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PasskeyChallenge
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, passkeyChallengeDBTypes, false, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PasskeyChallengeSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PasskeyChallenge)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testPasskeyChallengeToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PasskeyChallenge
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, passkeyChallengeDBTypes, false, strmangle.SetComplement(passkeyChallengePrimaryKeyColumns, passkeyChallengeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PasskeyChallenges[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testPasskeyChallengesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasskeyChallengesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PasskeyChallengeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasskeyChallengesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PasskeyChallenges().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	passkeyChallengeDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `Challenge`: `character varying`, `SessionData`: `jsonb`, `ExpiresAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `Action`: `text`, `ResourceID`: `text`}
+	_                       = bytes.MinRead
+)
+
+func testPasskeyChallengesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(passkeyChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(passkeyChallengeAllColumns) == len(passkeyChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPasskeyChallengesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(passkeyChallengeAllColumns) == len(passkeyChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasskeyChallenge{}
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, passkeyChallengeDBTypes, true, passkeyChallengePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(passkeyChallengeAllColumns, passkeyChallengePrimaryKeyColumns) {
+		fields = passkeyChallengeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			passkeyChallengeAllColumns,
+			passkeyChallengePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PasskeyChallengeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPasskeyChallengesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(passkeyChallengeAllColumns) == len(passkeyChallengePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PasskeyChallenge{}
+	if err = randomize.Struct(seed, &o, passkeyChallengeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PasskeyChallenge: %s", err)
+	}
+
+	count, err := PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, passkeyChallengeDBTypes, false, passkeyChallengePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasskeyChallenge struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PasskeyChallenge: %s", err)
+	}
+
+	count, err = PasskeyChallenges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -16,35 +16,73 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("Assets", testAssetsUpsert)
 
+	t.Run("AuditCheckpoints", testAuditCheckpointsUpsert)
+
 	t.Run("AuditLogs", testAuditLogsUpsert)
 
+	t.Run("ChainScanCursors", testChainScanCursorsUpsert)
+
 	t.Run("Chains", testChainsUpsert)
 
 	t.Run("ConfirmationTokens", testConfirmationTokensUpsert)
 
+	t.Run("Deposits", testDepositsUpsert)
+
+	t.Run("KeyBackupHealths", testKeyBackupHealthsUpsert)
+
+	t.Run("KeyRefreshes", testKeyRefreshesUpsert)
+
+	t.Run("KeyShareBackups", testKeyShareBackupsUpsert)
+
+	t.Run("KeyShareRecoveries", testKeyShareRecoveriesUpsert)
+
+	t.Run("KeyShareRecoveryApprovals", testKeyShareRecoveryApprovalsUpsert)
+
+	t.Run("NotificationPreferences", testNotificationPreferencesUpsert)
+
+	t.Run("OrganizationInvitations", testOrganizationInvitationsUpsert)
+
 	t.Run("OrganizationMembers", testOrganizationMembersUpsert)
 
 	t.Run("Organizations", testOrganizationsUpsert)
 
+	t.Run("OutboxEvents", testOutboxEventsUpsert)
+
+	t.Run("PasskeyChallenges", testPasskeyChallengesUpsert)
+
 	t.Run("PasswordResetTokens", testPasswordResetTokensUpsert)
 
 	t.Run("PushTokens", testPushTokensUpsert)
 
 	t.Run("RefreshTokens", testRefreshTokensUpsert)
 
+	t.Run("RootKeys", testRootKeysUpsert)
+
 	t.Run("SigningRequests", testSigningRequestsUpsert)
 
 	t.Run("SpendingLimits", testSpendingLimitsUpsert)
 
 	t.Run("UserCredentials", testUserCredentialsUpsert)
 
+	t.Run("UserDevices", testUserDevicesUpsert)
+
 	t.Run("Users", testUsersUpsert)
 
 	t.Run("VaultKeys", testVaultKeysUpsert)
 
+	t.Run("VaultProposalApprovals", testVaultProposalApprovalsUpsert)
+
+	t.Run("VaultProposals", testVaultProposalsUpsert)
+
 	t.Run("Vaults", testVaultsUpsert)
 
 	t.Run("WalletBalances", testWalletBalancesUpsert)
 
 	t.Run("Wallets", testWalletsUpsert)
+
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpsert)
+
+	t.Run("WebhookEndpoints", testWebhookEndpointsUpsert)
+
+	t.Run("WebhookEvents", testWebhookEventsUpsert)
 }
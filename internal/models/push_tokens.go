@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,12 +24,13 @@ import (
 
 // PushToken is an object representing the database table.
 type PushToken struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Token     string    `boil:"token" json:"token" toml:"token" yaml:"token"`
-	Provider  string    `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Token     string      `boil:"token" json:"token" toml:"token" yaml:"token"`
+	Provider  string      `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	UserID    string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Language  null.String `boil:"language" json:"language,omitempty" toml:"language" yaml:"language,omitempty"`
 
 	R *pushTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L pushTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -41,6 +43,7 @@ var PushTokenColumns = struct {
 	UserID    string
 	CreatedAt string
 	UpdatedAt string
+	Language  string
 }{
 	ID:        "id",
 	Token:     "token",
@@ -48,6 +51,7 @@ var PushTokenColumns = struct {
 	UserID:    "user_id",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
+	Language:  "language",
 }
 
 var PushTokenTableColumns = struct {
@@ -57,6 +61,7 @@ var PushTokenTableColumns = struct {
 	UserID    string
 	CreatedAt string
 	UpdatedAt string
+	Language  string
 }{
 	ID:        "push_tokens.id",
 	Token:     "push_tokens.token",
@@ -64,6 +69,7 @@ var PushTokenTableColumns = struct {
 	UserID:    "push_tokens.user_id",
 	CreatedAt: "push_tokens.created_at",
 	UpdatedAt: "push_tokens.updated_at",
+	Language:  "push_tokens.language",
 }
 
 // Generated where
@@ -75,6 +81,7 @@ var PushTokenWhere = struct {
 	UserID    whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
+	Language  whereHelpernull_String
 }{
 	ID:        whereHelperstring{field: "\"push_tokens\".\"id\""},
 	Token:     whereHelperstring{field: "\"push_tokens\".\"token\""},
@@ -82,6 +89,7 @@ var PushTokenWhere = struct {
 	UserID:    whereHelperstring{field: "\"push_tokens\".\"user_id\""},
 	CreatedAt: whereHelpertime_Time{field: "\"push_tokens\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"push_tokens\".\"updated_at\""},
+	Language:  whereHelpernull_String{field: "\"push_tokens\".\"language\""},
 }
 
 // PushTokenRels is where relationship names are stored.
@@ -121,9 +129,9 @@ func (r *pushTokenR) GetUser() *User {
 type pushTokenL struct{}
 
 var (
-	pushTokenAllColumns            = []string{"id", "token", "provider", "user_id", "created_at", "updated_at"}
+	pushTokenAllColumns            = []string{"id", "token", "provider", "user_id", "created_at", "updated_at", "language"}
 	pushTokenColumnsWithoutDefault = []string{"token", "provider", "user_id", "created_at", "updated_at"}
-	pushTokenColumnsWithDefault    = []string{"id"}
+	pushTokenColumnsWithDefault    = []string{"id", "language"}
 	pushTokenPrimaryKeyColumns     = []string{"id"}
 	pushTokenGeneratedColumns      = []string{}
 )
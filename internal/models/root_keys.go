@@ -0,0 +1,1413 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// RootKey is an object representing the database table.
+type RootKey struct {
+	KeyID                string      `boil:"key_id" json:"key_id" toml:"key_id" yaml:"key_id"`
+	VaultID              string      `boil:"vault_id" json:"vault_id" toml:"vault_id" yaml:"vault_id"`
+	Description          null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	Tags                 types.JSON  `boil:"tags" json:"tags" toml:"tags" yaml:"tags"`
+	Status               string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	SuccessorKeyID       null.String `boil:"successor_key_id" json:"successor_key_id,omitempty" toml:"successor_key_id" yaml:"successor_key_id,omitempty"`
+	RetirementProposalID null.String `boil:"retirement_proposal_id" json:"retirement_proposal_id,omitempty" toml:"retirement_proposal_id" yaml:"retirement_proposal_id,omitempty"`
+	RetiringAt           null.Time   `boil:"retiring_at" json:"retiring_at,omitempty" toml:"retiring_at" yaml:"retiring_at,omitempty"`
+	RetiredAt            null.Time   `boil:"retired_at" json:"retired_at,omitempty" toml:"retired_at" yaml:"retired_at,omitempty"`
+	CreatedAt            time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CompletionProposalID null.String `boil:"completion_proposal_id" json:"completion_proposal_id,omitempty" toml:"completion_proposal_id" yaml:"completion_proposal_id,omitempty"`
+
+	R *rootKeyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rootKeyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RootKeyColumns = struct {
+	KeyID                string
+	VaultID              string
+	Description          string
+	Tags                 string
+	Status               string
+	SuccessorKeyID       string
+	RetirementProposalID string
+	RetiringAt           string
+	RetiredAt            string
+	CreatedAt            string
+	UpdatedAt            string
+	CompletionProposalID string
+}{
+	KeyID:                "key_id",
+	VaultID:              "vault_id",
+	Description:          "description",
+	Tags:                 "tags",
+	Status:               "status",
+	SuccessorKeyID:       "successor_key_id",
+	RetirementProposalID: "retirement_proposal_id",
+	RetiringAt:           "retiring_at",
+	RetiredAt:            "retired_at",
+	CreatedAt:            "created_at",
+	UpdatedAt:            "updated_at",
+	CompletionProposalID: "completion_proposal_id",
+}
+
+var RootKeyTableColumns = struct {
+	KeyID                string
+	VaultID              string
+	Description          string
+	Tags                 string
+	Status               string
+	SuccessorKeyID       string
+	RetirementProposalID string
+	RetiringAt           string
+	RetiredAt            string
+	CreatedAt            string
+	UpdatedAt            string
+	CompletionProposalID string
+}{
+	KeyID:                "root_keys.key_id",
+	VaultID:              "root_keys.vault_id",
+	Description:          "root_keys.description",
+	Tags:                 "root_keys.tags",
+	Status:               "root_keys.status",
+	SuccessorKeyID:       "root_keys.successor_key_id",
+	RetirementProposalID: "root_keys.retirement_proposal_id",
+	RetiringAt:           "root_keys.retiring_at",
+	RetiredAt:            "root_keys.retired_at",
+	CreatedAt:            "root_keys.created_at",
+	UpdatedAt:            "root_keys.updated_at",
+	CompletionProposalID: "root_keys.completion_proposal_id",
+}
+
+// Generated where
+
+var RootKeyWhere = struct {
+	KeyID                whereHelperstring
+	VaultID              whereHelperstring
+	Description          whereHelpernull_String
+	Tags                 whereHelpertypes_JSON
+	Status               whereHelperstring
+	SuccessorKeyID       whereHelpernull_String
+	RetirementProposalID whereHelpernull_String
+	RetiringAt           whereHelpernull_Time
+	RetiredAt            whereHelpernull_Time
+	CreatedAt            whereHelpertime_Time
+	UpdatedAt            whereHelpertime_Time
+	CompletionProposalID whereHelpernull_String
+}{
+	KeyID:                whereHelperstring{field: "\"root_keys\".\"key_id\""},
+	VaultID:              whereHelperstring{field: "\"root_keys\".\"vault_id\""},
+	Description:          whereHelpernull_String{field: "\"root_keys\".\"description\""},
+	Tags:                 whereHelpertypes_JSON{field: "\"root_keys\".\"tags\""},
+	Status:               whereHelperstring{field: "\"root_keys\".\"status\""},
+	SuccessorKeyID:       whereHelpernull_String{field: "\"root_keys\".\"successor_key_id\""},
+	RetirementProposalID: whereHelpernull_String{field: "\"root_keys\".\"retirement_proposal_id\""},
+	RetiringAt:           whereHelpernull_Time{field: "\"root_keys\".\"retiring_at\""},
+	RetiredAt:            whereHelpernull_Time{field: "\"root_keys\".\"retired_at\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"root_keys\".\"created_at\""},
+	UpdatedAt:            whereHelpertime_Time{field: "\"root_keys\".\"updated_at\""},
+	CompletionProposalID: whereHelpernull_String{field: "\"root_keys\".\"completion_proposal_id\""},
+}
+
+// RootKeyRels is where relationship names are stored.
+var RootKeyRels = struct {
+	CompletionProposal string
+	RetirementProposal string
+	Vault              string
+}{
+	CompletionProposal: "CompletionProposal",
+	RetirementProposal: "RetirementProposal",
+	Vault:              "Vault",
+}
+
+// rootKeyR is where relationships are stored.
+type rootKeyR struct {
+	CompletionProposal *VaultProposal `boil:"CompletionProposal" json:"CompletionProposal" toml:"CompletionProposal" yaml:"CompletionProposal"`
+	RetirementProposal *VaultProposal `boil:"RetirementProposal" json:"RetirementProposal" toml:"RetirementProposal" yaml:"RetirementProposal"`
+	Vault              *Vault         `boil:"Vault" json:"Vault" toml:"Vault" yaml:"Vault"`
+}
+
+// NewStruct creates a new relationship struct
+func (*rootKeyR) NewStruct() *rootKeyR {
+	return &rootKeyR{}
+}
+
+func (o *RootKey) GetCompletionProposal() *VaultProposal {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCompletionProposal()
+}
+
+func (r *rootKeyR) GetCompletionProposal() *VaultProposal {
+	if r == nil {
+		return nil
+	}
+
+	return r.CompletionProposal
+}
+
+func (o *RootKey) GetRetirementProposal() *VaultProposal {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRetirementProposal()
+}
+
+func (r *rootKeyR) GetRetirementProposal() *VaultProposal {
+	if r == nil {
+		return nil
+	}
+
+	return r.RetirementProposal
+}
+
+func (o *RootKey) GetVault() *Vault {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVault()
+}
+
+func (r *rootKeyR) GetVault() *Vault {
+	if r == nil {
+		return nil
+	}
+
+	return r.Vault
+}
+
+// rootKeyL is where Load methods for each relationship are stored.
+type rootKeyL struct{}
+
+var (
+	rootKeyAllColumns            = []string{"key_id", "vault_id", "description", "tags", "status", "successor_key_id", "retirement_proposal_id", "retiring_at", "retired_at", "created_at", "updated_at", "completion_proposal_id"}
+	rootKeyColumnsWithoutDefault = []string{"key_id", "vault_id"}
+	rootKeyColumnsWithDefault    = []string{"description", "tags", "status", "successor_key_id", "retirement_proposal_id", "retiring_at", "retired_at", "created_at", "updated_at", "completion_proposal_id"}
+	rootKeyPrimaryKeyColumns     = []string{"key_id"}
+	rootKeyGeneratedColumns      = []string{}
+)
+
+type (
+	// RootKeySlice is an alias for a slice of pointers to RootKey.
+	// This should almost always be used instead of []RootKey.
+	RootKeySlice []*RootKey
+
+	rootKeyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rootKeyType                 = reflect.TypeOf(&RootKey{})
+	rootKeyMapping              = queries.MakeStructMapping(rootKeyType)
+	rootKeyPrimaryKeyMapping, _ = queries.BindMapping(rootKeyType, rootKeyMapping, rootKeyPrimaryKeyColumns)
+	rootKeyInsertCacheMut       sync.RWMutex
+	rootKeyInsertCache          = make(map[string]insertCache)
+	rootKeyUpdateCacheMut       sync.RWMutex
+	rootKeyUpdateCache          = make(map[string]updateCache)
+	rootKeyUpsertCacheMut       sync.RWMutex
+	rootKeyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single rootKey record from the query.
+func (q rootKeyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RootKey, error) {
+	o := &RootKey{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for root_keys")
+	}
+
+	return o, nil
+}
+
+// All returns all RootKey records from the query.
+func (q rootKeyQuery) All(ctx context.Context, exec boil.ContextExecutor) (RootKeySlice, error) {
+	var o []*RootKey
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RootKey slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RootKey records in the query.
+func (q rootKeyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count root_keys rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q rootKeyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if root_keys exists")
+	}
+
+	return count > 0, nil
+}
+
+// CompletionProposal pointed to by the foreign key.
+func (o *RootKey) CompletionProposal(mods ...qm.QueryMod) vaultProposalQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CompletionProposalID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return VaultProposals(queryMods...)
+}
+
+// RetirementProposal pointed to by the foreign key.
+func (o *RootKey) RetirementProposal(mods ...qm.QueryMod) vaultProposalQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RetirementProposalID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return VaultProposals(queryMods...)
+}
+
+// Vault pointed to by the foreign key.
+func (o *RootKey) Vault(mods ...qm.QueryMod) vaultQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VaultID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Vaults(queryMods...)
+}
+
+// LoadCompletionProposal allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rootKeyL) LoadCompletionProposal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRootKey interface{}, mods queries.Applicator) error {
+	var slice []*RootKey
+	var object *RootKey
+
+	if singular {
+		var ok bool
+		object, ok = maybeRootKey.(*RootKey)
+		if !ok {
+			object = new(RootKey)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRootKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRootKey))
+			}
+		}
+	} else {
+		s, ok := maybeRootKey.(*[]*RootKey)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRootKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRootKey))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &rootKeyR{}
+		}
+		if !queries.IsNil(object.CompletionProposalID) {
+			args[object.CompletionProposalID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rootKeyR{}
+			}
+
+			if !queries.IsNil(obj.CompletionProposalID) {
+				args[obj.CompletionProposalID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vault_proposals`),
+		qm.WhereIn(`vault_proposals.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load VaultProposal")
+	}
+
+	var resultSlice []*VaultProposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice VaultProposal")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vault_proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposals")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CompletionProposal = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultProposalR{}
+		}
+		foreign.R.CompletionProposalRootKeys = append(foreign.R.CompletionProposalRootKeys, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CompletionProposalID, foreign.ID) {
+				local.R.CompletionProposal = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultProposalR{}
+				}
+				foreign.R.CompletionProposalRootKeys = append(foreign.R.CompletionProposalRootKeys, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRetirementProposal allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rootKeyL) LoadRetirementProposal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRootKey interface{}, mods queries.Applicator) error {
+	var slice []*RootKey
+	var object *RootKey
+
+	if singular {
+		var ok bool
+		object, ok = maybeRootKey.(*RootKey)
+		if !ok {
+			object = new(RootKey)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRootKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRootKey))
+			}
+		}
+	} else {
+		s, ok := maybeRootKey.(*[]*RootKey)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRootKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRootKey))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &rootKeyR{}
+		}
+		if !queries.IsNil(object.RetirementProposalID) {
+			args[object.RetirementProposalID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rootKeyR{}
+			}
+
+			if !queries.IsNil(obj.RetirementProposalID) {
+				args[obj.RetirementProposalID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vault_proposals`),
+		qm.WhereIn(`vault_proposals.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load VaultProposal")
+	}
+
+	var resultSlice []*VaultProposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice VaultProposal")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vault_proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposals")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RetirementProposal = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultProposalR{}
+		}
+		foreign.R.RetirementProposalRootKeys = append(foreign.R.RetirementProposalRootKeys, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RetirementProposalID, foreign.ID) {
+				local.R.RetirementProposal = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultProposalR{}
+				}
+				foreign.R.RetirementProposalRootKeys = append(foreign.R.RetirementProposalRootKeys, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVault allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rootKeyL) LoadVault(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRootKey interface{}, mods queries.Applicator) error {
+	var slice []*RootKey
+	var object *RootKey
+
+	if singular {
+		var ok bool
+		object, ok = maybeRootKey.(*RootKey)
+		if !ok {
+			object = new(RootKey)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRootKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRootKey))
+			}
+		}
+	} else {
+		s, ok := maybeRootKey.(*[]*RootKey)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRootKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRootKey))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &rootKeyR{}
+		}
+		args[object.VaultID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rootKeyR{}
+			}
+
+			args[obj.VaultID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vaults`),
+		qm.WhereIn(`vaults.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Vault")
+	}
+
+	var resultSlice []*Vault
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Vault")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vaults")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vaults")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Vault = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultR{}
+		}
+		foreign.R.RootKeys = append(foreign.R.RootKeys, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VaultID == foreign.ID {
+				local.R.Vault = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultR{}
+				}
+				foreign.R.RootKeys = append(foreign.R.RootKeys, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCompletionProposal of the rootKey to the related item.
+// Sets o.R.CompletionProposal to related.
+// Adds o to related.R.CompletionProposalRootKeys.
+func (o *RootKey) SetCompletionProposal(ctx context.Context, exec boil.ContextExecutor, insert bool, related *VaultProposal) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"root_keys\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"completion_proposal_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rootKeyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.KeyID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CompletionProposalID, related.ID)
+	if o.R == nil {
+		o.R = &rootKeyR{
+			CompletionProposal: related,
+		}
+	} else {
+		o.R.CompletionProposal = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultProposalR{
+			CompletionProposalRootKeys: RootKeySlice{o},
+		}
+	} else {
+		related.R.CompletionProposalRootKeys = append(related.R.CompletionProposalRootKeys, o)
+	}
+
+	return nil
+}
+
+// RemoveCompletionProposal relationship.
+// Sets o.R.CompletionProposal to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *RootKey) RemoveCompletionProposal(ctx context.Context, exec boil.ContextExecutor, related *VaultProposal) error {
+	var err error
+
+	queries.SetScanner(&o.CompletionProposalID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("completion_proposal_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CompletionProposal = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CompletionProposalRootKeys {
+		if queries.Equal(o.CompletionProposalID, ri.CompletionProposalID) {
+			continue
+		}
+
+		ln := len(related.R.CompletionProposalRootKeys)
+		if ln > 1 && i < ln-1 {
+			related.R.CompletionProposalRootKeys[i] = related.R.CompletionProposalRootKeys[ln-1]
+		}
+		related.R.CompletionProposalRootKeys = related.R.CompletionProposalRootKeys[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRetirementProposal of the rootKey to the related item.
+// Sets o.R.RetirementProposal to related.
+// Adds o to related.R.RetirementProposalRootKeys.
+func (o *RootKey) SetRetirementProposal(ctx context.Context, exec boil.ContextExecutor, insert bool, related *VaultProposal) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"root_keys\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"retirement_proposal_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rootKeyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.KeyID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RetirementProposalID, related.ID)
+	if o.R == nil {
+		o.R = &rootKeyR{
+			RetirementProposal: related,
+		}
+	} else {
+		o.R.RetirementProposal = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultProposalR{
+			RetirementProposalRootKeys: RootKeySlice{o},
+		}
+	} else {
+		related.R.RetirementProposalRootKeys = append(related.R.RetirementProposalRootKeys, o)
+	}
+
+	return nil
+}
+
+// RemoveRetirementProposal relationship.
+// Sets o.R.RetirementProposal to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *RootKey) RemoveRetirementProposal(ctx context.Context, exec boil.ContextExecutor, related *VaultProposal) error {
+	var err error
+
+	queries.SetScanner(&o.RetirementProposalID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("retirement_proposal_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RetirementProposal = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RetirementProposalRootKeys {
+		if queries.Equal(o.RetirementProposalID, ri.RetirementProposalID) {
+			continue
+		}
+
+		ln := len(related.R.RetirementProposalRootKeys)
+		if ln > 1 && i < ln-1 {
+			related.R.RetirementProposalRootKeys[i] = related.R.RetirementProposalRootKeys[ln-1]
+		}
+		related.R.RetirementProposalRootKeys = related.R.RetirementProposalRootKeys[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetVault of the rootKey to the related item.
+// Sets o.R.Vault to related.
+// Adds o to related.R.RootKeys.
+func (o *RootKey) SetVault(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Vault) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"root_keys\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rootKeyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.KeyID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VaultID = related.ID
+	if o.R == nil {
+		o.R = &rootKeyR{
+			Vault: related,
+		}
+	} else {
+		o.R.Vault = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultR{
+			RootKeys: RootKeySlice{o},
+		}
+	} else {
+		related.R.RootKeys = append(related.R.RootKeys, o)
+	}
+
+	return nil
+}
+
+// RootKeys retrieves all the records using an executor.
+func RootKeys(mods ...qm.QueryMod) rootKeyQuery {
+	mods = append(mods, qm.From("\"root_keys\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"root_keys\".*"})
+	}
+
+	return rootKeyQuery{q}
+}
+
+// FindRootKey retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRootKey(ctx context.Context, exec boil.ContextExecutor, keyID string, selectCols ...string) (*RootKey, error) {
+	rootKeyObj := &RootKey{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"root_keys\" where \"key_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, keyID)
+
+	err := q.Bind(ctx, exec, rootKeyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from root_keys")
+	}
+
+	return rootKeyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RootKey) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no root_keys provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rootKeyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rootKeyInsertCacheMut.RLock()
+	cache, cached := rootKeyInsertCache[key]
+	rootKeyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rootKeyAllColumns,
+			rootKeyColumnsWithDefault,
+			rootKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rootKeyType, rootKeyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rootKeyType, rootKeyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"root_keys\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"root_keys\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into root_keys")
+	}
+
+	if !cached {
+		rootKeyInsertCacheMut.Lock()
+		rootKeyInsertCache[key] = cache
+		rootKeyInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the RootKey.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RootKey) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	rootKeyUpdateCacheMut.RLock()
+	cache, cached := rootKeyUpdateCache[key]
+	rootKeyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rootKeyAllColumns,
+			rootKeyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update root_keys, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"root_keys\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rootKeyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rootKeyType, rootKeyMapping, append(wl, rootKeyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update root_keys row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for root_keys")
+	}
+
+	if !cached {
+		rootKeyUpdateCacheMut.Lock()
+		rootKeyUpdateCache[key] = cache
+		rootKeyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rootKeyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for root_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for root_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RootKeySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rootKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"root_keys\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rootKeyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rootKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rootKey")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RootKey) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no root_keys provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rootKeyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rootKeyUpsertCacheMut.RLock()
+	cache, cached := rootKeyUpsertCache[key]
+	rootKeyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			rootKeyAllColumns,
+			rootKeyColumnsWithDefault,
+			rootKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			rootKeyAllColumns,
+			rootKeyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert root_keys, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(rootKeyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rootKeyPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert root_keys, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rootKeyPrimaryKeyColumns))
+			copy(conflict, rootKeyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"root_keys\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(rootKeyType, rootKeyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rootKeyType, rootKeyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert root_keys")
+	}
+
+	if !cached {
+		rootKeyUpsertCacheMut.Lock()
+		rootKeyUpsertCache[key] = cache
+		rootKeyUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single RootKey record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RootKey) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RootKey provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rootKeyPrimaryKeyMapping)
+	sql := "DELETE FROM \"root_keys\" WHERE \"key_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from root_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for root_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rootKeyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rootKeyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from root_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for root_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RootKeySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rootKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"root_keys\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rootKeyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rootKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for root_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RootKey) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRootKey(ctx, exec, o.KeyID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RootKeySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RootKeySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rootKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"root_keys\".* FROM \"root_keys\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rootKeyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RootKeySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RootKeyExists checks if the RootKey row exists.
+func RootKeyExists(ctx context.Context, exec boil.ContextExecutor, keyID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"root_keys\" where \"key_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, keyID)
+	}
+	row := exec.QueryRowContext(ctx, sql, keyID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if root_keys exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RootKey row exists.
+func (o *RootKey) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RootKeyExists(ctx, exec, o.KeyID)
+}
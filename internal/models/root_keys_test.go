@@ -0,0 +1,1023 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRootKeys(t *testing.T) {
+	t.Parallel()
+
+	query := RootKeys()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRootKeysDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRootKeysQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RootKeys().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRootKeysSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RootKeySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRootKeysExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RootKeyExists(ctx, tx, o.KeyID)
+	if err != nil {
+		t.Errorf("Unable to check if RootKey exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RootKeyExists to return true, but got false.")
+	}
+}
+
+func testRootKeysFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	rootKeyFound, err := FindRootKey(ctx, tx, o.KeyID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if rootKeyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRootKeysBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RootKeys().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRootKeysOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RootKeys().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRootKeysAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	rootKeyOne := &RootKey{}
+	rootKeyTwo := &RootKey{}
+	if err = randomize.Struct(seed, rootKeyOne, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rootKeyTwo, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rootKeyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rootKeyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RootKeys().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRootKeysCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	rootKeyOne := &RootKey{}
+	rootKeyTwo := &RootKey{}
+	if err = randomize.Struct(seed, rootKeyOne, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rootKeyTwo, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rootKeyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rootKeyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testRootKeysInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRootKeysInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRootKeyToOneVaultProposalUsingCompletionProposal(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RootKey
+	var foreign VaultProposal
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CompletionProposalID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.CompletionProposal().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RootKeySlice{&local}
+	if err = local.L.LoadCompletionProposal(ctx, tx, false, (*[]*RootKey)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CompletionProposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CompletionProposal = nil
+	if err = local.L.LoadCompletionProposal(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CompletionProposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testRootKeyToOneVaultProposalUsingRetirementProposal(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RootKey
+	var foreign VaultProposal
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.RetirementProposalID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.RetirementProposal().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RootKeySlice{&local}
+	if err = local.L.LoadRetirementProposal(ctx, tx, false, (*[]*RootKey)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RetirementProposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.RetirementProposal = nil
+	if err = local.L.LoadRetirementProposal(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RetirementProposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testRootKeyToOneVaultUsingVault(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RootKey
+	var foreign Vault
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VaultID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Vault().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RootKeySlice{&local}
+	if err = local.L.LoadVault(ctx, tx, false, (*[]*RootKey)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Vault = nil
+	if err = local.L.LoadVault(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testRootKeyToOneSetOpVaultProposalUsingCompletionProposal(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RootKey
+	var b, c VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*VaultProposal{&b, &c} {
+		err = a.SetCompletionProposal(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CompletionProposal != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CompletionProposalRootKeys[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CompletionProposalID, x.ID) {
+			t.Error("foreign key was wrong value", a.CompletionProposalID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CompletionProposalID))
+		reflect.Indirect(reflect.ValueOf(&a.CompletionProposalID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CompletionProposalID, x.ID) {
+			t.Error("foreign key was wrong value", a.CompletionProposalID, x.ID)
+		}
+	}
+}
+
+func testRootKeyToOneRemoveOpVaultProposalUsingCompletionProposal(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RootKey
+	var b VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCompletionProposal(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCompletionProposal(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.CompletionProposal().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.CompletionProposal != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CompletionProposalID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CompletionProposalRootKeys) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testRootKeyToOneSetOpVaultProposalUsingRetirementProposal(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RootKey
+	var b, c VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*VaultProposal{&b, &c} {
+		err = a.SetRetirementProposal(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.RetirementProposal != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RetirementProposalRootKeys[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.RetirementProposalID, x.ID) {
+			t.Error("foreign key was wrong value", a.RetirementProposalID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RetirementProposalID))
+		reflect.Indirect(reflect.ValueOf(&a.RetirementProposalID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.RetirementProposalID, x.ID) {
+			t.Error("foreign key was wrong value", a.RetirementProposalID, x.ID)
+		}
+	}
+}
+
+func testRootKeyToOneRemoveOpVaultProposalUsingRetirementProposal(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RootKey
+	var b VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetRetirementProposal(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveRetirementProposal(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.RetirementProposal().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.RetirementProposal != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.RetirementProposalID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.RetirementProposalRootKeys) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testRootKeyToOneSetOpVaultUsingVault(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RootKey
+	var b, c Vault
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Vault{&b, &c} {
+		err = a.SetVault(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Vault != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RootKeys[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VaultID))
+		reflect.Indirect(reflect.ValueOf(&a.VaultID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID, x.ID)
+		}
+	}
+}
+
+func testRootKeysReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRootKeysReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RootKeySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRootKeysSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RootKeys().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	rootKeyDBTypes = map[string]string{`KeyID`: `character varying`, `VaultID`: `uuid`, `Description`: `text`, `Tags`: `jsonb`, `Status`: `character varying`, `SuccessorKeyID`: `character varying`, `RetirementProposalID`: `uuid`, `RetiringAt`: `timestamp with time zone`, `RetiredAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `CompletionProposalID`: `uuid`}
+	_              = bytes.MinRead
+)
+
+func testRootKeysUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(rootKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(rootKeyAllColumns) == len(rootKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRootKeysSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(rootKeyAllColumns) == len(rootKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RootKey{}
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rootKeyDBTypes, true, rootKeyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(rootKeyAllColumns, rootKeyPrimaryKeyColumns) {
+		fields = rootKeyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			rootKeyAllColumns,
+			rootKeyPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RootKeySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRootKeysUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(rootKeyAllColumns) == len(rootKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RootKey{}
+	if err = randomize.Struct(seed, &o, rootKeyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RootKey: %s", err)
+	}
+
+	count, err := RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, rootKeyDBTypes, false, rootKeyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RootKey struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RootKey: %s", err)
+	}
+
+	count, err = RootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
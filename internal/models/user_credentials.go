@@ -35,6 +35,7 @@ type UserCredential struct {
 	LastUsedAt      null.Time   `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
 	CreatedAt       null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt       null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	BackupEligible  bool        `boil:"backup_eligible" json:"backup_eligible" toml:"backup_eligible" yaml:"backup_eligible"`
 
 	R *userCredentialR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userCredentialL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +53,7 @@ var UserCredentialColumns = struct {
 	LastUsedAt      string
 	CreatedAt       string
 	UpdatedAt       string
+	BackupEligible  string
 }{
 	ID:              "id",
 	UserID:          "user_id",
@@ -64,6 +66,7 @@ var UserCredentialColumns = struct {
 	LastUsedAt:      "last_used_at",
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
+	BackupEligible:  "backup_eligible",
 }
 
 var UserCredentialTableColumns = struct {
@@ -78,6 +81,7 @@ var UserCredentialTableColumns = struct {
 	LastUsedAt      string
 	CreatedAt       string
 	UpdatedAt       string
+	BackupEligible  string
 }{
 	ID:              "user_credentials.id",
 	UserID:          "user_credentials.user_id",
@@ -90,6 +94,7 @@ var UserCredentialTableColumns = struct {
 	LastUsedAt:      "user_credentials.last_used_at",
 	CreatedAt:       "user_credentials.created_at",
 	UpdatedAt:       "user_credentials.updated_at",
+	BackupEligible:  "user_credentials.backup_eligible",
 }
 
 // Generated where
@@ -144,6 +149,7 @@ var UserCredentialWhere = struct {
 	LastUsedAt      whereHelpernull_Time
 	CreatedAt       whereHelpernull_Time
 	UpdatedAt       whereHelpernull_Time
+	BackupEligible  whereHelperbool
 }{
 	ID:              whereHelperstring{field: "\"user_credentials\".\"id\""},
 	UserID:          whereHelperstring{field: "\"user_credentials\".\"user_id\""},
@@ -156,18 +162,22 @@ var UserCredentialWhere = struct {
 	LastUsedAt:      whereHelpernull_Time{field: "\"user_credentials\".\"last_used_at\""},
 	CreatedAt:       whereHelpernull_Time{field: "\"user_credentials\".\"created_at\""},
 	UpdatedAt:       whereHelpernull_Time{field: "\"user_credentials\".\"updated_at\""},
+	BackupEligible:  whereHelperbool{field: "\"user_credentials\".\"backup_eligible\""},
 }
 
 // UserCredentialRels is where relationship names are stored.
 var UserCredentialRels = struct {
-	User string
+	User                  string
+	CredentialUserDevices string
 }{
-	User: "User",
+	User:                  "User",
+	CredentialUserDevices: "CredentialUserDevices",
 }
 
 // userCredentialR is where relationships are stored.
 type userCredentialR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	User                  *User           `boil:"User" json:"User" toml:"User" yaml:"User"`
+	CredentialUserDevices UserDeviceSlice `boil:"CredentialUserDevices" json:"CredentialUserDevices" toml:"CredentialUserDevices" yaml:"CredentialUserDevices"`
 }
 
 // NewStruct creates a new relationship struct
@@ -191,13 +201,29 @@ func (r *userCredentialR) GetUser() *User {
 	return r.User
 }
 
+func (o *UserCredential) GetCredentialUserDevices() UserDeviceSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCredentialUserDevices()
+}
+
+func (r *userCredentialR) GetCredentialUserDevices() UserDeviceSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CredentialUserDevices
+}
+
 // userCredentialL is where Load methods for each relationship are stored.
 type userCredentialL struct{}
 
 var (
-	userCredentialAllColumns            = []string{"id", "user_id", "credential_id", "public_key", "attestation_type", "aaguid", "sign_count", "device_name", "last_used_at", "created_at", "updated_at"}
+	userCredentialAllColumns            = []string{"id", "user_id", "credential_id", "public_key", "attestation_type", "aaguid", "sign_count", "device_name", "last_used_at", "created_at", "updated_at", "backup_eligible"}
 	userCredentialColumnsWithoutDefault = []string{"user_id", "credential_id", "public_key"}
-	userCredentialColumnsWithDefault    = []string{"id", "attestation_type", "aaguid", "sign_count", "device_name", "last_used_at", "created_at", "updated_at"}
+	userCredentialColumnsWithDefault    = []string{"id", "attestation_type", "aaguid", "sign_count", "device_name", "last_used_at", "created_at", "updated_at", "backup_eligible"}
 	userCredentialPrimaryKeyColumns     = []string{"id"}
 	userCredentialGeneratedColumns      = []string{}
 )
@@ -304,6 +330,20 @@ func (o *UserCredential) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// CredentialUserDevices retrieves all the user_device's UserDevices with an executor via credential_id column.
+func (o *UserCredential) CredentialUserDevices(mods ...qm.QueryMod) userDeviceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_devices\".\"credential_id\"=?", o.ID),
+	)
+
+	return UserDevices(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (userCredentialL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserCredential interface{}, mods queries.Applicator) error {
@@ -416,6 +456,112 @@ func (userCredentialL) LoadUser(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
+// LoadCredentialUserDevices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userCredentialL) LoadCredentialUserDevices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserCredential interface{}, mods queries.Applicator) error {
+	var slice []*UserCredential
+	var object *UserCredential
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserCredential.(*UserCredential)
+		if !ok {
+			object = new(UserCredential)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserCredential))
+			}
+		}
+	} else {
+		s, ok := maybeUserCredential.(*[]*UserCredential)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserCredential))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userCredentialR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userCredentialR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_devices`),
+		qm.WhereIn(`user_devices.credential_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_devices")
+	}
+
+	var resultSlice []*UserDevice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_devices")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_devices")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_devices")
+	}
+
+	if singular {
+		object.R.CredentialUserDevices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userDeviceR{}
+			}
+			foreign.R.Credential = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CredentialID) {
+				local.R.CredentialUserDevices = append(local.R.CredentialUserDevices, foreign)
+				if foreign.R == nil {
+					foreign.R = &userDeviceR{}
+				}
+				foreign.R.Credential = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the userCredential to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.UserCredentials.
@@ -463,6 +609,133 @@ func (o *UserCredential) SetUser(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddCredentialUserDevices adds the given related objects to the existing relationships
+// of the user_credential, optionally inserting them as new records.
+// Appends related to o.R.CredentialUserDevices.
+// Sets related.R.Credential appropriately.
+func (o *UserCredential) AddCredentialUserDevices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserDevice) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CredentialID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_devices\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"credential_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userDevicePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CredentialID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userCredentialR{
+			CredentialUserDevices: related,
+		}
+	} else {
+		o.R.CredentialUserDevices = append(o.R.CredentialUserDevices, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userDeviceR{
+				Credential: o,
+			}
+		} else {
+			rel.R.Credential = o
+		}
+	}
+	return nil
+}
+
+// SetCredentialUserDevices removes all previously related items of the
+// user_credential replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Credential's CredentialUserDevices accordingly.
+// Replaces o.R.CredentialUserDevices with related.
+// Sets related.R.Credential's CredentialUserDevices accordingly.
+func (o *UserCredential) SetCredentialUserDevices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserDevice) error {
+	query := "update \"user_devices\" set \"credential_id\" = null where \"credential_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CredentialUserDevices {
+			queries.SetScanner(&rel.CredentialID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Credential = nil
+		}
+		o.R.CredentialUserDevices = nil
+	}
+
+	return o.AddCredentialUserDevices(ctx, exec, insert, related...)
+}
+
+// RemoveCredentialUserDevices relationships from objects passed in.
+// Removes related items from R.CredentialUserDevices (uses pointer comparison, removal does not keep order)
+// Sets related.R.Credential.
+func (o *UserCredential) RemoveCredentialUserDevices(ctx context.Context, exec boil.ContextExecutor, related ...*UserDevice) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CredentialID, nil)
+		if rel.R != nil {
+			rel.R.Credential = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("credential_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CredentialUserDevices {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CredentialUserDevices)
+			if ln > 1 && i < ln-1 {
+				o.R.CredentialUserDevices[i] = o.R.CredentialUserDevices[ln-1]
+			}
+			o.R.CredentialUserDevices = o.R.CredentialUserDevices[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // UserCredentials retrieves all the records using an executor.
 func UserCredentials(mods ...qm.QueryMod) userCredentialQuery {
 	mods = append(mods, qm.From("\"user_credentials\""))
@@ -353,6 +353,334 @@ func testUserCredentialsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testUserCredentialToManyCredentialUserDevices(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserCredential
+	var b, c UserDevice
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userCredentialDBTypes, true, userCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserCredential struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CredentialID, a.ID)
+	queries.Assign(&c.CredentialID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CredentialUserDevices().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CredentialID, b.CredentialID) {
+			bFound = true
+		}
+		if queries.Equal(v.CredentialID, c.CredentialID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserCredentialSlice{&a}
+	if err = a.L.LoadCredentialUserDevices(ctx, tx, false, (*[]*UserCredential)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CredentialUserDevices); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CredentialUserDevices = nil
+	if err = a.L.LoadCredentialUserDevices(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CredentialUserDevices); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserCredentialToManyAddOpCredentialUserDevices(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserCredential
+	var b, c, d, e UserDevice
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*UserDevice{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, userDeviceDBTypes, false, strmangle.SetComplement(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*UserDevice{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCredentialUserDevices(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CredentialID) {
+			t.Error("foreign key was wrong value", a.ID, first.CredentialID)
+		}
+		if !queries.Equal(a.ID, second.CredentialID) {
+			t.Error("foreign key was wrong value", a.ID, second.CredentialID)
+		}
+
+		if first.R.Credential != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Credential != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CredentialUserDevices[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CredentialUserDevices[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CredentialUserDevices().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserCredentialToManySetOpCredentialUserDevices(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserCredential
+	var b, c, d, e UserDevice
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*UserDevice{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, userDeviceDBTypes, false, strmangle.SetComplement(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCredentialUserDevices(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CredentialUserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCredentialUserDevices(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CredentialUserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CredentialID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CredentialID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CredentialID) {
+		t.Error("foreign key was wrong value", a.ID, d.CredentialID)
+	}
+	if !queries.Equal(a.ID, e.CredentialID) {
+		t.Error("foreign key was wrong value", a.ID, e.CredentialID)
+	}
+
+	if b.R.Credential != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Credential != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Credential != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Credential != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CredentialUserDevices[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CredentialUserDevices[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserCredentialToManyRemoveOpCredentialUserDevices(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserCredential
+	var b, c, d, e UserDevice
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*UserDevice{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, userDeviceDBTypes, false, strmangle.SetComplement(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCredentialUserDevices(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CredentialUserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCredentialUserDevices(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CredentialUserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CredentialID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CredentialID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Credential != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Credential != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Credential != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Credential != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CredentialUserDevices) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CredentialUserDevices[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CredentialUserDevices[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testUserCredentialToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -537,7 +865,7 @@ func testUserCredentialsSelect(t *testing.T) {
 }
 
 var (
-	userCredentialDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `CredentialID`: `text`, `PublicKey`: `text`, `AttestationType`: `character varying`, `Aaguid`: `uuid`, `SignCount`: `integer`, `DeviceName`: `character varying`, `LastUsedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	userCredentialDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `CredentialID`: `text`, `PublicKey`: `text`, `AttestationType`: `character varying`, `Aaguid`: `uuid`, `SignCount`: `integer`, `DeviceName`: `character varying`, `LastUsedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `BackupEligible`: `boolean`}
 	_                     = bytes.MinRead
 )
 
@@ -0,0 +1,1207 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// UserDevice is an object representing the database table.
+type UserDevice struct {
+	ID                    string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID                string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CredentialID          null.String `boil:"credential_id" json:"credential_id,omitempty" toml:"credential_id" yaml:"credential_id,omitempty"`
+	NodeID                string      `boil:"node_id" json:"node_id" toml:"node_id" yaml:"node_id"`
+	DeviceID              string      `boil:"device_id" json:"device_id" toml:"device_id" yaml:"device_id"`
+	PublicKey             string      `boil:"public_key" json:"public_key" toml:"public_key" yaml:"public_key"`
+	Name                  null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
+	Version               null.String `boil:"version" json:"version,omitempty" toml:"version" yaml:"version,omitempty"`
+	Status                string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	NodeStatus            null.String `boil:"node_status" json:"node_status,omitempty" toml:"node_status" yaml:"node_status,omitempty"`
+	LastHeartbeatAt       null.Time   `boil:"last_heartbeat_at" json:"last_heartbeat_at,omitempty" toml:"last_heartbeat_at" yaml:"last_heartbeat_at,omitempty"`
+	RevokedAt             null.Time   `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+	CreatedAt             time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	NodeRevocationPending bool        `boil:"node_revocation_pending" json:"node_revocation_pending" toml:"node_revocation_pending" yaml:"node_revocation_pending"`
+
+	R *userDeviceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userDeviceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserDeviceColumns = struct {
+	ID                    string
+	UserID                string
+	CredentialID          string
+	NodeID                string
+	DeviceID              string
+	PublicKey             string
+	Name                  string
+	Version               string
+	Status                string
+	NodeStatus            string
+	LastHeartbeatAt       string
+	RevokedAt             string
+	CreatedAt             string
+	UpdatedAt             string
+	NodeRevocationPending string
+}{
+	ID:                    "id",
+	UserID:                "user_id",
+	CredentialID:          "credential_id",
+	NodeID:                "node_id",
+	DeviceID:              "device_id",
+	PublicKey:             "public_key",
+	Name:                  "name",
+	Version:               "version",
+	Status:                "status",
+	NodeStatus:            "node_status",
+	LastHeartbeatAt:       "last_heartbeat_at",
+	RevokedAt:             "revoked_at",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	NodeRevocationPending: "node_revocation_pending",
+}
+
+var UserDeviceTableColumns = struct {
+	ID                    string
+	UserID                string
+	CredentialID          string
+	NodeID                string
+	DeviceID              string
+	PublicKey             string
+	Name                  string
+	Version               string
+	Status                string
+	NodeStatus            string
+	LastHeartbeatAt       string
+	RevokedAt             string
+	CreatedAt             string
+	UpdatedAt             string
+	NodeRevocationPending string
+}{
+	ID:                    "user_devices.id",
+	UserID:                "user_devices.user_id",
+	CredentialID:          "user_devices.credential_id",
+	NodeID:                "user_devices.node_id",
+	DeviceID:              "user_devices.device_id",
+	PublicKey:             "user_devices.public_key",
+	Name:                  "user_devices.name",
+	Version:               "user_devices.version",
+	Status:                "user_devices.status",
+	NodeStatus:            "user_devices.node_status",
+	LastHeartbeatAt:       "user_devices.last_heartbeat_at",
+	RevokedAt:             "user_devices.revoked_at",
+	CreatedAt:             "user_devices.created_at",
+	UpdatedAt:             "user_devices.updated_at",
+	NodeRevocationPending: "user_devices.node_revocation_pending",
+}
+
+// Generated where
+
+var UserDeviceWhere = struct {
+	ID                    whereHelperstring
+	UserID                whereHelperstring
+	CredentialID          whereHelpernull_String
+	NodeID                whereHelperstring
+	DeviceID              whereHelperstring
+	PublicKey             whereHelperstring
+	Name                  whereHelpernull_String
+	Version               whereHelpernull_String
+	Status                whereHelperstring
+	NodeStatus            whereHelpernull_String
+	LastHeartbeatAt       whereHelpernull_Time
+	RevokedAt             whereHelpernull_Time
+	CreatedAt             whereHelpertime_Time
+	UpdatedAt             whereHelpertime_Time
+	NodeRevocationPending whereHelperbool
+}{
+	ID:                    whereHelperstring{field: "\"user_devices\".\"id\""},
+	UserID:                whereHelperstring{field: "\"user_devices\".\"user_id\""},
+	CredentialID:          whereHelpernull_String{field: "\"user_devices\".\"credential_id\""},
+	NodeID:                whereHelperstring{field: "\"user_devices\".\"node_id\""},
+	DeviceID:              whereHelperstring{field: "\"user_devices\".\"device_id\""},
+	PublicKey:             whereHelperstring{field: "\"user_devices\".\"public_key\""},
+	Name:                  whereHelpernull_String{field: "\"user_devices\".\"name\""},
+	Version:               whereHelpernull_String{field: "\"user_devices\".\"version\""},
+	Status:                whereHelperstring{field: "\"user_devices\".\"status\""},
+	NodeStatus:            whereHelpernull_String{field: "\"user_devices\".\"node_status\""},
+	LastHeartbeatAt:       whereHelpernull_Time{field: "\"user_devices\".\"last_heartbeat_at\""},
+	RevokedAt:             whereHelpernull_Time{field: "\"user_devices\".\"revoked_at\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"user_devices\".\"created_at\""},
+	UpdatedAt:             whereHelpertime_Time{field: "\"user_devices\".\"updated_at\""},
+	NodeRevocationPending: whereHelperbool{field: "\"user_devices\".\"node_revocation_pending\""},
+}
+
+// UserDeviceRels is where relationship names are stored.
+var UserDeviceRels = struct {
+	Credential string
+	User       string
+}{
+	Credential: "Credential",
+	User:       "User",
+}
+
+// userDeviceR is where relationships are stored.
+type userDeviceR struct {
+	Credential *UserCredential `boil:"Credential" json:"Credential" toml:"Credential" yaml:"Credential"`
+	User       *User           `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userDeviceR) NewStruct() *userDeviceR {
+	return &userDeviceR{}
+}
+
+func (o *UserDevice) GetCredential() *UserCredential {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCredential()
+}
+
+func (r *userDeviceR) GetCredential() *UserCredential {
+	if r == nil {
+		return nil
+	}
+
+	return r.Credential
+}
+
+func (o *UserDevice) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *userDeviceR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// userDeviceL is where Load methods for each relationship are stored.
+type userDeviceL struct{}
+
+var (
+	userDeviceAllColumns            = []string{"id", "user_id", "credential_id", "node_id", "device_id", "public_key", "name", "version", "status", "node_status", "last_heartbeat_at", "revoked_at", "created_at", "updated_at", "node_revocation_pending"}
+	userDeviceColumnsWithoutDefault = []string{"user_id", "node_id", "device_id", "public_key", "status"}
+	userDeviceColumnsWithDefault    = []string{"id", "credential_id", "name", "version", "node_status", "last_heartbeat_at", "revoked_at", "created_at", "updated_at", "node_revocation_pending"}
+	userDevicePrimaryKeyColumns     = []string{"id"}
+	userDeviceGeneratedColumns      = []string{}
+)
+
+type (
+	// UserDeviceSlice is an alias for a slice of pointers to UserDevice.
+	// This should almost always be used instead of []UserDevice.
+	UserDeviceSlice []*UserDevice
+
+	userDeviceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userDeviceType                 = reflect.TypeOf(&UserDevice{})
+	userDeviceMapping              = queries.MakeStructMapping(userDeviceType)
+	userDevicePrimaryKeyMapping, _ = queries.BindMapping(userDeviceType, userDeviceMapping, userDevicePrimaryKeyColumns)
+	userDeviceInsertCacheMut       sync.RWMutex
+	userDeviceInsertCache          = make(map[string]insertCache)
+	userDeviceUpdateCacheMut       sync.RWMutex
+	userDeviceUpdateCache          = make(map[string]updateCache)
+	userDeviceUpsertCacheMut       sync.RWMutex
+	userDeviceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single userDevice record from the query.
+func (q userDeviceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserDevice, error) {
+	o := &UserDevice{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for user_devices")
+	}
+
+	return o, nil
+}
+
+// All returns all UserDevice records from the query.
+func (q userDeviceQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserDeviceSlice, error) {
+	var o []*UserDevice
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UserDevice slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserDevice records in the query.
+func (q userDeviceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count user_devices rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userDeviceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if user_devices exists")
+	}
+
+	return count > 0, nil
+}
+
+// Credential pointed to by the foreign key.
+func (o *UserDevice) Credential(mods ...qm.QueryMod) userCredentialQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CredentialID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserCredentials(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *UserDevice) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadCredential allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userDeviceL) LoadCredential(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserDevice interface{}, mods queries.Applicator) error {
+	var slice []*UserDevice
+	var object *UserDevice
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserDevice.(*UserDevice)
+		if !ok {
+			object = new(UserDevice)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserDevice)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserDevice))
+			}
+		}
+	} else {
+		s, ok := maybeUserDevice.(*[]*UserDevice)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserDevice)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserDevice))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userDeviceR{}
+		}
+		if !queries.IsNil(object.CredentialID) {
+			args[object.CredentialID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userDeviceR{}
+			}
+
+			if !queries.IsNil(obj.CredentialID) {
+				args[obj.CredentialID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_credentials`),
+		qm.WhereIn(`user_credentials.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserCredential")
+	}
+
+	var resultSlice []*UserCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserCredential")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_credentials")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Credential = foreign
+		if foreign.R == nil {
+			foreign.R = &userCredentialR{}
+		}
+		foreign.R.CredentialUserDevices = append(foreign.R.CredentialUserDevices, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CredentialID, foreign.ID) {
+				local.R.Credential = foreign
+				if foreign.R == nil {
+					foreign.R = &userCredentialR{}
+				}
+				foreign.R.CredentialUserDevices = append(foreign.R.CredentialUserDevices, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userDeviceL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserDevice interface{}, mods queries.Applicator) error {
+	var slice []*UserDevice
+	var object *UserDevice
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserDevice.(*UserDevice)
+		if !ok {
+			object = new(UserDevice)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserDevice)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserDevice))
+			}
+		}
+	} else {
+		s, ok := maybeUserDevice.(*[]*UserDevice)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserDevice)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserDevice))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userDeviceR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userDeviceR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserDevices = append(foreign.R.UserDevices, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserDevices = append(foreign.R.UserDevices, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCredential of the userDevice to the related item.
+// Sets o.R.Credential to related.
+// Adds o to related.R.CredentialUserDevices.
+func (o *UserDevice) SetCredential(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserCredential) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_devices\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"credential_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userDevicePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CredentialID, related.ID)
+	if o.R == nil {
+		o.R = &userDeviceR{
+			Credential: related,
+		}
+	} else {
+		o.R.Credential = related
+	}
+
+	if related.R == nil {
+		related.R = &userCredentialR{
+			CredentialUserDevices: UserDeviceSlice{o},
+		}
+	} else {
+		related.R.CredentialUserDevices = append(related.R.CredentialUserDevices, o)
+	}
+
+	return nil
+}
+
+// RemoveCredential relationship.
+// Sets o.R.Credential to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *UserDevice) RemoveCredential(ctx context.Context, exec boil.ContextExecutor, related *UserCredential) error {
+	var err error
+
+	queries.SetScanner(&o.CredentialID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("credential_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Credential = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CredentialUserDevices {
+		if queries.Equal(o.CredentialID, ri.CredentialID) {
+			continue
+		}
+
+		ln := len(related.R.CredentialUserDevices)
+		if ln > 1 && i < ln-1 {
+			related.R.CredentialUserDevices[i] = related.R.CredentialUserDevices[ln-1]
+		}
+		related.R.CredentialUserDevices = related.R.CredentialUserDevices[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the userDevice to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserDevices.
+func (o *UserDevice) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_devices\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userDevicePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &userDeviceR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserDevices: UserDeviceSlice{o},
+		}
+	} else {
+		related.R.UserDevices = append(related.R.UserDevices, o)
+	}
+
+	return nil
+}
+
+// UserDevices retrieves all the records using an executor.
+func UserDevices(mods ...qm.QueryMod) userDeviceQuery {
+	mods = append(mods, qm.From("\"user_devices\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"user_devices\".*"})
+	}
+
+	return userDeviceQuery{q}
+}
+
+// FindUserDevice retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserDevice(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*UserDevice, error) {
+	userDeviceObj := &UserDevice{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_devices\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, userDeviceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from user_devices")
+	}
+
+	return userDeviceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserDevice) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_devices provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userDeviceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userDeviceInsertCacheMut.RLock()
+	cache, cached := userDeviceInsertCache[key]
+	userDeviceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userDeviceAllColumns,
+			userDeviceColumnsWithDefault,
+			userDeviceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userDeviceType, userDeviceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userDeviceType, userDeviceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_devices\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_devices\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into user_devices")
+	}
+
+	if !cached {
+		userDeviceInsertCacheMut.Lock()
+		userDeviceInsertCache[key] = cache
+		userDeviceInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the UserDevice.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserDevice) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	userDeviceUpdateCacheMut.RLock()
+	cache, cached := userDeviceUpdateCache[key]
+	userDeviceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userDeviceAllColumns,
+			userDevicePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update user_devices, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_devices\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userDevicePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userDeviceType, userDeviceMapping, append(wl, userDevicePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update user_devices row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for user_devices")
+	}
+
+	if !cached {
+		userDeviceUpdateCacheMut.Lock()
+		userDeviceUpdateCache[key] = cache
+		userDeviceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userDeviceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for user_devices")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for user_devices")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserDeviceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userDevicePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_devices\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userDevicePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in userDevice slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all userDevice")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserDevice) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no user_devices provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userDeviceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userDeviceUpsertCacheMut.RLock()
+	cache, cached := userDeviceUpsertCache[key]
+	userDeviceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userDeviceAllColumns,
+			userDeviceColumnsWithDefault,
+			userDeviceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userDeviceAllColumns,
+			userDevicePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert user_devices, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userDeviceAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(userDevicePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert user_devices, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(userDevicePrimaryKeyColumns))
+			copy(conflict, userDevicePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_devices\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(userDeviceType, userDeviceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userDeviceType, userDeviceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert user_devices")
+	}
+
+	if !cached {
+		userDeviceUpsertCacheMut.Lock()
+		userDeviceUpsertCache[key] = cache
+		userDeviceUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single UserDevice record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserDevice) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UserDevice provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userDevicePrimaryKeyMapping)
+	sql := "DELETE FROM \"user_devices\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from user_devices")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for user_devices")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userDeviceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no userDeviceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from user_devices")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_devices")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserDeviceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userDevicePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_devices\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userDevicePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from userDevice slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_devices")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserDevice) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserDevice(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserDeviceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserDeviceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userDevicePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_devices\".* FROM \"user_devices\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userDevicePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UserDeviceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserDeviceExists checks if the UserDevice row exists.
+func UserDeviceExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_devices\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if user_devices exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserDevice row exists.
+func (o *UserDevice) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserDeviceExists(ctx, exec, o.ID)
+}
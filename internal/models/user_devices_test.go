@@ -0,0 +1,862 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testUserDevices(t *testing.T) {
+	t.Parallel()
+
+	query := UserDevices()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testUserDevicesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserDevicesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := UserDevices().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserDevicesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UserDeviceSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserDevicesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := UserDeviceExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if UserDevice exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected UserDeviceExists to return true, but got false.")
+	}
+}
+
+func testUserDevicesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	userDeviceFound, err := FindUserDevice(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if userDeviceFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testUserDevicesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = UserDevices().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserDevicesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := UserDevices().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testUserDevicesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	userDeviceOne := &UserDevice{}
+	userDeviceTwo := &UserDevice{}
+	if err = randomize.Struct(seed, userDeviceOne, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+	if err = randomize.Struct(seed, userDeviceTwo, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = userDeviceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = userDeviceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UserDevices().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testUserDevicesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	userDeviceOne := &UserDevice{}
+	userDeviceTwo := &UserDevice{}
+	if err = randomize.Struct(seed, userDeviceOne, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+	if err = randomize.Struct(seed, userDeviceTwo, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = userDeviceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = userDeviceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testUserDevicesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUserDevicesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUserDeviceToOneUserCredentialUsingCredential(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local UserDevice
+	var foreign UserCredential
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userCredentialDBTypes, false, userCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserCredential struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CredentialID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Credential().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := UserDeviceSlice{&local}
+	if err = local.L.LoadCredential(ctx, tx, false, (*[]*UserDevice)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Credential == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Credential = nil
+	if err = local.L.LoadCredential(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Credential == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testUserDeviceToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local UserDevice
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := UserDeviceSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*UserDevice)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testUserDeviceToOneSetOpUserCredentialUsingCredential(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserDevice
+	var b, c UserCredential
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDeviceDBTypes, false, strmangle.SetComplement(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*UserCredential{&b, &c} {
+		err = a.SetCredential(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Credential != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CredentialUserDevices[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CredentialID, x.ID) {
+			t.Error("foreign key was wrong value", a.CredentialID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CredentialID))
+		reflect.Indirect(reflect.ValueOf(&a.CredentialID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CredentialID, x.ID) {
+			t.Error("foreign key was wrong value", a.CredentialID, x.ID)
+		}
+	}
+}
+
+func testUserDeviceToOneRemoveOpUserCredentialUsingCredential(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserDevice
+	var b UserCredential
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDeviceDBTypes, false, strmangle.SetComplement(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCredential(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCredential(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Credential().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Credential != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CredentialID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CredentialUserDevices) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testUserDeviceToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserDevice
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDeviceDBTypes, false, strmangle.SetComplement(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.UserDevices[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testUserDevicesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserDevicesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UserDeviceSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserDevicesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UserDevices().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	userDeviceDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `CredentialID`: `uuid`, `NodeID`: `character varying`, `DeviceID`: `character varying`, `PublicKey`: `text`, `Name`: `character varying`, `Version`: `character varying`, `Status`: `character varying`, `NodeStatus`: `character varying`, `LastHeartbeatAt`: `timestamp with time zone`, `RevokedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `NodeRevocationPending`: `boolean`}
+	_                 = bytes.MinRead
+)
+
+func testUserDevicesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(userDevicePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(userDeviceAllColumns) == len(userDevicePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDevicePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testUserDevicesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(userDeviceAllColumns) == len(userDevicePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserDevice{}
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDeviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, userDeviceDBTypes, true, userDevicePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(userDeviceAllColumns, userDevicePrimaryKeyColumns) {
+		fields = userDeviceAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			userDeviceAllColumns,
+			userDevicePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := UserDeviceSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testUserDevicesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(userDeviceAllColumns) == len(userDevicePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := UserDevice{}
+	if err = randomize.Struct(seed, &o, userDeviceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UserDevice: %s", err)
+	}
+
+	count, err := UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, userDeviceDBTypes, false, userDevicePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserDevice struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UserDevice: %s", err)
+	}
+
+	count, err = UserDevices().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
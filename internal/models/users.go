@@ -85,36 +85,6 @@ var UserTableColumns = struct {
 
 // Generated where
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
-type whereHelpertypes_StringArray struct{ field string }
-
-func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var UserWhere = struct {
 	ID                   whereHelperstring
 	Username             whereHelpernull_String
@@ -139,50 +109,86 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	AppUserProfile           string
-	AccessTokens             string
-	CreatedByAddressBooks    string
-	Approvals                string
-	AuditLogs                string
-	ConfirmationTokens       string
-	OrganizationMembers      string
-	OwnerOrganizations       string
-	PasswordResetTokens      string
-	PushTokens               string
-	RefreshTokens            string
-	InitiatorSigningRequests string
-	UserCredentials          string
+	AppUserProfile                    string
+	AccessTokens                      string
+	ApprovedByAddressBooks            string
+	CreatedByAddressBooks             string
+	Approvals                         string
+	ConfirmationTokens                string
+	KeyShareBackups                   string
+	InitiatorKeyShareRecoveries       string
+	SubjectUserKeyShareRecoveries     string
+	KeyShareRecoveryApprovals         string
+	NotificationPreferences           string
+	AcceptedByOrganizationInvitations string
+	InvitedByOrganizationInvitations  string
+	OrganizationMembers               string
+	OwnerOrganizations                string
+	PasskeyChallenges                 string
+	PasswordResetTokens               string
+	PushTokens                        string
+	RefreshTokens                     string
+	InitiatorSigningRequests          string
+	UserCredentials                   string
+	UserDevices                       string
+	VaultProposalApprovals            string
+	InitiatorVaultProposals           string
+	CreatedByWebhookEndpoints         string
 }{
-	AppUserProfile:           "AppUserProfile",
-	AccessTokens:             "AccessTokens",
-	CreatedByAddressBooks:    "CreatedByAddressBooks",
-	Approvals:                "Approvals",
-	AuditLogs:                "AuditLogs",
-	ConfirmationTokens:       "ConfirmationTokens",
-	OrganizationMembers:      "OrganizationMembers",
-	OwnerOrganizations:       "OwnerOrganizations",
-	PasswordResetTokens:      "PasswordResetTokens",
-	PushTokens:               "PushTokens",
-	RefreshTokens:            "RefreshTokens",
-	InitiatorSigningRequests: "InitiatorSigningRequests",
-	UserCredentials:          "UserCredentials",
+	AppUserProfile:                    "AppUserProfile",
+	AccessTokens:                      "AccessTokens",
+	ApprovedByAddressBooks:            "ApprovedByAddressBooks",
+	CreatedByAddressBooks:             "CreatedByAddressBooks",
+	Approvals:                         "Approvals",
+	ConfirmationTokens:                "ConfirmationTokens",
+	KeyShareBackups:                   "KeyShareBackups",
+	InitiatorKeyShareRecoveries:       "InitiatorKeyShareRecoveries",
+	SubjectUserKeyShareRecoveries:     "SubjectUserKeyShareRecoveries",
+	KeyShareRecoveryApprovals:         "KeyShareRecoveryApprovals",
+	NotificationPreferences:           "NotificationPreferences",
+	AcceptedByOrganizationInvitations: "AcceptedByOrganizationInvitations",
+	InvitedByOrganizationInvitations:  "InvitedByOrganizationInvitations",
+	OrganizationMembers:               "OrganizationMembers",
+	OwnerOrganizations:                "OwnerOrganizations",
+	PasskeyChallenges:                 "PasskeyChallenges",
+	PasswordResetTokens:               "PasswordResetTokens",
+	PushTokens:                        "PushTokens",
+	RefreshTokens:                     "RefreshTokens",
+	InitiatorSigningRequests:          "InitiatorSigningRequests",
+	UserCredentials:                   "UserCredentials",
+	UserDevices:                       "UserDevices",
+	VaultProposalApprovals:            "VaultProposalApprovals",
+	InitiatorVaultProposals:           "InitiatorVaultProposals",
+	CreatedByWebhookEndpoints:         "CreatedByWebhookEndpoints",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	AppUserProfile           *AppUserProfile         `boil:"AppUserProfile" json:"AppUserProfile" toml:"AppUserProfile" yaml:"AppUserProfile"`
-	AccessTokens             AccessTokenSlice        `boil:"AccessTokens" json:"AccessTokens" toml:"AccessTokens" yaml:"AccessTokens"`
-	CreatedByAddressBooks    AddressBookSlice        `boil:"CreatedByAddressBooks" json:"CreatedByAddressBooks" toml:"CreatedByAddressBooks" yaml:"CreatedByAddressBooks"`
-	Approvals                ApprovalSlice           `boil:"Approvals" json:"Approvals" toml:"Approvals" yaml:"Approvals"`
-	AuditLogs                AuditLogSlice           `boil:"AuditLogs" json:"AuditLogs" toml:"AuditLogs" yaml:"AuditLogs"`
-	ConfirmationTokens       ConfirmationTokenSlice  `boil:"ConfirmationTokens" json:"ConfirmationTokens" toml:"ConfirmationTokens" yaml:"ConfirmationTokens"`
-	OrganizationMembers      OrganizationMemberSlice `boil:"OrganizationMembers" json:"OrganizationMembers" toml:"OrganizationMembers" yaml:"OrganizationMembers"`
-	OwnerOrganizations       OrganizationSlice       `boil:"OwnerOrganizations" json:"OwnerOrganizations" toml:"OwnerOrganizations" yaml:"OwnerOrganizations"`
-	PasswordResetTokens      PasswordResetTokenSlice `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
-	PushTokens               PushTokenSlice          `boil:"PushTokens" json:"PushTokens" toml:"PushTokens" yaml:"PushTokens"`
-	RefreshTokens            RefreshTokenSlice       `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
-	InitiatorSigningRequests SigningRequestSlice     `boil:"InitiatorSigningRequests" json:"InitiatorSigningRequests" toml:"InitiatorSigningRequests" yaml:"InitiatorSigningRequests"`
-	UserCredentials          UserCredentialSlice     `boil:"UserCredentials" json:"UserCredentials" toml:"UserCredentials" yaml:"UserCredentials"`
+	AppUserProfile                    *AppUserProfile               `boil:"AppUserProfile" json:"AppUserProfile" toml:"AppUserProfile" yaml:"AppUserProfile"`
+	AccessTokens                      AccessTokenSlice              `boil:"AccessTokens" json:"AccessTokens" toml:"AccessTokens" yaml:"AccessTokens"`
+	ApprovedByAddressBooks            AddressBookSlice              `boil:"ApprovedByAddressBooks" json:"ApprovedByAddressBooks" toml:"ApprovedByAddressBooks" yaml:"ApprovedByAddressBooks"`
+	CreatedByAddressBooks             AddressBookSlice              `boil:"CreatedByAddressBooks" json:"CreatedByAddressBooks" toml:"CreatedByAddressBooks" yaml:"CreatedByAddressBooks"`
+	Approvals                         ApprovalSlice                 `boil:"Approvals" json:"Approvals" toml:"Approvals" yaml:"Approvals"`
+	ConfirmationTokens                ConfirmationTokenSlice        `boil:"ConfirmationTokens" json:"ConfirmationTokens" toml:"ConfirmationTokens" yaml:"ConfirmationTokens"`
+	KeyShareBackups                   KeyShareBackupSlice           `boil:"KeyShareBackups" json:"KeyShareBackups" toml:"KeyShareBackups" yaml:"KeyShareBackups"`
+	InitiatorKeyShareRecoveries       KeyShareRecoverySlice         `boil:"InitiatorKeyShareRecoveries" json:"InitiatorKeyShareRecoveries" toml:"InitiatorKeyShareRecoveries" yaml:"InitiatorKeyShareRecoveries"`
+	SubjectUserKeyShareRecoveries     KeyShareRecoverySlice         `boil:"SubjectUserKeyShareRecoveries" json:"SubjectUserKeyShareRecoveries" toml:"SubjectUserKeyShareRecoveries" yaml:"SubjectUserKeyShareRecoveries"`
+	KeyShareRecoveryApprovals         KeyShareRecoveryApprovalSlice `boil:"KeyShareRecoveryApprovals" json:"KeyShareRecoveryApprovals" toml:"KeyShareRecoveryApprovals" yaml:"KeyShareRecoveryApprovals"`
+	NotificationPreferences           NotificationPreferenceSlice   `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	AcceptedByOrganizationInvitations OrganizationInvitationSlice   `boil:"AcceptedByOrganizationInvitations" json:"AcceptedByOrganizationInvitations" toml:"AcceptedByOrganizationInvitations" yaml:"AcceptedByOrganizationInvitations"`
+	InvitedByOrganizationInvitations  OrganizationInvitationSlice   `boil:"InvitedByOrganizationInvitations" json:"InvitedByOrganizationInvitations" toml:"InvitedByOrganizationInvitations" yaml:"InvitedByOrganizationInvitations"`
+	OrganizationMembers               OrganizationMemberSlice       `boil:"OrganizationMembers" json:"OrganizationMembers" toml:"OrganizationMembers" yaml:"OrganizationMembers"`
+	OwnerOrganizations                OrganizationSlice             `boil:"OwnerOrganizations" json:"OwnerOrganizations" toml:"OwnerOrganizations" yaml:"OwnerOrganizations"`
+	PasskeyChallenges                 PasskeyChallengeSlice         `boil:"PasskeyChallenges" json:"PasskeyChallenges" toml:"PasskeyChallenges" yaml:"PasskeyChallenges"`
+	PasswordResetTokens               PasswordResetTokenSlice       `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
+	PushTokens                        PushTokenSlice                `boil:"PushTokens" json:"PushTokens" toml:"PushTokens" yaml:"PushTokens"`
+	RefreshTokens                     RefreshTokenSlice             `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	InitiatorSigningRequests          SigningRequestSlice           `boil:"InitiatorSigningRequests" json:"InitiatorSigningRequests" toml:"InitiatorSigningRequests" yaml:"InitiatorSigningRequests"`
+	UserCredentials                   UserCredentialSlice           `boil:"UserCredentials" json:"UserCredentials" toml:"UserCredentials" yaml:"UserCredentials"`
+	UserDevices                       UserDeviceSlice               `boil:"UserDevices" json:"UserDevices" toml:"UserDevices" yaml:"UserDevices"`
+	VaultProposalApprovals            VaultProposalApprovalSlice    `boil:"VaultProposalApprovals" json:"VaultProposalApprovals" toml:"VaultProposalApprovals" yaml:"VaultProposalApprovals"`
+	InitiatorVaultProposals           VaultProposalSlice            `boil:"InitiatorVaultProposals" json:"InitiatorVaultProposals" toml:"InitiatorVaultProposals" yaml:"InitiatorVaultProposals"`
+	CreatedByWebhookEndpoints         WebhookEndpointSlice          `boil:"CreatedByWebhookEndpoints" json:"CreatedByWebhookEndpoints" toml:"CreatedByWebhookEndpoints" yaml:"CreatedByWebhookEndpoints"`
 }
 
 // NewStruct creates a new relationship struct
@@ -222,6 +228,22 @@ func (r *userR) GetAccessTokens() AccessTokenSlice {
 	return r.AccessTokens
 }
 
+func (o *User) GetApprovedByAddressBooks() AddressBookSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApprovedByAddressBooks()
+}
+
+func (r *userR) GetApprovedByAddressBooks() AddressBookSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ApprovedByAddressBooks
+}
+
 func (o *User) GetCreatedByAddressBooks() AddressBookSlice {
 	if o == nil {
 		return nil
@@ -254,36 +276,132 @@ func (r *userR) GetApprovals() ApprovalSlice {
 	return r.Approvals
 }
 
-func (o *User) GetAuditLogs() AuditLogSlice {
+func (o *User) GetConfirmationTokens() ConfirmationTokenSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetAuditLogs()
+	return o.R.GetConfirmationTokens()
 }
 
-func (r *userR) GetAuditLogs() AuditLogSlice {
+func (r *userR) GetConfirmationTokens() ConfirmationTokenSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.AuditLogs
+	return r.ConfirmationTokens
 }
 
-func (o *User) GetConfirmationTokens() ConfirmationTokenSlice {
+func (o *User) GetKeyShareBackups() KeyShareBackupSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetConfirmationTokens()
+	return o.R.GetKeyShareBackups()
 }
 
-func (r *userR) GetConfirmationTokens() ConfirmationTokenSlice {
+func (r *userR) GetKeyShareBackups() KeyShareBackupSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.ConfirmationTokens
+	return r.KeyShareBackups
+}
+
+func (o *User) GetInitiatorKeyShareRecoveries() KeyShareRecoverySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInitiatorKeyShareRecoveries()
+}
+
+func (r *userR) GetInitiatorKeyShareRecoveries() KeyShareRecoverySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.InitiatorKeyShareRecoveries
+}
+
+func (o *User) GetSubjectUserKeyShareRecoveries() KeyShareRecoverySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubjectUserKeyShareRecoveries()
+}
+
+func (r *userR) GetSubjectUserKeyShareRecoveries() KeyShareRecoverySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubjectUserKeyShareRecoveries
+}
+
+func (o *User) GetKeyShareRecoveryApprovals() KeyShareRecoveryApprovalSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetKeyShareRecoveryApprovals()
+}
+
+func (r *userR) GetKeyShareRecoveryApprovals() KeyShareRecoveryApprovalSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.KeyShareRecoveryApprovals
+}
+
+func (o *User) GetNotificationPreferences() NotificationPreferenceSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotificationPreferences()
+}
+
+func (r *userR) GetNotificationPreferences() NotificationPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.NotificationPreferences
+}
+
+func (o *User) GetAcceptedByOrganizationInvitations() OrganizationInvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAcceptedByOrganizationInvitations()
+}
+
+func (r *userR) GetAcceptedByOrganizationInvitations() OrganizationInvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AcceptedByOrganizationInvitations
+}
+
+func (o *User) GetInvitedByOrganizationInvitations() OrganizationInvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInvitedByOrganizationInvitations()
+}
+
+func (r *userR) GetInvitedByOrganizationInvitations() OrganizationInvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.InvitedByOrganizationInvitations
 }
 
 func (o *User) GetOrganizationMembers() OrganizationMemberSlice {
@@ -318,6 +436,22 @@ func (r *userR) GetOwnerOrganizations() OrganizationSlice {
 	return r.OwnerOrganizations
 }
 
+func (o *User) GetPasskeyChallenges() PasskeyChallengeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPasskeyChallenges()
+}
+
+func (r *userR) GetPasskeyChallenges() PasskeyChallengeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PasskeyChallenges
+}
+
 func (o *User) GetPasswordResetTokens() PasswordResetTokenSlice {
 	if o == nil {
 		return nil
@@ -398,6 +532,70 @@ func (r *userR) GetUserCredentials() UserCredentialSlice {
 	return r.UserCredentials
 }
 
+func (o *User) GetUserDevices() UserDeviceSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserDevices()
+}
+
+func (r *userR) GetUserDevices() UserDeviceSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserDevices
+}
+
+func (o *User) GetVaultProposalApprovals() VaultProposalApprovalSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVaultProposalApprovals()
+}
+
+func (r *userR) GetVaultProposalApprovals() VaultProposalApprovalSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.VaultProposalApprovals
+}
+
+func (o *User) GetInitiatorVaultProposals() VaultProposalSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInitiatorVaultProposals()
+}
+
+func (r *userR) GetInitiatorVaultProposals() VaultProposalSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.InitiatorVaultProposals
+}
+
+func (o *User) GetCreatedByWebhookEndpoints() WebhookEndpointSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByWebhookEndpoints()
+}
+
+func (r *userR) GetCreatedByWebhookEndpoints() WebhookEndpointSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByWebhookEndpoints
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -525,6 +723,20 @@ func (o *User) AccessTokens(mods ...qm.QueryMod) accessTokenQuery {
 	return AccessTokens(queryMods...)
 }
 
+// ApprovedByAddressBooks retrieves all the address_book's AddressBooks with an executor via approved_by column.
+func (o *User) ApprovedByAddressBooks(mods ...qm.QueryMod) addressBookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"address_book\".\"approved_by\"=?", o.ID),
+	)
+
+	return AddressBooks(queryMods...)
+}
+
 // CreatedByAddressBooks retrieves all the address_book's AddressBooks with an executor via created_by column.
 func (o *User) CreatedByAddressBooks(mods ...qm.QueryMod) addressBookQuery {
 	var queryMods []qm.QueryMod
@@ -553,32 +765,116 @@ func (o *User) Approvals(mods ...qm.QueryMod) approvalQuery {
 	return Approvals(queryMods...)
 }
 
-// AuditLogs retrieves all the audit_log's AuditLogs with an executor.
-func (o *User) AuditLogs(mods ...qm.QueryMod) auditLogQuery {
+// ConfirmationTokens retrieves all the confirmation_token's ConfirmationTokens with an executor.
+func (o *User) ConfirmationTokens(mods ...qm.QueryMod) confirmationTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"confirmation_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return ConfirmationTokens(queryMods...)
+}
+
+// KeyShareBackups retrieves all the key_share_backup's KeyShareBackups with an executor.
+func (o *User) KeyShareBackups(mods ...qm.QueryMod) keyShareBackupQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"audit_logs\".\"user_id\"=?", o.ID),
+		qm.Where("\"key_share_backups\".\"user_id\"=?", o.ID),
 	)
 
-	return AuditLogs(queryMods...)
+	return KeyShareBackups(queryMods...)
 }
 
-// ConfirmationTokens retrieves all the confirmation_token's ConfirmationTokens with an executor.
-func (o *User) ConfirmationTokens(mods ...qm.QueryMod) confirmationTokenQuery {
+// InitiatorKeyShareRecoveries retrieves all the key_share_recovery's KeyShareRecoveries with an executor via initiator_id column.
+func (o *User) InitiatorKeyShareRecoveries(mods ...qm.QueryMod) keyShareRecoveryQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"confirmation_tokens\".\"user_id\"=?", o.ID),
+		qm.Where("\"key_share_recoveries\".\"initiator_id\"=?", o.ID),
 	)
 
-	return ConfirmationTokens(queryMods...)
+	return KeyShareRecoveries(queryMods...)
+}
+
+// SubjectUserKeyShareRecoveries retrieves all the key_share_recovery's KeyShareRecoveries with an executor via subject_user_id column.
+func (o *User) SubjectUserKeyShareRecoveries(mods ...qm.QueryMod) keyShareRecoveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_share_recoveries\".\"subject_user_id\"=?", o.ID),
+	)
+
+	return KeyShareRecoveries(queryMods...)
+}
+
+// KeyShareRecoveryApprovals retrieves all the key_share_recovery_approval's KeyShareRecoveryApprovals with an executor.
+func (o *User) KeyShareRecoveryApprovals(mods ...qm.QueryMod) keyShareRecoveryApprovalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_share_recovery_approvals\".\"user_id\"=?", o.ID),
+	)
+
+	return KeyShareRecoveryApprovals(queryMods...)
+}
+
+// NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
+func (o *User) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_preferences\".\"user_id\"=?", o.ID),
+	)
+
+	return NotificationPreferences(queryMods...)
+}
+
+// AcceptedByOrganizationInvitations retrieves all the organization_invitation's OrganizationInvitations with an executor via accepted_by column.
+func (o *User) AcceptedByOrganizationInvitations(mods ...qm.QueryMod) organizationInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organization_invitations\".\"accepted_by\"=?", o.ID),
+	)
+
+	return OrganizationInvitations(queryMods...)
+}
+
+// InvitedByOrganizationInvitations retrieves all the organization_invitation's OrganizationInvitations with an executor via invited_by column.
+func (o *User) InvitedByOrganizationInvitations(mods ...qm.QueryMod) organizationInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organization_invitations\".\"invited_by\"=?", o.ID),
+	)
+
+	return OrganizationInvitations(queryMods...)
 }
 
 // OrganizationMembers retrieves all the organization_member's OrganizationMembers with an executor.
@@ -609,6 +905,20 @@ func (o *User) OwnerOrganizations(mods ...qm.QueryMod) organizationQuery {
 	return Organizations(queryMods...)
 }
 
+// PasskeyChallenges retrieves all the passkey_challenge's PasskeyChallenges with an executor.
+func (o *User) PasskeyChallenges(mods ...qm.QueryMod) passkeyChallengeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"passkey_challenges\".\"user_id\"=?", o.ID),
+	)
+
+	return PasskeyChallenges(queryMods...)
+}
+
 // PasswordResetTokens retrieves all the password_reset_token's PasswordResetTokens with an executor.
 func (o *User) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery {
 	var queryMods []qm.QueryMod
@@ -679,11 +989,67 @@ func (o *User) UserCredentials(mods ...qm.QueryMod) userCredentialQuery {
 	return UserCredentials(queryMods...)
 }
 
-// LoadAppUserProfile allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-1 relationship.
-func (userL) LoadAppUserProfile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// UserDevices retrieves all the user_device's UserDevices with an executor.
+func (o *User) UserDevices(mods ...qm.QueryMod) userDeviceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_devices\".\"user_id\"=?", o.ID),
+	)
+
+	return UserDevices(queryMods...)
+}
+
+// VaultProposalApprovals retrieves all the vault_proposal_approval's VaultProposalApprovals with an executor.
+func (o *User) VaultProposalApprovals(mods ...qm.QueryMod) vaultProposalApprovalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"vault_proposal_approvals\".\"user_id\"=?", o.ID),
+	)
+
+	return VaultProposalApprovals(queryMods...)
+}
+
+// InitiatorVaultProposals retrieves all the vault_proposal's VaultProposals with an executor via initiator_id column.
+func (o *User) InitiatorVaultProposals(mods ...qm.QueryMod) vaultProposalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"vault_proposals\".\"initiator_id\"=?", o.ID),
+	)
+
+	return VaultProposals(queryMods...)
+}
+
+// CreatedByWebhookEndpoints retrieves all the webhook_endpoint's WebhookEndpoints with an executor via created_by column.
+func (o *User) CreatedByWebhookEndpoints(mods ...qm.QueryMod) webhookEndpointQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_endpoints\".\"created_by\"=?", o.ID),
+	)
+
+	return WebhookEndpoints(queryMods...)
+}
+
+// LoadAppUserProfile allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadAppUserProfile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
 	if singular {
 		var ok bool
@@ -894,9 +1260,9 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadCreatedByAddressBooks allows an eager lookup of values, cached into the
+// LoadApprovedByAddressBooks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedByAddressBooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadApprovedByAddressBooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -950,7 +1316,7 @@ func (userL) LoadCreatedByAddressBooks(ctx context.Context, e boil.ContextExecut
 
 	query := NewQuery(
 		qm.From(`address_book`),
-		qm.WhereIn(`address_book.created_by in ?`, argsSlice...),
+		qm.WhereIn(`address_book.approved_by in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -974,24 +1340,24 @@ func (userL) LoadCreatedByAddressBooks(ctx context.Context, e boil.ContextExecut
 	}
 
 	if singular {
-		object.R.CreatedByAddressBooks = resultSlice
+		object.R.ApprovedByAddressBooks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
 				foreign.R = &addressBookR{}
 			}
-			foreign.R.CreatedByUser = object
+			foreign.R.ApprovedByUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedBy) {
-				local.R.CreatedByAddressBooks = append(local.R.CreatedByAddressBooks, foreign)
+			if queries.Equal(local.ID, foreign.ApprovedBy) {
+				local.R.ApprovedByAddressBooks = append(local.R.ApprovedByAddressBooks, foreign)
 				if foreign.R == nil {
 					foreign.R = &addressBookR{}
 				}
-				foreign.R.CreatedByUser = local
+				foreign.R.ApprovedByUser = local
 				break
 			}
 		}
@@ -1000,9 +1366,9 @@ func (userL) LoadCreatedByAddressBooks(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadApprovals allows an eager lookup of values, cached into the
+// LoadCreatedByAddressBooks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadCreatedByAddressBooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1055,8 +1421,8 @@ func (userL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`approvals`),
-		qm.WhereIn(`approvals.user_id in ?`, argsSlice...),
+		qm.From(`address_book`),
+		qm.WhereIn(`address_book.created_by in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1064,40 +1430,40 @@ func (userL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load approvals")
+		return errors.Wrap(err, "failed to eager load address_book")
 	}
 
-	var resultSlice []*Approval
+	var resultSlice []*AddressBook
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice approvals")
+		return errors.Wrap(err, "failed to bind eager loaded slice address_book")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on approvals")
+		return errors.Wrap(err, "failed to close results in eager load on address_book")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approvals")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for address_book")
 	}
 
 	if singular {
-		object.R.Approvals = resultSlice
+		object.R.CreatedByAddressBooks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &approvalR{}
+				foreign.R = &addressBookR{}
 			}
-			foreign.R.User = object
+			foreign.R.CreatedByUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.UserID) {
-				local.R.Approvals = append(local.R.Approvals, foreign)
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByAddressBooks = append(local.R.CreatedByAddressBooks, foreign)
 				if foreign.R == nil {
-					foreign.R = &approvalR{}
+					foreign.R = &addressBookR{}
 				}
-				foreign.R.User = local
+				foreign.R.CreatedByUser = local
 				break
 			}
 		}
@@ -1106,9 +1472,9 @@ func (userL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadAuditLogs allows an eager lookup of values, cached into the
+// LoadApprovals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1161,8 +1527,8 @@ func (userL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`audit_logs`),
-		qm.WhereIn(`audit_logs.user_id in ?`, argsSlice...),
+		qm.From(`approvals`),
+		qm.WhereIn(`approvals.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1170,26 +1536,26 @@ func (userL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load audit_logs")
+		return errors.Wrap(err, "failed to eager load approvals")
 	}
 
-	var resultSlice []*AuditLog
+	var resultSlice []*Approval
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice audit_logs")
+		return errors.Wrap(err, "failed to bind eager loaded slice approvals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on audit_logs")
+		return errors.Wrap(err, "failed to close results in eager load on approvals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for approvals")
 	}
 
 	if singular {
-		object.R.AuditLogs = resultSlice
+		object.R.Approvals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &auditLogR{}
+				foreign.R = &approvalR{}
 			}
 			foreign.R.User = object
 		}
@@ -1199,9 +1565,9 @@ func (userL) LoadAuditLogs(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if queries.Equal(local.ID, foreign.UserID) {
-				local.R.AuditLogs = append(local.R.AuditLogs, foreign)
+				local.R.Approvals = append(local.R.Approvals, foreign)
 				if foreign.R == nil {
-					foreign.R = &auditLogR{}
+					foreign.R = &approvalR{}
 				}
 				foreign.R.User = local
 				break
@@ -1318,9 +1684,9 @@ func (userL) LoadConfirmationTokens(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadOrganizationMembers allows an eager lookup of values, cached into the
+// LoadKeyShareBackups allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadKeyShareBackups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1373,8 +1739,8 @@ func (userL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`organization_members`),
-		qm.WhereIn(`organization_members.user_id in ?`, argsSlice...),
+		qm.From(`key_share_backups`),
+		qm.WhereIn(`key_share_backups.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1382,26 +1748,26 @@ func (userL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load organization_members")
+		return errors.Wrap(err, "failed to eager load key_share_backups")
 	}
 
-	var resultSlice []*OrganizationMember
+	var resultSlice []*KeyShareBackup
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice organization_members")
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_backups")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on organization_members")
+		return errors.Wrap(err, "failed to close results in eager load on key_share_backups")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_members")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_backups")
 	}
 
 	if singular {
-		object.R.OrganizationMembers = resultSlice
+		object.R.KeyShareBackups = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &organizationMemberR{}
+				foreign.R = &keyShareBackupR{}
 			}
 			foreign.R.User = object
 		}
@@ -1411,9 +1777,9 @@ func (userL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.OrganizationMembers = append(local.R.OrganizationMembers, foreign)
+				local.R.KeyShareBackups = append(local.R.KeyShareBackups, foreign)
 				if foreign.R == nil {
-					foreign.R = &organizationMemberR{}
+					foreign.R = &keyShareBackupR{}
 				}
 				foreign.R.User = local
 				break
@@ -1424,9 +1790,9 @@ func (userL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadOwnerOrganizations allows an eager lookup of values, cached into the
+// LoadInitiatorKeyShareRecoveries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerOrganizations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadInitiatorKeyShareRecoveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1479,8 +1845,8 @@ func (userL) LoadOwnerOrganizations(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`organizations`),
-		qm.WhereIn(`organizations.owner_id in ?`, argsSlice...),
+		qm.From(`key_share_recoveries`),
+		qm.WhereIn(`key_share_recoveries.initiator_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1488,40 +1854,40 @@ func (userL) LoadOwnerOrganizations(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load organizations")
+		return errors.Wrap(err, "failed to eager load key_share_recoveries")
 	}
 
-	var resultSlice []*Organization
+	var resultSlice []*KeyShareRecovery
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice organizations")
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_recoveries")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on organizations")
+		return errors.Wrap(err, "failed to close results in eager load on key_share_recoveries")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_recoveries")
 	}
 
 	if singular {
-		object.R.OwnerOrganizations = resultSlice
+		object.R.InitiatorKeyShareRecoveries = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &organizationR{}
+				foreign.R = &keyShareRecoveryR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.Initiator = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerOrganizations = append(local.R.OwnerOrganizations, foreign)
+			if queries.Equal(local.ID, foreign.InitiatorID) {
+				local.R.InitiatorKeyShareRecoveries = append(local.R.InitiatorKeyShareRecoveries, foreign)
 				if foreign.R == nil {
-					foreign.R = &organizationR{}
+					foreign.R = &keyShareRecoveryR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.Initiator = local
 				break
 			}
 		}
@@ -1530,9 +1896,9 @@ func (userL) LoadOwnerOrganizations(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// LoadSubjectUserKeyShareRecoveries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadSubjectUserKeyShareRecoveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1585,8 +1951,8 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`password_reset_tokens`),
-		qm.WhereIn(`password_reset_tokens.user_id in ?`, argsSlice...),
+		qm.From(`key_share_recoveries`),
+		qm.WhereIn(`key_share_recoveries.subject_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1594,40 +1960,40 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load password_reset_tokens")
+		return errors.Wrap(err, "failed to eager load key_share_recoveries")
 	}
 
-	var resultSlice []*PasswordResetToken
+	var resultSlice []*KeyShareRecovery
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_recoveries")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on key_share_recoveries")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_recoveries")
 	}
 
 	if singular {
-		object.R.PasswordResetTokens = resultSlice
+		object.R.SubjectUserKeyShareRecoveries = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &passwordResetTokenR{}
+				foreign.R = &keyShareRecoveryR{}
 			}
-			foreign.R.User = object
+			foreign.R.SubjectUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+			if local.ID == foreign.SubjectUserID {
+				local.R.SubjectUserKeyShareRecoveries = append(local.R.SubjectUserKeyShareRecoveries, foreign)
 				if foreign.R == nil {
-					foreign.R = &passwordResetTokenR{}
+					foreign.R = &keyShareRecoveryR{}
 				}
-				foreign.R.User = local
+				foreign.R.SubjectUser = local
 				break
 			}
 		}
@@ -1636,9 +2002,9 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadPushTokens allows an eager lookup of values, cached into the
+// LoadKeyShareRecoveryApprovals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadKeyShareRecoveryApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1691,8 +2057,8 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`push_tokens`),
-		qm.WhereIn(`push_tokens.user_id in ?`, argsSlice...),
+		qm.From(`key_share_recovery_approvals`),
+		qm.WhereIn(`key_share_recovery_approvals.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1700,26 +2066,26 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load push_tokens")
+		return errors.Wrap(err, "failed to eager load key_share_recovery_approvals")
 	}
 
-	var resultSlice []*PushToken
+	var resultSlice []*KeyShareRecoveryApproval
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice push_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_recovery_approvals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on push_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on key_share_recovery_approvals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_recovery_approvals")
 	}
 
 	if singular {
-		object.R.PushTokens = resultSlice
+		object.R.KeyShareRecoveryApprovals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &pushTokenR{}
+				foreign.R = &keyShareRecoveryApprovalR{}
 			}
 			foreign.R.User = object
 		}
@@ -1729,9 +2095,9 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.PushTokens = append(local.R.PushTokens, foreign)
+				local.R.KeyShareRecoveryApprovals = append(local.R.KeyShareRecoveryApprovals, foreign)
 				if foreign.R == nil {
-					foreign.R = &pushTokenR{}
+					foreign.R = &keyShareRecoveryApprovalR{}
 				}
 				foreign.R.User = local
 				break
@@ -1742,9 +2108,9 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadRefreshTokens allows an eager lookup of values, cached into the
+// LoadNotificationPreferences allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1797,8 +2163,8 @@ func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`refresh_tokens`),
-		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
+		qm.From(`notification_preferences`),
+		qm.WhereIn(`notification_preferences.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1806,26 +2172,26 @@ func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load refresh_tokens")
+		return errors.Wrap(err, "failed to eager load notification_preferences")
 	}
 
-	var resultSlice []*RefreshToken
+	var resultSlice []*NotificationPreference
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_preferences")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on notification_preferences")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preferences")
 	}
 
 	if singular {
-		object.R.RefreshTokens = resultSlice
+		object.R.NotificationPreferences = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &refreshTokenR{}
+				foreign.R = &notificationPreferenceR{}
 			}
 			foreign.R.User = object
 		}
@@ -1835,9 +2201,9 @@ func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
 				if foreign.R == nil {
-					foreign.R = &refreshTokenR{}
+					foreign.R = &notificationPreferenceR{}
 				}
 				foreign.R.User = local
 				break
@@ -1848,9 +2214,9 @@ func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadInitiatorSigningRequests allows an eager lookup of values, cached into the
+// LoadAcceptedByOrganizationInvitations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadInitiatorSigningRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAcceptedByOrganizationInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1903,8 +2269,8 @@ func (userL) LoadInitiatorSigningRequests(ctx context.Context, e boil.ContextExe
 	}
 
 	query := NewQuery(
-		qm.From(`signing_requests`),
-		qm.WhereIn(`signing_requests.initiator_id in ?`, argsSlice...),
+		qm.From(`organization_invitations`),
+		qm.WhereIn(`organization_invitations.accepted_by in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1912,40 +2278,40 @@ func (userL) LoadInitiatorSigningRequests(ctx context.Context, e boil.ContextExe
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load signing_requests")
+		return errors.Wrap(err, "failed to eager load organization_invitations")
 	}
 
-	var resultSlice []*SigningRequest
+	var resultSlice []*OrganizationInvitation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice signing_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_invitations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on signing_requests")
+		return errors.Wrap(err, "failed to close results in eager load on organization_invitations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for signing_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_invitations")
 	}
 
 	if singular {
-		object.R.InitiatorSigningRequests = resultSlice
+		object.R.AcceptedByOrganizationInvitations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &signingRequestR{}
+				foreign.R = &organizationInvitationR{}
 			}
-			foreign.R.Initiator = object
+			foreign.R.AcceptedByUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.InitiatorID) {
-				local.R.InitiatorSigningRequests = append(local.R.InitiatorSigningRequests, foreign)
+			if queries.Equal(local.ID, foreign.AcceptedBy) {
+				local.R.AcceptedByOrganizationInvitations = append(local.R.AcceptedByOrganizationInvitations, foreign)
 				if foreign.R == nil {
-					foreign.R = &signingRequestR{}
+					foreign.R = &organizationInvitationR{}
 				}
-				foreign.R.Initiator = local
+				foreign.R.AcceptedByUser = local
 				break
 			}
 		}
@@ -1954,9 +2320,9 @@ func (userL) LoadInitiatorSigningRequests(ctx context.Context, e boil.ContextExe
 	return nil
 }
 
-// LoadUserCredentials allows an eager lookup of values, cached into the
+// LoadInvitedByOrganizationInvitations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadInvitedByOrganizationInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2009,8 +2375,8 @@ func (userL) LoadUserCredentials(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`user_credentials`),
-		qm.WhereIn(`user_credentials.user_id in ?`, argsSlice...),
+		qm.From(`organization_invitations`),
+		qm.WhereIn(`organization_invitations.invited_by in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2018,40 +2384,40 @@ func (userL) LoadUserCredentials(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_credentials")
+		return errors.Wrap(err, "failed to eager load organization_invitations")
 	}
 
-	var resultSlice []*UserCredential
+	var resultSlice []*OrganizationInvitation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_credentials")
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_invitations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_credentials")
+		return errors.Wrap(err, "failed to close results in eager load on organization_invitations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_credentials")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_invitations")
 	}
 
 	if singular {
-		object.R.UserCredentials = resultSlice
+		object.R.InvitedByOrganizationInvitations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userCredentialR{}
+				foreign.R = &organizationInvitationR{}
 			}
-			foreign.R.User = object
+			foreign.R.InvitedByUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserCredentials = append(local.R.UserCredentials, foreign)
+			if queries.Equal(local.ID, foreign.InvitedBy) {
+				local.R.InvitedByOrganizationInvitations = append(local.R.InvitedByOrganizationInvitations, foreign)
 				if foreign.R == nil {
-					foreign.R = &userCredentialR{}
+					foreign.R = &organizationInvitationR{}
 				}
-				foreign.R.User = local
+				foreign.R.InvitedByUser = local
 				break
 			}
 		}
@@ -2060,61 +2426,2053 @@ func (userL) LoadUserCredentials(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// SetAppUserProfile of the user to the related item.
-// Sets o.R.AppUserProfile to related.
-// Adds o to related.R.User.
-func (o *User) SetAppUserProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AppUserProfile) error {
-	var err error
-
-	if insert {
-		related.UserID = o.ID
+// LoadOrganizationMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOrganizationMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
 	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"app_user_profiles\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.UserID}
-
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
-		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
 		}
-
-		related.UserID = o.ID
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			AppUserProfile: related,
-		}
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organization_members`),
+		qm.WhereIn(`organization_members.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organization_members")
+	}
+
+	var resultSlice []*OrganizationMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organization_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_members")
+	}
+
+	if singular {
+		object.R.OrganizationMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.OrganizationMembers = append(local.R.OrganizationMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerOrganizations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerOrganizations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organizations")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organizations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if singular {
+		object.R.OwnerOrganizations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerOrganizations = append(local.R.OwnerOrganizations, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasskeyChallenges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPasskeyChallenges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`passkey_challenges`),
+		qm.WhereIn(`passkey_challenges.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load passkey_challenges")
+	}
+
+	var resultSlice []*PasskeyChallenge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice passkey_challenges")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on passkey_challenges")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for passkey_challenges")
+	}
+
+	if singular {
+		object.R.PasskeyChallenges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passkeyChallengeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PasskeyChallenges = append(local.R.PasskeyChallenges, foreign)
+				if foreign.R == nil {
+					foreign.R = &passkeyChallengeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`password_reset_tokens`),
+		qm.WhereIn(`password_reset_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_reset_tokens")
+	}
+
+	var resultSlice []*PasswordResetToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
+	}
+
+	if singular {
+		object.R.PasswordResetTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordResetTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordResetTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`push_tokens`),
+		qm.WhereIn(`push_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_tokens")
+	}
+
+	var resultSlice []*PushToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_tokens")
+	}
+
+	if singular {
+		object.R.PushTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PushTokens = append(local.R.PushTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInitiatorSigningRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInitiatorSigningRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`signing_requests`),
+		qm.WhereIn(`signing_requests.initiator_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load signing_requests")
+	}
+
+	var resultSlice []*SigningRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice signing_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on signing_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for signing_requests")
+	}
+
+	if singular {
+		object.R.InitiatorSigningRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &signingRequestR{}
+			}
+			foreign.R.Initiator = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.InitiatorID) {
+				local.R.InitiatorSigningRequests = append(local.R.InitiatorSigningRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &signingRequestR{}
+				}
+				foreign.R.Initiator = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_credentials`),
+		qm.WhereIn(`user_credentials.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_credentials")
+	}
+
+	var resultSlice []*UserCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_credentials")
+	}
+
+	if singular {
+		object.R.UserCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userCredentialR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserCredentials = append(local.R.UserCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &userCredentialR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserDevices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserDevices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_devices`),
+		qm.WhereIn(`user_devices.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_devices")
+	}
+
+	var resultSlice []*UserDevice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_devices")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_devices")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_devices")
+	}
+
+	if singular {
+		object.R.UserDevices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userDeviceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserDevices = append(local.R.UserDevices, foreign)
+				if foreign.R == nil {
+					foreign.R = &userDeviceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVaultProposalApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadVaultProposalApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vault_proposal_approvals`),
+		qm.WhereIn(`vault_proposal_approvals.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load vault_proposal_approvals")
+	}
+
+	var resultSlice []*VaultProposalApproval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice vault_proposal_approvals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on vault_proposal_approvals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposal_approvals")
+	}
+
+	if singular {
+		object.R.VaultProposalApprovals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &vaultProposalApprovalR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.VaultProposalApprovals = append(local.R.VaultProposalApprovals, foreign)
+				if foreign.R == nil {
+					foreign.R = &vaultProposalApprovalR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInitiatorVaultProposals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInitiatorVaultProposals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vault_proposals`),
+		qm.WhereIn(`vault_proposals.initiator_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load vault_proposals")
+	}
+
+	var resultSlice []*VaultProposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice vault_proposals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on vault_proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposals")
+	}
+
+	if singular {
+		object.R.InitiatorVaultProposals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &vaultProposalR{}
+			}
+			foreign.R.Initiator = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.InitiatorID) {
+				local.R.InitiatorVaultProposals = append(local.R.InitiatorVaultProposals, foreign)
+				if foreign.R == nil {
+					foreign.R = &vaultProposalR{}
+				}
+				foreign.R.Initiator = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByWebhookEndpoints allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByWebhookEndpoints(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_endpoints`),
+		qm.WhereIn(`webhook_endpoints.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_endpoints")
+	}
+
+	var resultSlice []*WebhookEndpoint
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_endpoints")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_endpoints")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_endpoints")
+	}
+
+	if singular {
+		object.R.CreatedByWebhookEndpoints = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookEndpointR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByWebhookEndpoints = append(local.R.CreatedByWebhookEndpoints, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookEndpointR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAppUserProfile of the user to the related item.
+// Sets o.R.AppUserProfile to related.
+// Adds o to related.R.User.
+func (o *User) SetAppUserProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AppUserProfile) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"app_user_profiles\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AppUserProfile: related,
+		}
+	} else {
+		o.R.AppUserProfile = related
+	}
+
+	if related.R == nil {
+		related.R = &appUserProfileR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AccessTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AccessTokens: related,
+		}
+	} else {
+		o.R.AccessTokens = append(o.R.AccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accessTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddApprovedByAddressBooks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ApprovedByAddressBooks.
+// Sets related.R.ApprovedByUser appropriately.
+func (o *User) AddApprovedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ApprovedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"address_book\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"approved_by"}),
+				strmangle.WhereClause("\"", "\"", 2, addressBookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ApprovedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ApprovedByAddressBooks: related,
+		}
+	} else {
+		o.R.ApprovedByAddressBooks = append(o.R.ApprovedByAddressBooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &addressBookR{
+				ApprovedByUser: o,
+			}
+		} else {
+			rel.R.ApprovedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetApprovedByAddressBooks removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ApprovedByUser's ApprovedByAddressBooks accordingly.
+// Replaces o.R.ApprovedByAddressBooks with related.
+// Sets related.R.ApprovedByUser's ApprovedByAddressBooks accordingly.
+func (o *User) SetApprovedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+	query := "update \"address_book\" set \"approved_by\" = null where \"approved_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ApprovedByAddressBooks {
+			queries.SetScanner(&rel.ApprovedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ApprovedByUser = nil
+		}
+		o.R.ApprovedByAddressBooks = nil
+	}
+
+	return o.AddApprovedByAddressBooks(ctx, exec, insert, related...)
+}
+
+// RemoveApprovedByAddressBooks relationships from objects passed in.
+// Removes related items from R.ApprovedByAddressBooks (uses pointer comparison, removal does not keep order)
+// Sets related.R.ApprovedByUser.
+func (o *User) RemoveApprovedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, related ...*AddressBook) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ApprovedBy, nil)
+		if rel.R != nil {
+			rel.R.ApprovedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("approved_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ApprovedByAddressBooks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ApprovedByAddressBooks)
+			if ln > 1 && i < ln-1 {
+				o.R.ApprovedByAddressBooks[i] = o.R.ApprovedByAddressBooks[ln-1]
+			}
+			o.R.ApprovedByAddressBooks = o.R.ApprovedByAddressBooks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatedByAddressBooks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByAddressBooks.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"address_book\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, addressBookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByAddressBooks: related,
+		}
+	} else {
+		o.R.CreatedByAddressBooks = append(o.R.CreatedByAddressBooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &addressBookR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByAddressBooks removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByAddressBooks accordingly.
+// Replaces o.R.CreatedByAddressBooks with related.
+// Sets related.R.CreatedByUser's CreatedByAddressBooks accordingly.
+func (o *User) SetCreatedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+	query := "update \"address_book\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByAddressBooks {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByAddressBooks = nil
+	}
+
+	return o.AddCreatedByAddressBooks(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByAddressBooks relationships from objects passed in.
+// Removes related items from R.CreatedByAddressBooks (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, related ...*AddressBook) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByAddressBooks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByAddressBooks)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByAddressBooks[i] = o.R.CreatedByAddressBooks[ln-1]
+			}
+			o.R.CreatedByAddressBooks = o.R.CreatedByAddressBooks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddApprovals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Approvals.
+// Sets related.R.User appropriately.
+func (o *User) AddApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"approvals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Approvals: related,
+		}
+	} else {
+		o.R.Approvals = append(o.R.Approvals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &approvalR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetApprovals removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's Approvals accordingly.
+// Replaces o.R.Approvals with related.
+// Sets related.R.User's Approvals accordingly.
+func (o *User) SetApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+	query := "update \"approvals\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Approvals {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.Approvals = nil
+	}
+
+	return o.AddApprovals(ctx, exec, insert, related...)
+}
+
+// RemoveApprovals relationships from objects passed in.
+// Removes related items from R.Approvals (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveApprovals(ctx context.Context, exec boil.ContextExecutor, related ...*Approval) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Approvals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Approvals)
+			if ln > 1 && i < ln-1 {
+				o.R.Approvals[i] = o.R.Approvals[ln-1]
+			}
+			o.R.Approvals = o.R.Approvals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddConfirmationTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ConfirmationTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ConfirmationToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"confirmation_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, confirmationTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ConfirmationTokens: related,
+		}
+	} else {
+		o.R.ConfirmationTokens = append(o.R.ConfirmationTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &confirmationTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddKeyShareBackups adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.KeyShareBackups.
+// Sets related.R.User appropriately.
+func (o *User) AddKeyShareBackups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareBackup) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_share_backups\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyShareBackupPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			KeyShareBackups: related,
+		}
 	} else {
-		o.R.AppUserProfile = related
+		o.R.KeyShareBackups = append(o.R.KeyShareBackups, related...)
 	}
 
-	if related.R == nil {
-		related.R = &appUserProfileR{
-			User: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyShareBackupR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddInitiatorKeyShareRecoveries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InitiatorKeyShareRecoveries.
+// Sets related.R.Initiator appropriately.
+func (o *User) AddInitiatorKeyShareRecoveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareRecovery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.InitiatorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"initiator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.InitiatorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InitiatorKeyShareRecoveries: related,
 		}
 	} else {
-		related.R.User = o
+		o.R.InitiatorKeyShareRecoveries = append(o.R.InitiatorKeyShareRecoveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyShareRecoveryR{
+				Initiator: o,
+			}
+		} else {
+			rel.R.Initiator = o
+		}
 	}
 	return nil
 }
 
-// AddAccessTokens adds the given related objects to the existing relationships
+// SetInitiatorKeyShareRecoveries removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Initiator's InitiatorKeyShareRecoveries accordingly.
+// Replaces o.R.InitiatorKeyShareRecoveries with related.
+// Sets related.R.Initiator's InitiatorKeyShareRecoveries accordingly.
+func (o *User) SetInitiatorKeyShareRecoveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareRecovery) error {
+	query := "update \"key_share_recoveries\" set \"initiator_id\" = null where \"initiator_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.InitiatorKeyShareRecoveries {
+			queries.SetScanner(&rel.InitiatorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Initiator = nil
+		}
+		o.R.InitiatorKeyShareRecoveries = nil
+	}
+
+	return o.AddInitiatorKeyShareRecoveries(ctx, exec, insert, related...)
+}
+
+// RemoveInitiatorKeyShareRecoveries relationships from objects passed in.
+// Removes related items from R.InitiatorKeyShareRecoveries (uses pointer comparison, removal does not keep order)
+// Sets related.R.Initiator.
+func (o *User) RemoveInitiatorKeyShareRecoveries(ctx context.Context, exec boil.ContextExecutor, related ...*KeyShareRecovery) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.InitiatorID, nil)
+		if rel.R != nil {
+			rel.R.Initiator = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("initiator_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.InitiatorKeyShareRecoveries {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.InitiatorKeyShareRecoveries)
+			if ln > 1 && i < ln-1 {
+				o.R.InitiatorKeyShareRecoveries[i] = o.R.InitiatorKeyShareRecoveries[ln-1]
+			}
+			o.R.InitiatorKeyShareRecoveries = o.R.InitiatorKeyShareRecoveries[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSubjectUserKeyShareRecoveries adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AccessTokens.
+// Appends related to o.R.SubjectUserKeyShareRecoveries.
+// Sets related.R.SubjectUser appropriately.
+func (o *User) AddSubjectUserKeyShareRecoveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareRecovery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SubjectUserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subject_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SubjectUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SubjectUserKeyShareRecoveries: related,
+		}
+	} else {
+		o.R.SubjectUserKeyShareRecoveries = append(o.R.SubjectUserKeyShareRecoveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyShareRecoveryR{
+				SubjectUser: o,
+			}
+		} else {
+			rel.R.SubjectUser = o
+		}
+	}
+	return nil
+}
+
+// AddKeyShareRecoveryApprovals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.KeyShareRecoveryApprovals.
 // Sets related.R.User appropriately.
-func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+func (o *User) AddKeyShareRecoveryApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareRecoveryApproval) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2124,11 +4482,11 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				"UPDATE \"key_share_recovery_approvals\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryApprovalPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2145,15 +4503,15 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 
 	if o.R == nil {
 		o.R = &userR{
-			AccessTokens: related,
+			KeyShareRecoveryApprovals: related,
 		}
 	} else {
-		o.R.AccessTokens = append(o.R.AccessTokens, related...)
+		o.R.KeyShareRecoveryApprovals = append(o.R.KeyShareRecoveryApprovals, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &accessTokenR{
+			rel.R = &keyShareRecoveryApprovalR{
 				User: o,
 			}
 		} else {
@@ -2163,23 +4521,76 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
-// AddCreatedByAddressBooks adds the given related objects to the existing relationships
+// AddNotificationPreferences adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedByAddressBooks.
-// Sets related.R.CreatedByUser appropriately.
-func (o *User) AddCreatedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
+// Appends related to o.R.NotificationPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.CreatedBy, o.ID)
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"address_book\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
-				strmangle.WhereClause("\"", "\"", 2, addressBookPrimaryKeyColumns),
+				"UPDATE \"notification_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.OrganizationID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			NotificationPreferences: related,
+		}
+	} else {
+		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAcceptedByOrganizationInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AcceptedByOrganizationInvitations.
+// Sets related.R.AcceptedByUser appropriately.
+func (o *User) AddAcceptedByOrganizationInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AcceptedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organization_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"accepted_by"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2192,38 +4603,38 @@ func (o *User) AddCreatedByAddressBooks(ctx context.Context, exec boil.ContextEx
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.CreatedBy, o.ID)
+			queries.Assign(&rel.AcceptedBy, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedByAddressBooks: related,
+			AcceptedByOrganizationInvitations: related,
 		}
 	} else {
-		o.R.CreatedByAddressBooks = append(o.R.CreatedByAddressBooks, related...)
+		o.R.AcceptedByOrganizationInvitations = append(o.R.AcceptedByOrganizationInvitations, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &addressBookR{
-				CreatedByUser: o,
+			rel.R = &organizationInvitationR{
+				AcceptedByUser: o,
 			}
 		} else {
-			rel.R.CreatedByUser = o
+			rel.R.AcceptedByUser = o
 		}
 	}
 	return nil
 }
 
-// SetCreatedByAddressBooks removes all previously related items of the
+// SetAcceptedByOrganizationInvitations removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.CreatedByUser's CreatedByAddressBooks accordingly.
-// Replaces o.R.CreatedByAddressBooks with related.
-// Sets related.R.CreatedByUser's CreatedByAddressBooks accordingly.
-func (o *User) SetCreatedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AddressBook) error {
-	query := "update \"address_book\" set \"created_by\" = null where \"created_by\" = $1"
+// Sets o.R.AcceptedByUser's AcceptedByOrganizationInvitations accordingly.
+// Replaces o.R.AcceptedByOrganizationInvitations with related.
+// Sets related.R.AcceptedByUser's AcceptedByOrganizationInvitations accordingly.
+func (o *User) SetAcceptedByOrganizationInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationInvitation) error {
+	query := "update \"organization_invitations\" set \"accepted_by\" = null where \"accepted_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2236,35 +4647,35 @@ func (o *User) SetCreatedByAddressBooks(ctx context.Context, exec boil.ContextEx
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.CreatedByAddressBooks {
-			queries.SetScanner(&rel.CreatedBy, nil)
+		for _, rel := range o.R.AcceptedByOrganizationInvitations {
+			queries.SetScanner(&rel.AcceptedBy, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.CreatedByUser = nil
+			rel.R.AcceptedByUser = nil
 		}
-		o.R.CreatedByAddressBooks = nil
+		o.R.AcceptedByOrganizationInvitations = nil
 	}
 
-	return o.AddCreatedByAddressBooks(ctx, exec, insert, related...)
+	return o.AddAcceptedByOrganizationInvitations(ctx, exec, insert, related...)
 }
 
-// RemoveCreatedByAddressBooks relationships from objects passed in.
-// Removes related items from R.CreatedByAddressBooks (uses pointer comparison, removal does not keep order)
-// Sets related.R.CreatedByUser.
-func (o *User) RemoveCreatedByAddressBooks(ctx context.Context, exec boil.ContextExecutor, related ...*AddressBook) error {
+// RemoveAcceptedByOrganizationInvitations relationships from objects passed in.
+// Removes related items from R.AcceptedByOrganizationInvitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.AcceptedByUser.
+func (o *User) RemoveAcceptedByOrganizationInvitations(ctx context.Context, exec boil.ContextExecutor, related ...*OrganizationInvitation) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.CreatedBy, nil)
+		queries.SetScanner(&rel.AcceptedBy, nil)
 		if rel.R != nil {
-			rel.R.CreatedByUser = nil
+			rel.R.AcceptedByUser = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("accepted_by")); err != nil {
 			return err
 		}
 	}
@@ -2273,16 +4684,16 @@ func (o *User) RemoveCreatedByAddressBooks(ctx context.Context, exec boil.Contex
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.CreatedByAddressBooks {
+		for i, ri := range o.R.AcceptedByOrganizationInvitations {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.CreatedByAddressBooks)
+			ln := len(o.R.AcceptedByOrganizationInvitations)
 			if ln > 1 && i < ln-1 {
-				o.R.CreatedByAddressBooks[i] = o.R.CreatedByAddressBooks[ln-1]
+				o.R.AcceptedByOrganizationInvitations[i] = o.R.AcceptedByOrganizationInvitations[ln-1]
 			}
-			o.R.CreatedByAddressBooks = o.R.CreatedByAddressBooks[:ln-1]
+			o.R.AcceptedByOrganizationInvitations = o.R.AcceptedByOrganizationInvitations[:ln-1]
 			break
 		}
 	}
@@ -2290,23 +4701,23 @@ func (o *User) RemoveCreatedByAddressBooks(ctx context.Context, exec boil.Contex
 	return nil
 }
 
-// AddApprovals adds the given related objects to the existing relationships
+// AddInvitedByOrganizationInvitations adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Approvals.
-// Sets related.R.User appropriately.
-func (o *User) AddApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
+// Appends related to o.R.InvitedByOrganizationInvitations.
+// Sets related.R.InvitedByUser appropriately.
+func (o *User) AddInvitedByOrganizationInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationInvitation) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.UserID, o.ID)
+			queries.Assign(&rel.InvitedBy, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"approvals\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, approvalPrimaryKeyColumns),
+				"UPDATE \"organization_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"invited_by"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2319,38 +4730,38 @@ func (o *User) AddApprovals(ctx context.Context, exec boil.ContextExecutor, inse
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.UserID, o.ID)
+			queries.Assign(&rel.InvitedBy, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Approvals: related,
+			InvitedByOrganizationInvitations: related,
 		}
 	} else {
-		o.R.Approvals = append(o.R.Approvals, related...)
+		o.R.InvitedByOrganizationInvitations = append(o.R.InvitedByOrganizationInvitations, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &approvalR{
-				User: o,
+			rel.R = &organizationInvitationR{
+				InvitedByUser: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.InvitedByUser = o
 		}
 	}
 	return nil
 }
 
-// SetApprovals removes all previously related items of the
+// SetInvitedByOrganizationInvitations removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.User's Approvals accordingly.
-// Replaces o.R.Approvals with related.
-// Sets related.R.User's Approvals accordingly.
-func (o *User) SetApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Approval) error {
-	query := "update \"approvals\" set \"user_id\" = null where \"user_id\" = $1"
+// Sets o.R.InvitedByUser's InvitedByOrganizationInvitations accordingly.
+// Replaces o.R.InvitedByOrganizationInvitations with related.
+// Sets related.R.InvitedByUser's InvitedByOrganizationInvitations accordingly.
+func (o *User) SetInvitedByOrganizationInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationInvitation) error {
+	query := "update \"organization_invitations\" set \"invited_by\" = null where \"invited_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2363,35 +4774,35 @@ func (o *User) SetApprovals(ctx context.Context, exec boil.ContextExecutor, inse
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.Approvals {
-			queries.SetScanner(&rel.UserID, nil)
+		for _, rel := range o.R.InvitedByOrganizationInvitations {
+			queries.SetScanner(&rel.InvitedBy, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.User = nil
+			rel.R.InvitedByUser = nil
 		}
-		o.R.Approvals = nil
+		o.R.InvitedByOrganizationInvitations = nil
 	}
 
-	return o.AddApprovals(ctx, exec, insert, related...)
+	return o.AddInvitedByOrganizationInvitations(ctx, exec, insert, related...)
 }
 
-// RemoveApprovals relationships from objects passed in.
-// Removes related items from R.Approvals (uses pointer comparison, removal does not keep order)
-// Sets related.R.User.
-func (o *User) RemoveApprovals(ctx context.Context, exec boil.ContextExecutor, related ...*Approval) error {
+// RemoveInvitedByOrganizationInvitations relationships from objects passed in.
+// Removes related items from R.InvitedByOrganizationInvitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.InvitedByUser.
+func (o *User) RemoveInvitedByOrganizationInvitations(ctx context.Context, exec boil.ContextExecutor, related ...*OrganizationInvitation) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.UserID, nil)
+		queries.SetScanner(&rel.InvitedBy, nil)
 		if rel.R != nil {
-			rel.R.User = nil
+			rel.R.InvitedByUser = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("invited_by")); err != nil {
 			return err
 		}
 	}
@@ -2400,16 +4811,16 @@ func (o *User) RemoveApprovals(ctx context.Context, exec boil.ContextExecutor, r
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.Approvals {
+		for i, ri := range o.R.InvitedByOrganizationInvitations {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.Approvals)
+			ln := len(o.R.InvitedByOrganizationInvitations)
 			if ln > 1 && i < ln-1 {
-				o.R.Approvals[i] = o.R.Approvals[ln-1]
+				o.R.InvitedByOrganizationInvitations[i] = o.R.InvitedByOrganizationInvitations[ln-1]
 			}
-			o.R.Approvals = o.R.Approvals[:ln-1]
+			o.R.InvitedByOrganizationInvitations = o.R.InvitedByOrganizationInvitations[:ln-1]
 			break
 		}
 	}
@@ -2417,25 +4828,25 @@ func (o *User) RemoveApprovals(ctx context.Context, exec boil.ContextExecutor, r
 	return nil
 }
 
-// AddAuditLogs adds the given related objects to the existing relationships
+// AddOrganizationMembers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AuditLogs.
+// Appends related to o.R.OrganizationMembers.
 // Sets related.R.User appropriately.
-func (o *User) AddAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
+func (o *User) AddOrganizationMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationMember) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.UserID, o.ID)
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"audit_logs\" SET %s WHERE %s",
+				"UPDATE \"organization_members\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, auditLogPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.OrganizationID, rel.UserID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2446,21 +4857,21 @@ func (o *User) AddAuditLogs(ctx context.Context, exec boil.ContextExecutor, inse
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.UserID, o.ID)
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			AuditLogs: related,
+			OrganizationMembers: related,
 		}
 	} else {
-		o.R.AuditLogs = append(o.R.AuditLogs, related...)
+		o.R.OrganizationMembers = append(o.R.OrganizationMembers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &auditLogR{
+			rel.R = &organizationMemberR{
 				User: o,
 			}
 		} else {
@@ -2470,85 +4881,170 @@ func (o *User) AddAuditLogs(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
-// SetAuditLogs removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.User's AuditLogs accordingly.
-// Replaces o.R.AuditLogs with related.
-// Sets related.R.User's AuditLogs accordingly.
-func (o *User) SetAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
-	query := "update \"audit_logs\" set \"user_id\" = null where \"user_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
+// AddOwnerOrganizations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerOrganizations.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerOrganizations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Organization) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organizations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if o.R != nil {
-		for _, rel := range o.R.AuditLogs {
-			queries.SetScanner(&rel.UserID, nil)
-			if rel.R == nil {
-				continue
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.R.User = nil
+			rel.OwnerID = o.ID
 		}
-		o.R.AuditLogs = nil
 	}
 
-	return o.AddAuditLogs(ctx, exec, insert, related...)
-}
+	if o.R == nil {
+		o.R = &userR{
+			OwnerOrganizations: related,
+		}
+	} else {
+		o.R.OwnerOrganizations = append(o.R.OwnerOrganizations, related...)
+	}
 
-// RemoveAuditLogs relationships from objects passed in.
-// Removes related items from R.AuditLogs (uses pointer comparison, removal does not keep order)
-// Sets related.R.User.
-func (o *User) RemoveAuditLogs(ctx context.Context, exec boil.ContextExecutor, related ...*AuditLog) error {
-	if len(related) == 0 {
-		return nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &organizationR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
 	}
+	return nil
+}
 
+// AddPasskeyChallenges adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasskeyChallenges.
+// Sets related.R.User appropriately.
+func (o *User) AddPasskeyChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasskeyChallenge) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.UserID, nil)
-		if rel.R != nil {
-			rel.R.User = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
-			return err
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"passkey_challenges\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passkeyChallengePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
+
 	if o.R == nil {
-		return nil
+		o.R = &userR{
+			PasskeyChallenges: related,
+		}
+	} else {
+		o.R.PasskeyChallenges = append(o.R.PasskeyChallenges, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.AuditLogs {
-			if rel != ri {
-				continue
+		if rel.R == nil {
+			rel.R = &passkeyChallengeR{
+				User: o,
 			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
 
-			ln := len(o.R.AuditLogs)
-			if ln > 1 && i < ln-1 {
-				o.R.AuditLogs[i] = o.R.AuditLogs[ln-1]
+// AddPasswordResetTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordResetTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-			o.R.AuditLogs = o.R.AuditLogs[:ln-1]
-			break
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordResetTokens: related,
 		}
+	} else {
+		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordResetTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
 	return nil
 }
 
-// AddConfirmationTokens adds the given related objects to the existing relationships
+// AddPushTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ConfirmationTokens.
+// Appends related to o.R.PushTokens.
 // Sets related.R.User appropriately.
-func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ConfirmationToken) error {
+func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushToken) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2558,11 +5054,11 @@ func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecu
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"confirmation_tokens\" SET %s WHERE %s",
+				"UPDATE \"push_tokens\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, confirmationTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, pushTokenPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2579,15 +5075,15 @@ func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecu
 
 	if o.R == nil {
 		o.R = &userR{
-			ConfirmationTokens: related,
+			PushTokens: related,
 		}
 	} else {
-		o.R.ConfirmationTokens = append(o.R.ConfirmationTokens, related...)
+		o.R.PushTokens = append(o.R.PushTokens, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &confirmationTokenR{
+			rel.R = &pushTokenR{
 				User: o,
 			}
 		} else {
@@ -2597,11 +5093,11 @@ func (o *User) AddConfirmationTokens(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// AddOrganizationMembers adds the given related objects to the existing relationships
+// AddRefreshTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OrganizationMembers.
+// Appends related to o.R.RefreshTokens.
 // Sets related.R.User appropriately.
-func (o *User) AddOrganizationMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganizationMember) error {
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2611,11 +5107,11 @@ func (o *User) AddOrganizationMembers(ctx context.Context, exec boil.ContextExec
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"organization_members\" SET %s WHERE %s",
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.OrganizationID, rel.UserID}
+			values := []interface{}{o.ID, rel.Token}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2632,15 +5128,15 @@ func (o *User) AddOrganizationMembers(ctx context.Context, exec boil.ContextExec
 
 	if o.R == nil {
 		o.R = &userR{
-			OrganizationMembers: related,
+			RefreshTokens: related,
 		}
 	} else {
-		o.R.OrganizationMembers = append(o.R.OrganizationMembers, related...)
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &organizationMemberR{
+			rel.R = &refreshTokenR{
 				User: o,
 			}
 		} else {
@@ -2650,23 +5146,23 @@ func (o *User) AddOrganizationMembers(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// AddOwnerOrganizations adds the given related objects to the existing relationships
+// AddInitiatorSigningRequests adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerOrganizations.
-// Sets related.R.Owner appropriately.
-func (o *User) AddOwnerOrganizations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Organization) error {
+// Appends related to o.R.InitiatorSigningRequests.
+// Sets related.R.Initiator appropriately.
+func (o *User) AddInitiatorSigningRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SigningRequest) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.OwnerID = o.ID
+			queries.Assign(&rel.InitiatorID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"organizations\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, organizationPrimaryKeyColumns),
+				"UPDATE \"signing_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"initiator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, signingRequestPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2679,35 +5175,109 @@ func (o *User) AddOwnerOrganizations(ctx context.Context, exec boil.ContextExecu
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.OwnerID = o.ID
+			queries.Assign(&rel.InitiatorID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			OwnerOrganizations: related,
+			InitiatorSigningRequests: related,
 		}
 	} else {
-		o.R.OwnerOrganizations = append(o.R.OwnerOrganizations, related...)
+		o.R.InitiatorSigningRequests = append(o.R.InitiatorSigningRequests, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &organizationR{
-				Owner: o,
+			rel.R = &signingRequestR{
+				Initiator: o,
 			}
 		} else {
-			rel.R.Owner = o
+			rel.R.Initiator = o
 		}
 	}
 	return nil
 }
 
-// AddPasswordResetTokens adds the given related objects to the existing relationships
+// SetInitiatorSigningRequests removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Initiator's InitiatorSigningRequests accordingly.
+// Replaces o.R.InitiatorSigningRequests with related.
+// Sets related.R.Initiator's InitiatorSigningRequests accordingly.
+func (o *User) SetInitiatorSigningRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SigningRequest) error {
+	query := "update \"signing_requests\" set \"initiator_id\" = null where \"initiator_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.InitiatorSigningRequests {
+			queries.SetScanner(&rel.InitiatorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Initiator = nil
+		}
+		o.R.InitiatorSigningRequests = nil
+	}
+
+	return o.AddInitiatorSigningRequests(ctx, exec, insert, related...)
+}
+
+// RemoveInitiatorSigningRequests relationships from objects passed in.
+// Removes related items from R.InitiatorSigningRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.Initiator.
+func (o *User) RemoveInitiatorSigningRequests(ctx context.Context, exec boil.ContextExecutor, related ...*SigningRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.InitiatorID, nil)
+		if rel.R != nil {
+			rel.R.Initiator = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("initiator_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.InitiatorSigningRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.InitiatorSigningRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.InitiatorSigningRequests[i] = o.R.InitiatorSigningRequests[ln-1]
+			}
+			o.R.InitiatorSigningRequests = o.R.InitiatorSigningRequests[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddUserCredentials adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PasswordResetTokens.
+// Appends related to o.R.UserCredentials.
 // Sets related.R.User appropriately.
-func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+func (o *User) AddUserCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserCredential) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2717,11 +5287,11 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				"UPDATE \"user_credentials\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userCredentialPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2738,15 +5308,15 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 
 	if o.R == nil {
 		o.R = &userR{
-			PasswordResetTokens: related,
+			UserCredentials: related,
 		}
 	} else {
-		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
+		o.R.UserCredentials = append(o.R.UserCredentials, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &passwordResetTokenR{
+			rel.R = &userCredentialR{
 				User: o,
 			}
 		} else {
@@ -2756,11 +5326,11 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// AddPushTokens adds the given related objects to the existing relationships
+// AddUserDevices adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PushTokens.
+// Appends related to o.R.UserDevices.
 // Sets related.R.User appropriately.
-func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushToken) error {
+func (o *User) AddUserDevices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserDevice) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2770,9 +5340,9 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"push_tokens\" SET %s WHERE %s",
+				"UPDATE \"user_devices\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, pushTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userDevicePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2791,15 +5361,15 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 
 	if o.R == nil {
 		o.R = &userR{
-			PushTokens: related,
+			UserDevices: related,
 		}
 	} else {
-		o.R.PushTokens = append(o.R.PushTokens, related...)
+		o.R.UserDevices = append(o.R.UserDevices, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &pushTokenR{
+			rel.R = &userDeviceR{
 				User: o,
 			}
 		} else {
@@ -2809,11 +5379,11 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
-// AddRefreshTokens adds the given related objects to the existing relationships
+// AddVaultProposalApprovals adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.RefreshTokens.
+// Appends related to o.R.VaultProposalApprovals.
 // Sets related.R.User appropriately.
-func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+func (o *User) AddVaultProposalApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VaultProposalApproval) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2823,11 +5393,11 @@ func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				"UPDATE \"vault_proposal_approvals\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, vaultProposalApprovalPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2844,15 +5414,15 @@ func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &userR{
-			RefreshTokens: related,
+			VaultProposalApprovals: related,
 		}
 	} else {
-		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+		o.R.VaultProposalApprovals = append(o.R.VaultProposalApprovals, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &refreshTokenR{
+			rel.R = &vaultProposalApprovalR{
 				User: o,
 			}
 		} else {
@@ -2862,11 +5432,11 @@ func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
-// AddInitiatorSigningRequests adds the given related objects to the existing relationships
+// AddInitiatorVaultProposals adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.InitiatorSigningRequests.
+// Appends related to o.R.InitiatorVaultProposals.
 // Sets related.R.Initiator appropriately.
-func (o *User) AddInitiatorSigningRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SigningRequest) error {
+func (o *User) AddInitiatorVaultProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VaultProposal) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2876,9 +5446,9 @@ func (o *User) AddInitiatorSigningRequests(ctx context.Context, exec boil.Contex
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"signing_requests\" SET %s WHERE %s",
+				"UPDATE \"vault_proposals\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"initiator_id"}),
-				strmangle.WhereClause("\"", "\"", 2, signingRequestPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, vaultProposalPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2897,15 +5467,15 @@ func (o *User) AddInitiatorSigningRequests(ctx context.Context, exec boil.Contex
 
 	if o.R == nil {
 		o.R = &userR{
-			InitiatorSigningRequests: related,
+			InitiatorVaultProposals: related,
 		}
 	} else {
-		o.R.InitiatorSigningRequests = append(o.R.InitiatorSigningRequests, related...)
+		o.R.InitiatorVaultProposals = append(o.R.InitiatorVaultProposals, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &signingRequestR{
+			rel.R = &vaultProposalR{
 				Initiator: o,
 			}
 		} else {
@@ -2915,14 +5485,14 @@ func (o *User) AddInitiatorSigningRequests(ctx context.Context, exec boil.Contex
 	return nil
 }
 
-// SetInitiatorSigningRequests removes all previously related items of the
+// SetInitiatorVaultProposals removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.Initiator's InitiatorSigningRequests accordingly.
-// Replaces o.R.InitiatorSigningRequests with related.
-// Sets related.R.Initiator's InitiatorSigningRequests accordingly.
-func (o *User) SetInitiatorSigningRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SigningRequest) error {
-	query := "update \"signing_requests\" set \"initiator_id\" = null where \"initiator_id\" = $1"
+// Sets o.R.Initiator's InitiatorVaultProposals accordingly.
+// Replaces o.R.InitiatorVaultProposals with related.
+// Sets related.R.Initiator's InitiatorVaultProposals accordingly.
+func (o *User) SetInitiatorVaultProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VaultProposal) error {
+	query := "update \"vault_proposals\" set \"initiator_id\" = null where \"initiator_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2935,7 +5505,7 @@ func (o *User) SetInitiatorSigningRequests(ctx context.Context, exec boil.Contex
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.InitiatorSigningRequests {
+		for _, rel := range o.R.InitiatorVaultProposals {
 			queries.SetScanner(&rel.InitiatorID, nil)
 			if rel.R == nil {
 				continue
@@ -2943,16 +5513,16 @@ func (o *User) SetInitiatorSigningRequests(ctx context.Context, exec boil.Contex
 
 			rel.R.Initiator = nil
 		}
-		o.R.InitiatorSigningRequests = nil
+		o.R.InitiatorVaultProposals = nil
 	}
 
-	return o.AddInitiatorSigningRequests(ctx, exec, insert, related...)
+	return o.AddInitiatorVaultProposals(ctx, exec, insert, related...)
 }
 
-// RemoveInitiatorSigningRequests relationships from objects passed in.
-// Removes related items from R.InitiatorSigningRequests (uses pointer comparison, removal does not keep order)
+// RemoveInitiatorVaultProposals relationships from objects passed in.
+// Removes related items from R.InitiatorVaultProposals (uses pointer comparison, removal does not keep order)
 // Sets related.R.Initiator.
-func (o *User) RemoveInitiatorSigningRequests(ctx context.Context, exec boil.ContextExecutor, related ...*SigningRequest) error {
+func (o *User) RemoveInitiatorVaultProposals(ctx context.Context, exec boil.ContextExecutor, related ...*VaultProposal) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -2972,16 +5542,16 @@ func (o *User) RemoveInitiatorSigningRequests(ctx context.Context, exec boil.Con
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.InitiatorSigningRequests {
+		for i, ri := range o.R.InitiatorVaultProposals {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.InitiatorSigningRequests)
+			ln := len(o.R.InitiatorVaultProposals)
 			if ln > 1 && i < ln-1 {
-				o.R.InitiatorSigningRequests[i] = o.R.InitiatorSigningRequests[ln-1]
+				o.R.InitiatorVaultProposals[i] = o.R.InitiatorVaultProposals[ln-1]
 			}
-			o.R.InitiatorSigningRequests = o.R.InitiatorSigningRequests[:ln-1]
+			o.R.InitiatorVaultProposals = o.R.InitiatorVaultProposals[:ln-1]
 			break
 		}
 	}
@@ -2989,23 +5559,23 @@ func (o *User) RemoveInitiatorSigningRequests(ctx context.Context, exec boil.Con
 	return nil
 }
 
-// AddUserCredentials adds the given related objects to the existing relationships
+// AddCreatedByWebhookEndpoints adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UserCredentials.
-// Sets related.R.User appropriately.
-func (o *User) AddUserCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserCredential) error {
+// Appends related to o.R.CreatedByWebhookEndpoints.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByWebhookEndpoints(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookEndpoint) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			queries.Assign(&rel.CreatedBy, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"user_credentials\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, userCredentialPrimaryKeyColumns),
+				"UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookEndpointPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3018,27 +5588,101 @@ func (o *User) AddUserCredentials(ctx context.Context, exec boil.ContextExecutor
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			queries.Assign(&rel.CreatedBy, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			UserCredentials: related,
+			CreatedByWebhookEndpoints: related,
 		}
 	} else {
-		o.R.UserCredentials = append(o.R.UserCredentials, related...)
+		o.R.CreatedByWebhookEndpoints = append(o.R.CreatedByWebhookEndpoints, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userCredentialR{
-				User: o,
+			rel.R = &webhookEndpointR{
+				CreatedByUser: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByWebhookEndpoints removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByWebhookEndpoints accordingly.
+// Replaces o.R.CreatedByWebhookEndpoints with related.
+// Sets related.R.CreatedByUser's CreatedByWebhookEndpoints accordingly.
+func (o *User) SetCreatedByWebhookEndpoints(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookEndpoint) error {
+	query := "update \"webhook_endpoints\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByWebhookEndpoints {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByWebhookEndpoints = nil
+	}
+
+	return o.AddCreatedByWebhookEndpoints(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByWebhookEndpoints relationships from objects passed in.
+// Removes related items from R.CreatedByWebhookEndpoints (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByWebhookEndpoints(ctx context.Context, exec boil.ContextExecutor, related ...*WebhookEndpoint) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByWebhookEndpoints {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByWebhookEndpoints)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByWebhookEndpoints[i] = o.R.CreatedByWebhookEndpoints[ln-1]
+			}
+			o.R.CreatedByWebhookEndpoints = o.R.CreatedByWebhookEndpoints[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
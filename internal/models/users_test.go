@@ -544,7 +544,7 @@ func testUserToManyAccessTokens(t *testing.T) {
 	}
 }
 
-func testUserToManyCreatedByAddressBooks(t *testing.T) {
+func testUserToManyApprovedByAddressBooks(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -569,8 +569,8 @@ func testUserToManyCreatedByAddressBooks(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.CreatedBy, a.ID)
-	queries.Assign(&c.CreatedBy, a.ID)
+	queries.Assign(&b.ApprovedBy, a.ID)
+	queries.Assign(&c.ApprovedBy, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -578,17 +578,17 @@ func testUserToManyCreatedByAddressBooks(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.CreatedByAddressBooks().All(ctx, tx)
+	check, err := a.ApprovedByAddressBooks().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.CreatedBy, b.CreatedBy) {
+		if queries.Equal(v.ApprovedBy, b.ApprovedBy) {
 			bFound = true
 		}
-		if queries.Equal(v.CreatedBy, c.CreatedBy) {
+		if queries.Equal(v.ApprovedBy, c.ApprovedBy) {
 			cFound = true
 		}
 	}
@@ -601,18 +601,18 @@ func testUserToManyCreatedByAddressBooks(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadCreatedByAddressBooks(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadApprovedByAddressBooks(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.CreatedByAddressBooks); got != 2 {
+	if got := len(a.R.ApprovedByAddressBooks); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.CreatedByAddressBooks = nil
-	if err = a.L.LoadCreatedByAddressBooks(ctx, tx, true, &a, nil); err != nil {
+	a.R.ApprovedByAddressBooks = nil
+	if err = a.L.LoadApprovedByAddressBooks(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.CreatedByAddressBooks); got != 2 {
+	if got := len(a.R.ApprovedByAddressBooks); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -621,14 +621,14 @@ func testUserToManyCreatedByAddressBooks(t *testing.T) {
 	}
 }
 
-func testUserToManyApprovals(t *testing.T) {
+func testUserToManyCreatedByAddressBooks(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Approval
+	var b, c AddressBook
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -639,15 +639,15 @@ func testUserToManyApprovals(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, addressBookDBTypes, false, addressBookColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, addressBookDBTypes, false, addressBookColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.UserID, a.ID)
-	queries.Assign(&c.UserID, a.ID)
+	queries.Assign(&b.CreatedBy, a.ID)
+	queries.Assign(&c.CreatedBy, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -655,17 +655,17 @@ func testUserToManyApprovals(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Approvals().All(ctx, tx)
+	check, err := a.CreatedByAddressBooks().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.UserID, b.UserID) {
+		if queries.Equal(v.CreatedBy, b.CreatedBy) {
 			bFound = true
 		}
-		if queries.Equal(v.UserID, c.UserID) {
+		if queries.Equal(v.CreatedBy, c.CreatedBy) {
 			cFound = true
 		}
 	}
@@ -678,18 +678,18 @@ func testUserToManyApprovals(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadApprovals(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadCreatedByAddressBooks(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Approvals); got != 2 {
+	if got := len(a.R.CreatedByAddressBooks); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Approvals = nil
-	if err = a.L.LoadApprovals(ctx, tx, true, &a, nil); err != nil {
+	a.R.CreatedByAddressBooks = nil
+	if err = a.L.LoadCreatedByAddressBooks(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Approvals); got != 2 {
+	if got := len(a.R.CreatedByAddressBooks); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -698,14 +698,14 @@ func testUserToManyApprovals(t *testing.T) {
 	}
 }
 
-func testUserToManyAuditLogs(t *testing.T) {
+func testUserToManyApprovals(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c AuditLog
+	var b, c Approval
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -716,10 +716,10 @@ func testUserToManyAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, approvalDBTypes, false, approvalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -732,7 +732,7 @@ func testUserToManyAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.AuditLogs().All(ctx, tx)
+	check, err := a.Approvals().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -755,18 +755,18 @@ func testUserToManyAuditLogs(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadAuditLogs(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadApprovals(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.AuditLogs); got != 2 {
+	if got := len(a.R.Approvals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.AuditLogs = nil
-	if err = a.L.LoadAuditLogs(ctx, tx, true, &a, nil); err != nil {
+	a.R.Approvals = nil
+	if err = a.L.LoadApprovals(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.AuditLogs); got != 2 {
+	if got := len(a.R.Approvals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -853,14 +853,14 @@ func testUserToManyConfirmationTokens(t *testing.T) {
 	}
 }
 
-func testUserToManyOrganizationMembers(t *testing.T) {
+func testUserToManyKeyShareBackups(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c OrganizationMember
+	var b, c KeyShareBackup
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -871,10 +871,10 @@ func testUserToManyOrganizationMembers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, keyShareBackupDBTypes, false, keyShareBackupColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, keyShareBackupDBTypes, false, keyShareBackupColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -888,7 +888,7 @@ func testUserToManyOrganizationMembers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.OrganizationMembers().All(ctx, tx)
+	check, err := a.KeyShareBackups().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -911,18 +911,18 @@ func testUserToManyOrganizationMembers(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadOrganizationMembers(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadKeyShareBackups(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.OrganizationMembers); got != 2 {
+	if got := len(a.R.KeyShareBackups); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.OrganizationMembers = nil
-	if err = a.L.LoadOrganizationMembers(ctx, tx, true, &a, nil); err != nil {
+	a.R.KeyShareBackups = nil
+	if err = a.L.LoadKeyShareBackups(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.OrganizationMembers); got != 2 {
+	if got := len(a.R.KeyShareBackups); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -931,14 +931,14 @@ func testUserToManyOrganizationMembers(t *testing.T) {
 	}
 }
 
-func testUserToManyOwnerOrganizations(t *testing.T) {
+func testUserToManyInitiatorKeyShareRecoveries(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c Organization
+	var b, c KeyShareRecovery
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -949,16 +949,15 @@ func testUserToManyOwnerOrganizations(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.OwnerID = a.ID
-	c.OwnerID = a.ID
-
+	queries.Assign(&b.InitiatorID, a.ID)
+	queries.Assign(&c.InitiatorID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -966,17 +965,17 @@ func testUserToManyOwnerOrganizations(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.OwnerOrganizations().All(ctx, tx)
+	check, err := a.InitiatorKeyShareRecoveries().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.OwnerID == b.OwnerID {
+		if queries.Equal(v.InitiatorID, b.InitiatorID) {
 			bFound = true
 		}
-		if v.OwnerID == c.OwnerID {
+		if queries.Equal(v.InitiatorID, c.InitiatorID) {
 			cFound = true
 		}
 	}
@@ -989,18 +988,18 @@ func testUserToManyOwnerOrganizations(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadOwnerOrganizations(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadInitiatorKeyShareRecoveries(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.OwnerOrganizations); got != 2 {
+	if got := len(a.R.InitiatorKeyShareRecoveries); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.OwnerOrganizations = nil
-	if err = a.L.LoadOwnerOrganizations(ctx, tx, true, &a, nil); err != nil {
+	a.R.InitiatorKeyShareRecoveries = nil
+	if err = a.L.LoadInitiatorKeyShareRecoveries(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.OwnerOrganizations); got != 2 {
+	if got := len(a.R.InitiatorKeyShareRecoveries); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1009,14 +1008,14 @@ func testUserToManyOwnerOrganizations(t *testing.T) {
 	}
 }
 
-func testUserToManyPasswordResetTokens(t *testing.T) {
+func testUserToManySubjectUserKeyShareRecoveries(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c PasswordResetToken
+	var b, c KeyShareRecovery
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1027,15 +1026,15 @@ func testUserToManyPasswordResetTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, passwordResetTokenDBTypes, false, passwordResetTokenColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, passwordResetTokenDBTypes, false, passwordResetTokenColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.UserID = a.ID
-	c.UserID = a.ID
+	b.SubjectUserID = a.ID
+	c.SubjectUserID = a.ID
 
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
@@ -1044,17 +1043,17 @@ func testUserToManyPasswordResetTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.PasswordResetTokens().All(ctx, tx)
+	check, err := a.SubjectUserKeyShareRecoveries().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.UserID == b.UserID {
+		if v.SubjectUserID == b.SubjectUserID {
 			bFound = true
 		}
-		if v.UserID == c.UserID {
+		if v.SubjectUserID == c.SubjectUserID {
 			cFound = true
 		}
 	}
@@ -1067,18 +1066,18 @@ func testUserToManyPasswordResetTokens(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadPasswordResetTokens(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadSubjectUserKeyShareRecoveries(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.PasswordResetTokens); got != 2 {
+	if got := len(a.R.SubjectUserKeyShareRecoveries); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.PasswordResetTokens = nil
-	if err = a.L.LoadPasswordResetTokens(ctx, tx, true, &a, nil); err != nil {
+	a.R.SubjectUserKeyShareRecoveries = nil
+	if err = a.L.LoadSubjectUserKeyShareRecoveries(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.PasswordResetTokens); got != 2 {
+	if got := len(a.R.SubjectUserKeyShareRecoveries); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1087,14 +1086,14 @@ func testUserToManyPasswordResetTokens(t *testing.T) {
 	}
 }
 
-func testUserToManyPushTokens(t *testing.T) {
+func testUserToManyKeyShareRecoveryApprovals(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c PushToken
+	var b, c KeyShareRecoveryApproval
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1105,10 +1104,10 @@ func testUserToManyPushTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, pushTokenDBTypes, false, pushTokenColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, pushTokenDBTypes, false, pushTokenColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, keyShareRecoveryApprovalDBTypes, false, keyShareRecoveryApprovalColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1122,7 +1121,7 @@ func testUserToManyPushTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.PushTokens().All(ctx, tx)
+	check, err := a.KeyShareRecoveryApprovals().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1145,18 +1144,18 @@ func testUserToManyPushTokens(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadPushTokens(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadKeyShareRecoveryApprovals(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.PushTokens); got != 2 {
+	if got := len(a.R.KeyShareRecoveryApprovals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.PushTokens = nil
-	if err = a.L.LoadPushTokens(ctx, tx, true, &a, nil); err != nil {
+	a.R.KeyShareRecoveryApprovals = nil
+	if err = a.L.LoadKeyShareRecoveryApprovals(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.PushTokens); got != 2 {
+	if got := len(a.R.KeyShareRecoveryApprovals); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1165,14 +1164,14 @@ func testUserToManyPushTokens(t *testing.T) {
 	}
 }
 
-func testUserToManyRefreshTokens(t *testing.T) {
+func testUserToManyNotificationPreferences(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c RefreshToken
+	var b, c NotificationPreference
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1183,10 +1182,10 @@ func testUserToManyRefreshTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, refreshTokenDBTypes, false, refreshTokenColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, refreshTokenDBTypes, false, refreshTokenColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1200,7 +1199,7 @@ func testUserToManyRefreshTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.RefreshTokens().All(ctx, tx)
+	check, err := a.NotificationPreferences().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1223,18 +1222,18 @@ func testUserToManyRefreshTokens(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadRefreshTokens(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadNotificationPreferences(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.RefreshTokens); got != 2 {
+	if got := len(a.R.NotificationPreferences); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.RefreshTokens = nil
-	if err = a.L.LoadRefreshTokens(ctx, tx, true, &a, nil); err != nil {
+	a.R.NotificationPreferences = nil
+	if err = a.L.LoadNotificationPreferences(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.RefreshTokens); got != 2 {
+	if got := len(a.R.NotificationPreferences); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1243,14 +1242,14 @@ func testUserToManyRefreshTokens(t *testing.T) {
 	}
 }
 
-func testUserToManyInitiatorSigningRequests(t *testing.T) {
+func testUserToManyAcceptedByOrganizationInvitations(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c SigningRequest
+	var b, c OrganizationInvitation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1261,15 +1260,15 @@ func testUserToManyInitiatorSigningRequests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, signingRequestDBTypes, false, signingRequestColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, signingRequestDBTypes, false, signingRequestColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.InitiatorID, a.ID)
-	queries.Assign(&c.InitiatorID, a.ID)
+	queries.Assign(&b.AcceptedBy, a.ID)
+	queries.Assign(&c.AcceptedBy, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1277,17 +1276,17 @@ func testUserToManyInitiatorSigningRequests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.InitiatorSigningRequests().All(ctx, tx)
+	check, err := a.AcceptedByOrganizationInvitations().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.InitiatorID, b.InitiatorID) {
+		if queries.Equal(v.AcceptedBy, b.AcceptedBy) {
 			bFound = true
 		}
-		if queries.Equal(v.InitiatorID, c.InitiatorID) {
+		if queries.Equal(v.AcceptedBy, c.AcceptedBy) {
 			cFound = true
 		}
 	}
@@ -1300,18 +1299,18 @@ func testUserToManyInitiatorSigningRequests(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadInitiatorSigningRequests(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadAcceptedByOrganizationInvitations(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.InitiatorSigningRequests); got != 2 {
+	if got := len(a.R.AcceptedByOrganizationInvitations); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.InitiatorSigningRequests = nil
-	if err = a.L.LoadInitiatorSigningRequests(ctx, tx, true, &a, nil); err != nil {
+	a.R.AcceptedByOrganizationInvitations = nil
+	if err = a.L.LoadAcceptedByOrganizationInvitations(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.InitiatorSigningRequests); got != 2 {
+	if got := len(a.R.AcceptedByOrganizationInvitations); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1320,14 +1319,14 @@ func testUserToManyInitiatorSigningRequests(t *testing.T) {
 	}
 }
 
-func testUserToManyUserCredentials(t *testing.T) {
+func testUserToManyInvitedByOrganizationInvitations(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c UserCredential
+	var b, c OrganizationInvitation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
@@ -1338,16 +1337,15 @@ func testUserToManyUserCredentials(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, userCredentialDBTypes, false, userCredentialColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, userCredentialDBTypes, false, userCredentialColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, organizationInvitationDBTypes, false, organizationInvitationColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	b.UserID = a.ID
-	c.UserID = a.ID
-
+	queries.Assign(&b.InvitedBy, a.ID)
+	queries.Assign(&c.InvitedBy, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1355,17 +1353,17 @@ func testUserToManyUserCredentials(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.UserCredentials().All(ctx, tx)
+	check, err := a.InvitedByOrganizationInvitations().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.UserID == b.UserID {
+		if queries.Equal(v.InvitedBy, b.InvitedBy) {
 			bFound = true
 		}
-		if v.UserID == c.UserID {
+		if queries.Equal(v.InvitedBy, c.InvitedBy) {
 			cFound = true
 		}
 	}
@@ -1378,18 +1376,18 @@ func testUserToManyUserCredentials(t *testing.T) {
 	}
 
 	slice := UserSlice{&a}
-	if err = a.L.LoadUserCredentials(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+	if err = a.L.LoadInvitedByOrganizationInvitations(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.UserCredentials); got != 2 {
+	if got := len(a.R.InvitedByOrganizationInvitations); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.UserCredentials = nil
-	if err = a.L.LoadUserCredentials(ctx, tx, true, &a, nil); err != nil {
+	a.R.InvitedByOrganizationInvitations = nil
+	if err = a.L.LoadInvitedByOrganizationInvitations(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.UserCredentials); got != 2 {
+	if got := len(a.R.InvitedByOrganizationInvitations); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -1398,30 +1396,34 @@ func testUserToManyUserCredentials(t *testing.T) {
 	}
 }
 
-func testUserToManyAddOpAccessTokens(t *testing.T) {
+func testUserToManyOrganizationMembers(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e AccessToken
+	var b, c OrganizationMember
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*AccessToken{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, accessTokenDBTypes, false, strmangle.SetComplement(accessTokenPrimaryKeyColumns, accessTokenColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organizationMemberDBTypes, false, organizationMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -1429,78 +1431,1258 @@ func testUserToManyAddOpAccessTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*AccessToken{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.OrganizationMembers().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddAccessTokens(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.UserID {
-			t.Error("foreign key was wrong value", a.ID, first.UserID)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
 		}
-		if a.ID != second.UserID {
-			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		if v.UserID == c.UserID {
+			cFound = true
 		}
+	}
 
-		if first.R.User != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.User != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if a.R.AccessTokens[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.AccessTokens[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
+	slice := UserSlice{&a}
+	if err = a.L.LoadOrganizationMembers(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrganizationMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		count, err := a.AccessTokens().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	a.R.OrganizationMembers = nil
+	if err = a.L.LoadOrganizationMembers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OrganizationMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
-func testUserToManyAddOpCreatedByAddressBooks(t *testing.T) {
-	var err error
 
+func testUserToManyOwnerOrganizations(t *testing.T) {
+	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e AddressBook
+	var b, c Organization
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*AddressBook{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+
+	if err = randomize.Struct(seed, &b, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &c, organizationDBTypes, false, organizationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OwnerID = a.ID
+	c.OwnerID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OwnerOrganizations().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OwnerID == b.OwnerID {
+			bFound = true
+		}
+		if v.OwnerID == c.OwnerID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadOwnerOrganizations(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OwnerOrganizations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OwnerOrganizations = nil
+	if err = a.L.LoadOwnerOrganizations(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OwnerOrganizations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyPasskeyChallenges(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c PasskeyChallenge
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, passkeyChallengeDBTypes, false, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, passkeyChallengeDBTypes, false, passkeyChallengeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PasskeyChallenges().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadPasskeyChallenges(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PasskeyChallenges); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PasskeyChallenges = nil
+	if err = a.L.LoadPasskeyChallenges(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PasskeyChallenges); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyPasswordResetTokens(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c PasswordResetToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, passwordResetTokenDBTypes, false, passwordResetTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, passwordResetTokenDBTypes, false, passwordResetTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PasswordResetTokens().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadPasswordResetTokens(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PasswordResetTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PasswordResetTokens = nil
+	if err = a.L.LoadPasswordResetTokens(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PasswordResetTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyPushTokens(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c PushToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, pushTokenDBTypes, false, pushTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pushTokenDBTypes, false, pushTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PushTokens().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadPushTokens(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PushTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PushTokens = nil
+	if err = a.L.LoadPushTokens(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PushTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyRefreshTokens(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c RefreshToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, refreshTokenDBTypes, false, refreshTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, refreshTokenDBTypes, false, refreshTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RefreshTokens().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadRefreshTokens(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RefreshTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RefreshTokens = nil
+	if err = a.L.LoadRefreshTokens(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RefreshTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyInitiatorSigningRequests(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c SigningRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, signingRequestDBTypes, false, signingRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, signingRequestDBTypes, false, signingRequestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.InitiatorID, a.ID)
+	queries.Assign(&c.InitiatorID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.InitiatorSigningRequests().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.InitiatorID, b.InitiatorID) {
+			bFound = true
+		}
+		if queries.Equal(v.InitiatorID, c.InitiatorID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadInitiatorSigningRequests(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.InitiatorSigningRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.InitiatorSigningRequests = nil
+	if err = a.L.LoadInitiatorSigningRequests(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.InitiatorSigningRequests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyUserCredentials(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c UserCredential
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, userCredentialDBTypes, false, userCredentialColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userCredentialDBTypes, false, userCredentialColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.UserCredentials().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadUserCredentials(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UserCredentials); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.UserCredentials = nil
+	if err = a.L.LoadUserCredentials(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UserCredentials); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyUserDevices(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c UserDevice
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDeviceDBTypes, false, userDeviceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.UserDevices().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadUserDevices(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UserDevices); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.UserDevices = nil
+	if err = a.L.LoadUserDevices(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.UserDevices); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyVaultProposalApprovals(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c VaultProposalApproval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.UserID = a.ID
+	c.UserID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.VaultProposalApprovals().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.UserID == b.UserID {
+			bFound = true
+		}
+		if v.UserID == c.UserID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadVaultProposalApprovals(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultProposalApprovals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.VaultProposalApprovals = nil
+	if err = a.L.LoadVaultProposalApprovals(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultProposalApprovals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyInitiatorVaultProposals(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.InitiatorID, a.ID)
+	queries.Assign(&c.InitiatorID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.InitiatorVaultProposals().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.InitiatorID, b.InitiatorID) {
+			bFound = true
+		}
+		if queries.Equal(v.InitiatorID, c.InitiatorID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadInitiatorVaultProposals(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.InitiatorVaultProposals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.InitiatorVaultProposals = nil
+	if err = a.L.LoadInitiatorVaultProposals(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.InitiatorVaultProposals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyCreatedByWebhookEndpoints(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c WebhookEndpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CreatedBy, a.ID)
+	queries.Assign(&c.CreatedBy, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CreatedByWebhookEndpoints().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CreatedBy, b.CreatedBy) {
+			bFound = true
+		}
+		if queries.Equal(v.CreatedBy, c.CreatedBy) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := UserSlice{&a}
+	if err = a.L.LoadCreatedByWebhookEndpoints(ctx, tx, false, (*[]*User)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedByWebhookEndpoints); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CreatedByWebhookEndpoints = nil
+	if err = a.L.LoadCreatedByWebhookEndpoints(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CreatedByWebhookEndpoints); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testUserToManyAddOpAccessTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AccessToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccessToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accessTokenDBTypes, false, strmangle.SetComplement(accessTokenPrimaryKeyColumns, accessTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AccessToken{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAccessTokens(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AccessTokens[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AccessTokens[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AccessTokens().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpApprovedByAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AddressBook{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddApprovedByAddressBooks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ApprovedBy) {
+			t.Error("foreign key was wrong value", a.ID, first.ApprovedBy)
+		}
+		if !queries.Equal(a.ID, second.ApprovedBy) {
+			t.Error("foreign key was wrong value", a.ID, second.ApprovedBy)
+		}
+
+		if first.R.ApprovedByUser != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ApprovedByUser != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ApprovedByAddressBooks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ApprovedByAddressBooks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ApprovedByAddressBooks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpApprovedByAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetApprovedByAddressBooks(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ApprovedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetApprovedByAddressBooks(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ApprovedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ApprovedBy) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ApprovedBy) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ApprovedBy) {
+		t.Error("foreign key was wrong value", a.ID, d.ApprovedBy)
+	}
+	if !queries.Equal(a.ID, e.ApprovedBy) {
+		t.Error("foreign key was wrong value", a.ID, e.ApprovedBy)
+	}
+
+	if b.R.ApprovedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ApprovedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ApprovedByUser != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ApprovedByUser != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ApprovedByAddressBooks[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ApprovedByAddressBooks[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpApprovedByAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddApprovedByAddressBooks(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ApprovedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveApprovedByAddressBooks(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ApprovedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ApprovedBy) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ApprovedBy) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.ApprovedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ApprovedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ApprovedByUser != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ApprovedByUser != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ApprovedByAddressBooks) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ApprovedByAddressBooks[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ApprovedByAddressBooks[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpCreatedByAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1510,7 +2692,910 @@ func testUserToManyAddOpCreatedByAddressBooks(t *testing.T) {
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddCreatedByAddressBooks(ctx, tx, i != 0, x...)
+		err = a.AddCreatedByAddressBooks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CreatedBy) {
+			t.Error("foreign key was wrong value", a.ID, first.CreatedBy)
+		}
+		if !queries.Equal(a.ID, second.CreatedBy) {
+			t.Error("foreign key was wrong value", a.ID, second.CreatedBy)
+		}
+
+		if first.R.CreatedByUser != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.CreatedByUser != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CreatedByAddressBooks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CreatedByAddressBooks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CreatedByAddressBooks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpCreatedByAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCreatedByAddressBooks(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CreatedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCreatedByAddressBooks(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CreatedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CreatedBy) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CreatedBy) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CreatedBy) {
+		t.Error("foreign key was wrong value", a.ID, d.CreatedBy)
+	}
+	if !queries.Equal(a.ID, e.CreatedBy) {
+		t.Error("foreign key was wrong value", a.ID, e.CreatedBy)
+	}
+
+	if b.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CreatedByUser != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.CreatedByUser != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CreatedByAddressBooks[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CreatedByAddressBooks[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpCreatedByAddressBooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e AddressBook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AddressBook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCreatedByAddressBooks(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CreatedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCreatedByAddressBooks(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CreatedByAddressBooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CreatedBy) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CreatedBy) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CreatedByUser != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.CreatedByUser != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CreatedByAddressBooks) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CreatedByAddressBooks[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CreatedByAddressBooks[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Approval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.UserID) {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if !queries.Equal(a.ID, second.UserID) {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Approvals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Approvals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Approvals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetApprovals(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetApprovals(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.UserID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.UserID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.UserID) {
+		t.Error("foreign key was wrong value", a.ID, d.UserID)
+	}
+	if !queries.Equal(a.ID, e.UserID) {
+		t.Error("foreign key was wrong value", a.ID, e.UserID)
+	}
+
+	if b.R.User != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.User != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.User != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.User != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Approvals[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Approvals[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e Approval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Approval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddApprovals(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveApprovals(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Approvals().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.UserID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.UserID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.User != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.User != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.User != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.User != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Approvals) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Approvals[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Approvals[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpConfirmationTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e ConfirmationToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ConfirmationToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, confirmationTokenDBTypes, false, strmangle.SetComplement(confirmationTokenPrimaryKeyColumns, confirmationTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ConfirmationToken{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddConfirmationTokens(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ConfirmationTokens[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ConfirmationTokens[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ConfirmationTokens().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpKeyShareBackups(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e KeyShareBackup
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareBackup{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareBackupDBTypes, false, strmangle.SetComplement(keyShareBackupPrimaryKeyColumns, keyShareBackupColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyShareBackup{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKeyShareBackups(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.KeyShareBackups[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KeyShareBackups[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.KeyShareBackups().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpInitiatorKeyShareRecoveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareRecovery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyShareRecovery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddInitiatorKeyShareRecoveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.InitiatorID) {
+			t.Error("foreign key was wrong value", a.ID, first.InitiatorID)
+		}
+		if !queries.Equal(a.ID, second.InitiatorID) {
+			t.Error("foreign key was wrong value", a.ID, second.InitiatorID)
+		}
+
+		if first.R.Initiator != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Initiator != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.InitiatorKeyShareRecoveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.InitiatorKeyShareRecoveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.InitiatorKeyShareRecoveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpInitiatorKeyShareRecoveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareRecovery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetInitiatorKeyShareRecoveries(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.InitiatorKeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetInitiatorKeyShareRecoveries(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.InitiatorKeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.InitiatorID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.InitiatorID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.InitiatorID) {
+		t.Error("foreign key was wrong value", a.ID, d.InitiatorID)
+	}
+	if !queries.Equal(a.ID, e.InitiatorID) {
+		t.Error("foreign key was wrong value", a.ID, e.InitiatorID)
+	}
+
+	if b.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Initiator != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Initiator != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.InitiatorKeyShareRecoveries[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.InitiatorKeyShareRecoveries[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpInitiatorKeyShareRecoveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareRecovery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddInitiatorKeyShareRecoveries(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.InitiatorKeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveInitiatorKeyShareRecoveries(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.InitiatorKeyShareRecoveries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.InitiatorID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.InitiatorID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Initiator != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Initiator != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.InitiatorKeyShareRecoveries) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.InitiatorKeyShareRecoveries[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.InitiatorKeyShareRecoveries[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testUserToManyAddOpSubjectUserKeyShareRecoveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareRecovery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyShareRecovery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSubjectUserKeyShareRecoveries(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1518,28 +3603,28 @@ func testUserToManyAddOpCreatedByAddressBooks(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.CreatedBy) {
-			t.Error("foreign key was wrong value", a.ID, first.CreatedBy)
+		if a.ID != first.SubjectUserID {
+			t.Error("foreign key was wrong value", a.ID, first.SubjectUserID)
 		}
-		if !queries.Equal(a.ID, second.CreatedBy) {
-			t.Error("foreign key was wrong value", a.ID, second.CreatedBy)
+		if a.ID != second.SubjectUserID {
+			t.Error("foreign key was wrong value", a.ID, second.SubjectUserID)
 		}
 
-		if first.R.CreatedByUser != &a {
+		if first.R.SubjectUser != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.CreatedByUser != &a {
+		if second.R.SubjectUser != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.CreatedByAddressBooks[i*2] != first {
+		if a.R.SubjectUserKeyShareRecoveries[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.CreatedByAddressBooks[i*2+1] != second {
+		if a.R.SubjectUserKeyShareRecoveries[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.CreatedByAddressBooks().Count(ctx, tx)
+		count, err := a.SubjectUserKeyShareRecoveries().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1548,8 +3633,82 @@ func testUserToManyAddOpCreatedByAddressBooks(t *testing.T) {
 		}
 	}
 }
+func testUserToManyAddOpKeyShareRecoveryApprovals(t *testing.T) {
+	var err error
 
-func testUserToManySetOpCreatedByAddressBooks(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e KeyShareRecoveryApproval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyShareRecoveryApproval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryApprovalDBTypes, false, strmangle.SetComplement(keyShareRecoveryApprovalPrimaryKeyColumns, keyShareRecoveryApprovalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyShareRecoveryApproval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKeyShareRecoveryApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.KeyShareRecoveryApprovals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KeyShareRecoveryApprovals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.KeyShareRecoveryApprovals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpNotificationPreferences(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1557,15 +3716,166 @@ func testUserToManySetOpCreatedByAddressBooks(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e AddressBook
+	var b, c, d, e NotificationPreference
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AddressBook{&b, &c, &d, &e}
+	foreigners := []*NotificationPreference{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, notificationPreferenceDBTypes, false, strmangle.SetComplement(notificationPreferencePrimaryKeyColumns, notificationPreferenceColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*NotificationPreference{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddNotificationPreferences(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.NotificationPreferences[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.NotificationPreferences[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.NotificationPreferences().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testUserToManyAddOpAcceptedByOrganizationInvitations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e OrganizationInvitation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OrganizationInvitation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OrganizationInvitation{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAcceptedByOrganizationInvitations(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.AcceptedBy) {
+			t.Error("foreign key was wrong value", a.ID, first.AcceptedBy)
+		}
+		if !queries.Equal(a.ID, second.AcceptedBy) {
+			t.Error("foreign key was wrong value", a.ID, second.AcceptedBy)
+		}
+
+		if first.R.AcceptedByUser != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.AcceptedByUser != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AcceptedByOrganizationInvitations[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AcceptedByOrganizationInvitations[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AcceptedByOrganizationInvitations().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpAcceptedByOrganizationInvitations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e OrganizationInvitation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OrganizationInvitation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1580,12 +3890,12 @@ func testUserToManySetOpCreatedByAddressBooks(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetCreatedByAddressBooks(ctx, tx, false, &b, &c)
+	err = a.SetAcceptedByOrganizationInvitations(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.CreatedByAddressBooks().Count(ctx, tx)
+	count, err := a.AcceptedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1593,12 +3903,12 @@ func testUserToManySetOpCreatedByAddressBooks(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetCreatedByAddressBooks(ctx, tx, true, &d, &e)
+	err = a.SetAcceptedByOrganizationInvitations(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.CreatedByAddressBooks().Count(ctx, tx)
+	count, err = a.AcceptedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1606,41 +3916,41 @@ func testUserToManySetOpCreatedByAddressBooks(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.CreatedBy) {
+	if !queries.IsValuerNil(b.AcceptedBy) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.CreatedBy) {
+	if !queries.IsValuerNil(c.AcceptedBy) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.CreatedBy) {
-		t.Error("foreign key was wrong value", a.ID, d.CreatedBy)
+	if !queries.Equal(a.ID, d.AcceptedBy) {
+		t.Error("foreign key was wrong value", a.ID, d.AcceptedBy)
 	}
-	if !queries.Equal(a.ID, e.CreatedBy) {
-		t.Error("foreign key was wrong value", a.ID, e.CreatedBy)
+	if !queries.Equal(a.ID, e.AcceptedBy) {
+		t.Error("foreign key was wrong value", a.ID, e.AcceptedBy)
 	}
 
-	if b.R.CreatedByUser != nil {
+	if b.R.AcceptedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.CreatedByUser != nil {
+	if c.R.AcceptedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.CreatedByUser != &a {
+	if d.R.AcceptedByUser != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.CreatedByUser != &a {
+	if e.R.AcceptedByUser != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.CreatedByAddressBooks[0] != &d {
+	if a.R.AcceptedByOrganizationInvitations[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.CreatedByAddressBooks[1] != &e {
+	if a.R.AcceptedByOrganizationInvitations[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testUserToManyRemoveOpCreatedByAddressBooks(t *testing.T) {
+func testUserToManyRemoveOpAcceptedByOrganizationInvitations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1648,15 +3958,15 @@ func testUserToManyRemoveOpCreatedByAddressBooks(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e AddressBook
+	var b, c, d, e OrganizationInvitation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AddressBook{&b, &c, &d, &e}
+	foreigners := []*OrganizationInvitation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, addressBookDBTypes, false, strmangle.SetComplement(addressBookPrimaryKeyColumns, addressBookColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1665,12 +3975,12 @@ func testUserToManyRemoveOpCreatedByAddressBooks(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddCreatedByAddressBooks(ctx, tx, true, foreigners...)
+	err = a.AddAcceptedByOrganizationInvitations(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.CreatedByAddressBooks().Count(ctx, tx)
+	count, err := a.AcceptedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1678,12 +3988,12 @@ func testUserToManyRemoveOpCreatedByAddressBooks(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveCreatedByAddressBooks(ctx, tx, foreigners[:2]...)
+	err = a.RemoveAcceptedByOrganizationInvitations(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.CreatedByAddressBooks().Count(ctx, tx)
+	count, err = a.AcceptedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1691,40 +4001,40 @@ func testUserToManyRemoveOpCreatedByAddressBooks(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.CreatedBy) {
+	if !queries.IsValuerNil(b.AcceptedBy) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.CreatedBy) {
+	if !queries.IsValuerNil(c.AcceptedBy) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.CreatedByUser != nil {
+	if b.R.AcceptedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.CreatedByUser != nil {
+	if c.R.AcceptedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.CreatedByUser != &a {
+	if d.R.AcceptedByUser != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.CreatedByUser != &a {
+	if e.R.AcceptedByUser != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.CreatedByAddressBooks) != 2 {
+	if len(a.R.AcceptedByOrganizationInvitations) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.CreatedByAddressBooks[1] != &d {
+	if a.R.AcceptedByOrganizationInvitations[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.CreatedByAddressBooks[0] != &e {
+	if a.R.AcceptedByOrganizationInvitations[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testUserToManyAddOpApprovals(t *testing.T) {
+func testUserToManyAddOpInvitedByOrganizationInvitations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1732,15 +4042,15 @@ func testUserToManyAddOpApprovals(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Approval
+	var b, c, d, e OrganizationInvitation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Approval{&b, &c, &d, &e}
+	foreigners := []*OrganizationInvitation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1755,13 +4065,13 @@ func testUserToManyAddOpApprovals(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Approval{
+	foreignersSplitByInsertion := [][]*OrganizationInvitation{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddApprovals(ctx, tx, i != 0, x...)
+		err = a.AddInvitedByOrganizationInvitations(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1769,28 +4079,28 @@ func testUserToManyAddOpApprovals(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.UserID) {
-			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		if !queries.Equal(a.ID, first.InvitedBy) {
+			t.Error("foreign key was wrong value", a.ID, first.InvitedBy)
 		}
-		if !queries.Equal(a.ID, second.UserID) {
-			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		if !queries.Equal(a.ID, second.InvitedBy) {
+			t.Error("foreign key was wrong value", a.ID, second.InvitedBy)
 		}
 
-		if first.R.User != &a {
+		if first.R.InvitedByUser != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.User != &a {
+		if second.R.InvitedByUser != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.Approvals[i*2] != first {
+		if a.R.InvitedByOrganizationInvitations[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Approvals[i*2+1] != second {
+		if a.R.InvitedByOrganizationInvitations[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Approvals().Count(ctx, tx)
+		count, err := a.InvitedByOrganizationInvitations().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1800,7 +4110,7 @@ func testUserToManyAddOpApprovals(t *testing.T) {
 	}
 }
 
-func testUserToManySetOpApprovals(t *testing.T) {
+func testUserToManySetOpInvitedByOrganizationInvitations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1808,15 +4118,15 @@ func testUserToManySetOpApprovals(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Approval
+	var b, c, d, e OrganizationInvitation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Approval{&b, &c, &d, &e}
+	foreigners := []*OrganizationInvitation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1831,12 +4141,12 @@ func testUserToManySetOpApprovals(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetApprovals(ctx, tx, false, &b, &c)
+	err = a.SetInvitedByOrganizationInvitations(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.Approvals().Count(ctx, tx)
+	count, err := a.InvitedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1844,12 +4154,12 @@ func testUserToManySetOpApprovals(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetApprovals(ctx, tx, true, &d, &e)
+	err = a.SetInvitedByOrganizationInvitations(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.Approvals().Count(ctx, tx)
+	count, err = a.InvitedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1857,41 +4167,41 @@ func testUserToManySetOpApprovals(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.UserID) {
+	if !queries.IsValuerNil(b.InvitedBy) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.UserID) {
+	if !queries.IsValuerNil(c.InvitedBy) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.UserID) {
-		t.Error("foreign key was wrong value", a.ID, d.UserID)
+	if !queries.Equal(a.ID, d.InvitedBy) {
+		t.Error("foreign key was wrong value", a.ID, d.InvitedBy)
 	}
-	if !queries.Equal(a.ID, e.UserID) {
-		t.Error("foreign key was wrong value", a.ID, e.UserID)
+	if !queries.Equal(a.ID, e.InvitedBy) {
+		t.Error("foreign key was wrong value", a.ID, e.InvitedBy)
 	}
 
-	if b.R.User != nil {
+	if b.R.InvitedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.User != nil {
+	if c.R.InvitedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.User != &a {
+	if d.R.InvitedByUser != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.User != &a {
+	if e.R.InvitedByUser != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.Approvals[0] != &d {
+	if a.R.InvitedByOrganizationInvitations[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.Approvals[1] != &e {
+	if a.R.InvitedByOrganizationInvitations[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testUserToManyRemoveOpApprovals(t *testing.T) {
+func testUserToManyRemoveOpInvitedByOrganizationInvitations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1899,15 +4209,15 @@ func testUserToManyRemoveOpApprovals(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Approval
+	var b, c, d, e OrganizationInvitation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Approval{&b, &c, &d, &e}
+	foreigners := []*OrganizationInvitation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, approvalDBTypes, false, strmangle.SetComplement(approvalPrimaryKeyColumns, approvalColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationInvitationDBTypes, false, strmangle.SetComplement(organizationInvitationPrimaryKeyColumns, organizationInvitationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1916,12 +4226,12 @@ func testUserToManyRemoveOpApprovals(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddApprovals(ctx, tx, true, foreigners...)
+	err = a.AddInvitedByOrganizationInvitations(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.Approvals().Count(ctx, tx)
+	count, err := a.InvitedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1929,12 +4239,12 @@ func testUserToManyRemoveOpApprovals(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveApprovals(ctx, tx, foreigners[:2]...)
+	err = a.RemoveInvitedByOrganizationInvitations(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.Approvals().Count(ctx, tx)
+	count, err = a.InvitedByOrganizationInvitations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1942,40 +4252,40 @@ func testUserToManyRemoveOpApprovals(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.UserID) {
+	if !queries.IsValuerNil(b.InvitedBy) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.UserID) {
+	if !queries.IsValuerNil(c.InvitedBy) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.User != nil {
+	if b.R.InvitedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.User != nil {
+	if c.R.InvitedByUser != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.User != &a {
+	if d.R.InvitedByUser != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.User != &a {
+	if e.R.InvitedByUser != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.Approvals) != 2 {
+	if len(a.R.InvitedByOrganizationInvitations) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.Approvals[1] != &d {
+	if a.R.InvitedByOrganizationInvitations[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.Approvals[0] != &e {
+	if a.R.InvitedByOrganizationInvitations[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testUserToManyAddOpAuditLogs(t *testing.T) {
+func testUserToManyAddOpOrganizationMembers(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1983,15 +4293,15 @@ func testUserToManyAddOpAuditLogs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e AuditLog
+	var b, c, d, e OrganizationMember
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AuditLog{&b, &c, &d, &e}
+	foreigners := []*OrganizationMember{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationMemberDBTypes, false, strmangle.SetComplement(organizationMemberPrimaryKeyColumns, organizationMemberColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2006,13 +4316,13 @@ func testUserToManyAddOpAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*AuditLog{
+	foreignersSplitByInsertion := [][]*OrganizationMember{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddAuditLogs(ctx, tx, i != 0, x...)
+		err = a.AddOrganizationMembers(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2020,10 +4330,10 @@ func testUserToManyAddOpAuditLogs(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.UserID) {
+		if a.ID != first.UserID {
 			t.Error("foreign key was wrong value", a.ID, first.UserID)
 		}
-		if !queries.Equal(a.ID, second.UserID) {
+		if a.ID != second.UserID {
 			t.Error("foreign key was wrong value", a.ID, second.UserID)
 		}
 
@@ -2034,14 +4344,14 @@ func testUserToManyAddOpAuditLogs(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.AuditLogs[i*2] != first {
+		if a.R.OrganizationMembers[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.AuditLogs[i*2+1] != second {
+		if a.R.OrganizationMembers[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.AuditLogs().Count(ctx, tx)
+		count, err := a.OrganizationMembers().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2050,8 +4360,7 @@ func testUserToManyAddOpAuditLogs(t *testing.T) {
 		}
 	}
 }
-
-func testUserToManySetOpAuditLogs(t *testing.T) {
+func testUserToManyAddOpOwnerOrganizations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2059,20 +4368,20 @@ func testUserToManySetOpAuditLogs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e AuditLog
+	var b, c, d, e Organization
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AuditLog{&b, &c, &d, &e}
+	foreigners := []*Organization{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
 
-	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
@@ -2082,67 +4391,126 @@ func testUserToManySetOpAuditLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetAuditLogs(ctx, tx, false, &b, &c)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*Organization{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err := a.AuditLogs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOwnerOrganizations(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	err = a.SetAuditLogs(ctx, tx, true, &d, &e)
-	if err != nil {
-		t.Fatal(err)
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OwnerID {
+			t.Error("foreign key was wrong value", a.ID, first.OwnerID)
+		}
+		if a.ID != second.OwnerID {
+			t.Error("foreign key was wrong value", a.ID, second.OwnerID)
+		}
+
+		if first.R.Owner != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Owner != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OwnerOrganizations[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OwnerOrganizations[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OwnerOrganizations().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
+}
+func testUserToManyAddOpPasskeyChallenges(t *testing.T) {
+	var err error
 
-	count, err = a.AuditLogs().Count(ctx, tx)
-	if err != nil {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e PasskeyChallenge
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
+	foreigners := []*PasskeyChallenge{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, passkeyChallengeDBTypes, false, strmangle.SetComplement(passkeyChallengePrimaryKeyColumns, passkeyChallengeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	if !queries.IsValuerNil(b.UserID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.UserID) {
-		t.Error("want c's foreign key value to be nil")
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
 	}
-	if !queries.Equal(a.ID, d.UserID) {
-		t.Error("foreign key was wrong value", a.ID, d.UserID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
 	}
-	if !queries.Equal(a.ID, e.UserID) {
-		t.Error("foreign key was wrong value", a.ID, e.UserID)
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
 	}
 
-	if b.R.User != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.User != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.User != &a {
-		t.Error("relationship was not added properly to the foreign struct")
-	}
-	if e.R.User != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+	foreignersSplitByInsertion := [][]*PasskeyChallenge{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	if a.R.AuditLogs[0] != &d {
-		t.Error("relationship struct slice not set to correct value")
-	}
-	if a.R.AuditLogs[1] != &e {
-		t.Error("relationship struct slice not set to correct value")
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPasskeyChallenges(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
+
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PasskeyChallenges[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PasskeyChallenges[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PasskeyChallenges().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testUserToManyRemoveOpAuditLogs(t *testing.T) {
+func testUserToManyAddOpPasswordResetTokens(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2150,15 +4518,15 @@ func testUserToManyRemoveOpAuditLogs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e AuditLog
+	var b, c, d, e PasswordResetToken
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*AuditLog{&b, &c, &d, &e}
+	foreigners := []*PasswordResetToken{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, auditLogDBTypes, false, strmangle.SetComplement(auditLogPrimaryKeyColumns, auditLogColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, passwordResetTokenDBTypes, false, strmangle.SetComplement(passwordResetTokenPrimaryKeyColumns, passwordResetTokenColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2166,67 +4534,58 @@ func testUserToManyRemoveOpAuditLogs(t *testing.T) {
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	err = a.AddAuditLogs(ctx, tx, true, foreigners...)
-	if err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	count, err := a.AuditLogs().Count(ctx, tx)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if count != 4 {
-		t.Error("count was wrong:", count)
-	}
 
-	err = a.RemoveAuditLogs(ctx, tx, foreigners[:2]...)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*PasswordResetToken{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err = a.AuditLogs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPasswordResetTokens(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	if !queries.IsValuerNil(b.UserID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.UserID) {
-		t.Error("want c's foreign key value to be nil")
-	}
+		first := x[0]
+		second := x[1]
 
-	if b.R.User != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.User != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.User != &a {
-		t.Error("relationship to a should have been preserved")
-	}
-	if e.R.User != &a {
-		t.Error("relationship to a should have been preserved")
-	}
+		if a.ID != first.UserID {
+			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		}
+		if a.ID != second.UserID {
+			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		}
 
-	if len(a.R.AuditLogs) != 2 {
-		t.Error("should have preserved two relationships")
-	}
+		if first.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.User != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PasswordResetTokens[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PasswordResetTokens[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
 
-	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.AuditLogs[1] != &d {
-		t.Error("relationship to d should have been preserved")
-	}
-	if a.R.AuditLogs[0] != &e {
-		t.Error("relationship to e should have been preserved")
+		count, err := a.PasswordResetTokens().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testUserToManyAddOpConfirmationTokens(t *testing.T) {
+func testUserToManyAddOpPushTokens(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2234,15 +4593,15 @@ func testUserToManyAddOpConfirmationTokens(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e ConfirmationToken
+	var b, c, d, e PushToken
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*ConfirmationToken{&b, &c, &d, &e}
+	foreigners := []*PushToken{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, confirmationTokenDBTypes, false, strmangle.SetComplement(confirmationTokenPrimaryKeyColumns, confirmationTokenColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, pushTokenDBTypes, false, strmangle.SetComplement(pushTokenPrimaryKeyColumns, pushTokenColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2257,13 +4616,13 @@ func testUserToManyAddOpConfirmationTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*ConfirmationToken{
+	foreignersSplitByInsertion := [][]*PushToken{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddConfirmationTokens(ctx, tx, i != 0, x...)
+		err = a.AddPushTokens(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2285,14 +4644,14 @@ func testUserToManyAddOpConfirmationTokens(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.ConfirmationTokens[i*2] != first {
+		if a.R.PushTokens[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.ConfirmationTokens[i*2+1] != second {
+		if a.R.PushTokens[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.ConfirmationTokens().Count(ctx, tx)
+		count, err := a.PushTokens().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2301,7 +4660,7 @@ func testUserToManyAddOpConfirmationTokens(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpOrganizationMembers(t *testing.T) {
+func testUserToManyAddOpRefreshTokens(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2309,15 +4668,15 @@ func testUserToManyAddOpOrganizationMembers(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e OrganizationMember
+	var b, c, d, e RefreshToken
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*OrganizationMember{&b, &c, &d, &e}
+	foreigners := []*RefreshToken{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, organizationMemberDBTypes, false, strmangle.SetComplement(organizationMemberPrimaryKeyColumns, organizationMemberColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, refreshTokenDBTypes, false, strmangle.SetComplement(refreshTokenPrimaryKeyColumns, refreshTokenColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2332,13 +4691,13 @@ func testUserToManyAddOpOrganizationMembers(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*OrganizationMember{
+	foreignersSplitByInsertion := [][]*RefreshToken{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddOrganizationMembers(ctx, tx, i != 0, x...)
+		err = a.AddRefreshTokens(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2360,14 +4719,14 @@ func testUserToManyAddOpOrganizationMembers(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.OrganizationMembers[i*2] != first {
+		if a.R.RefreshTokens[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.OrganizationMembers[i*2+1] != second {
+		if a.R.RefreshTokens[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.OrganizationMembers().Count(ctx, tx)
+		count, err := a.RefreshTokens().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2376,7 +4735,7 @@ func testUserToManyAddOpOrganizationMembers(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpOwnerOrganizations(t *testing.T) {
+func testUserToManyAddOpInitiatorSigningRequests(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2384,15 +4743,15 @@ func testUserToManyAddOpOwnerOrganizations(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e Organization
+	var b, c, d, e SigningRequest
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Organization{&b, &c, &d, &e}
+	foreigners := []*SigningRequest{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, organizationDBTypes, false, strmangle.SetComplement(organizationPrimaryKeyColumns, organizationColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, signingRequestDBTypes, false, strmangle.SetComplement(signingRequestPrimaryKeyColumns, signingRequestColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2407,13 +4766,13 @@ func testUserToManyAddOpOwnerOrganizations(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Organization{
+	foreignersSplitByInsertion := [][]*SigningRequest{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddOwnerOrganizations(ctx, tx, i != 0, x...)
+		err = a.AddInitiatorSigningRequests(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2421,37 +4780,213 @@ func testUserToManyAddOpOwnerOrganizations(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.OwnerID {
-			t.Error("foreign key was wrong value", a.ID, first.OwnerID)
+		if !queries.Equal(a.ID, first.InitiatorID) {
+			t.Error("foreign key was wrong value", a.ID, first.InitiatorID)
 		}
-		if a.ID != second.OwnerID {
-			t.Error("foreign key was wrong value", a.ID, second.OwnerID)
+		if !queries.Equal(a.ID, second.InitiatorID) {
+			t.Error("foreign key was wrong value", a.ID, second.InitiatorID)
 		}
 
-		if first.R.Owner != &a {
+		if first.R.Initiator != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.Owner != &a {
+		if second.R.Initiator != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.OwnerOrganizations[i*2] != first {
+		if a.R.InitiatorSigningRequests[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.OwnerOrganizations[i*2+1] != second {
+		if a.R.InitiatorSigningRequests[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.OwnerOrganizations().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+		count, err := a.InitiatorSigningRequests().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testUserToManySetOpInitiatorSigningRequests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e SigningRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SigningRequest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, signingRequestDBTypes, false, strmangle.SetComplement(signingRequestPrimaryKeyColumns, signingRequestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetInitiatorSigningRequests(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.InitiatorSigningRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetInitiatorSigningRequests(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.InitiatorSigningRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.InitiatorID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.InitiatorID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.InitiatorID) {
+		t.Error("foreign key was wrong value", a.ID, d.InitiatorID)
+	}
+	if !queries.Equal(a.ID, e.InitiatorID) {
+		t.Error("foreign key was wrong value", a.ID, e.InitiatorID)
+	}
+
+	if b.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Initiator != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Initiator != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.InitiatorSigningRequests[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.InitiatorSigningRequests[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpInitiatorSigningRequests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e SigningRequest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SigningRequest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, signingRequestDBTypes, false, strmangle.SetComplement(signingRequestPrimaryKeyColumns, signingRequestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddInitiatorSigningRequests(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.InitiatorSigningRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveInitiatorSigningRequests(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.InitiatorSigningRequests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.InitiatorID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.InitiatorID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Initiator != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Initiator != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Initiator != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.InitiatorSigningRequests) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.InitiatorSigningRequests[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.InitiatorSigningRequests[0] != &e {
+		t.Error("relationship to e should have been preserved")
 	}
 }
-func testUserToManyAddOpPasswordResetTokens(t *testing.T) {
+
+func testUserToManyAddOpUserCredentials(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2459,15 +4994,15 @@ func testUserToManyAddOpPasswordResetTokens(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e PasswordResetToken
+	var b, c, d, e UserCredential
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*PasswordResetToken{&b, &c, &d, &e}
+	foreigners := []*UserCredential{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, passwordResetTokenDBTypes, false, strmangle.SetComplement(passwordResetTokenPrimaryKeyColumns, passwordResetTokenColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2482,13 +5017,13 @@ func testUserToManyAddOpPasswordResetTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*PasswordResetToken{
+	foreignersSplitByInsertion := [][]*UserCredential{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddPasswordResetTokens(ctx, tx, i != 0, x...)
+		err = a.AddUserCredentials(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2510,14 +5045,14 @@ func testUserToManyAddOpPasswordResetTokens(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.PasswordResetTokens[i*2] != first {
+		if a.R.UserCredentials[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.PasswordResetTokens[i*2+1] != second {
+		if a.R.UserCredentials[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.PasswordResetTokens().Count(ctx, tx)
+		count, err := a.UserCredentials().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2526,7 +5061,7 @@ func testUserToManyAddOpPasswordResetTokens(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpPushTokens(t *testing.T) {
+func testUserToManyAddOpUserDevices(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2534,15 +5069,15 @@ func testUserToManyAddOpPushTokens(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e PushToken
+	var b, c, d, e UserDevice
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*PushToken{&b, &c, &d, &e}
+	foreigners := []*UserDevice{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, pushTokenDBTypes, false, strmangle.SetComplement(pushTokenPrimaryKeyColumns, pushTokenColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, userDeviceDBTypes, false, strmangle.SetComplement(userDevicePrimaryKeyColumns, userDeviceColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2557,13 +5092,13 @@ func testUserToManyAddOpPushTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*PushToken{
+	foreignersSplitByInsertion := [][]*UserDevice{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddPushTokens(ctx, tx, i != 0, x...)
+		err = a.AddUserDevices(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2585,14 +5120,14 @@ func testUserToManyAddOpPushTokens(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.PushTokens[i*2] != first {
+		if a.R.UserDevices[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.PushTokens[i*2+1] != second {
+		if a.R.UserDevices[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.PushTokens().Count(ctx, tx)
+		count, err := a.UserDevices().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2601,7 +5136,7 @@ func testUserToManyAddOpPushTokens(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpRefreshTokens(t *testing.T) {
+func testUserToManyAddOpVaultProposalApprovals(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2609,15 +5144,15 @@ func testUserToManyAddOpRefreshTokens(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e RefreshToken
+	var b, c, d, e VaultProposalApproval
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*RefreshToken{&b, &c, &d, &e}
+	foreigners := []*VaultProposalApproval{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, refreshTokenDBTypes, false, strmangle.SetComplement(refreshTokenPrimaryKeyColumns, refreshTokenColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, vaultProposalApprovalDBTypes, false, strmangle.SetComplement(vaultProposalApprovalPrimaryKeyColumns, vaultProposalApprovalColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2632,13 +5167,13 @@ func testUserToManyAddOpRefreshTokens(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*RefreshToken{
+	foreignersSplitByInsertion := [][]*VaultProposalApproval{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddRefreshTokens(ctx, tx, i != 0, x...)
+		err = a.AddVaultProposalApprovals(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2660,14 +5195,14 @@ func testUserToManyAddOpRefreshTokens(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.RefreshTokens[i*2] != first {
+		if a.R.VaultProposalApprovals[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.RefreshTokens[i*2+1] != second {
+		if a.R.VaultProposalApprovals[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.RefreshTokens().Count(ctx, tx)
+		count, err := a.VaultProposalApprovals().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2676,7 +5211,7 @@ func testUserToManyAddOpRefreshTokens(t *testing.T) {
 		}
 	}
 }
-func testUserToManyAddOpInitiatorSigningRequests(t *testing.T) {
+func testUserToManyAddOpInitiatorVaultProposals(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2684,15 +5219,15 @@ func testUserToManyAddOpInitiatorSigningRequests(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e SigningRequest
+	var b, c, d, e VaultProposal
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*SigningRequest{&b, &c, &d, &e}
+	foreigners := []*VaultProposal{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, signingRequestDBTypes, false, strmangle.SetComplement(signingRequestPrimaryKeyColumns, signingRequestColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2707,13 +5242,13 @@ func testUserToManyAddOpInitiatorSigningRequests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*SigningRequest{
+	foreignersSplitByInsertion := [][]*VaultProposal{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddInitiatorSigningRequests(ctx, tx, i != 0, x...)
+		err = a.AddInitiatorVaultProposals(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2735,14 +5270,14 @@ func testUserToManyAddOpInitiatorSigningRequests(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.InitiatorSigningRequests[i*2] != first {
+		if a.R.InitiatorVaultProposals[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.InitiatorSigningRequests[i*2+1] != second {
+		if a.R.InitiatorVaultProposals[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.InitiatorSigningRequests().Count(ctx, tx)
+		count, err := a.InitiatorVaultProposals().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2752,7 +5287,7 @@ func testUserToManyAddOpInitiatorSigningRequests(t *testing.T) {
 	}
 }
 
-func testUserToManySetOpInitiatorSigningRequests(t *testing.T) {
+func testUserToManySetOpInitiatorVaultProposals(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2760,15 +5295,15 @@ func testUserToManySetOpInitiatorSigningRequests(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e SigningRequest
+	var b, c, d, e VaultProposal
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*SigningRequest{&b, &c, &d, &e}
+	foreigners := []*VaultProposal{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, signingRequestDBTypes, false, strmangle.SetComplement(signingRequestPrimaryKeyColumns, signingRequestColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2783,12 +5318,12 @@ func testUserToManySetOpInitiatorSigningRequests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetInitiatorSigningRequests(ctx, tx, false, &b, &c)
+	err = a.SetInitiatorVaultProposals(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.InitiatorSigningRequests().Count(ctx, tx)
+	count, err := a.InitiatorVaultProposals().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2796,12 +5331,12 @@ func testUserToManySetOpInitiatorSigningRequests(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetInitiatorSigningRequests(ctx, tx, true, &d, &e)
+	err = a.SetInitiatorVaultProposals(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.InitiatorSigningRequests().Count(ctx, tx)
+	count, err = a.InitiatorVaultProposals().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2835,15 +5370,15 @@ func testUserToManySetOpInitiatorSigningRequests(t *testing.T) {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.InitiatorSigningRequests[0] != &d {
+	if a.R.InitiatorVaultProposals[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.InitiatorSigningRequests[1] != &e {
+	if a.R.InitiatorVaultProposals[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testUserToManyRemoveOpInitiatorSigningRequests(t *testing.T) {
+func testUserToManyRemoveOpInitiatorVaultProposals(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2851,15 +5386,15 @@ func testUserToManyRemoveOpInitiatorSigningRequests(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e SigningRequest
+	var b, c, d, e VaultProposal
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*SigningRequest{&b, &c, &d, &e}
+	foreigners := []*VaultProposal{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, signingRequestDBTypes, false, strmangle.SetComplement(signingRequestPrimaryKeyColumns, signingRequestColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2868,12 +5403,12 @@ func testUserToManyRemoveOpInitiatorSigningRequests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddInitiatorSigningRequests(ctx, tx, true, foreigners...)
+	err = a.AddInitiatorVaultProposals(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.InitiatorSigningRequests().Count(ctx, tx)
+	count, err := a.InitiatorVaultProposals().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2881,12 +5416,12 @@ func testUserToManyRemoveOpInitiatorSigningRequests(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveInitiatorSigningRequests(ctx, tx, foreigners[:2]...)
+	err = a.RemoveInitiatorVaultProposals(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.InitiatorSigningRequests().Count(ctx, tx)
+	count, err = a.InitiatorVaultProposals().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2914,20 +5449,20 @@ func testUserToManyRemoveOpInitiatorSigningRequests(t *testing.T) {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.InitiatorSigningRequests) != 2 {
+	if len(a.R.InitiatorVaultProposals) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.InitiatorSigningRequests[1] != &d {
+	if a.R.InitiatorVaultProposals[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.InitiatorSigningRequests[0] != &e {
+	if a.R.InitiatorVaultProposals[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testUserToManyAddOpUserCredentials(t *testing.T) {
+func testUserToManyAddOpCreatedByWebhookEndpoints(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -2935,15 +5470,15 @@ func testUserToManyAddOpUserCredentials(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a User
-	var b, c, d, e UserCredential
+	var b, c, d, e WebhookEndpoint
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*UserCredential{&b, &c, &d, &e}
+	foreigners := []*WebhookEndpoint{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, userCredentialDBTypes, false, strmangle.SetComplement(userCredentialPrimaryKeyColumns, userCredentialColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -2958,13 +5493,13 @@ func testUserToManyAddOpUserCredentials(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*UserCredential{
+	foreignersSplitByInsertion := [][]*WebhookEndpoint{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddUserCredentials(ctx, tx, i != 0, x...)
+		err = a.AddCreatedByWebhookEndpoints(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -2972,28 +5507,28 @@ func testUserToManyAddOpUserCredentials(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.UserID {
-			t.Error("foreign key was wrong value", a.ID, first.UserID)
+		if !queries.Equal(a.ID, first.CreatedBy) {
+			t.Error("foreign key was wrong value", a.ID, first.CreatedBy)
 		}
-		if a.ID != second.UserID {
-			t.Error("foreign key was wrong value", a.ID, second.UserID)
+		if !queries.Equal(a.ID, second.CreatedBy) {
+			t.Error("foreign key was wrong value", a.ID, second.CreatedBy)
 		}
 
-		if first.R.User != &a {
+		if first.R.CreatedByUser != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.User != &a {
+		if second.R.CreatedByUser != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.UserCredentials[i*2] != first {
+		if a.R.CreatedByWebhookEndpoints[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.UserCredentials[i*2+1] != second {
+		if a.R.CreatedByWebhookEndpoints[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.UserCredentials().Count(ctx, tx)
+		count, err := a.CreatedByWebhookEndpoints().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -3003,6 +5538,181 @@ func testUserToManyAddOpUserCredentials(t *testing.T) {
 	}
 }
 
+func testUserToManySetOpCreatedByWebhookEndpoints(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e WebhookEndpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WebhookEndpoint{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCreatedByWebhookEndpoints(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CreatedByWebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCreatedByWebhookEndpoints(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CreatedByWebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CreatedBy) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CreatedBy) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CreatedBy) {
+		t.Error("foreign key was wrong value", a.ID, d.CreatedBy)
+	}
+	if !queries.Equal(a.ID, e.CreatedBy) {
+		t.Error("foreign key was wrong value", a.ID, e.CreatedBy)
+	}
+
+	if b.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CreatedByUser != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.CreatedByUser != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CreatedByWebhookEndpoints[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CreatedByWebhookEndpoints[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testUserToManyRemoveOpCreatedByWebhookEndpoints(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a User
+	var b, c, d, e WebhookEndpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WebhookEndpoint{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCreatedByWebhookEndpoints(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CreatedByWebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCreatedByWebhookEndpoints(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CreatedByWebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CreatedBy) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CreatedBy) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CreatedByUser != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CreatedByUser != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.CreatedByUser != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CreatedByWebhookEndpoints) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CreatedByWebhookEndpoints[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CreatedByWebhookEndpoints[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testUsersReload(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +32,11 @@ type VaultKey struct {
 	PublicKeyHex string      `boil:"public_key_hex" json:"public_key_hex" toml:"public_key_hex" yaml:"public_key_hex"`
 	CreatedAt    null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt    null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Protocol     null.String `boil:"protocol" json:"protocol,omitempty" toml:"protocol" yaml:"protocol,omitempty"`
+	Threshold    null.Int    `boil:"threshold" json:"threshold,omitempty" toml:"threshold" yaml:"threshold,omitempty"`
+	TotalNodes   null.Int    `boil:"total_nodes" json:"total_nodes,omitempty" toml:"total_nodes" yaml:"total_nodes,omitempty"`
+	RefreshEpoch int         `boil:"refresh_epoch" json:"refresh_epoch" toml:"refresh_epoch" yaml:"refresh_epoch"`
+	RefreshedAt  null.Time   `boil:"refreshed_at" json:"refreshed_at,omitempty" toml:"refreshed_at" yaml:"refreshed_at,omitempty"`
 
 	R *vaultKeyR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L vaultKeyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +51,11 @@ var VaultKeyColumns = struct {
 	PublicKeyHex string
 	CreatedAt    string
 	UpdatedAt    string
+	Protocol     string
+	Threshold    string
+	TotalNodes   string
+	RefreshEpoch string
+	RefreshedAt  string
 }{
 	ID:           "id",
 	VaultID:      "vault_id",
@@ -55,6 +65,11 @@ var VaultKeyColumns = struct {
 	PublicKeyHex: "public_key_hex",
 	CreatedAt:    "created_at",
 	UpdatedAt:    "updated_at",
+	Protocol:     "protocol",
+	Threshold:    "threshold",
+	TotalNodes:   "total_nodes",
+	RefreshEpoch: "refresh_epoch",
+	RefreshedAt:  "refreshed_at",
 }
 
 var VaultKeyTableColumns = struct {
@@ -66,6 +81,11 @@ var VaultKeyTableColumns = struct {
 	PublicKeyHex string
 	CreatedAt    string
 	UpdatedAt    string
+	Protocol     string
+	Threshold    string
+	TotalNodes   string
+	RefreshEpoch string
+	RefreshedAt  string
 }{
 	ID:           "vault_keys.id",
 	VaultID:      "vault_keys.vault_id",
@@ -75,6 +95,11 @@ var VaultKeyTableColumns = struct {
 	PublicKeyHex: "vault_keys.public_key_hex",
 	CreatedAt:    "vault_keys.created_at",
 	UpdatedAt:    "vault_keys.updated_at",
+	Protocol:     "vault_keys.protocol",
+	Threshold:    "vault_keys.threshold",
+	TotalNodes:   "vault_keys.total_nodes",
+	RefreshEpoch: "vault_keys.refresh_epoch",
+	RefreshedAt:  "vault_keys.refreshed_at",
 }
 
 // Generated where
@@ -88,6 +113,11 @@ var VaultKeyWhere = struct {
 	PublicKeyHex whereHelperstring
 	CreatedAt    whereHelpernull_Time
 	UpdatedAt    whereHelpernull_Time
+	Protocol     whereHelpernull_String
+	Threshold    whereHelpernull_Int
+	TotalNodes   whereHelpernull_Int
+	RefreshEpoch whereHelperint
+	RefreshedAt  whereHelpernull_Time
 }{
 	ID:           whereHelperstring{field: "\"vault_keys\".\"id\""},
 	VaultID:      whereHelpernull_String{field: "\"vault_keys\".\"vault_id\""},
@@ -97,6 +127,11 @@ var VaultKeyWhere = struct {
 	PublicKeyHex: whereHelperstring{field: "\"vault_keys\".\"public_key_hex\""},
 	CreatedAt:    whereHelpernull_Time{field: "\"vault_keys\".\"created_at\""},
 	UpdatedAt:    whereHelpernull_Time{field: "\"vault_keys\".\"updated_at\""},
+	Protocol:     whereHelpernull_String{field: "\"vault_keys\".\"protocol\""},
+	Threshold:    whereHelpernull_Int{field: "\"vault_keys\".\"threshold\""},
+	TotalNodes:   whereHelpernull_Int{field: "\"vault_keys\".\"total_nodes\""},
+	RefreshEpoch: whereHelperint{field: "\"vault_keys\".\"refresh_epoch\""},
+	RefreshedAt:  whereHelpernull_Time{field: "\"vault_keys\".\"refreshed_at\""},
 }
 
 // VaultKeyRels is where relationship names are stored.
@@ -136,9 +171,9 @@ func (r *vaultKeyR) GetVault() *Vault {
 type vaultKeyL struct{}
 
 var (
-	vaultKeyAllColumns            = []string{"id", "vault_id", "key_id", "algorithm", "curve", "public_key_hex", "created_at", "updated_at"}
+	vaultKeyAllColumns            = []string{"id", "vault_id", "key_id", "algorithm", "curve", "public_key_hex", "created_at", "updated_at", "protocol", "threshold", "total_nodes", "refresh_epoch", "refreshed_at"}
 	vaultKeyColumnsWithoutDefault = []string{"key_id", "algorithm", "curve", "public_key_hex"}
-	vaultKeyColumnsWithDefault    = []string{"id", "vault_id", "created_at", "updated_at"}
+	vaultKeyColumnsWithDefault    = []string{"id", "vault_id", "created_at", "updated_at", "protocol", "threshold", "total_nodes", "refresh_epoch", "refreshed_at"}
 	vaultKeyPrimaryKeyColumns     = []string{"id"}
 	vaultKeyGeneratedColumns      = []string{}
 )
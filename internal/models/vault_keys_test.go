@@ -588,7 +588,7 @@ func testVaultKeysSelect(t *testing.T) {
 }
 
 var (
-	vaultKeyDBTypes = map[string]string{`ID`: `uuid`, `VaultID`: `uuid`, `KeyID`: `character varying`, `Algorithm`: `character varying`, `Curve`: `character varying`, `PublicKeyHex`: `text`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	vaultKeyDBTypes = map[string]string{`ID`: `uuid`, `VaultID`: `uuid`, `KeyID`: `character varying`, `Algorithm`: `character varying`, `Curve`: `character varying`, `PublicKeyHex`: `text`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Protocol`: `character varying`, `Threshold`: `integer`, `TotalNodes`: `integer`, `RefreshEpoch`: `integer`, `RefreshedAt`: `timestamp with time zone`}
 	_               = bytes.MinRead
 )
 
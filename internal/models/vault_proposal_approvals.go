@@ -0,0 +1,1114 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// VaultProposalApproval is an object representing the database table.
+type VaultProposalApproval struct {
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	VaultProposalID string      `boil:"vault_proposal_id" json:"vault_proposal_id" toml:"vault_proposal_id" yaml:"vault_proposal_id"`
+	UserID          string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Action          string      `boil:"action" json:"action" toml:"action" yaml:"action"`
+	CredentialID    null.String `boil:"credential_id" json:"credential_id,omitempty" toml:"credential_id" yaml:"credential_id,omitempty"`
+	CreatedAt       null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt       null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *vaultProposalApprovalR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L vaultProposalApprovalL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VaultProposalApprovalColumns = struct {
+	ID              string
+	VaultProposalID string
+	UserID          string
+	Action          string
+	CredentialID    string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	VaultProposalID: "vault_proposal_id",
+	UserID:          "user_id",
+	Action:          "action",
+	CredentialID:    "credential_id",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var VaultProposalApprovalTableColumns = struct {
+	ID              string
+	VaultProposalID string
+	UserID          string
+	Action          string
+	CredentialID    string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "vault_proposal_approvals.id",
+	VaultProposalID: "vault_proposal_approvals.vault_proposal_id",
+	UserID:          "vault_proposal_approvals.user_id",
+	Action:          "vault_proposal_approvals.action",
+	CredentialID:    "vault_proposal_approvals.credential_id",
+	CreatedAt:       "vault_proposal_approvals.created_at",
+	UpdatedAt:       "vault_proposal_approvals.updated_at",
+}
+
+// Generated where
+
+var VaultProposalApprovalWhere = struct {
+	ID              whereHelperstring
+	VaultProposalID whereHelperstring
+	UserID          whereHelperstring
+	Action          whereHelperstring
+	CredentialID    whereHelpernull_String
+	CreatedAt       whereHelpernull_Time
+	UpdatedAt       whereHelpernull_Time
+}{
+	ID:              whereHelperstring{field: "\"vault_proposal_approvals\".\"id\""},
+	VaultProposalID: whereHelperstring{field: "\"vault_proposal_approvals\".\"vault_proposal_id\""},
+	UserID:          whereHelperstring{field: "\"vault_proposal_approvals\".\"user_id\""},
+	Action:          whereHelperstring{field: "\"vault_proposal_approvals\".\"action\""},
+	CredentialID:    whereHelpernull_String{field: "\"vault_proposal_approvals\".\"credential_id\""},
+	CreatedAt:       whereHelpernull_Time{field: "\"vault_proposal_approvals\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"vault_proposal_approvals\".\"updated_at\""},
+}
+
+// VaultProposalApprovalRels is where relationship names are stored.
+var VaultProposalApprovalRels = struct {
+	User          string
+	VaultProposal string
+}{
+	User:          "User",
+	VaultProposal: "VaultProposal",
+}
+
+// vaultProposalApprovalR is where relationships are stored.
+type vaultProposalApprovalR struct {
+	User          *User          `boil:"User" json:"User" toml:"User" yaml:"User"`
+	VaultProposal *VaultProposal `boil:"VaultProposal" json:"VaultProposal" toml:"VaultProposal" yaml:"VaultProposal"`
+}
+
+// NewStruct creates a new relationship struct
+func (*vaultProposalApprovalR) NewStruct() *vaultProposalApprovalR {
+	return &vaultProposalApprovalR{}
+}
+
+func (o *VaultProposalApproval) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *vaultProposalApprovalR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+func (o *VaultProposalApproval) GetVaultProposal() *VaultProposal {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVaultProposal()
+}
+
+func (r *vaultProposalApprovalR) GetVaultProposal() *VaultProposal {
+	if r == nil {
+		return nil
+	}
+
+	return r.VaultProposal
+}
+
+// vaultProposalApprovalL is where Load methods for each relationship are stored.
+type vaultProposalApprovalL struct{}
+
+var (
+	vaultProposalApprovalAllColumns            = []string{"id", "vault_proposal_id", "user_id", "action", "credential_id", "created_at", "updated_at"}
+	vaultProposalApprovalColumnsWithoutDefault = []string{"vault_proposal_id", "user_id", "action"}
+	vaultProposalApprovalColumnsWithDefault    = []string{"id", "credential_id", "created_at", "updated_at"}
+	vaultProposalApprovalPrimaryKeyColumns     = []string{"id"}
+	vaultProposalApprovalGeneratedColumns      = []string{}
+)
+
+type (
+	// VaultProposalApprovalSlice is an alias for a slice of pointers to VaultProposalApproval.
+	// This should almost always be used instead of []VaultProposalApproval.
+	VaultProposalApprovalSlice []*VaultProposalApproval
+
+	vaultProposalApprovalQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	vaultProposalApprovalType                 = reflect.TypeOf(&VaultProposalApproval{})
+	vaultProposalApprovalMapping              = queries.MakeStructMapping(vaultProposalApprovalType)
+	vaultProposalApprovalPrimaryKeyMapping, _ = queries.BindMapping(vaultProposalApprovalType, vaultProposalApprovalMapping, vaultProposalApprovalPrimaryKeyColumns)
+	vaultProposalApprovalInsertCacheMut       sync.RWMutex
+	vaultProposalApprovalInsertCache          = make(map[string]insertCache)
+	vaultProposalApprovalUpdateCacheMut       sync.RWMutex
+	vaultProposalApprovalUpdateCache          = make(map[string]updateCache)
+	vaultProposalApprovalUpsertCacheMut       sync.RWMutex
+	vaultProposalApprovalUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single vaultProposalApproval record from the query.
+func (q vaultProposalApprovalQuery) One(ctx context.Context, exec boil.ContextExecutor) (*VaultProposalApproval, error) {
+	o := &VaultProposalApproval{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for vault_proposal_approvals")
+	}
+
+	return o, nil
+}
+
+// All returns all VaultProposalApproval records from the query.
+func (q vaultProposalApprovalQuery) All(ctx context.Context, exec boil.ContextExecutor) (VaultProposalApprovalSlice, error) {
+	var o []*VaultProposalApproval
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to VaultProposalApproval slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VaultProposalApproval records in the query.
+func (q vaultProposalApprovalQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count vault_proposal_approvals rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q vaultProposalApprovalQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if vault_proposal_approvals exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *VaultProposalApproval) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// VaultProposal pointed to by the foreign key.
+func (o *VaultProposalApproval) VaultProposal(mods ...qm.QueryMod) vaultProposalQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VaultProposalID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return VaultProposals(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (vaultProposalApprovalL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposalApproval interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposalApproval
+	var object *VaultProposalApproval
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposalApproval.(*VaultProposalApproval)
+		if !ok {
+			object = new(VaultProposalApproval)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposalApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposalApproval))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposalApproval.(*[]*VaultProposalApproval)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposalApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposalApproval))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalApprovalR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalApprovalR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.VaultProposalApprovals = append(foreign.R.VaultProposalApprovals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.VaultProposalApprovals = append(foreign.R.VaultProposalApprovals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVaultProposal allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (vaultProposalApprovalL) LoadVaultProposal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposalApproval interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposalApproval
+	var object *VaultProposalApproval
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposalApproval.(*VaultProposalApproval)
+		if !ok {
+			object = new(VaultProposalApproval)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposalApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposalApproval))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposalApproval.(*[]*VaultProposalApproval)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposalApproval)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposalApproval))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalApprovalR{}
+		}
+		args[object.VaultProposalID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalApprovalR{}
+			}
+
+			args[obj.VaultProposalID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vault_proposals`),
+		qm.WhereIn(`vault_proposals.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load VaultProposal")
+	}
+
+	var resultSlice []*VaultProposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice VaultProposal")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vault_proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposals")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.VaultProposal = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultProposalR{}
+		}
+		foreign.R.VaultProposalApprovals = append(foreign.R.VaultProposalApprovals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VaultProposalID == foreign.ID {
+				local.R.VaultProposal = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultProposalR{}
+				}
+				foreign.R.VaultProposalApprovals = append(foreign.R.VaultProposalApprovals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the vaultProposalApproval to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.VaultProposalApprovals.
+func (o *VaultProposalApproval) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"vault_proposal_approvals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, vaultProposalApprovalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &vaultProposalApprovalR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			VaultProposalApprovals: VaultProposalApprovalSlice{o},
+		}
+	} else {
+		related.R.VaultProposalApprovals = append(related.R.VaultProposalApprovals, o)
+	}
+
+	return nil
+}
+
+// SetVaultProposal of the vaultProposalApproval to the related item.
+// Sets o.R.VaultProposal to related.
+// Adds o to related.R.VaultProposalApprovals.
+func (o *VaultProposalApproval) SetVaultProposal(ctx context.Context, exec boil.ContextExecutor, insert bool, related *VaultProposal) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"vault_proposal_approvals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"vault_proposal_id"}),
+		strmangle.WhereClause("\"", "\"", 2, vaultProposalApprovalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VaultProposalID = related.ID
+	if o.R == nil {
+		o.R = &vaultProposalApprovalR{
+			VaultProposal: related,
+		}
+	} else {
+		o.R.VaultProposal = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultProposalR{
+			VaultProposalApprovals: VaultProposalApprovalSlice{o},
+		}
+	} else {
+		related.R.VaultProposalApprovals = append(related.R.VaultProposalApprovals, o)
+	}
+
+	return nil
+}
+
+// VaultProposalApprovals retrieves all the records using an executor.
+func VaultProposalApprovals(mods ...qm.QueryMod) vaultProposalApprovalQuery {
+	mods = append(mods, qm.From("\"vault_proposal_approvals\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"vault_proposal_approvals\".*"})
+	}
+
+	return vaultProposalApprovalQuery{q}
+}
+
+// FindVaultProposalApproval retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVaultProposalApproval(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*VaultProposalApproval, error) {
+	vaultProposalApprovalObj := &VaultProposalApproval{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"vault_proposal_approvals\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, vaultProposalApprovalObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from vault_proposal_approvals")
+	}
+
+	return vaultProposalApprovalObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VaultProposalApproval) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no vault_proposal_approvals provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vaultProposalApprovalColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	vaultProposalApprovalInsertCacheMut.RLock()
+	cache, cached := vaultProposalApprovalInsertCache[key]
+	vaultProposalApprovalInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			vaultProposalApprovalAllColumns,
+			vaultProposalApprovalColumnsWithDefault,
+			vaultProposalApprovalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(vaultProposalApprovalType, vaultProposalApprovalMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(vaultProposalApprovalType, vaultProposalApprovalMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"vault_proposal_approvals\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"vault_proposal_approvals\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into vault_proposal_approvals")
+	}
+
+	if !cached {
+		vaultProposalApprovalInsertCacheMut.Lock()
+		vaultProposalApprovalInsertCache[key] = cache
+		vaultProposalApprovalInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the VaultProposalApproval.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VaultProposalApproval) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	vaultProposalApprovalUpdateCacheMut.RLock()
+	cache, cached := vaultProposalApprovalUpdateCache[key]
+	vaultProposalApprovalUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			vaultProposalApprovalAllColumns,
+			vaultProposalApprovalPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update vault_proposal_approvals, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"vault_proposal_approvals\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, vaultProposalApprovalPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(vaultProposalApprovalType, vaultProposalApprovalMapping, append(wl, vaultProposalApprovalPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update vault_proposal_approvals row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for vault_proposal_approvals")
+	}
+
+	if !cached {
+		vaultProposalApprovalUpdateCacheMut.Lock()
+		vaultProposalApprovalUpdateCache[key] = cache
+		vaultProposalApprovalUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q vaultProposalApprovalQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for vault_proposal_approvals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for vault_proposal_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VaultProposalApprovalSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vaultProposalApprovalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"vault_proposal_approvals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, vaultProposalApprovalPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in vaultProposalApproval slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all vaultProposalApproval")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VaultProposalApproval) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no vault_proposal_approvals provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vaultProposalApprovalColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	vaultProposalApprovalUpsertCacheMut.RLock()
+	cache, cached := vaultProposalApprovalUpsertCache[key]
+	vaultProposalApprovalUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			vaultProposalApprovalAllColumns,
+			vaultProposalApprovalColumnsWithDefault,
+			vaultProposalApprovalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			vaultProposalApprovalAllColumns,
+			vaultProposalApprovalPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert vault_proposal_approvals, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(vaultProposalApprovalAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(vaultProposalApprovalPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert vault_proposal_approvals, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(vaultProposalApprovalPrimaryKeyColumns))
+			copy(conflict, vaultProposalApprovalPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"vault_proposal_approvals\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(vaultProposalApprovalType, vaultProposalApprovalMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(vaultProposalApprovalType, vaultProposalApprovalMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert vault_proposal_approvals")
+	}
+
+	if !cached {
+		vaultProposalApprovalUpsertCacheMut.Lock()
+		vaultProposalApprovalUpsertCache[key] = cache
+		vaultProposalApprovalUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single VaultProposalApproval record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VaultProposalApproval) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no VaultProposalApproval provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), vaultProposalApprovalPrimaryKeyMapping)
+	sql := "DELETE FROM \"vault_proposal_approvals\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from vault_proposal_approvals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for vault_proposal_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q vaultProposalApprovalQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no vaultProposalApprovalQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from vault_proposal_approvals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for vault_proposal_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VaultProposalApprovalSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vaultProposalApprovalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"vault_proposal_approvals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vaultProposalApprovalPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from vaultProposalApproval slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for vault_proposal_approvals")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VaultProposalApproval) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindVaultProposalApproval(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VaultProposalApprovalSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VaultProposalApprovalSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vaultProposalApprovalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"vault_proposal_approvals\".* FROM \"vault_proposal_approvals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vaultProposalApprovalPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in VaultProposalApprovalSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VaultProposalApprovalExists checks if the VaultProposalApproval row exists.
+func VaultProposalApprovalExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"vault_proposal_approvals\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if vault_proposal_approvals exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the VaultProposalApproval row exists.
+func (o *VaultProposalApproval) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return VaultProposalApprovalExists(ctx, exec, o.ID)
+}
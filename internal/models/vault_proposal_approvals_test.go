@@ -0,0 +1,810 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testVaultProposalApprovals(t *testing.T) {
+	t.Parallel()
+
+	query := VaultProposalApprovals()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testVaultProposalApprovalsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVaultProposalApprovalsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := VaultProposalApprovals().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVaultProposalApprovalsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VaultProposalApprovalSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVaultProposalApprovalsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := VaultProposalApprovalExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if VaultProposalApproval exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected VaultProposalApprovalExists to return true, but got false.")
+	}
+}
+
+func testVaultProposalApprovalsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	vaultProposalApprovalFound, err := FindVaultProposalApproval(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if vaultProposalApprovalFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testVaultProposalApprovalsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = VaultProposalApprovals().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVaultProposalApprovalsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := VaultProposalApprovals().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testVaultProposalApprovalsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	vaultProposalApprovalOne := &VaultProposalApproval{}
+	vaultProposalApprovalTwo := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, vaultProposalApprovalOne, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+	if err = randomize.Struct(seed, vaultProposalApprovalTwo, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = vaultProposalApprovalOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = vaultProposalApprovalTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VaultProposalApprovals().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testVaultProposalApprovalsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	vaultProposalApprovalOne := &VaultProposalApproval{}
+	vaultProposalApprovalTwo := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, vaultProposalApprovalOne, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+	if err = randomize.Struct(seed, vaultProposalApprovalTwo, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = vaultProposalApprovalOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = vaultProposalApprovalTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testVaultProposalApprovalsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVaultProposalApprovalsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(vaultProposalApprovalPrimaryKeyColumns, vaultProposalApprovalColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVaultProposalApprovalToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VaultProposalApproval
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := VaultProposalApprovalSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*VaultProposalApproval)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testVaultProposalApprovalToOneVaultProposalUsingVaultProposal(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VaultProposalApproval
+	var foreign VaultProposal
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VaultProposalID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.VaultProposal().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := VaultProposalApprovalSlice{&local}
+	if err = local.L.LoadVaultProposal(ctx, tx, false, (*[]*VaultProposalApproval)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.VaultProposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.VaultProposal = nil
+	if err = local.L.LoadVaultProposal(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.VaultProposal == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testVaultProposalApprovalToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposalApproval
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalApprovalDBTypes, false, strmangle.SetComplement(vaultProposalApprovalPrimaryKeyColumns, vaultProposalApprovalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.VaultProposalApprovals[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+func testVaultProposalApprovalToOneSetOpVaultProposalUsingVaultProposal(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposalApproval
+	var b, c VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalApprovalDBTypes, false, strmangle.SetComplement(vaultProposalApprovalPrimaryKeyColumns, vaultProposalApprovalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*VaultProposal{&b, &c} {
+		err = a.SetVaultProposal(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.VaultProposal != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.VaultProposalApprovals[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VaultProposalID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultProposalID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VaultProposalID))
+		reflect.Indirect(reflect.ValueOf(&a.VaultProposalID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VaultProposalID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultProposalID, x.ID)
+		}
+	}
+}
+
+func testVaultProposalApprovalsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVaultProposalApprovalsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VaultProposalApprovalSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVaultProposalApprovalsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VaultProposalApprovals().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	vaultProposalApprovalDBTypes = map[string]string{`ID`: `uuid`, `VaultProposalID`: `uuid`, `UserID`: `uuid`, `Action`: `character varying`, `CredentialID`: `text`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                            = bytes.MinRead
+)
+
+func testVaultProposalApprovalsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(vaultProposalApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(vaultProposalApprovalAllColumns) == len(vaultProposalApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testVaultProposalApprovalsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(vaultProposalApprovalAllColumns) == len(vaultProposalApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposalApproval{}
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, vaultProposalApprovalDBTypes, true, vaultProposalApprovalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(vaultProposalApprovalAllColumns, vaultProposalApprovalPrimaryKeyColumns) {
+		fields = vaultProposalApprovalAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			vaultProposalApprovalAllColumns,
+			vaultProposalApprovalPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := VaultProposalApprovalSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testVaultProposalApprovalsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(vaultProposalApprovalAllColumns) == len(vaultProposalApprovalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := VaultProposalApproval{}
+	if err = randomize.Struct(seed, &o, vaultProposalApprovalDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VaultProposalApproval: %s", err)
+	}
+
+	count, err := VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, vaultProposalApprovalDBTypes, false, vaultProposalApprovalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VaultProposalApproval struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VaultProposalApproval: %s", err)
+	}
+
+	count, err = VaultProposalApprovals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,2163 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// VaultProposal is an object representing the database table.
+type VaultProposal struct {
+	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	VaultID           string      `boil:"vault_id" json:"vault_id" toml:"vault_id" yaml:"vault_id"`
+	InitiatorID       null.String `boil:"initiator_id" json:"initiator_id,omitempty" toml:"initiator_id" yaml:"initiator_id,omitempty"`
+	Kind              string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Payload           types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	RequiredApprovals int         `boil:"required_approvals" json:"required_approvals" toml:"required_approvals" yaml:"required_approvals"`
+	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt         null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt         null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	StatusReason      null.String `boil:"status_reason" json:"status_reason,omitempty" toml:"status_reason" yaml:"status_reason,omitempty"`
+
+	R *vaultProposalR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L vaultProposalL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VaultProposalColumns = struct {
+	ID                string
+	VaultID           string
+	InitiatorID       string
+	Kind              string
+	Payload           string
+	RequiredApprovals string
+	Status            string
+	CreatedAt         string
+	UpdatedAt         string
+	StatusReason      string
+}{
+	ID:                "id",
+	VaultID:           "vault_id",
+	InitiatorID:       "initiator_id",
+	Kind:              "kind",
+	Payload:           "payload",
+	RequiredApprovals: "required_approvals",
+	Status:            "status",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	StatusReason:      "status_reason",
+}
+
+var VaultProposalTableColumns = struct {
+	ID                string
+	VaultID           string
+	InitiatorID       string
+	Kind              string
+	Payload           string
+	RequiredApprovals string
+	Status            string
+	CreatedAt         string
+	UpdatedAt         string
+	StatusReason      string
+}{
+	ID:                "vault_proposals.id",
+	VaultID:           "vault_proposals.vault_id",
+	InitiatorID:       "vault_proposals.initiator_id",
+	Kind:              "vault_proposals.kind",
+	Payload:           "vault_proposals.payload",
+	RequiredApprovals: "vault_proposals.required_approvals",
+	Status:            "vault_proposals.status",
+	CreatedAt:         "vault_proposals.created_at",
+	UpdatedAt:         "vault_proposals.updated_at",
+	StatusReason:      "vault_proposals.status_reason",
+}
+
+// Generated where
+
+var VaultProposalWhere = struct {
+	ID                whereHelperstring
+	VaultID           whereHelperstring
+	InitiatorID       whereHelpernull_String
+	Kind              whereHelperstring
+	Payload           whereHelpertypes_JSON
+	RequiredApprovals whereHelperint
+	Status            whereHelperstring
+	CreatedAt         whereHelpernull_Time
+	UpdatedAt         whereHelpernull_Time
+	StatusReason      whereHelpernull_String
+}{
+	ID:                whereHelperstring{field: "\"vault_proposals\".\"id\""},
+	VaultID:           whereHelperstring{field: "\"vault_proposals\".\"vault_id\""},
+	InitiatorID:       whereHelpernull_String{field: "\"vault_proposals\".\"initiator_id\""},
+	Kind:              whereHelperstring{field: "\"vault_proposals\".\"kind\""},
+	Payload:           whereHelpertypes_JSON{field: "\"vault_proposals\".\"payload\""},
+	RequiredApprovals: whereHelperint{field: "\"vault_proposals\".\"required_approvals\""},
+	Status:            whereHelperstring{field: "\"vault_proposals\".\"status\""},
+	CreatedAt:         whereHelpernull_Time{field: "\"vault_proposals\".\"created_at\""},
+	UpdatedAt:         whereHelpernull_Time{field: "\"vault_proposals\".\"updated_at\""},
+	StatusReason:      whereHelpernull_String{field: "\"vault_proposals\".\"status_reason\""},
+}
+
+// VaultProposalRels is where relationship names are stored.
+var VaultProposalRels = struct {
+	Initiator                  string
+	Vault                      string
+	ProposalKeyRefreshes       string
+	CompletionProposalRootKeys string
+	RetirementProposalRootKeys string
+	VaultProposalApprovals     string
+}{
+	Initiator:                  "Initiator",
+	Vault:                      "Vault",
+	ProposalKeyRefreshes:       "ProposalKeyRefreshes",
+	CompletionProposalRootKeys: "CompletionProposalRootKeys",
+	RetirementProposalRootKeys: "RetirementProposalRootKeys",
+	VaultProposalApprovals:     "VaultProposalApprovals",
+}
+
+// vaultProposalR is where relationships are stored.
+type vaultProposalR struct {
+	Initiator                  *User                      `boil:"Initiator" json:"Initiator" toml:"Initiator" yaml:"Initiator"`
+	Vault                      *Vault                     `boil:"Vault" json:"Vault" toml:"Vault" yaml:"Vault"`
+	ProposalKeyRefreshes       KeyRefreshSlice            `boil:"ProposalKeyRefreshes" json:"ProposalKeyRefreshes" toml:"ProposalKeyRefreshes" yaml:"ProposalKeyRefreshes"`
+	CompletionProposalRootKeys RootKeySlice               `boil:"CompletionProposalRootKeys" json:"CompletionProposalRootKeys" toml:"CompletionProposalRootKeys" yaml:"CompletionProposalRootKeys"`
+	RetirementProposalRootKeys RootKeySlice               `boil:"RetirementProposalRootKeys" json:"RetirementProposalRootKeys" toml:"RetirementProposalRootKeys" yaml:"RetirementProposalRootKeys"`
+	VaultProposalApprovals     VaultProposalApprovalSlice `boil:"VaultProposalApprovals" json:"VaultProposalApprovals" toml:"VaultProposalApprovals" yaml:"VaultProposalApprovals"`
+}
+
+// NewStruct creates a new relationship struct
+func (*vaultProposalR) NewStruct() *vaultProposalR {
+	return &vaultProposalR{}
+}
+
+func (o *VaultProposal) GetInitiator() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInitiator()
+}
+
+func (r *vaultProposalR) GetInitiator() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Initiator
+}
+
+func (o *VaultProposal) GetVault() *Vault {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVault()
+}
+
+func (r *vaultProposalR) GetVault() *Vault {
+	if r == nil {
+		return nil
+	}
+
+	return r.Vault
+}
+
+func (o *VaultProposal) GetProposalKeyRefreshes() KeyRefreshSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetProposalKeyRefreshes()
+}
+
+func (r *vaultProposalR) GetProposalKeyRefreshes() KeyRefreshSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ProposalKeyRefreshes
+}
+
+func (o *VaultProposal) GetCompletionProposalRootKeys() RootKeySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCompletionProposalRootKeys()
+}
+
+func (r *vaultProposalR) GetCompletionProposalRootKeys() RootKeySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CompletionProposalRootKeys
+}
+
+func (o *VaultProposal) GetRetirementProposalRootKeys() RootKeySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRetirementProposalRootKeys()
+}
+
+func (r *vaultProposalR) GetRetirementProposalRootKeys() RootKeySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RetirementProposalRootKeys
+}
+
+func (o *VaultProposal) GetVaultProposalApprovals() VaultProposalApprovalSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVaultProposalApprovals()
+}
+
+func (r *vaultProposalR) GetVaultProposalApprovals() VaultProposalApprovalSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.VaultProposalApprovals
+}
+
+// vaultProposalL is where Load methods for each relationship are stored.
+type vaultProposalL struct{}
+
+var (
+	vaultProposalAllColumns            = []string{"id", "vault_id", "initiator_id", "kind", "payload", "required_approvals", "status", "created_at", "updated_at", "status_reason"}
+	vaultProposalColumnsWithoutDefault = []string{"vault_id", "kind", "payload", "required_approvals"}
+	vaultProposalColumnsWithDefault    = []string{"id", "initiator_id", "status", "created_at", "updated_at", "status_reason"}
+	vaultProposalPrimaryKeyColumns     = []string{"id"}
+	vaultProposalGeneratedColumns      = []string{}
+)
+
+type (
+	// VaultProposalSlice is an alias for a slice of pointers to VaultProposal.
+	// This should almost always be used instead of []VaultProposal.
+	VaultProposalSlice []*VaultProposal
+
+	vaultProposalQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	vaultProposalType                 = reflect.TypeOf(&VaultProposal{})
+	vaultProposalMapping              = queries.MakeStructMapping(vaultProposalType)
+	vaultProposalPrimaryKeyMapping, _ = queries.BindMapping(vaultProposalType, vaultProposalMapping, vaultProposalPrimaryKeyColumns)
+	vaultProposalInsertCacheMut       sync.RWMutex
+	vaultProposalInsertCache          = make(map[string]insertCache)
+	vaultProposalUpdateCacheMut       sync.RWMutex
+	vaultProposalUpdateCache          = make(map[string]updateCache)
+	vaultProposalUpsertCacheMut       sync.RWMutex
+	vaultProposalUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single vaultProposal record from the query.
+func (q vaultProposalQuery) One(ctx context.Context, exec boil.ContextExecutor) (*VaultProposal, error) {
+	o := &VaultProposal{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for vault_proposals")
+	}
+
+	return o, nil
+}
+
+// All returns all VaultProposal records from the query.
+func (q vaultProposalQuery) All(ctx context.Context, exec boil.ContextExecutor) (VaultProposalSlice, error) {
+	var o []*VaultProposal
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to VaultProposal slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VaultProposal records in the query.
+func (q vaultProposalQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count vault_proposals rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q vaultProposalQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if vault_proposals exists")
+	}
+
+	return count > 0, nil
+}
+
+// Initiator pointed to by the foreign key.
+func (o *VaultProposal) Initiator(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InitiatorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Vault pointed to by the foreign key.
+func (o *VaultProposal) Vault(mods ...qm.QueryMod) vaultQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VaultID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Vaults(queryMods...)
+}
+
+// ProposalKeyRefreshes retrieves all the key_refresh's KeyRefreshes with an executor via proposal_id column.
+func (o *VaultProposal) ProposalKeyRefreshes(mods ...qm.QueryMod) keyRefreshQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_refreshes\".\"proposal_id\"=?", o.ID),
+	)
+
+	return KeyRefreshes(queryMods...)
+}
+
+// CompletionProposalRootKeys retrieves all the root_key's RootKeys with an executor via completion_proposal_id column.
+func (o *VaultProposal) CompletionProposalRootKeys(mods ...qm.QueryMod) rootKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"root_keys\".\"completion_proposal_id\"=?", o.ID),
+	)
+
+	return RootKeys(queryMods...)
+}
+
+// RetirementProposalRootKeys retrieves all the root_key's RootKeys with an executor via retirement_proposal_id column.
+func (o *VaultProposal) RetirementProposalRootKeys(mods ...qm.QueryMod) rootKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"root_keys\".\"retirement_proposal_id\"=?", o.ID),
+	)
+
+	return RootKeys(queryMods...)
+}
+
+// VaultProposalApprovals retrieves all the vault_proposal_approval's VaultProposalApprovals with an executor.
+func (o *VaultProposal) VaultProposalApprovals(mods ...qm.QueryMod) vaultProposalApprovalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"vault_proposal_approvals\".\"vault_proposal_id\"=?", o.ID),
+	)
+
+	return VaultProposalApprovals(queryMods...)
+}
+
+// LoadInitiator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (vaultProposalL) LoadInitiator(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposal interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposal
+	var object *VaultProposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposal.(*VaultProposal)
+		if !ok {
+			object = new(VaultProposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposal))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposal.(*[]*VaultProposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalR{}
+		}
+		if !queries.IsNil(object.InitiatorID) {
+			args[object.InitiatorID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalR{}
+			}
+
+			if !queries.IsNil(obj.InitiatorID) {
+				args[obj.InitiatorID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Initiator = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InitiatorVaultProposals = append(foreign.R.InitiatorVaultProposals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.InitiatorID, foreign.ID) {
+				local.R.Initiator = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InitiatorVaultProposals = append(foreign.R.InitiatorVaultProposals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVault allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (vaultProposalL) LoadVault(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposal interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposal
+	var object *VaultProposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposal.(*VaultProposal)
+		if !ok {
+			object = new(VaultProposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposal))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposal.(*[]*VaultProposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalR{}
+		}
+		args[object.VaultID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalR{}
+			}
+
+			args[obj.VaultID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vaults`),
+		qm.WhereIn(`vaults.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Vault")
+	}
+
+	var resultSlice []*Vault
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Vault")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vaults")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vaults")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Vault = foreign
+		if foreign.R == nil {
+			foreign.R = &vaultR{}
+		}
+		foreign.R.VaultProposals = append(foreign.R.VaultProposals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VaultID == foreign.ID {
+				local.R.Vault = foreign
+				if foreign.R == nil {
+					foreign.R = &vaultR{}
+				}
+				foreign.R.VaultProposals = append(foreign.R.VaultProposals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProposalKeyRefreshes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultProposalL) LoadProposalKeyRefreshes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposal interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposal
+	var object *VaultProposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposal.(*VaultProposal)
+		if !ok {
+			object = new(VaultProposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposal))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposal.(*[]*VaultProposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_refreshes`),
+		qm.WhereIn(`key_refreshes.proposal_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load key_refreshes")
+	}
+
+	var resultSlice []*KeyRefresh
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice key_refreshes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on key_refreshes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_refreshes")
+	}
+
+	if singular {
+		object.R.ProposalKeyRefreshes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &keyRefreshR{}
+			}
+			foreign.R.Proposal = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ProposalID) {
+				local.R.ProposalKeyRefreshes = append(local.R.ProposalKeyRefreshes, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyRefreshR{}
+				}
+				foreign.R.Proposal = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCompletionProposalRootKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultProposalL) LoadCompletionProposalRootKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposal interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposal
+	var object *VaultProposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposal.(*VaultProposal)
+		if !ok {
+			object = new(VaultProposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposal))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposal.(*[]*VaultProposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`root_keys`),
+		qm.WhereIn(`root_keys.completion_proposal_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load root_keys")
+	}
+
+	var resultSlice []*RootKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice root_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on root_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for root_keys")
+	}
+
+	if singular {
+		object.R.CompletionProposalRootKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rootKeyR{}
+			}
+			foreign.R.CompletionProposal = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CompletionProposalID) {
+				local.R.CompletionProposalRootKeys = append(local.R.CompletionProposalRootKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &rootKeyR{}
+				}
+				foreign.R.CompletionProposal = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRetirementProposalRootKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultProposalL) LoadRetirementProposalRootKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposal interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposal
+	var object *VaultProposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposal.(*VaultProposal)
+		if !ok {
+			object = new(VaultProposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposal))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposal.(*[]*VaultProposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`root_keys`),
+		qm.WhereIn(`root_keys.retirement_proposal_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load root_keys")
+	}
+
+	var resultSlice []*RootKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice root_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on root_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for root_keys")
+	}
+
+	if singular {
+		object.R.RetirementProposalRootKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rootKeyR{}
+			}
+			foreign.R.RetirementProposal = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RetirementProposalID) {
+				local.R.RetirementProposalRootKeys = append(local.R.RetirementProposalRootKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &rootKeyR{}
+				}
+				foreign.R.RetirementProposal = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVaultProposalApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultProposalL) LoadVaultProposalApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVaultProposal interface{}, mods queries.Applicator) error {
+	var slice []*VaultProposal
+	var object *VaultProposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeVaultProposal.(*VaultProposal)
+		if !ok {
+			object = new(VaultProposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVaultProposal))
+			}
+		}
+	} else {
+		s, ok := maybeVaultProposal.(*[]*VaultProposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVaultProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVaultProposal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultProposalR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultProposalR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`vault_proposal_approvals`),
+		qm.WhereIn(`vault_proposal_approvals.vault_proposal_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load vault_proposal_approvals")
+	}
+
+	var resultSlice []*VaultProposalApproval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice vault_proposal_approvals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on vault_proposal_approvals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposal_approvals")
+	}
+
+	if singular {
+		object.R.VaultProposalApprovals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &vaultProposalApprovalR{}
+			}
+			foreign.R.VaultProposal = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.VaultProposalID {
+				local.R.VaultProposalApprovals = append(local.R.VaultProposalApprovals, foreign)
+				if foreign.R == nil {
+					foreign.R = &vaultProposalApprovalR{}
+				}
+				foreign.R.VaultProposal = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetInitiator of the vaultProposal to the related item.
+// Sets o.R.Initiator to related.
+// Adds o to related.R.InitiatorVaultProposals.
+func (o *VaultProposal) SetInitiator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"vault_proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"initiator_id"}),
+		strmangle.WhereClause("\"", "\"", 2, vaultProposalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.InitiatorID, related.ID)
+	if o.R == nil {
+		o.R = &vaultProposalR{
+			Initiator: related,
+		}
+	} else {
+		o.R.Initiator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InitiatorVaultProposals: VaultProposalSlice{o},
+		}
+	} else {
+		related.R.InitiatorVaultProposals = append(related.R.InitiatorVaultProposals, o)
+	}
+
+	return nil
+}
+
+// RemoveInitiator relationship.
+// Sets o.R.Initiator to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *VaultProposal) RemoveInitiator(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.InitiatorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("initiator_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Initiator = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.InitiatorVaultProposals {
+		if queries.Equal(o.InitiatorID, ri.InitiatorID) {
+			continue
+		}
+
+		ln := len(related.R.InitiatorVaultProposals)
+		if ln > 1 && i < ln-1 {
+			related.R.InitiatorVaultProposals[i] = related.R.InitiatorVaultProposals[ln-1]
+		}
+		related.R.InitiatorVaultProposals = related.R.InitiatorVaultProposals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetVault of the vaultProposal to the related item.
+// Sets o.R.Vault to related.
+// Adds o to related.R.VaultProposals.
+func (o *VaultProposal) SetVault(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Vault) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"vault_proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+		strmangle.WhereClause("\"", "\"", 2, vaultProposalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VaultID = related.ID
+	if o.R == nil {
+		o.R = &vaultProposalR{
+			Vault: related,
+		}
+	} else {
+		o.R.Vault = related
+	}
+
+	if related.R == nil {
+		related.R = &vaultR{
+			VaultProposals: VaultProposalSlice{o},
+		}
+	} else {
+		related.R.VaultProposals = append(related.R.VaultProposals, o)
+	}
+
+	return nil
+}
+
+// AddProposalKeyRefreshes adds the given related objects to the existing relationships
+// of the vault_proposal, optionally inserting them as new records.
+// Appends related to o.R.ProposalKeyRefreshes.
+// Sets related.R.Proposal appropriately.
+func (o *VaultProposal) AddProposalKeyRefreshes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyRefresh) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ProposalID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_refreshes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"proposal_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyRefreshPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ProposalID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultProposalR{
+			ProposalKeyRefreshes: related,
+		}
+	} else {
+		o.R.ProposalKeyRefreshes = append(o.R.ProposalKeyRefreshes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyRefreshR{
+				Proposal: o,
+			}
+		} else {
+			rel.R.Proposal = o
+		}
+	}
+	return nil
+}
+
+// SetProposalKeyRefreshes removes all previously related items of the
+// vault_proposal replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Proposal's ProposalKeyRefreshes accordingly.
+// Replaces o.R.ProposalKeyRefreshes with related.
+// Sets related.R.Proposal's ProposalKeyRefreshes accordingly.
+func (o *VaultProposal) SetProposalKeyRefreshes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyRefresh) error {
+	query := "update \"key_refreshes\" set \"proposal_id\" = null where \"proposal_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ProposalKeyRefreshes {
+			queries.SetScanner(&rel.ProposalID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Proposal = nil
+		}
+		o.R.ProposalKeyRefreshes = nil
+	}
+
+	return o.AddProposalKeyRefreshes(ctx, exec, insert, related...)
+}
+
+// RemoveProposalKeyRefreshes relationships from objects passed in.
+// Removes related items from R.ProposalKeyRefreshes (uses pointer comparison, removal does not keep order)
+// Sets related.R.Proposal.
+func (o *VaultProposal) RemoveProposalKeyRefreshes(ctx context.Context, exec boil.ContextExecutor, related ...*KeyRefresh) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ProposalID, nil)
+		if rel.R != nil {
+			rel.R.Proposal = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("proposal_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ProposalKeyRefreshes {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ProposalKeyRefreshes)
+			if ln > 1 && i < ln-1 {
+				o.R.ProposalKeyRefreshes[i] = o.R.ProposalKeyRefreshes[ln-1]
+			}
+			o.R.ProposalKeyRefreshes = o.R.ProposalKeyRefreshes[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCompletionProposalRootKeys adds the given related objects to the existing relationships
+// of the vault_proposal, optionally inserting them as new records.
+// Appends related to o.R.CompletionProposalRootKeys.
+// Sets related.R.CompletionProposal appropriately.
+func (o *VaultProposal) AddCompletionProposalRootKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RootKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CompletionProposalID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"root_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"completion_proposal_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rootKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.KeyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CompletionProposalID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultProposalR{
+			CompletionProposalRootKeys: related,
+		}
+	} else {
+		o.R.CompletionProposalRootKeys = append(o.R.CompletionProposalRootKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rootKeyR{
+				CompletionProposal: o,
+			}
+		} else {
+			rel.R.CompletionProposal = o
+		}
+	}
+	return nil
+}
+
+// SetCompletionProposalRootKeys removes all previously related items of the
+// vault_proposal replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CompletionProposal's CompletionProposalRootKeys accordingly.
+// Replaces o.R.CompletionProposalRootKeys with related.
+// Sets related.R.CompletionProposal's CompletionProposalRootKeys accordingly.
+func (o *VaultProposal) SetCompletionProposalRootKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RootKey) error {
+	query := "update \"root_keys\" set \"completion_proposal_id\" = null where \"completion_proposal_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CompletionProposalRootKeys {
+			queries.SetScanner(&rel.CompletionProposalID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CompletionProposal = nil
+		}
+		o.R.CompletionProposalRootKeys = nil
+	}
+
+	return o.AddCompletionProposalRootKeys(ctx, exec, insert, related...)
+}
+
+// RemoveCompletionProposalRootKeys relationships from objects passed in.
+// Removes related items from R.CompletionProposalRootKeys (uses pointer comparison, removal does not keep order)
+// Sets related.R.CompletionProposal.
+func (o *VaultProposal) RemoveCompletionProposalRootKeys(ctx context.Context, exec boil.ContextExecutor, related ...*RootKey) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CompletionProposalID, nil)
+		if rel.R != nil {
+			rel.R.CompletionProposal = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("completion_proposal_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CompletionProposalRootKeys {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CompletionProposalRootKeys)
+			if ln > 1 && i < ln-1 {
+				o.R.CompletionProposalRootKeys[i] = o.R.CompletionProposalRootKeys[ln-1]
+			}
+			o.R.CompletionProposalRootKeys = o.R.CompletionProposalRootKeys[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRetirementProposalRootKeys adds the given related objects to the existing relationships
+// of the vault_proposal, optionally inserting them as new records.
+// Appends related to o.R.RetirementProposalRootKeys.
+// Sets related.R.RetirementProposal appropriately.
+func (o *VaultProposal) AddRetirementProposalRootKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RootKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RetirementProposalID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"root_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"retirement_proposal_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rootKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.KeyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RetirementProposalID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultProposalR{
+			RetirementProposalRootKeys: related,
+		}
+	} else {
+		o.R.RetirementProposalRootKeys = append(o.R.RetirementProposalRootKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rootKeyR{
+				RetirementProposal: o,
+			}
+		} else {
+			rel.R.RetirementProposal = o
+		}
+	}
+	return nil
+}
+
+// SetRetirementProposalRootKeys removes all previously related items of the
+// vault_proposal replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RetirementProposal's RetirementProposalRootKeys accordingly.
+// Replaces o.R.RetirementProposalRootKeys with related.
+// Sets related.R.RetirementProposal's RetirementProposalRootKeys accordingly.
+func (o *VaultProposal) SetRetirementProposalRootKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RootKey) error {
+	query := "update \"root_keys\" set \"retirement_proposal_id\" = null where \"retirement_proposal_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RetirementProposalRootKeys {
+			queries.SetScanner(&rel.RetirementProposalID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RetirementProposal = nil
+		}
+		o.R.RetirementProposalRootKeys = nil
+	}
+
+	return o.AddRetirementProposalRootKeys(ctx, exec, insert, related...)
+}
+
+// RemoveRetirementProposalRootKeys relationships from objects passed in.
+// Removes related items from R.RetirementProposalRootKeys (uses pointer comparison, removal does not keep order)
+// Sets related.R.RetirementProposal.
+func (o *VaultProposal) RemoveRetirementProposalRootKeys(ctx context.Context, exec boil.ContextExecutor, related ...*RootKey) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RetirementProposalID, nil)
+		if rel.R != nil {
+			rel.R.RetirementProposal = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("retirement_proposal_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RetirementProposalRootKeys {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RetirementProposalRootKeys)
+			if ln > 1 && i < ln-1 {
+				o.R.RetirementProposalRootKeys[i] = o.R.RetirementProposalRootKeys[ln-1]
+			}
+			o.R.RetirementProposalRootKeys = o.R.RetirementProposalRootKeys[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddVaultProposalApprovals adds the given related objects to the existing relationships
+// of the vault_proposal, optionally inserting them as new records.
+// Appends related to o.R.VaultProposalApprovals.
+// Sets related.R.VaultProposal appropriately.
+func (o *VaultProposal) AddVaultProposalApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VaultProposalApproval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VaultProposalID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"vault_proposal_approvals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"vault_proposal_id"}),
+				strmangle.WhereClause("\"", "\"", 2, vaultProposalApprovalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VaultProposalID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultProposalR{
+			VaultProposalApprovals: related,
+		}
+	} else {
+		o.R.VaultProposalApprovals = append(o.R.VaultProposalApprovals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &vaultProposalApprovalR{
+				VaultProposal: o,
+			}
+		} else {
+			rel.R.VaultProposal = o
+		}
+	}
+	return nil
+}
+
+// VaultProposals retrieves all the records using an executor.
+func VaultProposals(mods ...qm.QueryMod) vaultProposalQuery {
+	mods = append(mods, qm.From("\"vault_proposals\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"vault_proposals\".*"})
+	}
+
+	return vaultProposalQuery{q}
+}
+
+// FindVaultProposal retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVaultProposal(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*VaultProposal, error) {
+	vaultProposalObj := &VaultProposal{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"vault_proposals\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, vaultProposalObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from vault_proposals")
+	}
+
+	return vaultProposalObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VaultProposal) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no vault_proposals provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vaultProposalColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	vaultProposalInsertCacheMut.RLock()
+	cache, cached := vaultProposalInsertCache[key]
+	vaultProposalInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			vaultProposalAllColumns,
+			vaultProposalColumnsWithDefault,
+			vaultProposalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(vaultProposalType, vaultProposalMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(vaultProposalType, vaultProposalMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"vault_proposals\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"vault_proposals\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into vault_proposals")
+	}
+
+	if !cached {
+		vaultProposalInsertCacheMut.Lock()
+		vaultProposalInsertCache[key] = cache
+		vaultProposalInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the VaultProposal.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VaultProposal) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	vaultProposalUpdateCacheMut.RLock()
+	cache, cached := vaultProposalUpdateCache[key]
+	vaultProposalUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			vaultProposalAllColumns,
+			vaultProposalPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update vault_proposals, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"vault_proposals\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, vaultProposalPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(vaultProposalType, vaultProposalMapping, append(wl, vaultProposalPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update vault_proposals row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for vault_proposals")
+	}
+
+	if !cached {
+		vaultProposalUpdateCacheMut.Lock()
+		vaultProposalUpdateCache[key] = cache
+		vaultProposalUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q vaultProposalQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for vault_proposals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for vault_proposals")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VaultProposalSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vaultProposalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"vault_proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, vaultProposalPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in vaultProposal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all vaultProposal")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VaultProposal) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no vault_proposals provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vaultProposalColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	vaultProposalUpsertCacheMut.RLock()
+	cache, cached := vaultProposalUpsertCache[key]
+	vaultProposalUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			vaultProposalAllColumns,
+			vaultProposalColumnsWithDefault,
+			vaultProposalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			vaultProposalAllColumns,
+			vaultProposalPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert vault_proposals, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(vaultProposalAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(vaultProposalPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert vault_proposals, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(vaultProposalPrimaryKeyColumns))
+			copy(conflict, vaultProposalPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"vault_proposals\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(vaultProposalType, vaultProposalMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(vaultProposalType, vaultProposalMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert vault_proposals")
+	}
+
+	if !cached {
+		vaultProposalUpsertCacheMut.Lock()
+		vaultProposalUpsertCache[key] = cache
+		vaultProposalUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single VaultProposal record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VaultProposal) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no VaultProposal provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), vaultProposalPrimaryKeyMapping)
+	sql := "DELETE FROM \"vault_proposals\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from vault_proposals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for vault_proposals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q vaultProposalQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no vaultProposalQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from vault_proposals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for vault_proposals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VaultProposalSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vaultProposalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"vault_proposals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vaultProposalPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from vaultProposal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for vault_proposals")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VaultProposal) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindVaultProposal(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VaultProposalSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VaultProposalSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vaultProposalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"vault_proposals\".* FROM \"vault_proposals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vaultProposalPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in VaultProposalSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VaultProposalExists checks if the VaultProposal row exists.
+func VaultProposalExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"vault_proposals\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if vault_proposals exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the VaultProposal row exists.
+func (o *VaultProposal) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return VaultProposalExists(ctx, exec, o.ID)
+}
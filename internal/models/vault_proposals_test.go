@@ -0,0 +1,1999 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testVaultProposals(t *testing.T) {
+	t.Parallel()
+
+	query := VaultProposals()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testVaultProposalsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVaultProposalsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := VaultProposals().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVaultProposalsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VaultProposalSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testVaultProposalsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := VaultProposalExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if VaultProposal exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected VaultProposalExists to return true, but got false.")
+	}
+}
+
+func testVaultProposalsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	vaultProposalFound, err := FindVaultProposal(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if vaultProposalFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testVaultProposalsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = VaultProposals().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVaultProposalsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := VaultProposals().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testVaultProposalsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	vaultProposalOne := &VaultProposal{}
+	vaultProposalTwo := &VaultProposal{}
+	if err = randomize.Struct(seed, vaultProposalOne, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+	if err = randomize.Struct(seed, vaultProposalTwo, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = vaultProposalOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = vaultProposalTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VaultProposals().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testVaultProposalsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	vaultProposalOne := &VaultProposal{}
+	vaultProposalTwo := &VaultProposal{}
+	if err = randomize.Struct(seed, vaultProposalOne, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+	if err = randomize.Struct(seed, vaultProposalTwo, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = vaultProposalOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = vaultProposalTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testVaultProposalsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVaultProposalsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testVaultProposalToManyProposalKeyRefreshes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c KeyRefresh
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ProposalID, a.ID)
+	queries.Assign(&c.ProposalID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ProposalKeyRefreshes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ProposalID, b.ProposalID) {
+			bFound = true
+		}
+		if queries.Equal(v.ProposalID, c.ProposalID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultProposalSlice{&a}
+	if err = a.L.LoadProposalKeyRefreshes(ctx, tx, false, (*[]*VaultProposal)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ProposalKeyRefreshes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ProposalKeyRefreshes = nil
+	if err = a.L.LoadProposalKeyRefreshes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ProposalKeyRefreshes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultProposalToManyCompletionProposalRootKeys(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CompletionProposalID, a.ID)
+	queries.Assign(&c.CompletionProposalID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CompletionProposalRootKeys().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CompletionProposalID, b.CompletionProposalID) {
+			bFound = true
+		}
+		if queries.Equal(v.CompletionProposalID, c.CompletionProposalID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultProposalSlice{&a}
+	if err = a.L.LoadCompletionProposalRootKeys(ctx, tx, false, (*[]*VaultProposal)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CompletionProposalRootKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CompletionProposalRootKeys = nil
+	if err = a.L.LoadCompletionProposalRootKeys(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CompletionProposalRootKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultProposalToManyRetirementProposalRootKeys(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.RetirementProposalID, a.ID)
+	queries.Assign(&c.RetirementProposalID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RetirementProposalRootKeys().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.RetirementProposalID, b.RetirementProposalID) {
+			bFound = true
+		}
+		if queries.Equal(v.RetirementProposalID, c.RetirementProposalID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultProposalSlice{&a}
+	if err = a.L.LoadRetirementProposalRootKeys(ctx, tx, false, (*[]*VaultProposal)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RetirementProposalRootKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RetirementProposalRootKeys = nil
+	if err = a.L.LoadRetirementProposalRootKeys(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RetirementProposalRootKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultProposalToManyVaultProposalApprovals(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c VaultProposalApproval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalApprovalDBTypes, false, vaultProposalApprovalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VaultProposalID = a.ID
+	c.VaultProposalID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.VaultProposalApprovals().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VaultProposalID == b.VaultProposalID {
+			bFound = true
+		}
+		if v.VaultProposalID == c.VaultProposalID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultProposalSlice{&a}
+	if err = a.L.LoadVaultProposalApprovals(ctx, tx, false, (*[]*VaultProposal)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultProposalApprovals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.VaultProposalApprovals = nil
+	if err = a.L.LoadVaultProposalApprovals(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultProposalApprovals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultProposalToManyAddOpProposalKeyRefreshes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e KeyRefresh
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyRefresh{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyRefreshDBTypes, false, strmangle.SetComplement(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyRefresh{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddProposalKeyRefreshes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ProposalID) {
+			t.Error("foreign key was wrong value", a.ID, first.ProposalID)
+		}
+		if !queries.Equal(a.ID, second.ProposalID) {
+			t.Error("foreign key was wrong value", a.ID, second.ProposalID)
+		}
+
+		if first.R.Proposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Proposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ProposalKeyRefreshes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ProposalKeyRefreshes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ProposalKeyRefreshes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testVaultProposalToManySetOpProposalKeyRefreshes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e KeyRefresh
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyRefresh{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyRefreshDBTypes, false, strmangle.SetComplement(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetProposalKeyRefreshes(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ProposalKeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetProposalKeyRefreshes(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ProposalKeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ProposalID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ProposalID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ProposalID) {
+		t.Error("foreign key was wrong value", a.ID, d.ProposalID)
+	}
+	if !queries.Equal(a.ID, e.ProposalID) {
+		t.Error("foreign key was wrong value", a.ID, e.ProposalID)
+	}
+
+	if b.R.Proposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Proposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Proposal != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Proposal != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ProposalKeyRefreshes[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ProposalKeyRefreshes[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testVaultProposalToManyRemoveOpProposalKeyRefreshes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e KeyRefresh
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyRefresh{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyRefreshDBTypes, false, strmangle.SetComplement(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddProposalKeyRefreshes(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ProposalKeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveProposalKeyRefreshes(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ProposalKeyRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ProposalID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ProposalID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Proposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Proposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Proposal != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Proposal != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ProposalKeyRefreshes) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ProposalKeyRefreshes[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ProposalKeyRefreshes[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testVaultProposalToManyAddOpCompletionProposalRootKeys(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RootKey{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*RootKey{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCompletionProposalRootKeys(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CompletionProposalID) {
+			t.Error("foreign key was wrong value", a.ID, first.CompletionProposalID)
+		}
+		if !queries.Equal(a.ID, second.CompletionProposalID) {
+			t.Error("foreign key was wrong value", a.ID, second.CompletionProposalID)
+		}
+
+		if first.R.CompletionProposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.CompletionProposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CompletionProposalRootKeys[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CompletionProposalRootKeys[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CompletionProposalRootKeys().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testVaultProposalToManySetOpCompletionProposalRootKeys(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RootKey{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCompletionProposalRootKeys(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CompletionProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCompletionProposalRootKeys(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CompletionProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CompletionProposalID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CompletionProposalID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CompletionProposalID) {
+		t.Error("foreign key was wrong value", a.ID, d.CompletionProposalID)
+	}
+	if !queries.Equal(a.ID, e.CompletionProposalID) {
+		t.Error("foreign key was wrong value", a.ID, e.CompletionProposalID)
+	}
+
+	if b.R.CompletionProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CompletionProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CompletionProposal != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.CompletionProposal != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CompletionProposalRootKeys[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CompletionProposalRootKeys[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testVaultProposalToManyRemoveOpCompletionProposalRootKeys(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RootKey{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCompletionProposalRootKeys(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CompletionProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCompletionProposalRootKeys(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CompletionProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CompletionProposalID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CompletionProposalID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.CompletionProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.CompletionProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.CompletionProposal != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.CompletionProposal != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CompletionProposalRootKeys) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CompletionProposalRootKeys[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CompletionProposalRootKeys[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testVaultProposalToManyAddOpRetirementProposalRootKeys(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RootKey{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*RootKey{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRetirementProposalRootKeys(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.RetirementProposalID) {
+			t.Error("foreign key was wrong value", a.ID, first.RetirementProposalID)
+		}
+		if !queries.Equal(a.ID, second.RetirementProposalID) {
+			t.Error("foreign key was wrong value", a.ID, second.RetirementProposalID)
+		}
+
+		if first.R.RetirementProposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.RetirementProposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RetirementProposalRootKeys[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RetirementProposalRootKeys[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RetirementProposalRootKeys().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testVaultProposalToManySetOpRetirementProposalRootKeys(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RootKey{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetRetirementProposalRootKeys(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.RetirementProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetRetirementProposalRootKeys(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.RetirementProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.RetirementProposalID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.RetirementProposalID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.RetirementProposalID) {
+		t.Error("foreign key was wrong value", a.ID, d.RetirementProposalID)
+	}
+	if !queries.Equal(a.ID, e.RetirementProposalID) {
+		t.Error("foreign key was wrong value", a.ID, e.RetirementProposalID)
+	}
+
+	if b.R.RetirementProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RetirementProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RetirementProposal != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.RetirementProposal != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.RetirementProposalRootKeys[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.RetirementProposalRootKeys[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testVaultProposalToManyRemoveOpRetirementProposalRootKeys(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RootKey{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddRetirementProposalRootKeys(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.RetirementProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveRetirementProposalRootKeys(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.RetirementProposalRootKeys().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.RetirementProposalID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.RetirementProposalID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.RetirementProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RetirementProposal != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RetirementProposal != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.RetirementProposal != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.RetirementProposalRootKeys) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.RetirementProposalRootKeys[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.RetirementProposalRootKeys[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testVaultProposalToManyAddOpVaultProposalApprovals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c, d, e VaultProposalApproval
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*VaultProposalApproval{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, vaultProposalApprovalDBTypes, false, strmangle.SetComplement(vaultProposalApprovalPrimaryKeyColumns, vaultProposalApprovalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*VaultProposalApproval{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddVaultProposalApprovals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.VaultProposalID {
+			t.Error("foreign key was wrong value", a.ID, first.VaultProposalID)
+		}
+		if a.ID != second.VaultProposalID {
+			t.Error("foreign key was wrong value", a.ID, second.VaultProposalID)
+		}
+
+		if first.R.VaultProposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.VaultProposal != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.VaultProposalApprovals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.VaultProposalApprovals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.VaultProposalApprovals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testVaultProposalToOneUserUsingInitiator(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VaultProposal
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.InitiatorID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Initiator().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := VaultProposalSlice{&local}
+	if err = local.L.LoadInitiator(ctx, tx, false, (*[]*VaultProposal)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Initiator == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Initiator = nil
+	if err = local.L.LoadInitiator(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Initiator == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testVaultProposalToOneVaultUsingVault(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local VaultProposal
+	var foreign Vault
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, vaultDBTypes, false, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.VaultID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Vault().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := VaultProposalSlice{&local}
+	if err = local.L.LoadVault(ctx, tx, false, (*[]*VaultProposal)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Vault = nil
+	if err = local.L.LoadVault(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Vault == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+}
+
+func testVaultProposalToOneSetOpUserUsingInitiator(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetInitiator(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Initiator != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.InitiatorVaultProposals[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.InitiatorID, x.ID) {
+			t.Error("foreign key was wrong value", a.InitiatorID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.InitiatorID))
+		reflect.Indirect(reflect.ValueOf(&a.InitiatorID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.InitiatorID, x.ID) {
+			t.Error("foreign key was wrong value", a.InitiatorID, x.ID)
+		}
+	}
+}
+
+func testVaultProposalToOneRemoveOpUserUsingInitiator(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetInitiator(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveInitiator(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Initiator().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Initiator != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.InitiatorID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.InitiatorVaultProposals) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testVaultProposalToOneSetOpVaultUsingVault(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a VaultProposal
+	var b, c Vault
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Vault{&b, &c} {
+		err = a.SetVault(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Vault != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.VaultProposals[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.VaultID))
+		reflect.Indirect(reflect.ValueOf(&a.VaultID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.VaultID != x.ID {
+			t.Error("foreign key was wrong value", a.VaultID, x.ID)
+		}
+	}
+}
+
+func testVaultProposalsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVaultProposalsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := VaultProposalSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testVaultProposalsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := VaultProposals().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	vaultProposalDBTypes = map[string]string{`ID`: `uuid`, `VaultID`: `uuid`, `InitiatorID`: `uuid`, `Kind`: `character varying`, `Payload`: `jsonb`, `RequiredApprovals`: `integer`, `Status`: `character varying`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `StatusReason`: `text`}
+	_                    = bytes.MinRead
+)
+
+func testVaultProposalsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(vaultProposalPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(vaultProposalAllColumns) == len(vaultProposalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testVaultProposalsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(vaultProposalAllColumns) == len(vaultProposalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &VaultProposal{}
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, vaultProposalDBTypes, true, vaultProposalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(vaultProposalAllColumns, vaultProposalPrimaryKeyColumns) {
+		fields = vaultProposalAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			vaultProposalAllColumns,
+			vaultProposalPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := VaultProposalSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testVaultProposalsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(vaultProposalAllColumns) == len(vaultProposalPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := VaultProposal{}
+	if err = randomize.Struct(seed, &o, vaultProposalDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VaultProposal: %s", err)
+	}
+
+	count, err := VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, vaultProposalDBTypes, false, vaultProposalPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize VaultProposal struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert VaultProposal: %s", err)
+	}
+
+	count, err = VaultProposals().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
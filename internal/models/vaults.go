@@ -30,6 +30,9 @@ type Vault struct {
 	Threshold      int         `boil:"threshold" json:"threshold" toml:"threshold" yaml:"threshold"`
 	CreatedAt      null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ArchivedAt     null.Time   `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	WhitelistOnly  bool        `boil:"whitelist_only" json:"whitelist_only" toml:"whitelist_only" yaml:"whitelist_only"`
 
 	R *vaultR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L vaultL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +45,9 @@ var VaultColumns = struct {
 	Threshold      string
 	CreatedAt      string
 	UpdatedAt      string
+	Status         string
+	ArchivedAt     string
+	WhitelistOnly  string
 }{
 	ID:             "id",
 	OrganizationID: "organization_id",
@@ -49,6 +55,9 @@ var VaultColumns = struct {
 	Threshold:      "threshold",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
+	Status:         "status",
+	ArchivedAt:     "archived_at",
+	WhitelistOnly:  "whitelist_only",
 }
 
 var VaultTableColumns = struct {
@@ -58,6 +67,9 @@ var VaultTableColumns = struct {
 	Threshold      string
 	CreatedAt      string
 	UpdatedAt      string
+	Status         string
+	ArchivedAt     string
+	WhitelistOnly  string
 }{
 	ID:             "vaults.id",
 	OrganizationID: "vaults.organization_id",
@@ -65,6 +77,9 @@ var VaultTableColumns = struct {
 	Threshold:      "vaults.threshold",
 	CreatedAt:      "vaults.created_at",
 	UpdatedAt:      "vaults.updated_at",
+	Status:         "vaults.status",
+	ArchivedAt:     "vaults.archived_at",
+	WhitelistOnly:  "vaults.whitelist_only",
 }
 
 // Generated where
@@ -76,6 +91,9 @@ var VaultWhere = struct {
 	Threshold      whereHelperint
 	CreatedAt      whereHelpernull_Time
 	UpdatedAt      whereHelpernull_Time
+	Status         whereHelperstring
+	ArchivedAt     whereHelpernull_Time
+	WhitelistOnly  whereHelperbool
 }{
 	ID:             whereHelperstring{field: "\"vaults\".\"id\""},
 	OrganizationID: whereHelpernull_String{field: "\"vaults\".\"organization_id\""},
@@ -83,30 +101,48 @@ var VaultWhere = struct {
 	Threshold:      whereHelperint{field: "\"vaults\".\"threshold\""},
 	CreatedAt:      whereHelpernull_Time{field: "\"vaults\".\"created_at\""},
 	UpdatedAt:      whereHelpernull_Time{field: "\"vaults\".\"updated_at\""},
+	Status:         whereHelperstring{field: "\"vaults\".\"status\""},
+	ArchivedAt:     whereHelpernull_Time{field: "\"vaults\".\"archived_at\""},
+	WhitelistOnly:  whereHelperbool{field: "\"vaults\".\"whitelist_only\""},
 }
 
 // VaultRels is where relationship names are stored.
 var VaultRels = struct {
-	Organization    string
-	SigningRequests string
-	SpendingLimits  string
-	VaultKeys       string
-	Wallets         string
+	Organization       string
+	KeyBackupHealths   string
+	KeyRefreshes       string
+	KeyShareRecoveries string
+	RootKeys           string
+	SigningRequests    string
+	SpendingLimits     string
+	VaultKeys          string
+	VaultProposals     string
+	Wallets            string
 }{
-	Organization:    "Organization",
-	SigningRequests: "SigningRequests",
-	SpendingLimits:  "SpendingLimits",
-	VaultKeys:       "VaultKeys",
-	Wallets:         "Wallets",
+	Organization:       "Organization",
+	KeyBackupHealths:   "KeyBackupHealths",
+	KeyRefreshes:       "KeyRefreshes",
+	KeyShareRecoveries: "KeyShareRecoveries",
+	RootKeys:           "RootKeys",
+	SigningRequests:    "SigningRequests",
+	SpendingLimits:     "SpendingLimits",
+	VaultKeys:          "VaultKeys",
+	VaultProposals:     "VaultProposals",
+	Wallets:            "Wallets",
 }
 
 // vaultR is where relationships are stored.
 type vaultR struct {
-	Organization    *Organization       `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
-	SigningRequests SigningRequestSlice `boil:"SigningRequests" json:"SigningRequests" toml:"SigningRequests" yaml:"SigningRequests"`
-	SpendingLimits  SpendingLimitSlice  `boil:"SpendingLimits" json:"SpendingLimits" toml:"SpendingLimits" yaml:"SpendingLimits"`
-	VaultKeys       VaultKeySlice       `boil:"VaultKeys" json:"VaultKeys" toml:"VaultKeys" yaml:"VaultKeys"`
-	Wallets         WalletSlice         `boil:"Wallets" json:"Wallets" toml:"Wallets" yaml:"Wallets"`
+	Organization       *Organization         `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	KeyBackupHealths   KeyBackupHealthSlice  `boil:"KeyBackupHealths" json:"KeyBackupHealths" toml:"KeyBackupHealths" yaml:"KeyBackupHealths"`
+	KeyRefreshes       KeyRefreshSlice       `boil:"KeyRefreshes" json:"KeyRefreshes" toml:"KeyRefreshes" yaml:"KeyRefreshes"`
+	KeyShareRecoveries KeyShareRecoverySlice `boil:"KeyShareRecoveries" json:"KeyShareRecoveries" toml:"KeyShareRecoveries" yaml:"KeyShareRecoveries"`
+	RootKeys           RootKeySlice          `boil:"RootKeys" json:"RootKeys" toml:"RootKeys" yaml:"RootKeys"`
+	SigningRequests    SigningRequestSlice   `boil:"SigningRequests" json:"SigningRequests" toml:"SigningRequests" yaml:"SigningRequests"`
+	SpendingLimits     SpendingLimitSlice    `boil:"SpendingLimits" json:"SpendingLimits" toml:"SpendingLimits" yaml:"SpendingLimits"`
+	VaultKeys          VaultKeySlice         `boil:"VaultKeys" json:"VaultKeys" toml:"VaultKeys" yaml:"VaultKeys"`
+	VaultProposals     VaultProposalSlice    `boil:"VaultProposals" json:"VaultProposals" toml:"VaultProposals" yaml:"VaultProposals"`
+	Wallets            WalletSlice           `boil:"Wallets" json:"Wallets" toml:"Wallets" yaml:"Wallets"`
 }
 
 // NewStruct creates a new relationship struct
@@ -130,6 +166,70 @@ func (r *vaultR) GetOrganization() *Organization {
 	return r.Organization
 }
 
+func (o *Vault) GetKeyBackupHealths() KeyBackupHealthSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetKeyBackupHealths()
+}
+
+func (r *vaultR) GetKeyBackupHealths() KeyBackupHealthSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.KeyBackupHealths
+}
+
+func (o *Vault) GetKeyRefreshes() KeyRefreshSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetKeyRefreshes()
+}
+
+func (r *vaultR) GetKeyRefreshes() KeyRefreshSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.KeyRefreshes
+}
+
+func (o *Vault) GetKeyShareRecoveries() KeyShareRecoverySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetKeyShareRecoveries()
+}
+
+func (r *vaultR) GetKeyShareRecoveries() KeyShareRecoverySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.KeyShareRecoveries
+}
+
+func (o *Vault) GetRootKeys() RootKeySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRootKeys()
+}
+
+func (r *vaultR) GetRootKeys() RootKeySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RootKeys
+}
+
 func (o *Vault) GetSigningRequests() SigningRequestSlice {
 	if o == nil {
 		return nil
@@ -178,6 +278,22 @@ func (r *vaultR) GetVaultKeys() VaultKeySlice {
 	return r.VaultKeys
 }
 
+func (o *Vault) GetVaultProposals() VaultProposalSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetVaultProposals()
+}
+
+func (r *vaultR) GetVaultProposals() VaultProposalSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.VaultProposals
+}
+
 func (o *Vault) GetWallets() WalletSlice {
 	if o == nil {
 		return nil
@@ -198,9 +314,9 @@ func (r *vaultR) GetWallets() WalletSlice {
 type vaultL struct{}
 
 var (
-	vaultAllColumns            = []string{"id", "organization_id", "name", "threshold", "created_at", "updated_at"}
+	vaultAllColumns            = []string{"id", "organization_id", "name", "threshold", "created_at", "updated_at", "status", "archived_at", "whitelist_only"}
 	vaultColumnsWithoutDefault = []string{"name"}
-	vaultColumnsWithDefault    = []string{"id", "organization_id", "threshold", "created_at", "updated_at"}
+	vaultColumnsWithDefault    = []string{"id", "organization_id", "threshold", "created_at", "updated_at", "status", "archived_at", "whitelist_only"}
 	vaultPrimaryKeyColumns     = []string{"id"}
 	vaultGeneratedColumns      = []string{}
 )
@@ -307,6 +423,62 @@ func (o *Vault) Organization(mods ...qm.QueryMod) organizationQuery {
 	return Organizations(queryMods...)
 }
 
+// KeyBackupHealths retrieves all the key_backup_health's KeyBackupHealths with an executor.
+func (o *Vault) KeyBackupHealths(mods ...qm.QueryMod) keyBackupHealthQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_backup_health\".\"vault_id\"=?", o.ID),
+	)
+
+	return KeyBackupHealths(queryMods...)
+}
+
+// KeyRefreshes retrieves all the key_refresh's KeyRefreshes with an executor.
+func (o *Vault) KeyRefreshes(mods ...qm.QueryMod) keyRefreshQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_refreshes\".\"vault_id\"=?", o.ID),
+	)
+
+	return KeyRefreshes(queryMods...)
+}
+
+// KeyShareRecoveries retrieves all the key_share_recovery's KeyShareRecoveries with an executor.
+func (o *Vault) KeyShareRecoveries(mods ...qm.QueryMod) keyShareRecoveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"key_share_recoveries\".\"vault_id\"=?", o.ID),
+	)
+
+	return KeyShareRecoveries(queryMods...)
+}
+
+// RootKeys retrieves all the root_key's RootKeys with an executor.
+func (o *Vault) RootKeys(mods ...qm.QueryMod) rootKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"root_keys\".\"vault_id\"=?", o.ID),
+	)
+
+	return RootKeys(queryMods...)
+}
+
 // SigningRequests retrieves all the signing_request's SigningRequests with an executor.
 func (o *Vault) SigningRequests(mods ...qm.QueryMod) signingRequestQuery {
 	var queryMods []qm.QueryMod
@@ -349,6 +521,20 @@ func (o *Vault) VaultKeys(mods ...qm.QueryMod) vaultKeyQuery {
 	return VaultKeys(queryMods...)
 }
 
+// VaultProposals retrieves all the vault_proposal's VaultProposals with an executor.
+func (o *Vault) VaultProposals(mods ...qm.QueryMod) vaultProposalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"vault_proposals\".\"vault_id\"=?", o.ID),
+	)
+
+	return VaultProposals(queryMods...)
+}
+
 // Wallets retrieves all the wallet's Wallets with an executor.
 func (o *Vault) Wallets(mods ...qm.QueryMod) walletQuery {
 	var queryMods []qm.QueryMod
@@ -479,9 +665,9 @@ func (vaultL) LoadOrganization(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadSigningRequests allows an eager lookup of values, cached into the
+// LoadKeyBackupHealths allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (vaultL) LoadSigningRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+func (vaultL) LoadKeyBackupHealths(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
 	var slice []*Vault
 	var object *Vault
 
@@ -534,8 +720,8 @@ func (vaultL) LoadSigningRequests(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`signing_requests`),
-		qm.WhereIn(`signing_requests.vault_id in ?`, argsSlice...),
+		qm.From(`key_backup_health`),
+		qm.WhereIn(`key_backup_health.vault_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -543,26 +729,26 @@ func (vaultL) LoadSigningRequests(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load signing_requests")
+		return errors.Wrap(err, "failed to eager load key_backup_health")
 	}
 
-	var resultSlice []*SigningRequest
+	var resultSlice []*KeyBackupHealth
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice signing_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice key_backup_health")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on signing_requests")
+		return errors.Wrap(err, "failed to close results in eager load on key_backup_health")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for signing_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_backup_health")
 	}
 
 	if singular {
-		object.R.SigningRequests = resultSlice
+		object.R.KeyBackupHealths = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &signingRequestR{}
+				foreign.R = &keyBackupHealthR{}
 			}
 			foreign.R.Vault = object
 		}
@@ -571,10 +757,540 @@ func (vaultL) LoadSigningRequests(ctx context.Context, e boil.ContextExecutor, s
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.VaultID) {
-				local.R.SigningRequests = append(local.R.SigningRequests, foreign)
+			if local.ID == foreign.VaultID {
+				local.R.KeyBackupHealths = append(local.R.KeyBackupHealths, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyBackupHealthR{}
+				}
+				foreign.R.Vault = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadKeyRefreshes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultL) LoadKeyRefreshes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+	var slice []*Vault
+	var object *Vault
+
+	if singular {
+		var ok bool
+		object, ok = maybeVault.(*Vault)
+		if !ok {
+			object = new(Vault)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVault))
+			}
+		}
+	} else {
+		s, ok := maybeVault.(*[]*Vault)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVault))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_refreshes`),
+		qm.WhereIn(`key_refreshes.vault_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load key_refreshes")
+	}
+
+	var resultSlice []*KeyRefresh
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice key_refreshes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on key_refreshes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_refreshes")
+	}
+
+	if singular {
+		object.R.KeyRefreshes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &keyRefreshR{}
+			}
+			foreign.R.Vault = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.VaultID {
+				local.R.KeyRefreshes = append(local.R.KeyRefreshes, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyRefreshR{}
+				}
+				foreign.R.Vault = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadKeyShareRecoveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultL) LoadKeyShareRecoveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+	var slice []*Vault
+	var object *Vault
+
+	if singular {
+		var ok bool
+		object, ok = maybeVault.(*Vault)
+		if !ok {
+			object = new(Vault)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVault))
+			}
+		}
+	} else {
+		s, ok := maybeVault.(*[]*Vault)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVault))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`key_share_recoveries`),
+		qm.WhereIn(`key_share_recoveries.vault_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load key_share_recoveries")
+	}
+
+	var resultSlice []*KeyShareRecovery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice key_share_recoveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on key_share_recoveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for key_share_recoveries")
+	}
+
+	if singular {
+		object.R.KeyShareRecoveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &keyShareRecoveryR{}
+			}
+			foreign.R.Vault = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.VaultID {
+				local.R.KeyShareRecoveries = append(local.R.KeyShareRecoveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &keyShareRecoveryR{}
+				}
+				foreign.R.Vault = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRootKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultL) LoadRootKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+	var slice []*Vault
+	var object *Vault
+
+	if singular {
+		var ok bool
+		object, ok = maybeVault.(*Vault)
+		if !ok {
+			object = new(Vault)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVault))
+			}
+		}
+	} else {
+		s, ok := maybeVault.(*[]*Vault)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVault))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`root_keys`),
+		qm.WhereIn(`root_keys.vault_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load root_keys")
+	}
+
+	var resultSlice []*RootKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice root_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on root_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for root_keys")
+	}
+
+	if singular {
+		object.R.RootKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rootKeyR{}
+			}
+			foreign.R.Vault = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.VaultID {
+				local.R.RootKeys = append(local.R.RootKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &rootKeyR{}
+				}
+				foreign.R.Vault = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSigningRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultL) LoadSigningRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+	var slice []*Vault
+	var object *Vault
+
+	if singular {
+		var ok bool
+		object, ok = maybeVault.(*Vault)
+		if !ok {
+			object = new(Vault)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVault))
+			}
+		}
+	} else {
+		s, ok := maybeVault.(*[]*Vault)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVault))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`signing_requests`),
+		qm.WhereIn(`signing_requests.vault_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load signing_requests")
+	}
+
+	var resultSlice []*SigningRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice signing_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on signing_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for signing_requests")
+	}
+
+	if singular {
+		object.R.SigningRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &signingRequestR{}
+			}
+			foreign.R.Vault = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.VaultID) {
+				local.R.SigningRequests = append(local.R.SigningRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &signingRequestR{}
+				}
+				foreign.R.Vault = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSpendingLimits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vaultL) LoadSpendingLimits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+	var slice []*Vault
+	var object *Vault
+
+	if singular {
+		var ok bool
+		object, ok = maybeVault.(*Vault)
+		if !ok {
+			object = new(Vault)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVault))
+			}
+		}
+	} else {
+		s, ok := maybeVault.(*[]*Vault)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVault)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVault))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &vaultR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vaultR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`spending_limits`),
+		qm.WhereIn(`spending_limits.vault_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load spending_limits")
+	}
+
+	var resultSlice []*SpendingLimit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice spending_limits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on spending_limits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for spending_limits")
+	}
+
+	if singular {
+		object.R.SpendingLimits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &spendingLimitR{}
+			}
+			foreign.R.Vault = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.VaultID) {
+				local.R.SpendingLimits = append(local.R.SpendingLimits, foreign)
 				if foreign.R == nil {
-					foreign.R = &signingRequestR{}
+					foreign.R = &spendingLimitR{}
 				}
 				foreign.R.Vault = local
 				break
@@ -585,9 +1301,9 @@ func (vaultL) LoadSigningRequests(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadSpendingLimits allows an eager lookup of values, cached into the
+// LoadVaultKeys allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (vaultL) LoadSpendingLimits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+func (vaultL) LoadVaultKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
 	var slice []*Vault
 	var object *Vault
 
@@ -640,8 +1356,8 @@ func (vaultL) LoadSpendingLimits(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`spending_limits`),
-		qm.WhereIn(`spending_limits.vault_id in ?`, argsSlice...),
+		qm.From(`vault_keys`),
+		qm.WhereIn(`vault_keys.vault_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -649,26 +1365,26 @@ func (vaultL) LoadSpendingLimits(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load spending_limits")
+		return errors.Wrap(err, "failed to eager load vault_keys")
 	}
 
-	var resultSlice []*SpendingLimit
+	var resultSlice []*VaultKey
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice spending_limits")
+		return errors.Wrap(err, "failed to bind eager loaded slice vault_keys")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on spending_limits")
+		return errors.Wrap(err, "failed to close results in eager load on vault_keys")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for spending_limits")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_keys")
 	}
 
 	if singular {
-		object.R.SpendingLimits = resultSlice
+		object.R.VaultKeys = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &spendingLimitR{}
+				foreign.R = &vaultKeyR{}
 			}
 			foreign.R.Vault = object
 		}
@@ -678,9 +1394,9 @@ func (vaultL) LoadSpendingLimits(ctx context.Context, e boil.ContextExecutor, si
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if queries.Equal(local.ID, foreign.VaultID) {
-				local.R.SpendingLimits = append(local.R.SpendingLimits, foreign)
+				local.R.VaultKeys = append(local.R.VaultKeys, foreign)
 				if foreign.R == nil {
-					foreign.R = &spendingLimitR{}
+					foreign.R = &vaultKeyR{}
 				}
 				foreign.R.Vault = local
 				break
@@ -691,9 +1407,9 @@ func (vaultL) LoadSpendingLimits(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadVaultKeys allows an eager lookup of values, cached into the
+// LoadVaultProposals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (vaultL) LoadVaultKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
+func (vaultL) LoadVaultProposals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVault interface{}, mods queries.Applicator) error {
 	var slice []*Vault
 	var object *Vault
 
@@ -746,8 +1462,8 @@ func (vaultL) LoadVaultKeys(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`vault_keys`),
-		qm.WhereIn(`vault_keys.vault_id in ?`, argsSlice...),
+		qm.From(`vault_proposals`),
+		qm.WhereIn(`vault_proposals.vault_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -755,26 +1471,26 @@ func (vaultL) LoadVaultKeys(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load vault_keys")
+		return errors.Wrap(err, "failed to eager load vault_proposals")
 	}
 
-	var resultSlice []*VaultKey
+	var resultSlice []*VaultProposal
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice vault_keys")
+		return errors.Wrap(err, "failed to bind eager loaded slice vault_proposals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on vault_keys")
+		return errors.Wrap(err, "failed to close results in eager load on vault_proposals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_keys")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vault_proposals")
 	}
 
 	if singular {
-		object.R.VaultKeys = resultSlice
+		object.R.VaultProposals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &vaultKeyR{}
+				foreign.R = &vaultProposalR{}
 			}
 			foreign.R.Vault = object
 		}
@@ -783,10 +1499,10 @@ func (vaultL) LoadVaultKeys(ctx context.Context, e boil.ContextExecutor, singula
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.VaultID) {
-				local.R.VaultKeys = append(local.R.VaultKeys, foreign)
+			if local.ID == foreign.VaultID {
+				local.R.VaultProposals = append(local.R.VaultProposals, foreign)
 				if foreign.R == nil {
-					foreign.R = &vaultKeyR{}
+					foreign.R = &vaultProposalR{}
 				}
 				foreign.R.Vault = local
 				break
@@ -983,6 +1699,218 @@ func (o *Vault) RemoveOrganization(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
+// AddKeyBackupHealths adds the given related objects to the existing relationships
+// of the vault, optionally inserting them as new records.
+// Appends related to o.R.KeyBackupHealths.
+// Sets related.R.Vault appropriately.
+func (o *Vault) AddKeyBackupHealths(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyBackupHealth) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VaultID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_backup_health\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyBackupHealthPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.KeyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VaultID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultR{
+			KeyBackupHealths: related,
+		}
+	} else {
+		o.R.KeyBackupHealths = append(o.R.KeyBackupHealths, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyBackupHealthR{
+				Vault: o,
+			}
+		} else {
+			rel.R.Vault = o
+		}
+	}
+	return nil
+}
+
+// AddKeyRefreshes adds the given related objects to the existing relationships
+// of the vault, optionally inserting them as new records.
+// Appends related to o.R.KeyRefreshes.
+// Sets related.R.Vault appropriately.
+func (o *Vault) AddKeyRefreshes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyRefresh) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VaultID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_refreshes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyRefreshPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VaultID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultR{
+			KeyRefreshes: related,
+		}
+	} else {
+		o.R.KeyRefreshes = append(o.R.KeyRefreshes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyRefreshR{
+				Vault: o,
+			}
+		} else {
+			rel.R.Vault = o
+		}
+	}
+	return nil
+}
+
+// AddKeyShareRecoveries adds the given related objects to the existing relationships
+// of the vault, optionally inserting them as new records.
+// Appends related to o.R.KeyShareRecoveries.
+// Sets related.R.Vault appropriately.
+func (o *Vault) AddKeyShareRecoveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*KeyShareRecovery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VaultID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"key_share_recoveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+				strmangle.WhereClause("\"", "\"", 2, keyShareRecoveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VaultID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultR{
+			KeyShareRecoveries: related,
+		}
+	} else {
+		o.R.KeyShareRecoveries = append(o.R.KeyShareRecoveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &keyShareRecoveryR{
+				Vault: o,
+			}
+		} else {
+			rel.R.Vault = o
+		}
+	}
+	return nil
+}
+
+// AddRootKeys adds the given related objects to the existing relationships
+// of the vault, optionally inserting them as new records.
+// Appends related to o.R.RootKeys.
+// Sets related.R.Vault appropriately.
+func (o *Vault) AddRootKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RootKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VaultID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"root_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rootKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.KeyID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VaultID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultR{
+			RootKeys: related,
+		}
+	} else {
+		o.R.RootKeys = append(o.R.RootKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rootKeyR{
+				Vault: o,
+			}
+		} else {
+			rel.R.Vault = o
+		}
+	}
+	return nil
+}
+
 // AddSigningRequests adds the given related objects to the existing relationships
 // of the vault, optionally inserting them as new records.
 // Appends related to o.R.SigningRequests.
@@ -1364,6 +2292,59 @@ func (o *Vault) RemoveVaultKeys(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddVaultProposals adds the given related objects to the existing relationships
+// of the vault, optionally inserting them as new records.
+// Appends related to o.R.VaultProposals.
+// Sets related.R.Vault appropriately.
+func (o *Vault) AddVaultProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VaultProposal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.VaultID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"vault_proposals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"vault_id"}),
+				strmangle.WhereClause("\"", "\"", 2, vaultProposalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.VaultID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vaultR{
+			VaultProposals: related,
+		}
+	} else {
+		o.R.VaultProposals = append(o.R.VaultProposals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &vaultProposalR{
+				Vault: o,
+			}
+		} else {
+			rel.R.Vault = o
+		}
+	}
+	return nil
+}
+
 // AddWallets adds the given related objects to the existing relationships
 // of the vault, optionally inserting them as new records.
 // Appends related to o.R.Wallets.
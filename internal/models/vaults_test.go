@@ -353,6 +353,318 @@ func testVaultsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testVaultToManyKeyBackupHealths(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c KeyBackupHealth
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, keyBackupHealthDBTypes, false, keyBackupHealthColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VaultID = a.ID
+	c.VaultID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.KeyBackupHealths().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VaultID == b.VaultID {
+			bFound = true
+		}
+		if v.VaultID == c.VaultID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultSlice{&a}
+	if err = a.L.LoadKeyBackupHealths(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyBackupHealths); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.KeyBackupHealths = nil
+	if err = a.L.LoadKeyBackupHealths(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyBackupHealths); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultToManyKeyRefreshes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c KeyRefresh
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, keyRefreshDBTypes, false, keyRefreshColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VaultID = a.ID
+	c.VaultID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.KeyRefreshes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VaultID == b.VaultID {
+			bFound = true
+		}
+		if v.VaultID == c.VaultID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultSlice{&a}
+	if err = a.L.LoadKeyRefreshes(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyRefreshes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.KeyRefreshes = nil
+	if err = a.L.LoadKeyRefreshes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyRefreshes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultToManyKeyShareRecoveries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c KeyShareRecovery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, keyShareRecoveryDBTypes, false, keyShareRecoveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VaultID = a.ID
+	c.VaultID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.KeyShareRecoveries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VaultID == b.VaultID {
+			bFound = true
+		}
+		if v.VaultID == c.VaultID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultSlice{&a}
+	if err = a.L.LoadKeyShareRecoveries(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyShareRecoveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.KeyShareRecoveries = nil
+	if err = a.L.LoadKeyShareRecoveries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.KeyShareRecoveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultToManyRootKeys(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c RootKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rootKeyDBTypes, false, rootKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VaultID = a.ID
+	c.VaultID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RootKeys().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VaultID == b.VaultID {
+			bFound = true
+		}
+		if v.VaultID == c.VaultID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultSlice{&a}
+	if err = a.L.LoadRootKeys(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RootKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RootKeys = nil
+	if err = a.L.LoadRootKeys(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RootKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testVaultToManySigningRequests(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -494,46 +806,425 @@ func testVaultToManySpendingLimits(t *testing.T) {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.SpendingLimits = nil
-	if err = a.L.LoadSpendingLimits(ctx, tx, true, &a, nil); err != nil {
-		t.Fatal(err)
-	}
-	if got := len(a.R.SpendingLimits); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
-	}
+	a.R.SpendingLimits = nil
+	if err = a.L.LoadSpendingLimits(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SpendingLimits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultToManyVaultKeys(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c VaultKey
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, vaultKeyDBTypes, false, vaultKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultKeyDBTypes, false, vaultKeyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.VaultID, a.ID)
+	queries.Assign(&c.VaultID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.VaultKeys().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.VaultID, b.VaultID) {
+			bFound = true
+		}
+		if queries.Equal(v.VaultID, c.VaultID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultSlice{&a}
+	if err = a.L.LoadVaultKeys(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.VaultKeys = nil
+	if err = a.L.LoadVaultKeys(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultKeys); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultToManyVaultProposals(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, vaultProposalDBTypes, false, vaultProposalColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.VaultID = a.ID
+	c.VaultID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.VaultProposals().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.VaultID == b.VaultID {
+			bFound = true
+		}
+		if v.VaultID == c.VaultID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultSlice{&a}
+	if err = a.L.LoadVaultProposals(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultProposals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.VaultProposals = nil
+	if err = a.L.LoadVaultProposals(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.VaultProposals); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultToManyWallets(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c Wallet
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Vault struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, walletDBTypes, false, walletColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, walletDBTypes, false, walletColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.VaultID, a.ID)
+	queries.Assign(&c.VaultID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Wallets().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.VaultID, b.VaultID) {
+			bFound = true
+		}
+		if queries.Equal(v.VaultID, c.VaultID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := VaultSlice{&a}
+	if err = a.L.LoadWallets(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Wallets); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Wallets = nil
+	if err = a.L.LoadWallets(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Wallets); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testVaultToManyAddOpKeyBackupHealths(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c, d, e KeyBackupHealth
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyBackupHealth{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyBackupHealthDBTypes, false, strmangle.SetComplement(keyBackupHealthPrimaryKeyColumns, keyBackupHealthColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyBackupHealth{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKeyBackupHealths(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.VaultID {
+			t.Error("foreign key was wrong value", a.ID, first.VaultID)
+		}
+		if a.ID != second.VaultID {
+			t.Error("foreign key was wrong value", a.ID, second.VaultID)
+		}
+
+		if first.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.KeyBackupHealths[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KeyBackupHealths[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.KeyBackupHealths().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testVaultToManyAddOpKeyRefreshes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c, d, e KeyRefresh
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*KeyRefresh{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyRefreshDBTypes, false, strmangle.SetComplement(keyRefreshPrimaryKeyColumns, keyRefreshColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*KeyRefresh{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKeyRefreshes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.VaultID {
+			t.Error("foreign key was wrong value", a.ID, first.VaultID)
+		}
+		if a.ID != second.VaultID {
+			t.Error("foreign key was wrong value", a.ID, second.VaultID)
+		}
+
+		if first.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.KeyRefreshes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KeyRefreshes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+		count, err := a.KeyRefreshes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testVaultToManyVaultKeys(t *testing.T) {
+func testVaultToManyAddOpKeyShareRecoveries(t *testing.T) {
 	var err error
+
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Vault
-	var b, c VaultKey
+	var b, c, d, e KeyShareRecovery
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Vault struct: %s", err)
-	}
-
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &a, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-
-	if err = randomize.Struct(seed, &b, vaultKeyDBTypes, false, vaultKeyColumnsWithDefault...); err != nil {
-		t.Fatal(err)
+	foreigners := []*KeyShareRecovery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, keyShareRecoveryDBTypes, false, strmangle.SetComplement(keyShareRecoveryPrimaryKeyColumns, keyShareRecoveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
-	if err = randomize.Struct(seed, &c, vaultKeyDBTypes, false, vaultKeyColumnsWithDefault...); err != nil {
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	queries.Assign(&b.VaultID, a.ID)
-	queries.Assign(&c.VaultID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -541,76 +1232,74 @@ func testVaultToManyVaultKeys(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.VaultKeys().All(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*KeyShareRecovery{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	bFound, cFound := false, false
-	for _, v := range check {
-		if queries.Equal(v.VaultID, b.VaultID) {
-			bFound = true
-		}
-		if queries.Equal(v.VaultID, c.VaultID) {
-			cFound = true
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddKeyShareRecoveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
 		}
-	}
 
-	if !bFound {
-		t.Error("expected to find b")
-	}
-	if !cFound {
-		t.Error("expected to find c")
-	}
+		first := x[0]
+		second := x[1]
 
-	slice := VaultSlice{&a}
-	if err = a.L.LoadVaultKeys(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if got := len(a.R.VaultKeys); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
-	}
+		if a.ID != first.VaultID {
+			t.Error("foreign key was wrong value", a.ID, first.VaultID)
+		}
+		if a.ID != second.VaultID {
+			t.Error("foreign key was wrong value", a.ID, second.VaultID)
+		}
 
-	a.R.VaultKeys = nil
-	if err = a.L.LoadVaultKeys(ctx, tx, true, &a, nil); err != nil {
-		t.Fatal(err)
-	}
-	if got := len(a.R.VaultKeys); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
-	}
+		if first.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+		if a.R.KeyShareRecoveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.KeyShareRecoveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.KeyShareRecoveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testVaultToManyWallets(t *testing.T) {
+func testVaultToManyAddOpRootKeys(t *testing.T) {
 	var err error
+
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Vault
-	var b, c Wallet
+	var b, c, d, e RootKey
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, vaultDBTypes, true, vaultColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Vault struct: %s", err)
-	}
-
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &a, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-
-	if err = randomize.Struct(seed, &b, walletDBTypes, false, walletColumnsWithDefault...); err != nil {
-		t.Fatal(err)
+	foreigners := []*RootKey{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rootKeyDBTypes, false, strmangle.SetComplement(rootKeyPrimaryKeyColumns, rootKeyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
-	if err = randomize.Struct(seed, &c, walletDBTypes, false, walletColumnsWithDefault...); err != nil {
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	queries.Assign(&b.VaultID, a.ID)
-	queries.Assign(&c.VaultID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -618,49 +1307,50 @@ func testVaultToManyWallets(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Wallets().All(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*RootKey{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	bFound, cFound := false, false
-	for _, v := range check {
-		if queries.Equal(v.VaultID, b.VaultID) {
-			bFound = true
-		}
-		if queries.Equal(v.VaultID, c.VaultID) {
-			cFound = true
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRootKeys(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
 		}
-	}
 
-	if !bFound {
-		t.Error("expected to find b")
-	}
-	if !cFound {
-		t.Error("expected to find c")
-	}
+		first := x[0]
+		second := x[1]
 
-	slice := VaultSlice{&a}
-	if err = a.L.LoadWallets(ctx, tx, false, (*[]*Vault)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if got := len(a.R.Wallets); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
-	}
+		if a.ID != first.VaultID {
+			t.Error("foreign key was wrong value", a.ID, first.VaultID)
+		}
+		if a.ID != second.VaultID {
+			t.Error("foreign key was wrong value", a.ID, second.VaultID)
+		}
 
-	a.R.Wallets = nil
-	if err = a.L.LoadWallets(ctx, tx, true, &a, nil); err != nil {
-		t.Fatal(err)
-	}
-	if got := len(a.R.Wallets); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
-	}
+		if first.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+		if a.R.RootKeys[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RootKeys[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RootKeys().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
 func testVaultToManyAddOpSigningRequests(t *testing.T) {
 	var err error
 
@@ -1414,6 +2104,81 @@ func testVaultToManyRemoveOpVaultKeys(t *testing.T) {
 	}
 }
 
+func testVaultToManyAddOpVaultProposals(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Vault
+	var b, c, d, e VaultProposal
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, vaultDBTypes, false, strmangle.SetComplement(vaultPrimaryKeyColumns, vaultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*VaultProposal{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, vaultProposalDBTypes, false, strmangle.SetComplement(vaultProposalPrimaryKeyColumns, vaultProposalColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*VaultProposal{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddVaultProposals(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.VaultID {
+			t.Error("foreign key was wrong value", a.ID, first.VaultID)
+		}
+		if a.ID != second.VaultID {
+			t.Error("foreign key was wrong value", a.ID, second.VaultID)
+		}
+
+		if first.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Vault != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.VaultProposals[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.VaultProposals[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.VaultProposals().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testVaultToManyAddOpWallets(t *testing.T) {
 	var err error
 
@@ -1900,7 +2665,7 @@ func testVaultsSelect(t *testing.T) {
 }
 
 var (
-	vaultDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `Name`: `character varying`, `Threshold`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	vaultDBTypes = map[string]string{`ID`: `uuid`, `OrganizationID`: `uuid`, `Name`: `character varying`, `Threshold`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Status`: `character varying`, `ArchivedAt`: `timestamp with time zone`, `WhitelistOnly`: `boolean`}
 	_            = bytes.MinRead
 )
 
@@ -110,11 +110,13 @@ var WalletWhere = struct {
 var WalletRels = struct {
 	Chain           string
 	Vault           string
+	Deposits        string
 	SigningRequests string
 	WalletBalances  string
 }{
 	Chain:           "Chain",
 	Vault:           "Vault",
+	Deposits:        "Deposits",
 	SigningRequests: "SigningRequests",
 	WalletBalances:  "WalletBalances",
 }
@@ -123,6 +125,7 @@ var WalletRels = struct {
 type walletR struct {
 	Chain           *Chain              `boil:"Chain" json:"Chain" toml:"Chain" yaml:"Chain"`
 	Vault           *Vault              `boil:"Vault" json:"Vault" toml:"Vault" yaml:"Vault"`
+	Deposits        DepositSlice        `boil:"Deposits" json:"Deposits" toml:"Deposits" yaml:"Deposits"`
 	SigningRequests SigningRequestSlice `boil:"SigningRequests" json:"SigningRequests" toml:"SigningRequests" yaml:"SigningRequests"`
 	WalletBalances  WalletBalanceSlice  `boil:"WalletBalances" json:"WalletBalances" toml:"WalletBalances" yaml:"WalletBalances"`
 }
@@ -164,6 +167,22 @@ func (r *walletR) GetVault() *Vault {
 	return r.Vault
 }
 
+func (o *Wallet) GetDeposits() DepositSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDeposits()
+}
+
+func (r *walletR) GetDeposits() DepositSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Deposits
+}
+
 func (o *Wallet) GetSigningRequests() SigningRequestSlice {
 	if o == nil {
 		return nil
@@ -320,6 +339,20 @@ func (o *Wallet) Vault(mods ...qm.QueryMod) vaultQuery {
 	return Vaults(queryMods...)
 }
 
+// Deposits retrieves all the deposit's Deposits with an executor.
+func (o *Wallet) Deposits(mods ...qm.QueryMod) depositQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deposits\".\"wallet_id\"=?", o.ID),
+	)
+
+	return Deposits(queryMods...)
+}
+
 // SigningRequests retrieves all the signing_request's SigningRequests with an executor.
 func (o *Wallet) SigningRequests(mods ...qm.QueryMod) signingRequestQuery {
 	var queryMods []qm.QueryMod
@@ -580,6 +613,112 @@ func (walletL) LoadVault(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
+// LoadDeposits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (walletL) LoadDeposits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWallet interface{}, mods queries.Applicator) error {
+	var slice []*Wallet
+	var object *Wallet
+
+	if singular {
+		var ok bool
+		object, ok = maybeWallet.(*Wallet)
+		if !ok {
+			object = new(Wallet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWallet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWallet))
+			}
+		}
+	} else {
+		s, ok := maybeWallet.(*[]*Wallet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWallet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWallet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &walletR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`deposits`),
+		qm.WhereIn(`deposits.wallet_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deposits")
+	}
+
+	var resultSlice []*Deposit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deposits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deposits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposits")
+	}
+
+	if singular {
+		object.R.Deposits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &depositR{}
+			}
+			foreign.R.Wallet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WalletID {
+				local.R.Deposits = append(local.R.Deposits, foreign)
+				if foreign.R == nil {
+					foreign.R = &depositR{}
+				}
+				foreign.R.Wallet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSigningRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (walletL) LoadSigningRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWallet interface{}, mods queries.Applicator) error {
@@ -952,6 +1091,59 @@ func (o *Wallet) RemoveVault(ctx context.Context, exec boil.ContextExecutor, rel
 	return nil
 }
 
+// AddDeposits adds the given related objects to the existing relationships
+// of the wallet, optionally inserting them as new records.
+// Appends related to o.R.Deposits.
+// Sets related.R.Wallet appropriately.
+func (o *Wallet) AddDeposits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Deposit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WalletID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deposits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"wallet_id"}),
+				strmangle.WhereClause("\"", "\"", 2, depositPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WalletID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &walletR{
+			Deposits: related,
+		}
+	} else {
+		o.R.Deposits = append(o.R.Deposits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &depositR{
+				Wallet: o,
+			}
+		} else {
+			rel.R.Wallet = o
+		}
+	}
+	return nil
+}
+
 // AddSigningRequests adds the given related objects to the existing relationships
 // of the wallet, optionally inserting them as new records.
 // Appends related to o.R.SigningRequests.
@@ -353,6 +353,84 @@ func testWalletsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testWalletToManyDeposits(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Wallet
+	var b, c Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, walletDBTypes, true, walletColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Wallet struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, depositDBTypes, false, depositColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.WalletID = a.ID
+	c.WalletID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Deposits().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.WalletID == b.WalletID {
+			bFound = true
+		}
+		if v.WalletID == c.WalletID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := WalletSlice{&a}
+	if err = a.L.LoadDeposits(ctx, tx, false, (*[]*Wallet)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Deposits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Deposits = nil
+	if err = a.L.LoadDeposits(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Deposits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testWalletToManySigningRequests(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -507,6 +585,81 @@ func testWalletToManyWalletBalances(t *testing.T) {
 	}
 }
 
+func testWalletToManyAddOpDeposits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Wallet
+	var b, c, d, e Deposit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, walletDBTypes, false, strmangle.SetComplement(walletPrimaryKeyColumns, walletColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Deposit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, depositDBTypes, false, strmangle.SetComplement(depositPrimaryKeyColumns, depositColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Deposit{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddDeposits(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.WalletID {
+			t.Error("foreign key was wrong value", a.ID, first.WalletID)
+		}
+		if a.ID != second.WalletID {
+			t.Error("foreign key was wrong value", a.ID, second.WalletID)
+		}
+
+		if first.R.Wallet != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Wallet != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Deposits[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Deposits[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Deposits().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testWalletToManyAddOpSigningRequests(t *testing.T) {
 	var err error
 
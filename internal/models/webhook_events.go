@@ -0,0 +1,764 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebhookEvent is an object representing the database table.
+type WebhookEvent struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Provider    string      `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	EventID     string      `boil:"event_id" json:"event_id" toml:"event_id" yaml:"event_id"`
+	EventType   string      `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	Payload     types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status      string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts    int         `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError   null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	ProcessedAt null.Time   `boil:"processed_at" json:"processed_at,omitempty" toml:"processed_at" yaml:"processed_at,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *webhookEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookEventColumns = struct {
+	ID          string
+	Provider    string
+	EventID     string
+	EventType   string
+	Payload     string
+	Status      string
+	Attempts    string
+	LastError   string
+	ProcessedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	Provider:    "provider",
+	EventID:     "event_id",
+	EventType:   "event_type",
+	Payload:     "payload",
+	Status:      "status",
+	Attempts:    "attempts",
+	LastError:   "last_error",
+	ProcessedAt: "processed_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var WebhookEventTableColumns = struct {
+	ID          string
+	Provider    string
+	EventID     string
+	EventType   string
+	Payload     string
+	Status      string
+	Attempts    string
+	LastError   string
+	ProcessedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "webhook_events.id",
+	Provider:    "webhook_events.provider",
+	EventID:     "webhook_events.event_id",
+	EventType:   "webhook_events.event_type",
+	Payload:     "webhook_events.payload",
+	Status:      "webhook_events.status",
+	Attempts:    "webhook_events.attempts",
+	LastError:   "webhook_events.last_error",
+	ProcessedAt: "webhook_events.processed_at",
+	CreatedAt:   "webhook_events.created_at",
+	UpdatedAt:   "webhook_events.updated_at",
+}
+
+// Generated where
+
+var WebhookEventWhere = struct {
+	ID          whereHelperstring
+	Provider    whereHelperstring
+	EventID     whereHelperstring
+	EventType   whereHelperstring
+	Payload     whereHelpertypes_JSON
+	Status      whereHelperstring
+	Attempts    whereHelperint
+	LastError   whereHelpernull_String
+	ProcessedAt whereHelpernull_Time
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"webhook_events\".\"id\""},
+	Provider:    whereHelperstring{field: "\"webhook_events\".\"provider\""},
+	EventID:     whereHelperstring{field: "\"webhook_events\".\"event_id\""},
+	EventType:   whereHelperstring{field: "\"webhook_events\".\"event_type\""},
+	Payload:     whereHelpertypes_JSON{field: "\"webhook_events\".\"payload\""},
+	Status:      whereHelperstring{field: "\"webhook_events\".\"status\""},
+	Attempts:    whereHelperint{field: "\"webhook_events\".\"attempts\""},
+	LastError:   whereHelpernull_String{field: "\"webhook_events\".\"last_error\""},
+	ProcessedAt: whereHelpernull_Time{field: "\"webhook_events\".\"processed_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"webhook_events\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"webhook_events\".\"updated_at\""},
+}
+
+// WebhookEventRels is where relationship names are stored.
+var WebhookEventRels = struct {
+}{}
+
+// webhookEventR is where relationships are stored.
+type webhookEventR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookEventR) NewStruct() *webhookEventR {
+	return &webhookEventR{}
+}
+
+// webhookEventL is where Load methods for each relationship are stored.
+type webhookEventL struct{}
+
+var (
+	webhookEventAllColumns            = []string{"id", "provider", "event_id", "event_type", "payload", "status", "attempts", "last_error", "processed_at", "created_at", "updated_at"}
+	webhookEventColumnsWithoutDefault = []string{"provider", "event_id", "event_type", "payload"}
+	webhookEventColumnsWithDefault    = []string{"id", "status", "attempts", "last_error", "processed_at", "created_at", "updated_at"}
+	webhookEventPrimaryKeyColumns     = []string{"id"}
+	webhookEventGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookEventSlice is an alias for a slice of pointers to WebhookEvent.
+	// This should almost always be used instead of []WebhookEvent.
+	WebhookEventSlice []*WebhookEvent
+
+	webhookEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookEventType                 = reflect.TypeOf(&WebhookEvent{})
+	webhookEventMapping              = queries.MakeStructMapping(webhookEventType)
+	webhookEventPrimaryKeyMapping, _ = queries.BindMapping(webhookEventType, webhookEventMapping, webhookEventPrimaryKeyColumns)
+	webhookEventInsertCacheMut       sync.RWMutex
+	webhookEventInsertCache          = make(map[string]insertCache)
+	webhookEventUpdateCacheMut       sync.RWMutex
+	webhookEventUpdateCache          = make(map[string]updateCache)
+	webhookEventUpsertCacheMut       sync.RWMutex
+	webhookEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single webhookEvent record from the query.
+func (q webhookEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookEvent, error) {
+	o := &WebhookEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webhook_events")
+	}
+
+	return o, nil
+}
+
+// All returns all WebhookEvent records from the query.
+func (q webhookEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookEventSlice, error) {
+	var o []*WebhookEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebhookEvent slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebhookEvent records in the query.
+func (q webhookEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webhook_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webhook_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// WebhookEvents retrieves all the records using an executor.
+func WebhookEvents(mods ...qm.QueryMod) webhookEventQuery {
+	mods = append(mods, qm.From("\"webhook_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_events\".*"})
+	}
+
+	return webhookEventQuery{q}
+}
+
+// FindWebhookEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookEvent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WebhookEvent, error) {
+	webhookEventObj := &WebhookEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_events\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webhookEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webhook_events")
+	}
+
+	return webhookEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webhook_events provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookEventInsertCacheMut.RLock()
+	cache, cached := webhookEventInsertCache[key]
+	webhookEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookEventAllColumns,
+			webhookEventColumnsWithDefault,
+			webhookEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webhook_events")
+	}
+
+	if !cached {
+		webhookEventInsertCacheMut.Lock()
+		webhookEventInsertCache[key] = cache
+		webhookEventInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the WebhookEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	webhookEventUpdateCacheMut.RLock()
+	cache, cached := webhookEventUpdateCache[key]
+	webhookEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookEventAllColumns,
+			webhookEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webhook_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, append(wl, webhookEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webhook_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webhook_events")
+	}
+
+	if !cached {
+		webhookEventUpdateCacheMut.Lock()
+		webhookEventUpdateCache[key] = cache
+		webhookEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webhook_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webhook_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webhookEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webhookEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no webhook_events provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookEventUpsertCacheMut.RLock()
+	cache, cached := webhookEventUpsertCache[key]
+	webhookEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookEventAllColumns,
+			webhookEventColumnsWithDefault,
+			webhookEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookEventAllColumns,
+			webhookEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert webhook_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webhookEventPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert webhook_events, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webhookEventPrimaryKeyColumns))
+			copy(conflict, webhookEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_events\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert webhook_events")
+	}
+
+	if !cached {
+		webhookEventUpsertCacheMut.Lock()
+		webhookEventUpsertCache[key] = cache
+		webhookEventUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single WebhookEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebhookEvent provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"webhook_events\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webhook_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webhook_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webhookEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webhook_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webhook_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webhook_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webhookEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webhook_events")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_events\".* FROM \"webhook_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebhookEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookEventExists checks if the WebhookEvent row exists.
+func WebhookEventExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_events\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webhook_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookEvent row exists.
+func (o *WebhookEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookEventExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kashguard/go-mpc-vault/internal/push"
+)
+
+const (
+	APNSProductionEndpoint  = "https://api.push.apple.com"
+	APNSDevelopmentEndpoint = "https://api.sandbox.push.apple.com"
+
+	// apnsTokenLifetime is the time provider tokens are reused for, APNs rejects tokens older than an hour.
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+// APNs reasons for rejecting the device token, the token should not be used anymore.
+// https://developer.apple.com/documentation/usernotifications/handling-notification-responses-from-apns
+const (
+	APNSReasonUnregistered   = "Unregistered"
+	APNSReasonBadDeviceToken = "BadDeviceToken"
+)
+
+type APNS struct {
+	Config APNSConfig
+	client *http.Client
+	key    *ecdsa.PrivateKey
+
+	endpoint string
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+type APNSConfig struct {
+	// path to the p8 key of the token-based authentication
+	PrivateKeyPath string `json:"-"` // sensitive
+	KeyID          string
+	TeamID         string
+	// bundle ID of the app
+	Topic      string
+	Production bool
+	// overrides the endpoint derived from Production, e.g. for tests
+	Endpoint string
+	Timeout  time.Duration
+}
+
+type APNSOption func(p *APNS)
+
+// WithAPNSHTTPClient replaces the HTTP/2 client used to reach APNs.
+func WithAPNSHTTPClient(client *http.Client) APNSOption {
+	return func(p *APNS) {
+		p.client = client
+	}
+}
+
+func NewAPNS(config APNSConfig, opts ...APNSOption) (*APNS, error) {
+	raw, err := os.ReadFile(config.PrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read APNs key: %w", err)
+	}
+	key, err := parseAPNSKey(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := APNSDevelopmentEndpoint
+	if config.Production {
+		endpoint = APNSProductionEndpoint
+	}
+	if config.Endpoint != "" {
+		endpoint = strings.TrimRight(config.Endpoint, "/")
+	}
+
+	p := &APNS{
+		Config: config,
+		client: &http.Client{
+			Timeout: config.Timeout,
+			Transport: &http.Transport{
+				Proxy:             http.ProxyFromEnvironment,
+				ForceAttemptHTTP2: true,
+			},
+		},
+		key:      key,
+		endpoint: endpoint,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p, nil
+}
+
+func (p *APNS) GetProviderType() push.ProviderType {
+	return push.ProviderTypeAPN
+}
+
+func (p *APNS) Send(token string, message push.Message) push.ProviderSendResponse {
+	// https://developer.apple.com/documentation/usernotifications/sending-notification-requests-to-apns
+	body, err := json.Marshal(apnsPayload(message))
+	if err != nil {
+		return push.ProviderSendResponse{
+			Token: token,
+			Valid: true,
+			Err:   fmt.Errorf("failed to marshal APNs payload: %w", err),
+		}
+	}
+
+	authToken, err := p.providerToken()
+	if err != nil {
+		return push.ProviderSendResponse{
+			Token: token,
+			Valid: true,
+			Err:   err,
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.endpoint+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return push.ProviderSendResponse{
+			Token: token,
+			Valid: true,
+			Err:   fmt.Errorf("failed to create APNs request: %w", err),
+		}
+	}
+	req.Header.Set("Authorization", "bearer "+authToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("apns-topic", p.Config.Topic)
+	if message.Title == "" && message.Body == "" {
+		req.Header.Set("apns-push-type", "background")
+		req.Header.Set("apns-priority", "5")
+	} else {
+		req.Header.Set("apns-push-type", "alert")
+		req.Header.Set("apns-priority", "10")
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return push.ProviderSendResponse{
+			Token: token,
+			Valid: true,
+			Err:   fmt.Errorf("failed to send APNs request: %w", err),
+		}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return push.ProviderSendResponse{
+			Token: token,
+			Valid: true,
+		}
+	}
+
+	var apnsErr struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 4096)).Decode(&apnsErr); err != nil {
+		apnsErr.Reason = http.StatusText(res.StatusCode)
+	}
+
+	if res.StatusCode == http.StatusForbidden {
+		// The provider token might have been revoked or expired, a new one is issued with the next request.
+		p.mu.Lock()
+		p.token = ""
+		p.mu.Unlock()
+	}
+
+	// APNs responds 410 for tokens no longer active for the topic.
+	valid := res.StatusCode != http.StatusGone &&
+		apnsErr.Reason != APNSReasonUnregistered &&
+		apnsErr.Reason != APNSReasonBadDeviceToken
+
+	return push.ProviderSendResponse{
+		Token: token,
+		Valid: valid,
+		Err:   fmt.Errorf("APNs responded with status %d: %s", res.StatusCode, apnsErr.Reason),
+	}
+}
+
+func (p *APNS) SendMulticast(tokens []string, message push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, message)
+}
+
+// providerToken returns the JWT authenticating the provider, issuing a new one once the current one expired.
+func (p *APNS) providerToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if p.token != "" && now.Sub(p.issuedAt) < apnsTokenLifetime {
+		return p.token, nil
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "ES256", "kid": p.Config.KeyID})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal APNs token header: %w", err)
+	}
+	claims, err := json.Marshal(map[string]interface{}{"iss": p.Config.TeamID, "iat": now.Unix()})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal APNs token claims: %w", err)
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, p.key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign APNs token: %w", err)
+	}
+
+	// ES256 signatures are the concatenation of r and s, each padded to 32 bytes.
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	p.token = unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+	p.issuedAt = now
+	return p.token, nil
+}
+
+// apnsPayload builds the payload of the notification, custom data is added next to the aps dictionary.
+func apnsPayload(message push.Message) map[string]interface{} {
+	aps := make(map[string]interface{})
+	if message.Title != "" || message.Body != "" {
+		aps["alert"] = map[string]string{
+			"title": message.Title,
+			"body":  message.Body,
+		}
+		aps["sound"] = "default"
+		if message.TimeSensitive {
+			aps["interruption-level"] = "time-sensitive"
+		}
+	} else {
+		aps["content-available"] = 1
+	}
+	if message.Badge != nil {
+		aps["badge"] = *message.Badge
+	}
+
+	payload := map[string]interface{}{}
+	for key, value := range message.Data {
+		payload[key] = value
+	}
+	payload["aps"] = aps
+
+	return payload
+}
+
+func parseAPNSKey(raw []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("failed to decode APNs key: no PEM block found")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse APNs key: %w", err)
+	}
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok || ecdsaKey.Curve.Params().BitSize != 256 {
+		return nil, errors.New("failed to parse APNs key: not a P-256 key")
+	}
+
+	return ecdsaKey, nil
+}
@@ -0,0 +1,208 @@
+package provider_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/push"
+	"github.com/kashguard/go-mpc-vault/internal/push/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAPNSKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "AuthKey_ABC123DEFG.p8")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	return key, path
+}
+
+func newAPNSServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewUnstartedServer(handler)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newAPNS(t *testing.T, srv *httptest.Server, keyPath string) *provider.APNS {
+	t.Helper()
+
+	p, err := provider.NewAPNS(provider.APNSConfig{
+		PrivateKeyPath: keyPath,
+		KeyID:          "ABC123DEFG",
+		TeamID:         "DEF123GHIJ",
+		Topic:          "com.example.signer",
+		Endpoint:       srv.URL,
+	}, provider.WithAPNSHTTPClient(srv.Client()))
+	require.NoError(t, err)
+
+	return p
+}
+
+func verifyProviderToken(t *testing.T, key *ecdsa.PublicKey, authorization string) {
+	t.Helper()
+
+	token := strings.TrimPrefix(authorization, "bearer ")
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"alg":"ES256","kid":"ABC123DEFG"}`, string(header))
+
+	claims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var c map[string]interface{}
+	require.NoError(t, json.Unmarshal(claims, &c))
+	assert.Equal(t, "DEF123GHIJ", c["iss"])
+	assert.NotZero(t, c["iat"])
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	require.Len(t, signature, 64)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.True(t, ecdsa.Verify(key, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])))
+}
+
+func TestAPNSSend(t *testing.T) {
+	key, keyPath := writeAPNSKey(t)
+
+	requests := 0
+	srv := newAPNSServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, 2, r.ProtoMajor)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/3/device/a1b2c3d4e5f6", r.URL.Path)
+		assert.Equal(t, "com.example.signer", r.Header.Get("apns-topic"))
+		assert.Equal(t, "alert", r.Header.Get("apns-push-type"))
+		assert.Equal(t, "10", r.Header.Get("apns-priority"))
+		verifyProviderToken(t, &key.PublicKey, r.Header.Get("Authorization"))
+
+		var payload map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "5f4c0a2e-6f39-4d3b-9a59-5b0d7f6b5f0e", payload["request_id"])
+
+		aps, ok := payload["aps"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"title": "Approval required", "body": "Transfer of 1.5 ETH awaits your approval"}, aps["alert"])
+		assert.Equal(t, "time-sensitive", aps["interruption-level"])
+		assert.InDelta(t, 3, aps["badge"], 0)
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	p := newAPNS(t, srv, keyPath)
+	assert.Equal(t, push.ProviderTypeAPN, p.GetProviderType())
+
+	badge := 3
+	message := push.Message{
+		Title:         "Approval required",
+		Body:          "Transfer of 1.5 ETH awaits your approval",
+		Data:          map[string]string{"request_id": "5f4c0a2e-6f39-4d3b-9a59-5b0d7f6b5f0e"},
+		Badge:         &badge,
+		TimeSensitive: true,
+	}
+
+	res := p.Send("a1b2c3d4e5f6", message)
+	require.NoError(t, res.Err)
+	assert.True(t, res.Valid)
+	assert.Equal(t, "a1b2c3d4e5f6", res.Token)
+
+	// The provider token is reused for subsequent requests.
+	res = p.Send("a1b2c3d4e5f6", message)
+	require.NoError(t, res.Err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestAPNSSendBackground(t *testing.T) {
+	_, keyPath := writeAPNSKey(t)
+
+	srv := newAPNSServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "background", r.Header.Get("apns-push-type"))
+		assert.Equal(t, "5", r.Header.Get("apns-priority"))
+
+		var payload map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]interface{}{"content-available": float64(1), "badge": float64(0)}, payload["aps"])
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	badge := 0
+	res := newAPNS(t, srv, keyPath).Send("a1b2c3d4e5f6", push.Message{Badge: &badge})
+	require.NoError(t, res.Err)
+	assert.True(t, res.Valid)
+}
+
+func TestAPNSSendInvalidTokens(t *testing.T) {
+	_, keyPath := writeAPNSKey(t)
+
+	srv := newAPNSServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, "/3/device/") {
+		case "unregistered":
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered","timestamp":1717243200000}`))
+		case "bad":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		case "unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"reason":"ServiceUnavailable"}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+
+	responses := newAPNS(t, srv, keyPath).SendMulticast([]string{"unregistered", "bad", "unavailable", "valid"}, push.Message{
+		Title: "Hello",
+		Body:  "World",
+	})
+	require.Len(t, responses, 4)
+
+	assert.False(t, responses[0].Valid)
+	assert.ErrorContains(t, responses[0].Err, "Unregistered")
+	assert.False(t, responses[1].Valid)
+	assert.ErrorContains(t, responses[1].Err, "BadDeviceToken")
+
+	// Other failures keep the token.
+	assert.True(t, responses[2].Valid)
+	assert.ErrorContains(t, responses[2].Err, "ServiceUnavailable")
+
+	assert.True(t, responses[3].Valid)
+	assert.NoError(t, responses[3].Err)
+}
+
+func TestNewAPNSInvalidKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "AuthKey.p8")
+	require.NoError(t, os.WriteFile(path, []byte("not a key"), 0o600))
+
+	_, err := provider.NewAPNS(provider.APNSConfig{PrivateKeyPath: path})
+	require.Error(t, err)
+
+	_, err = provider.NewAPNS(provider.APNSConfig{PrivateKeyPath: filepath.Join(t.TempDir(), "missing.p8")})
+	require.Error(t, err)
+}
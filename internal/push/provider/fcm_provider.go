@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -40,20 +41,55 @@ func (p *FCM) GetProviderType() push.ProviderType {
 	return push.ProviderTypeFCM
 }
 
-func (p *FCM) Send(token string, title string, message string) push.ProviderSendResponse {
+func (p *FCM) Send(token string, message push.Message) push.ProviderSendResponse {
 	// https: //godoc.org/google.golang.org/api/fcm/v1#SendMessageRequest
 	// https://firebase.google.com/docs/cloud-messaging/send-message#rest
 	messageRequest := &fcm.SendMessageRequest{
 		ValidateOnly: p.Config.ValidateOnly,
 		Message: &fcm.Message{
 			Token: token,
-			Notification: &fcm.Notification{
-				Title: title,
-				Body:  message,
-			},
+			Data:  message.Data,
 		},
 	}
 
+	if message.Title != "" || message.Body != "" {
+		messageRequest.Message.Notification = &fcm.Notification{
+			Title: message.Title,
+			Body:  message.Body,
+		}
+	}
+
+	if message.Badge != nil || message.TimeSensitive {
+		// Messages without notification only update the badge, APNs requires them to be flagged as background update.
+		aps := make(map[string]interface{})
+		if message.Badge != nil {
+			aps["badge"] = *message.Badge
+		}
+		if messageRequest.Message.Notification == nil {
+			aps["content-available"] = 1
+		} else if message.TimeSensitive {
+			aps["interruption-level"] = "time-sensitive"
+		}
+		payload, err := json.Marshal(map[string]interface{}{"aps": aps})
+		if err != nil {
+			return push.ProviderSendResponse{
+				Token: token,
+				Valid: true,
+				Err:   fmt.Errorf("failed to marshal apns payload: %w", err),
+			}
+		}
+		messageRequest.Message.Apns = &fcm.ApnsConfig{
+			Payload: payload,
+		}
+		if messageRequest.Message.Notification != nil && message.Badge != nil {
+			messageRequest.Message.Android = &fcm.AndroidConfig{
+				Notification: &fcm.AndroidNotification{
+					NotificationCount: int64(*message.Badge),
+				},
+			}
+		}
+	}
+
 	_, err := p.service.Projects.Messages.Send("projects/"+p.Config.ProjectID, messageRequest).Do()
 	valid := true
 	if err != nil {
@@ -71,6 +107,6 @@ func (p *FCM) Send(token string, title string, message string) push.ProviderSend
 	}
 }
 
-func (p *FCM) SendMulticast(tokens []string, title, message string) []push.ProviderSendResponse {
-	return sendMulticastWithProvider(p, tokens, title, message)
+func (p *FCM) SendMulticast(tokens []string, message push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, message)
 }
@@ -2,11 +2,11 @@ package provider
 
 import "github.com/kashguard/go-mpc-vault/internal/push"
 
-func sendMulticastWithProvider(p push.Provider, tokens []string, title, message string) []push.ProviderSendResponse {
+func sendMulticastWithProvider(p push.Provider, tokens []string, message push.Message) []push.ProviderSendResponse {
 	responseSlice := make([]push.ProviderSendResponse, 0)
 
 	for _, token := range tokens {
-		responseSlice = append(responseSlice, p.Send(token, title, message))
+		responseSlice = append(responseSlice, p.Send(token, message))
 	}
 
 	return responseSlice
@@ -25,7 +25,7 @@ const (
 	expectedTokenLength = 40
 )
 
-func (p *Mock) Send(token string, title string, message string) push.ProviderSendResponse {
+func (p *Mock) Send(token string, message push.Message) push.ProviderSendResponse {
 	valid := true
 	var err error
 	if len(token) < expectedTokenLength {
@@ -33,11 +33,18 @@ func (p *Mock) Send(token string, title string, message string) push.ProviderSen
 		err = errors.New("invalid token")
 	}
 
-	if title == "other error" {
+	if message.Title == "other error" {
 		err = errors.New("other error")
 	}
 
-	log.Info().Str("token", token).Str("title", title).Str("message", message).Msg("Mock Push Notification")
+	event := log.Info().Str("token", token).Str("title", message.Title).Str("message", message.Body)
+	for key, value := range message.Data {
+		event = event.Str("data_"+key, value)
+	}
+	if message.Badge != nil {
+		event = event.Int("badge", *message.Badge)
+	}
+	event.Msg("Mock Push Notification")
 
 	return push.ProviderSendResponse{
 		Token: token,
@@ -46,6 +53,6 @@ func (p *Mock) Send(token string, title string, message string) push.ProviderSen
 	}
 }
 
-func (p *Mock) SendMulticast(tokens []string, title, message string) []push.ProviderSendResponse {
-	return sendMulticastWithProvider(p, tokens, title, message)
+func (p *Mock) SendMulticast(tokens []string, message push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, message)
 }
@@ -35,9 +35,24 @@ type ProviderSendResponse struct {
 	Err error
 }
 
+// Message is a push notification. Messages without title and body are delivered silently, e.g. to update the badge.
+type Message struct {
+	Title string
+	Body  string
+
+	// custom data delivered along the notification, e.g. to deep link into the app
+	Data map[string]string
+
+	// badge count shown on the app icon, nil leaves the badge unchanged
+	Badge *int
+
+	// time sensitive notifications break through focus modes on iOS, e.g. for pending approvals
+	TimeSensitive bool
+}
+
 type Provider interface {
-	Send(token string, title string, message string) ProviderSendResponse
-	SendMulticast(tokens []string, title, message string) []ProviderSendResponse
+	Send(token string, message Message) ProviderSendResponse
+	SendMulticast(tokens []string, message Message) []ProviderSendResponse
 	GetProviderType() ProviderType
 }
 
@@ -61,6 +76,17 @@ func (s *Service) GetProviderCount() int {
 }
 
 func (s *Service) SendToUser(ctx context.Context, user *dto.User, title string, message string) error {
+	return s.SendLocalizedToUser(ctx, user.ID, func(_ string) Message {
+		return Message{
+			Title: title,
+			Body:  message,
+		}
+	})
+}
+
+// SendLocalizedToUser sends the message built for the language of each registered device of the user.
+// The language is empty for devices which did not register theirs.
+func (s *Service) SendLocalizedToUser(ctx context.Context, userID string, build func(lang string) Message) error {
 	if s.GetProviderCount() < 1 {
 		return errors.New("no provider found")
 	}
@@ -70,32 +96,34 @@ func (s *Service) SendToUser(ctx context.Context, user *dto.User, title string,
 		// get all registered tokens for provider
 		pushTokens, err := models.PushTokens(
 			models.PushTokenWhere.Provider.EQ(string(providerType)),
-			models.PushTokenWhere.UserID.EQ(user.ID),
+			models.PushTokenWhere.UserID.EQ(userID),
 		).All(ctx, s.DB)
 		if err != nil {
 			return fmt.Errorf("failed to get push tokens: %w", err)
 		}
 
-		var tokens []string
+		tokensByLang := make(map[string][]string)
 		for _, token := range pushTokens {
-			tokens = append(tokens, token.Token)
+			tokensByLang[token.Language.String] = append(tokensByLang[token.Language.String], token.Token)
 		}
 
-		responseSlice := provider.SendMulticast(tokens, title, message)
 		tokenToDelete := make([]string, 0)
-		for _, res := range responseSlice {
-			if res.Err != nil && res.Valid {
-				log.Debug().Err(res.Err).Str("token", res.Token).Str("provider", string(provider.GetProviderType())).Msgf("Error while sending push message to provider with valid token.")
-			}
-
-			if !res.Valid {
-				tokenToDelete = append(tokenToDelete, res.Token)
+		for lang, tokens := range tokensByLang {
+			responseSlice := provider.SendMulticast(tokens, build(lang))
+			for _, res := range responseSlice {
+				if res.Err != nil && res.Valid {
+					log.Debug().Err(res.Err).Str("token", res.Token).Str("provider", string(provider.GetProviderType())).Msgf("Error while sending push message to provider with valid token.")
+				}
+
+				if !res.Valid {
+					tokenToDelete = append(tokenToDelete, res.Token)
+				}
 			}
 		}
 		// delete invalid tokens
 		_, err = models.PushTokens(
 			models.PushTokenWhere.Token.IN(tokenToDelete),
-			models.PushTokenWhere.UserID.EQ(user.ID),
+			models.PushTokenWhere.UserID.EQ(userID),
 		).DeleteAll(ctx, s.DB)
 		if err != nil {
 			log.Debug().Err(err).Str("provider", string(provider.GetProviderType())).Msg("Could not delete invalid tokens for provider")
@@ -0,0 +1,271 @@
+package addressbook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+type impl struct {
+	config config.Server
+	db     *sql.DB
+	clock  time2.Clock
+}
+
+func NewService(config config.Server, db *sql.DB, clock time2.Clock) Service {
+	return &impl{
+		config: config,
+		db:     db,
+		clock:  clock,
+	}
+}
+
+func (s *impl) ListEntries(ctx context.Context, orgID string, chainID string) (models.AddressBookSlice, error) {
+	mods := []qm.QueryMod{
+		models.AddressBookWhere.OrganizationID.EQ(null.StringFrom(orgID)),
+		qm.OrderBy(models.AddressBookColumns.Name + " ASC"),
+	}
+	if chainID != "" {
+		mods = append(mods, models.AddressBookWhere.ChainID.EQ(null.StringFrom(chainID)))
+	}
+
+	entries, err := models.AddressBooks(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("list address book entries: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *impl) CreateEntry(ctx context.Context, params CreateEntryParams) (*models.AddressBook, error) {
+	chain, err := models.FindChain(ctx, s.db, params.ChainID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrBadRequestUnknownChain
+		}
+		return nil, fmt.Errorf("find chain: %w", err)
+	}
+
+	addr, err := address.Normalize(chain.Type, params.Address)
+	if err != nil {
+		return nil, httperrors.ErrBadRequestInvalidAddress
+	}
+
+	exists, err := models.AddressBooks(
+		models.AddressBookWhere.OrganizationID.EQ(null.StringFrom(params.OrganizationID)),
+		models.AddressBookWhere.ChainID.EQ(null.StringFrom(chain.ID)),
+		models.AddressBookWhere.Address.EQ(addr),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("check existing address book entry: %w", err)
+	}
+	if exists {
+		return nil, httperrors.ErrConflictAddressBookEntryExists
+	}
+
+	now := s.clock.Now()
+	entry := &models.AddressBook{
+		OrganizationID: null.StringFrom(params.OrganizationID),
+		ChainID:        null.StringFrom(chain.ID),
+		Address:        addr,
+		Name:           params.Name,
+		IsWhitelisted:  null.BoolFrom(params.Whitelisted),
+		CreatedBy:      null.StringFrom(params.UserID),
+		CreatedAt:      null.TimeFrom(now),
+		UpdatedAt:      null.TimeFrom(now),
+		Status:         EntryStatusActive,
+		UsableAfter:    null.TimeFrom(now.Add(s.config.AddressBook.CoolingOffPeriod)),
+	}
+	if params.Whitelisted {
+		// The cooling-off period starts once an admin approved the entry.
+		entry.Status = EntryStatusPendingApproval
+		entry.UsableAfter = null.Time{}
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := entry.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("insert address book entry: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: params.OrganizationID,
+			UserID:         params.UserID,
+			Action:         audit.ActionCreateAddressBookEntry,
+			ResourceType:   audit.ResourceTypeAddressBookEntry,
+			ResourceID:     entry.ID,
+			Details:        entryDetails(entry),
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *impl) RenameEntry(ctx context.Context, orgID string, entryID string, name string, userID string) (*models.AddressBook, error) {
+	entry, err := findEntry(ctx, s.db, orgID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		entry.Name = name
+		entry.UpdatedAt = null.TimeFrom(s.clock.Now())
+		if _, err := entry.Update(ctx, exec, boil.Whitelist(models.AddressBookColumns.Name, models.AddressBookColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("update address book entry: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionRenameAddressBookEntry,
+			ResourceType:   audit.ResourceTypeAddressBookEntry,
+			ResourceID:     entryID,
+			Details:        entryDetails(entry),
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *impl) DeleteEntry(ctx context.Context, orgID string, entryID string, userID string) error {
+	entry, err := findEntry(ctx, s.db, orgID, entryID)
+	if err != nil {
+		return err
+	}
+
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if _, err := entry.Delete(ctx, exec); err != nil {
+			return fmt.Errorf("delete address book entry: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionDeleteAddressBookEntry,
+			ResourceType:   audit.ResourceTypeAddressBookEntry,
+			ResourceID:     entryID,
+			Details:        entryDetails(entry),
+		})
+	})
+}
+
+func (s *impl) ApproveEntry(ctx context.Context, orgID string, entryID string, userID string) (*models.AddressBook, error) {
+	entry, err := findEntry(ctx, s.db, orgID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status != EntryStatusPendingApproval {
+		return nil, httperrors.ErrConflictAddressBookEntryNotPending
+	}
+	if entry.CreatedBy.String == userID {
+		return nil, httperrors.ErrForbiddenSelfApproval
+	}
+
+	now := s.clock.Now()
+	entry.Status = EntryStatusActive
+	entry.ApprovedBy = null.StringFrom(userID)
+	entry.ApprovedAt = null.TimeFrom(now)
+	entry.UsableAfter = null.TimeFrom(now.Add(s.config.AddressBook.CoolingOffPeriod))
+	entry.UpdatedAt = null.TimeFrom(now)
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		// Guard against concurrent approvals, only a single one may activate the entry.
+		rows, err := models.AddressBooks(
+			models.AddressBookWhere.ID.EQ(entry.ID),
+			models.AddressBookWhere.Status.EQ(EntryStatusPendingApproval),
+		).UpdateAll(ctx, exec, models.M{
+			models.AddressBookColumns.Status:      entry.Status,
+			models.AddressBookColumns.ApprovedBy:  entry.ApprovedBy,
+			models.AddressBookColumns.ApprovedAt:  entry.ApprovedAt,
+			models.AddressBookColumns.UsableAfter: entry.UsableAfter,
+			models.AddressBookColumns.UpdatedAt:   entry.UpdatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("approve address book entry: %w", err)
+		}
+		if rows == 0 {
+			return httperrors.ErrConflictAddressBookEntryNotPending
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionApproveAddressBookEntry,
+			ResourceType:   audit.ResourceTypeAddressBookEntry,
+			ResourceID:     entryID,
+			Details:        entryDetails(entry),
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// CheckDestination ensures the address is a whitelisted, approved entry of the organization's address book
+// for the chain whose cooling-off period has passed. It is used for vaults in whitelist-only mode.
+func CheckDestination(ctx context.Context, exec boil.ContextExecutor, orgID string, chain *models.Chain, destination string, now time.Time) error {
+	addr, err := address.Normalize(chain.Type, destination)
+	if err != nil {
+		return httperrors.ErrBadRequestInvalidAddress
+	}
+
+	entry, err := models.AddressBooks(
+		models.AddressBookWhere.OrganizationID.EQ(null.StringFrom(orgID)),
+		models.AddressBookWhere.ChainID.EQ(null.StringFrom(chain.ID)),
+		models.AddressBookWhere.Address.EQ(addr),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return httperrors.ErrForbiddenDestinationNotWhitelisted
+		}
+		return fmt.Errorf("find address book entry: %w", err)
+	}
+	if !entry.IsWhitelisted.Bool || entry.Status != EntryStatusActive {
+		return httperrors.ErrForbiddenDestinationNotWhitelisted
+	}
+	if entry.UsableAfter.Valid && now.Before(entry.UsableAfter.Time) {
+		return httperrors.ErrConflictDestinationCoolingOff
+	}
+
+	return nil
+}
+
+func entryDetails(entry *models.AddressBook) map[string]interface{} {
+	return map[string]interface{}{
+		"chain_id":       entry.ChainID.String,
+		"address":        entry.Address,
+		"name":           entry.Name,
+		"is_whitelisted": entry.IsWhitelisted.Bool,
+		"status":         entry.Status,
+	}
+}
+
+func findEntry(ctx context.Context, exec boil.ContextExecutor, orgID string, entryID string) (*models.AddressBook, error) {
+	entry, err := models.AddressBooks(
+		models.AddressBookWhere.ID.EQ(entryID),
+		models.AddressBookWhere.OrganizationID.EQ(null.StringFrom(orgID)),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundAddressBookEntry
+		}
+		return nil, fmt.Errorf("find address book entry: %w", err)
+	}
+	return entry, nil
+}
@@ -0,0 +1,32 @@
+package addressbook
+
+import (
+	"context"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+const (
+	EntryStatusPendingApproval = "pending_approval"
+	EntryStatusActive          = "active"
+)
+
+type CreateEntryParams struct {
+	OrganizationID string
+	ChainID        string
+	Address        string
+	Name           string
+	// Whitelisted entries are usable within whitelist-only vaults, they require the approval of an admin.
+	Whitelisted bool
+	UserID      string
+}
+
+type Service interface {
+	ListEntries(ctx context.Context, orgID string, chainID string) (models.AddressBookSlice, error)
+	CreateEntry(ctx context.Context, params CreateEntryParams) (*models.AddressBook, error)
+	RenameEntry(ctx context.Context, orgID string, entryID string, name string, userID string) (*models.AddressBook, error)
+	DeleteEntry(ctx context.Context, orgID string, entryID string, userID string) error
+	// ApproveEntry activates a pending whitelisted entry, starting its cooling-off period.
+	// Entries cannot be approved by the user who added them.
+	ApproveEntry(ctx context.Context, orgID string, entryID string, userID string) (*models.AddressBook, error)
+}
@@ -0,0 +1,196 @@
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+)
+
+// Actions recorded in the audit log.
+const (
+	ActionRegister             = "REGISTER"
+	ActionConfirmRegistration  = "CONFIRM_REGISTRATION"
+	ActionLogin                = "LOGIN"
+	ActionLogout               = "LOGOUT"
+	ActionChangePassword       = "CHANGE_PASSWORD"
+	ActionRequestPasswordReset = "REQUEST_PASSWORD_RESET"
+	ActionDeleteAccount        = "DELETE_ACCOUNT"
+	ActionRegisterPasskey      = "REGISTER_PASSKEY"
+	ActionLoginPasskey         = "LOGIN_PASSKEY"
+
+	ActionCreateOrganization = "CREATE_ORGANIZATION"
+	ActionAddMember          = "ADD_MEMBER"
+	ActionRemoveMember       = "REMOVE_MEMBER"
+	ActionChangeMemberRole   = "CHANGE_MEMBER_ROLE"
+	ActionTransferOwnership  = "TRANSFER_OWNERSHIP"
+	ActionCreateInvitation   = "CREATE_INVITATION"
+	ActionRevokeInvitation   = "REVOKE_INVITATION"
+	ActionAcceptInvitation   = "ACCEPT_INVITATION"
+
+	ActionCreateAddressBookEntry  = "CREATE_ADDRESS_BOOK_ENTRY"
+	ActionRenameAddressBookEntry  = "RENAME_ADDRESS_BOOK_ENTRY"
+	ActionDeleteAddressBookEntry  = "DELETE_ADDRESS_BOOK_ENTRY"
+	ActionApproveAddressBookEntry = "APPROVE_ADDRESS_BOOK_ENTRY"
+
+	ActionCreateVault          = "CREATE_VAULT"
+	ActionRenameVault          = "RENAME_VAULT"
+	ActionModifyPolicy         = "MODIFY_POLICY"
+	ActionArchiveVault         = "ARCHIVE_VAULT"
+	ActionCreateWallet         = "CREATE_WALLET"
+	ActionProposeThreshold     = "PROPOSE_THRESHOLD_CHANGE"
+	ActionApproveProposal      = "APPROVE_PROPOSAL"
+	ActionRejectProposal       = "REJECT_PROPOSAL"
+	ActionCreateSigningRequest = "CREATE_SIGNING_REQUEST"
+	ActionApproveTx            = "APPROVE_TX"
+	ActionRejectTx             = "REJECT_TX"
+	ActionBroadcastTx          = "BROADCAST_TX"
+	// ActionPolicyViolation is recorded for actions denied by a policy of the vault,
+	// the policy and its outcome are part of the details.
+	ActionPolicyViolation = "POLICY_VIOLATION"
+
+	ActionCreateAsset = "CREATE_ASSET"
+	ActionUpdateAsset = "UPDATE_ASSET"
+
+	ActionReadAuditLog   = "READ_AUDIT_LOG"
+	ActionExportAuditLog = "EXPORT_AUDIT_LOG"
+
+	ActionCreateWebhookEndpoint = "CREATE_WEBHOOK_ENDPOINT"
+	ActionDeleteWebhookEndpoint = "DELETE_WEBHOOK_ENDPOINT"
+	ActionRedeliverWebhook      = "REDELIVER_WEBHOOK"
+
+	ActionDeliverKeyShare = "DELIVER_KEY_SHARE"
+	ActionConfirmKeyShare = "CONFIRM_KEY_SHARE"
+
+	ActionRequestKeyRecovery  = "REQUEST_KEY_RECOVERY"
+	ActionApproveKeyRecovery  = "APPROVE_KEY_RECOVERY"
+	ActionRejectKeyRecovery   = "REJECT_KEY_RECOVERY"
+	ActionCompleteKeyRecovery = "COMPLETE_KEY_RECOVERY"
+	ActionFailKeyRecovery     = "FAIL_KEY_RECOVERY"
+
+	ActionEnrollDevice     = "ENROLL_DEVICE"
+	ActionRevokeDevice     = "REVOKE_DEVICE"
+	ActionRemoveCredential = "REMOVE_CREDENTIAL"
+
+	ActionTagKey                     = "TAG_KEY"
+	ActionProposeKeyRetire           = "PROPOSE_KEY_RETIREMENT"
+	ActionProposeKeyRetireCompletion = "PROPOSE_KEY_RETIREMENT_COMPLETION"
+	ActionRetireKey                  = "RETIRE_KEY"
+	ActionRefuseKeyRetire            = "REFUSE_KEY_RETIREMENT"
+
+	ActionProposeKeyRefresh  = "PROPOSE_KEY_REFRESH"
+	ActionCompleteKeyRefresh = "COMPLETE_KEY_REFRESH"
+	ActionFailKeyRefresh     = "FAIL_KEY_REFRESH"
+)
+
+// Types of the resources referenced by audit log entries.
+const (
+	ResourceTypeUser             = "user"
+	ResourceTypeOrganization     = "organization"
+	ResourceTypeInvitation       = "invitation"
+	ResourceTypeAddressBookEntry = "address_book_entry"
+	ResourceTypeVault            = "vault"
+	ResourceTypeWallet           = "wallet"
+	ResourceTypeVaultProposal    = "vault_proposal"
+	ResourceTypeSigningRequest   = "signing_request"
+	ResourceTypeAsset            = "asset"
+	ResourceTypeWebhookEndpoint  = "webhook_endpoint"
+	ResourceTypeKeyShare         = "key_share"
+	ResourceTypeKeyRecovery      = "key_recovery"
+	ResourceTypeKeyRefresh       = "key_refresh"
+	ResourceTypeKey              = "key"
+	ResourceTypeDevice           = "device"
+	ResourceTypeCredential       = "credential"
+)
+
+// Policies and their outcomes, reported within the details of signing related entries.
+const (
+	DetailPolicy        = "policy"
+	DetailPolicyOutcome = "policy_outcome"
+
+	PolicyWhitelist = "whitelist"
+
+	PolicyOutcomeHit            = "hit"
+	PolicyOutcomeNotWhitelisted = "not_whitelisted"
+	PolicyOutcomeCoolingOff     = "cooling_off"
+)
+
+// Entry describes a single action to record.
+type Entry struct {
+	OrganizationID string
+	// UserID of the acting user, empty for actions without an authenticated user.
+	UserID       string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	// CredentialID is the raw ID of the passkey which confirmed the action, if any.
+	CredentialID []byte
+	Details      map[string]interface{}
+}
+
+// Record writes the entry to the audit log, adding the origin of the request stored in the context.
+// The entry is appended to the hash chain of its organization, entries without organization form a chain of their own.
+// The executor must be the transaction of the action described, so either both or none are persisted.
+func Record(ctx context.Context, exec boil.ContextExecutor, entry Entry) error {
+	origin := util.RequestOriginFromContext(ctx)
+
+	log := &models.AuditLog{
+		ID:             uuid.New().String(),
+		OrganizationID: optionalString(entry.OrganizationID),
+		UserID:         optionalString(entry.UserID),
+		Action:         entry.Action,
+		ResourceType:   optionalString(entry.ResourceType),
+		ResourceID:     optionalString(entry.ResourceID),
+		IPAddress:      optionalString(origin.IPAddress),
+		UserAgent:      optionalString(origin.UserAgent),
+	}
+	if len(entry.CredentialID) > 0 {
+		log.CredentialID = null.StringFrom(base64.RawURLEncoding.EncodeToString(entry.CredentialID))
+	}
+	if len(entry.Details) > 0 {
+		details, err := json.Marshal(entry.Details)
+		if err != nil {
+			return fmt.Errorf("marshal audit log details: %w", err)
+		}
+		log.Details = null.JSONFrom(details)
+	}
+
+	if err := lockChain(ctx, exec, entry.OrganizationID); err != nil {
+		return err
+	}
+	head, err := chainHead(ctx, exec, entry.OrganizationID)
+	if err != nil {
+		return err
+	}
+	log.Sequence = null.Int64From(1)
+	log.PrevHash = null.StringFrom("")
+	if head != nil {
+		log.Sequence = null.Int64From(head.Sequence.Int64 + 1)
+		log.PrevHash = head.Hash
+	}
+	// The hash has to cover the persisted and exported value, both are precise to the millisecond.
+	log.CreatedAt = time.Now().UTC().Truncate(time.Millisecond)
+
+	hash, err := ComputeHash(log)
+	if err != nil {
+		return err
+	}
+	log.Hash = null.StringFrom(hash)
+
+	if err := log.Insert(ctx, exec, boil.Infer()); err != nil {
+		return fmt.Errorf("insert audit log: %w", err)
+	}
+
+	return nil
+}
+
+func optionalString(s string) null.String {
+	return null.NewString(s, s != "")
+}
@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// HashAlgorithm used to chain audit log entries.
+const HashAlgorithm = "sha256"
+
+// Canonicalization describes how the hash of an entry is computed, it is handed to external auditors along with exports.
+const Canonicalization = "hex(sha256(json)) of an object holding the keys id, organization_id, sequence, prev_hash, user_id, action, " +
+	"resource_type, resource_id, ip_address, user_agent, credential_id, details and created_at in this order; " +
+	"absent values are empty strings, UUIDs are lowercase, details is JSON with sorted keys or null and created_at is RFC 3339 in UTC without trailing zeros of the fraction."
+
+// canonicalEntry fixes the order and representation of the fields covered by the hash of an entry.
+type canonicalEntry struct {
+	ID             string          `json:"id"`
+	OrganizationID string          `json:"organization_id"`
+	Sequence       int64           `json:"sequence"`
+	PrevHash       string          `json:"prev_hash"`
+	UserID         string          `json:"user_id"`
+	Action         string          `json:"action"`
+	ResourceType   string          `json:"resource_type"`
+	ResourceID     string          `json:"resource_id"`
+	IPAddress      string          `json:"ip_address"`
+	UserAgent      string          `json:"user_agent"`
+	CredentialID   string          `json:"credential_id"`
+	Details        json.RawMessage `json:"details"`
+	CreatedAt      string          `json:"created_at"`
+}
+
+// ComputeHash returns the hash of the entry covering its content and its predecessor.
+func ComputeHash(entry *models.AuditLog) (string, error) {
+	details, err := canonicalDetails(entry.Details)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := json.Marshal(canonicalEntry{
+		ID:             strings.ToLower(entry.ID),
+		OrganizationID: strings.ToLower(entry.OrganizationID.String),
+		Sequence:       entry.Sequence.Int64,
+		PrevHash:       entry.PrevHash.String,
+		UserID:         strings.ToLower(entry.UserID.String),
+		Action:         entry.Action,
+		ResourceType:   entry.ResourceType.String,
+		ResourceID:     entry.ResourceID.String,
+		IPAddress:      entry.IPAddress.String,
+		UserAgent:      entry.UserAgent.String,
+		CredentialID:   entry.CredentialID.String,
+		Details:        details,
+		CreatedAt:      entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal audit log entry: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalDetails re-encodes the details with sorted keys, as the database does not preserve their formatting.
+func canonicalDetails(details null.JSON) (json.RawMessage, error) {
+	if !details.Valid || len(details.JSON) == 0 {
+		return json.RawMessage("null"), nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(details.JSON))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decode audit log details: %w", err)
+	}
+
+	canonical, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode audit log details: %w", err)
+	}
+	return canonical, nil
+}
+
+// chainWhere selects the chain of the organization, the chain of entries without organization if empty.
+func chainWhere(orgID string) qm.QueryMod {
+	if orgID == "" {
+		return models.AuditLogWhere.OrganizationID.IsNull()
+	}
+	return models.AuditLogWhere.OrganizationID.EQ(null.StringFrom(orgID))
+}
+
+// checkpointWhere selects the checkpoints of the organization's chain, see chainWhere.
+func checkpointWhere(orgID string) qm.QueryMod {
+	if orgID == "" {
+		return models.AuditCheckpointWhere.OrganizationID.IsNull()
+	}
+	return models.AuditCheckpointWhere.OrganizationID.EQ(null.StringFrom(orgID))
+}
+
+// lockChain serializes appends to the chain of the organization until the transaction ends.
+func lockChain(ctx context.Context, exec boil.ContextExecutor, orgID string) error {
+	if _, err := exec.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "audit_logs:"+orgID); err != nil {
+		return fmt.Errorf("lock audit log chain: %w", err)
+	}
+	return nil
+}
+
+// chainHead returns the last chained entry of the organization, nil if the chain is empty.
+// Entries recorded before chaining was introduced carry no sequence and are not part of any chain.
+func chainHead(ctx context.Context, exec boil.ContextExecutor, orgID string) (*models.AuditLog, error) {
+	entries, err := models.AuditLogs(
+		chainWhere(orgID),
+		models.AuditLogWhere.Sequence.IsNotNull(),
+		qm.OrderBy(models.AuditLogColumns.Sequence+" DESC"),
+		qm.Limit(1),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("find audit log chain head: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return entries[0], nil
+}
@@ -0,0 +1,62 @@
+package audit_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeHash(t *testing.T) {
+	entry := &models.AuditLog{
+		ID:             "9A3E9E6B-5C1F-4F2A-8E6B-0C5D2B7A1E4F",
+		OrganizationID: null.StringFrom("b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e"),
+		UserID:         null.StringFrom("F6E5D4C3-B2A1-4C9D-8E7F-6A5B4C3D2E1F"),
+		Action:         audit.ActionRenameVault,
+		ResourceType:   null.StringFrom(audit.ResourceTypeVault),
+		ResourceID:     null.StringFrom("vault-1"),
+		Details:        null.JSONFrom([]byte(`{"name": "Treasury", "amount": 1.50, "nested": {"b": 2, "a": 1}}`)),
+		CreatedAt:      time.Date(2025, 6, 1, 12, 30, 0, 120000000, time.FixedZone("CEST", 2*60*60)),
+		Sequence:       null.Int64From(2),
+		PrevHash:       null.StringFrom("prev"),
+	}
+
+	// The canonical form as documented for external auditors.
+	canonical := `{"id":"9a3e9e6b-5c1f-4f2a-8e6b-0c5d2b7a1e4f","organization_id":"b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e",` +
+		`"sequence":2,"prev_hash":"prev","user_id":"f6e5d4c3-b2a1-4c9d-8e7f-6a5b4c3d2e1f","action":"` + audit.ActionRenameVault + `",` +
+		`"resource_type":"` + audit.ResourceTypeVault + `","resource_id":"vault-1","ip_address":"","user_agent":"","credential_id":"",` +
+		`"details":{"amount":1.50,"name":"Treasury","nested":{"a":1,"b":2}},"created_at":"2025-06-01T10:30:00.12Z"}`
+	sum := sha256.Sum256([]byte(canonical))
+
+	hash, err := audit.ComputeHash(entry)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), hash)
+
+	// The formatting of the details as stored by the database does not change the hash.
+	entry.Details = null.JSONFrom([]byte(`{"nested":{"a":1,"b":2},"name":"Treasury","amount":1.50}`))
+	reformatted, err := audit.ComputeHash(entry)
+	require.NoError(t, err)
+	assert.Equal(t, hash, reformatted)
+
+	// Any change of the content or the predecessor does.
+	entry.PrevHash = null.StringFrom("other")
+	changed, err := audit.ComputeHash(entry)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+
+	entry.PrevHash = null.StringFrom("prev")
+	entry.Details = null.JSONFrom([]byte(`{"nested":{"a":1,"b":2},"name":"Treasury","amount":1.5}`))
+	changed, err = audit.ComputeHash(entry)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+
+	entry.Details = null.JSONFrom([]byte(`{`))
+	_, err = audit.ComputeHash(entry)
+	require.Error(t, err)
+}
@@ -0,0 +1,387 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// verifyBatchSize limits the number of entries loaded at once while walking a chain.
+const verifyBatchSize = 1000
+
+// maxExportEntries limits the number of entries of an export bundle, larger ranges are exported in several bundles.
+const maxExportEntries = 10 * verifyBatchSize
+
+type impl struct {
+	config     config.Server
+	db         *sql.DB
+	clock      time2.Clock
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+	keyID      string
+}
+
+func NewService(config config.Server, db *sql.DB, clock time2.Clock) (Service, error) {
+	s := &impl{
+		config: config,
+		db:     db,
+		clock:  clock,
+	}
+
+	if config.Audit.CheckpointSigningKey != "" {
+		seed, err := base64.StdEncoding.DecodeString(config.Audit.CheckpointSigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("decode audit checkpoint signing key: %w", err)
+		}
+		if len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("invalid audit checkpoint signing key length %d, expected %d", len(seed), ed25519.SeedSize)
+		}
+
+		s.privateKey = ed25519.NewKeyFromSeed(seed)
+		s.publicKey = s.privateKey.Public().(ed25519.PublicKey)
+		s.keyID = KeyID(s.publicKey)
+	}
+
+	return s, nil
+}
+
+// KeyID identifies the public key verifying checkpoints.
+func KeyID(publicKey ed25519.PublicKey) string {
+	sum := sha256.Sum256(publicKey)
+	return hex.EncodeToString(sum[:8])
+}
+
+// CheckpointMessage returns the message signed by a checkpoint of the organization's chain.
+func CheckpointMessage(orgID string, sequence int64, hash string) []byte {
+	return []byte(fmt.Sprintf("%s:%d:%s", strings.ToLower(orgID), sequence, hash))
+}
+
+func (s *impl) CreateCheckpoints(ctx context.Context) error {
+	if s.privateKey == nil {
+		return nil
+	}
+
+	chains, err := s.chains(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, orgID := range chains {
+		if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+			// Prevents entries from being appended while the head is signed.
+			if err := lockChain(ctx, exec, orgID); err != nil {
+				return err
+			}
+
+			head, err := chainHead(ctx, exec, orgID)
+			if err != nil {
+				return err
+			}
+			if head == nil {
+				return nil
+			}
+
+			last, err := lastCheckpoint(ctx, exec, orgID)
+			if err != nil {
+				return err
+			}
+			if last != nil && last.Sequence >= head.Sequence.Int64 {
+				return nil
+			}
+
+			checkpoint := &models.AuditCheckpoint{
+				OrganizationID: head.OrganizationID,
+				Sequence:       head.Sequence.Int64,
+				Hash:           head.Hash.String,
+				KeyID:          s.keyID,
+				Signature:      base64.StdEncoding.EncodeToString(ed25519.Sign(s.privateKey, CheckpointMessage(orgID, head.Sequence.Int64, head.Hash.String))),
+				CreatedAt:      s.clock.Now(),
+			}
+			if err := checkpoint.Insert(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("insert audit checkpoint: %w", err)
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("create checkpoint of audit log chain %q: %w", orgID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *impl) RunCheckpoints(ctx context.Context) {
+	log := util.LogFromContext(ctx)
+
+	if s.privateKey == nil {
+		log.Warn().Msg("No audit checkpoint signing key configured, skipping audit log checkpoints")
+		return
+	}
+	if s.config.Audit.CheckpointInterval <= 0 {
+		log.Warn().Msg("No audit checkpoint interval configured, skipping audit log checkpoints")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Audit.CheckpointInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.CreateCheckpoints(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to create audit log checkpoints")
+			}
+		}
+	}
+}
+
+func (s *impl) Verify(ctx context.Context) ([]ChainReport, error) {
+	chains, err := s.chains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]ChainReport, 0, len(chains))
+	for _, orgID := range chains {
+		report, err := s.verifyChain(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, *report)
+	}
+
+	return reports, nil
+}
+
+func (s *impl) verifyChain(ctx context.Context, orgID string) (*ChainReport, error) {
+	report := &ChainReport{OrganizationID: orgID, SignaturesVerified: s.publicKey != nil}
+
+	checkpoints, err := models.AuditCheckpoints(
+		checkpointWhere(orgID),
+		qm.OrderBy(models.AuditCheckpointColumns.Sequence+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("load audit checkpoints: %w", err)
+	}
+	report.Checkpoints = int64(len(checkpoints))
+
+	next := 0
+	for {
+		entries, err := models.AuditLogs(
+			chainWhere(orgID),
+			models.AuditLogWhere.Sequence.GT(null.Int64From(report.HeadSequence)),
+			qm.OrderBy(models.AuditLogColumns.Sequence+" ASC"),
+			qm.Limit(verifyBatchSize),
+		).All(ctx, s.db)
+		if err != nil {
+			return nil, fmt.Errorf("load audit log chain: %w", err)
+		}
+
+		for _, entry := range entries {
+			if broken := s.verifyEntry(report, entry); broken != nil {
+				report.Broken = broken
+				return report, nil
+			}
+
+			for ; next < len(checkpoints) && checkpoints[next].Sequence <= entry.Sequence.Int64; next++ {
+				if broken := s.verifyCheckpoint(orgID, checkpoints[next], entry); broken != nil {
+					report.Broken = broken
+					return report, nil
+				}
+			}
+
+			report.Entries++
+			report.HeadSequence = entry.Sequence.Int64
+			report.HeadHash = entry.Hash.String
+		}
+
+		if len(entries) < verifyBatchSize {
+			break
+		}
+	}
+
+	// Checkpoints beyond the head reveal entries removed from the end of the chain.
+	if next < len(checkpoints) {
+		report.Broken = &BrokenLink{
+			Sequence: checkpoints[next].Sequence,
+			Reason:   BrokenReasonCheckpointMissing,
+		}
+	}
+
+	return report, nil
+}
+
+func (s *impl) verifyEntry(report *ChainReport, entry *models.AuditLog) *BrokenLink {
+	broken := func(reason string) *BrokenLink {
+		return &BrokenLink{EntryID: entry.ID, Sequence: entry.Sequence.Int64, Reason: reason}
+	}
+
+	if entry.Sequence.Int64 != report.HeadSequence+1 {
+		return broken(BrokenReasonSequenceGap)
+	}
+	if entry.PrevHash.String != report.HeadHash {
+		return broken(BrokenReasonPrevHashMismatch)
+	}
+
+	hash, err := ComputeHash(entry)
+	if err != nil || hash != entry.Hash.String {
+		return broken(BrokenReasonHashMismatch)
+	}
+
+	return nil
+}
+
+func (s *impl) verifyCheckpoint(orgID string, checkpoint *models.AuditCheckpoint, entry *models.AuditLog) *BrokenLink {
+	if checkpoint.Sequence != entry.Sequence.Int64 {
+		// The entry signed by the checkpoint is missing, the sequence check of the chain covers this already.
+		return &BrokenLink{Sequence: checkpoint.Sequence, Reason: BrokenReasonCheckpointMissing}
+	}
+	if checkpoint.Hash != entry.Hash.String {
+		return &BrokenLink{EntryID: entry.ID, Sequence: checkpoint.Sequence, Reason: BrokenReasonCheckpointMismatch}
+	}
+
+	// Signatures can only be verified with the configured key, without one the checkpoints still anchor the hashes and
+	// the report tells the signatures were not verified.
+	if s.publicKey == nil {
+		return nil
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(checkpoint.Signature)
+	if err != nil || checkpoint.KeyID != s.keyID || !ed25519.Verify(s.publicKey, CheckpointMessage(orgID, checkpoint.Sequence, checkpoint.Hash), signature) {
+		return &BrokenLink{EntryID: entry.ID, Sequence: checkpoint.Sequence, Reason: BrokenReasonCheckpointSignature}
+	}
+
+	return nil
+}
+
+func (s *impl) Export(ctx context.Context, orgID string, fromSequence int64, toSequence int64, userID string) (*ExportBundle, error) {
+	entryMods := []qm.QueryMod{
+		chainWhere(orgID),
+		models.AuditLogWhere.Sequence.IsNotNull(),
+		qm.OrderBy(models.AuditLogColumns.Sequence + " ASC"),
+	}
+	checkpointMods := []qm.QueryMod{
+		checkpointWhere(orgID),
+		qm.OrderBy(models.AuditCheckpointColumns.Sequence + " ASC"),
+	}
+	if fromSequence > 0 {
+		entryMods = append(entryMods, models.AuditLogWhere.Sequence.GTE(null.Int64From(fromSequence)))
+		checkpointMods = append(checkpointMods, models.AuditCheckpointWhere.Sequence.GTE(fromSequence))
+	}
+	if toSequence > 0 {
+		entryMods = append(entryMods, models.AuditLogWhere.Sequence.LTE(null.Int64From(toSequence)))
+		checkpointMods = append(checkpointMods, models.AuditCheckpointWhere.Sequence.LTE(toSequence))
+	}
+
+	var (
+		entries      models.AuditLogSlice
+		checkpoints  models.AuditCheckpointSlice
+		nextSequence int64
+	)
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		details := map[string]interface{}{
+			DetailFormat: FormatBundle,
+		}
+		if fromSequence > 0 {
+			details["from_sequence"] = fromSequence
+		}
+		if toSequence > 0 {
+			details["to_sequence"] = toSequence
+		}
+		if err := Record(ctx, exec, Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         ActionExportAuditLog,
+			ResourceType:   ResourceTypeOrganization,
+			ResourceID:     orgID,
+			Details:        details,
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		entries, err = models.AuditLogs(append(entryMods, qm.Limit(maxExportEntries+1))...).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("load audit log entries: %w", err)
+		}
+		if len(entries) > maxExportEntries {
+			nextSequence = entries[maxExportEntries].Sequence.Int64
+			entries = entries[:maxExportEntries]
+			checkpointMods = append(checkpointMods, models.AuditCheckpointWhere.Sequence.LT(nextSequence))
+		}
+
+		checkpoints, err = models.AuditCheckpoints(checkpointMods...).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("load audit checkpoints: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ExportBundle{
+		OrganizationID: orgID,
+		Entries:        entries,
+		Checkpoints:    checkpoints,
+		NextSequence:   nextSequence,
+		PublicKey:      s.publicKey,
+		KeyID:          s.keyID,
+	}, nil
+}
+
+// chains returns the organizations having a chain, an empty ID stands for the chain of entries without organization.
+func (s *impl) chains(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT organization_id FROM audit_logs WHERE sequence IS NOT NULL
+		UNION SELECT organization_id FROM audit_checkpoints
+		ORDER BY 1 NULLS FIRST`)
+	if err != nil {
+		return nil, fmt.Errorf("list audit log chains: %w", err)
+	}
+	defer rows.Close()
+
+	var chains []string
+	for rows.Next() {
+		var orgID sql.NullString
+		if err := rows.Scan(&orgID); err != nil {
+			return nil, fmt.Errorf("scan audit log chain: %w", err)
+		}
+		chains = append(chains, orgID.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit log chains: %w", err)
+	}
+
+	return chains, nil
+}
+
+func lastCheckpoint(ctx context.Context, exec boil.ContextExecutor, orgID string) (*models.AuditCheckpoint, error) {
+	checkpoints, err := models.AuditCheckpoints(
+		checkpointWhere(orgID),
+		qm.OrderBy(models.AuditCheckpointColumns.Sequence+" DESC"),
+		qm.Limit(1),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("find last audit checkpoint: %w", err)
+	}
+	if len(checkpoints) == 0 {
+		return nil, nil
+	}
+	return checkpoints[0], nil
+}
@@ -0,0 +1,150 @@
+package audit_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkpointConfig returns the test config with a checkpoint signing key configured.
+func checkpointConfig() config.Server {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Audit.CheckpointSigningKey = base64.StdEncoding.EncodeToString(make([]byte, ed25519.SeedSize))
+	return cfg
+}
+
+// chainReport returns the report of the organization's chain.
+func chainReport(t *testing.T, s *api.Server, orgID string) audit.ChainReport {
+	t.Helper()
+
+	reports, err := s.Audit.Verify(t.Context())
+	require.NoError(t, err)
+	for _, report := range reports {
+		if report.OrganizationID == orgID {
+			return report
+		}
+	}
+	require.FailNow(t, "chain not reported", orgID)
+	return audit.ChainReport{}
+}
+
+func TestVerify(t *testing.T) {
+	test.WithTestServerConfigurable(t, checkpointConfig(), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		for _, name := range []string{"Treasury", "Operations"} {
+			require.NoError(t, audit.Record(ctx, s.DB, audit.Entry{
+				OrganizationID: org.ID,
+				UserID:         fix.User1.ID,
+				Action:         audit.ActionRenameVault,
+				ResourceType:   audit.ResourceTypeVault,
+				ResourceID:     "vault-1",
+				Details:        map[string]interface{}{"name": name},
+			}))
+		}
+		require.NoError(t, s.Audit.CreateCheckpoints(ctx))
+
+		report := chainReport(t, s, org.ID)
+		require.Nil(t, report.Broken)
+		assert.True(t, report.SignaturesVerified)
+		assert.Equal(t, int64(1), report.Checkpoints)
+		assert.Equal(t, report.HeadSequence, report.Entries)
+		head := report.HeadSequence
+
+		tamper := func(query string, args ...interface{}) {
+			t.Helper()
+			_, err := s.DB.ExecContext(ctx, query, args...)
+			require.NoError(t, err)
+		}
+		entries, err := models.AuditLogs(
+			models.AuditLogWhere.OrganizationID.EQ(null.StringFrom(org.ID)),
+			models.AuditLogWhere.Sequence.LTE(null.Int64From(head)),
+			qm.OrderBy(models.AuditLogColumns.Sequence+" ASC"),
+		).All(ctx, s.DB)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(entries), 2)
+		forged := entries[len(entries)-2]
+
+		// Entries changed after the fact no longer match their hash.
+		tamper(`UPDATE audit_logs SET details = '{"name": "Forged"}' WHERE id = $1`, forged.ID)
+		report = chainReport(t, s, org.ID)
+		require.NotNil(t, report.Broken)
+		assert.Equal(t, audit.BrokenReasonHashMismatch, report.Broken.Reason)
+		assert.Equal(t, forged.ID, report.Broken.EntryID)
+		assert.Equal(t, forged.Sequence.Int64, report.Broken.Sequence)
+		assert.Equal(t, forged.Sequence.Int64-1, report.Entries)
+
+		// Rehashing the entry breaks the link of its successor.
+		require.NoError(t, forged.Reload(ctx, s.DB))
+		hash, err := audit.ComputeHash(forged)
+		require.NoError(t, err)
+		tamper(`UPDATE audit_logs SET hash = $2 WHERE id = $1`, forged.ID, hash)
+		report = chainReport(t, s, org.ID)
+		require.NotNil(t, report.Broken)
+		assert.Equal(t, audit.BrokenReasonPrevHashMismatch, report.Broken.Reason)
+		assert.Equal(t, head, report.Broken.Sequence)
+
+		// Rebuilding the rest of the chain is caught by the checkpoint of its head.
+		prevHash := hash
+		for _, e := range entries[len(entries)-1:] {
+			e.PrevHash = null.StringFrom(prevHash)
+			hash, err := audit.ComputeHash(e)
+			require.NoError(t, err)
+			tamper(`UPDATE audit_logs SET prev_hash = $2, hash = $3 WHERE id = $1`, e.ID, prevHash, hash)
+			prevHash = hash
+		}
+		report = chainReport(t, s, org.ID)
+		require.NotNil(t, report.Broken)
+		assert.Equal(t, audit.BrokenReasonCheckpointMismatch, report.Broken.Reason)
+		assert.Equal(t, head, report.Broken.Sequence)
+
+		// Moving the checkpoint along requires its signature.
+		tamper(`UPDATE audit_checkpoints SET hash = $2 WHERE organization_id = $1`, org.ID, prevHash)
+		report = chainReport(t, s, org.ID)
+		require.NotNil(t, report.Broken)
+		assert.Equal(t, audit.BrokenReasonCheckpointSignature, report.Broken.Reason)
+		assert.Equal(t, head, report.Broken.Sequence)
+
+		// Entries removed from the end of the chain leave their checkpoint behind.
+		tamper(`DELETE FROM audit_logs WHERE organization_id = $1 AND sequence >= $2`, org.ID, head)
+		report = chainReport(t, s, org.ID)
+		require.NotNil(t, report.Broken)
+		assert.Equal(t, audit.BrokenReasonCheckpointMissing, report.Broken.Reason)
+		assert.Equal(t, head, report.Broken.Sequence)
+		assert.Empty(t, report.Broken.EntryID)
+	})
+}
+
+func TestVerifyWithoutSigningKey(t *testing.T) {
+	test.WithTestServerConfigurable(t, checkpointConfig(), func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		require.NoError(t, s.Audit.CreateCheckpoints(ctx))
+
+		// Without the key the hashes are still anchored by the checkpoints, but the signatures are not verified.
+		unsigned, err := audit.NewService(config.DefaultServiceConfigFromEnv(), s.DB, s.Clock)
+		require.NoError(t, err)
+		s.Audit = unsigned
+		report := chainReport(t, s, org.ID)
+		assert.Nil(t, report.Broken)
+		assert.Equal(t, int64(1), report.Checkpoints)
+		assert.False(t, report.SignaturesVerified)
+	})
+}
@@ -0,0 +1,207 @@
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// Formats in which the audit log is read, reported within the details of read entries.
+const (
+	DetailFormat = "format"
+
+	FormatJSON   = "json"
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+	FormatBundle = "bundle"
+)
+
+func (s *impl) ListEntries(ctx context.Context, params ListEntriesParams) (models.AuditLogSlice, string, error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	mods := filterMods(params)
+	if params.Cursor != "" {
+		createdAt, id, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		mods = append(mods, afterCursor(createdAt, id))
+	}
+	// One additional entry tells whether there is a next page.
+	mods = append(mods, qm.Limit(limit+1))
+
+	var entries models.AuditLogSlice
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := recordRead(ctx, exec, params, ActionReadAuditLog, FormatJSON); err != nil {
+			return err
+		}
+
+		var err error
+		entries, err = models.AuditLogs(mods...).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("list audit log entries: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+
+	if len(entries) <= limit {
+		return entries, "", nil
+	}
+
+	entries = entries[:limit]
+	last := entries[len(entries)-1]
+	return entries, encodeCursor(last.CreatedAt, last.ID), nil
+}
+
+func (s *impl) StreamEntries(ctx context.Context, params ListEntriesParams, format string, fn func(entry *models.AuditLog) error) error {
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		return recordRead(ctx, exec, params, ActionExportAuditLog, format)
+	}); err != nil {
+		return err
+	}
+
+	var cursor qm.QueryMod
+	for {
+		mods := filterMods(params)
+		if cursor != nil {
+			mods = append(mods, cursor)
+		}
+		mods = append(mods, qm.Limit(verifyBatchSize))
+
+		entries, err := models.AuditLogs(mods...).All(ctx, s.db)
+		if err != nil {
+			return fmt.Errorf("list audit log entries: %w", err)
+		}
+
+		for _, entry := range entries {
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < verifyBatchSize {
+			return nil
+		}
+
+		last := entries[len(entries)-1]
+		cursor = afterCursor(last.CreatedAt, last.ID)
+	}
+}
+
+// filterMods returns the filters and the order of the params, newest entries first.
+func filterMods(params ListEntriesParams) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		models.AuditLogWhere.OrganizationID.EQ(null.StringFrom(params.OrganizationID)),
+		qm.OrderBy(models.AuditLogColumns.CreatedAt + " DESC, " + models.AuditLogColumns.ID + " DESC"),
+	}
+
+	if params.UserID != "" {
+		mods = append(mods, models.AuditLogWhere.UserID.EQ(null.StringFrom(params.UserID)))
+	}
+	if params.Action != "" {
+		mods = append(mods, models.AuditLogWhere.Action.EQ(params.Action))
+	}
+	if params.ResourceType != "" {
+		mods = append(mods, models.AuditLogWhere.ResourceType.EQ(null.StringFrom(params.ResourceType)))
+	}
+	if params.ResourceID != "" {
+		mods = append(mods, models.AuditLogWhere.ResourceID.EQ(null.StringFrom(params.ResourceID)))
+	}
+	if !params.From.IsZero() {
+		mods = append(mods, models.AuditLogWhere.CreatedAt.GTE(params.From))
+	}
+	if !params.To.IsZero() {
+		mods = append(mods, models.AuditLogWhere.CreatedAt.LT(params.To))
+	}
+	if strings.TrimSpace(params.Query) != "" {
+		mods = append(mods, db.ILikeSearch(params.Query, models.TableNames.AuditLogs+"."+models.AuditLogColumns.Details+"::text"))
+	}
+
+	return mods
+}
+
+// recordRead records the read of the audit log along with the filters applied.
+func recordRead(ctx context.Context, exec boil.ContextExecutor, params ListEntriesParams, action string, format string) error {
+	details := map[string]interface{}{
+		DetailFormat: format,
+	}
+	filters := map[string]string{
+		"user_id":       params.UserID,
+		"action":        params.Action,
+		"resource_type": params.ResourceType,
+		"resource_id":   params.ResourceID,
+		"query":         params.Query,
+		"cursor":        params.Cursor,
+	}
+	for key, value := range filters {
+		if value != "" {
+			details[key] = value
+		}
+	}
+	if !params.From.IsZero() {
+		details["from"] = params.From.UTC().Format(time.RFC3339Nano)
+	}
+	if !params.To.IsZero() {
+		details["to"] = params.To.UTC().Format(time.RFC3339Nano)
+	}
+
+	return Record(ctx, exec, Entry{
+		OrganizationID: params.OrganizationID,
+		UserID:         params.ReaderID,
+		Action:         action,
+		ResourceType:   ResourceTypeOrganization,
+		ResourceID:     params.OrganizationID,
+		Details:        details,
+	})
+}
+
+// afterCursor selects the entries following the cursor position in the newest first order.
+func afterCursor(createdAt time.Time, id string) qm.QueryMod {
+	return qm.Where(fmt.Sprintf("(%s, %s) < (?, ?)", models.AuditLogColumns.CreatedAt, models.AuditLogColumns.ID), createdAt, id)
+}
+
+func encodeCursor(createdAt time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.UTC().Format(time.RFC3339Nano) + "|" + id))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", httperrors.ErrBadRequestInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if _, err := uuid.Parse(id); !ok || err != nil {
+		return time.Time{}, "", httperrors.ErrBadRequestInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return time.Time{}, "", httperrors.ErrBadRequestInvalidCursor
+	}
+
+	return t, id, nil
+}
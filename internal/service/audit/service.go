@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"time"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// Reasons reported for broken links of a chain.
+const (
+	BrokenReasonSequenceGap         = "sequence_gap"
+	BrokenReasonPrevHashMismatch    = "prev_hash_mismatch"
+	BrokenReasonHashMismatch        = "hash_mismatch"
+	BrokenReasonCheckpointSignature = "checkpoint_signature_invalid"
+	BrokenReasonCheckpointMismatch  = "checkpoint_hash_mismatch"
+	BrokenReasonCheckpointMissing   = "checkpoint_entry_missing"
+)
+
+// BrokenLink describes the first entry of a chain failing verification.
+type BrokenLink struct {
+	// EntryID of the offending entry, empty if the entry is missing, e.g. as the chain was truncated.
+	EntryID  string
+	Sequence int64
+	Reason   string
+}
+
+// ChainReport summarizes the verification of a single chain.
+type ChainReport struct {
+	// OrganizationID of the chain, empty for the chain of entries without organization.
+	OrganizationID string
+	Entries        int64
+	Checkpoints    int64
+	HeadSequence   int64
+	HeadHash       string
+	// SignaturesVerified reports whether the signatures of the checkpoints were verified, which requires the
+	// checkpoint signing key to be configured.
+	SignaturesVerified bool
+	// Broken is nil if the chain was verified successfully.
+	Broken *BrokenLink
+}
+
+// ExportBundle holds a range of a chain along with everything required to verify it independently.
+type ExportBundle struct {
+	OrganizationID string
+	Entries        models.AuditLogSlice
+	Checkpoints    models.AuditCheckpointSlice
+	// NextSequence is the first sequence beyond the entries if the range exceeded the size of a bundle, zero otherwise.
+	NextSequence int64
+	// PublicKey verifies the signatures of the checkpoints, nil if checkpoints are disabled.
+	PublicKey ed25519.PublicKey
+	KeyID     string
+}
+
+// ListEntriesParams filters the audit log of an organization, empty values are not applied.
+type ListEntriesParams struct {
+	OrganizationID string
+	UserID         string
+	Action         string
+	ResourceType   string
+	ResourceID     string
+	From           time.Time
+	To             time.Time
+	// Query is matched against the details of the entries, each word has to be contained.
+	Query string
+	// Cursor continues a previous listing, as returned along with its entries.
+	Cursor string
+	Limit  int
+	// ReaderID of the user reading the audit log, the read itself is recorded.
+	ReaderID string
+}
+
+type Service interface {
+	// CreateCheckpoints signs the head of every chain which advanced since its last checkpoint.
+	CreateCheckpoints(ctx context.Context) error
+	// RunCheckpoints creates checkpoints periodically until the context is done.
+	RunCheckpoints(ctx context.Context)
+	// Verify walks all chains, reporting the first broken link of each.
+	Verify(ctx context.Context) ([]ChainReport, error)
+	// Export returns the entries of the organization's chain within the sequence range, zero bounds are open. Ranges
+	// exceeding the size of a bundle are cut, the bundle then reports the sequence to continue from.
+	Export(ctx context.Context, orgID string, fromSequence int64, toSequence int64, userID string) (*ExportBundle, error)
+	// ListEntries returns a page of matching entries, newest first, and the cursor of the next page, empty on the last page.
+	ListEntries(ctx context.Context, params ListEntriesParams) (models.AuditLogSlice, string, error)
+	// StreamEntries passes all matching entries, newest first, to fn. Cursor and limit of the params are ignored.
+	StreamEntries(ctx context.Context, params ListEntriesParams, format string, fn func(entry *models.AuditLog) error) error
+}
@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+)
+
+// Assertion is the WebAuthn assertion of a passkey confirming a sensitive operation, answering a challenge issued by
+// AssertionVerifier.BeginAssertion.
+type Assertion struct {
+	CredentialID      []byte
+	Signature         []byte
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+}
+
+// Operations confirmed with a passkey. Challenges are issued for one operation on one resource and only confirm that
+// operation.
+const (
+	AssertionActionApproveProposal   = "approve_proposal"
+	AssertionActionApproveRecovery   = "approve_recovery"
+	AssertionActionTransferOwnership = "transfer_ownership"
+	AssertionActionEnrollDevice      = "enroll_device"
+	AssertionActionCreateAsset       = "create_asset"
+	AssertionActionUpdateAsset       = "update_asset"
+)
+
+// AssertionScope is the operation a passkey challenge is issued for: the action and the ID of the resource it is
+// performed on (the proposal, recovery, organization or asset, the device ID for enrollments and the chain for new
+// assets).
+type AssertionScope struct {
+	Action     string
+	ResourceID string
+}
+
+// AssertionVerifier issues passkey challenges to users and verifies the assertions answering them. Every service
+// requiring an operation to be confirmed with a passkey verifies the assertion through it.
+type AssertionVerifier interface {
+	// BeginAssertion issues a challenge to be signed with one of the passkeys registered to the user, confirming the
+	// operation of the scope.
+	BeginAssertion(ctx context.Context, userID string, scope AssertionScope) (*protocol.CredentialAssertion, error)
+	// VerifyAssertion verifies the assertion of the user against a challenge issued to them for the scope: the origin
+	// and relying party, the signature over the challenge against the stored public key and the sign count of the
+	// passkey. The challenge is consumed and the sign count updated within exec. It returns
+	// httperrors.ErrForbiddenPasskeyRequired if the assertion is incomplete and
+	// httperrors.ErrForbiddenPasskeyAssertionInvalid if it does not verify.
+	VerifyAssertion(ctx context.Context, exec boil.ContextExecutor, userID string, scope AssertionScope, assertion Assertion) (*models.UserCredential, error)
+}
+
+func (s *Service) BeginAssertion(ctx context.Context, userID string, scope AssertionScope) (*protocol.CredentialAssertion, error) {
+	user, err := models.FindUser(ctx, s.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	webAuthnUser, err := loadCredentials(ctx, s.db, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credentials: %w", err)
+	}
+	if len(webAuthnUser.credentials) == 0 {
+		return nil, httperrors.ErrForbiddenPasskeyRequired
+	}
+
+	assertion, session, err := s.webAuthn.BeginLogin(webAuthnUser)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin assertion: %w", err)
+	}
+	if session.Expires.IsZero() {
+		session.Expires = time.Now().Add(time.Duration(assertion.Response.Timeout) * time.Millisecond)
+	}
+
+	sessionData, err := json.Marshal(session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal session data: %w", err)
+	}
+
+	// Challenges left unanswered are dropped along with issuing a new one.
+	if _, err := models.PasskeyChallenges(
+		models.PasskeyChallengeWhere.UserID.EQ(userID),
+		models.PasskeyChallengeWhere.ExpiresAt.LT(time.Now()),
+	).DeleteAll(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("failed to delete expired challenges: %w", err)
+	}
+
+	challenge := &models.PasskeyChallenge{
+		UserID:      userID,
+		Challenge:   session.Challenge,
+		SessionData: sessionData,
+		ExpiresAt:   session.Expires,
+		Action:      scope.Action,
+		ResourceID:  scope.ResourceID,
+	}
+	if err := challenge.Insert(ctx, s.db, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to insert challenge: %w", err)
+	}
+
+	return assertion, nil
+}
+
+func (s *Service) VerifyAssertion(ctx context.Context, exec boil.ContextExecutor, userID string, scope AssertionScope, assertion Assertion) (*models.UserCredential, error) {
+	if len(assertion.CredentialID) == 0 || len(assertion.Signature) == 0 || len(assertion.AuthenticatorData) == 0 || len(assertion.ClientDataJSON) == 0 {
+		return nil, httperrors.ErrForbiddenPasskeyRequired
+	}
+
+	log := util.LogFromContext(ctx).With().Str("user_id", userID).Str("action", scope.Action).Str("resource_id", scope.ResourceID).Logger()
+
+	var clientData protocol.CollectedClientData
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		log.Debug().Err(err).Msg("Failed to parse client data of passkey assertion")
+		return nil, httperrors.ErrForbiddenPasskeyAssertionInvalid
+	}
+
+	// The challenge is consumed by the transaction the assertion confirms, concurrent replays wait for it and find
+	// the challenge gone. Challenges issued for another operation do not confirm this one.
+	challenge, err := models.PasskeyChallenges(
+		models.PasskeyChallengeWhere.UserID.EQ(userID),
+		models.PasskeyChallengeWhere.Challenge.EQ(clientData.Challenge),
+		models.PasskeyChallengeWhere.Action.EQ(scope.Action),
+		models.PasskeyChallengeWhere.ResourceID.EQ(scope.ResourceID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Msg("Passkey assertion does not answer a challenge issued to the user for the operation")
+			return nil, httperrors.ErrForbiddenPasskeyAssertionInvalid
+		}
+		return nil, fmt.Errorf("failed to find challenge: %w", err)
+	}
+	if _, err := challenge.Delete(ctx, exec); err != nil {
+		return nil, fmt.Errorf("failed to consume challenge: %w", err)
+	}
+
+	var session webauthn.SessionData
+	if err := json.Unmarshal(challenge.SessionData, &session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session data: %w", err)
+	}
+
+	user, err := models.FindUser(ctx, exec, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	webAuthnUser, err := loadCredentials(ctx, exec, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credentials: %w", err)
+	}
+
+	parsed, err := protocol.CredentialAssertionResponse{
+		PublicKeyCredential: protocol.PublicKeyCredential{
+			Credential: protocol.Credential{
+				ID:   base64.RawURLEncoding.EncodeToString(assertion.CredentialID),
+				Type: string(protocol.PublicKeyCredentialType),
+			},
+			RawID: assertion.CredentialID,
+		},
+		AssertionResponse: protocol.AuthenticatorAssertionResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{
+				ClientDataJSON: assertion.ClientDataJSON,
+			},
+			AuthenticatorData: assertion.AuthenticatorData,
+			Signature:         assertion.Signature,
+		},
+	}.Parse()
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to parse passkey assertion")
+		return nil, httperrors.ErrForbiddenPasskeyAssertionInvalid
+	}
+
+	credential, err := s.webAuthn.ValidateLogin(webAuthnUser, session, parsed)
+	if err != nil {
+		log.Debug().Err(err).Msg("Passkey assertion failed to verify")
+		return nil, httperrors.ErrForbiddenPasskeyAssertionInvalid
+	}
+	// A sign count not increasing beyond the stored one hints at a cloned authenticator.
+	if credential.Authenticator.CloneWarning {
+		log.Warn().Str("credential_id", base64.RawURLEncoding.EncodeToString(credential.ID)).Msg("Sign count of passkey did not increase, refusing assertion")
+		return nil, httperrors.ErrForbiddenPasskeyAssertionInvalid
+	}
+
+	dbCred, err := models.UserCredentials(
+		models.UserCredentialWhere.UserID.EQ(userID),
+		models.UserCredentialWhere.CredentialID.EQ(string(credential.ID)),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credential: %w", err)
+	}
+	dbCred.SignCount = null.IntFrom(int(credential.Authenticator.SignCount))
+	dbCred.LastUsedAt = null.TimeFrom(time.Now())
+	if _, err := dbCred.Update(ctx, exec, boil.Whitelist(
+		models.UserCredentialColumns.SignCount,
+		models.UserCredentialColumns.LastUsedAt,
+		models.UserCredentialColumns.UpdatedAt,
+	)); err != nil {
+		return nil, fmt.Errorf("failed to update sign count: %w", err)
+	}
+
+	return dbCred, nil
+}
@@ -3,14 +3,19 @@ package auth
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
 	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
 )
 
 type Service struct {
@@ -60,29 +65,23 @@ func (s *Service) getUser(ctx context.Context, email string) (*WebAuthnUser, err
 		return nil, err
 	}
 
-	// Load credentials
-	creds, err := models.UserCredentials(models.UserCredentialWhere.UserID.EQ(user.ID)).All(ctx, s.db)
+	return loadCredentials(ctx, s.db, user)
+}
+
+// loadCredentials wraps the user along with the passkeys registered to them.
+func loadCredentials(ctx context.Context, exec boil.ContextExecutor, user *models.User) (*WebAuthnUser, error) {
+	creds, err := models.UserCredentials(models.UserCredentialWhere.UserID.EQ(user.ID)).All(ctx, exec)
 	if err != nil {
 		return nil, err
 	}
 
-	var webAuthnCreds []webauthn.Credential
+	webAuthnCreds := make([]webauthn.Credential, 0, len(creds))
 	for _, c := range creds {
-		// In a real implementation, we need to store and retrieve the full Credential struct or sufficient parts
-		// For now, let's assume we decode from DB or construct it.
-		// Since we only store PublicKey and ID in the migration, we might need to adjust the migration or reconstruction.
-		// NOTE: The migration I created has `credential_id` and `public_key`.
-		// `webauthn.Credential` has ID, PublicKey, AttestationType, etc.
-
-		// For simplicity in this PoC, we might need to store the blob.
-		// But let's try to reconstruct what we can.
-
-		webAuthnCreds = append(webAuthnCreds, webauthn.Credential{
-			ID:              []byte(c.CredentialID),
-			PublicKey:       []byte(c.PublicKey),
-			AttestationType: c.AttestationType.String,
-			// Authenticator: ...
-		})
+		credential, err := credentialFromModel(c)
+		if err != nil {
+			return nil, err
+		}
+		webAuthnCreds = append(webAuthnCreds, credential)
 	}
 
 	return &WebAuthnUser{
@@ -91,6 +90,27 @@ func (s *Service) getUser(ctx context.Context, email string) (*WebAuthnUser, err
 	}, nil
 }
 
+// credentialFromModel restores the stored passkey. The COSE public key is stored base64url encoded, the sign count and
+// backup eligibility are checked against every assertion.
+func credentialFromModel(c *models.UserCredential) (webauthn.Credential, error) {
+	publicKey, err := base64.RawURLEncoding.DecodeString(c.PublicKey)
+	if err != nil {
+		return webauthn.Credential{}, fmt.Errorf("failed to decode public key of credential %s: %w", c.ID, err)
+	}
+
+	return webauthn.Credential{
+		ID:              []byte(c.CredentialID),
+		PublicKey:       publicKey,
+		AttestationType: c.AttestationType.String,
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: c.BackupEligible,
+		},
+		Authenticator: webauthn.Authenticator{
+			SignCount: uint32(c.SignCount.Int), //nolint:gosec
+		},
+	}, nil
+}
+
 func (s *Service) BeginRegistration(ctx context.Context, email string) (*protocol.CredentialCreation, *webauthn.SessionData, error) {
 	user, err := s.getUser(ctx, email)
 	if err != nil {
@@ -115,17 +135,31 @@ func (s *Service) FinishRegistration(ctx context.Context, email string, sessionD
 	dbCred := &models.UserCredential{
 		UserID:          user.ID,
 		CredentialID:    string(credential.ID),
-		PublicKey:       string(credential.PublicKey),
+		PublicKey:       base64.RawURLEncoding.EncodeToString(credential.PublicKey),
 		AttestationType: null.StringFrom(credential.AttestationType),
-		// SignCount:       int(credential.Authenticator.SignCount),
+		SignCount:       null.IntFrom(int(credential.Authenticator.SignCount)),
+		BackupEligible:  credential.Flags.BackupEligible,
+	}
+	if aaguid, err := uuid.FromBytes(credential.Authenticator.AAGUID); err == nil {
+		dbCred.Aaguid = null.StringFrom(aaguid.String())
 	}
-	// Note: Authenticator AAGUID and SignCount are inside credential.Authenticator which is not exported fully in older versions?
-	// Checking recent webauthn lib, Credential struct has Authenticator field.
-	dbCred.Aaguid = null.StringFrom(string(credential.Authenticator.AAGUID))
-	dbCred.SignCount = null.IntFrom(int(credential.Authenticator.SignCount))
 
-	err = dbCred.Insert(ctx, s.db, boil.Infer())
-	if err != nil {
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := dbCred.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			UserID:       user.ID,
+			Action:       audit.ActionRegisterPasskey,
+			ResourceType: audit.ResourceTypeUser,
+			ResourceID:   user.ID,
+			CredentialID: credential.ID,
+			Details: map[string]interface{}{
+				"attestation_type": credential.AttestationType,
+			},
+		})
+	}); err != nil {
 		return nil, err
 	}
 
@@ -152,18 +186,30 @@ func (s *Service) FinishLogin(ctx context.Context, email string, sessionData web
 		return nil, err
 	}
 
-	// Update sign count
-	// We need to find the specific credential and update it
-	dbCred, err := models.UserCredentials(
-		models.UserCredentialWhere.UserID.EQ(user.ID),
-		models.UserCredentialWhere.CredentialID.EQ(string(credential.ID)),
-	).One(ctx, s.db)
-
-	if err == nil {
-		dbCred.SignCount = null.IntFrom(int(credential.Authenticator.SignCount))
-		if _, err := dbCred.Update(ctx, s.db, boil.Infer()); err != nil {
-			return nil, err
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		// Update sign count
+		// We need to find the specific credential and update it
+		dbCred, err := models.UserCredentials(
+			models.UserCredentialWhere.UserID.EQ(user.ID),
+			models.UserCredentialWhere.CredentialID.EQ(string(credential.ID)),
+		).One(ctx, exec)
+
+		if err == nil {
+			dbCred.SignCount = null.IntFrom(int(credential.Authenticator.SignCount))
+			if _, err := dbCred.Update(ctx, exec, boil.Infer()); err != nil {
+				return err
+			}
 		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			UserID:       user.ID,
+			Action:       audit.ActionLoginPasskey,
+			ResourceType: audit.ResourceTypeUser,
+			ResourceID:   user.ID,
+			CredentialID: credential.ID,
+		})
+	}); err != nil {
+		return nil, err
 	}
 
 	return credential, nil
@@ -9,6 +9,8 @@ import (
 )
 
 type AuthService interface {
+	AssertionVerifier
+
 	BeginRegistration(ctx context.Context, user string) (*protocol.CredentialCreation, *webauthn.SessionData, error)
 	FinishRegistration(ctx context.Context, user string, sessionData webauthn.SessionData, response *http.Request) (*webauthn.Credential, error)
 	BeginLogin(ctx context.Context, user string) (*protocol.CredentialAssertion, *webauthn.SessionData, error)
@@ -0,0 +1,232 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// healthKeysQuery lists the keys of the vaults and wallets of the active vaults belonging to an organization.
+const healthKeysQuery = `
+SELECT DISTINCT keys.key_id, keys.vault_id, vaults.organization_id
+FROM (
+	SELECT key_id, vault_id FROM vault_keys WHERE vault_id IS NOT NULL
+	UNION
+	SELECT key_id, vault_id FROM wallets
+) AS keys
+INNER JOIN vaults ON vaults.id = keys.vault_id
+WHERE vaults.organization_id IS NOT NULL AND vaults.status <> $1
+ORDER BY keys.key_id`
+
+type healthKey struct {
+	KeyID          string `boil:"key_id"`
+	VaultID        string `boil:"vault_id"`
+	OrganizationID string `boil:"organization_id"`
+}
+
+func (s *impl) Run(ctx context.Context) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Backup.HealthCheckInterval <= 0 {
+		log.Warn().Msg("No backup health check interval configured, skipping backup health checks")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Backup.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.CheckHealth(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to check backup health")
+			}
+		}
+	}
+}
+
+func (s *impl) CheckHealth(ctx context.Context) error {
+	log := util.LogFromContext(ctx)
+
+	var keys []*healthKey
+	if err := queries.Raw(healthKeysQuery, vault.StatusArchived).Bind(ctx, s.db, &keys); err != nil {
+		return fmt.Errorf("failed to list keys to check backup health: %w", err)
+	}
+
+	// Keys becoming at risk by organization, alerted once all keys were checked.
+	degraded := make(map[string][]string)
+	keyIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		keyIDs = append(keyIDs, key.KeyID)
+
+		becameAtRisk, err := s.checkKeyHealth(ctx, key)
+		if err != nil {
+			log.Error().Err(err).Str("key_id", key.KeyID).Msg("Failed to check backup health of key")
+			continue
+		}
+		if becameAtRisk {
+			degraded[key.OrganizationID] = append(degraded[key.OrganizationID], key.KeyID)
+		}
+	}
+
+	// Keys of vaults archived or removed since are no longer reported.
+	stale := models.KeyBackupHealths()
+	if len(keyIDs) > 0 {
+		stale = models.KeyBackupHealths(models.KeyBackupHealthWhere.KeyID.NIN(keyIDs))
+	}
+	if _, err := stale.DeleteAll(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to delete stale backup health: %w", err)
+	}
+
+	for orgID, ids := range degraded {
+		log.Warn().Str("organization_id", orgID).Strs("key_ids", ids).Msg("Backup health of keys degraded")
+		s.notification.NotifyBackupDegraded(ctx, orgID, ids)
+	}
+
+	return nil
+}
+
+// checkKeyHealth queries the backup state of the key and caches it, reporting whether the key became at risk. Failing
+// queries are recorded along the last known state, the key is not considered at risk for them alone.
+func (s *impl) checkKeyHealth(ctx context.Context, key *healthKey) (bool, error) {
+	existing, err := models.FindKeyBackupHealth(ctx, s.db, key.KeyID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("failed to find backup health: %w", err)
+	}
+
+	health := &models.KeyBackupHealth{
+		KeyID:          key.KeyID,
+		OrganizationID: key.OrganizationID,
+		VaultID:        key.VaultID,
+		Nodes:          []byte("[]"),
+		CheckedAt:      s.clock.Now(),
+	}
+	if existing != nil {
+		health.AtRisk = existing.AtRisk
+		health.Nodes = existing.Nodes
+		health.DegradedAt = existing.DegradedAt
+	}
+
+	nodes, err := s.queryNodeHealth(ctx, key.KeyID)
+	if err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Str("key_id", key.KeyID).Msg("Failed to query backup state of MPC server")
+		health.Error = null.StringFrom(err.Error())
+	} else {
+		health.Nodes, err = json.Marshal(nodes)
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal node backup health: %w", err)
+		}
+
+		// Keys without any backup state cannot be recovered either.
+		health.AtRisk = len(nodes) == 0
+		for _, node := range nodes {
+			if node.AtRisk {
+				health.AtRisk = true
+			}
+		}
+	}
+
+	becameAtRisk := health.AtRisk && (existing == nil || !existing.AtRisk)
+	switch {
+	case becameAtRisk:
+		health.DegradedAt = null.TimeFrom(health.CheckedAt)
+	case !health.AtRisk:
+		health.DegradedAt = null.Time{}
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := health.Upsert(ctx, exec, true,
+			[]string{models.KeyBackupHealthColumns.KeyID},
+			boil.Whitelist(
+				models.KeyBackupHealthColumns.OrganizationID,
+				models.KeyBackupHealthColumns.VaultID,
+				models.KeyBackupHealthColumns.AtRisk,
+				models.KeyBackupHealthColumns.Nodes,
+				models.KeyBackupHealthColumns.Error,
+				models.KeyBackupHealthColumns.CheckedAt,
+				models.KeyBackupHealthColumns.DegradedAt,
+				models.KeyBackupHealthColumns.UpdatedAt,
+			),
+			boil.Infer(),
+		); err != nil {
+			return fmt.Errorf("failed to upsert backup health: %w", err)
+		}
+
+		if !becameAtRisk {
+			return nil
+		}
+		return outbox.Enqueue(ctx, exec, outbox.Event{
+			OrganizationID: key.OrganizationID,
+			Type:           outbox.EventKeyBackupDegraded,
+			ResourceType:   audit.ResourceTypeKey,
+			ResourceID:     key.KeyID,
+			Data: map[string]interface{}{
+				"key_id":   key.KeyID,
+				"vault_id": key.VaultID,
+				"nodes":    json.RawMessage(health.Nodes),
+			},
+		})
+	}); err != nil {
+		return false, err
+	}
+
+	return becameAtRisk, nil
+}
+
+// queryNodeHealth returns the backup state of every node of the key, a node is at risk if fewer backup shares exist
+// than are required to recover its share or the MPC server reports it as not recoverable.
+func (s *impl) queryNodeHealth(ctx context.Context, keyID string) ([]NodeHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Backup.HealthCheckTimeout)
+	defer cancel()
+
+	statuses, err := s.client.GetBackupStatus(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backup status: %w", err)
+	}
+	shares, err := s.client.ListBackupShares(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backup shares: %w", err)
+	}
+
+	nodes := make([]NodeHealth, 0, len(statuses))
+	for _, status := range statuses {
+		nodes = append(nodes, NodeHealth{
+			NodeID:         status.NodeID,
+			TotalShares:    status.TotalShares,
+			RequiredShares: status.RequiredShares,
+			BackupShares:   shares[status.NodeID],
+			Recoverable:    status.Recoverable,
+			AtRisk:         status.TotalShares < status.RequiredShares || !status.Recoverable,
+		})
+	}
+
+	return nodes, nil
+}
+
+func (s *impl) GetHealth(ctx context.Context, orgID string) (models.KeyBackupHealthSlice, error) {
+	health, err := models.KeyBackupHealths(
+		models.KeyBackupHealthWhere.OrganizationID.EQ(orgID),
+		qm.OrderBy(models.KeyBackupHealthColumns.AtRisk+" DESC, "+models.KeyBackupHealthColumns.VaultID+", "+models.KeyBackupHealthColumns.KeyID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backup health: %w", err)
+	}
+
+	return health, nil
+}
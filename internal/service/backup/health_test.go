@@ -0,0 +1,127 @@
+package backup_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc/fake"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckHealth(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// The backup state is queried from a fake MPC server of its own, so backup shares can be lost.
+		server := fake.NewServer()
+		conn, err := server.Connect()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = conn.Close()
+		})
+		client, err := mpc.NewBackupClient(conn, mpc.BackupConfig{})
+		require.NoError(t, err)
+		svc := backup.NewService(s.Config, s.DB, s.Clock, client, s.Notification, nil)
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		v := &models.Vault{
+			OrganizationID: null.StringFrom(org.ID),
+			Name:           "Treasury",
+			Threshold:      1,
+			Status:         vault.StatusActive,
+		}
+		require.NoError(t, v.Insert(ctx, s.DB, boil.Infer()))
+
+		spec := mpc.KeySpec{Algorithm: mpc.AlgorithmECDSA, Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20}
+		key, err := mpc.NewKeyClient(conn).CreateKey(ctx, "", spec)
+		require.NoError(t, err)
+		// The EdDSA key is unknown to the MPC server.
+		for _, vaultKey := range []*models.VaultKey{
+			{KeyID: key.KeyID, Algorithm: spec.Algorithm, Curve: spec.Curve, PublicKeyHex: key.PublicKey},
+			{KeyID: "unknown", Algorithm: mpc.AlgorithmEdDSA, Curve: mpc.CurveEd25519, PublicKeyHex: key.PublicKey},
+		} {
+			vaultKey.VaultID = null.StringFrom(v.ID)
+			require.NoError(t, vaultKey.Insert(ctx, s.DB, boil.Infer()))
+		}
+
+		healthOf := func(keyID string) *models.KeyBackupHealth {
+			t.Helper()
+			health, err := svc.GetHealth(ctx, org.ID)
+			require.NoError(t, err)
+			for _, h := range health {
+				if h.KeyID == keyID {
+					return h
+				}
+			}
+			require.FailNow(t, "backup health not reported", keyID)
+			return nil
+		}
+		degradedEvents := func() int64 {
+			t.Helper()
+			count, err := models.OutboxEvents(
+				models.OutboxEventWhere.EventType.EQ(outbox.EventKeyBackupDegraded),
+				models.OutboxEventWhere.ResourceID.EQ(key.KeyID),
+			).Count(ctx, s.DB)
+			require.NoError(t, err)
+			return count
+		}
+
+		require.NoError(t, svc.CheckHealth(ctx))
+		health := healthOf(key.KeyID)
+		assert.False(t, health.AtRisk)
+		assert.False(t, health.Error.Valid)
+		assert.False(t, health.DegradedAt.Valid)
+		var nodes []backup.NodeHealth
+		require.NoError(t, json.Unmarshal(health.Nodes, &nodes))
+		require.Len(t, nodes, key.TotalNodes)
+
+		// Keys the MPC server fails to report on are recorded with the error, but not considered at risk.
+		unknown := healthOf("unknown")
+		assert.False(t, unknown.AtRisk)
+		assert.True(t, unknown.Error.Valid)
+
+		// Too few backup shares of a single node put the key at risk, which is announced once.
+		server.LoseBackupShares(key.KeyID, nodes[0].NodeID)
+		require.NoError(t, svc.CheckHealth(ctx))
+		health = healthOf(key.KeyID)
+		assert.True(t, health.AtRisk)
+		assert.True(t, health.DegradedAt.Valid)
+		require.NoError(t, json.Unmarshal(health.Nodes, &nodes))
+		assert.True(t, nodes[0].AtRisk)
+		assert.Equal(t, 1, nodes[0].BackupShares)
+		assert.False(t, nodes[1].AtRisk)
+		assert.Equal(t, int64(1), degradedEvents())
+
+		require.NoError(t, svc.CheckHealth(ctx))
+		assert.True(t, healthOf(key.KeyID).AtRisk)
+		assert.Equal(t, int64(1), degradedEvents())
+
+		// Keys at risk are reported first.
+		all, err := svc.GetHealth(ctx, org.ID)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, key.KeyID, all[0].KeyID)
+
+		// Keys of archived vaults are no longer reported.
+		v.Status = vault.StatusArchived
+		_, err = v.Update(ctx, s.DB, boil.Whitelist(models.VaultColumns.Status))
+		require.NoError(t, err)
+		require.NoError(t, svc.CheckHealth(ctx))
+		all, err = svc.GetHealth(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+}
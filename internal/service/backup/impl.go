@@ -0,0 +1,335 @@
+package backup
+
+import (
+	"context"
+	"crypto/ecdh"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/notification"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+type impl struct {
+	config       config.Server
+	db           *sql.DB
+	clock        time2.Clock
+	client       *mpc.BackupClient
+	notification notification.Service
+	passkeys     auth.AssertionVerifier
+}
+
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, client *mpc.BackupClient, notificationService notification.Service, passkeys auth.AssertionVerifier) Service {
+	return &impl{
+		config:       config,
+		db:           db,
+		clock:        clock,
+		client:       client,
+		notification: notificationService,
+		passkeys:     passkeys,
+	}
+}
+
+func (s *impl) DeliverShare(ctx context.Context, params DeliverShareParams) (*Delivery, error) {
+	if !validDevicePublicKey(params.DevicePublicKey) {
+		return nil, httperrors.ErrBadRequestInvalidDevicePublicKey
+	}
+
+	v, err := s.findKeyVault(ctx, s.db, params.KeyID, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if v.Status == vault.StatusArchived {
+		return nil, httperrors.ErrConflictVaultArchived
+	}
+
+	ref := shareRef(params.UserID, params.KeyID, params.ShareIndex)
+	share, err := s.client.RequestShareDelivery(ctx, ref, params.DevicePublicKey)
+	if err != nil {
+		return nil, deliveryError(ctx, err)
+	}
+
+	backup := &models.KeyShareBackup{
+		OrganizationID:  v.OrganizationID.String,
+		UserID:          params.UserID,
+		KeyID:           params.KeyID,
+		ShareIndex:      params.ShareIndex,
+		Status:          StatusDelivered,
+		DevicePublicKey: params.DevicePublicKey,
+		DeliveredAt:     s.clock.Now(),
+	}
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		// Delivering the share again, e.g. to a new device, restarts the backup.
+		if err := backup.Upsert(ctx, exec, true,
+			[]string{models.KeyShareBackupColumns.UserID, models.KeyShareBackupColumns.KeyID},
+			boil.Whitelist(
+				models.KeyShareBackupColumns.ShareIndex,
+				models.KeyShareBackupColumns.Status,
+				models.KeyShareBackupColumns.DevicePublicKey,
+				models.KeyShareBackupColumns.DeliveredAt,
+				models.KeyShareBackupColumns.ConfirmedAt,
+				models.KeyShareBackupColumns.FailureReason,
+				models.KeyShareBackupColumns.UpdatedAt,
+			),
+			boil.Infer(),
+		); err != nil {
+			return fmt.Errorf("failed to upsert key share backup: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: backup.OrganizationID,
+			UserID:         params.UserID,
+			Action:         audit.ActionDeliverKeyShare,
+			ResourceType:   audit.ResourceTypeKeyShare,
+			ResourceID:     params.KeyID,
+			Details: map[string]interface{}{
+				"vault_id":    v.ID,
+				"share_index": params.ShareIndex,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return &Delivery{
+		Backup:         backup,
+		EncryptedShare: share.EncryptedShare,
+		Signature:      share.Signature,
+		Timestamp:      share.Timestamp,
+	}, nil
+}
+
+func (s *impl) ConfirmShare(ctx context.Context, params ConfirmShareParams) (*models.KeyShareBackup, error) {
+	if _, err := s.findKeyVault(ctx, s.db, params.KeyID, params.UserID); err != nil {
+		return nil, err
+	}
+
+	backup, err := s.findBackup(ctx, s.db, params.UserID, params.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if backup == nil {
+		return nil, httperrors.ErrConflictShareNotDelivered
+	}
+
+	ref := shareRef(params.UserID, params.KeyID, backup.ShareIndex)
+	if err := s.client.ConfirmShareDelivery(ctx, ref, params.ReceivedSuccessfully, params.FailureReason); err != nil {
+		return nil, fmt.Errorf("failed to confirm share delivery: %w", err)
+	}
+
+	if params.ReceivedSuccessfully {
+		backup.Status = StatusConfirmed
+		backup.ConfirmedAt = null.TimeFrom(s.clock.Now())
+		backup.FailureReason = null.String{}
+	} else {
+		backup.Status = StatusFailed
+		backup.ConfirmedAt = null.Time{}
+		backup.FailureReason = null.NewString(params.FailureReason, params.FailureReason != "")
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if _, err := backup.Update(ctx, exec, boil.Whitelist(
+			models.KeyShareBackupColumns.Status,
+			models.KeyShareBackupColumns.ConfirmedAt,
+			models.KeyShareBackupColumns.FailureReason,
+			models.KeyShareBackupColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update key share backup: %w", err)
+		}
+
+		details := map[string]interface{}{
+			"share_index": backup.ShareIndex,
+			"status":      backup.Status,
+		}
+		if backup.FailureReason.Valid {
+			details["failure_reason"] = backup.FailureReason.String
+		}
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: backup.OrganizationID,
+			UserID:         params.UserID,
+			Action:         audit.ActionConfirmKeyShare,
+			ResourceType:   audit.ResourceTypeKeyShare,
+			ResourceID:     params.KeyID,
+			Details:        details,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+func (s *impl) GetShareStatus(ctx context.Context, userID string, keyID string) (*ShareStatus, error) {
+	if _, err := s.findKeyVault(ctx, s.db, keyID, userID); err != nil {
+		return nil, err
+	}
+
+	backup, err := s.findBackup(ctx, s.db, userID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	status := &ShareStatus{Backup: backup}
+	if backup == nil {
+		return status, nil
+	}
+
+	// The state recorded by the vault is returned even if the MPC server cannot be reached.
+	status.Infra, err = s.client.QueryShareStatus(ctx, shareRef(userID, keyID, backup.ShareIndex))
+	if err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Str("key_id", keyID).Msg("Failed to query share status of MPC server")
+	}
+
+	return status, nil
+}
+
+// listBackupsQuery pairs every key of the active vaults of the organization with every user eligible to approve
+// within the organization, reporting pairs without backup as missing.
+const listBackupsQuery = `
+WITH keys AS (
+	SELECT DISTINCT wallets.vault_id, wallets.key_id
+	FROM wallets
+	INNER JOIN vaults ON vaults.id = wallets.vault_id
+	WHERE vaults.organization_id = $1 AND vaults.status <> $2
+), users AS (
+	SELECT owner_id AS user_id FROM organizations WHERE id = $1
+	UNION
+	SELECT user_id FROM organization_members WHERE organization_id = $1 AND role <> $3
+), entries AS (
+	SELECT keys.vault_id, keys.key_id, users.user_id,
+		COALESCE(key_share_backups.status, $4) AS status,
+		key_share_backups.share_index, key_share_backups.delivered_at,
+		key_share_backups.confirmed_at, key_share_backups.failure_reason
+	FROM keys
+	CROSS JOIN users
+	LEFT JOIN key_share_backups ON key_share_backups.key_id = keys.key_id AND key_share_backups.user_id = users.user_id
+)
+SELECT %s FROM entries
+WHERE ($5 = '' OR vault_id::text = $5) AND ($6 = '' OR status = $6)
+%s`
+
+func (s *impl) ListBackups(ctx context.Context, params ListBackupsParams) ([]*BackupEntry, int64, error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	page := params.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	args := []interface{}{
+		params.OrganizationID,
+		vault.StatusArchived,
+		organization.RoleAuditor,
+		StatusMissing,
+		params.VaultID,
+		params.Status,
+	}
+
+	var count struct {
+		Total int64 `boil:"total"`
+	}
+	if err := queries.Raw(fmt.Sprintf(listBackupsQuery, "COUNT(*) AS total", ""), args...).Bind(ctx, s.db, &count); err != nil {
+		return nil, 0, fmt.Errorf("failed to count key share backups: %w", err)
+	}
+
+	var entries []*BackupEntry
+	query := fmt.Sprintf(listBackupsQuery, "*", "ORDER BY vault_id, key_id, user_id LIMIT $7 OFFSET $8")
+	if err := queries.Raw(query, append(args, limit, (page-1)*limit)...).Bind(ctx, s.db, &entries); err != nil {
+		return nil, 0, fmt.Errorf("failed to list key share backups: %w", err)
+	}
+
+	return entries, count.Total, nil
+}
+
+// findKeyVault returns the vault of the key if the user holds a share of it, i.e. is eligible to approve within the
+// vault.
+func (s *impl) findKeyVault(ctx context.Context, exec boil.ContextExecutor, keyID string, userID string) (*models.Vault, error) {
+	wallet, err := models.Wallets(
+		models.WalletWhere.KeyID.EQ(keyID),
+		qm.Load(models.WalletRels.Vault),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundKeyShare
+		}
+		return nil, fmt.Errorf("failed to find wallet of key: %w", err)
+	}
+
+	v := wallet.R.GetVault()
+	if v == nil || !v.OrganizationID.Valid {
+		return nil, httperrors.ErrNotFoundKeyShare
+	}
+
+	approvers, err := vault.EligibleApprovers(ctx, exec, v)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := approvers[userID]; !ok {
+		return nil, httperrors.ErrNotFoundKeyShare
+	}
+
+	return v, nil
+}
+
+func (s *impl) findBackup(ctx context.Context, exec boil.ContextExecutor, userID string, keyID string) (*models.KeyShareBackup, error) {
+	backup, err := models.KeyShareBackups(
+		models.KeyShareBackupWhere.UserID.EQ(userID),
+		models.KeyShareBackupWhere.KeyID.EQ(keyID),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find key share backup: %w", err)
+	}
+
+	return backup, nil
+}
+
+func shareRef(userID string, keyID string, shareIndex int) mpc.ShareRef {
+	return mpc.ShareRef{
+		ClientID:   userID,
+		KeyID:      keyID,
+		NodeID:     mpc.ClientNodeID(userID),
+		ShareIndex: int32(shareIndex), //nolint:gosec
+	}
+}
+
+// validDevicePublicKey reports whether the key is a X25519 key or an uncompressed P-256 key the share can be
+// encrypted to.
+func validDevicePublicKey(key []byte) bool {
+	if _, err := ecdh.X25519().NewPublicKey(key); err == nil {
+		return true
+	}
+	_, err := ecdh.P256().NewPublicKey(key)
+	return err == nil
+}
+
+// deliveryError maps deliveries failing verification to errors of the API, other errors are passed through.
+func deliveryError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, mpc.ErrShareVerificationKeyMissing):
+		return httperrors.ErrServiceUnavailableShareDelivery
+	case errors.Is(err, mpc.ErrInvalidShareSignature), errors.Is(err, mpc.ErrStaleShareDelivery):
+		util.LogFromContext(ctx).Warn().Err(err).Msg("Refusing share delivery of MPC server")
+		return httperrors.ErrBadGatewayInvalidShareDelivery
+	default:
+		return fmt.Errorf("failed to request share delivery: %w", err)
+	}
+}
@@ -0,0 +1,223 @@
+package backup_test
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	infra "github.com/kashguard/go-mpc-vault/internal/infra/grpc/v1"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// deliveryServer delivers shares signed with its key and keeps the state of the shares confirmed, signatures are
+// forged if tamper is set.
+type deliveryServer struct {
+	infra.UnimplementedBackupDeliveryServiceServer
+	key ed25519.PrivateKey
+
+	mu       sync.Mutex
+	tamper   bool
+	statuses map[string]*infra.ShareStatusResponse
+}
+
+func (d *deliveryServer) setTamper(tamper bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tamper = tamper
+}
+
+func (d *deliveryServer) RequestShareDelivery(_ context.Context, req *infra.ShareDeliveryRequest) (*infra.ShareDeliveryResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ref := mpc.ShareRef{ClientID: req.GetClientId(), KeyID: req.GetKeyId(), NodeID: req.GetNodeId(), ShareIndex: req.GetShareIndex()}
+	now := time.Now().Unix()
+	share := append([]byte("share encrypted to "), req.GetClientPublicKey()...)
+	signature := ed25519.Sign(d.key, mpc.ShareDeliveryDigest(ref, now, share))
+	if d.tamper {
+		signature[0] ^= 0xff
+	}
+	d.statuses[req.GetClientId()] = &infra.ShareStatusResponse{Status: backup.StatusDelivered, DeliveredAt: now}
+
+	return &infra.ShareDeliveryResponse{EncryptedShare: share, Signature: signature, Timestamp: now}, nil
+}
+
+func (d *deliveryServer) ConfirmShareDelivery(_ context.Context, req *infra.ShareConfirmationRequest) (*infra.ShareConfirmationResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	status, ok := d.statuses[req.GetClientId()]
+	if !ok {
+		return &infra.ShareConfirmationResponse{Message: "share not delivered"}, nil
+	}
+	if req.GetReceivedSuccessfully() {
+		status.Status = backup.StatusConfirmed
+		status.ConfirmedAt = time.Now().Unix()
+	} else {
+		status.Status = backup.StatusFailed
+		status.FailureReason = req.GetFailureReason()
+	}
+
+	return &infra.ShareConfirmationResponse{Confirmed: true}, nil
+}
+
+func (d *deliveryServer) QueryShareStatus(_ context.Context, req *infra.ShareStatusQuery) (*infra.ShareStatusResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if status, ok := d.statuses[req.GetClientId()]; ok {
+		return status, nil
+	}
+	return &infra.ShareStatusResponse{}, nil
+}
+
+// newDeliveryService serves the delivery server in process, returning a backup service verifying its deliveries.
+func newDeliveryService(t *testing.T, s *api.Server, delivery *deliveryServer) backup.Service {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(delivery.key.Public())
+	require.NoError(t, err)
+	verifyKeyFile := filepath.Join(t.TempDir(), "verify.pem")
+	require.NoError(t, os.WriteFile(verifyKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	infra.RegisterBackupDeliveryServiceServer(srv, delivery)
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///delivery",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	client, err := mpc.NewBackupClient(conn, mpc.BackupConfig{VerifyKeyFile: verifyKeyFile, MaxClockSkew: time.Minute})
+	require.NoError(t, err)
+
+	return backup.NewService(s.Config, s.DB, s.Clock, client, s.Notification, nil)
+}
+
+func TestDeliverShare(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		delivery := &deliveryServer{key: key, statuses: make(map[string]*infra.ShareStatusResponse)}
+		svc := newDeliveryService(t, s, delivery)
+
+		auditor := insertAdmins(t, s, 1)[0]
+		org, keyID := setupRecovery(t, s, fix.User2.ID)
+		_, err = s.Organization.AddMember(ctx, org.ID, auditor.ID, organization.RoleAuditor, fix.User1.ID)
+		require.NoError(t, err)
+
+		device, err := ecdh.X25519().GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		params := backup.DeliverShareParams{UserID: fix.User1.ID, KeyID: keyID, ShareIndex: 1, DevicePublicKey: device.PublicKey().Bytes()}
+
+		invalid := params
+		invalid.DevicePublicKey = []byte("not a key")
+		_, err = svc.DeliverShare(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestInvalidDevicePublicKey)
+
+		// Only users eligible to approve within the vault hold a share of its keys.
+		invalid = params
+		invalid.UserID = auditor.ID
+		_, err = svc.DeliverShare(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrNotFoundKeyShare)
+		invalid = params
+		invalid.KeyID = "unknown"
+		_, err = svc.DeliverShare(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrNotFoundKeyShare)
+
+		status, err := svc.GetShareStatus(ctx, fix.User1.ID, keyID)
+		require.NoError(t, err)
+		assert.Nil(t, status.Backup)
+		_, err = svc.ConfirmShare(ctx, backup.ConfirmShareParams{UserID: fix.User1.ID, KeyID: keyID, ReceivedSuccessfully: true})
+		require.ErrorIs(t, err, httperrors.ErrConflictShareNotDelivered)
+
+		// Deliveries failing verification are refused without being recorded.
+		delivery.setTamper(true)
+		_, err = svc.DeliverShare(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrBadGatewayInvalidShareDelivery)
+		status, err = svc.GetShareStatus(ctx, fix.User1.ID, keyID)
+		require.NoError(t, err)
+		assert.Nil(t, status.Backup)
+		delivery.setTamper(false)
+
+		delivered, err := svc.DeliverShare(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, backup.StatusDelivered, delivered.Backup.Status)
+		assert.Equal(t, org.ID, delivered.Backup.OrganizationID)
+		assert.NotEmpty(t, delivered.EncryptedShare)
+		assert.NotEmpty(t, delivered.Signature)
+
+		confirmed, err := svc.ConfirmShare(ctx, backup.ConfirmShareParams{UserID: fix.User1.ID, KeyID: keyID, FailureReason: "storage full"})
+		require.NoError(t, err)
+		assert.Equal(t, backup.StatusFailed, confirmed.Status)
+		assert.Equal(t, "storage full", confirmed.FailureReason.String)
+		assert.False(t, confirmed.ConfirmedAt.Valid)
+
+		// Delivering the share again restarts the backup.
+		_, err = svc.DeliverShare(ctx, params)
+		require.NoError(t, err)
+		confirmed, err = svc.ConfirmShare(ctx, backup.ConfirmShareParams{UserID: fix.User1.ID, KeyID: keyID, ReceivedSuccessfully: true})
+		require.NoError(t, err)
+		assert.Equal(t, backup.StatusConfirmed, confirmed.Status)
+		assert.True(t, confirmed.ConfirmedAt.Valid)
+		assert.False(t, confirmed.FailureReason.Valid)
+
+		status, err = svc.GetShareStatus(ctx, fix.User1.ID, keyID)
+		require.NoError(t, err)
+		require.NotNil(t, status.Backup)
+		assert.Equal(t, backup.StatusConfirmed, status.Backup.Status)
+		require.NotNil(t, status.Infra)
+		assert.Equal(t, backup.StatusConfirmed, status.Infra.Status)
+
+		// Users eligible to approve without backup are reported as missing, auditors are not reported.
+		entries, total, err := svc.ListBackups(ctx, backup.ListBackupsParams{OrganizationID: org.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		statuses := make(map[string]string, len(entries))
+		for _, entry := range entries {
+			assert.Equal(t, keyID, entry.KeyID)
+			statuses[entry.UserID] = entry.Status
+		}
+		assert.Equal(t, map[string]string{fix.User1.ID: backup.StatusConfirmed, fix.User2.ID: backup.StatusMissing}, statuses)
+
+		entries, total, err = svc.ListBackups(ctx, backup.ListBackupsParams{OrganizationID: org.ID, Status: backup.StatusMissing})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, entries, 1)
+		assert.Equal(t, fix.User2.ID, entries[0].UserID)
+	})
+}
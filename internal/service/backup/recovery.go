@@ -0,0 +1,440 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// KeyFrozen reports whether a recovery of a share of the key is pending or recovering, signing with the key is
+// refused until the recovery completed, failed or was rejected.
+func KeyFrozen(ctx context.Context, exec boil.ContextExecutor, keyID string) (bool, error) {
+	frozen, err := models.KeyShareRecoveries(
+		models.KeyShareRecoveryWhere.KeyID.EQ(keyID),
+		models.KeyShareRecoveryWhere.Status.IN([]string{RecoveryStatusPending, RecoveryStatusRecovering}),
+	).Exists(ctx, exec)
+	if err != nil {
+		return false, fmt.Errorf("failed to check key recoveries: %w", err)
+	}
+
+	return frozen, nil
+}
+
+func (s *impl) RequestRecovery(ctx context.Context, params RequestRecoveryParams) (*models.KeyShareRecovery, error) {
+	var recovery *models.KeyShareRecovery
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		role, err := organization.MemberRole(ctx, exec, params.OrganizationID, params.InitiatorID)
+		if err != nil {
+			return err
+		}
+		if params.InitiatorID != params.SubjectUserID && !isAdmin(role) {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		v, err := s.findKeyVault(ctx, exec, params.KeyID, params.SubjectUserID)
+		if err != nil {
+			return err
+		}
+		if v.OrganizationID.String != params.OrganizationID {
+			return httperrors.ErrNotFoundKeyShare
+		}
+
+		frozen, err := KeyFrozen(ctx, exec, params.KeyID)
+		if err != nil {
+			return err
+		}
+		if frozen {
+			return httperrors.ErrConflictKeyRecoveryInProgress
+		}
+
+		approvers, err := recoveryApprovers(ctx, exec, params.OrganizationID, params.InitiatorID, params.SubjectUserID)
+		if err != nil {
+			return err
+		}
+		if len(approvers) == 0 {
+			return httperrors.ErrConflictNoRecoveryApprovers
+		}
+
+		// Recoveries must be approved by as many admins as transfers of the vault, as far as there are enough admins.
+		required := vault.RequiredApprovals(v, approvers)
+
+		recovery = &models.KeyShareRecovery{
+			ID:                uuid.New().String(),
+			OrganizationID:    params.OrganizationID,
+			VaultID:           v.ID,
+			KeyID:             params.KeyID,
+			NodeID:            mpc.ClientNodeID(params.SubjectUserID),
+			SubjectUserID:     params.SubjectUserID,
+			InitiatorID:       null.StringFrom(params.InitiatorID),
+			Reason:            null.NewString(params.Reason, params.Reason != ""),
+			RequiredApprovals: required,
+			Status:            RecoveryStatusPending,
+		}
+		if err := recovery.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert key recovery: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: params.OrganizationID,
+			UserID:         params.InitiatorID,
+			Action:         audit.ActionRequestKeyRecovery,
+			ResourceType:   audit.ResourceTypeKeyRecovery,
+			ResourceID:     recovery.ID,
+			Details: map[string]interface{}{
+				"vault_id":           v.ID,
+				"key_id":             params.KeyID,
+				"node_id":            recovery.NodeID,
+				"subject_user_id":    params.SubjectUserID,
+				"required_approvals": required,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return recovery, nil
+}
+
+func (s *impl) GetRecovery(ctx context.Context, orgID string, recoveryID string) (*models.KeyShareRecovery, error) {
+	recovery, err := models.KeyShareRecoveries(
+		models.KeyShareRecoveryWhere.ID.EQ(recoveryID),
+		models.KeyShareRecoveryWhere.OrganizationID.EQ(orgID),
+		qm.Load(models.KeyShareRecoveryRels.RecoveryKeyShareRecoveryApprovals),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundKeyRecovery
+		}
+		return nil, fmt.Errorf("failed to find key recovery: %w", err)
+	}
+
+	return recovery, nil
+}
+
+func (s *impl) ListRecoveries(ctx context.Context, params ListRecoveriesParams) (models.KeyShareRecoverySlice, int64, error) {
+	mods := []qm.QueryMod{
+		models.KeyShareRecoveryWhere.OrganizationID.EQ(params.OrganizationID),
+	}
+	if params.Status != "" {
+		mods = append(mods, models.KeyShareRecoveryWhere.Status.EQ(params.Status))
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	page := params.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	total, err := models.KeyShareRecoveries(mods...).Count(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count key recoveries: %w", err)
+	}
+
+	mods = append(mods,
+		qm.Load(models.KeyShareRecoveryRels.RecoveryKeyShareRecoveryApprovals),
+		qm.OrderBy(models.KeyShareRecoveryColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+		qm.Offset((page-1)*limit),
+	)
+	recoveries, err := models.KeyShareRecoveries(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list key recoveries: %w", err)
+	}
+
+	return recoveries, total, nil
+}
+
+func (s *impl) ApproveRecovery(ctx context.Context, orgID string, recoveryID string, params RecoveryApprovalParams) (*models.KeyShareRecovery, error) {
+	var recovery *models.KeyShareRecovery
+	var approved bool
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var approvers map[string]struct{}
+		var err error
+		recovery, approvers, err = lockPendingRecovery(ctx, exec, orgID, recoveryID, params.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Recoveries must be confirmed with a passkey registered to the approving admin.
+		if _, err := s.passkeys.VerifyAssertion(ctx, exec, params.UserID, auth.AssertionScope{
+			Action:     auth.AssertionActionApproveRecovery,
+			ResourceID: recoveryID,
+		}, auth.Assertion{
+			CredentialID:      params.CredentialID,
+			Signature:         params.Signature,
+			AuthenticatorData: params.AuthenticatorData,
+			ClientDataJSON:    params.ClientDataJSON,
+		}); err != nil {
+			return err
+		}
+
+		approval := &models.KeyShareRecoveryApproval{
+			ID:           uuid.New().String(),
+			RecoveryID:   recoveryID,
+			UserID:       params.UserID,
+			Action:       "approve",
+			CredentialID: null.StringFrom(string(params.CredentialID)),
+		}
+		if err := approval.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert key recovery approval: %w", err)
+		}
+
+		// Approvals of users no longer admins do not count towards the quorum.
+		ids := make([]interface{}, 0, len(approvers))
+		for id := range approvers {
+			ids = append(ids, id)
+		}
+		count, err := models.KeyShareRecoveryApprovals(
+			models.KeyShareRecoveryApprovalWhere.RecoveryID.EQ(recoveryID),
+			models.KeyShareRecoveryApprovalWhere.Action.EQ("approve"),
+			qm.WhereIn(models.KeyShareRecoveryApprovalColumns.UserID+" IN ?", ids...),
+		).Count(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to count key recovery approvals: %w", err)
+		}
+
+		approved = int(count) >= recovery.RequiredApprovals
+		if approved {
+			recovery.Status = RecoveryStatusRecovering
+			if _, err := recovery.Update(ctx, exec, boil.Whitelist(
+				models.KeyShareRecoveryColumns.Status,
+				models.KeyShareRecoveryColumns.UpdatedAt,
+			)); err != nil {
+				return fmt.Errorf("failed to update key recovery: %w", err)
+			}
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         params.UserID,
+			Action:         audit.ActionApproveKeyRecovery,
+			ResourceType:   audit.ResourceTypeKeyRecovery,
+			ResourceID:     recoveryID,
+			CredentialID:   params.CredentialID,
+			Details: map[string]interface{}{
+				"key_id":             recovery.KeyID,
+				"approvals":          count,
+				"required_approvals": recovery.RequiredApprovals,
+				"approved":           approved,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	if approved {
+		if err := s.executeRecovery(ctx, recovery, params.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetRecovery(ctx, orgID, recoveryID)
+}
+
+func (s *impl) RejectRecovery(ctx context.Context, orgID string, recoveryID string, userID string) (*models.KeyShareRecovery, error) {
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		recovery, _, err := lockPendingRecovery(ctx, exec, orgID, recoveryID, userID)
+		if err != nil {
+			return err
+		}
+
+		rejection := &models.KeyShareRecoveryApproval{
+			ID:         uuid.New().String(),
+			RecoveryID: recoveryID,
+			UserID:     userID,
+			Action:     "reject",
+		}
+		if err := rejection.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert key recovery rejection: %w", err)
+		}
+
+		recovery.Status = RecoveryStatusRejected
+		recovery.CompletedAt = null.TimeFrom(s.clock.Now())
+		if _, err := recovery.Update(ctx, exec, boil.Whitelist(
+			models.KeyShareRecoveryColumns.Status,
+			models.KeyShareRecoveryColumns.CompletedAt,
+			models.KeyShareRecoveryColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update key recovery: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionRejectKeyRecovery,
+			ResourceType:   audit.ResourceTypeKeyRecovery,
+			ResourceID:     recoveryID,
+			Details: map[string]interface{}{
+				"key_id": recovery.KeyID,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.GetRecovery(ctx, orgID, recoveryID)
+}
+
+// executeRecovery restores the share of the approved recovery through the MPC server, provided enough backup shares of the
+// node exist, and completes or fails the recovery accordingly.
+func (s *impl) executeRecovery(ctx context.Context, recovery *models.KeyShareRecovery, userID string) error {
+	// The recovery proceeds even if the approving request is cancelled, as the key stays frozen until it finished.
+	rctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.config.Backup.RecoveryTimeout)
+	defer cancel()
+
+	status := RecoveryStatusCompleted
+	var failure string
+	if err := s.recoverShare(rctx, recovery); err != nil {
+		util.LogFromContext(ctx).Error().Err(err).Str("recovery_id", recovery.ID).Msg("Failed to recover key share")
+		status = RecoveryStatusFailed
+		failure = err.Error()
+	}
+
+	return db.WithTransaction(rctx, s.db, func(exec boil.ContextExecutor) error {
+		recovery.Status = status
+		recovery.FailureReason = null.NewString(failure, failure != "")
+		recovery.CompletedAt = null.TimeFrom(s.clock.Now())
+		if _, err := recovery.Update(rctx, exec, boil.Whitelist(
+			models.KeyShareRecoveryColumns.Status,
+			models.KeyShareRecoveryColumns.FailureReason,
+			models.KeyShareRecoveryColumns.CompletedAt,
+			models.KeyShareRecoveryColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update key recovery: %w", err)
+		}
+
+		action := audit.ActionCompleteKeyRecovery
+		details := map[string]interface{}{
+			"key_id":          recovery.KeyID,
+			"node_id":         recovery.NodeID,
+			"subject_user_id": recovery.SubjectUserID,
+		}
+		if status == RecoveryStatusFailed {
+			action = audit.ActionFailKeyRecovery
+			details["failure_reason"] = failure
+		} else {
+			// The recovered share has not been backed up to a device of the user yet.
+			if _, err := models.KeyShareBackups(
+				models.KeyShareBackupWhere.UserID.EQ(recovery.SubjectUserID),
+				models.KeyShareBackupWhere.KeyID.EQ(recovery.KeyID),
+			).DeleteAll(rctx, exec); err != nil {
+				return fmt.Errorf("failed to reset key share backup: %w", err)
+			}
+		}
+
+		return audit.Record(rctx, exec, audit.Entry{
+			OrganizationID: recovery.OrganizationID,
+			UserID:         userID,
+			Action:         action,
+			ResourceType:   audit.ResourceTypeKeyRecovery,
+			ResourceID:     recovery.ID,
+			Details:        details,
+		})
+	})
+}
+
+func (s *impl) recoverShare(ctx context.Context, recovery *models.KeyShareRecovery) error {
+	statuses, err := s.client.GetBackupStatus(ctx, recovery.KeyID)
+	if err != nil {
+		return fmt.Errorf("failed to get backup status: %w", err)
+	}
+
+	recoverable := false
+	for _, status := range statuses {
+		if status.NodeID == recovery.NodeID {
+			recoverable = status.Recoverable
+			break
+		}
+	}
+	if !recoverable {
+		return fmt.Errorf("share of node %s is not recoverable from its backup shares", recovery.NodeID)
+	}
+
+	return s.client.RecoverShare(ctx, recovery.KeyID, recovery.NodeID)
+}
+
+// lockPendingRecovery loads a pending recovery locked for update and ensures the user may still vote on it.
+func lockPendingRecovery(ctx context.Context, exec boil.ContextExecutor, orgID string, recoveryID string, userID string) (*models.KeyShareRecovery, map[string]struct{}, error) {
+	recovery, err := models.KeyShareRecoveries(
+		models.KeyShareRecoveryWhere.ID.EQ(recoveryID),
+		models.KeyShareRecoveryWhere.OrganizationID.EQ(orgID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, httperrors.ErrNotFoundKeyRecovery
+		}
+		return nil, nil, fmt.Errorf("failed to find key recovery: %w", err)
+	}
+	if recovery.Status != RecoveryStatusPending {
+		return nil, nil, httperrors.ErrConflictKeyRecoveryNotPending
+	}
+
+	approvers, err := recoveryApprovers(ctx, exec, orgID, recovery.InitiatorID.String, recovery.SubjectUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := approvers[userID]; !ok {
+		return nil, nil, httperrors.ErrForbiddenNotRecoveryApprover
+	}
+
+	voted, err := models.KeyShareRecoveryApprovals(
+		models.KeyShareRecoveryApprovalWhere.RecoveryID.EQ(recoveryID),
+		models.KeyShareRecoveryApprovalWhere.UserID.EQ(userID),
+	).Exists(ctx, exec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check existing vote: %w", err)
+	}
+	if voted {
+		return nil, nil, httperrors.ErrConflictKeyRecoveryAlreadyVoted
+	}
+
+	return recovery, approvers, nil
+}
+
+// recoveryApprovers returns the IDs of the owner and admins of the organization, except the initiator of the recovery
+// and the user whose share is recovered.
+func recoveryApprovers(ctx context.Context, exec boil.ContextExecutor, orgID string, initiatorID string, subjectUserID string) (map[string]struct{}, error) {
+	org, err := models.FindOrganization(ctx, exec, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organization: %w", err)
+	}
+
+	approvers := map[string]struct{}{org.OwnerID: {}}
+	admins, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(orgID),
+		models.OrganizationMemberWhere.Role.IN([]string{organization.RoleOwner, organization.RoleAdmin}),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization admins: %w", err)
+	}
+	for _, m := range admins {
+		approvers[m.UserID] = struct{}{}
+	}
+
+	delete(approvers, initiatorID)
+	delete(approvers, subjectUserID)
+	return approvers, nil
+}
+
+func isAdmin(role string) bool {
+	return role == organization.RoleOwner || role == organization.RoleAdmin
+}
@@ -0,0 +1,281 @@
+package backup_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApproveRecoveryQuorum(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// User1 recovers their own share, the admins User2 and User3 have to approve.
+		user3 := insertAdmins(t, s, 1)[0]
+		org, keyID := setupRecovery(t, s, fix.User2.ID, user3.ID)
+
+		recovery, err := s.Backup.RequestRecovery(ctx, backup.RequestRecoveryParams{
+			OrganizationID: org.ID,
+			KeyID:          keyID,
+			SubjectUserID:  fix.User1.ID,
+			InitiatorID:    fix.User1.ID,
+			Reason:         "lost device",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusPending, recovery.Status)
+		assert.Equal(t, 2, recovery.RequiredApprovals)
+
+		frozen, err := backup.KeyFrozen(ctx, s.DB, keyID)
+		require.NoError(t, err)
+		assert.True(t, frozen)
+
+		passkey1 := test.NewPasskey(t, s, fix.User1.ID)
+		passkey2 := test.NewPasskey(t, s, fix.User2.ID)
+		passkey3 := test.NewPasskey(t, s, user3.ID)
+		vote := func(userID string, passkey *test.Passkey) backup.RecoveryApprovalParams {
+			return vote(t, s, recovery.ID, userID, passkey)
+		}
+
+		// The user whose share is recovered does not vote on it.
+		_, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(fix.User1.ID, passkey1))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenNotRecoveryApprover)
+
+		_, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, backup.RecoveryApprovalParams{UserID: fix.User2.ID})
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyRequired)
+
+		// Assertions are verified against the passkeys of the voter.
+		_, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(fix.User2.ID, passkey3))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		recovery, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(fix.User2.ID, passkey2))
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusPending, recovery.Status)
+		assert.Len(t, recovery.R.RecoveryKeyShareRecoveryApprovals, 1)
+
+		_, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(fix.User2.ID, passkey2))
+		require.ErrorIs(t, err, httperrors.ErrConflictKeyRecoveryAlreadyVoted)
+
+		// The second approval reaches the quorum and runs the recovery. The fake MPC server holds no share of client
+		// nodes, so the recovery fails, which unfreezes the key all the same.
+		recovery, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(user3.ID, passkey3))
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusFailed, recovery.Status)
+		assert.True(t, recovery.FailureReason.Valid)
+		assert.True(t, recovery.CompletedAt.Valid)
+		assert.Len(t, recovery.R.RecoveryKeyShareRecoveryApprovals, 2)
+
+		frozen, err = backup.KeyFrozen(ctx, s.DB, keyID)
+		require.NoError(t, err)
+		assert.False(t, frozen)
+
+		_, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(user3.ID, passkey3))
+		require.ErrorIs(t, err, httperrors.ErrConflictKeyRecoveryNotPending)
+	})
+}
+
+// insertAdmins inserts n users to be added as admins of the organization of the recovery.
+func insertAdmins(t *testing.T, s *api.Server, n int) []*models.User {
+	t.Helper()
+
+	users := make([]*models.User, 0, n)
+	for i := range n {
+		user := &models.User{
+			Username: null.StringFrom(fmt.Sprintf("user%d@example.com", i+3)),
+			IsActive: true,
+			Scopes:   []string{"app"},
+		}
+		require.NoError(t, user.Insert(t.Context(), s.DB, boil.Infer()))
+		users = append(users, user)
+	}
+
+	return users
+}
+
+// setupRecovery creates an organization owned by User1 with the admins given and a vault of threshold 2 holding an
+// Ethereum wallet, returning the organization and the key of the wallet.
+func setupRecovery(t *testing.T, s *api.Server, adminIDs ...string) (*models.Organization, string) {
+	t.Helper()
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+	require.NoError(t, err)
+	for _, userID := range adminIDs {
+		_, err = s.Organization.AddMember(ctx, org.ID, userID, organization.RoleAdmin, fix.User1.ID)
+		require.NoError(t, err)
+	}
+
+	v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 2, fix.User1.ID, []vault.KeyConfig{
+		{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+	})
+	require.NoError(t, err)
+	chain := &models.Chain{
+		ID:             "ETH",
+		Name:           "Ethereum",
+		Type:           "evm",
+		Algorithm:      mpc.AlgorithmECDSA,
+		Curve:          mpc.CurveSecp256k1,
+		CurrencySymbol: "ETH",
+		IsActive:       true,
+	}
+	require.NoError(t, chain.Insert(ctx, s.DB, boil.Infer()))
+	wallet, err := s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+	require.NoError(t, err)
+
+	return org, wallet.KeyID
+}
+
+// vote returns the approval of the user for the recovery, confirmed with their passkey.
+func vote(t *testing.T, s *api.Server, recoveryID string, userID string, passkey *test.Passkey) backup.RecoveryApprovalParams {
+	t.Helper()
+
+	assertion := passkey.Assert(t, s, auth.AssertionActionApproveRecovery, recoveryID)
+	return backup.RecoveryApprovalParams{
+		UserID:            userID,
+		CredentialID:      assertion.CredentialID,
+		Signature:         assertion.Signature,
+		AuthenticatorData: assertion.AuthenticatorData,
+		ClientDataJSON:    assertion.ClientDataJSON,
+	}
+}
+
+func TestApproveRecoveryCompleted(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// User2 recovers the share of User1, the admin User3 and the owner User1 have to approve.
+		user3 := insertAdmins(t, s, 1)[0]
+		org, keyID := setupRecovery(t, s, fix.User2.ID, user3.ID)
+
+		recovery, err := s.Backup.RequestRecovery(ctx, backup.RequestRecoveryParams{
+			OrganizationID: org.ID,
+			KeyID:          keyID,
+			SubjectUserID:  fix.User1.ID,
+			InitiatorID:    fix.User2.ID,
+			Reason:         "lost device",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, recovery.RequiredApprovals)
+
+		// The fake MPC server only holds shares of server nodes, so the recovery is pointed at one of them.
+		_, err = s.DB.ExecContext(ctx, `UPDATE key_share_recoveries SET node_id = 'server-proxy-0' WHERE id = $1`, recovery.ID)
+		require.NoError(t, err)
+
+		recovery, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(t, s, recovery.ID, user3.ID, test.NewPasskey(t, s, user3.ID)))
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusCompleted, recovery.Status)
+		assert.False(t, recovery.FailureReason.Valid)
+		assert.True(t, recovery.CompletedAt.Valid)
+
+		frozen, err := backup.KeyFrozen(ctx, s.DB, keyID)
+		require.NoError(t, err)
+		assert.False(t, frozen)
+	})
+}
+
+func TestApproveRecoveryDemotedApprover(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// User1 recovers their own share, two of the admins User2, User3 and User4 have to approve.
+		users := insertAdmins(t, s, 2)
+		user3, user4 := users[0], users[1]
+		org, keyID := setupRecovery(t, s, fix.User2.ID, user3.ID, user4.ID)
+
+		recovery, err := s.Backup.RequestRecovery(ctx, backup.RequestRecoveryParams{
+			OrganizationID: org.ID,
+			KeyID:          keyID,
+			SubjectUserID:  fix.User1.ID,
+			InitiatorID:    fix.User1.ID,
+			Reason:         "lost device",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, recovery.RequiredApprovals)
+
+		passkey2 := test.NewPasskey(t, s, fix.User2.ID)
+		recovery, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(t, s, recovery.ID, fix.User2.ID, passkey2))
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusPending, recovery.Status)
+
+		// Once demoted, User2 no longer votes and their approval no longer counts towards the quorum.
+		err = s.Organization.UpdateMemberRole(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(t, s, recovery.ID, fix.User2.ID, passkey2))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenNotRecoveryApprover)
+		_, err = s.Backup.RejectRecovery(ctx, org.ID, recovery.ID, fix.User2.ID)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenNotRecoveryApprover)
+
+		recovery, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(t, s, recovery.ID, user3.ID, test.NewPasskey(t, s, user3.ID)))
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusPending, recovery.Status)
+
+		// The approval of User4 reaches the quorum, the recovery of the client share fails against the fake MPC server.
+		recovery, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(t, s, recovery.ID, user4.ID, test.NewPasskey(t, s, user4.ID)))
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusFailed, recovery.Status)
+	})
+}
+
+func TestRejectRecovery(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		user3 := insertAdmins(t, s, 1)[0]
+		org, keyID := setupRecovery(t, s, fix.User2.ID, user3.ID)
+
+		recovery, err := s.Backup.RequestRecovery(ctx, backup.RequestRecoveryParams{
+			OrganizationID: org.ID,
+			KeyID:          keyID,
+			SubjectUserID:  fix.User1.ID,
+			InitiatorID:    fix.User1.ID,
+			Reason:         "lost device",
+		})
+		require.NoError(t, err)
+
+		// A second recovery of the frozen key is refused while the first one is pending.
+		_, err = s.Backup.RequestRecovery(ctx, backup.RequestRecoveryParams{
+			OrganizationID: org.ID,
+			KeyID:          keyID,
+			SubjectUserID:  fix.User1.ID,
+			InitiatorID:    fix.User1.ID,
+			Reason:         "lost device",
+		})
+		require.ErrorIs(t, err, httperrors.ErrConflictKeyRecoveryInProgress)
+
+		_, err = s.Backup.RejectRecovery(ctx, org.ID, recovery.ID, fix.User1.ID)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenNotRecoveryApprover)
+
+		// A single rejection ends the recovery and unfreezes the key.
+		recovery, err = s.Backup.RejectRecovery(ctx, org.ID, recovery.ID, fix.User2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, backup.RecoveryStatusRejected, recovery.Status)
+		assert.True(t, recovery.CompletedAt.Valid)
+
+		frozen, err := backup.KeyFrozen(ctx, s.DB, keyID)
+		require.NoError(t, err)
+		assert.False(t, frozen)
+
+		_, err = s.Backup.ApproveRecovery(ctx, org.ID, recovery.ID, vote(t, s, recovery.ID, user3.ID, test.NewPasskey(t, s, user3.ID)))
+		require.ErrorIs(t, err, httperrors.ErrConflictKeyRecoveryNotPending)
+		_, err = s.Backup.RejectRecovery(ctx, org.ID, recovery.ID, user3.ID)
+		require.ErrorIs(t, err, httperrors.ErrConflictKeyRecoveryNotPending)
+	})
+}
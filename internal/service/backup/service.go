@@ -0,0 +1,149 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// Backup states of the share a user holds of a key. Users without backup row are reported as missing.
+const (
+	StatusMissing   = "missing"
+	StatusDelivered = "delivered"
+	StatusConfirmed = "confirmed"
+	StatusFailed    = "failed"
+)
+
+// Recoveries are pending until approved by their quorum, the key is frozen for signing while pending or recovering.
+const (
+	RecoveryStatusPending    = "pending"
+	RecoveryStatusRecovering = "recovering"
+	RecoveryStatusCompleted  = "completed"
+	RecoveryStatusFailed     = "failed"
+	RecoveryStatusRejected   = "rejected"
+)
+
+type DeliverShareParams struct {
+	UserID     string
+	KeyID      string
+	ShareIndex int
+	// DevicePublicKey the share is encrypted to by the MPC server.
+	DevicePublicKey []byte
+}
+
+type ConfirmShareParams struct {
+	UserID               string
+	KeyID                string
+	ReceivedSuccessfully bool
+	FailureReason        string
+}
+
+// Delivery is the encrypted share to store on the device along with the signature of the MPC server.
+type Delivery struct {
+	Backup         *models.KeyShareBackup
+	EncryptedShare []byte
+	Signature      []byte
+	Timestamp      time.Time
+}
+
+// ShareStatus is the backup state recorded by the vault along with the delivery state reported by the MPC server.
+type ShareStatus struct {
+	// Backup is nil if the share was never delivered to the user.
+	Backup *models.KeyShareBackup
+	// Infra is nil if the share was never delivered or the MPC server could not be reached.
+	Infra *mpc.ShareStatus
+}
+
+type ListBackupsParams struct {
+	OrganizationID string
+	// VaultID and Status filter the backups, empty for all.
+	VaultID string
+	Status  string
+	Page    int
+	Limit   int
+}
+
+// BackupEntry is the backup state of the share of a user eligible to approve within a vault.
+type BackupEntry struct {
+	VaultID       string      `boil:"vault_id"`
+	KeyID         string      `boil:"key_id"`
+	UserID        string      `boil:"user_id"`
+	Status        string      `boil:"status"`
+	ShareIndex    null.Int    `boil:"share_index"`
+	DeliveredAt   null.Time   `boil:"delivered_at"`
+	ConfirmedAt   null.Time   `boil:"confirmed_at"`
+	FailureReason null.String `boil:"failure_reason"`
+}
+
+type RequestRecoveryParams struct {
+	OrganizationID string
+	KeyID          string
+	// SubjectUserID is the user whose share is recovered, e.g. after the device was lost.
+	SubjectUserID string
+	InitiatorID   string
+	Reason        string
+}
+
+// RecoveryApprovalParams carries the passkey assertion of an admin approving a recovery.
+type RecoveryApprovalParams struct {
+	UserID            string
+	CredentialID      []byte
+	Signature         []byte
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+}
+
+type ListRecoveriesParams struct {
+	OrganizationID string
+	// Status filters the recoveries, empty for all.
+	Status string
+	Page   int
+	Limit  int
+}
+
+// NodeHealth is the backup state of the share of a node of a key as reported by the MPC server.
+type NodeHealth struct {
+	NodeID         string `json:"node_id"`
+	TotalShares    int    `json:"total_shares"`
+	RequiredShares int    `json:"required_shares"`
+	// BackupShares is the number of backup shares listed for the node.
+	BackupShares int  `json:"backup_shares"`
+	Recoverable  bool `json:"recoverable"`
+	// AtRisk is set if the share cannot be recovered from its backup shares.
+	AtRisk bool `json:"at_risk"`
+}
+
+type Service interface {
+	// DeliverShare requests the share of the user encrypted to the public key of the device, verifies the signature
+	// of the MPC server and records the share as delivered.
+	DeliverShare(ctx context.Context, params DeliverShareParams) (*Delivery, error)
+	// ConfirmShare reports to the MPC server whether the device stored the share delivered and records the outcome.
+	ConfirmShare(ctx context.Context, params ConfirmShareParams) (*models.KeyShareBackup, error)
+	// GetShareStatus returns the backup state of the share of the user.
+	GetShareStatus(ctx context.Context, userID string, keyID string) (*ShareStatus, error)
+	// ListBackups returns the backup state of the shares of every key within the vaults of the organization for each
+	// user eligible to approve, so admins see who did not back up their share yet.
+	ListBackups(ctx context.Context, params ListBackupsParams) ([]*BackupEntry, int64, error)
+
+	// RequestRecovery opens the recovery of the share the user holds of the key, freezing the key for signing.
+	// Owners and admins may request recoveries for any user, other users for their own share only.
+	RequestRecovery(ctx context.Context, params RequestRecoveryParams) (*models.KeyShareRecovery, error)
+	// GetRecovery returns the recovery with its votes loaded.
+	GetRecovery(ctx context.Context, orgID string, recoveryID string) (*models.KeyShareRecovery, error)
+	// ListRecoveries returns the recoveries of the organization, newest first, with their votes loaded.
+	ListRecoveries(ctx context.Context, params ListRecoveriesParams) (models.KeyShareRecoverySlice, int64, error)
+	// ApproveRecovery records the approval and recovers the share through the MPC server once the quorum approved.
+	ApproveRecovery(ctx context.Context, orgID string, recoveryID string, params RecoveryApprovalParams) (*models.KeyShareRecovery, error)
+	RejectRecovery(ctx context.Context, orgID string, recoveryID string, userID string) (*models.KeyShareRecovery, error)
+
+	// Run checks the backup health of all keys in the configured interval until the context is canceled.
+	Run(ctx context.Context)
+	// CheckHealth queries the backup state of every key of the active vaults from the MPC server and caches it,
+	// alerting the organizations about keys becoming at risk.
+	CheckHealth(ctx context.Context) error
+	// GetHealth returns the cached backup health of the keys of the organization, keys at risk first.
+	GetHealth(ctx context.Context, orgID string) (models.KeyBackupHealthSlice, error)
+}
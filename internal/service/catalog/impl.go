@@ -0,0 +1,258 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/tokenmeta"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// tokenType describes on which chains a token type lives and how many decimals its amounts may have.
+type tokenType struct {
+	chainType string
+	// maxDecimals is bound by the integer type of the token amounts: uint256 for ERC20 and TRC20, u64 for SPL.
+	maxDecimals int
+}
+
+var tokenTypes = map[string]tokenType{
+	AssetTypeERC20: {chainType: address.ChainTypeEVM, maxDecimals: 77},
+	AssetTypeSPL:   {chainType: address.ChainTypeSolana, maxDecimals: 19},
+	AssetTypeTRC20: {chainType: address.ChainTypeTron, maxDecimals: 77},
+}
+
+type impl struct {
+	db       *sql.DB
+	resolver tokenmeta.Resolver
+	passkeys auth.AssertionVerifier
+}
+
+func NewService(db *sql.DB, resolver tokenmeta.Resolver, passkeys auth.AssertionVerifier) Service {
+	return &impl{
+		db:       db,
+		resolver: resolver,
+		passkeys: passkeys,
+	}
+}
+
+func (s *impl) ListChains(ctx context.Context, params ListChainsParams) (models.ChainSlice, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.ChainColumns.Name + " ASC"),
+	}
+	if params.IsTestnet != nil {
+		mods = append(mods, models.ChainWhere.IsTestnet.EQ(null.BoolFrom(*params.IsTestnet)))
+	}
+	if params.Type != "" {
+		mods = append(mods, models.ChainWhere.Type.EQ(strings.ToUpper(params.Type)))
+	}
+
+	chains, err := models.Chains(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("list chains: %w", err)
+	}
+	return chains, nil
+}
+
+func (s *impl) ListAssets(ctx context.Context, params ListAssetsParams) (models.AssetSlice, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.AssetColumns.ChainID + " ASC, " + models.AssetColumns.Symbol + " ASC"),
+	}
+	if params.ChainID != "" {
+		mods = append(mods, models.AssetWhere.ChainID.EQ(null.StringFrom(params.ChainID)))
+	}
+	if params.Type != "" {
+		mods = append(mods, models.AssetWhere.Type.EQ(strings.ToUpper(params.Type)))
+	}
+	if params.IsActive != nil {
+		mods = append(mods, models.AssetWhere.IsActive.EQ(null.BoolFrom(*params.IsActive)))
+	}
+
+	assets, err := models.Assets(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("list assets: %w", err)
+	}
+	return assets, nil
+}
+
+func (s *impl) CreateAsset(ctx context.Context, params CreateAssetParams) (*models.Asset, error) {
+	tt, ok := tokenTypes[params.Type]
+	if !ok {
+		return nil, httperrors.ErrBadRequestUnsupportedAssetType
+	}
+	if params.Decimals != nil && (*params.Decimals < 0 || *params.Decimals > tt.maxDecimals) {
+		return nil, httperrors.ErrBadRequestInvalidDecimals
+	}
+
+	chain, err := s.findChain(ctx, params.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(chain.Type, tt.chainType) {
+		return nil, httperrors.ErrBadRequestUnsupportedAssetType
+	}
+
+	contractAddress, err := address.Normalize(chain.Type, params.ContractAddress)
+	if err != nil {
+		return nil, httperrors.ErrBadRequestInvalidAddress
+	}
+
+	params.Symbol = strings.TrimSpace(params.Symbol)
+	params.Name = strings.TrimSpace(params.Name)
+	metadataSource := metadataSourcePayload
+	if hasRPC(chain) {
+		metadata, err := s.resolve(ctx, chain, contractAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := fillMetadata(&params, metadata); err != nil {
+			return nil, err
+		}
+		metadataSource = metadataSourceChain
+	}
+	if params.Symbol == "" || params.Name == "" || params.Decimals == nil {
+		return nil, httperrors.ErrBadRequestAssetMetadataUnavailable
+	}
+	if *params.Decimals > tt.maxDecimals {
+		return nil, httperrors.ErrBadRequestInvalidDecimals
+	}
+
+	asset := &models.Asset{
+		ChainID:         null.StringFrom(chain.ID),
+		Symbol:          params.Symbol,
+		Name:            params.Name,
+		Type:            params.Type,
+		ContractAddress: null.StringFrom(contractAddress),
+		Decimals:        *params.Decimals,
+		IconURL:         null.NewString(params.IconURL, params.IconURL != ""),
+		IsActive:        null.BoolFrom(true),
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := s.verifyPasskey(ctx, exec, params.UserID, auth.AssertionScope{Action: auth.AssertionActionCreateAsset, ResourceID: params.ChainID}, params.Passkey); err != nil {
+			return err
+		}
+
+		exists, err := models.Assets(
+			models.AssetWhere.ChainID.EQ(asset.ChainID),
+			models.AssetWhere.ContractAddress.EQ(asset.ContractAddress),
+		).Exists(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("check asset: %w", err)
+		}
+		if exists {
+			return httperrors.ErrConflictAssetExists
+		}
+
+		conflicts, err := symbolConflicts(ctx, exec, chain.ID, asset.Symbol, contractAddress)
+		if err != nil {
+			return err
+		}
+		if len(conflicts) > 0 && !params.AcknowledgeConflicts {
+			return httperrors.ErrConflictAssetSymbol
+		}
+		conflictIDs := make([]string, 0, len(conflicts))
+		for _, conflict := range conflicts {
+			conflictIDs = append(conflictIDs, conflict.ID)
+		}
+
+		if err := asset.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("insert asset: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			UserID:       params.UserID,
+			Action:       audit.ActionCreateAsset,
+			ResourceType: audit.ResourceTypeAsset,
+			ResourceID:   asset.ID,
+			CredentialID: params.Passkey.CredentialID,
+			Details: map[string]interface{}{
+				"chain_id":         chain.ID,
+				"symbol":           asset.Symbol,
+				"type":             asset.Type,
+				"contract_address": contractAddress,
+				"decimals":         asset.Decimals,
+				"metadata_source":  metadataSource,
+				"conflicts":        conflictIDs,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+func (s *impl) UpdateAsset(ctx context.Context, params UpdateAssetParams) (*models.Asset, error) {
+	var asset *models.Asset
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := s.verifyPasskey(ctx, exec, params.UserID, auth.AssertionScope{Action: auth.AssertionActionUpdateAsset, ResourceID: params.AssetID}, params.Passkey); err != nil {
+			return err
+		}
+
+		var err error
+		asset, err = models.Assets(
+			models.AssetWhere.ID.EQ(params.AssetID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrNotFoundAsset
+			}
+			return fmt.Errorf("find asset: %w", err)
+		}
+
+		asset.IsActive = null.BoolFrom(params.IsActive)
+		if _, err := asset.Update(ctx, exec, boil.Whitelist(models.AssetColumns.IsActive, models.AssetColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("update asset: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			UserID:       params.UserID,
+			Action:       audit.ActionUpdateAsset,
+			ResourceType: audit.ResourceTypeAsset,
+			ResourceID:   asset.ID,
+			CredentialID: params.Passkey.CredentialID,
+			Details: map[string]interface{}{
+				"is_active": params.IsActive,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+func (s *impl) findChain(ctx context.Context, chainID string) (*models.Chain, error) {
+	chain, err := models.FindChain(ctx, s.db, chainID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrBadRequestUnknownChain
+		}
+		return nil, fmt.Errorf("find chain: %w", err)
+	}
+	return chain, nil
+}
+
+// verifyPasskey ensures the change is confirmed with an assertion of a passkey registered to the acting user, answering
+// a challenge issued to them for the change.
+func (s *impl) verifyPasskey(ctx context.Context, exec boil.ContextExecutor, userID string, scope auth.AssertionScope, passkey PasskeyAssertion) error {
+	_, err := s.passkeys.VerifyAssertion(ctx, exec, userID, scope, auth.Assertion{
+		CredentialID:      passkey.CredentialID,
+		Signature:         passkey.Signature,
+		AuthenticatorData: passkey.AuthenticatorData,
+		ClientDataJSON:    passkey.ClientDataJSON,
+	})
+	return err
+}
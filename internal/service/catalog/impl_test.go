@@ -0,0 +1,175 @@
+package catalog_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	usdtAddress = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+	usdcAddress = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+)
+
+// insertChain inserts an Ethereum chain, reading token metadata from rpcURL if not empty.
+func insertChain(t *testing.T, s *api.Server, rpcURL string) *models.Chain {
+	t.Helper()
+
+	chain := &models.Chain{
+		ID:             "ETH",
+		Name:           "Ethereum",
+		Type:           "EVM",
+		Algorithm:      mpc.AlgorithmECDSA,
+		Curve:          mpc.CurveSecp256k1,
+		CurrencySymbol: "ETH",
+		RPCURL:         null.NewString(rpcURL, rpcURL != ""),
+		IsActive:       true,
+	}
+	require.NoError(t, chain.Insert(t.Context(), s.DB, boil.Infer()))
+
+	return chain
+}
+
+// passkeyAssertion confirms the action on the resource with the passkey.
+func passkeyAssertion(t *testing.T, s *api.Server, passkey *test.Passkey, action string, resourceID string) catalog.PasskeyAssertion {
+	t.Helper()
+
+	assertion := passkey.Assert(t, s, action, resourceID)
+	return catalog.PasskeyAssertion{
+		CredentialID:      assertion.CredentialID,
+		Signature:         assertion.Signature,
+		AuthenticatorData: assertion.AuthenticatorData,
+		ClientDataJSON:    assertion.ClientDataJSON,
+	}
+}
+
+func TestCreateAsset(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		chain := insertChain(t, s, "")
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		params := func(contractAddress string, symbol string) catalog.CreateAssetParams {
+			return catalog.CreateAssetParams{
+				ChainID:         chain.ID,
+				Symbol:          symbol,
+				Name:            symbol + " Token",
+				Type:            catalog.AssetTypeERC20,
+				ContractAddress: contractAddress,
+				Decimals:        swag.Int(6),
+				UserID:          fix.User1.ID,
+				Passkey:         passkeyAssertion(t, s, passkey, auth.AssertionActionCreateAsset, chain.ID),
+			}
+		}
+
+		invalid := params(usdtAddress, "USDT")
+		invalid.Type = catalog.AssetTypeSPL
+		_, err := s.Catalog.CreateAsset(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestUnsupportedAssetType)
+
+		invalid = params(usdtAddress, "USDT")
+		invalid.Decimals = swag.Int(78)
+		_, err = s.Catalog.CreateAsset(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestInvalidDecimals)
+
+		invalid = params("0x1234", "USDT")
+		_, err = s.Catalog.CreateAsset(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestInvalidAddress)
+
+		invalid = params(usdtAddress, "USDT")
+		invalid.ChainID = "BTC"
+		_, err = s.Catalog.CreateAsset(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestUnknownChain)
+
+		// Without RPC endpoint the metadata has to be provided.
+		invalid = params(usdtAddress, "USDT")
+		invalid.Decimals = nil
+		_, err = s.Catalog.CreateAsset(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestAssetMetadataUnavailable)
+
+		invalid = params(usdtAddress, "USDT")
+		invalid.Passkey = catalog.PasskeyAssertion{}
+		_, err = s.Catalog.CreateAsset(ctx, invalid)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyRequired)
+
+		// Contract addresses are stored checksummed.
+		asset, err := s.Catalog.CreateAsset(ctx, params(usdtAddress, "USDT"))
+		require.NoError(t, err)
+		assert.Equal(t, "0xdAC17F958D2ee523a2206206994597C13D831ec7", asset.ContractAddress.String)
+		assert.Equal(t, 6, asset.Decimals)
+		assert.True(t, asset.IsActive.Bool)
+
+		_, err = s.Catalog.CreateAsset(ctx, params(usdtAddress, "USDT"))
+		require.ErrorIs(t, err, httperrors.ErrConflictAssetExists)
+
+		// Symbols used by another contract of the chain have to be acknowledged.
+		_, err = s.Catalog.CreateAsset(ctx, params(usdcAddress, "usdt"))
+		require.ErrorIs(t, err, httperrors.ErrConflictAssetSymbol)
+		acknowledged := params(usdcAddress, "usdt")
+		acknowledged.AcknowledgeConflicts = true
+		_, err = s.Catalog.CreateAsset(ctx, acknowledged)
+		require.NoError(t, err)
+
+		assets, err := s.Catalog.ListAssets(ctx, catalog.ListAssetsParams{ChainID: chain.ID, Type: "erc20"})
+		require.NoError(t, err)
+		assert.Len(t, assets, 2)
+	})
+}
+
+func TestUpdateAsset(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		chain := insertChain(t, s, "")
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		asset, err := s.Catalog.CreateAsset(ctx, catalog.CreateAssetParams{
+			ChainID:         chain.ID,
+			Symbol:          "USDT",
+			Name:            "Tether USD",
+			Type:            catalog.AssetTypeERC20,
+			ContractAddress: usdtAddress,
+			Decimals:        swag.Int(6),
+			UserID:          fix.User1.ID,
+			Passkey:         passkeyAssertion(t, s, passkey, auth.AssertionActionCreateAsset, chain.ID),
+		})
+		require.NoError(t, err)
+
+		// Assertions confirm the update of the asset they were issued for only.
+		_, err = s.Catalog.UpdateAsset(ctx, catalog.UpdateAssetParams{
+			AssetID: asset.ID,
+			UserID:  fix.User1.ID,
+			Passkey: passkeyAssertion(t, s, passkey, auth.AssertionActionCreateAsset, chain.ID),
+		})
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		updated, err := s.Catalog.UpdateAsset(ctx, catalog.UpdateAssetParams{
+			AssetID: asset.ID,
+			UserID:  fix.User1.ID,
+			Passkey: passkeyAssertion(t, s, passkey, auth.AssertionActionUpdateAsset, asset.ID),
+		})
+		require.NoError(t, err)
+		assert.False(t, updated.IsActive.Bool)
+
+		active, err := s.Catalog.ListAssets(ctx, catalog.ListAssetsParams{IsActive: swag.Bool(true)})
+		require.NoError(t, err)
+		assert.Empty(t, active)
+		inactive, err := s.Catalog.ListAssets(ctx, catalog.ListAssetsParams{IsActive: swag.Bool(false)})
+		require.NoError(t, err)
+		require.Len(t, inactive, 1)
+		assert.Equal(t, asset.ID, inactive[0].ID)
+	})
+}
@@ -0,0 +1,152 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/tokenmeta"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+)
+
+// Sources of the metadata of created assets, reported within the audit log.
+const (
+	metadataSourceChain   = "chain"
+	metadataSourcePayload = "payload"
+)
+
+// Lengths of the symbol and name columns of assets.
+const (
+	maxSymbolLength = 20
+	maxNameLength   = 100
+)
+
+func (s *impl) ResolveAssetMetadata(ctx context.Context, chainID string, contractAddress string) (*AssetMetadata, error) {
+	chain, err := s.findChain(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	assetType, ok := assetTypeOfChain(chain.Type)
+	if !ok {
+		return nil, httperrors.ErrBadRequestUnsupportedAssetType
+	}
+
+	contractAddress, err = address.Normalize(chain.Type, contractAddress)
+	if err != nil {
+		return nil, httperrors.ErrBadRequestInvalidAddress
+	}
+	if !hasRPC(chain) {
+		return nil, httperrors.ErrBadRequestAssetMetadataUnavailable
+	}
+
+	metadata, err := s.resolve(ctx, chain, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts, err := symbolConflicts(ctx, s.db, chain.ID, metadata.Symbol, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssetMetadata{
+		ChainID:         chain.ID,
+		Type:            assetType,
+		ContractAddress: contractAddress,
+		Symbol:          metadata.Symbol,
+		Name:            metadata.Name,
+		Decimals:        metadata.Decimals,
+		Conflicts:       conflicts,
+	}, nil
+}
+
+// resolve reads the metadata of the token from the chain, truncated to the lengths of the asset columns.
+func (s *impl) resolve(ctx context.Context, chain *models.Chain, contractAddress string) (*tokenmeta.Metadata, error) {
+	metadata, err := s.resolver.Resolve(ctx, chain.Type, chain.RPCURL.String, contractAddress)
+	if err != nil {
+		switch {
+		case errors.Is(err, tokenmeta.ErrNotToken):
+			return nil, httperrors.ErrBadRequestNotAToken
+		case errors.Is(err, tokenmeta.ErrUnsupportedChainType):
+			return nil, httperrors.ErrBadRequestUnsupportedAssetType
+		case errors.Is(err, address.ErrInvalidAddress):
+			return nil, httperrors.ErrBadRequestInvalidAddress
+		}
+		util.LogFromContext(ctx).Warn().Err(err).Str("chain_id", chain.ID).Str("contract_address", contractAddress).Msg("Failed to resolve token metadata")
+		return nil, httperrors.ErrBadGatewayAssetMetadataUnavailable
+	}
+
+	metadata.Symbol = truncate(metadata.Symbol, maxSymbolLength)
+	metadata.Name = truncate(metadata.Name, maxNameLength)
+	return metadata, nil
+}
+
+// fillMetadata completes the metadata of the params with the metadata of the chain, provided symbol and decimals must
+// match the chain while a provided name takes precedence, tokens often publish abbreviated or outdated names.
+func fillMetadata(params *CreateAssetParams, metadata *tokenmeta.Metadata) error {
+	if params.Symbol != "" && metadata.Symbol != "" && params.Symbol != metadata.Symbol {
+		return httperrors.ErrBadRequestAssetMetadataMismatch
+	}
+	if params.Decimals != nil && *params.Decimals != metadata.Decimals {
+		return httperrors.ErrBadRequestAssetMetadataMismatch
+	}
+
+	if params.Symbol == "" {
+		params.Symbol = metadata.Symbol
+	}
+	if params.Name == "" {
+		params.Name = metadata.Name
+	}
+	if params.Decimals == nil {
+		decimals := metadata.Decimals
+		params.Decimals = &decimals
+	}
+	return nil
+}
+
+// symbolConflicts returns the assets of the chain using the symbol with another contract (or natively).
+func symbolConflicts(ctx context.Context, exec boil.ContextExecutor, chainID string, symbol string, contractAddress string) (models.AssetSlice, error) {
+	if symbol == "" {
+		return models.AssetSlice{}, nil
+	}
+
+	conflicts, err := models.Assets(
+		models.AssetWhere.ChainID.EQ(null.StringFrom(chainID)),
+		qm.Where(fmt.Sprintf("upper(%s) = upper(?)", models.AssetColumns.Symbol), symbol),
+		qm.Where(fmt.Sprintf("%s IS DISTINCT FROM ?", models.AssetColumns.ContractAddress), contractAddress),
+		qm.OrderBy(models.AssetColumns.CreatedAt+" ASC"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("find symbol conflicts: %w", err)
+	}
+	return conflicts, nil
+}
+
+// assetTypeOfChain returns the asset type of tokens on chains of the chain type.
+func assetTypeOfChain(chainType string) (string, bool) {
+	for assetType, tt := range tokenTypes {
+		if strings.EqualFold(tt.chainType, chainType) {
+			return assetType, true
+		}
+	}
+	return "", false
+}
+
+func hasRPC(chain *models.Chain) bool {
+	return chain.RPCURL.Valid && strings.TrimSpace(chain.RPCURL.String) != ""
+}
+
+func truncate(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:length]))
+}
@@ -0,0 +1,122 @@
+package catalog_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenCalls returns the eth_call results of the ERC20 name, symbol and decimals getters of a token.
+func tokenCalls(name string, symbol string, decimals int) map[string]string {
+	word := func(n int) []byte {
+		w := make([]byte, 32)
+		binary.BigEndian.PutUint64(w[24:], uint64(n)) //nolint:gosec
+		return w
+	}
+	str := func(s string) string {
+		padded := make([]byte, (len(s)+31)/32*32)
+		copy(padded, s)
+		return "0x" + hex.EncodeToString(append(append(word(32), word(len(s))...), padded...))
+	}
+
+	return map[string]string{
+		"0x06fdde03": str(name),
+		"0x95d89b41": str(symbol),
+		"0x313ce567": "0x" + hex.EncodeToString(word(decimals)),
+	}
+}
+
+// tokenRPC starts a chain node answering the getters of the tokens by contract address, other calls revert.
+func tokenRPC(t *testing.T, tokens map[string]map[string]string) string {
+	t.Helper()
+
+	srv := test.NewTestJSONRPCServer(t, func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		var call struct {
+			To   string `json:"to"`
+			Data string `json:"data"`
+		}
+		if method != "eth_call" || len(params) == 0 || json.Unmarshal(params[0], &call) != nil {
+			return nil, &jsonrpc.RPCError{Code: -32602, Message: "invalid params"}
+		}
+		result, ok := tokens[strings.ToLower(call.To)][call.Data]
+		if !ok {
+			return nil, &jsonrpc.RPCError{Code: 3, Message: "execution reverted"}
+		}
+		return result, nil
+	})
+
+	return srv.URL
+}
+
+func TestCreateAssetWithChainMetadata(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		chain := insertChain(t, s, tokenRPC(t, map[string]map[string]string{
+			usdtAddress: tokenCalls("Tether USD", "USDT", 6),
+			usdcAddress: tokenCalls("Fake Tether", "USDT", 6),
+		}))
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		params := func(contractAddress string) catalog.CreateAssetParams {
+			return catalog.CreateAssetParams{
+				ChainID:         chain.ID,
+				Type:            catalog.AssetTypeERC20,
+				ContractAddress: contractAddress,
+				UserID:          fix.User1.ID,
+				Passkey:         passkeyAssertion(t, s, passkey, auth.AssertionActionCreateAsset, chain.ID),
+			}
+		}
+
+		metadata, err := s.Catalog.ResolveAssetMetadata(ctx, chain.ID, usdtAddress)
+		require.NoError(t, err)
+		assert.Equal(t, catalog.AssetTypeERC20, metadata.Type)
+		assert.Equal(t, "USDT", metadata.Symbol)
+		assert.Equal(t, "Tether USD", metadata.Name)
+		assert.Equal(t, 6, metadata.Decimals)
+		assert.Empty(t, metadata.Conflicts)
+
+		_, err = s.Catalog.ResolveAssetMetadata(ctx, chain.ID, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+		require.ErrorIs(t, err, httperrors.ErrBadRequestNotAToken)
+
+		// Provided symbol and decimals must match the chain, the name may differ.
+		mismatch := params(usdtAddress)
+		mismatch.Decimals = swag.Int(18)
+		_, err = s.Catalog.CreateAsset(ctx, mismatch)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestAssetMetadataMismatch)
+		mismatch = params(usdtAddress)
+		mismatch.Symbol = "USDC"
+		_, err = s.Catalog.CreateAsset(ctx, mismatch)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestAssetMetadataMismatch)
+
+		named := params(usdtAddress)
+		named.Name = "Tether"
+		asset, err := s.Catalog.CreateAsset(ctx, named)
+		require.NoError(t, err)
+		assert.Equal(t, "USDT", asset.Symbol)
+		assert.Equal(t, "Tether", asset.Name)
+		assert.Equal(t, 6, asset.Decimals)
+
+		// Tokens copying the symbol of a listed asset are reported along with it.
+		metadata, err = s.Catalog.ResolveAssetMetadata(ctx, chain.ID, usdcAddress)
+		require.NoError(t, err)
+		require.Len(t, metadata.Conflicts, 1)
+		assert.Equal(t, asset.ID, metadata.Conflicts[0].ID)
+
+		_, err = s.Catalog.CreateAsset(ctx, params(usdcAddress))
+		require.ErrorIs(t, err, httperrors.ErrConflictAssetSymbol)
+	})
+}
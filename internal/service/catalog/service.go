@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// Types of assets, tokens are held by contracts (or mints) of their chain.
+const (
+	AssetTypeNative = "NATIVE"
+	AssetTypeERC20  = "ERC20"
+	AssetTypeSPL    = "SPL"
+	AssetTypeTRC20  = "TRC20"
+)
+
+// ListChainsParams filters the chains, nil or empty values are not applied.
+type ListChainsParams struct {
+	IsTestnet *bool
+	Type      string
+}
+
+// ListAssetsParams filters the assets, nil or empty values are not applied.
+type ListAssetsParams struct {
+	ChainID  string
+	Type     string
+	IsActive *bool
+}
+
+// PasskeyAssertion confirms a catalog change with a passkey registered to the acting admin.
+type PasskeyAssertion struct {
+	CredentialID      []byte
+	Signature         []byte
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+}
+
+// CreateAssetParams of a token. Symbol, Name and Decimals are read from the chain if empty (or nil).
+type CreateAssetParams struct {
+	ChainID         string
+	Symbol          string
+	Name            string
+	Type            string
+	ContractAddress string
+	Decimals        *int
+	IconURL         string
+	// AcknowledgeConflicts creates the asset even though its symbol is used by another asset of the chain.
+	AcknowledgeConflicts bool
+	UserID               string
+	Passkey              PasskeyAssertion
+}
+
+// AssetMetadata of a token as read from its chain, along with the assets of the chain using the same symbol.
+type AssetMetadata struct {
+	ChainID         string
+	Type            string
+	ContractAddress string
+	Symbol          string
+	Name            string
+	Decimals        int
+	Conflicts       models.AssetSlice
+}
+
+type UpdateAssetParams struct {
+	AssetID  string
+	IsActive bool
+	UserID   string
+	Passkey  PasskeyAssertion
+}
+
+type Service interface {
+	ListChains(ctx context.Context, params ListChainsParams) (models.ChainSlice, error)
+	ListAssets(ctx context.Context, params ListAssetsParams) (models.AssetSlice, error)
+	// ResolveAssetMetadata reads the metadata of the token from the RPC endpoint of the chain.
+	ResolveAssetMetadata(ctx context.Context, chainID string, contractAddress string) (*AssetMetadata, error)
+	// CreateAsset adds a token to the catalog, its contract address and decimals are validated against the chain.
+	// Metadata missing from the params is read from the chain, provided symbol and decimals must match the chain.
+	CreateAsset(ctx context.Context, params CreateAssetParams) (*models.Asset, error)
+	// UpdateAsset activates or deactivates an asset.
+	UpdateAsset(ctx context.Context, params UpdateAssetParams) (*models.Asset, error)
+}
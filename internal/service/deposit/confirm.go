@@ -0,0 +1,115 @@
+package deposit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// creditBalanceQuery adds the amount of a deposit to the balance of its wallet, converting the raw amount with the
+// decimals of the asset.
+const creditBalanceQuery = `INSERT INTO wallet_balances (wallet_id, asset_id, balance, raw_balance, updated_at)
+SELECT $1, assets.id, $3::numeric / power(10::numeric, assets.decimals), $3::text, NOW()
+FROM assets
+WHERE assets.id = $2
+ON CONFLICT (wallet_id, asset_id) DO UPDATE SET
+	balance = COALESCE(wallet_balances.balance, 0) + EXCLUDED.balance,
+	raw_balance = (COALESCE(wallet_balances.raw_balance, '0')::numeric + EXCLUDED.raw_balance::numeric)::text,
+	updated_at = EXCLUDED.updated_at`
+
+// confirmDeposits updates the confirmations of the pending deposits of the chain, rolling back deposits whose block
+// is no longer canonical. Deposits reaching the required confirmations are credited and returned.
+func (s *impl) confirmDeposits(ctx context.Context, chain *models.Chain, head uint64) (models.DepositSlice, error) {
+	pending, err := models.Deposits(
+		models.DepositWhere.ChainID.EQ(chain.ID),
+		models.DepositWhere.Status.EQ(StatusPending),
+		qm.OrderBy(models.DepositColumns.BlockNumber+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("list pending deposits: %w", err)
+	}
+
+	hashes := make(map[int64]string)
+	confirmed := make(models.DepositSlice, 0)
+	for _, deposit := range pending {
+		hash, ok := hashes[deposit.BlockNumber]
+		if !ok {
+			hash, err = s.evm.BlockHash(ctx, chain.RPCURL.String, uint64(deposit.BlockNumber))
+			if err != nil {
+				return nil, fmt.Errorf("get block %d: %w", deposit.BlockNumber, err)
+			}
+			hashes[deposit.BlockNumber] = hash
+		}
+		// Load balanced nodes may lag behind the one the deposit was detected with.
+		if hash == "" {
+			continue
+		}
+
+		confirmations := 0
+		if head >= uint64(deposit.BlockNumber) {
+			confirmations = int(head-uint64(deposit.BlockNumber)) + 1
+		}
+
+		var credited bool
+		if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+			// Locking the deposit guards against crediting it twice with concurrent scans.
+			d, err := models.Deposits(
+				models.DepositWhere.ID.EQ(deposit.ID),
+				qm.For("UPDATE"),
+			).One(ctx, exec)
+			if err != nil {
+				return fmt.Errorf("find deposit: %w", err)
+			}
+			if d.Status != StatusPending {
+				return nil
+			}
+
+			switch {
+			case hash != d.BlockHash:
+				d.Status = StatusReorged
+				d.Confirmations = 0
+			case confirmations >= chain.RequiredConfirmations:
+				d.Status = StatusConfirmed
+				d.Confirmations = confirmations
+				d.ConfirmedAt = null.TimeFrom(s.clock.Now())
+				if err := creditBalance(ctx, exec, d); err != nil {
+					return err
+				}
+				credited = true
+			default:
+				d.Confirmations = confirmations
+			}
+
+			if _, err := d.Update(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("update deposit: %w", err)
+			}
+			*deposit = *d
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		if credited {
+			confirmed = append(confirmed, deposit)
+		}
+	}
+
+	return confirmed, nil
+}
+
+// creditBalance adds the deposit to the balance of its wallet, deposits of unknown native assets are not credited.
+func creditBalance(ctx context.Context, exec boil.ContextExecutor, deposit *models.Deposit) error {
+	if !deposit.AssetID.Valid {
+		return nil
+	}
+
+	if _, err := exec.ExecContext(ctx, creditBalanceQuery, deposit.WalletID, deposit.AssetID.String, deposit.Amount); err != nil {
+		return fmt.Errorf("credit wallet balance: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/evm"
+	"github.com/kashguard/go-mpc-vault/internal/mailer"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/push"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+var (
+	// ErrUnsupportedChain is returned for chains deposits cannot be detected on, only EVM chains are scanned.
+	ErrUnsupportedChain = errors.New("deposits are not detected on chains of this type")
+	// ErrNoRPC is returned for chains without RPC endpoint.
+	ErrNoRPC = errors.New("chain has no rpc url")
+)
+
+type impl struct {
+	config config.Server
+	db     *sql.DB
+	clock  time2.Clock
+	evm    evm.Client
+	push   *push.Service
+	mailer *mailer.Mailer
+}
+
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, evmClient evm.Client, pusher *push.Service, m *mailer.Mailer) Service {
+	return &impl{
+		config: config,
+		db:     db,
+		clock:  clock,
+		evm:    evmClient,
+		push:   pusher,
+		mailer: m,
+	}
+}
+
+func (s *impl) Run(ctx context.Context) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Deposit.ScanInterval <= 0 {
+		log.Warn().Msg("No deposit scan interval configured, skipping deposit detection")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Deposit.ScanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			chains, err := models.Chains(
+				models.ChainWhere.IsActive.EQ(true),
+				models.ChainWhere.Type.EQ(address.ChainTypeEVM),
+				models.ChainWhere.RPCURL.IsNotNull(),
+			).All(ctx, s.db)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to list chains to scan for deposits")
+				continue
+			}
+
+			for _, chain := range chains {
+				if err := s.Scan(ctx, chain.ID); err != nil {
+					log.Error().Err(err).Str("chain_id", chain.ID).Msg("Failed to scan chain for deposits")
+				}
+			}
+		}
+	}
+}
+
+func (s *impl) Scan(ctx context.Context, chainID string) error {
+	chain, err := s.findChain(ctx, chainID)
+	if err != nil {
+		return err
+	}
+
+	head, err := s.evm.BlockNumber(ctx, chain.RPCURL.String)
+	if err != nil {
+		return fmt.Errorf("get block number: %w", err)
+	}
+
+	if err := s.scanBlocks(ctx, chain, head); err != nil {
+		return err
+	}
+
+	confirmed, err := s.confirmDeposits(ctx, chain, head)
+	if err != nil {
+		return err
+	}
+
+	for _, deposit := range confirmed {
+		s.notify(ctx, chain, deposit)
+	}
+
+	return nil
+}
+
+func (s *impl) RecordTransfers(ctx context.Context, chainID string, transfers []Transfer) (int, error) {
+	chain, err := s.findChain(ctx, chainID)
+	if err != nil {
+		return 0, err
+	}
+	rpcURL := chain.RPCURL.String
+
+	list, err := s.watchlist(ctx, chain.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	hashes := make(map[uint64]string)
+	deposits := make([]*models.Deposit, 0, len(transfers))
+	for _, transfer := range transfers {
+		wallet, ok := list.wallets[strings.ToLower(transfer.To)]
+		if !ok || transfer.Amount == nil || transfer.Amount.Sign() <= 0 {
+			continue
+		}
+		txHash := strings.ToLower(transfer.TxHash)
+
+		var assetID null.String
+		logIndex := transfer.LogIndex
+		if transfer.Token == "" {
+			// Providers report native transfers of reverted transactions as well.
+			succeeded, err := s.evm.TransactionSucceeded(ctx, rpcURL, txHash)
+			if err != nil {
+				return 0, fmt.Errorf("get receipt of %s: %w", txHash, err)
+			}
+			if !succeeded {
+				continue
+			}
+			logIndex = NativeLogIndex
+			if list.native != nil {
+				assetID = null.StringFrom(list.native.ID)
+			}
+		} else {
+			asset, known := list.tokens[strings.ToLower(transfer.Token)]
+			if !known {
+				continue
+			}
+			assetID = null.StringFrom(asset.ID)
+		}
+
+		blockHash := strings.ToLower(transfer.BlockHash)
+		if blockHash == "" {
+			if blockHash, ok = hashes[transfer.BlockNumber]; !ok {
+				blockHash, err = s.evm.BlockHash(ctx, rpcURL, transfer.BlockNumber)
+				if err != nil {
+					return 0, fmt.Errorf("get block %d: %w", transfer.BlockNumber, err)
+				}
+				if blockHash == "" {
+					return 0, fmt.Errorf("block %d of %s not found", transfer.BlockNumber, txHash)
+				}
+				hashes[transfer.BlockNumber] = blockHash
+			}
+		}
+
+		deposit := newDeposit(chain, transfer.BlockNumber, blockHash, wallet, txHash, logIndex, strings.ToLower(transfer.From), transfer.Amount.String())
+		deposit.AssetID = assetID
+		deposits = append(deposits, deposit)
+	}
+
+	if len(deposits) == 0 {
+		return 0, nil
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		for _, deposit := range deposits {
+			if err := recordDeposit(ctx, exec, deposit); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	head, err := s.evm.BlockNumber(ctx, rpcURL)
+	if err != nil {
+		return 0, fmt.Errorf("get block number: %w", err)
+	}
+
+	confirmed, err := s.confirmDeposits(ctx, chain, head)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, deposit := range confirmed {
+		s.notify(ctx, chain, deposit)
+	}
+
+	return len(deposits), nil
+}
+
+func (s *impl) ListDeposits(ctx context.Context, params ListDepositsParams) (models.DepositSlice, int64, error) {
+	mods := []qm.QueryMod{
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s = %s.%s",
+			models.TableNames.Wallets,
+			models.TableNames.Wallets, models.WalletColumns.ID,
+			models.TableNames.Deposits, models.DepositColumns.WalletID,
+		)),
+		models.WalletWhere.VaultID.EQ(null.StringFrom(params.VaultID)),
+	}
+	if params.Status != "" {
+		mods = append(mods, models.DepositWhere.Status.EQ(params.Status))
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	page := params.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	total, err := models.Deposits(mods...).Count(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count deposits: %w", err)
+	}
+
+	mods = append(mods,
+		qm.Select(models.TableNames.Deposits+".*"),
+		qm.Load(models.DepositRels.Asset),
+		qm.OrderBy(models.TableNames.Deposits+"."+models.DepositColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+		qm.Offset((page-1)*limit),
+	)
+	deposits, err := models.Deposits(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list deposits: %w", err)
+	}
+
+	return deposits, total, nil
+}
+
+// findChain returns the chain if deposits can be detected on it.
+func (s *impl) findChain(ctx context.Context, chainID string) (*models.Chain, error) {
+	chain, err := models.FindChain(ctx, s.db, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("find chain: %w", err)
+	}
+	if !strings.EqualFold(chain.Type, address.ChainTypeEVM) {
+		return nil, ErrUnsupportedChain
+	}
+	if !chain.RPCURL.Valid || strings.TrimSpace(chain.RPCURL.String) == "" {
+		return nil, ErrNoRPC
+	}
+
+	return chain, nil
+}
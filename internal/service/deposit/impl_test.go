@@ -0,0 +1,186 @@
+package deposit_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/infra/evm"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/service/deposit"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const usdtAddress = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+
+// fakeChain is a chain of blocks without transactions, blocks are canonical up to the head.
+type fakeChain struct {
+	mu       sync.Mutex
+	head     uint64
+	reverted map[string]bool
+}
+
+func (c *fakeChain) setHead(head uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = head
+}
+
+func (c *fakeChain) hash(number uint64) string {
+	return fmt.Sprintf("0x%064x", number)
+}
+
+func (c *fakeChain) BlockNumber(_ context.Context, _ string) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, nil
+}
+
+func (c *fakeChain) BlockByNumber(_ context.Context, _ string, number uint64) (*evm.Block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number > c.head {
+		return nil, nil
+	}
+	return &evm.Block{Number: number, Hash: c.hash(number), ParentHash: c.hash(number - 1)}, nil
+}
+
+func (c *fakeChain) BlockHash(_ context.Context, _ string, number uint64) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number > c.head {
+		return "", nil
+	}
+	return c.hash(number), nil
+}
+
+func (c *fakeChain) TokenTransfers(_ context.Context, _ string, _ string, _ []string) ([]evm.Transfer, error) {
+	return nil, nil
+}
+
+func (c *fakeChain) TransactionSucceeded(_ context.Context, _ string, txHash string) (bool, error) {
+	return !c.reverted[txHash], nil
+}
+
+func (c *fakeChain) Balance(_ context.Context, _ string, _ string) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (c *fakeChain) TokenBalance(_ context.Context, _ string, _ string, _ string) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func TestRecordTransfers(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		chain := &fakeChain{head: 10, reverted: map[string]bool{"0xreverted": true}}
+		svc := deposit.NewService(s.Config, s.DB, s.Clock, chain, s.Push, s.Mailer)
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+		eth := &models.Chain{
+			ID:                    "ETH",
+			Name:                  "Ethereum",
+			Type:                  "EVM",
+			Algorithm:             mpc.AlgorithmECDSA,
+			Curve:                 mpc.CurveSecp256k1,
+			CurrencySymbol:        "ETH",
+			RPCURL:                null.StringFrom("http://chain.invalid"),
+			IsActive:              true,
+			RequiredConfirmations: 3,
+		}
+		require.NoError(t, eth.Insert(ctx, s.DB, boil.Infer()))
+		native := &models.Asset{ChainID: null.StringFrom(eth.ID), Symbol: "ETH", Name: "Ether", Type: catalog.AssetTypeNative, Decimals: 18}
+		require.NoError(t, native.Insert(ctx, s.DB, boil.Infer()))
+		usdt := &models.Asset{
+			ChainID:         null.StringFrom(eth.ID),
+			Symbol:          "USDT",
+			Name:            "Tether USD",
+			Type:            catalog.AssetTypeERC20,
+			ContractAddress: null.StringFrom(usdtAddress),
+			Decimals:        6,
+		}
+		require.NoError(t, usdt.Insert(ctx, s.DB, boil.Infer()))
+		wallet, err := s.Vault.CreateWallet(ctx, v.ID, eth.ID, fix.User1.ID)
+		require.NoError(t, err)
+
+		transfers := []deposit.Transfer{
+			{TxHash: "0xtoken", LogIndex: 2, BlockNumber: 10, From: "0xsender", To: wallet.Address, Token: usdtAddress, Amount: big.NewInt(1_500_000)},
+			{TxHash: "0xnative", BlockNumber: 10, From: "0xsender", To: wallet.Address, Amount: big.NewInt(1_000_000_000_000_000_000)},
+			// Transfers of reverted transactions, unknown tokens, into other addresses and without amount are skipped.
+			{TxHash: "0xreverted", BlockNumber: 10, From: "0xsender", To: wallet.Address, Amount: big.NewInt(1)},
+			{TxHash: "0xunknown", BlockNumber: 10, From: "0xsender", To: wallet.Address, Token: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", Amount: big.NewInt(1)},
+			{TxHash: "0xother", BlockNumber: 10, From: "0xsender", To: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", Amount: big.NewInt(1)},
+			{TxHash: "0xzero", BlockNumber: 10, From: "0xsender", To: wallet.Address, Amount: big.NewInt(0)},
+		}
+		recorded, err := svc.RecordTransfers(ctx, eth.ID, transfers)
+		require.NoError(t, err)
+		assert.Equal(t, 2, recorded)
+
+		deposits, total, err := svc.ListDeposits(ctx, deposit.ListDepositsParams{VaultID: v.ID, Status: deposit.StatusPending})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		for _, d := range deposits {
+			assert.Equal(t, 1, d.Confirmations)
+		}
+
+		// Transfers reported with a block no longer canonical are rolled back right away.
+		recorded, err = svc.RecordTransfers(ctx, eth.ID, []deposit.Transfer{
+			{TxHash: "0xorphaned", BlockNumber: 9, BlockHash: "0xstale", From: "0xsender", To: wallet.Address, Amount: big.NewInt(1)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, recorded)
+		_, total, err = svc.ListDeposits(ctx, deposit.ListDepositsParams{VaultID: v.ID, Status: deposit.StatusReorged})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+
+		// Deposits buried under the required confirmations are credited and announced.
+		chain.setHead(12)
+		require.NoError(t, svc.Scan(ctx, eth.ID))
+		deposits, total, err = svc.ListDeposits(ctx, deposit.ListDepositsParams{VaultID: v.ID, Status: deposit.StatusConfirmed})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		for _, d := range deposits {
+			assert.Equal(t, 3, d.Confirmations)
+			assert.True(t, d.ConfirmedAt.Valid)
+		}
+		assert.Len(t, test.GetSentMails(t, s.Mailer), 2)
+
+		balanceOf := func(asset *models.Asset) string {
+			t.Helper()
+			balance, err := models.WalletBalances(
+				models.WalletBalanceWhere.WalletID.EQ(null.StringFrom(wallet.ID)),
+				models.WalletBalanceWhere.AssetID.EQ(null.StringFrom(asset.ID)),
+			).One(ctx, s.DB)
+			require.NoError(t, err)
+			return balance.RawBalance.String
+		}
+		assert.Equal(t, "1500000", balanceOf(usdt))
+		assert.Equal(t, "1000000000000000000", balanceOf(native))
+
+		// Transfers delivered again are not credited twice.
+		_, err = svc.RecordTransfers(ctx, eth.ID, transfers)
+		require.NoError(t, err)
+		_, total, err = svc.ListDeposits(ctx, deposit.ListDepositsParams{VaultID: v.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, "1500000", balanceOf(usdt))
+	})
+}
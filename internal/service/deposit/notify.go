@@ -0,0 +1,104 @@
+package deposit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/data/dto"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+)
+
+// notify informs the members of the organization owning the wallet about the confirmed deposit by push and email.
+// Failures are logged only, the deposit is credited regardless.
+func (s *impl) notify(ctx context.Context, chain *models.Chain, deposit *models.Deposit) {
+	log := util.LogFromContext(ctx).With().Str("deposit_id", deposit.ID).Logger()
+
+	wallet, err := models.Wallets(
+		models.WalletWhere.ID.EQ(deposit.WalletID),
+		qm.Load(models.WalletRels.Vault),
+	).One(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load wallet of deposit to notify")
+		return
+	}
+	vault := wallet.R.GetVault()
+	if vault == nil || !vault.OrganizationID.Valid {
+		return
+	}
+
+	symbol := chain.CurrencySymbol
+	decimals := 0
+	if deposit.AssetID.Valid {
+		asset, err := models.FindAsset(ctx, s.db, deposit.AssetID.String)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to load asset of deposit to notify")
+			return
+		}
+		symbol = asset.Symbol
+		decimals = asset.Decimals
+	}
+
+	payload := dto.DepositNotificationPayload{
+		VaultName: vault.Name,
+		ChainName: chain.Name,
+		Address:   wallet.Address,
+		Amount:    formatAmount(deposit.Amount, decimals),
+		Symbol:    symbol,
+		TxHash:    deposit.TXHash,
+	}
+	if chain.ExplorerURL.Valid && chain.ExplorerURL.String != "" {
+		payload.TxLink = strings.TrimRight(chain.ExplorerURL.String, "/") + "/tx/" + deposit.TXHash
+	}
+
+	members, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(vault.OrganizationID.String),
+		qm.Load(models.OrganizationMemberRels.User),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list organization members to notify about deposit")
+		return
+	}
+
+	title := "Deposit received"
+	message := fmt.Sprintf("%s %s deposited into %s", payload.Amount, payload.Symbol, payload.VaultName)
+	for _, member := range members {
+		user := member.R.GetUser()
+		if user == nil || !user.IsActive {
+			continue
+		}
+
+		if s.push.GetProviderCount() > 0 {
+			if err := s.push.SendToUser(ctx, &dto.User{ID: user.ID}, title, message); err != nil {
+				log.Debug().Err(err).Str("user_id", user.ID).Msg("Failed to send deposit push notification")
+			}
+		}
+
+		if user.Username.Valid {
+			if err := s.mailer.SendDepositConfirmed(ctx, user.Username.String, payload); err != nil {
+				log.Debug().Err(err).Str("user_id", user.ID).Msg("Failed to send deposit email")
+			}
+		}
+	}
+}
+
+// formatAmount formats the raw amount in the smallest unit of the asset as decimal number.
+func formatAmount(raw string, decimals int) string {
+	amount, ok := new(big.Int).SetString(raw, 10)
+	if !ok || decimals <= 0 {
+		return raw
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, fraction := new(big.Int).QuoRem(amount, unit, new(big.Int))
+	if fraction.Sign() == 0 {
+		return whole.String()
+	}
+
+	digits := fraction.String()
+	digits = strings.Repeat("0", decimals-len(digits)) + digits
+	return whole.String() + "." + strings.TrimRight(digits, "0")
+}
@@ -0,0 +1,296 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/infra/evm"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/catalog"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// watchlist holds the wallets and token contracts of a chain, keyed by their lower case address.
+type watchlist struct {
+	wallets map[string]*models.Wallet
+	tokens  map[string]*models.Asset
+	// native is the native asset of the chain, nil if the catalog has none.
+	native *models.Asset
+}
+
+func (w *watchlist) contracts() []string {
+	contracts := make([]string, 0, len(w.tokens))
+	for contract := range w.tokens {
+		contracts = append(contracts, contract)
+	}
+	return contracts
+}
+
+// scanBlocks processes the blocks following the scan cursor of the chain up to the head, at most the configured
+// number of blocks per scan. Chains are scanned from the head at the time of their first scan onwards.
+func (s *impl) scanBlocks(ctx context.Context, chain *models.Chain, head uint64) error {
+	rpcURL := chain.RPCURL.String
+
+	cursor, err := models.FindChainScanCursor(ctx, s.db, chain.ID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("find scan cursor: %w", err)
+		}
+		return s.moveCursor(ctx, &models.ChainScanCursor{ChainID: chain.ID}, rpcURL, head, true)
+	}
+
+	list, err := s.watchlist(ctx, chain.ID)
+	if err != nil {
+		return err
+	}
+	// Blocks without wallets to watch cannot contain deposits.
+	if len(list.wallets) == 0 {
+		if uint64(cursor.BlockNumber) >= head {
+			return nil
+		}
+		return s.moveCursor(ctx, cursor, rpcURL, head, false)
+	}
+
+	end := head
+	if maxBlocks := uint64(s.config.Deposit.MaxBlocksPerScan); maxBlocks > 0 && uint64(cursor.BlockNumber)+maxBlocks < end {
+		end = uint64(cursor.BlockNumber) + maxBlocks
+	}
+
+	for number := uint64(cursor.BlockNumber) + 1; number <= end; number++ {
+		block, err := s.evm.BlockByNumber(ctx, rpcURL, number)
+		if err != nil {
+			return fmt.Errorf("get block %d: %w", number, err)
+		}
+		// Load balanced nodes may lag behind the one reporting the head.
+		if block == nil {
+			return nil
+		}
+		if block.ParentHash != cursor.BlockHash {
+			return s.rewind(ctx, chain, cursor)
+		}
+
+		deposits, err := s.detect(ctx, chain, block, list)
+		if err != nil {
+			return err
+		}
+
+		if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+			for _, deposit := range deposits {
+				if err := recordDeposit(ctx, exec, deposit); err != nil {
+					return err
+				}
+			}
+
+			cursor.BlockNumber = int64(block.Number)
+			cursor.BlockHash = block.Hash
+			if _, err := cursor.Update(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("update scan cursor: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// detect returns the native transfers and the token transfers of the block into the watched wallets.
+func (s *impl) detect(ctx context.Context, chain *models.Chain, block *evm.Block, list *watchlist) ([]*models.Deposit, error) {
+	rpcURL := chain.RPCURL.String
+	deposits := make([]*models.Deposit, 0)
+
+	// Value sent to wallets by contracts (internal transactions) is not visible within the block.
+	for _, tx := range block.Transactions {
+		wallet, ok := list.wallets[tx.To]
+		if !ok || tx.Value.Sign() <= 0 {
+			continue
+		}
+
+		// The value of reverted transactions is not transferred.
+		succeeded, err := s.evm.TransactionSucceeded(ctx, rpcURL, tx.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("get receipt of %s: %w", tx.Hash, err)
+		}
+		if !succeeded {
+			continue
+		}
+
+		deposit := newDeposit(chain, block.Number, block.Hash, wallet, tx.Hash, NativeLogIndex, tx.From, tx.Value.String())
+		if list.native != nil {
+			deposit.AssetID = null.StringFrom(list.native.ID)
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	transfers, err := s.evm.TokenTransfers(ctx, rpcURL, block.Hash, list.contracts())
+	if err != nil {
+		return nil, fmt.Errorf("get token transfers of block %d: %w", block.Number, err)
+	}
+	for _, transfer := range transfers {
+		wallet, ok := list.wallets[transfer.To]
+		asset, known := list.tokens[transfer.Token]
+		if !ok || !known || transfer.Amount.Sign() <= 0 {
+			continue
+		}
+
+		deposit := newDeposit(chain, block.Number, block.Hash, wallet, transfer.TxHash, transfer.LogIndex, transfer.From, transfer.Amount.String())
+		deposit.AssetID = null.StringFrom(asset.ID)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits, nil
+}
+
+// rewind moves the scan cursor back by the required confirmations of the chain once a block does not link to the
+// cursor. Pending deposits above the new cursor are rolled back and recorded again if rescanning finds them.
+func (s *impl) rewind(ctx context.Context, chain *models.Chain, cursor *models.ChainScanCursor) error {
+	target := cursor.BlockNumber - int64(chain.RequiredConfirmations)
+	if target < 0 {
+		target = 0
+	}
+
+	hash, err := s.evm.BlockHash(ctx, chain.RPCURL.String, uint64(target))
+	if err != nil {
+		return fmt.Errorf("get block %d: %w", target, err)
+	}
+	if hash == "" {
+		return fmt.Errorf("block %d to rewind to not found", target)
+	}
+
+	util.LogFromContext(ctx).Warn().
+		Str("chain_id", chain.ID).
+		Int64("block_number", cursor.BlockNumber).
+		Int64("rewind_to", target).
+		Msg("Detected chain reorganization, rolling back unconfirmed deposits")
+
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if _, err := models.Deposits(
+			models.DepositWhere.ChainID.EQ(chain.ID),
+			models.DepositWhere.Status.EQ(StatusPending),
+			models.DepositWhere.BlockNumber.GT(target),
+		).UpdateAll(ctx, exec, models.M{
+			models.DepositColumns.Status:        StatusReorged,
+			models.DepositColumns.Confirmations: 0,
+			models.DepositColumns.UpdatedAt:     s.clock.Now(),
+		}); err != nil {
+			return fmt.Errorf("roll back deposits: %w", err)
+		}
+
+		cursor.BlockNumber = target
+		cursor.BlockHash = hash
+		if _, err := cursor.Update(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("update scan cursor: %w", err)
+		}
+		return nil
+	})
+}
+
+// moveCursor sets the scan cursor to the block at the number, skipping the blocks in between.
+func (s *impl) moveCursor(ctx context.Context, cursor *models.ChainScanCursor, rpcURL string, number uint64, insert bool) error {
+	hash, err := s.evm.BlockHash(ctx, rpcURL, number)
+	if err != nil {
+		return fmt.Errorf("get block %d: %w", number, err)
+	}
+	if hash == "" {
+		return nil
+	}
+
+	cursor.BlockNumber = int64(number)
+	cursor.BlockHash = hash
+	if insert {
+		if err := cursor.Insert(ctx, s.db, boil.Infer()); err != nil {
+			return fmt.Errorf("insert scan cursor: %w", err)
+		}
+		return nil
+	}
+	if _, err := cursor.Update(ctx, s.db, boil.Infer()); err != nil {
+		return fmt.Errorf("update scan cursor: %w", err)
+	}
+	return nil
+}
+
+func (s *impl) watchlist(ctx context.Context, chainID string) (*watchlist, error) {
+	wallets, err := models.Wallets(
+		models.WalletWhere.ChainID.EQ(null.StringFrom(chainID)),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("list wallets: %w", err)
+	}
+	// Deposits of deactivated assets are still recorded, only new assets are prevented.
+	assets, err := models.Assets(
+		models.AssetWhere.ChainID.EQ(null.StringFrom(chainID)),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("list assets: %w", err)
+	}
+
+	list := &watchlist{
+		wallets: make(map[string]*models.Wallet, len(wallets)),
+		tokens:  make(map[string]*models.Asset, len(assets)),
+	}
+	for _, wallet := range wallets {
+		list.wallets[strings.ToLower(wallet.Address)] = wallet
+	}
+	for _, asset := range assets {
+		switch {
+		case asset.Type == catalog.AssetTypeNative:
+			list.native = asset
+		case asset.ContractAddress.Valid:
+			list.tokens[strings.ToLower(asset.ContractAddress.String)] = asset
+		}
+	}
+
+	return list, nil
+}
+
+func newDeposit(chain *models.Chain, blockNumber uint64, blockHash string, wallet *models.Wallet, txHash string, logIndex int, from string, amount string) *models.Deposit {
+	return &models.Deposit{
+		WalletID:    wallet.ID,
+		ChainID:     chain.ID,
+		TXHash:      txHash,
+		LogIndex:    logIndex,
+		FromAddress: from,
+		Amount:      amount,
+		BlockNumber: int64(blockNumber),
+		BlockHash:   blockHash,
+		Status:      StatusPending,
+	}
+}
+
+// recordDeposit inserts the deposit, or revives it if it was rolled back and mined again. Confirmed deposits are kept.
+func recordDeposit(ctx context.Context, exec boil.ContextExecutor, deposit *models.Deposit) error {
+	existing, err := models.Deposits(
+		models.DepositWhere.ChainID.EQ(deposit.ChainID),
+		models.DepositWhere.TXHash.EQ(deposit.TXHash),
+		models.DepositWhere.LogIndex.EQ(deposit.LogIndex),
+		models.DepositWhere.WalletID.EQ(deposit.WalletID),
+	).One(ctx, exec)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("find deposit: %w", err)
+		}
+		if err := deposit.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("insert deposit: %w", err)
+		}
+		return nil
+	}
+	if existing.Status == StatusConfirmed {
+		return nil
+	}
+
+	existing.BlockNumber = deposit.BlockNumber
+	existing.BlockHash = deposit.BlockHash
+	existing.Confirmations = 0
+	existing.Status = StatusPending
+	if _, err := existing.Update(ctx, exec, boil.Infer()); err != nil {
+		return fmt.Errorf("update deposit: %w", err)
+	}
+	return nil
+}
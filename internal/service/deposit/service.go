@@ -0,0 +1,55 @@
+package deposit
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// Deposits are pending until buried under the required confirmations of their chain. Pending deposits whose
+// block was replaced by a reorg are rolled back, they become pending again if the transfer is mined anew.
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusReorged   = "reorged"
+
+	// NativeLogIndex is the log index of native transfers, which do not emit logs.
+	NativeLogIndex = -1
+)
+
+// Transfer is a transfer into an address reported by a blockchain data provider.
+type Transfer struct {
+	TxHash string
+	// LogIndex of token transfers, NativeLogIndex for native transfers.
+	LogIndex    int
+	BlockNumber uint64
+	// BlockHash of the block including the transfer, looked up on the chain if empty.
+	BlockHash string
+	From      string
+	To        string
+	// Token is the contract address of the token transferred, empty for native transfers.
+	Token  string
+	Amount *big.Int
+}
+
+type ListDepositsParams struct {
+	VaultID string
+	// Status filters the deposits, empty for all.
+	Status string
+	Page   int
+	Limit  int
+}
+
+type Service interface {
+	// Run scans the chains for deposits in the configured interval until the context is done.
+	Run(ctx context.Context)
+	// Scan processes the new blocks of the chain, recording deposits into its wallets, and confirms the pending deposits
+	// buried deep enough, crediting them to the wallet balances and notifying the members of the organization.
+	Scan(ctx context.Context, chainID string) error
+	// RecordTransfers records the transfers into wallets of the chain as pending deposits and confirms the pending
+	// deposits of the chain, transfers into other addresses or of unknown tokens are skipped.
+	// It returns the number of transfers recorded.
+	RecordTransfers(ctx context.Context, chainID string, transfers []Transfer) (int, error)
+	ListDeposits(ctx context.Context, params ListDepositsParams) (models.DepositSlice, int64, error)
+}
@@ -0,0 +1,360 @@
+package device
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+type impl struct {
+	config     config.Server
+	db         *sql.DB
+	clock      time2.Clock
+	nodeClient *mpc.NodeClient
+	passkeys   auth.AssertionVerifier
+}
+
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, nodeClient *mpc.NodeClient, passkeys auth.AssertionVerifier) Service {
+	return &impl{
+		config:     config,
+		db:         db,
+		clock:      clock,
+		nodeClient: nodeClient,
+		passkeys:   passkeys,
+	}
+}
+
+func (s *impl) Enroll(ctx context.Context, params EnrollParams) (*models.UserDevice, error) {
+	// The device is bound to the passkey confirming the enrollment, verified before the device becomes a node.
+	var credential *models.UserCredential
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var err error
+		credential, err = s.passkeys.VerifyAssertion(ctx, exec, params.UserID, auth.AssertionScope{
+			Action:     auth.AssertionActionEnrollDevice,
+			ResourceID: params.DeviceID,
+		}, auth.Assertion{
+			CredentialID:      params.CredentialID,
+			Signature:         params.Signature,
+			AuthenticatorData: params.AuthenticatorData,
+			ClientDataJSON:    params.ClientDataJSON,
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	enrolled, err := models.UserDevices(
+		models.UserDeviceWhere.UserID.EQ(params.UserID),
+		models.UserDeviceWhere.DeviceID.EQ(params.DeviceID),
+		models.UserDeviceWhere.Status.EQ(StatusActive),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check device: %w", err)
+	}
+	if enrolled {
+		return nil, httperrors.ErrConflictDeviceAlreadyEnrolled
+	}
+
+	nodeID, err := s.nodeClient.RegisterNode(ctx, params.DeviceID, params.PublicKey, NodeType, params.Version, map[string]string{
+		"user_id":       params.UserID,
+		"credential_id": credential.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register device as node: %w", err)
+	}
+	if nodeID == "" {
+		return nil, errors.New("MPC server assigned no node ID to device")
+	}
+
+	device := &models.UserDevice{
+		UserID:       params.UserID,
+		CredentialID: null.StringFrom(credential.ID),
+		NodeID:       nodeID,
+		DeviceID:     params.DeviceID,
+		PublicKey:    params.PublicKey,
+		Name:         null.NewString(params.Name, params.Name != ""),
+		Version:      null.NewString(params.Version, params.Version != ""),
+		Status:       StatusActive,
+	}
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := device.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert device: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			UserID:       params.UserID,
+			Action:       audit.ActionEnrollDevice,
+			ResourceType: audit.ResourceTypeDevice,
+			ResourceID:   device.ID,
+			CredentialID: params.CredentialID,
+			Details: map[string]interface{}{
+				"node_id":   nodeID,
+				"device_id": params.DeviceID,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	device.R = device.R.NewStruct()
+	device.R.Credential = credential
+	return device, nil
+}
+
+func (s *impl) ListDevices(ctx context.Context, userID string) (models.UserDeviceSlice, error) {
+	devices, err := models.UserDevices(
+		models.UserDeviceWhere.UserID.EQ(userID),
+		qm.Load(models.UserDeviceRels.Credential),
+		qm.OrderBy(models.UserDeviceColumns.CreatedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices: %w", err)
+	}
+
+	return devices, nil
+}
+
+func (s *impl) Heartbeat(ctx context.Context, userID string, deviceID string, status string) (*models.UserDevice, []string, error) {
+	device, err := s.findDevice(ctx, s.db, userID, deviceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if device.Status != StatusActive {
+		return nil, nil, httperrors.ErrConflictDeviceRevoked
+	}
+
+	commands, err := s.nodeClient.Heartbeat(ctx, device.NodeID, status)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to send heartbeat of node: %w", err)
+	}
+
+	device.NodeStatus = null.StringFrom(status)
+	device.LastHeartbeatAt = null.TimeFrom(s.clock.Now())
+	if _, err := device.Update(ctx, s.db, boil.Whitelist(
+		models.UserDeviceColumns.NodeStatus,
+		models.UserDeviceColumns.LastHeartbeatAt,
+		models.UserDeviceColumns.UpdatedAt,
+	)); err != nil {
+		return nil, nil, fmt.Errorf("failed to update device: %w", err)
+	}
+
+	return device, commands, nil
+}
+
+func (s *impl) Revoke(ctx context.Context, userID string, deviceID string) (*models.UserDevice, error) {
+	var device *models.UserDevice
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var err error
+		device, err = s.findDevice(ctx, exec, userID, deviceID, qm.For("UPDATE"))
+		if err != nil {
+			return err
+		}
+		if device.Status != StatusActive {
+			return httperrors.ErrConflictDeviceRevoked
+		}
+
+		return s.revoke(ctx, exec, device)
+	}); err != nil {
+		return nil, err
+	}
+
+	s.revokeNodes(ctx, device.NodeID)
+
+	return device, nil
+}
+
+func (s *impl) RemoveCredential(ctx context.Context, userID string, credentialID []byte) error {
+	var nodeIDs []string
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		credential, err := models.UserCredentials(
+			models.UserCredentialWhere.UserID.EQ(userID),
+			models.UserCredentialWhere.CredentialID.EQ(string(credentialID)),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrNotFoundCredential
+			}
+			return fmt.Errorf("failed to find credential: %w", err)
+		}
+
+		devices, err := models.UserDevices(
+			models.UserDeviceWhere.CredentialID.EQ(null.StringFrom(credential.ID)),
+			models.UserDeviceWhere.Status.EQ(StatusActive),
+			qm.For("UPDATE"),
+		).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to list devices of credential: %w", err)
+		}
+		for _, device := range devices {
+			if err := s.revoke(ctx, exec, device); err != nil {
+				return err
+			}
+			nodeIDs = append(nodeIDs, device.NodeID)
+		}
+
+		// The devices keep their record, unbound from the credential by the foreign key.
+		if _, err := credential.Delete(ctx, exec); err != nil {
+			return fmt.Errorf("failed to delete credential: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			UserID:       userID,
+			Action:       audit.ActionRemoveCredential,
+			ResourceType: audit.ResourceTypeCredential,
+			ResourceID:   credential.ID,
+			CredentialID: credentialID,
+			Details: map[string]interface{}{
+				"revoked_devices": len(devices),
+			},
+		})
+	}); err != nil {
+		return err
+	}
+
+	s.revokeNodes(ctx, nodeIDs...)
+
+	return nil
+}
+
+func (s *impl) findDevice(ctx context.Context, exec boil.ContextExecutor, userID string, deviceID string, mods ...qm.QueryMod) (*models.UserDevice, error) {
+	device, err := models.UserDevices(append([]qm.QueryMod{
+		models.UserDeviceWhere.ID.EQ(deviceID),
+		models.UserDeviceWhere.UserID.EQ(userID),
+		qm.Load(models.UserDeviceRels.Credential),
+	}, mods...)...).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundDevice
+		}
+		return nil, fmt.Errorf("failed to find device: %w", err)
+	}
+
+	return device, nil
+}
+
+func (s *impl) revoke(ctx context.Context, exec boil.ContextExecutor, device *models.UserDevice) error {
+	device.Status = StatusRevoked
+	device.RevokedAt = null.TimeFrom(s.clock.Now())
+	device.NodeRevocationPending = true
+	if _, err := device.Update(ctx, exec, boil.Whitelist(
+		models.UserDeviceColumns.Status,
+		models.UserDeviceColumns.RevokedAt,
+		models.UserDeviceColumns.NodeRevocationPending,
+		models.UserDeviceColumns.UpdatedAt,
+	)); err != nil {
+		return fmt.Errorf("failed to revoke device: %w", err)
+	}
+
+	return audit.Record(ctx, exec, audit.Entry{
+		UserID:       device.UserID,
+		Action:       audit.ActionRevokeDevice,
+		ResourceType: audit.ResourceTypeDevice,
+		ResourceID:   device.ID,
+		Details: map[string]interface{}{
+			"node_id":   device.NodeID,
+			"device_id": device.DeviceID,
+		},
+	})
+}
+
+// revokeNodes reports the client nodes as revoked to the MPC server unless another active device shares them. The
+// registry offers no call to remove nodes, so revocation is reported as status of the node. Failures are logged only,
+// the devices are revoked within the vault regardless and their nodes are reported again by RetryNodeRevocations.
+func (s *impl) revokeNodes(ctx context.Context, nodeIDs ...string) {
+	log := util.LogFromContext(ctx)
+
+	for _, nodeID := range nodeIDs {
+		if err := s.revokeNode(ctx, nodeID); err != nil {
+			log.Warn().Err(err).Str("node_id", nodeID).Msg("Failed to report revoked node to MPC server")
+		}
+	}
+}
+
+func (s *impl) revokeNode(ctx context.Context, nodeID string) error {
+	shared, err := models.UserDevices(
+		models.UserDeviceWhere.NodeID.EQ(nodeID),
+		models.UserDeviceWhere.Status.EQ(StatusActive),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("failed to check node is shared by active devices: %w", err)
+	}
+	if !shared {
+		if _, err := s.nodeClient.Heartbeat(ctx, nodeID, NodeStatusRevoked); err != nil {
+			return err
+		}
+	}
+
+	// Nodes still shared are revoked along with the last of their devices.
+	if _, err := models.UserDevices(
+		models.UserDeviceWhere.NodeID.EQ(nodeID),
+		models.UserDeviceWhere.NodeRevocationPending.EQ(true),
+	).UpdateAll(ctx, s.db, models.M{
+		models.UserDeviceColumns.NodeRevocationPending: false,
+	}); err != nil {
+		return fmt.Errorf("failed to clear pending node revocation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *impl) Run(ctx context.Context) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Mpc.NodeRevocationInterval <= 0 {
+		log.Warn().Msg("No node revocation interval configured, skipping retries of node revocations")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Mpc.NodeRevocationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RetryNodeRevocations(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to retry node revocations")
+			}
+		}
+	}
+}
+
+func (s *impl) RetryNodeRevocations(ctx context.Context) error {
+	devices, err := models.UserDevices(
+		models.UserDeviceWhere.NodeRevocationPending.EQ(true),
+		qm.OrderBy(models.UserDeviceColumns.RevokedAt),
+	).All(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("failed to list pending node revocations: %w", err)
+	}
+
+	// Devices sharing a node are reported once.
+	seen := make(map[string]struct{}, len(devices))
+	nodeIDs := make([]string, 0, len(devices))
+	for _, device := range devices {
+		if _, ok := seen[device.NodeID]; ok {
+			continue
+		}
+		seen[device.NodeID] = struct{}{}
+		nodeIDs = append(nodeIDs, device.NodeID)
+	}
+	s.revokeNodes(ctx, nodeIDs...)
+
+	return nil
+}
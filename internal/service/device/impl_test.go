@@ -0,0 +1,116 @@
+package device_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/device"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnroll(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+
+		params := device.EnrollParams{
+			UserID:    fix.User1.ID,
+			DeviceID:  "device-1",
+			PublicKey: "public-key",
+		}
+		_, err := s.Device.Enroll(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyRequired)
+
+		// A passkey of the user merely named in the request does not enroll a device.
+		assertion := passkey.Assert(t, s, auth.AssertionActionEnrollDevice, "device-1")
+		params.CredentialID = assertion.CredentialID
+		params.Signature = assertion.Signature
+		params.AuthenticatorData = assertion.AuthenticatorData
+		params.ClientDataJSON = []byte(`{"type":"webauthn.get","challenge":"forged","origin":"http://localhost:3000"}`)
+		_, err = s.Device.Enroll(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		nodes, err := mpc.NewNodeClient(s.MpcConn).ListNodes(ctx, mpc.NodeFilter{Type: device.NodeType})
+		require.NoError(t, err)
+		assert.Empty(t, nodes)
+
+		// Neither does an assertion confirming the enrollment of another device.
+		assertion = passkey.Assert(t, s, auth.AssertionActionEnrollDevice, "device-2")
+		params.Signature = assertion.Signature
+		params.AuthenticatorData = assertion.AuthenticatorData
+		params.ClientDataJSON = assertion.ClientDataJSON
+		_, err = s.Device.Enroll(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		assertion = passkey.Assert(t, s, auth.AssertionActionEnrollDevice, "device-1")
+		params.Signature = assertion.Signature
+		params.AuthenticatorData = assertion.AuthenticatorData
+		params.ClientDataJSON = assertion.ClientDataJSON
+		enrolled, err := s.Device.Enroll(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, device.StatusActive, enrolled.Status)
+		assert.NotEmpty(t, enrolled.NodeID)
+
+		credential, err := models.UserCredentials(models.UserCredentialWhere.CredentialID.EQ(string(passkey.CredentialID))).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, credential.ID, enrolled.CredentialID.String)
+
+		nodes, err = mpc.NewNodeClient(s.MpcConn).ListNodes(ctx, mpc.NodeFilter{Type: device.NodeType})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, enrolled.NodeID, nodes[0].ID)
+	})
+}
+
+func TestRetryNodeRevocations(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		nodeClient := mpc.NewNodeClient(s.MpcConn)
+
+		assertion := passkey.Assert(t, s, auth.AssertionActionEnrollDevice, "device-1")
+		enrolled, err := s.Device.Enroll(ctx, device.EnrollParams{
+			UserID:            fix.User1.ID,
+			DeviceID:          "device-1",
+			PublicKey:         "public-key",
+			CredentialID:      assertion.CredentialID,
+			Signature:         assertion.Signature,
+			AuthenticatorData: assertion.AuthenticatorData,
+			ClientDataJSON:    assertion.ClientDataJSON,
+		})
+		require.NoError(t, err)
+
+		revoked, err := s.Device.Revoke(ctx, fix.User1.ID, enrolled.ID)
+		require.NoError(t, err)
+		require.NoError(t, revoked.Reload(ctx, s.DB))
+		assert.False(t, revoked.NodeRevocationPending)
+		nodes, err := nodeClient.ListNodes(ctx, mpc.NodeFilter{Type: device.NodeType, Status: device.NodeStatusRevoked})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+
+		// A revocation the MPC server missed stays pending until it is reported.
+		_, err = nodeClient.Heartbeat(ctx, enrolled.NodeID, mpc.NodeStatusOnline)
+		require.NoError(t, err)
+		revoked.NodeRevocationPending = true
+		_, err = revoked.Update(ctx, s.DB, boil.Whitelist(models.UserDeviceColumns.NodeRevocationPending))
+		require.NoError(t, err)
+
+		require.NoError(t, s.Device.RetryNodeRevocations(ctx))
+		require.NoError(t, revoked.Reload(ctx, s.DB))
+		assert.False(t, revoked.NodeRevocationPending)
+		nodes, err = nodeClient.ListNodes(ctx, mpc.NodeFilter{Type: device.NodeType, Status: device.NodeStatusRevoked})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, enrolled.NodeID, nodes[0].ID)
+	})
+}
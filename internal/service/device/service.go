@@ -0,0 +1,50 @@
+package device
+
+import (
+	"context"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// Devices are active until revoked by the user or along with the passkey they are bound to.
+const (
+	StatusActive  = "active"
+	StatusRevoked = "revoked"
+)
+
+// NodeType is the type devices are registered as with the MPC server.
+const NodeType = "client"
+
+// NodeStatusRevoked is reported to the MPC server for the client node of revoked devices.
+const NodeStatusRevoked = "revoked"
+
+// EnrollParams carries the device along with the passkey assertion of the user binding it.
+type EnrollParams struct {
+	UserID    string
+	DeviceID  string
+	PublicKey string
+	Name      string
+	Version   string
+
+	CredentialID      []byte
+	Signature         []byte
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+}
+
+type Service interface {
+	// Enroll registers the device as client node with the MPC server and binds it to the passkey of the user.
+	Enroll(ctx context.Context, params EnrollParams) (*models.UserDevice, error)
+	// ListDevices returns the devices of the user, newest first, with their credential loaded.
+	ListDevices(ctx context.Context, userID string) (models.UserDeviceSlice, error)
+	// Heartbeat reports the status of the client node of the device to the MPC server, returning its commands.
+	Heartbeat(ctx context.Context, userID string, deviceID string, status string) (*models.UserDevice, []string, error)
+	// Revoke revokes the device of the user and its client node unless another active device shares it.
+	Revoke(ctx context.Context, userID string, deviceID string) (*models.UserDevice, error)
+	// RemoveCredential removes the passkey of the user and revokes the devices bound to it.
+	RemoveCredential(ctx context.Context, userID string, credentialID []byte) error
+	// Run retries reporting the client nodes of revoked devices as revoked until the context is cancelled.
+	Run(ctx context.Context)
+	// RetryNodeRevocations reports the client nodes of revoked devices as revoked which failed to be reported before.
+	RetryNodeRevocations(ctx context.Context) error
+}
@@ -0,0 +1,82 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+)
+
+// localKeysQuery lists the keys referenced by the vaults which were not retired, retired keys are deleted from the
+// MPC server.
+const localKeysQuery = `
+SELECT DISTINCT keys.key_id, COALESCE(keys.vault_id::text, '') AS vault_id, keys.source
+FROM (
+	SELECT key_id, vault_id, 'vault_keys' AS source FROM vault_keys
+	UNION
+	SELECT key_id, vault_id, 'wallets' AS source FROM wallets
+) AS keys
+LEFT JOIN root_keys ON root_keys.key_id = keys.key_id
+WHERE root_keys.status IS DISTINCT FROM $1
+ORDER BY keys.key_id, vault_id, keys.source`
+
+// mpcKeyStatusDeleted is reported by the MPC server for keys pending deletion.
+const mpcKeyStatusDeleted = "deleted"
+
+func (s *impl) CheckConsistency(ctx context.Context) (*ConsistencyReport, error) {
+	var local []struct {
+		KeyID   string `boil:"key_id"`
+		VaultID string `boil:"vault_id"`
+		Source  string `boil:"source"`
+	}
+	if err := queries.Raw(localKeysQuery, vault.KeyStatusRetired).Bind(ctx, s.db, &local); err != nil {
+		return nil, fmt.Errorf("failed to list keys of vaults: %w", err)
+	}
+
+	remote, err := s.keyClient.ListKeys(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keys of MPC server: %w", err)
+	}
+
+	held := make(map[string]struct{}, len(remote))
+	for _, key := range remote {
+		if strings.EqualFold(key.Status, mpcKeyStatusDeleted) {
+			continue
+		}
+		held[key.KeyID] = struct{}{}
+	}
+
+	report := &ConsistencyReport{}
+	referenced := make(map[string]struct{}, len(local))
+	for _, key := range local {
+		if _, ok := referenced[key.KeyID]; !ok {
+			report.Checked++
+		}
+		referenced[key.KeyID] = struct{}{}
+
+		if _, ok := held[key.KeyID]; !ok {
+			report.Missing = append(report.Missing, LocalKey{
+				KeyID:   key.KeyID,
+				VaultID: key.VaultID,
+				Source:  key.Source,
+			})
+		}
+	}
+
+	for _, key := range remote {
+		if _, ok := held[key.KeyID]; !ok {
+			continue
+		}
+		if _, ok := referenced[key.KeyID]; !ok {
+			report.Orphaned = append(report.Orphaned, key)
+		}
+	}
+	sort.Slice(report.Orphaned, func(i, j int) bool {
+		return report.Orphaned[i].KeyID < report.Orphaned[j].KeyID
+	})
+
+	return report, nil
+}
@@ -0,0 +1,214 @@
+package key
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/evm"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+type impl struct {
+	config    config.Server
+	db        *sql.DB
+	clock     time2.Clock
+	keyClient *mpc.KeyClient
+	evm       evm.Client
+}
+
+//nolint:ireturn
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, keyClient *mpc.KeyClient, evmClient evm.Client) Service {
+	return &impl{
+		config:    config,
+		db:        db,
+		clock:     clock,
+		keyClient: keyClient,
+		evm:       evmClient,
+	}
+}
+
+func (s *impl) ListKeys(ctx context.Context, vaultID string) ([]*Key, error) {
+	keyIDs, err := vault.KeyIDs(ctx, s.db, vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(keyIDs))
+	for keyID := range keyIDs {
+		ids = append(ids, keyID)
+	}
+	sort.Strings(ids)
+
+	return s.loadKeys(ctx, vaultID, ids)
+}
+
+func (s *impl) GetKey(ctx context.Context, vaultID string, keyID string) (*Key, error) {
+	keyIDs, err := vault.KeyIDs(ctx, s.db, vaultID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := keyIDs[keyID]; !ok {
+		return nil, httperrors.ErrNotFoundKey
+	}
+
+	keys, err := s.loadKeys(ctx, vaultID, []string{keyID})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys[0], nil
+}
+
+func (s *impl) UpdateKey(ctx context.Context, vaultID string, keyID string, userID string, params UpdateParams) (*Key, error) {
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		v, err := models.FindVault(ctx, exec, vaultID)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+		if v.Status == vault.StatusArchived {
+			return httperrors.ErrConflictVaultArchived
+		}
+
+		keyIDs, err := vault.KeyIDs(ctx, exec, vaultID)
+		if err != nil {
+			return err
+		}
+		if _, ok := keyIDs[keyID]; !ok {
+			return httperrors.ErrNotFoundKey
+		}
+
+		record := &models.RootKey{
+			KeyID:   keyID,
+			VaultID: vaultID,
+			Tags:    []byte("{}"),
+			Status:  vault.KeyStatusActive,
+		}
+		update := []string{models.RootKeyColumns.UpdatedAt}
+		details := map[string]interface{}{
+			"vault_id": vaultID,
+		}
+		if params.Description != nil {
+			record.Description = null.NewString(*params.Description, *params.Description != "")
+			update = append(update, models.RootKeyColumns.Description)
+			details["description"] = *params.Description
+		}
+		if params.Tags != nil {
+			record.Tags, err = json.Marshal(params.Tags)
+			if err != nil {
+				return fmt.Errorf("failed to marshal tags: %w", err)
+			}
+			update = append(update, models.RootKeyColumns.Tags)
+			details["tags"] = params.Tags
+		}
+
+		if err := record.Upsert(ctx, exec, true,
+			[]string{models.RootKeyColumns.KeyID},
+			boil.Whitelist(update...),
+			boil.Infer(),
+		); err != nil {
+			return fmt.Errorf("failed to upsert root key: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: v.OrganizationID.String,
+			UserID:         userID,
+			Action:         audit.ActionTagKey,
+			ResourceType:   audit.ResourceTypeKey,
+			ResourceID:     keyID,
+			Details:        details,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.GetKey(ctx, vaultID, keyID)
+}
+
+// loadKeys assembles the keys of the vault from their records, wallets and the MPC server. Keys the MPC server fails
+// to report are returned without metadata.
+func (s *impl) loadKeys(ctx context.Context, vaultID string, keyIDs []string) ([]*Key, error) {
+	log := util.LogFromContext(ctx)
+
+	records, err := models.RootKeys(
+		models.RootKeyWhere.KeyID.IN(keyIDs),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list root keys: %w", err)
+	}
+	byKeyID := make(map[string]*models.RootKey, len(records))
+	for _, r := range records {
+		byKeyID[r.KeyID] = r
+	}
+
+	vaultKeys, err := models.VaultKeys(
+		models.VaultKeyWhere.VaultID.EQ(null.StringFrom(vaultID)),
+		models.VaultKeyWhere.KeyID.IN(keyIDs),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vault keys: %w", err)
+	}
+	vaultKeysByKeyID := make(map[string]*models.VaultKey, len(vaultKeys))
+	for _, k := range vaultKeys {
+		vaultKeysByKeyID[k.KeyID] = k
+	}
+
+	refreshes, err := models.KeyRefreshes(
+		models.KeyRefreshWhere.KeyID.IN(keyIDs),
+		models.KeyRefreshWhere.Status.IN([]string{vault.RefreshStatusApproved, vault.RefreshStatusRunning}),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list key refreshes: %w", err)
+	}
+	refreshing := make(map[string]bool, len(refreshes))
+	for _, r := range refreshes {
+		refreshing[r.KeyID] = true
+	}
+
+	wallets, err := models.Wallets(
+		models.WalletWhere.VaultID.EQ(null.StringFrom(vaultID)),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+	walletCounts := make(map[string]int)
+	for _, w := range wallets {
+		walletCounts[w.KeyID]++
+	}
+
+	keys := make([]*Key, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		key := &Key{
+			KeyID:      keyID,
+			VaultID:    vaultID,
+			Record:     byKeyID[keyID],
+			VaultKey:   vaultKeysByKeyID[keyID],
+			Refreshing: refreshing[keyID],
+			Wallets:    walletCounts[keyID],
+		}
+
+		// Retired keys were deleted from the MPC server.
+		if key.Status() != vault.KeyStatusRetired {
+			key.Info, err = s.keyClient.GetKey(ctx, keyID)
+			if err != nil {
+				log.Warn().Err(err).Str("key_id", keyID).Msg("Failed to get key from MPC server")
+			}
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,344 @@
+package key
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// dueRefreshesQuery lists the active keys of the active vaults whose shares were last refreshed, or created, before
+// the cutoff. Keys with a refresh proposal pending, or opened since the cutoff, are skipped so rejected refreshes are
+// not proposed again before the shares aged once more.
+const dueRefreshesQuery = `
+SELECT vault_keys.vault_id, vault_keys.key_id
+FROM vault_keys
+INNER JOIN vaults ON vaults.id = vault_keys.vault_id
+LEFT JOIN root_keys ON root_keys.key_id = vault_keys.key_id
+WHERE vaults.status <> $1
+AND COALESCE(root_keys.status, $2) = $2
+AND COALESCE(vault_keys.refreshed_at, vault_keys.created_at) < $3
+AND NOT EXISTS (
+	SELECT 1 FROM vault_proposals
+	WHERE vault_proposals.vault_id = vault_keys.vault_id
+	AND vault_proposals.kind = $4
+	AND vault_proposals.payload->>'key_id' = vault_keys.key_id
+	AND (vault_proposals.status = $5 OR vault_proposals.created_at >= $3)
+)
+AND NOT EXISTS (
+	SELECT 1 FROM key_refreshes
+	WHERE key_refreshes.key_id = vault_keys.key_id
+	AND key_refreshes.status IN ($6, $7)
+)
+ORDER BY vault_keys.vault_id, vault_keys.key_id`
+
+type dueRefresh struct {
+	VaultID string `boil:"vault_id"`
+	KeyID   string `boil:"key_id"`
+}
+
+func (s *impl) ListRefreshes(ctx context.Context, vaultID string, keyID string) (models.KeyRefreshSlice, error) {
+	keyIDs, err := vault.KeyIDs(ctx, s.db, vaultID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := keyIDs[keyID]; !ok {
+		return nil, httperrors.ErrNotFoundKey
+	}
+
+	refreshes, err := models.KeyRefreshes(
+		models.KeyRefreshWhere.VaultID.EQ(vaultID),
+		models.KeyRefreshWhere.KeyID.EQ(keyID),
+		qm.OrderBy(models.KeyRefreshColumns.CreatedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list key refreshes: %w", err)
+	}
+
+	return refreshes, nil
+}
+
+func (s *impl) Run(ctx context.Context) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.KeyRefresh.Interval <= 0 {
+		log.Warn().Msg("No key refresh interval configured, skipping key refreshes and retirements")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.KeyRefresh.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RunRefreshes(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to run key refreshes")
+			}
+			if err := s.RunRetirements(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to run key retirements")
+			}
+		}
+	}
+}
+
+func (s *impl) RunRefreshes(ctx context.Context) error {
+	if err := s.failStaleRefreshes(ctx); err != nil {
+		return err
+	}
+
+	for {
+		refresh, err := s.startRefresh(ctx)
+		if err != nil {
+			return err
+		}
+		if refresh == nil {
+			break
+		}
+		if err := s.executeRefresh(ctx, refresh); err != nil {
+			return err
+		}
+	}
+
+	return s.proposeDueRefreshes(ctx)
+}
+
+// failStaleRefreshes fails running refreshes not finished within twice the timeout, the instance running them must
+// have stopped before recording their outcome.
+func (s *impl) failStaleRefreshes(ctx context.Context) error {
+	stale, err := models.KeyRefreshes(
+		models.KeyRefreshWhere.Status.EQ(vault.RefreshStatusRunning),
+		models.KeyRefreshWhere.StartedAt.LT(null.TimeFrom(s.clock.Now().Add(-2*s.config.KeyRefresh.Timeout))),
+	).All(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("failed to list stale key refreshes: %w", err)
+	}
+
+	for _, refresh := range stale {
+		if err := s.finishRefresh(ctx, refresh.ID, errors.New("refresh did not finish in time")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startRefresh marks the oldest approved refresh as running, nil if none is approved. Refreshes started by other
+// instances are skipped.
+func (s *impl) startRefresh(ctx context.Context) (*models.KeyRefresh, error) {
+	var refresh *models.KeyRefresh
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var err error
+		refresh, err = models.KeyRefreshes(
+			models.KeyRefreshWhere.Status.EQ(vault.RefreshStatusApproved),
+			qm.OrderBy(models.KeyRefreshColumns.CreatedAt),
+			qm.Limit(1),
+			qm.For("UPDATE SKIP LOCKED"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				refresh = nil
+				return nil
+			}
+			return fmt.Errorf("failed to find approved key refresh: %w", err)
+		}
+
+		refresh.Status = vault.RefreshStatusRunning
+		refresh.StartedAt = null.TimeFrom(s.clock.Now())
+		if _, err := refresh.Update(ctx, exec, boil.Whitelist(
+			models.KeyRefreshColumns.Status,
+			models.KeyRefreshColumns.StartedAt,
+			models.KeyRefreshColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update key refresh: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return refresh, nil
+}
+
+// executeRefresh re-shares the key of the running refresh through the MPC server and records the outcome.
+func (s *impl) executeRefresh(ctx context.Context, refresh *models.KeyRefresh) error {
+	// The refresh proceeds even if the server shuts down meanwhile, as the key stays frozen until it finished.
+	rctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.config.KeyRefresh.Timeout)
+	defer cancel()
+
+	refreshErr := s.refreshShares(rctx, refresh)
+	if refreshErr != nil {
+		util.LogFromContext(ctx).Error().Err(refreshErr).Str("refresh_id", refresh.ID).Str("key_id", refresh.KeyID).Msg("Failed to refresh key shares")
+	}
+
+	return s.finishRefresh(context.WithoutCancel(ctx), refresh.ID, refreshErr)
+}
+
+func (s *impl) refreshShares(ctx context.Context, refresh *models.KeyRefresh) error {
+	vaultKey, err := models.VaultKeys(
+		models.VaultKeyWhere.VaultID.EQ(null.StringFrom(refresh.VaultID)),
+		models.VaultKeyWhere.KeyID.EQ(refresh.KeyID),
+	).One(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("failed to find vault key: %w", err)
+	}
+
+	// Shares being recovered would be invalidated by the refresh.
+	frozen, err := backup.KeyFrozen(ctx, s.db, refresh.KeyID)
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return errors.New("a share of the key is being recovered")
+	}
+
+	// Signings started before the refresh was approved finish first, later ones are refused as it is running.
+	unlock, err := vault.LockKeyShares(ctx, s.db, refresh.KeyID, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	_, err = s.keyClient.RefreshKey(ctx, refresh.KeyID, vaultKey.PublicKeyHex, refresh.Epoch)
+	return err
+}
+
+// finishRefresh completes the running refresh, or fails it if refreshErr is set. Refreshes no longer running were
+// finished meanwhile and are left unchanged.
+func (s *impl) finishRefresh(ctx context.Context, refreshID string, refreshErr error) error {
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		refresh, err := models.KeyRefreshes(
+			models.KeyRefreshWhere.ID.EQ(refreshID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to find key refresh: %w", err)
+		}
+		if refresh.Status != vault.RefreshStatusRunning {
+			return nil
+		}
+
+		v, err := models.FindVault(ctx, exec, refresh.VaultID)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+
+		now := s.clock.Now()
+		refresh.Status = vault.RefreshStatusCompleted
+		refresh.CompletedAt = null.TimeFrom(now)
+		action := audit.ActionCompleteKeyRefresh
+		eventType := outbox.EventKeyRefreshed
+		details := map[string]interface{}{
+			"vault_id":    refresh.VaultID,
+			"key_id":      refresh.KeyID,
+			"proposal_id": refresh.ProposalID.String,
+			"trigger":     refresh.Trigger,
+			"epoch":       refresh.Epoch,
+		}
+		if refreshErr != nil {
+			refresh.Status = vault.RefreshStatusFailed
+			refresh.FailureReason = null.StringFrom(refreshErr.Error())
+			action = audit.ActionFailKeyRefresh
+			eventType = outbox.EventKeyRefreshFailed
+			details["failure_reason"] = refreshErr.Error()
+		} else {
+			if _, err := models.VaultKeys(
+				models.VaultKeyWhere.VaultID.EQ(null.StringFrom(refresh.VaultID)),
+				models.VaultKeyWhere.KeyID.EQ(refresh.KeyID),
+			).UpdateAll(ctx, exec, models.M{
+				models.VaultKeyColumns.RefreshEpoch: refresh.Epoch,
+				models.VaultKeyColumns.RefreshedAt:  now,
+				models.VaultKeyColumns.UpdatedAt:    now,
+			}); err != nil {
+				return fmt.Errorf("failed to update vault key: %w", err)
+			}
+		}
+
+		if _, err := refresh.Update(ctx, exec, boil.Whitelist(
+			models.KeyRefreshColumns.Status,
+			models.KeyRefreshColumns.FailureReason,
+			models.KeyRefreshColumns.CompletedAt,
+			models.KeyRefreshColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update key refresh: %w", err)
+		}
+
+		if err := audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: v.OrganizationID.String,
+			Action:         action,
+			ResourceType:   audit.ResourceTypeKeyRefresh,
+			ResourceID:     refresh.ID,
+			Details:        details,
+		}); err != nil {
+			return err
+		}
+
+		return outbox.Enqueue(ctx, exec, outbox.Event{
+			OrganizationID: v.OrganizationID.String,
+			Type:           eventType,
+			ResourceType:   audit.ResourceTypeKey,
+			ResourceID:     refresh.KeyID,
+			Data:           details,
+		})
+	})
+}
+
+// proposeDueRefreshes opens refresh proposals for the keys whose shares reached the maximum age.
+func (s *impl) proposeDueRefreshes(ctx context.Context) error {
+	if s.config.KeyRefresh.MaxAge <= 0 {
+		return nil
+	}
+
+	var due []*dueRefresh
+	if err := queries.Raw(dueRefreshesQuery,
+		vault.StatusArchived,
+		vault.KeyStatusActive,
+		s.clock.Now().Add(-s.config.KeyRefresh.MaxAge),
+		vault.ProposalKindKeyRefresh,
+		vault.ProposalStatusPending,
+		vault.RefreshStatusApproved,
+		vault.RefreshStatusRunning,
+	).Bind(ctx, s.db, &due); err != nil {
+		return fmt.Errorf("failed to list keys due for refresh: %w", err)
+	}
+
+	log := util.LogFromContext(ctx)
+	for _, d := range due {
+		if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+			v, approvers, err := vault.LockProposalVault(ctx, exec, d.VaultID, "")
+			if err != nil {
+				return err
+			}
+
+			proposal, err := vault.OpenKeyRefreshProposal(ctx, exec, v, approvers, d.KeyID, "", vault.RefreshTriggerScheduled)
+			if err != nil {
+				return err
+			}
+
+			log.Info().Str("vault_id", d.VaultID).Str("key_id", d.KeyID).Str("proposal_id", proposal.ID).Msg("Proposed scheduled key refresh")
+			return nil
+		}); err != nil {
+			// Keys might have been proposed or retired meanwhile, the remaining keys are proposed regardless.
+			log.Error().Err(err).Str("vault_id", d.VaultID).Str("key_id", d.KeyID).Msg("Failed to propose scheduled key refresh")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package key_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withRefreshVault creates a vault of an organization of User1 and User2 with the given threshold and returns it with
+// its key.
+func withRefreshVault(t *testing.T, s *api.Server, threshold int) (*models.Vault, *models.VaultKey) {
+	t.Helper()
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+	require.NoError(t, err)
+	_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+	require.NoError(t, err)
+	v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, threshold, fix.User1.ID, []vault.KeyConfig{
+		{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+	})
+	require.NoError(t, err)
+	vaultKey, err := models.VaultKeys(models.VaultKeyWhere.VaultID.EQ(null.StringFrom(v.ID))).One(ctx, s.DB)
+	require.NoError(t, err)
+
+	return v, vaultKey
+}
+
+func approveProposal(t *testing.T, s *api.Server, passkey *test.Passkey, proposalID string, userID string) *models.VaultProposal {
+	t.Helper()
+
+	assertion := passkey.Assert(t, s, auth.AssertionActionApproveProposal, proposalID)
+	proposal, err := s.Vault.ApproveProposal(t.Context(), proposalID, vault.ApprovalParams{
+		UserID:            userID,
+		CredentialID:      assertion.CredentialID,
+		Signature:         assertion.Signature,
+		AuthenticatorData: assertion.AuthenticatorData,
+		ClientDataJSON:    assertion.ClientDataJSON,
+	})
+	require.NoError(t, err)
+
+	return proposal
+}
+
+func TestKeyRefreshWorkflow(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, vaultKey := withRefreshVault(t, s, 1)
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+
+		refresh := func() *models.KeyRefresh {
+			t.Helper()
+			proposal, err := s.Vault.ProposeKeyRefresh(ctx, v.ID, fix.User1.ID, vaultKey.KeyID)
+			require.NoError(t, err)
+			_, err = s.Vault.ProposeKeyRefresh(ctx, v.ID, fix.User1.ID, vaultKey.KeyID)
+			require.ErrorIs(t, err, httperrors.ErrConflictKeyRefreshPending)
+
+			// The approval freezes the key until the refresh was run.
+			assert.Equal(t, vault.ProposalStatusExecuted, approveProposal(t, s, passkey, proposal.ID, fix.User1.ID).Status)
+			refreshing, err := vault.KeyRefreshing(ctx, s.DB, vaultKey.KeyID)
+			require.NoError(t, err)
+			assert.True(t, refreshing)
+
+			require.NoError(t, s.Key.RunRefreshes(ctx))
+			refreshing, err = vault.KeyRefreshing(ctx, s.DB, vaultKey.KeyID)
+			require.NoError(t, err)
+			assert.False(t, refreshing)
+
+			refreshes, err := s.Key.ListRefreshes(ctx, v.ID, vaultKey.KeyID)
+			require.NoError(t, err)
+			require.NotEmpty(t, refreshes)
+			assert.Equal(t, proposal.ID, refreshes[0].ProposalID.String)
+			return refreshes[0]
+		}
+
+		completed := refresh()
+		assert.Equal(t, vault.RefreshStatusCompleted, completed.Status)
+		assert.Equal(t, 1, completed.Epoch)
+		require.NoError(t, vaultKey.Reload(ctx, s.DB))
+		assert.Equal(t, 1, vaultKey.RefreshEpoch)
+		assert.True(t, vaultKey.RefreshedAt.Valid)
+		refreshed, err := models.OutboxEvents(
+			models.OutboxEventWhere.EventType.EQ(outbox.EventKeyRefreshed),
+			models.OutboxEventWhere.ResourceID.EQ(vaultKey.KeyID),
+		).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), refreshed)
+
+		// The MPC server moved the shares on by itself, the next refresh does not match its epoch and fails.
+		_, err = mpc.NewKeyClient(s.MpcConn).RefreshKey(ctx, vaultKey.KeyID, vaultKey.PublicKeyHex, 2)
+		require.NoError(t, err)
+		failed := refresh()
+		assert.Equal(t, vault.RefreshStatusFailed, failed.Status)
+		assert.Equal(t, 2, failed.Epoch)
+		assert.NotEmpty(t, failed.FailureReason.String)
+		require.NoError(t, vaultKey.Reload(ctx, s.DB))
+		assert.Equal(t, 1, vaultKey.RefreshEpoch)
+		refreshFailed, err := models.OutboxEvents(
+			models.OutboxEventWhere.EventType.EQ(outbox.EventKeyRefreshFailed),
+			models.OutboxEventWhere.ResourceID.EQ(vaultKey.KeyID),
+		).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), refreshFailed)
+	})
+}
+
+func TestKeyRefreshCancelledOnceKeyNotActive(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, vaultKey := withRefreshVault(t, s, 2)
+
+		proposal, err := s.Vault.ProposeKeyRefresh(ctx, v.ID, fix.User1.ID, vaultKey.KeyID)
+		require.NoError(t, err)
+		approveProposal(t, s, test.NewPasskey(t, s, fix.User1.ID), proposal.ID, fix.User1.ID)
+
+		// The key is retired meanwhile, the final approval cancels the proposal instead of failing.
+		record := &models.RootKey{
+			KeyID:     vaultKey.KeyID,
+			VaultID:   v.ID,
+			Tags:      []byte("{}"),
+			Status:    vault.KeyStatusRetired,
+			RetiredAt: null.TimeFrom(time.Now()),
+		}
+		require.NoError(t, record.Insert(ctx, s.DB, boil.Infer()))
+		proposal = approveProposal(t, s, test.NewPasskey(t, s, fix.User2.ID), proposal.ID, fix.User2.ID)
+		assert.Equal(t, vault.ProposalStatusCancelled, proposal.Status)
+		assert.Equal(t, "key "+vaultKey.KeyID+" is retired", proposal.StatusReason.String)
+
+		refreshes, err := s.Key.ListRefreshes(ctx, v.ID, vaultKey.KeyID)
+		require.NoError(t, err)
+		assert.Empty(t, refreshes)
+	})
+}
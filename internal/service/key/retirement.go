@@ -0,0 +1,204 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *impl) RunRetirements(ctx context.Context) error {
+	keys, err := models.RootKeys(
+		models.RootKeyWhere.Status.EQ(vault.KeyStatusRetiring),
+		models.RootKeyWhere.CompletionProposalID.IsNotNull(),
+		qm.OrderBy(models.RootKeyColumns.UpdatedAt),
+	).All(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("failed to list approved key retirements: %w", err)
+	}
+
+	log := util.LogFromContext(ctx)
+	for _, key := range keys {
+		// Balances the chains fail to report are read again in the next run, the remaining keys are completed regardless.
+		if err := s.completeRetirement(ctx, key); err != nil {
+			log.Error().Err(err).Str("key_id", key.KeyID).Msg("Failed to complete key retirement")
+		}
+	}
+
+	return nil
+}
+
+// completeRetirement deletes the key of an approved retirement from the MPC server and marks it as retired, or refuses
+// the retirement if any of its wallets still holds funds.
+func (s *impl) completeRetirement(ctx context.Context, record *models.RootKey) error {
+	reason, err := s.unsweptReason(ctx, record.KeyID)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return s.refuseRetirement(ctx, record, reason)
+	}
+
+	// The key is deleted before it is marked as retired, so a failure leaves it retiring to be completed again.
+	if err := s.keyClient.DeleteKey(ctx, record.KeyID); err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("failed to delete key from MPC server: %w", err)
+	}
+
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		key, err := models.RootKeys(
+			models.RootKeyWhere.KeyID.EQ(record.KeyID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to find root key: %w", err)
+		}
+		if key.Status != vault.KeyStatusRetiring {
+			return nil
+		}
+
+		v, err := models.FindVault(ctx, exec, key.VaultID)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+
+		key.Status = vault.KeyStatusRetired
+		key.RetiredAt = null.TimeFrom(s.clock.Now())
+		if _, err := key.Update(ctx, exec, boil.Whitelist(
+			models.RootKeyColumns.Status,
+			models.RootKeyColumns.RetiredAt,
+			models.RootKeyColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to retire root key: %w", err)
+		}
+
+		details := map[string]interface{}{
+			"key_id":           key.KeyID,
+			"vault_id":         key.VaultID,
+			"successor_key_id": key.SuccessorKeyID.String,
+			"proposal_id":      key.CompletionProposalID.String,
+		}
+		if err := outbox.Enqueue(ctx, exec, outbox.Event{
+			OrganizationID: v.OrganizationID.String,
+			Type:           outbox.EventKeyRetired,
+			ResourceType:   audit.ResourceTypeKey,
+			ResourceID:     key.KeyID,
+			Data:           details,
+		}); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: v.OrganizationID.String,
+			Action:         audit.ActionRetireKey,
+			ResourceType:   audit.ResourceTypeKey,
+			ResourceID:     key.KeyID,
+			Details:        details,
+		})
+	})
+}
+
+// unsweptReason describes why the wallets of the key are not known to be empty, empty if every balance recorded is zero
+// and the chains confirm it. Only the balances of EVM chains can be read, other chains never confirm them.
+func (s *impl) unsweptReason(ctx context.Context, keyID string) (string, error) {
+	funded, err := vault.KeyHoldsFunds(ctx, s.db, keyID)
+	if err != nil {
+		return "", err
+	}
+	if funded {
+		return "wallets of the key hold funds", nil
+	}
+
+	wallets, err := models.Wallets(
+		models.WalletWhere.KeyID.EQ(keyID),
+		qm.Load(models.WalletRels.Chain),
+		qm.Load(qm.Rels(models.WalletRels.WalletBalances, models.WalletBalanceRels.Asset)),
+	).All(ctx, s.db)
+	if err != nil {
+		return "", fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	for _, wallet := range wallets {
+		chain := wallet.R.Chain
+		if chain == nil || !strings.EqualFold(chain.Type, address.ChainTypeEVM) || strings.TrimSpace(chain.RPCURL.String) == "" {
+			return fmt.Sprintf("balances of wallet %s cannot be read from its chain", wallet.ID), nil
+		}
+
+		balance, err := s.evm.Balance(ctx, chain.RPCURL.String, wallet.Address)
+		if err != nil {
+			return "", fmt.Errorf("failed to read balance of wallet %s: %w", wallet.ID, err)
+		}
+		if balance.Sign() != 0 {
+			return fmt.Sprintf("wallet %s holds %s on chain", wallet.ID, chain.CurrencySymbol), nil
+		}
+
+		for _, b := range wallet.R.WalletBalances {
+			asset := b.R.Asset
+			if asset == nil || !asset.ContractAddress.Valid || asset.ContractAddress.String == "" {
+				continue
+			}
+			balance, err := s.evm.TokenBalance(ctx, chain.RPCURL.String, asset.ContractAddress.String, wallet.Address)
+			if err != nil {
+				return "", fmt.Errorf("failed to read %s balance of wallet %s: %w", asset.Symbol, wallet.ID, err)
+			}
+			if balance.Sign() != 0 {
+				return fmt.Sprintf("wallet %s holds %s on chain", wallet.ID, asset.Symbol), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// refuseRetirement withdraws the approval of the retirement completion, the key stays retiring and its completion has
+// to be proposed again once its funds were swept.
+func (s *impl) refuseRetirement(ctx context.Context, record *models.RootKey, reason string) error {
+	util.LogFromContext(ctx).Warn().Str("key_id", record.KeyID).Str("reason", reason).Msg("Refused to complete key retirement")
+
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		key, err := models.RootKeys(
+			models.RootKeyWhere.KeyID.EQ(record.KeyID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to find root key: %w", err)
+		}
+		if key.Status != vault.KeyStatusRetiring || key.CompletionProposalID != record.CompletionProposalID {
+			return nil
+		}
+
+		v, err := models.FindVault(ctx, exec, key.VaultID)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+
+		proposalID := key.CompletionProposalID.String
+		key.CompletionProposalID = null.String{}
+		if _, err := key.Update(ctx, exec, boil.Whitelist(models.RootKeyColumns.CompletionProposalID, models.RootKeyColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("failed to update root key: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: v.OrganizationID.String,
+			Action:         audit.ActionRefuseKeyRetire,
+			ResourceType:   audit.ResourceTypeKey,
+			ResourceID:     key.KeyID,
+			Details: map[string]interface{}{
+				"vault_id":    key.VaultID,
+				"proposal_id": proposalID,
+				"reason":      reason,
+			},
+		})
+	})
+}
@@ -0,0 +1,219 @@
+package key_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetirementRefusedWhileFundsRemain(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		// The chain reports the balances set by the test.
+		nativeBalance, tokenBalance := "0x1", "0x0000000000000000000000000000000000000000000000000000000000000000"
+		srv := test.NewTestJSONRPCServer(t, func(method string, _ []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+			switch method {
+			case "eth_getBalance":
+				return nativeBalance, nil
+			case "eth_call":
+				return tokenBalance, nil
+			default:
+				return nil, &jsonrpc.RPCError{Code: -32601, Message: "method not found"}
+			}
+		})
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+		chain := &models.Chain{
+			ID:             "ETH",
+			Name:           "Ethereum",
+			Type:           "EVM",
+			Algorithm:      mpc.AlgorithmECDSA,
+			Curve:          mpc.CurveSecp256k1,
+			CurrencySymbol: "ETH",
+			RPCURL:         null.StringFrom(srv.URL),
+			IsActive:       true,
+		}
+		require.NoError(t, chain.Insert(ctx, s.DB, boil.Infer()))
+		asset := &models.Asset{
+			ChainID:         null.StringFrom(chain.ID),
+			Symbol:          "USDT",
+			Name:            "Tether USD",
+			Type:            "ERC20",
+			ContractAddress: null.StringFrom("0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+			Decimals:        6,
+		}
+		require.NoError(t, asset.Insert(ctx, s.DB, boil.Infer()))
+		wallet, err := s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.DB.ExecContext(ctx, `INSERT INTO wallet_balances (wallet_id, asset_id, balance, raw_balance) VALUES ($1, $2, 1, '1000000')`, wallet.ID, asset.ID)
+		require.NoError(t, err)
+
+		record := &models.RootKey{
+			KeyID:          wallet.KeyID,
+			VaultID:        v.ID,
+			Tags:           []byte("{}"),
+			Status:         vault.KeyStatusRetiring,
+			SuccessorKeyID: null.StringFrom("successor-key"),
+			RetiringAt:     null.TimeFrom(time.Now()),
+		}
+		require.NoError(t, record.Insert(ctx, s.DB, boil.Infer()))
+
+		// The completion is not proposed while balances of the wallets are recorded.
+		_, err = s.Vault.ProposeKeyRetirementCompletion(ctx, v.ID, fix.User1.ID, wallet.KeyID)
+		require.ErrorIs(t, err, httperrors.ErrConflictKeyFundsNotSwept)
+
+		_, err = s.DB.ExecContext(ctx, `UPDATE wallet_balances SET balance = 0, raw_balance = '0' WHERE wallet_id = $1`, wallet.ID)
+		require.NoError(t, err)
+
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		approveCompletion := func() {
+			t.Helper()
+			proposal, err := s.Vault.ProposeKeyRetirementCompletion(ctx, v.ID, fix.User1.ID, wallet.KeyID)
+			require.NoError(t, err)
+			_, err = s.Vault.ProposeKeyRetirementCompletion(ctx, v.ID, fix.User1.ID, wallet.KeyID)
+			require.ErrorIs(t, err, httperrors.ErrConflictKeyRetirementPending)
+
+			assertion := passkey.Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID)
+			proposal, err = s.Vault.ApproveProposal(ctx, proposal.ID, vault.ApprovalParams{
+				UserID:            fix.User1.ID,
+				CredentialID:      assertion.CredentialID,
+				Signature:         assertion.Signature,
+				AuthenticatorData: assertion.AuthenticatorData,
+				ClientDataJSON:    assertion.ClientDataJSON,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, vault.ProposalStatusExecuted, proposal.Status)
+
+			require.NoError(t, record.Reload(ctx, s.DB))
+			assert.Equal(t, proposal.ID, record.CompletionProposalID.String)
+		}
+
+		// The chain still reports funds, the approval is withdrawn and the key kept.
+		approveCompletion()
+		require.NoError(t, s.Key.RunRetirements(ctx))
+		require.NoError(t, record.Reload(ctx, s.DB))
+		assert.Equal(t, vault.KeyStatusRetiring, record.Status)
+		assert.False(t, record.CompletionProposalID.Valid)
+		_, err = mpc.NewKeyClient(s.MpcConn).GetKey(ctx, wallet.KeyID)
+		require.NoError(t, err)
+
+		nativeBalance = "0x0"
+		tokenBalance = "0x00000000000000000000000000000000000000000000000000000000000f4240"
+		approveCompletion()
+		require.NoError(t, s.Key.RunRetirements(ctx))
+		require.NoError(t, record.Reload(ctx, s.DB))
+		assert.Equal(t, vault.KeyStatusRetiring, record.Status)
+		assert.False(t, record.CompletionProposalID.Valid)
+
+		// Once the chain confirms the wallets to be empty, the key is deleted.
+		tokenBalance = "0x0000000000000000000000000000000000000000000000000000000000000000"
+		approveCompletion()
+		require.NoError(t, s.Key.RunRetirements(ctx))
+		require.NoError(t, record.Reload(ctx, s.DB))
+		assert.Equal(t, vault.KeyStatusRetired, record.Status)
+		assert.True(t, record.RetiredAt.Valid)
+		_, err = mpc.NewKeyClient(s.MpcConn).GetKey(ctx, wallet.KeyID)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestRetirementCancelledOnceKeyNotActive(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+		require.NoError(t, err)
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 2, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+		vaultKey, err := models.VaultKeys(models.VaultKeyWhere.VaultID.EQ(null.StringFrom(v.ID))).One(ctx, s.DB)
+		require.NoError(t, err)
+		chain := &models.Chain{
+			ID:             "ETH",
+			Name:           "Ethereum",
+			Type:           "EVM",
+			Algorithm:      mpc.AlgorithmECDSA,
+			Curve:          mpc.CurveSecp256k1,
+			CurrencySymbol: "ETH",
+			IsActive:       true,
+		}
+		require.NoError(t, chain.Insert(ctx, s.DB, boil.Infer()))
+		wallet, err := s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+		require.NoError(t, err)
+
+		passkeys := map[string]*test.Passkey{
+			fix.User1.ID: test.NewPasskey(t, s, fix.User1.ID),
+			fix.User2.ID: test.NewPasskey(t, s, fix.User2.ID),
+		}
+		approve := func(proposalID string, userID string) *models.VaultProposal {
+			t.Helper()
+			return approveProposal(t, s, passkeys[userID], proposalID, userID)
+		}
+
+		proposal, err := s.Vault.ProposeKeyRetirement(ctx, v.ID, fix.User1.ID, wallet.KeyID, vaultKey.KeyID)
+		require.NoError(t, err)
+		assert.Equal(t, vault.ProposalStatusPending, approve(proposal.ID, fix.User1.ID).Status)
+
+		// The successor is retired meanwhile, the final approval cancels the proposal instead of failing.
+		successor := &models.RootKey{
+			KeyID:     vaultKey.KeyID,
+			VaultID:   v.ID,
+			Tags:      []byte("{}"),
+			Status:    vault.KeyStatusRetired,
+			RetiredAt: null.TimeFrom(time.Now()),
+		}
+		require.NoError(t, successor.Insert(ctx, s.DB, boil.Infer()))
+		proposal = approve(proposal.ID, fix.User2.ID)
+		assert.Equal(t, vault.ProposalStatusCancelled, proposal.Status)
+		assert.Equal(t, "key "+vaultKey.KeyID+" is retired", proposal.StatusReason.String)
+		status, err := vault.KeyStatus(ctx, s.DB, wallet.KeyID)
+		require.NoError(t, err)
+		assert.Equal(t, vault.KeyStatusActive, status)
+
+		// The cancelled proposal no longer blocks new ones.
+		_, err = successor.Delete(ctx, s.DB)
+		require.NoError(t, err)
+		proposal, err = s.Vault.ProposeKeyRetirement(ctx, v.ID, fix.User1.ID, wallet.KeyID, vaultKey.KeyID)
+		require.NoError(t, err)
+		approve(proposal.ID, fix.User1.ID)
+		assert.Equal(t, vault.ProposalStatusExecuted, approve(proposal.ID, fix.User2.ID).Status)
+
+		// Likewise the completion is cancelled once the key is no longer retiring.
+		completion, err := s.Vault.ProposeKeyRetirementCompletion(ctx, v.ID, fix.User1.ID, wallet.KeyID)
+		require.NoError(t, err)
+		approve(completion.ID, fix.User1.ID)
+		_, err = s.DB.ExecContext(ctx, `UPDATE root_keys SET status = $1 WHERE key_id = $2`, vault.KeyStatusRetired, wallet.KeyID)
+		require.NoError(t, err)
+		completion = approve(completion.ID, fix.User2.ID)
+		assert.Equal(t, vault.ProposalStatusCancelled, completion.Status)
+		assert.Equal(t, "key "+wallet.KeyID+" is not retiring", completion.StatusReason.String)
+	})
+}
@@ -0,0 +1,81 @@
+package key
+
+import (
+	"context"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+)
+
+// Key is a root key backing a vault along with its metadata as reported by the MPC server.
+type Key struct {
+	KeyID   string
+	VaultID string
+	// Record holds the lifecycle and annotations of the key, nil for active keys never annotated.
+	Record *models.RootKey
+	// VaultKey holds the configuration and refresh epoch of the key, nil for keys only referenced by wallets.
+	VaultKey *models.VaultKey
+	// Refreshing is set while a refresh of the shares of the key is approved or running.
+	Refreshing bool
+	// Info is nil if the MPC server could not report the key.
+	Info *mpc.KeyInfo
+	// Wallets is the number of wallets of the vault derived from the key.
+	Wallets int
+}
+
+// Status returns the lifecycle status of the key.
+func (k *Key) Status() string {
+	if k.Record == nil {
+		return vault.KeyStatusActive
+	}
+	return k.Record.Status
+}
+
+type UpdateParams struct {
+	// Description and Tags are left unchanged if nil, Tags replace all tags set before.
+	Description *string
+	Tags        map[string]string
+}
+
+// LocalKey is a key referenced by the vault.
+type LocalKey struct {
+	KeyID   string
+	VaultID string
+	// Source is the table referencing the key, either vault_keys or wallets.
+	Source string
+}
+
+// ConsistencyReport lists the keys known to only one side of the vault and the MPC server.
+type ConsistencyReport struct {
+	// Checked is the number of keys referenced by the vault.
+	Checked int
+	// Missing are referenced by the vault but unknown to the MPC server.
+	Missing []LocalKey
+	// Orphaned are held by the MPC server but referenced by no vault.
+	Orphaned []*mpc.KeyInfo
+}
+
+type Service interface {
+	// ListKeys returns the root keys backing the vault, its own keys and those of its wallets.
+	ListKeys(ctx context.Context, vaultID string) ([]*Key, error)
+	GetKey(ctx context.Context, vaultID string, keyID string) (*Key, error)
+	// UpdateKey sets the description and tags of the key, kept by the vault as the MPC server cannot update them.
+	UpdateKey(ctx context.Context, vaultID string, keyID string, userID string, params UpdateParams) (*Key, error)
+	// ListRefreshes returns the refreshes of the shares of the key, newest first.
+	ListRefreshes(ctx context.Context, vaultID string, keyID string) (models.KeyRefreshSlice, error)
+
+	// Run runs approved refreshes, proposes due ones and completes approved retirements in the configured interval until
+	// the context is canceled.
+	Run(ctx context.Context)
+	// RunRefreshes fails refreshes left running, re-shares the keys of approved refreshes through the MPC server and
+	// proposes refreshes for the keys whose shares reached the maximum age.
+	RunRefreshes(ctx context.Context) error
+	// RunRetirements completes the retirements whose completion was approved, deleting the keys from the MPC server once
+	// every balance recorded for their wallets is zero and confirmed by the chains. Retirements of keys still holding
+	// funds are refused, their completion has to be proposed again.
+	RunRetirements(ctx context.Context) error
+
+	// CheckConsistency compares the keys referenced by vault_keys and wallets against the root keys of the MPC server.
+	CheckConsistency(ctx context.Context) (*ConsistencyReport, error)
+}
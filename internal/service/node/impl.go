@@ -0,0 +1,126 @@
+package node
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+)
+
+type impl struct {
+	config       config.Server
+	db           *sql.DB
+	clock        time2.Clock
+	nodeClient   *mpc.NodeClient
+	keyClient    *mpc.KeyClient
+	backupClient *mpc.BackupClient
+}
+
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, nodeClient *mpc.NodeClient, keyClient *mpc.KeyClient, backupClient *mpc.BackupClient) Service {
+	return &impl{
+		config:       config,
+		db:           db,
+		clock:        clock,
+		nodeClient:   nodeClient,
+		keyClient:    keyClient,
+		backupClient: backupClient,
+	}
+}
+
+// keyVaultQuery returns the vault of the key, which is either a key of the vault or of one of its wallets.
+const keyVaultQuery = `
+SELECT vault_id FROM vault_keys WHERE key_id = $1 AND vault_id IS NOT NULL
+UNION
+SELECT vault_id FROM wallets WHERE key_id = $1
+LIMIT 1`
+
+func (s *impl) ListNodes(ctx context.Context, filter mpc.NodeFilter) ([]mpc.Node, error) {
+	nodes, err := s.nodeClient.ListNodes(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MPC nodes: %w", err)
+	}
+
+	return nodes, nil
+}
+
+func (s *impl) Online(node mpc.Node) bool {
+	return node.Online(s.clock.Now(), s.config.Mpc.NodeHeartbeatTimeout)
+}
+
+func (s *impl) GetKeyNodes(ctx context.Context, keyID string) (*KeyNodes, error) {
+	var vault struct {
+		VaultID string `boil:"vault_id"`
+	}
+	if err := queries.Raw(keyVaultQuery, keyID).Bind(ctx, s.db, &vault); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundKey
+		}
+		return nil, fmt.Errorf("failed to find vault of key: %w", err)
+	}
+
+	key, err := s.keyClient.GetKey(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key: %w", err)
+	}
+
+	// The nodes holding a share of the key are those the MPC server keeps backups for.
+	statuses, err := s.backupClient.GetBackupStatus(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nodes of key: %w", err)
+	}
+
+	nodes, err := s.ListNodes(ctx, mpc.NodeFilter{})
+	if err != nil {
+		return nil, err
+	}
+	registered := make(map[string]mpc.Node, len(nodes))
+	for _, n := range nodes {
+		registered[n.ID] = n
+	}
+
+	res := &KeyNodes{
+		KeyID:      keyID,
+		VaultID:    vault.VaultID,
+		Threshold:  key.Threshold,
+		TotalNodes: key.TotalNodes,
+		Nodes:      make([]KeyNode, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		keyNode := KeyNode{NodeID: status.NodeID}
+		if n, ok := registered[status.NodeID]; ok {
+			keyNode.Node = &n
+			keyNode.Online = s.Online(n)
+		}
+		res.Nodes = append(res.Nodes, keyNode)
+	}
+
+	return res, nil
+}
+
+func (s *impl) CheckSigningNodes(ctx context.Context, keyID string) error {
+	log := util.LogFromContext(ctx).With().Str("key_id", keyID).Logger()
+
+	checkCtx, cancel := context.WithTimeout(ctx, s.config.Mpc.NodeCheckTimeout)
+	defer cancel()
+
+	nodes, err := s.GetKeyNodes(checkCtx, keyID)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to check nodes of key are online, skipping check")
+		return nil
+	}
+
+	online := nodes.OnlineCount()
+	if online < nodes.Threshold {
+		log.Warn().Int("online", online).Int("threshold", nodes.Threshold).Msg("Not enough nodes of key online to sign")
+		return httperrors.ErrServiceUnavailableNodesOffline
+	}
+
+	return nil
+}
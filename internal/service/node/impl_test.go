@@ -0,0 +1,89 @@
+package node_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSigningNodes(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20, Threshold: 2, TotalNodes: 3},
+		})
+		require.NoError(t, err)
+		vaultKey, err := models.VaultKeys(models.VaultKeyWhere.VaultID.EQ(null.StringFrom(v.ID))).One(ctx, s.DB)
+		require.NoError(t, err)
+
+		_, err = s.Node.GetKeyNodes(ctx, "unknown")
+		require.ErrorIs(t, err, httperrors.ErrNotFoundKey)
+
+		nodes, err := s.Node.GetKeyNodes(ctx, vaultKey.KeyID)
+		require.NoError(t, err)
+		assert.Equal(t, v.ID, nodes.VaultID)
+		assert.Equal(t, 2, nodes.Threshold)
+		assert.Equal(t, 3, nodes.TotalNodes)
+		require.Len(t, nodes.Nodes, 3)
+		for _, n := range nodes.Nodes {
+			require.NotNil(t, n.Node, n.NodeID)
+			assert.True(t, n.Online, n.NodeID)
+		}
+		require.NoError(t, s.Node.CheckSigningNodes(ctx, vaultKey.KeyID))
+
+		// Keys the registry cannot report on are not checked, signing reports the failure instead.
+		require.NoError(t, s.Node.CheckSigningNodes(ctx, "unknown"))
+
+		// Nodes without recent heartbeat are considered offline.
+		test.SetMockClock(t, s, time.Now().Add(s.Config.Mpc.NodeHeartbeatTimeout+time.Minute))
+		nodes, err = s.Node.GetKeyNodes(ctx, vaultKey.KeyID)
+		require.NoError(t, err)
+		assert.Zero(t, nodes.OnlineCount())
+		err = s.Node.CheckSigningNodes(ctx, vaultKey.KeyID)
+		require.ErrorIs(t, err, httperrors.ErrServiceUnavailableNodesOffline)
+	})
+}
+
+func TestListNodes(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+		nodeID, err := mpc.NewNodeClient(s.MpcConn).RegisterNode(ctx, "device-1", "", "client", "1.0.0", nil)
+		require.NoError(t, err)
+
+		clients, err := s.Node.ListNodes(ctx, mpc.NodeFilter{Type: "client"})
+		require.NoError(t, err)
+		require.Len(t, clients, 1)
+		assert.Equal(t, nodeID, clients[0].ID)
+		assert.True(t, s.Node.Online(clients[0]))
+
+		all, err := s.Node.ListNodes(ctx, mpc.NodeFilter{})
+		require.NoError(t, err)
+		assert.Len(t, all, 4)
+
+		// Client nodes stop being online once their heartbeat is older than the timeout.
+		test.SetMockClock(t, s, clients[0].LastHeartbeat.Add(s.Config.Mpc.NodeHeartbeatTimeout+time.Second))
+		assert.False(t, s.Node.Online(clients[0]))
+	})
+}
@@ -0,0 +1,49 @@
+package node
+
+import (
+	"context"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+)
+
+// KeyNode is a node holding a share of a key along with its registration.
+type KeyNode struct {
+	NodeID string
+	// Node is nil if the node is not registered with the MPC server.
+	Node   *mpc.Node
+	Online bool
+}
+
+// KeyNodes are the nodes holding shares of a key of a vault.
+type KeyNodes struct {
+	KeyID   string
+	VaultID string
+	// Threshold is the number of nodes required to sign with the key.
+	Threshold  int
+	TotalNodes int
+	Nodes      []KeyNode
+}
+
+// OnlineCount returns the number of nodes holding a share of the key that are online.
+func (k *KeyNodes) OnlineCount() int {
+	var count int
+	for _, n := range k.Nodes {
+		if n.Online {
+			count++
+		}
+	}
+	return count
+}
+
+type Service interface {
+	// ListNodes returns the nodes registered with the MPC server matching the filter.
+	ListNodes(ctx context.Context, filter mpc.NodeFilter) ([]mpc.Node, error)
+	// Online reports whether the node is considered online to take part in signing.
+	Online(node mpc.Node) bool
+	// GetKeyNodes returns the nodes holding a share of the key of a vault and whether they are online.
+	GetKeyNodes(ctx context.Context, keyID string) (*KeyNodes, error)
+	// CheckSigningNodes returns an error if fewer nodes holding a share of the key are online than required to sign,
+	// so signing fails fast instead of timing out within the MPC server. The check is skipped if the registry cannot
+	// be queried.
+	CheckSigningNodes(ctx context.Context, keyID string) error
+}
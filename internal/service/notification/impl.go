@@ -0,0 +1,371 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/i18n"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/push"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"golang.org/x/text/language"
+)
+
+// Keys of the data delivered along the notifications, used by the apps to deep link into the signing request.
+const (
+	DataEvent          = "event"
+	DataRequestID      = "request_id"
+	DataVaultID        = "vault_id"
+	DataOrganizationID = "organization_id"
+	DataStatus         = "status"
+	DataAmount         = "amount"
+	DataAsset          = "asset"
+	DataDestination    = "destination"
+	DataKeyIDs         = "key_ids"
+	DataKeyCount       = "key_count"
+)
+
+type impl struct {
+	db   *sql.DB
+	push *push.Service
+	i18n *i18n.Service
+}
+
+func NewService(db *sql.DB, pusher *push.Service, i18nService *i18n.Service) Service {
+	return &impl{
+		db:   db,
+		push: pusher,
+		i18n: i18nService,
+	}
+}
+
+// request holds the signing request to notify about along with the details shown.
+type request struct {
+	model *models.SigningRequest
+	vault *models.Vault
+	data  map[string]string
+}
+
+func (s *impl) NotifyApprovalRequested(ctx context.Context, requestID string) {
+	if s.push.GetProviderCount() == 0 {
+		return
+	}
+	log := util.LogFromContext(ctx).With().Str("request_id", requestID).Logger()
+
+	req, err := s.loadRequest(ctx, requestID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load signing request to notify approvers")
+		return
+	}
+	if req == nil {
+		return
+	}
+
+	approvers, err := vault.EligibleApprovers(ctx, s.db, req.vault)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list approvers to notify")
+		return
+	}
+	delete(approvers, req.model.InitiatorID.String)
+
+	recipients, err := s.receiving(ctx, req.vault.OrganizationID.String, approvers, EventApprovalRequested)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load notification preferences")
+		return
+	}
+
+	data := req.withEvent(EventApprovalRequested)
+	bodyKey := "push.approval_requested.body"
+	if data[DataAmount] == "" {
+		bodyKey = "push.approval_requested.body_without_amount"
+	}
+
+	for userID, receives := range recipients {
+		if !receives {
+			continue
+		}
+		s.send(ctx, userID, "push.approval_requested.title", bodyKey, data, true)
+	}
+}
+
+func (s *impl) NotifyRequestResolved(ctx context.Context, requestID string) {
+	if s.push.GetProviderCount() == 0 {
+		return
+	}
+	log := util.LogFromContext(ctx).With().Str("request_id", requestID).Logger()
+
+	req, err := s.loadRequest(ctx, requestID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load signing request to send follow-up notifications")
+		return
+	}
+	if req == nil {
+		return
+	}
+
+	event := EventRequestApproved
+	switch req.model.Status.String {
+	case "rejected":
+		event = EventRequestRejected
+	case "cancelled":
+		event = EventRequestCancelled
+	}
+
+	users, err := vault.EligibleApprovers(ctx, s.db, req.vault)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list approvers to send follow-up notifications")
+		return
+	}
+	if req.model.InitiatorID.Valid {
+		users[req.model.InitiatorID.String] = struct{}{}
+	}
+
+	recipients, err := s.receiving(ctx, req.vault.OrganizationID.String, users, event)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load notification preferences")
+		return
+	}
+
+	data := req.withEvent(event)
+	for userID, receives := range recipients {
+		if !receives {
+			s.send(ctx, userID, "", "", data, false)
+			continue
+		}
+		s.send(ctx, userID, "push."+event+".title", "push."+event+".body", data, false)
+	}
+}
+
+func (s *impl) NotifyBackupDegraded(ctx context.Context, orgID string, keyIDs []string) {
+	if s.push.GetProviderCount() == 0 || len(keyIDs) == 0 {
+		return
+	}
+	log := util.LogFromContext(ctx).With().Str("organization_id", orgID).Logger()
+
+	org, err := models.FindOrganization(ctx, s.db, orgID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load organization to alert about degraded backups")
+		return
+	}
+	admins, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(orgID),
+		models.OrganizationMemberWhere.Role.IN([]string{organization.RoleOwner, organization.RoleAdmin}),
+	).All(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list admins to alert about degraded backups")
+		return
+	}
+	users := map[string]struct{}{org.OwnerID: {}}
+	for _, m := range admins {
+		users[m.UserID] = struct{}{}
+	}
+
+	recipients, err := s.receiving(ctx, orgID, users, EventBackupDegraded)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load notification preferences")
+		return
+	}
+
+	data := map[string]string{
+		DataEvent:          EventBackupDegraded,
+		DataOrganizationID: orgID,
+		DataKeyIDs:         strings.Join(keyIDs, ","),
+		DataKeyCount:       strconv.Itoa(len(keyIDs)),
+	}
+	for userID, receives := range recipients {
+		if !receives {
+			continue
+		}
+		s.send(ctx, userID, "push.backup_degraded.title", "push.backup_degraded.body", data, true)
+	}
+}
+
+func (s *impl) GetPreferences(ctx context.Context, orgID string, userID string) ([]string, error) {
+	preference, err := models.FindNotificationPreference(ctx, s.db, userID, orgID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Events, nil
+		}
+		return nil, fmt.Errorf("failed to find notification preference: %w", err)
+	}
+
+	return preference.Events, nil
+}
+
+func (s *impl) UpdatePreferences(ctx context.Context, orgID string, userID string, events []string) ([]string, error) {
+	// Events are stored in the order they are defined in, ignoring duplicates.
+	selected := make([]string, 0, len(events))
+	for _, event := range Events {
+		for _, e := range events {
+			if e == event {
+				selected = append(selected, event)
+				break
+			}
+		}
+	}
+
+	preference := &models.NotificationPreference{
+		UserID:         userID,
+		OrganizationID: orgID,
+		Events:         selected,
+	}
+	if err := preference.Upsert(ctx, s.db, true,
+		[]string{models.NotificationPreferenceColumns.UserID, models.NotificationPreferenceColumns.OrganizationID},
+		boil.Whitelist(models.NotificationPreferenceColumns.Events, models.NotificationPreferenceColumns.UpdatedAt),
+		boil.Infer(),
+	); err != nil {
+		return nil, fmt.Errorf("failed to upsert notification preference: %w", err)
+	}
+
+	return selected, nil
+}
+
+// loadRequest returns the signing request with the details shown, or nil if its vault does not belong to an
+// organization.
+func (s *impl) loadRequest(ctx context.Context, requestID string) (*request, error) {
+	req, err := models.SigningRequests(
+		models.SigningRequestWhere.ID.EQ(requestID),
+		qm.Load(models.SigningRequestRels.Vault),
+		qm.Load(qm.Rels(models.SigningRequestRels.Wallet, models.WalletRels.Chain)),
+	).One(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find signing request: %w", err)
+	}
+	v := req.R.GetVault()
+	if v == nil || !v.OrganizationID.Valid {
+		return nil, nil
+	}
+
+	data := map[string]string{
+		DataRequestID:      req.ID,
+		DataVaultID:        v.ID,
+		DataOrganizationID: v.OrganizationID.String,
+		DataStatus:         req.Status.String,
+		DataDestination:    req.ToAddress.String,
+	}
+	if req.Amount.Big != nil {
+		data[DataAmount] = req.Amount.Big.String()
+	}
+	if wallet := req.R.GetWallet(); wallet != nil && wallet.R.GetChain() != nil {
+		data[DataAsset] = wallet.R.GetChain().CurrencySymbol
+	}
+
+	return &request{
+		model: req,
+		vault: v,
+		data:  data,
+	}, nil
+}
+
+func (r *request) withEvent(event string) map[string]string {
+	data := make(map[string]string, len(r.data)+1)
+	for key, value := range r.data {
+		data[key] = value
+	}
+	data[DataEvent] = event
+	return data
+}
+
+// receiving reports for each of the users whether they receive the event for the organization.
+func (s *impl) receiving(ctx context.Context, orgID string, users map[string]struct{}, event string) (map[string]bool, error) {
+	ids := make([]string, 0, len(users))
+	receives := make(map[string]bool, len(users))
+	for id := range users {
+		ids = append(ids, id)
+		receives[id] = true
+	}
+
+	preferences, err := models.NotificationPreferences(
+		models.NotificationPreferenceWhere.OrganizationID.EQ(orgID),
+		models.NotificationPreferenceWhere.UserID.IN(ids),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	for _, preference := range preferences {
+		receives[preference.UserID] = contains(preference.Events, event)
+	}
+
+	return receives, nil
+}
+
+// send notifies the user localized to the language of each device, with the badge set to the number of signing
+// requests awaiting the user. Without title key the badge is updated silently.
+func (s *impl) send(ctx context.Context, userID string, titleKey string, bodyKey string, data map[string]string, timeSensitive bool) {
+	log := util.LogFromContext(ctx).With().Str("user_id", userID).Logger()
+
+	badge, err := s.pendingCount(ctx, userID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to count signing requests awaiting user")
+		return
+	}
+
+	translations := i18n.Data{
+		"Amount":      data[DataAmount],
+		"Asset":       data[DataAsset],
+		"Destination": data[DataDestination],
+		"KeyCount":    data[DataKeyCount],
+	}
+	if err := s.push.SendLocalizedToUser(ctx, userID, func(lang string) push.Message {
+		msg := push.Message{
+			Data:          data,
+			Badge:         &badge,
+			TimeSensitive: timeSensitive,
+		}
+		if titleKey != "" {
+			tag := s.language(lang)
+			msg.Title = s.i18n.Translate(titleKey, tag, translations)
+			msg.Body = s.i18n.Translate(bodyKey, tag, translations)
+		}
+		return msg
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to send push notification")
+	}
+}
+
+// pendingCount returns the number of pending signing requests of other users the user is eligible to vote on but did
+// not vote on yet. Requests reaching the threshold stay pending while being signed, they await no further votes.
+func (s *impl) pendingCount(ctx context.Context, userID string) (int, error) {
+	count, err := models.SigningRequests(
+		qm.InnerJoin("vaults on vaults.id = signing_requests.vault_id"),
+		qm.Where(`vaults.organization_id IN (
+			SELECT id FROM organizations WHERE owner_id = ?
+			UNION
+			SELECT organization_id FROM organization_members WHERE user_id = ? AND role <> ?)`, userID, userID, organization.RoleAuditor),
+		models.SigningRequestWhere.Status.EQ(null.StringFrom("pending")),
+		qm.Where("signing_requests.initiator_id IS DISTINCT FROM ?", userID),
+		qm.Where("NOT EXISTS (SELECT 1 FROM approvals WHERE approvals.request_id = signing_requests.id AND approvals.user_id = ?)", userID),
+		qm.Where("(SELECT COUNT(*) FROM approvals WHERE approvals.request_id = signing_requests.id AND approvals.action = 'approve') < vaults.threshold"),
+	).Count(ctx, s.db)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// language matches the language the device registered, falling back to the default language.
+func (s *impl) language(lang string) language.Tag {
+	if lang == "" {
+		return s.i18n.Tags()[0]
+	}
+	return s.i18n.ParseLang(lang)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package notification_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/push"
+	"github.com/kashguard/go-mpc-vault/internal/service/notification"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingProvider records the messages sent by token.
+type recordingProvider struct {
+	mu   sync.Mutex
+	sent map[string][]push.Message
+}
+
+func (p *recordingProvider) GetProviderType() push.ProviderType {
+	return push.ProviderTypeFCM
+}
+
+func (p *recordingProvider) Send(token string, message push.Message) push.ProviderSendResponse {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sent[token] = append(p.sent[token], message)
+	return push.ProviderSendResponse{Token: token, Valid: true}
+}
+
+func (p *recordingProvider) SendMulticast(tokens []string, message push.Message) []push.ProviderSendResponse {
+	res := make([]push.ProviderSendResponse, 0, len(tokens))
+	for _, token := range tokens {
+		res = append(res, p.Send(token, message))
+	}
+	return res
+}
+
+// take returns and forgets the messages sent to the token.
+func (p *recordingProvider) take(token string) []push.Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sent := p.sent[token]
+	delete(p.sent, token)
+	return sent
+}
+
+func TestNotificationPreferences(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		provider := &recordingProvider{sent: map[string][]push.Message{}}
+		s.Push.ResetProviders()
+		s.Push.RegisterProvider(provider)
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+		require.NoError(t, err)
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+
+		user2Token := &models.PushToken{
+			ID:       uuid.NewString(),
+			Token:    strings.Repeat("2", 40),
+			Provider: string(push.ProviderTypeFCM),
+			UserID:   fix.User2.ID,
+		}
+		require.NoError(t, user2Token.Insert(ctx, s.DB, boil.Infer()))
+
+		req := &models.SigningRequest{
+			ID:          uuid.NewString(),
+			VaultID:     null.StringFrom(v.ID),
+			InitiatorID: null.StringFrom(fix.User1.ID),
+			TXData:      "0x",
+			Status:      null.StringFrom("pending"),
+		}
+		require.NoError(t, req.Insert(ctx, s.DB, boil.Infer()))
+
+		// Users without preferences receive every event.
+		s.Notification.NotifyApprovalRequested(ctx, req.ID)
+		sent := provider.take(user2Token.Token)
+		require.Len(t, sent, 1)
+		assert.Equal(t, notification.EventApprovalRequested, sent[0].Data[notification.DataEvent])
+		assert.NotEmpty(t, sent[0].Title)
+		require.NotNil(t, sent[0].Badge)
+		assert.Equal(t, 1, *sent[0].Badge)
+
+		// Events the user opted out of are not delivered.
+		events, err := s.Notification.UpdatePreferences(ctx, org.ID, fix.User2.ID, []string{notification.EventRequestRejected})
+		require.NoError(t, err)
+		assert.Equal(t, []string{notification.EventRequestRejected}, events)
+		s.Notification.NotifyApprovalRequested(ctx, req.ID)
+		assert.Empty(t, provider.take(user2Token.Token))
+
+		// The resolution is not announced either, but the badge is updated silently. The request reached the threshold
+		// and awaits no vote of the user, although it is still pending while being signed.
+		approval := &models.Approval{
+			ID:        uuid.NewString(),
+			RequestID: null.StringFrom(req.ID),
+			UserID:    null.StringFrom(fix.User1.ID),
+			Action:    "approve",
+		}
+		require.NoError(t, approval.Insert(ctx, s.DB, boil.Infer()))
+		s.Notification.NotifyRequestResolved(ctx, req.ID)
+		sent = provider.take(user2Token.Token)
+		require.Len(t, sent, 1)
+		assert.Empty(t, sent[0].Title)
+		require.NotNil(t, sent[0].Badge)
+		assert.Equal(t, 0, *sent[0].Badge)
+
+		// Requests cancelled as their quorum can no longer be reached are announced as such.
+		_, err = s.Notification.UpdatePreferences(ctx, org.ID, fix.User2.ID, notification.Events)
+		require.NoError(t, err)
+		req.Status = null.StringFrom("cancelled")
+		_, err = req.Update(ctx, s.DB, boil.Whitelist(models.SigningRequestColumns.Status))
+		require.NoError(t, err)
+		s.Notification.NotifyRequestResolved(ctx, req.ID)
+		sent = provider.take(user2Token.Token)
+		require.Len(t, sent, 1)
+		assert.Equal(t, notification.EventRequestCancelled, sent[0].Data[notification.DataEvent])
+		assert.NotEmpty(t, sent[0].Title)
+
+		// Preferences apply to their organization only.
+		other, err := s.Organization.CreateOrganization(ctx, "Other", fix.User1.ID)
+		require.NoError(t, err)
+		events, err = s.Notification.GetPreferences(ctx, other.ID, fix.User2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, notification.Events, events)
+	})
+}
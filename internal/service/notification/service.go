@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+)
+
+// Events users may receive notifications for, selectable per organization.
+const (
+	// EventApprovalRequested is sent to the eligible approvers of a vault once a signing request awaits them.
+	EventApprovalRequested = "approval_requested"
+	// EventRequestApproved is sent once a signing request reached the quorum of its vault.
+	EventRequestApproved = "request_approved"
+	// EventRequestRejected is sent once a signing request was rejected.
+	EventRequestRejected = "request_rejected"
+	// EventRequestCancelled is sent once a signing request was cancelled as its quorum could no longer be reached.
+	EventRequestCancelled = "request_cancelled"
+	// EventBackupDegraded is sent to the owner and admins of an organization once the share of a key can no longer be
+	// recovered from its backup shares.
+	EventBackupDegraded = "backup_degraded"
+)
+
+// Events are all events users receive unless they chose otherwise.
+var Events = []string{
+	EventApprovalRequested,
+	EventRequestApproved,
+	EventRequestRejected,
+	EventRequestCancelled,
+	EventBackupDegraded,
+}
+
+type Service interface {
+	// NotifyApprovalRequested informs the eligible approvers of the vault, except its initiator, about the pending
+	// signing request. Failures are logged only.
+	NotifyApprovalRequested(ctx context.Context, requestID string)
+	// NotifyRequestResolved informs the eligible approvers and the initiator that the signing request reached its
+	// quorum, was rejected or cancelled, updating the badge of their devices. Users not receiving the event get their badge
+	// updated silently. Failures are logged only.
+	NotifyRequestResolved(ctx context.Context, requestID string)
+	// NotifyBackupDegraded alerts the owner and admins of the organization that the keys are at risk as their backup
+	// shares no longer suffice to recover them. Failures are logged only.
+	NotifyBackupDegraded(ctx context.Context, orgID string, keyIDs []string)
+	// GetPreferences returns the events the user receives for the organization.
+	GetPreferences(ctx context.Context, orgID string, userID string) ([]string, error)
+	// UpdatePreferences replaces the events the user receives for the organization.
+	UpdatePreferences(ctx context.Context, orgID string, userID string, events []string) ([]string, error)
+}
@@ -5,20 +5,42 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
 	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+	"github.com/labstack/echo/v4"
 )
 
+// RequestNotifier informs the approvers of signing requests cancelled by membership changes. It is implemented by
+// the notification service, which depends on this package.
+type RequestNotifier interface {
+	NotifyRequestResolved(ctx context.Context, requestID string)
+}
+
 type impl struct {
-	db *sql.DB
+	config   config.Server
+	db       *sql.DB
+	clock    time2.Clock
+	passkeys auth.AssertionVerifier
+	notifier RequestNotifier
 }
 
-func NewService(db *sql.DB) Service {
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, passkeys auth.AssertionVerifier, notifier RequestNotifier) Service {
 	return &impl{
-		db: db,
+		config:   config,
+		db:       db,
+		clock:    clock,
+		passkeys: passkeys,
+		notifier: notifier,
 	}
 }
 
@@ -28,8 +50,34 @@ func (s *impl) CreateOrganization(ctx context.Context, name string, ownerID stri
 		OwnerID: ownerID,
 	}
 
-	if err := org.Insert(ctx, s.db, boil.Infer()); err != nil {
-		return nil, fmt.Errorf("insert organization: %w", err)
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := org.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("insert organization: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: org.ID,
+			UserID:         ownerID,
+			Action:         audit.ActionCreateOrganization,
+			ResourceType:   audit.ResourceTypeOrganization,
+			ResourceID:     org.ID,
+			Details: map[string]interface{}{
+				"name": name,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+func (s *impl) GetOrganization(ctx context.Context, orgID string) (*models.Organization, error) {
+	org, err := models.FindOrganization(ctx, s.db, orgID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, echo.ErrNotFound
+		}
+		return nil, fmt.Errorf("find organization: %w", err)
 	}
 	return org, nil
 }
@@ -51,13 +99,20 @@ func (s *impl) ListUserOrganizations(ctx context.Context, userID string) (models
 	}
 	var memberOrgs models.OrganizationSlice
 	if len(ids) > 0 {
-		memberOrgs, err = models.Organizations(qm.WhereIn(models.OrganizationColumns.ID, ids)).All(ctx, s.db)
+		memberOrgs, err = models.Organizations(models.OrganizationWhere.ID.IN(ids)).All(ctx, s.db)
 		if err != nil {
 			return nil, fmt.Errorf("list member organizations: %w", err)
 		}
 	}
 
-	return append(owned, memberOrgs...), nil
+	orgs := owned
+	for _, o := range memberOrgs {
+		if o.OwnerID != userID {
+			orgs = append(orgs, o)
+		}
+	}
+
+	return orgs, nil
 }
 
 func (s *impl) ListMembers(ctx context.Context, orgID string) (models.OrganizationMemberSlice, error) {
@@ -68,7 +123,7 @@ func (s *impl) ListMembers(ctx context.Context, orgID string) (models.Organizati
 	return members, nil
 }
 
-func (s *impl) AddMember(ctx context.Context, orgID string, userID string, role string) (*models.OrganizationMember, error) {
+func (s *impl) AddMember(ctx context.Context, orgID string, userID string, role string, actorID string) (*models.OrganizationMember, error) {
 	_, err := models.FindOrganization(ctx, s.db, orgID)
 	if err != nil {
 		return nil, fmt.Errorf("organization not found: %w", err)
@@ -80,36 +135,251 @@ func (s *impl) AddMember(ctx context.Context, orgID string, userID string, role
 		Role:           role,
 		CreatedAt:      null.Time{},
 	}
-	if err := member.Insert(ctx, s.db, boil.Infer()); err != nil {
-		return nil, fmt.Errorf("insert member: %w", err)
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := member.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("insert member: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         actorID,
+			Action:         audit.ActionAddMember,
+			ResourceType:   audit.ResourceTypeUser,
+			ResourceID:     userID,
+			Details: map[string]interface{}{
+				"role": role,
+			},
+		})
+	}); err != nil {
+		return nil, err
 	}
 	return member, nil
 }
 
-func (s *impl) RemoveMember(ctx context.Context, orgID string, userID string) error {
-	member, err := models.FindOrganizationMember(ctx, s.db, orgID, userID)
+func (s *impl) RemoveMember(ctx context.Context, orgID string, userID string, actorID string) error {
+	var cancelled []string
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		org, err := models.Organizations(
+			models.OrganizationWhere.ID.EQ(orgID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("find organization: %w", err)
+		}
+		if org.OwnerID == userID {
+			return httperrors.ErrConflictOwnerMembershipImmutable
+		}
+
+		member, err := models.FindOrganizationMember(ctx, exec, orgID, userID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("find member: %w", err)
+		}
+		if member.Role == RoleAdmin {
+			if err := ensureAdminRemains(ctx, exec, org, userID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := member.Delete(ctx, exec); err != nil {
+			return fmt.Errorf("delete member: %w", err)
+		}
+
+		cancelled, err = revokeApproverStatus(ctx, exec, org, userID)
+		if err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         actorID,
+			Action:         audit.ActionRemoveMember,
+			ResourceType:   audit.ResourceTypeUser,
+			ResourceID:     userID,
+			Details: map[string]interface{}{
+				"role": member.Role,
+			},
+		})
+	}); err != nil {
+		return err
+	}
+
+	s.notifyCancelled(ctx, cancelled)
+	return nil
+}
+
+func (s *impl) UpdateMemberRole(ctx context.Context, orgID string, userID string, role string, actorID string) error {
+	var cancelled []string
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		org, err := models.Organizations(
+			models.OrganizationWhere.ID.EQ(orgID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("find organization: %w", err)
+		}
+		if org.OwnerID == userID {
+			return httperrors.ErrConflictOwnerMembershipImmutable
+		}
+
+		m, err := models.FindOrganizationMember(ctx, exec, orgID, userID)
+		if err != nil {
+			return fmt.Errorf("find member: %w", err)
+		}
+		if m.Role == RoleAdmin && role != RoleAdmin {
+			if err := ensureAdminRemains(ctx, exec, org, userID); err != nil {
+				return err
+			}
+		}
+
+		previousRole := m.Role
+		m.Role = role
+		if _, err := m.Update(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("update member: %w", err)
+		}
+
+		// Auditors do not take part in quorums, demoting to one is equal to leaving for pending approvals.
+		if previousRole != RoleAuditor && role == RoleAuditor {
+			cancelled, err = revokeApproverStatus(ctx, exec, org, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         actorID,
+			Action:         audit.ActionChangeMemberRole,
+			ResourceType:   audit.ResourceTypeUser,
+			ResourceID:     userID,
+			Details: map[string]interface{}{
+				"previous_role": previousRole,
+				"role":          role,
+			},
+		})
+	}); err != nil {
+		return err
+	}
+
+	s.notifyCancelled(ctx, cancelled)
+	return nil
+}
+
+// notifyCancelled informs the approvers of the signing requests cancelled as they could no longer reach their quorum.
+func (s *impl) notifyCancelled(ctx context.Context, requestIDs []string) {
+	for _, requestID := range requestIDs {
+		s.notifier.NotifyRequestResolved(ctx, requestID)
+	}
+}
+
+func (s *impl) MemberRole(ctx context.Context, orgID string, userID string) (string, error) {
+	return MemberRole(ctx, s.db, orgID, userID)
+}
+
+func (s *impl) RequireManager(ctx context.Context, orgID string, userID string) error {
+	role, err := MemberRole(ctx, s.db, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if role != RoleOwner && role != RoleAdmin {
+		return httperrors.ErrForbiddenInsufficientRole
+	}
+	return nil
+}
+
+func (s *impl) GetDefaultOrganization(ctx context.Context, userID string) (string, error) {
+	profile, err := models.FindAppUserProfile(ctx, s.db, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil
+			return "", nil
 		}
-		return fmt.Errorf("find member: %w", err)
+		return "", fmt.Errorf("find app user profile: %w", err)
 	}
-	_, err = member.Delete(ctx, s.db)
+	return profile.DefaultOrganizationID.String, nil
+}
+
+func (s *impl) SetDefaultOrganization(ctx context.Context, userID string, orgID string) error {
+	if _, err := MemberRole(ctx, s.db, orgID, userID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	profile, err := models.FindAppUserProfile(ctx, s.db, userID)
 	if err != nil {
-		return fmt.Errorf("delete member: %w", err)
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("find app user profile: %w", err)
+		}
+		profile = &models.AppUserProfile{
+			UserID:                userID,
+			DefaultOrganizationID: null.StringFrom(orgID),
+			CreatedAt:             now,
+			UpdatedAt:             now,
+		}
+		if err := profile.Insert(ctx, s.db, boil.Infer()); err != nil {
+			return fmt.Errorf("insert app user profile: %w", err)
+		}
+		return nil
+	}
+
+	profile.DefaultOrganizationID = null.StringFrom(orgID)
+	profile.UpdatedAt = now
+	if _, err := profile.Update(ctx, s.db, boil.Whitelist(models.AppUserProfileColumns.DefaultOrganizationID, models.AppUserProfileColumns.UpdatedAt)); err != nil {
+		return fmt.Errorf("update app user profile: %w", err)
 	}
 	return nil
 }
 
-func (s *impl) UpdateMemberRole(ctx context.Context, orgID string, userID string, role string) error {
-	m, err := models.FindOrganizationMember(ctx, s.db, orgID, userID)
+func (s *impl) ResolveOrganization(ctx context.Context, userID string, orgID string) (string, error) {
+	if orgID != "" {
+		if _, err := MemberRole(ctx, s.db, orgID, userID); err != nil {
+			return "", err
+		}
+		return orgID, nil
+	}
+
+	defaultOrgID, err := s.GetDefaultOrganization(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("find member: %w", err)
+		return "", err
 	}
-	m.Role = role
-	_, err = m.Update(ctx, s.db, boil.Infer())
+	if defaultOrgID != "" {
+		// The preference is kept even if the user left the organization, ignore it in that case.
+		if _, err := MemberRole(ctx, s.db, defaultOrgID, userID); err == nil {
+			return defaultOrgID, nil
+		}
+	}
+
+	orgs, err := s.ListUserOrganizations(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("update member: %w", err)
+		return "", err
 	}
-	return nil
+	if len(orgs) != 1 {
+		return "", httperrors.ErrBadRequestOrganizationRequired
+	}
+	return orgs[0].ID, nil
+}
+
+// MemberRole returns the role of the user within the organization using the given executor, so it
+// can be used inside transactions of other services as well.
+func MemberRole(ctx context.Context, exec boil.ContextExecutor, orgID string, userID string) (string, error) {
+	org, err := models.FindOrganization(ctx, exec, orgID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", httperrors.ErrForbiddenNotOrganizationMember
+		}
+		return "", fmt.Errorf("find organization: %w", err)
+	}
+	if org.OwnerID == userID {
+		return RoleOwner, nil
+	}
+
+	member, err := models.FindOrganizationMember(ctx, exec, orgID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", httperrors.ErrForbiddenNotOrganizationMember
+		}
+		return "", fmt.Errorf("find member: %w", err)
+	}
+	return member.Role, nil
 }
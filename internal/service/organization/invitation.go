@@ -0,0 +1,242 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+	"github.com/kashguard/go-mpc-vault/internal/util/hashing"
+)
+
+func (s *impl) CreateInvitation(ctx context.Context, orgID string, invitedBy string, email string, role string) (*models.OrganizationInvitation, error) {
+	log := util.LogFromContext(ctx).With().Str("organization_id", orgID).Logger()
+
+	user, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(email))).One(ctx, s.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("find invited user: %w", err)
+	}
+	if user != nil {
+		if _, err := MemberRole(ctx, s.db, orgID, user.ID); err == nil {
+			return nil, httperrors.ErrConflictAlreadyOrganizationMember
+		} else if !errors.Is(err, httperrors.ErrForbiddenNotOrganizationMember) {
+			return nil, err
+		}
+	}
+
+	invitation := &models.OrganizationInvitation{
+		OrganizationID: orgID,
+		Email:          email,
+		Role:           role,
+		Status:         InvitationStatusPending,
+		InvitedBy:      null.StringFrom(invitedBy),
+		ValidUntil:     s.clock.Now().Add(s.config.Auth.InvitationTokenValidity),
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		// Re-inviting replaces the pending invitation, invalidating the previously sent link.
+		if _, err := models.OrganizationInvitations(
+			models.OrganizationInvitationWhere.OrganizationID.EQ(orgID),
+			models.OrganizationInvitationWhere.Email.EQ(email),
+			models.OrganizationInvitationWhere.Status.EQ(InvitationStatusPending),
+		).UpdateAll(ctx, exec, models.M{
+			models.OrganizationInvitationColumns.Status:    InvitationStatusRevoked,
+			models.OrganizationInvitationColumns.UpdatedAt: s.clock.Now(),
+		}); err != nil {
+			return fmt.Errorf("revoke pending invitations: %w", err)
+		}
+
+		if err := invitation.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("insert invitation: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         invitedBy,
+			Action:         audit.ActionCreateInvitation,
+			ResourceType:   audit.ResourceTypeInvitation,
+			ResourceID:     invitation.ID,
+			Details: map[string]interface{}{
+				"email": email,
+				"role":  role,
+			},
+		})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to create invitation")
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (s *impl) ListPendingInvitations(ctx context.Context, orgID string) (models.OrganizationInvitationSlice, error) {
+	invitations, err := models.OrganizationInvitations(
+		models.OrganizationInvitationWhere.OrganizationID.EQ(orgID),
+		models.OrganizationInvitationWhere.Status.EQ(InvitationStatusPending),
+		models.OrganizationInvitationWhere.ValidUntil.GT(s.clock.Now()),
+		qm.OrderBy(models.OrganizationInvitationColumns.CreatedAt+" DESC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("list invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+func (s *impl) RevokeInvitation(ctx context.Context, orgID string, invitationID string, actorID string) error {
+	invitation, err := models.OrganizationInvitations(
+		models.OrganizationInvitationWhere.ID.EQ(invitationID),
+		models.OrganizationInvitationWhere.OrganizationID.EQ(orgID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return httperrors.ErrNotFoundInvitation
+		}
+		return fmt.Errorf("find invitation: %w", err)
+	}
+	if invitation.Status != InvitationStatusPending {
+		return httperrors.ErrConflictInvitationNotPending
+	}
+
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		invitation.Status = InvitationStatusRevoked
+		invitation.UpdatedAt = s.clock.Now()
+		if _, err := invitation.Update(ctx, exec, boil.Whitelist(models.OrganizationInvitationColumns.Status, models.OrganizationInvitationColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("update invitation: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         actorID,
+			Action:         audit.ActionRevokeInvitation,
+			ResourceType:   audit.ResourceTypeInvitation,
+			ResourceID:     invitationID,
+			Details: map[string]interface{}{
+				"email": invitation.Email,
+			},
+		})
+	})
+}
+
+func (s *impl) AcceptInvitation(ctx context.Context, token string, password string) (AcceptInvitationResult, error) {
+	log := util.LogFromContext(ctx)
+
+	var result AcceptInvitationResult
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		invitation, err := models.OrganizationInvitations(
+			models.OrganizationInvitationWhere.Token.EQ(token),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrNotFoundInvitation
+			}
+			return fmt.Errorf("find invitation: %w", err)
+		}
+		if invitation.Status != InvitationStatusPending {
+			return httperrors.ErrConflictInvitationNotPending
+		}
+		if !invitation.ValidUntil.After(s.clock.Now()) {
+			return httperrors.ErrConflictInvitationExpired
+		}
+
+		user, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(invitation.Email))).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("find invited user: %w", err)
+		}
+
+		if user == nil {
+			user, err = s.registerInvitedUser(ctx, exec, invitation, password)
+			if err != nil {
+				return err
+			}
+			result.Registered = true
+		} else {
+			if !user.IsActive && !user.RequiresConfirmation {
+				return httperrors.ErrForbiddenUserDeactivated
+			}
+			if _, err := MemberRole(ctx, exec, invitation.OrganizationID, user.ID); err == nil {
+				return httperrors.ErrConflictAlreadyOrganizationMember
+			} else if !errors.Is(err, httperrors.ErrForbiddenNotOrganizationMember) {
+				return err
+			}
+		}
+
+		member := &models.OrganizationMember{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         user.ID,
+			Role:           invitation.Role,
+			CreatedAt:      null.TimeFrom(s.clock.Now()),
+		}
+		if err := member.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("insert member: %w", err)
+		}
+
+		invitation.Status = InvitationStatusAccepted
+		invitation.AcceptedBy = null.StringFrom(user.ID)
+		invitation.AcceptedAt = null.TimeFrom(s.clock.Now())
+		invitation.UpdatedAt = s.clock.Now()
+		if _, err := invitation.Update(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("update invitation: %w", err)
+		}
+
+		result.Member = member
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         user.ID,
+			Action:         audit.ActionAcceptInvitation,
+			ResourceType:   audit.ResourceTypeInvitation,
+			ResourceID:     invitation.ID,
+			Details: map[string]interface{}{
+				"role":       invitation.Role,
+				"registered": result.Registered,
+			},
+		})
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to accept invitation")
+		return AcceptInvitationResult{}, err
+	}
+
+	return result, nil
+}
+
+// registerInvitedUser registers a user for the email of the invitation. As the invitation token
+// was delivered to that email, the account does not require another confirmation.
+func (s *impl) registerInvitedUser(ctx context.Context, exec boil.ContextExecutor, invitation *models.OrganizationInvitation, password string) (*models.User, error) {
+	if password == "" {
+		return nil, httperrors.ErrBadRequestRegistrationPassword
+	}
+
+	hash, err := hashing.HashPassword(password, hashing.DefaultArgon2Params)
+	if err != nil {
+		return nil, httperrors.ErrBadRequestInvalidPassword
+	}
+
+	user := &models.User{
+		Username: null.StringFrom(invitation.Email),
+		Password: null.StringFrom(hash),
+		IsActive: true,
+		Scopes:   s.config.Auth.DefaultUserScopes,
+	}
+	if err := user.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("insert user: %w", err)
+	}
+
+	appUserProfile := models.AppUserProfile{
+		UserID:                user.ID,
+		DefaultOrganizationID: null.StringFrom(invitation.OrganizationID),
+	}
+	if err := appUserProfile.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("insert app user profile: %w", err)
+	}
+
+	return user, nil
+}
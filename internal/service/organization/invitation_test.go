@@ -0,0 +1,136 @@
+package organization_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptInvitation(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+
+		_, err = s.Organization.CreateInvitation(ctx, org.ID, fix.User1.ID, fix.User1.Username.String, organization.RoleAdmin)
+		require.ErrorIs(t, err, httperrors.ErrConflictAlreadyOrganizationMember)
+
+		// Re-inviting replaces the pending invitation, the link sent first no longer works.
+		replaced, err := s.Organization.CreateInvitation(ctx, org.ID, fix.User1.ID, "new@example.com", organization.RoleAuditor)
+		require.NoError(t, err)
+		invitation, err := s.Organization.CreateInvitation(ctx, org.ID, fix.User1.ID, "new@example.com", organization.RoleOperator)
+		require.NoError(t, err)
+
+		pending, err := s.Organization.ListPendingInvitations(ctx, org.ID)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, invitation.ID, pending[0].ID)
+
+		_, err = s.Organization.AcceptInvitation(ctx, replaced.Token, "password")
+		require.ErrorIs(t, err, httperrors.ErrConflictInvitationNotPending)
+		_, err = s.Organization.AcceptInvitation(ctx, uuid.NewString(), "password")
+		require.ErrorIs(t, err, httperrors.ErrNotFoundInvitation)
+
+		// Emails without account are registered along with accepting, which requires a password.
+		_, err = s.Organization.AcceptInvitation(ctx, invitation.Token, "")
+		require.ErrorIs(t, err, httperrors.ErrBadRequestRegistrationPassword)
+
+		result, err := s.Organization.AcceptInvitation(ctx, invitation.Token, "correct horse battery staple")
+		require.NoError(t, err)
+		assert.True(t, result.Registered)
+		assert.Equal(t, organization.RoleOperator, result.Member.Role)
+
+		user, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom("new@example.com"))).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, user.IsActive)
+		assert.Equal(t, user.ID, result.Member.UserID)
+		role, err := organization.MemberRole(ctx, s.DB, org.ID, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, organization.RoleOperator, role)
+
+		_, err = s.Organization.AcceptInvitation(ctx, invitation.Token, "correct horse battery staple")
+		require.ErrorIs(t, err, httperrors.ErrConflictInvitationNotPending)
+
+		// Existing users join without registering.
+		invitation, err = s.Organization.CreateInvitation(ctx, org.ID, fix.User1.ID, fix.User2.Username.String, organization.RoleAdmin)
+		require.NoError(t, err)
+		result, err = s.Organization.AcceptInvitation(ctx, invitation.Token, "")
+		require.NoError(t, err)
+		assert.False(t, result.Registered)
+		assert.Equal(t, fix.User2.ID, result.Member.UserID)
+		role, err = organization.MemberRole(ctx, s.DB, org.ID, fix.User2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, organization.RoleAdmin, role)
+
+		pending, err = s.Organization.ListPendingInvitations(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+}
+
+func TestAcceptExpiredInvitation(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		invitation, err := s.Organization.CreateInvitation(ctx, org.ID, fix.User1.ID, fix.User2.Username.String, organization.RoleOperator)
+		require.NoError(t, err)
+
+		test.SetMockClock(t, s, invitation.ValidUntil.Add(time.Second))
+
+		pending, err := s.Organization.ListPendingInvitations(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		_, err = s.Organization.AcceptInvitation(ctx, invitation.Token, "")
+		require.ErrorIs(t, err, httperrors.ErrConflictInvitationExpired)
+		_, err = organization.MemberRole(ctx, s.DB, org.ID, fix.User2.ID)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenNotOrganizationMember)
+	})
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		invitation, err := s.Organization.CreateInvitation(ctx, org.ID, fix.User1.ID, fix.User2.Username.String, organization.RoleOperator)
+		require.NoError(t, err)
+
+		// Invitations are revoked within their organization only.
+		other, err := s.Organization.CreateOrganization(ctx, "Other", fix.User1.ID)
+		require.NoError(t, err)
+		err = s.Organization.RevokeInvitation(ctx, other.ID, invitation.ID, fix.User1.ID)
+		require.ErrorIs(t, err, httperrors.ErrNotFoundInvitation)
+
+		require.NoError(t, s.Organization.RevokeInvitation(ctx, org.ID, invitation.ID, fix.User1.ID))
+
+		pending, err := s.Organization.ListPendingInvitations(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		_, err = s.Organization.AcceptInvitation(ctx, invitation.Token, "")
+		require.ErrorIs(t, err, httperrors.ErrConflictInvitationNotPending)
+		_, err = organization.MemberRole(ctx, s.DB, org.ID, fix.User2.ID)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenNotOrganizationMember)
+
+		err = s.Organization.RevokeInvitation(ctx, org.ID, invitation.ID, fix.User1.ID)
+		require.ErrorIs(t, err, httperrors.ErrConflictInvitationNotPending)
+	})
+}
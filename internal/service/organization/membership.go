@@ -0,0 +1,247 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// Statuses of vault proposals and signing requests touched while revoking approver status. They mirror
+// the constants of the vault package, which can't be imported here as it depends on this package.
+const (
+	statusPending   = "pending"
+	statusCancelled = "cancelled"
+
+	approvalActionApprove = "approve"
+	approvalActionVoided  = "voided"
+)
+
+func (s *impl) TransferOwnership(ctx context.Context, orgID string, params TransferOwnershipParams) error {
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		org, err := models.Organizations(
+			models.OrganizationWhere.ID.EQ(orgID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("find organization: %w", err)
+		}
+		if org.OwnerID != params.UserID {
+			return httperrors.ErrForbiddenInsufficientRole
+		}
+
+		// Giving away the organization must be confirmed with a passkey registered to the owner.
+		if _, err := s.passkeys.VerifyAssertion(ctx, exec, params.UserID, auth.AssertionScope{
+			Action:     auth.AssertionActionTransferOwnership,
+			ResourceID: orgID,
+		}, auth.Assertion{
+			CredentialID:      params.CredentialID,
+			Signature:         params.Signature,
+			AuthenticatorData: params.AuthenticatorData,
+			ClientDataJSON:    params.ClientDataJSON,
+		}); err != nil {
+			return err
+		}
+
+		newOwner, err := models.OrganizationMembers(
+			models.OrganizationMemberWhere.OrganizationID.EQ(orgID),
+			models.OrganizationMemberWhere.UserID.EQ(params.NewOwnerID),
+			qm.Load(models.OrganizationMemberRels.User),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrBadRequestNewOwnerNotMember
+			}
+			return fmt.Errorf("find new owner: %w", err)
+		}
+		if newOwner.R.User == nil || !newOwner.R.User.IsActive {
+			return httperrors.ErrForbiddenUserDeactivated
+		}
+
+		now := time.Now()
+
+		// Both owners are kept as admins, so the organization still has one after another transfer.
+		newOwner.Role = RoleAdmin
+		if _, err := newOwner.Update(ctx, exec, boil.Whitelist(models.OrganizationMemberColumns.Role)); err != nil {
+			return fmt.Errorf("update new owner membership: %w", err)
+		}
+
+		previousOwner := &models.OrganizationMember{
+			OrganizationID: orgID,
+			UserID:         org.OwnerID,
+			Role:           RoleAdmin,
+			CreatedAt:      null.TimeFrom(now),
+		}
+		if err := previousOwner.Upsert(ctx, exec, true,
+			[]string{models.OrganizationMemberColumns.OrganizationID, models.OrganizationMemberColumns.UserID},
+			boil.Whitelist(models.OrganizationMemberColumns.Role),
+			boil.Infer(),
+		); err != nil {
+			return fmt.Errorf("upsert previous owner membership: %w", err)
+		}
+
+		org.OwnerID = params.NewOwnerID
+		org.UpdatedAt = null.TimeFrom(now)
+		if _, err := org.Update(ctx, exec, boil.Whitelist(models.OrganizationColumns.OwnerID, models.OrganizationColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("update organization owner: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         params.UserID,
+			Action:         audit.ActionTransferOwnership,
+			ResourceType:   audit.ResourceTypeOrganization,
+			ResourceID:     orgID,
+			CredentialID:   params.CredentialID,
+			Details: map[string]interface{}{
+				"new_owner_id": params.NewOwnerID,
+			},
+		})
+	})
+}
+
+// ensureAdminRemains returns httperrors.ErrConflictLastAdmin if, apart from the given user, no active
+// user is left to administer the organization. The owner counts as admin.
+func ensureAdminRemains(ctx context.Context, exec boil.ContextExecutor, org *models.Organization, userID string) error {
+	admins, err := models.Users(
+		qm.Where(models.UserColumns.ID+" <> ?", userID),
+		models.UserWhere.IsActive.EQ(true),
+		qm.Where(models.UserColumns.ID+" = ? OR "+models.UserColumns.ID+" IN (SELECT "+models.OrganizationMemberColumns.UserID+" FROM "+models.TableNames.OrganizationMembers+" WHERE "+models.OrganizationMemberColumns.OrganizationID+" = ? AND "+models.OrganizationMemberColumns.Role+" = ?)",
+			org.OwnerID, org.ID, RoleAdmin),
+	).Count(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("count admins: %w", err)
+	}
+	if admins == 0 {
+		return httperrors.ErrConflictLastAdmin
+	}
+	return nil
+}
+
+// EligibleApprovers returns the users eligible to approve the signing requests and proposals of the vaults of the
+// organization: its owner and every member but auditors.
+func EligibleApprovers(ctx context.Context, exec boil.ContextExecutor, org *models.Organization) (map[string]struct{}, error) {
+	approvers := map[string]struct{}{org.OwnerID: {}}
+
+	members, err := models.OrganizationMembers(
+		models.OrganizationMemberWhere.OrganizationID.EQ(org.ID),
+		models.OrganizationMemberWhere.Role.NEQ(RoleAuditor),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("list approvers: %w", err)
+	}
+	for _, m := range members {
+		approvers[m.UserID] = struct{}{}
+	}
+
+	return approvers, nil
+}
+
+// revokeApproverStatus voids the approvals the user gave on still pending signing requests and vault
+// proposals of the organization. Afterwards every pending request is re-evaluated against the remaining
+// approvers, requests that can no longer reach their quorum are cancelled. The IDs of the cancelled signing
+// requests are returned for their approvers to be notified once committed.
+func revokeApproverStatus(ctx context.Context, exec boil.ContextExecutor, org *models.Organization, userID string) ([]string, error) {
+	vaults, err := models.Vaults(
+		models.VaultWhere.OrganizationID.EQ(null.StringFrom(org.ID)),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("list organization vaults: %w", err)
+	}
+	if len(vaults) == 0 {
+		return nil, nil
+	}
+
+	eligible, err := EligibleApprovers(ctx, exec, org)
+	if err != nil {
+		return nil, err
+	}
+	approvers := len(eligible)
+
+	var cancelled []string
+	now := time.Now()
+	for _, v := range vaults {
+		pendingRequests := qm.Where(models.ApprovalColumns.RequestID+" IN (SELECT "+models.SigningRequestColumns.ID+" FROM "+models.TableNames.SigningRequests+" WHERE "+models.SigningRequestColumns.VaultID+" = ? AND "+models.SigningRequestColumns.Status+" = ?)", v.ID, statusPending)
+		if _, err := models.Approvals(
+			pendingRequests,
+			models.ApprovalWhere.UserID.EQ(null.StringFrom(userID)),
+			models.ApprovalWhere.Action.EQ(approvalActionApprove),
+		).UpdateAll(ctx, exec, models.M{
+			models.ApprovalColumns.Action:    approvalActionVoided,
+			models.ApprovalColumns.UpdatedAt: now,
+		}); err != nil {
+			return nil, fmt.Errorf("void signing approvals: %w", err)
+		}
+
+		pendingProposals := qm.Where(models.VaultProposalApprovalColumns.VaultProposalID+" IN (SELECT "+models.VaultProposalColumns.ID+" FROM "+models.TableNames.VaultProposals+" WHERE "+models.VaultProposalColumns.VaultID+" = ? AND "+models.VaultProposalColumns.Status+" = ?)", v.ID, statusPending)
+		if _, err := models.VaultProposalApprovals(
+			pendingProposals,
+			models.VaultProposalApprovalWhere.UserID.EQ(userID),
+			models.VaultProposalApprovalWhere.Action.EQ(approvalActionApprove),
+		).UpdateAll(ctx, exec, models.M{
+			models.VaultProposalApprovalColumns.Action:    approvalActionVoided,
+			models.VaultProposalApprovalColumns.UpdatedAt: now,
+		}); err != nil {
+			return nil, fmt.Errorf("void proposal approvals: %w", err)
+		}
+
+		if approvers < v.Threshold {
+			requests, err := models.SigningRequests(
+				models.SigningRequestWhere.VaultID.EQ(null.StringFrom(v.ID)),
+				models.SigningRequestWhere.Status.EQ(null.StringFrom(statusPending)),
+			).All(ctx, exec)
+			if err != nil {
+				return nil, fmt.Errorf("list unreachable signing requests: %w", err)
+			}
+			if _, err := requests.UpdateAll(ctx, exec, models.M{
+				models.SigningRequestColumns.Status:    statusCancelled,
+				models.SigningRequestColumns.UpdatedAt: now,
+			}); err != nil {
+				return nil, fmt.Errorf("cancel unreachable signing requests: %w", err)
+			}
+			for _, req := range requests {
+				req.Status = null.StringFrom(statusCancelled)
+				if err := outbox.Enqueue(ctx, exec, outbox.SigningRequestEvent(org.ID, outbox.EventSigningRequestCancelled, req, map[string]interface{}{
+					"reason": "quorum unreachable",
+				})); err != nil {
+					return nil, err
+				}
+				cancelled = append(cancelled, req.ID)
+			}
+		}
+
+		proposals, err := models.VaultProposals(
+			models.VaultProposalWhere.VaultID.EQ(v.ID),
+			models.VaultProposalWhere.Status.EQ(statusPending),
+			models.VaultProposalWhere.RequiredApprovals.GT(approvers),
+		).All(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("list unreachable vault proposals: %w", err)
+		}
+		for _, proposal := range proposals {
+			proposal.Status = statusCancelled
+			proposal.StatusReason = null.StringFrom(fmt.Sprintf("quorum of %d exceeds the %d eligible approvers", proposal.RequiredApprovals, approvers))
+			proposal.UpdatedAt = null.TimeFrom(now)
+			if _, err := proposal.Update(ctx, exec, boil.Whitelist(
+				models.VaultProposalColumns.Status,
+				models.VaultProposalColumns.StatusReason,
+				models.VaultProposalColumns.UpdatedAt,
+			)); err != nil {
+				return nil, fmt.Errorf("cancel unreachable vault proposal: %w", err)
+			}
+		}
+	}
+
+	return cancelled, nil
+}
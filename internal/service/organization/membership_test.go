@@ -0,0 +1,257 @@
+package organization_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferOwnership(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+		require.NoError(t, err)
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+
+		params := organization.TransferOwnershipParams{
+			UserID:     fix.User1.ID,
+			NewOwnerID: fix.User2.ID,
+		}
+		require.ErrorIs(t, s.Organization.TransferOwnership(ctx, org.ID, params), httperrors.ErrForbiddenPasskeyRequired)
+
+		// The assertion must answer a challenge issued to the owner.
+		assertion := test.NewPasskey(t, s, fix.User2.ID).Assert(t, s, auth.AssertionActionTransferOwnership, org.ID)
+		params.CredentialID = assertion.CredentialID
+		params.Signature = assertion.Signature
+		params.AuthenticatorData = assertion.AuthenticatorData
+		params.ClientDataJSON = assertion.ClientDataJSON
+		require.ErrorIs(t, s.Organization.TransferOwnership(ctx, org.ID, params), httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		assertion = passkey.Assert(t, s, auth.AssertionActionTransferOwnership, org.ID)
+		params.CredentialID = assertion.CredentialID
+		params.Signature = assertion.Signature
+		params.AuthenticatorData = assertion.AuthenticatorData
+		params.ClientDataJSON = assertion.ClientDataJSON
+		require.NoError(t, s.Organization.TransferOwnership(ctx, org.ID, params))
+
+		require.NoError(t, org.Reload(ctx, s.DB))
+		assert.Equal(t, fix.User2.ID, org.OwnerID)
+
+		// The previous owner stays admin.
+		role, err := s.Organization.MemberRole(ctx, org.ID, fix.User2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, organization.RoleOwner, role)
+		role, err = s.Organization.MemberRole(ctx, org.ID, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, organization.RoleAdmin, role)
+
+		// A replayed assertion does not confirm another transfer.
+		params.UserID = fix.User2.ID
+		params.NewOwnerID = fix.User1.ID
+		require.ErrorIs(t, s.Organization.TransferOwnership(ctx, org.ID, params), httperrors.ErrForbiddenPasskeyAssertionInvalid)
+	})
+}
+
+func TestLastAdminRemains(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Organization.AddMember(ctx, org.ID, fix.User2.ID, organization.RoleAdmin, fix.User1.ID)
+		require.NoError(t, err)
+
+		// The owner is immutable, counting as admin while active.
+		require.ErrorIs(t, s.Organization.RemoveMember(ctx, org.ID, fix.User1.ID, fix.User2.ID), httperrors.ErrConflictOwnerMembershipImmutable)
+		require.NoError(t, s.Organization.UpdateMemberRole(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID))
+		require.NoError(t, s.Organization.UpdateMemberRole(ctx, org.ID, fix.User2.ID, organization.RoleAdmin, fix.User1.ID))
+
+		// With the owner deactivated, the remaining admin can neither be demoted nor removed.
+		owner, err := models.FindUser(ctx, s.DB, fix.User1.ID)
+		require.NoError(t, err)
+		owner.IsActive = false
+		_, err = owner.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.IsActive))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, s.Organization.UpdateMemberRole(ctx, org.ID, fix.User2.ID, organization.RoleOperator, fix.User2.ID), httperrors.ErrConflictLastAdmin)
+		require.ErrorIs(t, s.Organization.RemoveMember(ctx, org.ID, fix.User2.ID, fix.User2.ID), httperrors.ErrConflictLastAdmin)
+
+		role, err := s.Organization.MemberRole(ctx, org.ID, fix.User2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, organization.RoleAdmin, role)
+	})
+}
+
+func TestOrganizationScopeOfMember(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		acme, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		other, err := s.Organization.CreateOrganization(ctx, "Other", fix.User1.ID)
+		require.NoError(t, err)
+
+		// Without any membership there is nothing to select.
+		orgs, err := s.Organization.ListUserOrganizations(ctx, fix.User2.ID)
+		require.NoError(t, err)
+		assert.Empty(t, orgs)
+		_, err = s.Organization.ResolveOrganization(ctx, fix.User2.ID, "")
+		require.ErrorIs(t, err, httperrors.ErrBadRequestOrganizationRequired)
+
+		_, err = s.Organization.AddMember(ctx, acme.ID, fix.User2.ID, organization.RoleOperator, fix.User1.ID)
+		require.NoError(t, err)
+
+		// The member sees the organization it joined, but not the others of the owner.
+		orgs, err = s.Organization.ListUserOrganizations(ctx, fix.User2.ID)
+		require.NoError(t, err)
+		require.Len(t, orgs, 1)
+		assert.Equal(t, acme.ID, orgs[0].ID)
+
+		orgID, err := s.Organization.ResolveOrganization(ctx, fix.User2.ID, "")
+		require.NoError(t, err)
+		assert.Equal(t, acme.ID, orgID)
+		_, err = s.Organization.ResolveOrganization(ctx, fix.User2.ID, other.ID)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenNotOrganizationMember)
+		require.ErrorIs(t, s.Organization.SetDefaultOrganization(ctx, fix.User2.ID, other.ID), httperrors.ErrForbiddenNotOrganizationMember)
+
+		// With a second membership the organization has to be selected, unless a default is set.
+		_, err = s.Organization.AddMember(ctx, other.ID, fix.User2.ID, organization.RoleAuditor, fix.User1.ID)
+		require.NoError(t, err)
+		orgs, err = s.Organization.ListUserOrganizations(ctx, fix.User2.ID)
+		require.NoError(t, err)
+		assert.Len(t, orgs, 2)
+		_, err = s.Organization.ResolveOrganization(ctx, fix.User2.ID, "")
+		require.ErrorIs(t, err, httperrors.ErrBadRequestOrganizationRequired)
+
+		require.NoError(t, s.Organization.SetDefaultOrganization(ctx, fix.User2.ID, other.ID))
+		orgID, err = s.Organization.ResolveOrganization(ctx, fix.User2.ID, "")
+		require.NoError(t, err)
+		assert.Equal(t, other.ID, orgID)
+
+		// A default of an organization the member left is ignored.
+		require.NoError(t, s.Organization.RemoveMember(ctx, other.ID, fix.User2.ID, fix.User1.ID))
+		orgID, err = s.Organization.ResolveOrganization(ctx, fix.User2.ID, "")
+		require.NoError(t, err)
+		assert.Equal(t, acme.ID, orgID)
+	})
+}
+
+func TestRevokeApproverStatus(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		user3 := &models.User{
+			Username: null.StringFrom("user3@example.com"),
+			IsActive: true,
+			Scopes:   []string{"app"},
+		}
+		require.NoError(t, user3.Insert(ctx, s.DB, boil.Infer()))
+
+		// Two of User1, User2 and User3 have to approve.
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		for _, userID := range []string{fix.User2.ID, user3.ID} {
+			_, err = s.Organization.AddMember(ctx, org.ID, userID, organization.RoleOperator, fix.User1.ID)
+			require.NoError(t, err)
+		}
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 2, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+
+		proposal, err := s.Vault.ProposeThresholdChange(ctx, v.ID, fix.User1.ID, 1)
+		require.NoError(t, err)
+		assertion := test.NewPasskey(t, s, fix.User2.ID).Assert(t, s, auth.AssertionActionApproveProposal, proposal.ID)
+		_, err = s.Vault.ApproveProposal(ctx, proposal.ID, vault.ApprovalParams{
+			UserID:            fix.User2.ID,
+			CredentialID:      assertion.CredentialID,
+			Signature:         assertion.Signature,
+			AuthenticatorData: assertion.AuthenticatorData,
+			ClientDataJSON:    assertion.ClientDataJSON,
+		})
+		require.NoError(t, err)
+
+		req := &models.SigningRequest{
+			ID:          uuid.NewString(),
+			VaultID:     null.StringFrom(v.ID),
+			InitiatorID: null.StringFrom(fix.User1.ID),
+			TXData:      "0x",
+			Status:      null.StringFrom("pending"),
+		}
+		require.NoError(t, req.Insert(ctx, s.DB, boil.Infer()))
+		for _, userID := range []string{fix.User2.ID, user3.ID} {
+			approval := &models.Approval{
+				ID:        uuid.NewString(),
+				RequestID: null.StringFrom(req.ID),
+				UserID:    null.StringFrom(userID),
+				Action:    "approve",
+			}
+			require.NoError(t, approval.Insert(ctx, s.DB, boil.Infer()))
+		}
+
+		approvalOf := func(userID string) string {
+			t.Helper()
+			approval, err := models.Approvals(
+				models.ApprovalWhere.RequestID.EQ(null.StringFrom(req.ID)),
+				models.ApprovalWhere.UserID.EQ(null.StringFrom(userID)),
+			).One(ctx, s.DB)
+			require.NoError(t, err)
+			return approval.Action
+		}
+
+		// Auditors do not vote, the approvals of a demoted member are voided. Two approvers remain for the quorum.
+		require.NoError(t, s.Organization.UpdateMemberRole(ctx, org.ID, fix.User2.ID, organization.RoleAuditor, fix.User1.ID))
+		assert.Equal(t, "voided", approvalOf(fix.User2.ID))
+		assert.Equal(t, "approve", approvalOf(user3.ID))
+		proposalApproval, err := models.VaultProposalApprovals(
+			models.VaultProposalApprovalWhere.VaultProposalID.EQ(proposal.ID),
+			models.VaultProposalApprovalWhere.UserID.EQ(fix.User2.ID),
+		).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "voided", proposalApproval.Action)
+		require.NoError(t, req.Reload(ctx, s.DB))
+		assert.Equal(t, "pending", req.Status.String)
+		require.NoError(t, proposal.Reload(ctx, s.DB))
+		assert.Equal(t, vault.ProposalStatusPending, proposal.Status)
+
+		// With another approver removed the quorum can no longer be reached, pending requests are cancelled.
+		require.NoError(t, s.Organization.RemoveMember(ctx, org.ID, user3.ID, fix.User1.ID))
+		assert.Equal(t, "voided", approvalOf(user3.ID))
+		require.NoError(t, req.Reload(ctx, s.DB))
+		assert.Equal(t, "cancelled", req.Status.String)
+		cancelledEvents, err := models.OutboxEvents(
+			models.OutboxEventWhere.EventType.EQ(outbox.EventSigningRequestCancelled),
+			models.OutboxEventWhere.ResourceID.EQ(req.ID),
+		).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cancelledEvents)
+		require.NoError(t, proposal.Reload(ctx, s.DB))
+		assert.Equal(t, vault.ProposalStatusCancelled, proposal.Status)
+		assert.Equal(t, "quorum of 2 exceeds the 1 eligible approvers", proposal.StatusReason.String)
+
+		approvers, err := vault.EligibleApprovers(ctx, s.DB, v)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]struct{}{fix.User1.ID: {}}, approvers)
+	})
+}
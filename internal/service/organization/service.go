@@ -6,11 +6,67 @@ import (
 	"github.com/kashguard/go-mpc-vault/internal/models"
 )
 
+const (
+	// RoleOwner is reported for the owner of an organization, who is not necessarily listed as member.
+	RoleOwner    = "owner"
+	RoleAdmin    = "admin"
+	RoleOperator = "operator"
+	RoleAuditor  = "auditor"
+
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusRevoked  = "revoked"
+)
+
+// TransferOwnershipParams carries the passkey assertion of the current owner confirming the transfer.
+type TransferOwnershipParams struct {
+	UserID            string
+	NewOwnerID        string
+	CredentialID      []byte
+	Signature         []byte
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+}
+
+// AcceptInvitationResult describes the membership created by accepting an invitation.
+type AcceptInvitationResult struct {
+	Member *models.OrganizationMember
+	// Registered is set if a new user was registered for the invited email.
+	Registered bool
+}
+
 type Service interface {
 	CreateOrganization(ctx context.Context, name string, ownerID string) (*models.Organization, error)
+	GetOrganization(ctx context.Context, orgID string) (*models.Organization, error)
 	ListUserOrganizations(ctx context.Context, userID string) (models.OrganizationSlice, error)
 	ListMembers(ctx context.Context, orgID string) (models.OrganizationMemberSlice, error)
-	AddMember(ctx context.Context, orgID string, userID string, role string) (*models.OrganizationMember, error)
-	RemoveMember(ctx context.Context, orgID string, userID string) error
-	UpdateMemberRole(ctx context.Context, orgID string, userID string, role string) error
+	// AddMember, RemoveMember and UpdateMemberRole take the ID of the acting user as actorID.
+	AddMember(ctx context.Context, orgID string, userID string, role string, actorID string) (*models.OrganizationMember, error)
+	// RemoveMember removes the user from the organization, voiding their pending approvals and
+	// re-evaluating in-flight requests of the organization's vaults against the remaining quorum.
+	RemoveMember(ctx context.Context, orgID string, userID string, actorID string) error
+	UpdateMemberRole(ctx context.Context, orgID string, userID string, role string, actorID string) error
+	// TransferOwnership hands the organization over to another member, the previous owner stays admin.
+	TransferOwnership(ctx context.Context, orgID string, params TransferOwnershipParams) error
+
+	// MemberRole returns the role of the user within the organization or
+	// httperrors.ErrForbiddenNotOrganizationMember if the user does not belong to it.
+	MemberRole(ctx context.Context, orgID string, userID string) (string, error)
+	// RequireManager ensures the user is the owner or an admin of the organization, returning
+	// httperrors.ErrForbiddenInsufficientRole otherwise.
+	RequireManager(ctx context.Context, orgID string, userID string) error
+	GetDefaultOrganization(ctx context.Context, userID string) (string, error)
+	SetDefaultOrganization(ctx context.Context, userID string, orgID string) error
+	// ResolveOrganization returns orgID if given, else the default organization of the user or,
+	// lacking one, the only organization the user belongs to. Membership is checked in any case.
+	ResolveOrganization(ctx context.Context, userID string, orgID string) (string, error)
+
+	// CreateInvitation invites the email to join the organization with the given role,
+	// replacing any pending invitation for the same email.
+	CreateInvitation(ctx context.Context, orgID string, invitedBy string, email string, role string) (*models.OrganizationInvitation, error)
+	ListPendingInvitations(ctx context.Context, orgID string) (models.OrganizationInvitationSlice, error)
+	RevokeInvitation(ctx context.Context, orgID string, invitationID string, actorID string) error
+	// AcceptInvitation adds the user with the invited email to the organization. If no such user
+	// exists yet, a user is registered with the given password first.
+	AcceptInvitation(ctx context.Context, token string, password string) (AcceptInvitationResult, error)
 }
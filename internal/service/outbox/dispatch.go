@@ -0,0 +1,232 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/kashguard/go-mpc-vault/internal/infra/webhookclient"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// claimDeliveriesQuery leases the due deliveries by postponing them, so concurrent dispatchers skip them and deliveries
+// interrupted by a crash are attempted again once the lease expired.
+const claimDeliveriesQuery = `UPDATE webhook_deliveries SET next_attempt_at = $1, updated_at = $2
+WHERE id IN (
+	SELECT id FROM webhook_deliveries
+	WHERE status = 'pending' AND next_attempt_at <= $2
+	ORDER BY next_attempt_at
+	LIMIT $3
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING id`
+
+// envelope is the payload delivered to the endpoints.
+type envelope struct {
+	ID             string          `json:"id"`
+	Type           string          `json:"type"`
+	OrganizationID string          `json:"organization_id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Data           json.RawMessage `json:"data"`
+}
+
+// dispatchEvents creates the deliveries of the undispatched events for the endpoints of their organization
+// subscribed to them. Events are dispatched once, endpoints created later do not receive earlier events.
+func (s *impl) dispatchEvents(ctx context.Context) error {
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		events, err := models.OutboxEvents(
+			models.OutboxEventWhere.DispatchedAt.IsNull(),
+			qm.OrderBy(models.OutboxEventColumns.CreatedAt+" ASC"),
+			qm.Limit(s.config.Outbox.BatchSize),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to list outbox events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		orgIDs := make([]string, 0, len(events))
+		eventIDs := make([]string, 0, len(events))
+		for _, event := range events {
+			orgIDs = append(orgIDs, event.OrganizationID)
+			eventIDs = append(eventIDs, event.ID)
+		}
+
+		endpoints, err := models.WebhookEndpoints(
+			models.WebhookEndpointWhere.OrganizationID.IN(orgIDs),
+		).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to list webhook endpoints: %w", err)
+		}
+		endpointsByOrg := make(map[string]models.WebhookEndpointSlice)
+		for _, endpoint := range endpoints {
+			endpointsByOrg[endpoint.OrganizationID] = append(endpointsByOrg[endpoint.OrganizationID], endpoint)
+		}
+
+		now := s.clock.Now()
+		for _, event := range events {
+			for _, endpoint := range endpointsByOrg[event.OrganizationID] {
+				if !subscribed(endpoint, event.EventType) {
+					continue
+				}
+
+				delivery := &models.WebhookDelivery{
+					EndpointID:    endpoint.ID,
+					EventID:       event.ID,
+					Status:        DeliveryStatusPending,
+					NextAttemptAt: now,
+				}
+				if err := delivery.Insert(ctx, exec, boil.Infer()); err != nil {
+					return fmt.Errorf("failed to insert webhook delivery: %w", err)
+				}
+			}
+		}
+
+		if _, err := models.OutboxEvents(
+			models.OutboxEventWhere.ID.IN(eventIDs),
+		).UpdateAll(ctx, exec, models.M{
+			models.OutboxEventColumns.DispatchedAt: now,
+		}); err != nil {
+			return fmt.Errorf("failed to mark outbox events dispatched: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// deliverDue attempts the pending deliveries which are due.
+func (s *impl) deliverDue(ctx context.Context) error {
+	now := s.clock.Now()
+	lease := now.Add(s.config.Outbox.Timeout + time.Minute)
+
+	rows, err := s.db.QueryContext(ctx, claimDeliveriesQuery, lease, now, s.config.Outbox.BatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	deliveries, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.ID.IN(ids),
+		qm.Load(models.WebhookDeliveryRels.Endpoint),
+		qm.Load(models.WebhookDeliveryRels.Event),
+		qm.OrderBy(models.WebhookDeliveryColumns.CreatedAt+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("failed to load webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.deliver(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver posts the event of the delivery to its endpoint and records the outcome, scheduling a retry or marking the
+// delivery dead if it failed.
+func (s *impl) deliver(ctx context.Context, delivery *models.WebhookDelivery) error {
+	endpoint := delivery.R.GetEndpoint()
+	event := delivery.R.GetEvent()
+
+	body, err := json.Marshal(envelope{
+		ID:             event.ID,
+		Type:           event.EventType,
+		OrganizationID: event.OrganizationID,
+		CreatedAt:      event.CreatedAt,
+		Data:           json.RawMessage(event.Payload),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	status, err := s.client.Send(ctx, endpoint.URL, endpoint.Secret, webhookclient.Message{
+		EventID:   event.ID,
+		EventType: event.EventType,
+		Body:      body,
+	})
+
+	now := s.clock.Now()
+	delivery.Attempts++
+	switch {
+	case err != nil:
+		delivery.LastStatusCode = null.Int{}
+		delivery.LastError = null.StringFrom(err.Error())
+	case status < 200 || status > 299:
+		delivery.LastStatusCode = null.IntFrom(status)
+		delivery.LastError = null.StringFrom(fmt.Sprintf("endpoint responded with status %d", status))
+	default:
+		delivery.LastStatusCode = null.IntFrom(status)
+		delivery.LastError = null.String{}
+		delivery.Status = DeliveryStatusSucceeded
+		delivery.DeliveredAt = null.TimeFrom(now)
+	}
+
+	if delivery.Status == DeliveryStatusPending {
+		if delivery.Attempts >= s.config.Outbox.MaxAttempts {
+			delivery.Status = DeliveryStatusDead
+			util.LogFromContext(ctx).Warn().
+				Str("delivery_id", delivery.ID).
+				Str("endpoint_id", endpoint.ID).
+				Int("attempts", delivery.Attempts).
+				Str("error", delivery.LastError.String).
+				Msg("Webhook delivery exhausted its attempts")
+		} else {
+			delivery.NextAttemptAt = now.Add(s.backoff(delivery.Attempts))
+		}
+	}
+
+	if _, err := delivery.Update(ctx, s.db, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// backoff returns the delay after the given number of failed attempts, doubling the initial backoff per attempt.
+func (s *impl) backoff(attempts int) time.Duration {
+	delay := s.config.Outbox.InitialBackoff
+	for i := 1; i < attempts && delay < s.config.Outbox.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.config.Outbox.MaxBackoff {
+		delay = s.config.Outbox.MaxBackoff
+	}
+	return delay
+}
+
+func subscribed(endpoint *models.WebhookEndpoint, eventType string) bool {
+	if len(endpoint.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range endpoint.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/webhookclient"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
+)
+
+// secretPrefix marks the secrets of webhook endpoints, so they are recognizable e.g. by secret scanners.
+const secretPrefix = "whsec_"
+
+type impl struct {
+	config config.Server
+	db     *sql.DB
+	clock  time2.Clock
+	client webhookclient.Client
+}
+
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, client webhookclient.Client) Service {
+	return &impl{
+		config: config,
+		db:     db,
+		clock:  clock,
+		client: client,
+	}
+}
+
+func (s *impl) Run(ctx context.Context) {
+	log := util.LogFromContext(ctx)
+
+	if s.config.Outbox.PollInterval <= 0 {
+		log.Warn().Msg("No outbox poll interval configured, skipping webhook delivery")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Outbox.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Dispatch(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to dispatch outbox")
+			}
+		}
+	}
+}
+
+func (s *impl) Dispatch(ctx context.Context) error {
+	if err := s.dispatchEvents(ctx); err != nil {
+		return err
+	}
+	return s.deliverDue(ctx)
+}
+
+func (s *impl) CreateEndpoint(ctx context.Context, params CreateEndpointParams) (*models.WebhookEndpoint, error) {
+	if err := s.client.ValidateURL(params.URL); err != nil {
+		util.LogFromContext(ctx).Debug().Err(err).Msg("Rejecting webhook endpoint url")
+		return nil, httperrors.ErrBadRequestInvalidWebhookURL
+	}
+
+	secret, err := util.GenerateRandomHexString(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	eventTypes := params.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	endpoint := &models.WebhookEndpoint{
+		ID:             uuid.New().String(),
+		OrganizationID: params.OrganizationID,
+		URL:            params.URL,
+		Description:    null.NewString(params.Description, params.Description != ""),
+		Secret:         secretPrefix + secret,
+		EventTypes:     eventTypes,
+		CreatedBy:      null.StringFrom(params.UserID),
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := endpoint.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert webhook endpoint: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: params.OrganizationID,
+			UserID:         params.UserID,
+			Action:         audit.ActionCreateWebhookEndpoint,
+			ResourceType:   audit.ResourceTypeWebhookEndpoint,
+			ResourceID:     endpoint.ID,
+			Details: map[string]interface{}{
+				"url":         endpoint.URL,
+				"event_types": eventTypes,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return endpoint, nil
+}
+
+func (s *impl) ListEndpoints(ctx context.Context, orgID string) (models.WebhookEndpointSlice, error) {
+	endpoints, err := models.WebhookEndpoints(
+		models.WebhookEndpointWhere.OrganizationID.EQ(orgID),
+		qm.OrderBy(models.WebhookEndpointColumns.CreatedAt+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+
+	return endpoints, nil
+}
+
+func (s *impl) DeleteEndpoint(ctx context.Context, orgID string, endpointID string, userID string) error {
+	return db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		endpoint, err := findEndpoint(ctx, exec, orgID, endpointID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := endpoint.Delete(ctx, exec); err != nil {
+			return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionDeleteWebhookEndpoint,
+			ResourceType:   audit.ResourceTypeWebhookEndpoint,
+			ResourceID:     endpoint.ID,
+			Details: map[string]interface{}{
+				"url": endpoint.URL,
+			},
+		})
+	})
+}
+
+func (s *impl) ListDeliveries(ctx context.Context, params ListDeliveriesParams) (models.WebhookDeliverySlice, int64, error) {
+	if _, err := findEndpoint(ctx, s.db, params.OrganizationID, params.EndpointID); err != nil {
+		return nil, 0, err
+	}
+
+	mods := []qm.QueryMod{
+		models.WebhookDeliveryWhere.EndpointID.EQ(params.EndpointID),
+	}
+	if params.Status != "" {
+		mods = append(mods, models.WebhookDeliveryWhere.Status.EQ(params.Status))
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	page := params.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	total, err := models.WebhookDeliveries(mods...).Count(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	mods = append(mods,
+		qm.Load(models.WebhookDeliveryRels.Event),
+		qm.OrderBy(models.WebhookDeliveryColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+		qm.Offset((page-1)*limit),
+	)
+	deliveries, err := models.WebhookDeliveries(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	return deliveries, total, nil
+}
+
+func (s *impl) Redeliver(ctx context.Context, orgID string, endpointID string, deliveryID string, userID string) (*models.WebhookDelivery, error) {
+	var delivery *models.WebhookDelivery
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if _, err := findEndpoint(ctx, exec, orgID, endpointID); err != nil {
+			return err
+		}
+
+		var err error
+		delivery, err = models.WebhookDeliveries(
+			models.WebhookDeliveryWhere.ID.EQ(deliveryID),
+			models.WebhookDeliveryWhere.EndpointID.EQ(endpointID),
+			qm.Load(models.WebhookDeliveryRels.Event),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrNotFoundWebhookDelivery
+			}
+			return fmt.Errorf("failed to find webhook delivery: %w", err)
+		}
+
+		previousStatus := delivery.Status
+		delivery.Status = DeliveryStatusPending
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = s.clock.Now()
+		if _, err := delivery.Update(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to update webhook delivery: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionRedeliverWebhook,
+			ResourceType:   audit.ResourceTypeWebhookEndpoint,
+			ResourceID:     endpointID,
+			Details: map[string]interface{}{
+				"delivery_id":     delivery.ID,
+				"event_id":        delivery.EventID,
+				"previous_status": previousStatus,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func findEndpoint(ctx context.Context, exec boil.ContextExecutor, orgID string, endpointID string) (*models.WebhookEndpoint, error) {
+	endpoint, err := models.WebhookEndpoints(
+		models.WebhookEndpointWhere.ID.EQ(endpointID),
+		models.WebhookEndpointWhere.OrganizationID.EQ(orgID),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundWebhookEndpoint
+		}
+		return nil, fmt.Errorf("failed to find webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
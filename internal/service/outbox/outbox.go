@@ -0,0 +1,112 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+)
+
+// Types of the events delivered to the webhook endpoints of organizations.
+const (
+	EventSigningRequestCreated  = "signing_request.created"
+	EventSigningRequestApproved = "signing_request.approved"
+	EventSigningRequestSigned   = "signing_request.signed"
+	EventSigningRequestRejected  = "signing_request.rejected"
+	EventSigningRequestBroadcast = "signing_request.broadcast"
+	EventSigningRequestCancelled = "signing_request.cancelled"
+
+	EventVaultCreated          = "vault.created"
+	EventVaultArchived         = "vault.archived"
+	EventVaultThresholdChanged = "vault.threshold_changed"
+
+	EventKeyBackupDegraded = "key.backup_degraded"
+	EventKeyRetiring       = "key.retiring"
+	EventKeyRetired        = "key.retired"
+	EventKeyRefreshed      = "key.refreshed"
+	EventKeyRefreshFailed  = "key.refresh_failed"
+)
+
+// EventTypes lists the types endpoints may subscribe to.
+var EventTypes = []string{
+	EventSigningRequestCreated,
+	EventSigningRequestApproved,
+	EventSigningRequestSigned,
+	EventSigningRequestRejected,
+	EventSigningRequestBroadcast,
+	EventSigningRequestCancelled,
+	EventVaultCreated,
+	EventVaultArchived,
+	EventVaultThresholdChanged,
+	EventKeyBackupDegraded,
+	EventKeyRetiring,
+	EventKeyRetired,
+	EventKeyRefreshed,
+	EventKeyRefreshFailed,
+}
+
+type Event struct {
+	OrganizationID string
+	Type           string
+	ResourceType   string
+	ResourceID     string
+	// Data is delivered as data of the event, it has to marshal to a JSON object.
+	Data interface{}
+}
+
+// SigningRequestEvent returns the event of the given type about the signing request of a vault of the organization.
+// The extra fields are added to the data of the request.
+func SigningRequestEvent(orgID string, eventType string, req *models.SigningRequest, extra map[string]interface{}) Event {
+	data := map[string]interface{}{
+		"id":           req.ID,
+		"vault_id":     req.VaultID.String,
+		"wallet_id":    req.WalletID.String,
+		"to_address":   req.ToAddress.String,
+		"note":         req.Note.String,
+		"status":       req.Status.String,
+		"initiator_id": req.InitiatorID.String,
+	}
+	for key, value := range extra {
+		data[key] = value
+	}
+
+	return Event{
+		OrganizationID: orgID,
+		Type:           eventType,
+		ResourceType:   audit.ResourceTypeSigningRequest,
+		ResourceID:     req.ID,
+		Data:           data,
+	}
+}
+
+// Enqueue writes the event to the outbox to be delivered to the endpoints of the organization subscribed to it.
+// The executor must be the transaction of the change described, so either both or none are persisted.
+// Events of resources without organization are not delivered and thus not written.
+func Enqueue(ctx context.Context, exec boil.ContextExecutor, event Event) error {
+	if event.OrganizationID == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox event data: %w", err)
+	}
+
+	e := &models.OutboxEvent{
+		ID:             uuid.New().String(),
+		OrganizationID: event.OrganizationID,
+		EventType:      event.Type,
+		ResourceType:   event.ResourceType,
+		ResourceID:     event.ResourceID,
+		Payload:        payload,
+	}
+	if err := e.Insert(ctx, exec, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert outbox event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// Deliveries are retried with exponential backoff while pending, they are dead once the attempts are exhausted.
+// Dead and succeeded deliveries may be redelivered manually.
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusSucceeded = "succeeded"
+	DeliveryStatusDead      = "dead"
+)
+
+type CreateEndpointParams struct {
+	OrganizationID string
+	URL            string
+	Description    string
+	// EventTypes the endpoint subscribes to, empty for all.
+	EventTypes []string
+	UserID     string
+}
+
+type ListDeliveriesParams struct {
+	OrganizationID string
+	EndpointID     string
+	// Status filters the deliveries, empty for all.
+	Status string
+	Page   int
+	Limit  int
+}
+
+type Service interface {
+	// Run dispatches the events of the outbox to the endpoints subscribed and attempts the due deliveries in the
+	// configured interval until the context is done.
+	Run(ctx context.Context)
+	// Dispatch fans the undispatched events of the outbox out to deliveries of the endpoints subscribed and attempts
+	// the due deliveries once.
+	Dispatch(ctx context.Context) error
+
+	// CreateEndpoint subscribes the URL to events of the organization. The secret signing the payloads is
+	// generated and only readable from the endpoint returned.
+	CreateEndpoint(ctx context.Context, params CreateEndpointParams) (*models.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context, orgID string) (models.WebhookEndpointSlice, error)
+	DeleteEndpoint(ctx context.Context, orgID string, endpointID string, userID string) error
+	// ListDeliveries returns the deliveries of the endpoint, newest first, with their events loaded.
+	ListDeliveries(ctx context.Context, params ListDeliveriesParams) (models.WebhookDeliverySlice, int64, error)
+	// Redeliver resets the attempts of the delivery and schedules it immediately.
+	Redeliver(ctx context.Context, orgID string, endpointID string, deliveryID string, userID string) (*models.WebhookDelivery, error)
+}
@@ -4,46 +4,156 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
 	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
 	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
 	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/backup"
+	"github.com/kashguard/go-mpc-vault/internal/service/node"
+	"github.com/kashguard/go-mpc-vault/internal/service/notification"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
 )
 
 type impl struct {
 	db            *sql.DB
+	clock         time2.Clock
 	signingClient *mpc.SigningClient
+	notification  notification.Service
+	node          node.Service
 }
 
 //nolint:ireturn
-func NewService(db *sql.DB, signingClient *mpc.SigningClient) Service {
+func NewService(db *sql.DB, clock time2.Clock, signingClient *mpc.SigningClient, notificationService notification.Service, nodeService node.Service) Service {
 	return &impl{
 		db:            db,
+		clock:         clock,
 		signingClient: signingClient,
+		notification:  notificationService,
+		node:          nodeService,
 	}
 }
 
-func (s *impl) CreateRequest(ctx context.Context, walletID string, txData string, note string, userID string) (*models.SigningRequest, error) {
+func (s *impl) CreateRequest(ctx context.Context, params CreateRequestParams) (*models.SigningRequest, error) {
+	wallet, err := models.Wallets(
+		models.WalletWhere.ID.EQ(params.WalletID),
+		qm.Load(models.WalletRels.Vault),
+		qm.Load(models.WalletRels.Chain),
+	).One(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("wallet not found: %w", err)
+	}
+	if wallet.VaultID.String != params.VaultID || wallet.R.Vault == nil {
+		return nil, httperrors.ErrBadRequestWalletNotInVault
+	}
+	if wallet.R.Vault.Status == vault.StatusArchived {
+		return nil, httperrors.ErrConflictVaultArchived
+	}
+	if err := checkKeyNotFrozen(ctx, s.db, wallet.KeyID); err != nil {
+		return nil, err
+	}
+	if err := checkKeyNotRefreshing(ctx, s.db, wallet.KeyID); err != nil {
+		return nil, err
+	}
+	if err := checkKeyRetirement(ctx, s.db, wallet, params.TxData); err != nil {
+		return nil, err
+	}
+	if wallet.R.Vault.WhitelistOnly && wallet.R.Chain == nil {
+		return nil, httperrors.ErrBadRequestUnknownChain
+	}
+	recipient, err := transactionRecipient(ctx, s.db, wallet, params.TxData, params.ToAddress, wallet.R.Vault.WhitelistOnly)
+	if err != nil {
+		return nil, err
+	}
+	orgID := wallet.R.Vault.OrganizationID.String
+	details := map[string]interface{}{
+		"vault_id":   params.VaultID,
+		"wallet_id":  params.WalletID,
+		"to_address": params.ToAddress,
+	}
+	if wallet.R.Vault.WhitelistOnly {
+		details[audit.DetailPolicy] = audit.PolicyWhitelist
+		if err := addressbook.CheckDestination(ctx, s.db, orgID, wallet.R.Chain, recipient, s.clock.Now()); err != nil {
+			s.recordPolicyViolation(ctx, orgID, params.VaultID, params.UserID, details, err)
+			return nil, err
+		}
+		details[audit.DetailPolicyOutcome] = audit.PolicyOutcomeHit
+	}
+
 	req := &models.SigningRequest{
 		ID:          uuid.New().String(),
-		WalletID:    null.StringFrom(walletID),
-		TXData:      txData,
-		Note:        null.StringFrom(note),
+		VaultID:     null.StringFrom(params.VaultID),
+		WalletID:    null.StringFrom(params.WalletID),
+		TXData:      params.TxData,
+		ToAddress:   null.NewString(params.ToAddress, params.ToAddress != ""),
+		Note:        null.StringFrom(params.Note),
 		Status:      null.StringFrom("pending"),
-		InitiatorID: null.StringFrom(userID),
+		InitiatorID: null.StringFrom(params.UserID),
 	}
 
-	if err := req.Insert(ctx, s.db, boil.Infer()); err != nil {
-		return nil, fmt.Errorf("failed to insert signing request: %w", err)
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := req.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert signing request: %w", err)
+		}
+
+		if err := enqueueEvent(ctx, exec, orgID, outbox.EventSigningRequestCreated, req, nil); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         params.UserID,
+			Action:         audit.ActionCreateSigningRequest,
+			ResourceType:   audit.ResourceTypeSigningRequest,
+			ResourceID:     req.ID,
+			Details:        details,
+		})
+	}); err != nil {
+		return nil, err
 	}
 
+	s.notification.NotifyApprovalRequested(ctx, req.ID)
+
 	return req, nil
 }
 
+// recordPolicyViolation records a signing request denied by a policy of the vault. As no request is
+// created in this case, the entry is written on its own; failing to do so is only logged so the caller
+// still receives the policy error.
+func (s *impl) recordPolicyViolation(ctx context.Context, orgID string, vaultID string, userID string, details map[string]interface{}, policyErr error) {
+	outcome := audit.PolicyOutcomeNotWhitelisted
+	if errors.Is(policyErr, httperrors.ErrConflictDestinationCoolingOff) {
+		outcome = audit.PolicyOutcomeCoolingOff
+	}
+	details[audit.DetailPolicyOutcome] = outcome
+
+	// Appending to the audit log chain requires a transaction of its own.
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionPolicyViolation,
+			ResourceType:   audit.ResourceTypeVault,
+			ResourceID:     vaultID,
+			Details:        details,
+		})
+	}); err != nil {
+		util.LogFromContext(ctx).Error().Err(err).Msg("Failed to record policy violation")
+	}
+}
+
 func (s *impl) ApproveRequest(ctx context.Context, requestID string, params ApprovalParams) error {
 	// 1. Load Request with Wallet and Vault
 	req, err := models.SigningRequests(
@@ -59,6 +169,20 @@ func (s *impl) ApproveRequest(ctx context.Context, requestID string, params Appr
 	if req.Status.String != "pending" {
 		return fmt.Errorf("request is not pending (status: %s)", req.Status.String)
 	}
+	if req.R.Wallet != nil && req.R.Wallet.R.Vault != nil && req.R.Wallet.R.Vault.Status == vault.StatusArchived {
+		return httperrors.ErrConflictVaultArchived
+	}
+	if req.R.Wallet != nil {
+		if err := checkKeyNotFrozen(ctx, s.db, req.R.Wallet.KeyID); err != nil {
+			return err
+		}
+		if err := checkKeyNotRefreshing(ctx, s.db, req.R.Wallet.KeyID); err != nil {
+			return err
+		}
+		if err := checkKeyRetirement(ctx, s.db, req.R.Wallet, req.TXData); err != nil {
+			return err
+		}
+	}
 
 	// 2. Check if user already approved
 	exists, err := models.Approvals(
@@ -84,10 +208,54 @@ func (s *impl) ApproveRequest(ctx context.Context, requestID string, params Appr
 		Action:    "approve",
 		Comment:   null.StringFrom(string(authDataJSON)), // Storing auth data in comment
 	}
-	if err := approval.Insert(ctx, s.db, boil.Infer()); err != nil {
+	var orgID string
+	if req.R.Wallet != nil && req.R.Wallet.R.Vault != nil {
+		orgID = req.R.Wallet.R.Vault.OrganizationID.String
+	}
+	quorumReached := false
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := approval.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+
+		// The approval reaching the threshold of the vault approves the request.
+		if req.R.Wallet != nil && req.R.Wallet.R.Vault != nil {
+			count, err := models.Approvals(
+				models.ApprovalWhere.RequestID.EQ(null.StringFrom(requestID)),
+				models.ApprovalWhere.Action.EQ("approve"),
+			).Count(ctx, exec)
+			if err != nil {
+				return err
+			}
+			quorumReached = int(count) == req.R.Wallet.R.Vault.Threshold
+			if quorumReached {
+				if err := enqueueEvent(ctx, exec, orgID, outbox.EventSigningRequestApproved, req, map[string]interface{}{
+					"approvals": count,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         params.UserID,
+			Action:         audit.ActionApproveTx,
+			ResourceType:   audit.ResourceTypeSigningRequest,
+			ResourceID:     requestID,
+			CredentialID:   params.CredentialID,
+			Details: map[string]interface{}{
+				"vault_id": req.VaultID.String,
+			},
+		})
+	}); err != nil {
 		return err
 	}
 
+	if quorumReached {
+		s.notification.NotifyRequestResolved(ctx, requestID)
+	}
+
 	// 4. Check Threshold
 	approvals, err := models.Approvals(
 		models.ApprovalWhere.RequestID.EQ(null.StringFrom(requestID)),
@@ -97,12 +265,12 @@ func (s *impl) ApproveRequest(ctx context.Context, requestID string, params Appr
 		return err
 	}
 
-	vault := req.R.Wallet.R.Vault
-	if vault == nil {
+	v := req.R.Wallet.R.Vault
+	if v == nil {
 		return fmt.Errorf("vault not found for wallet")
 	}
 
-	if len(approvals) >= vault.Threshold {
+	if len(approvals) >= v.Threshold {
 		// Trigger MPC
 		var authTokens []mpc.AuthToken
 		for _, app := range approvals {
@@ -125,6 +293,20 @@ func (s *impl) ApproveRequest(ctx context.Context, requestID string, params Appr
 			chainType = chain.Type
 		}
 
+		if err := s.node.CheckSigningNodes(ctx, wallet.KeyID); err != nil {
+			return err
+		}
+
+		// A refresh approved since the checks above must not re-share the key while it signs.
+		unlock, err := vault.LockKeyShares(ctx, s.db, wallet.KeyID, false)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		if err := checkKeyNotRefreshing(ctx, s.db, wallet.KeyID); err != nil {
+			return err
+		}
+
 		signature, err := s.signingClient.ThresholdSign(ctx, wallet.KeyID, req.TXData, chainType, authTokens)
 		if err != nil {
 			// Mark as failed? Or just return error?
@@ -135,7 +317,15 @@ func (s *impl) ApproveRequest(ctx context.Context, requestID string, params Appr
 		// Update Request
 		req.Status = null.StringFrom("completed")
 		req.Signature = null.StringFrom(signature)
-		if _, err := req.Update(ctx, s.db, boil.Infer()); err != nil {
+		if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+			if _, err := req.Update(ctx, exec, boil.Infer()); err != nil {
+				return err
+			}
+
+			return enqueueEvent(ctx, exec, orgID, outbox.EventSigningRequestSigned, req, map[string]interface{}{
+				"signature": signature,
+			})
+		}); err != nil {
 			return err
 		}
 	}
@@ -144,7 +334,10 @@ func (s *impl) ApproveRequest(ctx context.Context, requestID string, params Appr
 }
 
 func (s *impl) RejectRequest(ctx context.Context, requestID string, userID string) error {
-	req, err := models.FindSigningRequest(ctx, s.db, requestID)
+	req, err := models.SigningRequests(
+		models.SigningRequestWhere.ID.EQ(requestID),
+		qm.Load(models.SigningRequestRels.Vault),
+	).One(ctx, s.db)
 	if err != nil {
 		return err
 	}
@@ -153,33 +346,119 @@ func (s *impl) RejectRequest(ctx context.Context, requestID string, userID strin
 		return fmt.Errorf("request is not pending")
 	}
 
-	approval := &models.Approval{
-		ID:        uuid.New().String(),
-		RequestID: null.StringFrom(requestID),
-		UserID:    null.StringFrom(userID),
-		Action:    "reject",
-	}
-	if err := approval.Insert(ctx, s.db, boil.Infer()); err != nil {
-		return err
-	}
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		approval := &models.Approval{
+			ID:        uuid.New().String(),
+			RequestID: null.StringFrom(requestID),
+			UserID:    null.StringFrom(userID),
+			Action:    "reject",
+		}
+		if err := approval.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
 
-	req.Status = null.StringFrom("rejected")
-	if _, err := req.Update(ctx, s.db, boil.Infer()); err != nil {
+		req.Status = null.StringFrom("rejected")
+		if _, err := req.Update(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+
+		var orgID string
+		if req.R.Vault != nil {
+			orgID = req.R.Vault.OrganizationID.String
+		}
+		if err := enqueueEvent(ctx, exec, orgID, outbox.EventSigningRequestRejected, req, map[string]interface{}{
+			"rejected_by": userID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionRejectTx,
+			ResourceType:   audit.ResourceTypeSigningRequest,
+			ResourceID:     requestID,
+			Details: map[string]interface{}{
+				"vault_id": req.VaultID.String,
+			},
+		})
+	}); err != nil {
 		return err
 	}
 
+	s.notification.NotifyRequestResolved(ctx, requestID)
+
 	return nil
 }
 
+func (s *impl) MarkBroadcast(ctx context.Context, requestID string, userID string, txHash string) (*models.SigningRequest, error) {
+	var req *models.SigningRequest
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var err error
+		req, err = models.SigningRequests(
+			models.SigningRequestWhere.ID.EQ(requestID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to find signing request: %w", err)
+		}
+		if req.Status.String != "completed" {
+			return httperrors.ErrConflictSigningRequestNotSigned
+		}
+
+		req.Status = null.StringFrom("broadcast")
+		req.TXHash = null.StringFrom(txHash)
+		req.UpdatedAt = null.TimeFrom(s.clock.Now())
+		if _, err := req.Update(ctx, exec, boil.Whitelist(
+			models.SigningRequestColumns.Status,
+			models.SigningRequestColumns.TXHash,
+			models.SigningRequestColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update signing request: %w", err)
+		}
+
+		v, err := req.Vault().One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to find vault: %w", err)
+		}
+		if err := enqueueEvent(ctx, exec, v.OrganizationID.String, outbox.EventSigningRequestBroadcast, req, map[string]interface{}{
+			"tx_hash": txHash,
+		}); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: v.OrganizationID.String,
+			UserID:         userID,
+			Action:         audit.ActionBroadcastTx,
+			ResourceType:   audit.ResourceTypeSigningRequest,
+			ResourceID:     requestID,
+			Details: map[string]interface{}{
+				"vault_id": req.VaultID.String,
+				"tx_hash":  txHash,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (s *impl) GetRequest(ctx context.Context, requestID string) (*models.SigningRequest, error) {
 	return models.FindSigningRequest(ctx, s.db, requestID)
 }
 
-func (s *impl) ListRequests(ctx context.Context, userID string, vaultID string, status string, page int, limit int) (models.SigningRequestSlice, int64, error) {
+func (s *impl) ListRequests(ctx context.Context, userID string, orgID string, vaultID string, status string, page int, limit int) (models.SigningRequestSlice, int64, error) {
+	// Owners are not necessarily listed in organization_members, so both relations are considered.
 	mods := []qm.QueryMod{
 		qm.InnerJoin("vaults on vaults.id = signing_requests.vault_id"),
-		qm.InnerJoin("organization_members on organization_members.organization_id = vaults.organization_id"),
-		qm.Where("organization_members.user_id = ?", userID),
+		qm.Where(`vaults.organization_id IN (
+			SELECT id FROM organizations WHERE owner_id = ?
+			UNION
+			SELECT organization_id FROM organization_members WHERE user_id = ?)`, userID, userID),
+	}
+	if orgID != "" {
+		mods = append(mods, qm.Where("vaults.organization_id = ?", orgID))
 	}
 	if status != "" {
 		mods = append(mods, models.SigningRequestWhere.Status.EQ(null.StringFrom(status)))
@@ -208,3 +487,133 @@ func (s *impl) ListRequests(ctx context.Context, userID string, vaultID string,
 
 	return items, total, nil
 }
+
+// checkKeyNotFrozen refuses signing with keys whose share is being recovered.
+func checkKeyNotFrozen(ctx context.Context, exec boil.ContextExecutor, keyID string) error {
+	frozen, err := backup.KeyFrozen(ctx, exec, keyID)
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return httperrors.ErrConflictKeyRecoveryInProgress
+	}
+	return nil
+}
+
+// checkKeyNotRefreshing refuses signing with keys whose shares are being refreshed.
+func checkKeyNotRefreshing(ctx context.Context, exec boil.ContextExecutor, keyID string) error {
+	refreshing, err := vault.KeyRefreshing(ctx, exec, keyID)
+	if err != nil {
+		return err
+	}
+	if refreshing {
+		return httperrors.ErrConflictKeyRefreshInProgress
+	}
+	return nil
+}
+
+// transactionRecipient returns the recipient of the transaction, decoded from its data, and refuses destinations not
+// matching it. Chains whose transactions are not decoded fall back to the given destination, unless strict as a policy
+// depends on the recipient.
+func transactionRecipient(ctx context.Context, exec boil.ContextExecutor, wallet *models.Wallet, txData string, toAddress string, strict bool) (string, error) {
+	chainType := walletChainType(wallet)
+
+	recipient, err := decodeRecipient(ctx, exec, wallet, txData)
+	if err != nil {
+		if errors.Is(err, address.ErrUnsupportedTransaction) && !strict {
+			return toAddress, nil
+		}
+		if errors.Is(err, address.ErrInvalidTransaction) || errors.Is(err, address.ErrUnsupportedTransaction) {
+			return "", httperrors.ErrBadRequestInvalidTransaction
+		}
+		return "", err
+	}
+
+	destination, err := address.Normalize(chainType, toAddress)
+	if err != nil {
+		return "", httperrors.ErrBadRequestInvalidAddress
+	}
+	if destination != recipient {
+		return "", httperrors.ErrBadRequestTransactionRecipientMismatch
+	}
+
+	return recipient, nil
+}
+
+// checkKeyRetirement refuses signing with retired keys and restricts keys being retired to sweeps to a wallet of their
+// successor key on the same chain. The destination of a sweep is decoded from the transaction data, as only the
+// transaction is signed.
+func checkKeyRetirement(ctx context.Context, exec boil.ContextExecutor, wallet *models.Wallet, txData string) error {
+	key, err := models.FindRootKey(ctx, exec, wallet.KeyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to find root key: %w", err)
+	}
+
+	switch key.Status {
+	case vault.KeyStatusRetired:
+		return httperrors.ErrConflictKeyNotActive
+	case vault.KeyStatusRetiring:
+		chainType := walletChainType(wallet)
+		recipient, err := decodeRecipient(ctx, exec, wallet, txData)
+		if err != nil {
+			if errors.Is(err, address.ErrInvalidTransaction) || errors.Is(err, address.ErrUnsupportedTransaction) {
+				return httperrors.ErrBadRequestInvalidTransaction
+			}
+			return err
+		}
+
+		successors, err := models.Wallets(
+			models.WalletWhere.KeyID.EQ(key.SuccessorKeyID.String),
+			models.WalletWhere.ChainID.EQ(wallet.ChainID),
+		).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to list sweep destinations: %w", err)
+		}
+		for _, successor := range successors {
+			if addr, err := address.Normalize(chainType, successor.Address); err == nil && addr == recipient {
+				return nil
+			}
+		}
+		return httperrors.ErrBadRequestSweepDestinationRequired
+	}
+
+	return nil
+}
+
+// decodeRecipient returns the address receiving the funds of the transaction. The recipient within ERC-20 calldata
+// only counts for calls to an active asset contract of the wallet's chain, any other call is directed at the contract.
+func decodeRecipient(ctx context.Context, exec boil.ContextExecutor, wallet *models.Wallet, txData string) (string, error) {
+	transfer, err := address.DecodeTransfer(walletChainType(wallet), txData)
+	if err != nil {
+		return "", err
+	}
+	if transfer.TokenRecipient == "" || transfer.HasValue {
+		return transfer.To, nil
+	}
+
+	tokenContract, err := models.Assets(
+		models.AssetWhere.ChainID.EQ(wallet.ChainID),
+		models.AssetWhere.IsActive.EQ(null.BoolFrom(true)),
+		qm.Where("LOWER(assets.contract_address) = LOWER(?)", transfer.To),
+	).Exists(ctx, exec)
+	if err != nil {
+		return "", fmt.Errorf("failed to check token contract: %w", err)
+	}
+
+	return transfer.Recipient(tokenContract), nil
+}
+
+func walletChainType(wallet *models.Wallet) string {
+	if wallet.R != nil && wallet.R.Chain != nil {
+		return wallet.R.Chain.Type
+	}
+	return ""
+}
+
+// enqueueEvent writes the lifecycle event of the request to the outbox, extra is merged into the data of the event.
+func enqueueEvent(ctx context.Context, exec boil.ContextExecutor, orgID string, eventType string, req *models.SigningRequest, extra map[string]interface{}) error {
+	return outbox.Enqueue(ctx, exec, outbox.SigningRequestEvent(orgID, eventType, req, extra))
+}
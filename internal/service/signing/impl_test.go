@@ -0,0 +1,331 @@
+package signing_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc/fake"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/addressbook"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/service/signing"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transferTx is an unsigned EIP-155 transaction transferring 1 ETH to 0x52908400098527886E0F7030069857D2E4169EE7.
+const transferTx = "0xec098504a817c8008252089452908400098527886e0f7030069857d2e4169ee7880de0b6b3a764000080018080"
+
+// withWallet creates a vault with the given quorum threshold and an Ethereum wallet in it.
+func withWallet(t *testing.T, s *api.Server, threshold int) (*models.Vault, *models.Wallet) {
+	t.Helper()
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+	require.NoError(t, err)
+
+	v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, threshold, fix.User1.ID, []vault.KeyConfig{
+		{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+	})
+	require.NoError(t, err)
+
+	chain := &models.Chain{
+		ID:             "ETH",
+		Name:           "Ethereum",
+		Type:           "evm",
+		Algorithm:      mpc.AlgorithmECDSA,
+		Curve:          mpc.CurveSecp256k1,
+		CurrencySymbol: "ETH",
+		IsActive:       true,
+	}
+	require.NoError(t, chain.Insert(ctx, s.DB, boil.Infer()))
+
+	wallet, err := s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+	require.NoError(t, err)
+
+	return v, wallet
+}
+
+func TestApproveRequestSigns(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, wallet := withWallet(t, s, 2)
+
+		req, err := s.Signing.CreateRequest(ctx, signing.CreateRequestParams{
+			VaultID:   v.ID,
+			WalletID:  wallet.ID,
+			ToAddress: "0x52908400098527886E0F7030069857D2E4169EE7",
+			TxData:    transferTx,
+			UserID:    fix.User1.ID,
+		})
+		require.NoError(t, err)
+
+		// The request is signed once the quorum of the vault is reached.
+		require.NoError(t, s.Signing.ApproveRequest(ctx, req.ID, signing.ApprovalParams{UserID: fix.User1.ID}))
+		require.NoError(t, req.Reload(ctx, s.DB))
+		assert.Equal(t, "pending", req.Status.String)
+		assert.False(t, req.Signature.Valid)
+
+		require.NoError(t, s.Signing.ApproveRequest(ctx, req.ID, signing.ApprovalParams{UserID: fix.User2.ID}))
+		require.NoError(t, req.Reload(ctx, s.DB))
+		assert.Equal(t, "completed", req.Status.String)
+		require.True(t, req.Signature.Valid)
+
+		key, err := mpc.NewKeyClient(s.MpcConn).GetKey(ctx, wallet.KeyID)
+		require.NoError(t, err)
+		message, err := hex.DecodeString(strings.TrimPrefix(transferTx, "0x"))
+		require.NoError(t, err)
+		require.NoError(t, fake.Verify(key.Curve, key.PublicKey, message, req.Signature.String))
+	})
+}
+
+func TestApproveRequestTwice(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, wallet := withWallet(t, s, 2)
+
+		req, err := s.Signing.CreateRequest(ctx, signing.CreateRequestParams{
+			VaultID:   v.ID,
+			WalletID:  wallet.ID,
+			ToAddress: "0x52908400098527886E0F7030069857D2E4169EE7",
+			TxData:    transferTx,
+			UserID:    fix.User1.ID,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, s.Signing.ApproveRequest(ctx, req.ID, signing.ApprovalParams{UserID: fix.User1.ID}))
+		require.Error(t, s.Signing.ApproveRequest(ctx, req.ID, signing.ApprovalParams{UserID: fix.User1.ID}))
+
+		require.NoError(t, req.Reload(ctx, s.DB))
+		assert.Equal(t, "pending", req.Status.String)
+	})
+}
+
+func TestCreateRequestRecipient(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, wallet := withWallet(t, s, 1)
+
+		params := signing.CreateRequestParams{
+			VaultID:   v.ID,
+			WalletID:  wallet.ID,
+			ToAddress: "0x52908400098527886E0F7030069857D2E4169EE7",
+			TxData:    "0xdeadbeef",
+			UserID:    fix.User1.ID,
+		}
+		_, err := s.Signing.CreateRequest(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestInvalidTransaction)
+
+		// The destination has to be the recipient of the transaction.
+		params.TxData = transferTx
+		params.ToAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+		_, err = s.Signing.CreateRequest(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestTransactionRecipientMismatch)
+
+		params.ToAddress = "0x52908400098527886e0f7030069857d2e4169ee7"
+		req, err := s.Signing.CreateRequest(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", req.Status.String)
+	})
+}
+
+// tokenTransferTx returns an unsigned legacy transaction to the contract calling transfer(recipient, 100) with the
+// given native value.
+func tokenTransferTx(contract string, recipient string, value byte) string {
+	valueField := "80"
+	if value != 0 {
+		valueField = hex.EncodeToString([]byte{value})
+	}
+	data := "a9059cbb" + strings.Repeat("0", 24) + strings.ToLower(strings.TrimPrefix(recipient, "0x")) + strings.Repeat("0", 62) + "64"
+	return "0xf861800182520894" + strings.ToLower(strings.TrimPrefix(contract, "0x")) + valueField + "b844" + data
+}
+
+func TestCreateRequestTokenRecipient(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, wallet := withWallet(t, s, 1)
+
+		v.WhitelistOnly = true
+		_, err := v.Update(ctx, s.DB, boil.Whitelist(models.VaultColumns.WhitelistOnly))
+		require.NoError(t, err)
+
+		contract := "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+		recipient := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+		entry := &models.AddressBook{
+			ID:             uuid.New().String(),
+			OrganizationID: v.OrganizationID,
+			ChainID:        null.StringFrom(wallet.ChainID.String),
+			Address:        recipient,
+			Name:           "Exchange",
+			IsWhitelisted:  null.BoolFrom(true),
+			Status:         addressbook.EntryStatusActive,
+		}
+		require.NoError(t, entry.Insert(ctx, s.DB, boil.Infer()))
+
+		params := signing.CreateRequestParams{
+			VaultID:   v.ID,
+			WalletID:  wallet.ID,
+			ToAddress: recipient,
+			TxData:    tokenTransferTx(contract, recipient, 0),
+			UserID:    fix.User1.ID,
+		}
+
+		// The transfer calldata of an unknown contract does not direct funds to the whitelisted recipient.
+		_, err = s.Signing.CreateRequest(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestTransactionRecipientMismatch)
+		params.ToAddress = contract
+		_, err = s.Signing.CreateRequest(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenDestinationNotWhitelisted)
+
+		asset := &models.Asset{
+			ChainID:         wallet.ChainID,
+			Symbol:          "USDT",
+			Name:            "Tether USD",
+			Type:            "ERC20",
+			ContractAddress: null.StringFrom(contract),
+			Decimals:        6,
+			IsActive:        null.BoolFrom(true),
+		}
+		require.NoError(t, asset.Insert(ctx, s.DB, boil.Infer()))
+
+		// Native value sent along with the calldata goes to the contract.
+		params.ToAddress = recipient
+		params.TxData = tokenTransferTx(contract, recipient, 1)
+		_, err = s.Signing.CreateRequest(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestTransactionRecipientMismatch)
+
+		params.TxData = tokenTransferTx(contract, recipient, 0)
+		req, err := s.Signing.CreateRequest(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", req.Status.String)
+	})
+}
+
+func TestCreateRequestSweepOfRetiringKey(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, wallet := withWallet(t, s, 1)
+
+		successor, err := s.Vault.CreateWallet(ctx, v.ID, wallet.ChainID.String, fix.User1.ID)
+		require.NoError(t, err)
+		record := &models.RootKey{
+			KeyID:          wallet.KeyID,
+			VaultID:        v.ID,
+			Tags:           []byte("{}"),
+			Status:         vault.KeyStatusRetiring,
+			SuccessorKeyID: null.StringFrom(successor.KeyID),
+			RetiringAt:     null.TimeFrom(time.Now()),
+		}
+		require.NoError(t, record.Insert(ctx, s.DB, boil.Infer()))
+
+		contract := "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+		params := signing.CreateRequestParams{
+			VaultID:   v.ID,
+			WalletID:  wallet.ID,
+			ToAddress: successor.Address,
+			TxData:    tokenTransferTx(contract, successor.Address, 0),
+			UserID:    fix.User1.ID,
+		}
+
+		// Transfer calldata of an unknown contract does not make a sweep to the successor.
+		_, err = s.Signing.CreateRequest(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestSweepDestinationRequired)
+
+		asset := &models.Asset{
+			ChainID:         wallet.ChainID,
+			Symbol:          "USDT",
+			Name:            "Tether USD",
+			Type:            "ERC20",
+			ContractAddress: null.StringFrom(contract),
+			Decimals:        6,
+			IsActive:        null.BoolFrom(true),
+		}
+		require.NoError(t, asset.Insert(ctx, s.DB, boil.Infer()))
+
+		params.TxData = tokenTransferTx(contract, successor.Address, 1)
+		_, err = s.Signing.CreateRequest(ctx, params)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestSweepDestinationRequired)
+
+		params.TxData = tokenTransferTx(contract, successor.Address, 0)
+		_, err = s.Signing.CreateRequest(ctx, params)
+		require.NoError(t, err)
+	})
+}
+
+func TestSigningRequestLifecycleEvents(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		v, wallet := withWallet(t, s, 1)
+
+		create := func() *models.SigningRequest {
+			t.Helper()
+			req, err := s.Signing.CreateRequest(ctx, signing.CreateRequestParams{
+				VaultID:   v.ID,
+				WalletID:  wallet.ID,
+				ToAddress: "0x52908400098527886E0F7030069857D2E4169EE7",
+				TxData:    transferTx,
+				UserID:    fix.User1.ID,
+			})
+			require.NoError(t, err)
+			return req
+		}
+		events := func(requestID string) []string {
+			t.Helper()
+			entries, err := models.OutboxEvents(
+				models.OutboxEventWhere.ResourceID.EQ(requestID),
+				qm.OrderBy(models.OutboxEventColumns.CreatedAt+" ASC"),
+			).All(ctx, s.DB)
+			require.NoError(t, err)
+			types := make([]string, 0, len(entries))
+			for _, e := range entries {
+				types = append(types, e.EventType)
+			}
+			return types
+		}
+
+		// Only signed transactions are broadcast, and only once.
+		req := create()
+		_, err := s.Signing.MarkBroadcast(ctx, req.ID, fix.User1.ID, "0xabc")
+		require.ErrorIs(t, err, httperrors.ErrConflictSigningRequestNotSigned)
+		require.NoError(t, s.Signing.ApproveRequest(ctx, req.ID, signing.ApprovalParams{UserID: fix.User1.ID}))
+		broadcast, err := s.Signing.MarkBroadcast(ctx, req.ID, fix.User1.ID, "0xabc")
+		require.NoError(t, err)
+		assert.Equal(t, "broadcast", broadcast.Status.String)
+		assert.Equal(t, "0xabc", broadcast.TXHash.String)
+		_, err = s.Signing.MarkBroadcast(ctx, req.ID, fix.User1.ID, "0xdef")
+		require.ErrorIs(t, err, httperrors.ErrConflictSigningRequestNotSigned)
+		assert.Equal(t, []string{
+			outbox.EventSigningRequestCreated,
+			outbox.EventSigningRequestApproved,
+			outbox.EventSigningRequestSigned,
+			outbox.EventSigningRequestBroadcast,
+		}, events(req.ID))
+
+		// Requests still pending once the vault is archived are cancelled.
+		pending := create()
+		_, err = s.Vault.ArchiveVault(ctx, v.ID, fix.User1.ID)
+		require.NoError(t, err)
+		require.NoError(t, pending.Reload(ctx, s.DB))
+		assert.Equal(t, "cancelled", pending.Status.String)
+		assert.Equal(t, []string{outbox.EventSigningRequestCreated, outbox.EventSigningRequestCancelled}, events(pending.ID))
+	})
+}
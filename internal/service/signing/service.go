@@ -14,10 +14,27 @@ type ApprovalParams struct {
 	ClientDataJSON    []byte
 }
 
+type CreateRequestParams struct {
+	VaultID   string
+	WalletID  string
+	ToAddress string
+	TxData    string
+	Note      string
+	UserID    string
+}
+
 type Service interface {
-	CreateRequest(ctx context.Context, walletID string, txData string, note string, userID string) (*models.SigningRequest, error)
+	// CreateRequest creates a pending signing request. The destination must match the recipient decoded from
+	// the transaction data. Vaults in whitelist-only mode reject recipients which are not usable entries of the
+	// organization's address book.
+	CreateRequest(ctx context.Context, params CreateRequestParams) (*models.SigningRequest, error)
 	ApproveRequest(ctx context.Context, requestID string, params ApprovalParams) error
 	RejectRequest(ctx context.Context, requestID string, userID string) error
+	// MarkBroadcast records the hash of the signed transaction of the request once it was broadcast to its chain. It
+	// returns httperrors.ErrConflictSigningRequestNotSigned unless the request was signed and not yet broadcast.
+	MarkBroadcast(ctx context.Context, requestID string, userID string, txHash string) (*models.SigningRequest, error)
 	GetRequest(ctx context.Context, requestID string) (*models.SigningRequest, error)
-	ListRequests(ctx context.Context, userID string, vaultID string, status string, page int, limit int) (models.SigningRequestSlice, int64, error)
+	// ListRequests returns the requests of all vaults within organizations the user belongs to, optionally
+	// narrowed down to a single organization and/or vault.
+	ListRequests(ctx context.Context, userID string, orgID string, vaultID string, status string, page int, limit int) (models.SigningRequestSlice, int64, error)
 }
@@ -3,48 +3,242 @@ package vault
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
 	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
 	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/audit"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/service/organization"
+	"github.com/kashguard/go-mpc-vault/internal/service/outbox"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/db"
 )
 
 type impl struct {
 	db        *sql.DB
 	keyClient *mpc.KeyClient
+	passkeys  auth.AssertionVerifier
 }
 
 //nolint:ireturn
-func NewService(db *sql.DB, keyClient *mpc.KeyClient) Service {
+func NewService(db *sql.DB, keyClient *mpc.KeyClient, passkeys auth.AssertionVerifier) Service {
 	return &impl{
 		db:        db,
 		keyClient: keyClient,
+		passkeys:  passkeys,
 	}
 }
 
-func (s *impl) CreateVault(ctx context.Context, name string, orgID string) (*models.Vault, error) {
+// thresholdChangePayload is stored as payload of a threshold_change proposal.
+type thresholdChangePayload struct {
+	Threshold int `json:"threshold"`
+}
+
+// keyRefreshPayload is stored as payload of a key_refresh proposal.
+type keyRefreshPayload struct {
+	KeyID   string `json:"key_id"`
+	Trigger string `json:"trigger"`
+}
+
+// keyRetirementPayload is stored as payload of a key_retirement and a key_retirement_completion proposal.
+type keyRetirementPayload struct {
+	KeyID          string `json:"key_id"`
+	SuccessorKeyID string `json:"successor_key_id"`
+}
+
+func (s *impl) CreateVault(ctx context.Context, name string, orgID string, threshold int, userID string, keys []KeyConfig) (*models.Vault, error) {
+	if threshold == 0 {
+		threshold = DefaultThreshold
+	}
+	if threshold < 1 {
+		return nil, httperrors.ErrBadRequestInvalidThreshold
+	}
+
+	specs, err := keySpecs(keys)
+	if err != nil {
+		return nil, err
+	}
+
 	vault := &models.Vault{
 		Name:           name,
 		OrganizationID: null.StringFrom(orgID),
-		Threshold:      2, // Default
+		Threshold:      threshold,
+		Status:         StatusActive,
 	}
 
-	if err := vault.Insert(ctx, s.db, boil.Infer()); err != nil {
-		return nil, fmt.Errorf("failed to insert vault: %w", err)
+	// The DKG takes place outside of the transaction, keys are deleted again if the vault is not stored.
+	vaultKeys, err := s.createKeys(ctx, specs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if err := vault.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert vault: %w", err)
+		}
+
+		keyDetails := make([]map[string]interface{}, 0, len(vaultKeys))
+		for _, key := range vaultKeys {
+			key.VaultID = null.StringFrom(vault.ID)
+			if err := key.Insert(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("failed to insert vault key: %w", err)
+			}
+			keyDetails = append(keyDetails, map[string]interface{}{
+				"key_id":      key.KeyID,
+				"curve":       key.Curve,
+				"protocol":    key.Protocol.String,
+				"threshold":   key.Threshold.Int,
+				"total_nodes": key.TotalNodes.Int,
+			})
+		}
+
+		if err := enqueueEvent(ctx, exec, outbox.EventVaultCreated, vault, nil); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: orgID,
+			UserID:         userID,
+			Action:         audit.ActionCreateVault,
+			ResourceType:   audit.ResourceTypeVault,
+			ResourceID:     vault.ID,
+			Details: map[string]interface{}{
+				"name":      name,
+				"threshold": threshold,
+				"keys":      keyDetails,
+			},
+		})
+	}); err != nil {
+		s.deleteKeys(ctx, vaultKeys)
+		return nil, err
 	}
 
 	return vault, nil
 }
 
-func (s *impl) CreateWallet(ctx context.Context, vaultID string, chainID string) (*models.Wallet, error) {
-	// 1. Check if chain exists
+// keySpecs validates the key configs of a vault, every protocol has to support its curve and each curve may be
+// configured once.
+func keySpecs(keys []KeyConfig) ([]mpc.KeySpec, error) {
+	specs := make([]mpc.KeySpec, 0, len(keys))
+	curves := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		algorithm, ok := mpc.Algorithm(key.Protocol, key.Curve)
+		if !ok {
+			return nil, httperrors.ErrBadRequestInvalidMpcProtocol
+		}
+		if key.Threshold != 0 && key.TotalNodes != 0 && key.Threshold > key.TotalNodes {
+			return nil, httperrors.ErrBadRequestInvalidKeyThreshold
+		}
+		if _, ok := curves[key.Curve]; ok {
+			return nil, httperrors.ErrBadRequestDuplicateKeyCurve
+		}
+		curves[key.Curve] = struct{}{}
+
+		specs = append(specs, mpc.KeySpec{
+			Algorithm:  algorithm,
+			Curve:      key.Curve,
+			Protocol:   key.Protocol,
+			Threshold:  key.Threshold,
+			TotalNodes: key.TotalNodes,
+		})
+	}
+
+	return specs, nil
+}
+
+// createKeys runs the DKG of every spec, returning the vault keys to store. Keys already generated are deleted if one
+// fails or does not match its spec.
+func (s *impl) createKeys(ctx context.Context, specs []mpc.KeySpec) (models.VaultKeySlice, error) {
+	vaultKeys := make(models.VaultKeySlice, 0, len(specs))
+	for _, spec := range specs {
+		key, err := s.createKey(ctx, uuid.New().String(), spec)
+		if err != nil {
+			s.deleteKeys(ctx, vaultKeys)
+			return nil, err
+		}
+
+		vaultKeys = append(vaultKeys, &models.VaultKey{
+			KeyID:        key.KeyID,
+			Algorithm:    spec.Algorithm,
+			Curve:        spec.Curve,
+			PublicKeyHex: key.PublicKey,
+			Protocol:     null.StringFrom(key.Protocol),
+			Threshold:    null.IntFrom(key.Threshold),
+			TotalNodes:   null.IntFrom(key.TotalNodes),
+		})
+	}
+
+	return vaultKeys, nil
+}
+
+// createKey runs the DKG of the key and checks the MPC server created it as specified, deleting it otherwise.
+func (s *impl) createKey(ctx context.Context, keyID string, spec mpc.KeySpec) (*mpc.KeyInfo, error) {
+	key, err := s.keyClient.CreateKey(ctx, keyID, spec)
+	if err != nil {
+		return nil, fmt.Errorf("mpc key generation failed: %w", err)
+	}
+
+	if err := mpc.CheckKey(key, spec); err != nil {
+		util.LogFromContext(ctx).Error().Err(err).Str("key_id", keyID).Msg("MPC server created key not matching its spec")
+		if err := s.keyClient.DeleteKey(ctx, keyID); err != nil {
+			util.LogFromContext(ctx).Warn().Err(err).Str("key_id", keyID).Msg("Failed to delete mismatching key from MPC server")
+		}
+		return nil, httperrors.ErrBadGatewayKeySpecMismatch
+	}
+
+	// The MPC server might report the ID it generated if none was requested.
+	if key.KeyID == "" {
+		key.KeyID = keyID
+	}
+
+	return key, nil
+}
+
+// deleteKeys deletes keys of a vault which was not stored from the MPC server.
+func (s *impl) deleteKeys(ctx context.Context, vaultKeys models.VaultKeySlice) {
+	for _, key := range vaultKeys {
+		s.deleteKey(ctx, key.KeyID)
+	}
+}
+
+// deleteKey deletes a key which was not stored from the MPC server, failures are left to the key consistency check to
+// report.
+func (s *impl) deleteKey(ctx context.Context, keyID string) {
+	if err := s.keyClient.DeleteKey(ctx, keyID); err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Str("key_id", keyID).Msg("Failed to delete key not stored from MPC server")
+	}
+}
+
+func (s *impl) CreateWallet(ctx context.Context, vaultID string, chainID string, userID string) (*models.Wallet, error) {
+	vault, err := models.FindVault(ctx, s.db, vaultID)
+	if err != nil {
+		return nil, fmt.Errorf("vault not found: %w", err)
+	}
+	if vault.Status == StatusArchived {
+		return nil, httperrors.ErrConflictVaultArchived
+	}
+
+	// 1. Check if chain exists and is still supported
 	chain, err := models.Chains(models.ChainWhere.ID.EQ(chainID)).One(ctx, s.db)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrBadRequestUnknownChain
+		}
 		return nil, fmt.Errorf("chain not found: %w", err)
 	}
+	if !chain.IsActive {
+		return nil, httperrors.ErrBadRequestChainInactive
+	}
 
 	// 2. Generate ID
 	walletID := uuid.New().String()
@@ -52,17 +246,36 @@ func (s *impl) CreateWallet(ctx context.Context, vaultID string, chainID string)
 	// 3. Call MPC to generate key
 	// Use wallet ID as Key ID
 	// Determine algo/curve based on chain
-	algo := "ECDSA"
-	curve := "secp256k1"
-	if chain.Curve == "ed25519" { // Assuming Chain has Curve field or similar
-		curve = "ed25519"
-		algo = "EdDSA"
+	spec := mpc.KeySpec{
+		Algorithm: mpc.AlgorithmECDSA,
+		Curve:     mpc.CurveSecp256k1,
+	}
+	if chain.Curve == mpc.CurveEd25519 {
+		spec.Algorithm = mpc.AlgorithmEdDSA
+		spec.Curve = mpc.CurveEd25519
+	}
+
+	// Wallet keys are generated with the configuration of the key of the vault on their curve, if any.
+	vaultKey, err := models.VaultKeys(
+		models.VaultKeyWhere.VaultID.EQ(null.StringFrom(vaultID)),
+		models.VaultKeyWhere.Algorithm.EQ(spec.Algorithm),
+		models.VaultKeyWhere.Curve.EQ(spec.Curve),
+	).One(ctx, s.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to find vault key: %w", err)
+	}
+	if vaultKey != nil {
+		spec.Protocol = vaultKey.Protocol.String
+		spec.Threshold = vaultKey.Threshold.Int
+		spec.TotalNodes = vaultKey.TotalNodes.Int
 	}
 
-	pubKey, err := s.keyClient.CreateKey(ctx, walletID, algo, curve)
+	// The DKG takes place outside of the transaction, the key is deleted again if the wallet is not stored.
+	key, err := s.createKey(ctx, walletID, spec)
 	if err != nil {
-		return nil, fmt.Errorf("mpc key generation failed: %w", err)
+		return nil, err
 	}
+	pubKey := key.PublicKey
 
 	// 4. Create Wallet record
 	wallet := &models.Wallet{
@@ -75,15 +288,1076 @@ func (s *impl) CreateWallet(ctx context.Context, vaultID string, chainID string)
 		DeriveIndex: 0,
 	}
 
-	if err := wallet.Insert(ctx, s.db, boil.Infer()); err != nil {
-		return nil, fmt.Errorf("failed to insert wallet: %w", err)
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		// The vault might have been archived during the DKG.
+		vault, err := models.Vaults(
+			models.VaultWhere.ID.EQ(vaultID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+		if vault.Status == StatusArchived {
+			return httperrors.ErrConflictVaultArchived
+		}
+
+		if err := wallet.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert wallet: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: vault.OrganizationID.String,
+			UserID:         userID,
+			Action:         audit.ActionCreateWallet,
+			ResourceType:   audit.ResourceTypeWallet,
+			ResourceID:     wallet.ID,
+			Details: map[string]interface{}{
+				"vault_id": vaultID,
+				"chain_id": chainID,
+				"address":  wallet.Address,
+			},
+		})
+	}); err != nil {
+		s.deleteKey(ctx, key.KeyID)
+		return nil, err
 	}
 
 	return wallet, nil
 }
 
+func (s *impl) ListVaults(ctx context.Context, orgID string, page int, limit int) (models.VaultSlice, int64, error) {
+	mods := []qm.QueryMod{
+		models.VaultWhere.OrganizationID.EQ(null.StringFrom(orgID)),
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * limit
+
+	total, err := models.Vaults(mods...).Count(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count vaults: %w", err)
+	}
+
+	mods = append(mods,
+		qm.Load(models.VaultRels.Wallets),
+		qm.Load(models.VaultRels.VaultKeys),
+		qm.OrderBy(models.VaultColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	)
+	vaults, err := models.Vaults(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vaults: %w", err)
+	}
+
+	return vaults, total, nil
+}
+
+func (s *impl) GetVault(ctx context.Context, vaultID string) (*models.Vault, error) {
+	vault, err := models.Vaults(
+		models.VaultWhere.ID.EQ(vaultID),
+		qm.Load(models.VaultRels.Wallets),
+		qm.Load(models.VaultRels.VaultKeys),
+		qm.Load(models.VaultRels.VaultProposals, models.VaultProposalWhere.Status.EQ(ProposalStatusPending)),
+	).One(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("vault not found: %w", err)
+	}
+
+	return vault, nil
+}
+
+func (s *impl) GetVaultMemberRole(ctx context.Context, vaultID string, userID string) (*models.Vault, string, error) {
+	vault, err := models.FindVault(ctx, s.db, vaultID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", httperrors.ErrNotFoundVault
+		}
+		return nil, "", fmt.Errorf("failed to find vault: %w", err)
+	}
+	if !vault.OrganizationID.Valid {
+		return nil, "", httperrors.ErrForbiddenNotOrganizationMember
+	}
+
+	role, err := organization.MemberRole(ctx, s.db, vault.OrganizationID.String, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return vault, role, nil
+}
+
+func (s *impl) RenameVault(ctx context.Context, vaultID string, name string, userID string) (*models.Vault, error) {
+	var vault *models.Vault
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var err error
+		vault, err = models.FindVault(ctx, exec, vaultID)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+		if vault.Status == StatusArchived {
+			return httperrors.ErrConflictVaultArchived
+		}
+
+		previousName := vault.Name
+		vault.Name = name
+		vault.UpdatedAt = null.TimeFrom(time.Now())
+		if _, err := vault.Update(ctx, exec, boil.Whitelist(models.VaultColumns.Name, models.VaultColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("failed to update vault: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: vault.OrganizationID.String,
+			UserID:         userID,
+			Action:         audit.ActionRenameVault,
+			ResourceType:   audit.ResourceTypeVault,
+			ResourceID:     vaultID,
+			Details: map[string]interface{}{
+				"previous_name": previousName,
+				"name":          name,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return vault, nil
+}
+
+func (s *impl) SetWhitelistOnly(ctx context.Context, vaultID string, whitelistOnly bool, userID string) (*models.Vault, error) {
+	var vault *models.Vault
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var err error
+		vault, err = models.FindVault(ctx, exec, vaultID)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+		if vault.Status == StatusArchived {
+			return httperrors.ErrConflictVaultArchived
+		}
+
+		vault.WhitelistOnly = whitelistOnly
+		vault.UpdatedAt = null.TimeFrom(time.Now())
+		if _, err := vault.Update(ctx, exec, boil.Whitelist(models.VaultColumns.WhitelistOnly, models.VaultColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("failed to update vault: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: vault.OrganizationID.String,
+			UserID:         userID,
+			Action:         audit.ActionModifyPolicy,
+			ResourceType:   audit.ResourceTypeVault,
+			ResourceID:     vaultID,
+			Details: map[string]interface{}{
+				"whitelist_only": whitelistOnly,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return vault, nil
+}
+
+// ArchiveVault freezes the vault: no new wallets, signing requests or proposals are accepted,
+// while wallets, requests and approvals stay in place as history.
+func (s *impl) ArchiveVault(ctx context.Context, vaultID string, userID string) (*models.Vault, error) {
+	var vault *models.Vault
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var err error
+		vault, err = models.Vaults(
+			models.VaultWhere.ID.EQ(vaultID),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("vault not found: %w", err)
+		}
+		if vault.Status == StatusArchived {
+			return httperrors.ErrConflictVaultArchived
+		}
+
+		now := time.Now()
+		vault.Status = StatusArchived
+		vault.ArchivedAt = null.TimeFrom(now)
+		vault.UpdatedAt = null.TimeFrom(now)
+		if _, err := vault.Update(ctx, exec, boil.Whitelist(models.VaultColumns.Status, models.VaultColumns.ArchivedAt, models.VaultColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("failed to archive vault: %w", err)
+		}
+
+		if _, err := models.VaultProposals(
+			models.VaultProposalWhere.VaultID.EQ(vaultID),
+			models.VaultProposalWhere.Status.EQ(ProposalStatusPending),
+		).UpdateAll(ctx, exec, models.M{
+			models.VaultProposalColumns.Status:    ProposalStatusCancelled,
+			models.VaultProposalColumns.UpdatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("failed to cancel pending proposals: %w", err)
+		}
+
+		requests, err := models.SigningRequests(
+			models.SigningRequestWhere.VaultID.EQ(null.StringFrom(vaultID)),
+			models.SigningRequestWhere.Status.EQ(null.StringFrom("pending")),
+		).All(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to list pending signing requests: %w", err)
+		}
+		if _, err := requests.UpdateAll(ctx, exec, models.M{
+			models.SigningRequestColumns.Status:    "cancelled",
+			models.SigningRequestColumns.UpdatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("failed to cancel pending signing requests: %w", err)
+		}
+		for _, req := range requests {
+			req.Status = null.StringFrom("cancelled")
+			if err := outbox.Enqueue(ctx, exec, outbox.SigningRequestEvent(vault.OrganizationID.String, outbox.EventSigningRequestCancelled, req, map[string]interface{}{
+				"reason": "vault archived",
+			})); err != nil {
+				return err
+			}
+		}
+
+		if err := enqueueEvent(ctx, exec, outbox.EventVaultArchived, vault, nil); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: vault.OrganizationID.String,
+			UserID:         userID,
+			Action:         audit.ActionArchiveVault,
+			ResourceType:   audit.ResourceTypeVault,
+			ResourceID:     vaultID,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return vault, nil
+}
+
+func (s *impl) ProposeThresholdChange(ctx context.Context, vaultID string, userID string, threshold int) (*models.VaultProposal, error) {
+	var proposal *models.VaultProposal
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		vault, approvers, err := LockProposalVault(ctx, exec, vaultID, userID)
+		if err != nil {
+			return err
+		}
+		if threshold < 1 || threshold > len(approvers) || threshold == vault.Threshold {
+			return httperrors.ErrBadRequestInvalidThreshold
+		}
+
+		pending, err := models.VaultProposals(
+			models.VaultProposalWhere.VaultID.EQ(vaultID),
+			models.VaultProposalWhere.Kind.EQ(ProposalKindThresholdChange),
+			models.VaultProposalWhere.Status.EQ(ProposalStatusPending),
+		).Exists(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to check pending proposals: %w", err)
+		}
+		if pending {
+			return httperrors.ErrConflictThresholdChangePending
+		}
+
+		proposal, err = openProposal(ctx, exec, vault, approvers, userID, ProposalKindThresholdChange, thresholdChangePayload{Threshold: threshold},
+			audit.ActionProposeThreshold, map[string]interface{}{
+				"current_threshold":  vault.Threshold,
+				"proposed_threshold": threshold,
+			})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (s *impl) ProposeKeyRetirement(ctx context.Context, vaultID string, userID string, keyID string, successorKeyID string) (*models.VaultProposal, error) {
+	var proposal *models.VaultProposal
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		vault, approvers, err := LockProposalVault(ctx, exec, vaultID, userID)
+		if err != nil {
+			return err
+		}
+
+		keyIDs, err := KeyIDs(ctx, exec, vaultID)
+		if err != nil {
+			return err
+		}
+		if _, ok := keyIDs[keyID]; !ok {
+			return httperrors.ErrNotFoundKey
+		}
+		if _, ok := keyIDs[successorKeyID]; !ok || successorKeyID == keyID {
+			return httperrors.ErrBadRequestInvalidSuccessorKey
+		}
+
+		status, err := KeyStatus(ctx, exec, keyID)
+		if err != nil {
+			return err
+		}
+		if status != KeyStatusActive {
+			return httperrors.ErrConflictKeyNotActive
+		}
+		successorStatus, err := KeyStatus(ctx, exec, successorKeyID)
+		if err != nil {
+			return err
+		}
+		if successorStatus != KeyStatusActive {
+			return httperrors.ErrBadRequestInvalidSuccessorKey
+		}
+
+		pending, err := models.VaultProposals(
+			models.VaultProposalWhere.VaultID.EQ(vaultID),
+			models.VaultProposalWhere.Kind.EQ(ProposalKindKeyRetirement),
+			models.VaultProposalWhere.Status.EQ(ProposalStatusPending),
+			qm.Where(models.VaultProposalColumns.Payload+"->>'key_id' = ?", keyID),
+		).Exists(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to check pending proposals: %w", err)
+		}
+		if pending {
+			return httperrors.ErrConflictKeyRetirementPending
+		}
+
+		proposal, err = openProposal(ctx, exec, vault, approvers, userID, ProposalKindKeyRetirement, keyRetirementPayload{KeyID: keyID, SuccessorKeyID: successorKeyID},
+			audit.ActionProposeKeyRetire, map[string]interface{}{
+				"key_id":           keyID,
+				"successor_key_id": successorKeyID,
+			})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (s *impl) ProposeKeyRetirementCompletion(ctx context.Context, vaultID string, userID string, keyID string) (*models.VaultProposal, error) {
+	var proposal *models.VaultProposal
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		vault, approvers, err := LockProposalVault(ctx, exec, vaultID, userID)
+		if err != nil {
+			return err
+		}
+
+		key, err := models.RootKeys(
+			models.RootKeyWhere.KeyID.EQ(keyID),
+			models.RootKeyWhere.VaultID.EQ(vaultID),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to find root key: %w", err)
+		}
+		if key == nil {
+			keyIDs, err := KeyIDs(ctx, exec, vaultID)
+			if err != nil {
+				return err
+			}
+			if _, ok := keyIDs[keyID]; !ok {
+				return httperrors.ErrNotFoundKey
+			}
+			return httperrors.ErrConflictKeyNotRetiring
+		}
+		if key.Status != KeyStatusRetiring {
+			return httperrors.ErrConflictKeyNotRetiring
+		}
+
+		pending, err := models.VaultProposals(
+			models.VaultProposalWhere.VaultID.EQ(vaultID),
+			models.VaultProposalWhere.Kind.EQ(ProposalKindKeyRetirementCompletion),
+			models.VaultProposalWhere.Status.EQ(ProposalStatusPending),
+			qm.Where(models.VaultProposalColumns.Payload+"->>'key_id' = ?", keyID),
+		).Exists(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to check pending proposals: %w", err)
+		}
+		if pending || key.CompletionProposalID.Valid {
+			return httperrors.ErrConflictKeyRetirementPending
+		}
+
+		funded, err := KeyHoldsFunds(ctx, exec, keyID)
+		if err != nil {
+			return err
+		}
+		if funded {
+			return httperrors.ErrConflictKeyFundsNotSwept
+		}
+
+		proposal, err = openProposal(ctx, exec, vault, approvers, userID, ProposalKindKeyRetirementCompletion, keyRetirementPayload{KeyID: keyID, SuccessorKeyID: key.SuccessorKeyID.String},
+			audit.ActionProposeKeyRetireCompletion, map[string]interface{}{
+				"key_id":           keyID,
+				"successor_key_id": key.SuccessorKeyID.String,
+			})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (s *impl) ProposeKeyRefresh(ctx context.Context, vaultID string, userID string, keyID string) (*models.VaultProposal, error) {
+	var proposal *models.VaultProposal
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		vault, approvers, err := LockProposalVault(ctx, exec, vaultID, userID)
+		if err != nil {
+			return err
+		}
+
+		proposal, err = OpenKeyRefreshProposal(ctx, exec, vault, approvers, keyID, userID, RefreshTriggerManual)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// OpenKeyRefreshProposal opens a proposal to refresh the shares of the key of the vault, which has to be locked by
+// LockProposalVault. The initiator is empty for scheduled refreshes.
+func OpenKeyRefreshProposal(ctx context.Context, exec boil.ContextExecutor, vault *models.Vault, approvers map[string]struct{}, keyID string, initiatorID string, trigger string) (*models.VaultProposal, error) {
+	// Only the keys of the vault itself record the epoch of their shares.
+	exists, err := models.VaultKeys(
+		models.VaultKeyWhere.VaultID.EQ(null.StringFrom(vault.ID)),
+		models.VaultKeyWhere.KeyID.EQ(keyID),
+	).Exists(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check vault key: %w", err)
+	}
+	if !exists {
+		return nil, httperrors.ErrNotFoundKey
+	}
+
+	status, err := KeyStatus(ctx, exec, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if status != KeyStatusActive {
+		return nil, httperrors.ErrConflictKeyNotActive
+	}
+
+	pending, err := models.VaultProposals(
+		models.VaultProposalWhere.VaultID.EQ(vault.ID),
+		models.VaultProposalWhere.Kind.EQ(ProposalKindKeyRefresh),
+		models.VaultProposalWhere.Status.EQ(ProposalStatusPending),
+		qm.Where(models.VaultProposalColumns.Payload+"->>'key_id' = ?", keyID),
+	).Exists(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending proposals: %w", err)
+	}
+	refreshing, err := KeyRefreshing(ctx, exec, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if pending || refreshing {
+		return nil, httperrors.ErrConflictKeyRefreshPending
+	}
+
+	return openProposal(ctx, exec, vault, approvers, initiatorID, ProposalKindKeyRefresh, keyRefreshPayload{KeyID: keyID, Trigger: trigger},
+		audit.ActionProposeKeyRefresh, map[string]interface{}{
+			"key_id":  keyID,
+			"trigger": trigger,
+		})
+}
+
+// LockProposalVault locks the vault for update to open a proposal on it and returns it along with its eligible
+// approvers. Archived vaults are refused, as are initiators not eligible to approve; the initiator is empty for
+// scheduled proposals.
+func LockProposalVault(ctx context.Context, exec boil.ContextExecutor, vaultID string, initiatorID string) (*models.Vault, map[string]struct{}, error) {
+	vault, err := models.Vaults(
+		models.VaultWhere.ID.EQ(vaultID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("vault not found: %w", err)
+	}
+	if vault.Status == StatusArchived {
+		return nil, nil, httperrors.ErrConflictVaultArchived
+	}
+
+	approvers, err := EligibleApprovers(ctx, exec, vault)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := approvers[initiatorID]; initiatorID != "" && !ok {
+		return nil, nil, httperrors.ErrForbiddenNotEligibleApprover
+	}
+
+	return vault, approvers, nil
+}
+
+// openProposal inserts a pending proposal on the vault locked by LockProposalVault and records it in the audit log with
+// the details given. The proposal has to be approved by the quorum currently configured on the vault, limited to the
+// approvers eligible.
+func openProposal(ctx context.Context, exec boil.ContextExecutor, vault *models.Vault, approvers map[string]struct{}, initiatorID string, kind string, payload interface{}, action string, details map[string]interface{}) (*models.VaultProposal, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal proposal payload: %w", err)
+	}
+
+	required := RequiredApprovals(vault, approvers)
+	proposal := &models.VaultProposal{
+		ID:                uuid.New().String(),
+		VaultID:           vault.ID,
+		InitiatorID:       null.NewString(initiatorID, initiatorID != ""),
+		Kind:              kind,
+		Payload:           encoded,
+		RequiredApprovals: required,
+		Status:            ProposalStatusPending,
+	}
+	if err := proposal.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to insert proposal: %w", err)
+	}
+
+	details["vault_id"] = vault.ID
+	details["required_approvals"] = required
+	if err := audit.Record(ctx, exec, audit.Entry{
+		OrganizationID: vault.OrganizationID.String,
+		UserID:         initiatorID,
+		Action:         action,
+		ResourceType:   audit.ResourceTypeVaultProposal,
+		ResourceID:     proposal.ID,
+		Details:        details,
+	}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (s *impl) GetProposal(ctx context.Context, proposalID string) (*models.VaultProposal, error) {
+	proposal, err := models.VaultProposals(
+		models.VaultProposalWhere.ID.EQ(proposalID),
+		qm.Load(models.VaultProposalRels.VaultProposalApprovals),
+	).One(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("proposal not found: %w", err)
+	}
+
+	return proposal, nil
+}
+
+func (s *impl) ApproveProposal(ctx context.Context, proposalID string, params ApprovalParams) (*models.VaultProposal, error) {
+	var proposal *models.VaultProposal
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var vault *models.Vault
+		var approvers map[string]struct{}
+		var err error
+		proposal, vault, approvers, err = s.lockPendingProposal(ctx, exec, proposalID, params.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Key operations must be confirmed with a passkey registered to the approving user.
+		if _, err := s.passkeys.VerifyAssertion(ctx, exec, params.UserID, auth.AssertionScope{
+			Action:     auth.AssertionActionApproveProposal,
+			ResourceID: proposalID,
+		}, auth.Assertion{
+			CredentialID:      params.CredentialID,
+			Signature:         params.Signature,
+			AuthenticatorData: params.AuthenticatorData,
+			ClientDataJSON:    params.ClientDataJSON,
+		}); err != nil {
+			return err
+		}
+
+		approval := &models.VaultProposalApproval{
+			ID:              uuid.New().String(),
+			VaultProposalID: proposalID,
+			UserID:          params.UserID,
+			Action:          "approve",
+			CredentialID:    null.StringFrom(string(params.CredentialID)),
+		}
+		if err := approval.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert approval: %w", err)
+		}
+
+		approved, err := models.VaultProposalApprovals(
+			models.VaultProposalApprovalWhere.VaultProposalID.EQ(proposalID),
+			models.VaultProposalApprovalWhere.Action.EQ("approve"),
+			qm.WhereIn(models.VaultProposalApprovalColumns.UserID+" IN ?", approverIDs(approvers)...),
+		).Count(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("failed to count approvals: %w", err)
+		}
+
+		if int(approved) >= proposal.RequiredApprovals {
+			if err := executeProposal(ctx, exec, proposal, vault, approvers); err != nil {
+				return err
+			}
+		}
+		executed := proposal.Status == ProposalStatusExecuted
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: vault.OrganizationID.String,
+			UserID:         params.UserID,
+			Action:         audit.ActionApproveProposal,
+			ResourceType:   audit.ResourceTypeVaultProposal,
+			ResourceID:     proposalID,
+			CredentialID:   params.CredentialID,
+			Details: map[string]interface{}{
+				"vault_id":           vault.ID,
+				"approvals":          approved,
+				"required_approvals": proposal.RequiredApprovals,
+				"executed":           executed,
+				"status_reason":      proposal.StatusReason.String,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (s *impl) RejectProposal(ctx context.Context, proposalID string, userID string) (*models.VaultProposal, error) {
+	var proposal *models.VaultProposal
+	if err := db.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		var vault *models.Vault
+		var err error
+		proposal, vault, _, err = s.lockPendingProposal(ctx, exec, proposalID, userID)
+		if err != nil {
+			return err
+		}
+
+		approval := &models.VaultProposalApproval{
+			ID:              uuid.New().String(),
+			VaultProposalID: proposalID,
+			UserID:          userID,
+			Action:          "reject",
+		}
+		if err := approval.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert rejection: %w", err)
+		}
+
+		proposal.Status = ProposalStatusRejected
+		proposal.UpdatedAt = null.TimeFrom(time.Now())
+		if _, err := proposal.Update(ctx, exec, boil.Whitelist(models.VaultProposalColumns.Status, models.VaultProposalColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("failed to update proposal: %w", err)
+		}
+
+		return audit.Record(ctx, exec, audit.Entry{
+			OrganizationID: vault.OrganizationID.String,
+			UserID:         userID,
+			Action:         audit.ActionRejectProposal,
+			ResourceType:   audit.ResourceTypeVaultProposal,
+			ResourceID:     proposalID,
+			Details: map[string]interface{}{
+				"vault_id": vault.ID,
+			},
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// lockPendingProposal loads a pending proposal together with its vault (both locked for update) and
+// ensures userID may still vote on it.
+func (s *impl) lockPendingProposal(ctx context.Context, exec boil.ContextExecutor, proposalID string, userID string) (*models.VaultProposal, *models.Vault, map[string]struct{}, error) {
+	proposal, err := models.VaultProposals(
+		models.VaultProposalWhere.ID.EQ(proposalID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("proposal not found: %w", err)
+	}
+	if proposal.Status != ProposalStatusPending {
+		return nil, nil, nil, httperrors.ErrConflictProposalNotPending
+	}
+
+	vault, err := models.Vaults(
+		models.VaultWhere.ID.EQ(proposal.VaultID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("vault not found: %w", err)
+	}
+	if vault.Status == StatusArchived {
+		return nil, nil, nil, httperrors.ErrConflictVaultArchived
+	}
+
+	approvers, err := EligibleApprovers(ctx, exec, vault)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if _, ok := approvers[userID]; !ok {
+		return nil, nil, nil, httperrors.ErrForbiddenNotEligibleApprover
+	}
+
+	voted, err := models.VaultProposalApprovals(
+		models.VaultProposalApprovalWhere.VaultProposalID.EQ(proposalID),
+		models.VaultProposalApprovalWhere.UserID.EQ(userID),
+	).Exists(ctx, exec)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to check existing vote: %w", err)
+	}
+	if voted {
+		return nil, nil, nil, httperrors.ErrConflictProposalAlreadyVoted
+	}
+
+	return proposal, vault, approvers, nil
+}
+
+func executeProposal(ctx context.Context, exec boil.ContextExecutor, proposal *models.VaultProposal, vault *models.Vault, approvers map[string]struct{}) error {
+	switch proposal.Kind {
+	case ProposalKindThresholdChange:
+		var payload thresholdChangePayload
+		if err := json.Unmarshal(proposal.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal proposal payload: %w", err)
+		}
+		// Membership might have changed since the proposal was opened, the approvals then no longer apply.
+		if payload.Threshold < 1 || payload.Threshold > len(approvers) {
+			return cancelProposal(ctx, exec, proposal, fmt.Sprintf("threshold %d exceeds the %d eligible approvers", payload.Threshold, len(approvers)))
+		}
+
+		previous := vault.Threshold
+		vault.Threshold = payload.Threshold
+		vault.UpdatedAt = null.TimeFrom(time.Now())
+		if _, err := vault.Update(ctx, exec, boil.Whitelist(models.VaultColumns.Threshold, models.VaultColumns.UpdatedAt)); err != nil {
+			return fmt.Errorf("failed to update vault threshold: %w", err)
+		}
+
+		if err := enqueueEvent(ctx, exec, outbox.EventVaultThresholdChanged, vault, map[string]interface{}{
+			"previous_threshold": previous,
+			"proposal_id":        proposal.ID,
+		}); err != nil {
+			return err
+		}
+	case ProposalKindKeyRetirement:
+		var payload keyRetirementPayload
+		if err := json.Unmarshal(proposal.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal proposal payload: %w", err)
+		}
+		reason, err := startKeyRetirement(ctx, exec, proposal, vault, payload)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			return cancelProposal(ctx, exec, proposal, reason)
+		}
+	case ProposalKindKeyRetirementCompletion:
+		var payload keyRetirementPayload
+		if err := json.Unmarshal(proposal.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal proposal payload: %w", err)
+		}
+		reason, err := approveKeyRetirementCompletion(ctx, exec, proposal, payload)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			return cancelProposal(ctx, exec, proposal, reason)
+		}
+	case ProposalKindKeyRefresh:
+		var payload keyRefreshPayload
+		if err := json.Unmarshal(proposal.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal proposal payload: %w", err)
+		}
+		reason, err := approveKeyRefresh(ctx, exec, proposal, vault, payload)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			return cancelProposal(ctx, exec, proposal, reason)
+		}
+	default:
+		return fmt.Errorf("unsupported proposal kind %q", proposal.Kind)
+	}
+
+	proposal.Status = ProposalStatusExecuted
+	proposal.UpdatedAt = null.TimeFrom(time.Now())
+	if _, err := proposal.Update(ctx, exec, boil.Whitelist(models.VaultProposalColumns.Status, models.VaultProposalColumns.UpdatedAt)); err != nil {
+		return fmt.Errorf("failed to update proposal: %w", err)
+	}
+
+	return nil
+}
+
+// cancelProposal cancels the approved proposal instead of executing it, recording why.
+func cancelProposal(ctx context.Context, exec boil.ContextExecutor, proposal *models.VaultProposal, reason string) error {
+	proposal.Status = ProposalStatusCancelled
+	proposal.StatusReason = null.StringFrom(reason)
+	proposal.UpdatedAt = null.TimeFrom(time.Now())
+	if _, err := proposal.Update(ctx, exec, boil.Whitelist(
+		models.VaultProposalColumns.Status,
+		models.VaultProposalColumns.StatusReason,
+		models.VaultProposalColumns.UpdatedAt,
+	)); err != nil {
+		return fmt.Errorf("failed to update proposal: %w", err)
+	}
+
+	return nil
+}
+
+// startKeyRetirement marks the key of an approved retirement proposal as retiring, from then on it only signs sweeps
+// to its successor. If the proposal no longer applies, the reason to cancel it is returned instead.
+func startKeyRetirement(ctx context.Context, exec boil.ContextExecutor, proposal *models.VaultProposal, vault *models.Vault, payload keyRetirementPayload) (string, error) {
+	// Either key might have been retired by another proposal since this one was opened.
+	for _, keyID := range []string{payload.KeyID, payload.SuccessorKeyID} {
+		status, err := KeyStatus(ctx, exec, keyID)
+		if err != nil {
+			return "", err
+		}
+		if status != KeyStatusActive {
+			return fmt.Sprintf("key %s is %s", keyID, status), nil
+		}
+	}
+
+	now := time.Now()
+	key := &models.RootKey{
+		KeyID:                payload.KeyID,
+		VaultID:              vault.ID,
+		Tags:                 []byte("{}"),
+		Status:               KeyStatusRetiring,
+		SuccessorKeyID:       null.StringFrom(payload.SuccessorKeyID),
+		RetirementProposalID: null.StringFrom(proposal.ID),
+		RetiringAt:           null.TimeFrom(now),
+	}
+	if err := key.Upsert(ctx, exec, true,
+		[]string{models.RootKeyColumns.KeyID},
+		boil.Whitelist(
+			models.RootKeyColumns.Status,
+			models.RootKeyColumns.SuccessorKeyID,
+			models.RootKeyColumns.RetirementProposalID,
+			models.RootKeyColumns.RetiringAt,
+			models.RootKeyColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	); err != nil {
+		return "", fmt.Errorf("failed to upsert root key: %w", err)
+	}
+
+	return "", outbox.Enqueue(ctx, exec, outbox.Event{
+		OrganizationID: vault.OrganizationID.String,
+		Type:           outbox.EventKeyRetiring,
+		ResourceType:   audit.ResourceTypeKey,
+		ResourceID:     payload.KeyID,
+		Data: map[string]interface{}{
+			"key_id":           payload.KeyID,
+			"vault_id":         vault.ID,
+			"successor_key_id": payload.SuccessorKeyID,
+			"proposal_id":      proposal.ID,
+		},
+	})
+}
+
+// approveKeyRetirementCompletion records the approval of a completion proposal on the retiring key, which is retired
+// by the key service once the chains confirmed its wallets to be empty. If the proposal no longer applies, the reason to
+// cancel it is returned instead.
+func approveKeyRetirementCompletion(ctx context.Context, exec boil.ContextExecutor, proposal *models.VaultProposal, payload keyRetirementPayload) (string, error) {
+	key, err := models.RootKeys(
+		models.RootKeyWhere.KeyID.EQ(payload.KeyID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("failed to find root key: %w", err)
+	}
+	// The key might have been retired since the proposal was opened.
+	if key == nil || key.Status != KeyStatusRetiring {
+		return fmt.Sprintf("key %s is not retiring", payload.KeyID), nil
+	}
+
+	key.CompletionProposalID = null.StringFrom(proposal.ID)
+	if _, err := key.Update(ctx, exec, boil.Whitelist(models.RootKeyColumns.CompletionProposalID, models.RootKeyColumns.UpdatedAt)); err != nil {
+		return "", fmt.Errorf("failed to update root key: %w", err)
+	}
+
+	return "", nil
+}
+
+// KeyHoldsFunds reports whether any wallet of the key has a balance recorded which is not zero.
+func KeyHoldsFunds(ctx context.Context, exec boil.ContextExecutor, keyID string) (bool, error) {
+	funded, err := models.WalletBalances(
+		qm.InnerJoin("wallets on wallets.id = wallet_balances.wallet_id"),
+		qm.Where("wallets.key_id = ?", keyID),
+		qm.Where("(COALESCE(wallet_balances.balance, 0) <> 0 OR COALESCE(wallet_balances.raw_balance, '0')::numeric <> 0)"),
+	).Exists(ctx, exec)
+	if err != nil {
+		return false, fmt.Errorf("failed to check wallet balances: %w", err)
+	}
+
+	return funded, nil
+}
+
+// approveKeyRefresh records the refresh of an approved refresh proposal, freezing the key for signing until the refresh
+// was run by the key service. If the proposal no longer applies, the reason to cancel it is returned instead.
+func approveKeyRefresh(ctx context.Context, exec boil.ContextExecutor, proposal *models.VaultProposal, vault *models.Vault, payload keyRefreshPayload) (string, error) {
+	// The key might have been retired since the proposal was opened.
+	status, err := KeyStatus(ctx, exec, payload.KeyID)
+	if err != nil {
+		return "", err
+	}
+	if status != KeyStatusActive {
+		return fmt.Sprintf("key %s is %s", payload.KeyID, status), nil
+	}
+
+	vaultKey, err := models.VaultKeys(
+		models.VaultKeyWhere.VaultID.EQ(null.StringFrom(vault.ID)),
+		models.VaultKeyWhere.KeyID.EQ(payload.KeyID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", httperrors.ErrNotFoundKey
+		}
+		return "", fmt.Errorf("failed to find vault key: %w", err)
+	}
+
+	refresh := &models.KeyRefresh{
+		ID:         uuid.New().String(),
+		VaultID:    vault.ID,
+		KeyID:      payload.KeyID,
+		ProposalID: null.StringFrom(proposal.ID),
+		Trigger:    payload.Trigger,
+		Status:     RefreshStatusApproved,
+		Epoch:      vaultKey.RefreshEpoch + 1,
+	}
+	if err := refresh.Insert(ctx, exec, boil.Infer()); err != nil {
+		return "", fmt.Errorf("failed to insert key refresh: %w", err)
+	}
+
+	return "", nil
+}
+
+// KeyRefreshing reports whether a refresh of the shares of the key is approved or running, signing with the key is
+// refused until the refresh completed or failed.
+func KeyRefreshing(ctx context.Context, exec boil.ContextExecutor, keyID string) (bool, error) {
+	refreshing, err := models.KeyRefreshes(
+		models.KeyRefreshWhere.KeyID.EQ(keyID),
+		models.KeyRefreshWhere.Status.IN([]string{RefreshStatusApproved, RefreshStatusRunning}),
+	).Exists(ctx, exec)
+	if err != nil {
+		return false, fmt.Errorf("failed to check key refreshes: %w", err)
+	}
+
+	return refreshing, nil
+}
+
+// LockKeyShares takes an advisory lock on the shares of the key until the returned unlock is called. Signings hold the
+// lock shared and refreshes exclusively, so a refresh waits for signings in flight and signings started meanwhile
+// wait for the refresh and then see it through KeyRefreshing. The lock is held by a connection of its own.
+func LockKeyShares(ctx context.Context, sqlDB *sql.DB, keyID string, exclusive bool) (func(), error) {
+	lock, unlock := "pg_advisory_lock_shared", "pg_advisory_unlock_shared"
+	if exclusive {
+		lock, unlock = "pg_advisory_lock", "pg_advisory_unlock"
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT "+lock+"(hashtext($1))", "key_shares:"+keyID); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to lock key shares: %w", err)
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT "+unlock+"(hashtext($1))", "key_shares:"+keyID); err != nil {
+			util.LogFromContext(ctx).Error().Err(err).Str("key_id", keyID).Msg("Failed to unlock key shares, discarding connection")
+			// Discarding the connection ends its session and thereby releases the lock.
+			_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}, nil
+}
+
+// KeyIDs returns the IDs of the root keys backing the vault, the keys of the vault itself and of its wallets.
+func KeyIDs(ctx context.Context, exec boil.ContextExecutor, vaultID string) (map[string]struct{}, error) {
+	keys, err := models.VaultKeys(
+		models.VaultKeyWhere.VaultID.EQ(null.StringFrom(vaultID)),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vault keys: %w", err)
+	}
+	wallets, err := models.Wallets(
+		models.WalletWhere.VaultID.EQ(null.StringFrom(vaultID)),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	keyIDs := make(map[string]struct{}, len(keys)+len(wallets))
+	for _, k := range keys {
+		keyIDs[k.KeyID] = struct{}{}
+	}
+	for _, w := range wallets {
+		keyIDs[w.KeyID] = struct{}{}
+	}
+
+	return keyIDs, nil
+}
+
+// KeyStatus returns the lifecycle status of the root key, keys without record are active.
+func KeyStatus(ctx context.Context, exec boil.ContextExecutor, keyID string) (string, error) {
+	key, err := models.FindRootKey(ctx, exec, keyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return KeyStatusActive, nil
+		}
+		return "", fmt.Errorf("failed to find root key: %w", err)
+	}
+
+	return key.Status, nil
+}
+
+// RequiredApprovals returns the quorum operations on the vault need among the approvers: the threshold of the vault,
+// as far as there are enough approvers.
+func RequiredApprovals(vault *models.Vault, approvers map[string]struct{}) int {
+	return min(vault.Threshold, len(approvers))
+}
+
+// EligibleApprovers returns the IDs of all users that may take part in the quorum of the vault, the eligible
+// approvers of its organization as of organization.EligibleApprovers.
+func EligibleApprovers(ctx context.Context, exec boil.ContextExecutor, vault *models.Vault) (map[string]struct{}, error) {
+	approvers := make(map[string]struct{})
+	if !vault.OrganizationID.Valid {
+		return approvers, nil
+	}
+
+	org, err := models.FindOrganization(ctx, exec, vault.OrganizationID.String)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return approvers, nil
+		}
+		return nil, fmt.Errorf("failed to load organization: %w", err)
+	}
+
+	return organization.EligibleApprovers(ctx, exec, org)
+}
+
+func approverIDs(approvers map[string]struct{}) []interface{} {
+	ids := make([]interface{}, 0, len(approvers))
+	for id := range approvers {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func deriveAddress(pubKeyHex string, chainType string) string {
 	// Placeholder for address derivation
 	// Real impl needs crypto libs
 	return "0x" + pubKeyHex[:40] // Mock
 }
+
+// enqueueEvent writes the lifecycle event of the vault to the outbox, extra is merged into the data of the event.
+func enqueueEvent(ctx context.Context, exec boil.ContextExecutor, eventType string, vault *models.Vault, extra map[string]interface{}) error {
+	data := map[string]interface{}{
+		"id":        vault.ID,
+		"name":      vault.Name,
+		"threshold": vault.Threshold,
+		"status":    vault.Status,
+	}
+	for key, value := range extra {
+		data[key] = value
+	}
+
+	return outbox.Enqueue(ctx, exec, outbox.Event{
+		OrganizationID: vault.OrganizationID.String,
+		Type:           eventType,
+		ResourceType:   audit.ResourceTypeVault,
+		ResourceID:     vault.ID,
+		Data:           data,
+	})
+}
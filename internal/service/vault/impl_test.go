@@ -0,0 +1,203 @@
+package vault_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/infra/mpc"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/vault"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWallet(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+			{Curve: mpc.CurveEd25519, Protocol: mpc.ProtocolFROST, Threshold: 3, TotalNodes: 5},
+		})
+		require.NoError(t, err)
+
+		chain := &models.Chain{
+			ID:             "SOL",
+			Name:           "Solana",
+			Type:           "solana",
+			Algorithm:      mpc.AlgorithmEdDSA,
+			Curve:          mpc.CurveEd25519,
+			CurrencySymbol: "SOL",
+			IsActive:       true,
+		}
+		require.NoError(t, chain.Insert(ctx, s.DB, boil.Infer()))
+
+		wallet, err := s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom(v.ID), wallet.VaultID)
+		assert.Equal(t, null.StringFrom(chain.ID), wallet.ChainID)
+
+		// The wallet key is generated with the config of the vault key on its curve.
+		key, err := mpc.NewKeyClient(s.MpcConn).GetKey(ctx, wallet.KeyID)
+		require.NoError(t, err)
+		assert.Equal(t, mpc.CurveEd25519, key.Curve)
+		assert.Equal(t, mpc.AlgorithmEdDSA, key.Algorithm)
+		assert.Equal(t, mpc.ProtocolFROST, key.Protocol)
+		assert.Equal(t, 3, key.Threshold)
+		assert.Equal(t, 5, key.TotalNodes)
+		assert.NotEmpty(t, wallet.Address)
+	})
+}
+
+func TestCreateWalletChainInactive(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+
+		chain := &models.Chain{
+			ID:             "ETH",
+			Name:           "Ethereum",
+			Type:           "evm",
+			Algorithm:      mpc.AlgorithmECDSA,
+			Curve:          mpc.CurveSecp256k1,
+			CurrencySymbol: "ETH",
+		}
+		require.NoError(t, chain.Insert(ctx, s.DB, boil.Infer()))
+		chain.IsActive = false
+		_, err = chain.Update(ctx, s.DB, boil.Whitelist(models.ChainColumns.IsActive))
+		require.NoError(t, err)
+
+		_, err = s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+		require.ErrorIs(t, err, httperrors.ErrBadRequestChainInactive)
+
+		count, err := models.Wallets(models.WalletWhere.VaultID.EQ(null.StringFrom(v.ID))).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
+
+func TestCreateWalletNotStored(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+		})
+		require.NoError(t, err)
+		chain := &models.Chain{
+			ID:             "ETH",
+			Name:           "Ethereum",
+			Type:           "evm",
+			Algorithm:      mpc.AlgorithmECDSA,
+			Curve:          mpc.CurveSecp256k1,
+			CurrencySymbol: "ETH",
+			IsActive:       true,
+		}
+		require.NoError(t, chain.Insert(ctx, s.DB, boil.Infer()))
+
+		keyClient := mpc.NewKeyClient(s.MpcConn)
+		keys, err := keyClient.ListKeys(ctx, "")
+		require.NoError(t, err)
+
+		// The wallet fails to be stored after its key was generated, the key is deleted again.
+		_, err = s.DB.ExecContext(ctx, `CREATE FUNCTION refuse_wallet() RETURNS trigger AS $$ BEGIN RAISE EXCEPTION 'refused'; END; $$ LANGUAGE plpgsql;
+			CREATE TRIGGER refuse_wallet BEFORE INSERT ON wallets FOR EACH ROW EXECUTE FUNCTION refuse_wallet();`)
+		require.NoError(t, err)
+		_, err = s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+		require.Error(t, err)
+
+		remaining, err := keyClient.ListKeys(ctx, "")
+		require.NoError(t, err)
+		assert.Len(t, remaining, len(keys))
+
+		// Archived vaults get no wallets.
+		_, err = s.DB.ExecContext(ctx, `DROP TRIGGER refuse_wallet ON wallets`)
+		require.NoError(t, err)
+		_, err = s.Vault.ArchiveVault(ctx, v.ID, fix.User1.ID)
+		require.NoError(t, err)
+		_, err = s.Vault.CreateWallet(ctx, v.ID, chain.ID, fix.User1.ID)
+		require.ErrorIs(t, err, httperrors.ErrConflictVaultArchived)
+	})
+}
+
+func TestCreateVaultKeyConfig(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme", fix.User1.ID)
+		require.NoError(t, err)
+		keyClient := mpc.NewKeyClient(s.MpcConn)
+		keys, err := keyClient.ListKeys(ctx, "")
+		require.NoError(t, err)
+
+		tests := []struct {
+			name string
+			keys []vault.KeyConfig
+			err  error
+		}{
+			{"curve unsupported by protocol", []vault.KeyConfig{{Curve: mpc.CurveEd25519, Protocol: mpc.ProtocolGG20}}, httperrors.ErrBadRequestInvalidMpcProtocol},
+			{"unknown protocol", []vault.KeyConfig{{Curve: mpc.CurveSecp256k1, Protocol: "cmp"}}, httperrors.ErrBadRequestInvalidMpcProtocol},
+			{"threshold above nodes", []vault.KeyConfig{{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20, Threshold: 4, TotalNodes: 3}}, httperrors.ErrBadRequestInvalidKeyThreshold},
+			{"duplicate curve", []vault.KeyConfig{
+				{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG20},
+				{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolFROST},
+			}, httperrors.ErrBadRequestDuplicateKeyCurve},
+		}
+		for _, tt := range tests {
+			_, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, tt.keys)
+			require.ErrorIs(t, err, tt.err, tt.name)
+		}
+
+		// Invalid configs are refused before any key is generated.
+		remaining, err := keyClient.ListKeys(ctx, "")
+		require.NoError(t, err)
+		assert.Len(t, remaining, len(keys))
+		count, err := models.Vaults(models.VaultWhere.OrganizationID.EQ(null.StringFrom(org.ID))).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		// The config of every key is stored along with the vault.
+		v, err := s.Vault.CreateVault(ctx, "Treasury", org.ID, 1, fix.User1.ID, []vault.KeyConfig{
+			{Curve: mpc.CurveSecp256k1, Protocol: mpc.ProtocolGG18, Threshold: 2, TotalNodes: 4},
+			{Curve: mpc.CurveEd25519, Protocol: mpc.ProtocolFROST},
+		})
+		require.NoError(t, err)
+		vaultKeys, err := models.VaultKeys(models.VaultKeyWhere.VaultID.EQ(null.StringFrom(v.ID))).All(ctx, s.DB)
+		require.NoError(t, err)
+		require.Len(t, vaultKeys, 2)
+		for _, vaultKey := range vaultKeys {
+			key, err := keyClient.GetKey(ctx, vaultKey.KeyID)
+			require.NoError(t, err)
+			assert.Equal(t, key.Protocol, vaultKey.Protocol.String)
+			assert.Equal(t, key.Threshold, vaultKey.Threshold.Int)
+			assert.Equal(t, key.TotalNodes, vaultKey.TotalNodes.Int)
+			if vaultKey.Curve == mpc.CurveSecp256k1 {
+				assert.Equal(t, mpc.AlgorithmECDSA, vaultKey.Algorithm)
+				assert.Equal(t, mpc.ProtocolGG18, vaultKey.Protocol.String)
+				assert.Equal(t, 2, vaultKey.Threshold.Int)
+				assert.Equal(t, 4, vaultKey.TotalNodes.Int)
+			}
+		}
+	})
+}
@@ -6,7 +6,87 @@ import (
 	"github.com/kashguard/go-mpc-vault/internal/models"
 )
 
+const (
+	StatusActive   = "active"
+	StatusArchived = "archived"
+
+	DefaultThreshold = 2
+
+	ProposalKindThresholdChange = "threshold_change"
+	ProposalKindKeyRetirement   = "key_retirement"
+	ProposalKindKeyRefresh      = "key_refresh"
+	// ProposalKindKeyRetirementCompletion approves deleting a retiring key from the MPC server, which is done by the key
+	// service once the funds of the key were confirmed swept.
+	ProposalKindKeyRetirementCompletion = "key_retirement_completion"
+
+	ProposalStatusPending   = "pending"
+	ProposalStatusExecuted  = "executed"
+	ProposalStatusRejected  = "rejected"
+	ProposalStatusCancelled = "cancelled"
+
+	// Keys without root_keys record are active. Once the retirement is approved, a key may only sign sweeps to its
+	// successor and is retired after its funds were swept.
+	KeyStatusActive   = "active"
+	KeyStatusRetiring = "retiring"
+	KeyStatusRetired  = "retired"
+
+	// Refreshes are approved once the quorum of the vault approved their proposal and run in the background, the key
+	// is frozen for signing while a refresh is approved or running.
+	RefreshStatusApproved  = "approved"
+	RefreshStatusRunning   = "running"
+	RefreshStatusCompleted = "completed"
+	RefreshStatusFailed    = "failed"
+
+	// Refreshes are proposed by users or once the shares of a key reached their maximum age.
+	RefreshTriggerManual    = "manual"
+	RefreshTriggerScheduled = "scheduled"
+)
+
+// KeyConfig configures the DKG of a root key of a vault. Threshold and TotalNodes are left to the defaults of the MPC
+// server if zero.
+type KeyConfig struct {
+	Curve      string
+	Protocol   string
+	Threshold  int
+	TotalNodes int
+}
+
+// ApprovalParams carries the passkey assertion of a quorum member voting on a vault proposal.
+type ApprovalParams struct {
+	UserID            string
+	CredentialID      []byte
+	Signature         []byte
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+}
+
 type Service interface {
-	CreateVault(ctx context.Context, name string, orgID string) (*models.Vault, error)
-	CreateWallet(ctx context.Context, vaultID string, chainID string) (*models.Wallet, error)
+	// CreateVault creates the vault along with a root key for each of the key configs, generated before the vault is
+	// stored and checked to match the configuration.
+	CreateVault(ctx context.Context, name string, orgID string, threshold int, userID string, keys []KeyConfig) (*models.Vault, error)
+	CreateWallet(ctx context.Context, vaultID string, chainID string, userID string) (*models.Wallet, error)
+	ListVaults(ctx context.Context, orgID string, page int, limit int) (models.VaultSlice, int64, error)
+	GetVault(ctx context.Context, vaultID string) (*models.Vault, error)
+	// GetVaultMemberRole loads the vault and returns the role of the user within the organization owning it.
+	GetVaultMemberRole(ctx context.Context, vaultID string, userID string) (*models.Vault, string, error)
+	RenameVault(ctx context.Context, vaultID string, name string, userID string) (*models.Vault, error)
+	// SetWhitelistOnly toggles whether signing requests of the vault are restricted to whitelisted address book entries.
+	SetWhitelistOnly(ctx context.Context, vaultID string, whitelistOnly bool, userID string) (*models.Vault, error)
+	ArchiveVault(ctx context.Context, vaultID string, userID string) (*models.Vault, error)
+
+	// ProposeThresholdChange opens a proposal which is applied once the current quorum of the vault approved it.
+	ProposeThresholdChange(ctx context.Context, vaultID string, userID string, threshold int) (*models.VaultProposal, error)
+	// ProposeKeyRetirement opens a proposal to retire the key of the vault in favor of the successor key. Once the
+	// current quorum of the vault approved it, the key is retiring.
+	ProposeKeyRetirement(ctx context.Context, vaultID string, userID string, keyID string, successorKeyID string) (*models.VaultProposal, error)
+	// ProposeKeyRetirementCompletion opens a proposal to complete the retirement of a retiring key whose wallets hold no
+	// funds. Once the current quorum of the vault approved it, the key is retired by the key service if the chains
+	// confirm its wallets to be empty.
+	ProposeKeyRetirementCompletion(ctx context.Context, vaultID string, userID string, keyID string) (*models.VaultProposal, error)
+	// ProposeKeyRefresh opens a proposal to refresh the shares of the key of the vault. Once the current quorum of the
+	// vault approved it, the key is frozen for signing until the refresh completed or failed.
+	ProposeKeyRefresh(ctx context.Context, vaultID string, userID string, keyID string) (*models.VaultProposal, error)
+	GetProposal(ctx context.Context, proposalID string) (*models.VaultProposal, error)
+	ApproveProposal(ctx context.Context, proposalID string, params ApprovalParams) (*models.VaultProposal, error)
+	RejectProposal(ctx context.Context, proposalID string, userID string) (*models.VaultProposal, error)
 }
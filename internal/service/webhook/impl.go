@@ -0,0 +1,269 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/dropbox/godropbox/time2"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/chainwebhook"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/deposit"
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+)
+
+// insertEventQuery records an event unless it was received before, returning no rows for duplicates.
+const insertEventQuery = `INSERT INTO webhook_events (provider, event_id, event_type, payload, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, 'received', $5, $5)
+ON CONFLICT (provider, event_id) DO NOTHING
+RETURNING id`
+
+// claimEventQuery marks an event as processing if it is not processed or processing yet. Events processing since
+// before the lease expiry $3 are claimed again, as the instance processing them is assumed to have crashed.
+const claimEventQuery = `UPDATE webhook_events SET status = 'processing', attempts = attempts + 1, updated_at = $2
+WHERE id = $1 AND (status IN ('received', 'failed') OR (status = 'processing' AND updated_at < $3))`
+
+type impl struct {
+	db        *sql.DB
+	clock     time2.Clock
+	deposit   deposit.Service
+	providers map[string]chainwebhook.Provider
+	lease     time.Duration
+}
+
+// NewService returns the webhook service accepting the events of the providers configured.
+func NewService(config config.Server, db *sql.DB, clock time2.Clock, depositService deposit.Service) Service {
+	providers := make(map[string]chainwebhook.Provider)
+	if hasKey(config.Webhook.AlchemySigningKeys) {
+		providers[chainwebhook.ProviderAlchemy] = chainwebhook.NewAlchemy(config.Webhook.AlchemySigningKeys)
+	}
+
+	return &impl{
+		db:        db,
+		clock:     clock,
+		deposit:   depositService,
+		providers: providers,
+		lease:     config.Webhook.ProcessingLease,
+	}
+}
+
+func (s *impl) Receive(ctx context.Context, provider string, header http.Header, body []byte) (*models.WebhookEvent, bool, error) {
+	log := util.LogFromContext(ctx).With().Str("provider", provider).Logger()
+
+	p, ok := s.providers[strings.ToLower(provider)]
+	if !ok {
+		return nil, false, httperrors.ErrNotFoundWebhookProvider
+	}
+	if err := p.Verify(header, body); err != nil {
+		log.Debug().Err(err).Msg("Rejecting webhook with invalid signature")
+		return nil, false, httperrors.ErrUnauthorizedWebhookSignature
+	}
+	event, err := p.Parse(body)
+	if err != nil {
+		log.Debug().Err(err).Msg("Rejecting webhook with invalid payload")
+		return nil, false, httperrors.ErrBadRequestInvalidWebhookPayload
+	}
+
+	var id string
+	if err := s.db.QueryRowContext(ctx, insertEventQuery, p.Name(), event.ID, event.Type, string(body), s.clock.Now()).Scan(&id); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, false, fmt.Errorf("failed to insert webhook event: %w", err)
+		}
+
+		existing, err := models.WebhookEvents(
+			models.WebhookEventWhere.Provider.EQ(p.Name()),
+			models.WebhookEventWhere.EventID.EQ(event.ID),
+		).One(ctx, s.db)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to find webhook event: %w", err)
+		}
+		return existing, true, nil
+	}
+
+	processed, _, err := s.process(ctx, p, id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return processed, false, nil
+}
+
+func (s *impl) ListEvents(ctx context.Context, params ListEventsParams) (models.WebhookEventSlice, int64, error) {
+	mods := make([]qm.QueryMod, 0)
+	if params.Provider != "" {
+		mods = append(mods, models.WebhookEventWhere.Provider.EQ(strings.ToLower(params.Provider)))
+	}
+	if params.Status != "" {
+		mods = append(mods, models.WebhookEventWhere.Status.EQ(params.Status))
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	page := params.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	total, err := models.WebhookEvents(mods...).Count(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook events: %w", err)
+	}
+
+	mods = append(mods,
+		qm.OrderBy(models.WebhookEventColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+		qm.Offset((page-1)*limit),
+	)
+	events, err := models.WebhookEvents(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+func (s *impl) Reprocess(ctx context.Context, id string) (*models.WebhookEvent, error) {
+	event, err := models.FindWebhookEvent(ctx, s.db, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundWebhookEvent
+		}
+		return nil, fmt.Errorf("failed to find webhook event: %w", err)
+	}
+
+	p, ok := s.providers[event.Provider]
+	if !ok {
+		return nil, httperrors.ErrNotFoundWebhookProvider
+	}
+
+	processed, claimed, err := s.process(ctx, p, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, httperrors.ErrConflictWebhookEventNotReprocessable
+	}
+
+	return processed, nil
+}
+
+// process claims the event and records its transfers as deposits. Events processed or processing within their lease
+// are returned unchanged and not claimed. The outcome is recorded with the event, failures are not returned.
+func (s *impl) process(ctx context.Context, p chainwebhook.Provider, id string) (*models.WebhookEvent, bool, error) {
+	// The outcome has to be recorded even if the provider gives up on the request, else the event stays processing.
+	ctx = context.WithoutCancel(ctx)
+
+	now := s.clock.Now()
+	res, err := s.db.ExecContext(ctx, claimEventQuery, id, now, now.Add(-s.lease))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to claim webhook event: %w", err)
+	}
+	claimed, err := res.RowsAffected()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to claim webhook event: %w", err)
+	}
+
+	event, err := models.FindWebhookEvent(ctx, s.db, id)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find webhook event: %w", err)
+	}
+	if claimed == 0 {
+		return event, false, nil
+	}
+
+	log := util.LogFromContext(ctx).With().Str("provider", event.Provider).Str("event_id", event.EventID).Logger()
+
+	ignored, err := s.handle(ctx, p, event)
+	switch {
+	case err != nil:
+		log.Warn().Err(err).Int("attempts", event.Attempts).Msg("Failed to process webhook event")
+		event.Status = StatusFailed
+		event.LastError = null.StringFrom(err.Error())
+	case ignored:
+		event.Status = StatusIgnored
+		event.LastError = null.String{}
+		event.ProcessedAt = null.TimeFrom(s.clock.Now())
+	default:
+		event.Status = StatusProcessed
+		event.LastError = null.String{}
+		event.ProcessedAt = null.TimeFrom(s.clock.Now())
+	}
+
+	if _, err := event.Update(ctx, s.db, boil.Infer()); err != nil {
+		return nil, true, fmt.Errorf("failed to update webhook event: %w", err)
+	}
+
+	return event, true, nil
+}
+
+// handle records the transfers of the event as deposits of the chain it occurred on. It reports events not about
+// transfers or of networks without active chain within the catalog as ignored.
+func (s *impl) handle(ctx context.Context, p chainwebhook.Provider, event *models.WebhookEvent) (bool, error) {
+	parsed, err := p.Parse(event.Payload)
+	if err != nil {
+		return false, err
+	}
+	if parsed.ChainID == "" || len(parsed.Transfers) == 0 {
+		return true, nil
+	}
+
+	chain, err := models.Chains(
+		models.ChainWhere.Type.EQ(address.ChainTypeEVM),
+		models.ChainWhere.ChainID.EQ(null.StringFrom(parsed.ChainID)),
+		models.ChainWhere.IsActive.EQ(true),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return true, nil
+		}
+		return false, fmt.Errorf("find chain: %w", err)
+	}
+
+	transfers := make([]deposit.Transfer, 0, len(parsed.Transfers))
+	for _, transfer := range parsed.Transfers {
+		transfers = append(transfers, deposit.Transfer{
+			TxHash:      transfer.TxHash,
+			LogIndex:    transfer.LogIndex,
+			BlockNumber: transfer.BlockNumber,
+			BlockHash:   transfer.BlockHash,
+			From:        transfer.From,
+			To:          transfer.To,
+			Token:       transfer.Token,
+			Amount:      transfer.Amount,
+		})
+	}
+
+	recorded, err := s.deposit.RecordTransfers(ctx, chain.ID, transfers)
+	if err != nil {
+		return false, err
+	}
+
+	util.LogFromContext(ctx).Debug().
+		Str("chain_id", chain.ID).
+		Int("transfers", len(transfers)).
+		Int("deposits", recorded).
+		Msg("Recorded deposits of webhook event")
+
+	return false, nil
+}
+
+func hasKey(keys []string) bool {
+	for _, key := range keys {
+		if key != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/config"
+	"github.com/kashguard/go-mpc-vault/internal/infra/chainwebhook"
+	"github.com/kashguard/go-mpc-vault/internal/service/webhook"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signingKey = "whsec_test"
+
+// minedTransaction is an Alchemy event without transfers, which is ignored once processed.
+const minedTransaction = `{
+	"webhookId": "wh_octjglnywaupz6th",
+	"id": "whevt_mined",
+	"createdAt": "2024-06-01T12:00:00.000Z",
+	"type": "MINED_TRANSACTION",
+	"event": {}
+}`
+
+func TestReprocessAbandonedEvent(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Webhook.AlchemySigningKeys = []string{signingKey}
+	cfg.Webhook.ProcessingLease = time.Minute
+
+	test.WithTestServerConfigurable(t, cfg, func(s *api.Server) {
+		ctx := t.Context()
+
+		mac := hmac.New(sha256.New, []byte(signingKey))
+		mac.Write([]byte(minedTransaction))
+		header := http.Header{}
+		header.Set(chainwebhook.AlchemySignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+
+		event, duplicate, err := s.Webhook.Receive(ctx, chainwebhook.ProviderAlchemy, header, []byte(minedTransaction))
+		require.NoError(t, err)
+		require.False(t, duplicate)
+		assert.Equal(t, webhook.StatusIgnored, event.Status)
+		assert.Equal(t, 1, event.Attempts)
+
+		// Events are claimed by one instance at a time while their lease lasts.
+		setProcessing := func(since time.Time) {
+			t.Helper()
+			_, err := s.DB.ExecContext(ctx, `UPDATE webhook_events SET status = 'processing', updated_at = $2 WHERE id = $1`, event.ID, since)
+			require.NoError(t, err)
+		}
+		setProcessing(s.Clock.Now().Add(-30 * time.Second))
+		_, err = s.Webhook.Reprocess(ctx, event.ID)
+		require.ErrorIs(t, err, httperrors.ErrConflictWebhookEventNotReprocessable)
+
+		// Events left processing beyond their lease were abandoned, e.g. by a crashed instance, and are claimed again.
+		setProcessing(s.Clock.Now().Add(-2 * time.Minute))
+		event, err = s.Webhook.Reprocess(ctx, event.ID)
+		require.NoError(t, err)
+		assert.Equal(t, webhook.StatusIgnored, event.Status)
+		assert.Equal(t, 2, event.Attempts)
+
+		_, err = s.Webhook.Reprocess(ctx, event.ID)
+		require.ErrorIs(t, err, httperrors.ErrConflictWebhookEventNotReprocessable)
+	})
+}
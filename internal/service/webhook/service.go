@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kashguard/go-mpc-vault/internal/models"
+)
+
+// Events are processed once received, processing events claims them so concurrent deliveries and reprocessing do not
+// process an event twice. Events not about transfers or of networks outside of the catalog are ignored.
+const (
+	StatusReceived   = "received"
+	StatusProcessing = "processing"
+	StatusProcessed  = "processed"
+	StatusIgnored    = "ignored"
+	StatusFailed     = "failed"
+)
+
+type ListEventsParams struct {
+	// Provider filters the events, empty for all.
+	Provider string
+	// Status filters the events, empty for all.
+	Status string
+	Page   int
+	Limit  int
+}
+
+type Service interface {
+	// Receive verifies the signature of the request body, records the event and processes it.
+	// Events received before are returned as duplicate without processing them again. Events failing to process are
+	// recorded as failed, the error is not returned.
+	Receive(ctx context.Context, provider string, header http.Header, body []byte) (event *models.WebhookEvent, duplicate bool, err error)
+	ListEvents(ctx context.Context, params ListEventsParams) (models.WebhookEventSlice, int64, error)
+	// Reprocess processes a failed event, an event which was not processed yet or an event processing beyond its lease,
+	// again.
+	Reprocess(ctx context.Context, id string) (*models.WebhookEvent, error)
+}
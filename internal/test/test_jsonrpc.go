@@ -0,0 +1,45 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// JSONRPCHandler answers a request to the JSON-RPC stand-in, either with a result or an error.
+type JSONRPCHandler func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError)
+
+// NewTestJSONRPCServer starts a JSON-RPC stand-in for a chain node, which is closed once the test finished.
+func NewTestJSONRPCServer(t *testing.T, handler JSONRPCHandler) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int               `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		result, rpcErr := handler(req.Method, req.Params)
+		res := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if rpcErr != nil {
+			res["error"] = rpcErr
+		} else {
+			res["result"] = result
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
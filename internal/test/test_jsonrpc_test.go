@@ -0,0 +1,40 @@
+package test_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/infra/jsonrpc"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONRPCServer(t *testing.T) {
+	srv := test.NewTestJSONRPCServer(t, func(method string, params []json.RawMessage) (interface{}, *jsonrpc.RPCError) {
+		switch method {
+		case "eth_blockNumber":
+			assert.Empty(t, params)
+			return "0x10", nil
+		case "eth_getBlockByNumber":
+			return nil, nil
+		default:
+			return nil, &jsonrpc.RPCError{Code: -32601, Message: "method not found"}
+		}
+	})
+	client := jsonrpc.NewClient(srv.Client())
+
+	var head string
+	require.NoError(t, client.Call(context.Background(), srv.URL, "eth_blockNumber", []interface{}{}, &head))
+	assert.Equal(t, "0x10", head)
+
+	block := &struct{}{}
+	require.NoError(t, client.Call(context.Background(), srv.URL, "eth_getBlockByNumber", []interface{}{"0x11", false}, &block))
+	assert.Nil(t, block)
+
+	err := client.Call(context.Background(), srv.URL, "eth_unknown", []interface{}{}, &head)
+	var rpcErr *jsonrpc.RPCError
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, -32601, rpcErr.Code)
+}
@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+)
+
+// PasskeyOrigin is the origin assertions of test passkeys are made for, the relying party origin of the server.
+const PasskeyOrigin = "http://localhost:3000"
+
+// Passkey is a software authenticator holding an ES256 passkey registered to a user. It answers the passkey
+// challenges issued by the server like a platform authenticator would.
+type Passkey struct {
+	UserID       string
+	CredentialID []byte
+
+	key       *ecdsa.PrivateKey
+	signCount uint32
+}
+
+// NewPasskey generates a passkey and registers it to the user.
+func NewPasskey(t TestingT, s *api.Server, userID string) *Passkey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate passkey: %v", err)
+	}
+
+	publicKey, err := key.PublicKey.ECDH()
+	if err != nil {
+		t.Fatalf("failed to convert passkey: %v", err)
+	}
+	// Uncompressed point, 0x04 || x || y.
+	point := publicKey.Bytes()
+	cose, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: point[1:33],
+		YCoord: point[33:],
+	})
+	if err != nil {
+		t.Fatalf("failed to encode passkey: %v", err)
+	}
+
+	passkey := &Passkey{
+		UserID:       userID,
+		CredentialID: []byte("passkey-" + uuid.NewString()),
+		key:          key,
+	}
+
+	credential := &models.UserCredential{
+		UserID:          userID,
+		CredentialID:    string(passkey.CredentialID),
+		PublicKey:       base64.RawURLEncoding.EncodeToString(cose),
+		AttestationType: null.StringFrom("none"),
+		SignCount:       null.IntFrom(0),
+		DeviceName:      null.StringFrom("Test passkey"),
+	}
+	if err := credential.Insert(context.Background(), s.DB, boil.Infer()); err != nil {
+		t.Fatalf("failed to insert passkey: %v", err)
+	}
+
+	return passkey
+}
+
+// Assert requests a challenge for the user of the passkey confirming the action on the resource and returns the
+// assertion answering it.
+func (p *Passkey) Assert(t TestingT, s *api.Server, action string, resourceID string) auth.Assertion {
+	t.Helper()
+
+	options, err := s.WebAuthn.BeginAssertion(context.Background(), p.UserID, auth.AssertionScope{
+		Action:     action,
+		ResourceID: resourceID,
+	})
+	if err != nil {
+		t.Fatalf("failed to begin passkey assertion: %v", err)
+	}
+
+	return p.Sign(t, options.Response.RelyingPartyID, options.Response.Challenge.String())
+}
+
+// Sign answers the challenge, given base64url encoded, for the relying party with the passkey.
+func (p *Passkey) Sign(t TestingT, rpID string, challenge string) auth.Assertion {
+	t.Helper()
+
+	clientDataJSON, err := json.Marshal(protocol.CollectedClientData{
+		Type:      protocol.AssertCeremony,
+		Challenge: challenge,
+		Origin:    PasskeyOrigin,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal client data: %v", err)
+	}
+
+	p.signCount++
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authenticatorData := make([]byte, 0, len(rpIDHash)+5)
+	authenticatorData = append(authenticatorData, rpIDHash[:]...)
+	authenticatorData = append(authenticatorData, byte(protocol.FlagUserPresent|protocol.FlagUserVerified))
+	authenticatorData = binary.BigEndian.AppendUint32(authenticatorData, p.signCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(authenticatorData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, p.key, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign assertion: %v", err)
+	}
+
+	return auth.Assertion{
+		CredentialID:      p.CredentialID,
+		Signature:         signature,
+		AuthenticatorData: authenticatorData,
+		ClientDataJSON:    clientDataJSON,
+	}
+}
+
+// AssertionPayload returns the fields of the assertion as sent within request payloads.
+func AssertionPayload(assertion auth.Assertion) GenericPayload {
+	return GenericPayload{
+		"credential_id":      base64.StdEncoding.EncodeToString(assertion.CredentialID),
+		"signature":          base64.StdEncoding.EncodeToString(assertion.Signature),
+		"authenticator_data": base64.StdEncoding.EncodeToString(assertion.AuthenticatorData),
+		"client_data_json":   base64.StdEncoding.EncodeToString(assertion.ClientDataJSON),
+	}
+}
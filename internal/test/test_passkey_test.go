@@ -0,0 +1,115 @@
+package test_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/google/uuid"
+	"github.com/kashguard/go-mpc-vault/internal/api"
+	"github.com/kashguard/go-mpc-vault/internal/api/httperrors"
+	"github.com/kashguard/go-mpc-vault/internal/models"
+	"github.com/kashguard/go-mpc-vault/internal/service/auth"
+	"github.com/kashguard/go-mpc-vault/internal/test"
+	"github.com/kashguard/go-mpc-vault/internal/test/fixtures"
+	"github.com/kashguard/go-mpc-vault/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasskeyAssertion(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		scope := auth.AssertionScope{Action: auth.AssertionActionApproveProposal, ResourceID: uuid.NewString()}
+
+		assertion := passkey.Assert(t, s, scope.Action, scope.ResourceID)
+		credential, err := s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, assertion)
+		require.NoError(t, err)
+		assert.Equal(t, string(passkey.CredentialID), credential.CredentialID)
+		assert.Equal(t, 1, credential.SignCount.Int)
+		assert.True(t, credential.LastUsedAt.Valid)
+
+		// The challenge is consumed by the first assertion answering it.
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, assertion)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		// Challenges are bound to the user they were issued to.
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User2.ID, scope, passkey.Assert(t, s, scope.Action, scope.ResourceID))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, passkey.Sign(t, "localhost", "bm90LWlzc3VlZC1ieS10aGUtc2VydmVy"))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		// Challenges are bound to the operation and the resource they were issued for.
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, passkey.Assert(t, s, auth.AssertionActionApproveRecovery, scope.ResourceID))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, passkey.Assert(t, s, scope.Action, uuid.NewString()))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		tampered := passkey.Assert(t, s, scope.Action, scope.ResourceID)
+		tampered.Signature[len(tampered.Signature)-1] ^= 0x01
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, tampered)
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		// Assertions are scoped to the relying party of the server.
+		options, err := s.WebAuthn.BeginAssertion(ctx, fix.User1.ID, scope)
+		require.NoError(t, err)
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, passkey.Sign(t, "evil.example.com", options.Response.Challenge.String()))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, test.NewPasskey(t, s, fix.User2.ID).Assert(t, s, scope.Action, scope.ResourceID))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+	})
+}
+
+func TestPasskeyAssertionSignCount(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		scope := auth.AssertionScope{Action: auth.AssertionActionApproveProposal, ResourceID: uuid.NewString()}
+
+		// A sign count not increasing beyond the stored one hints at a cloned authenticator.
+		credential, err := models.UserCredentials(models.UserCredentialWhere.CredentialID.EQ(string(passkey.CredentialID))).One(ctx, s.DB)
+		require.NoError(t, err)
+		credential.SignCount.Int = 10
+		_, err = credential.Update(ctx, s.DB, boil.Whitelist(models.UserCredentialColumns.SignCount))
+		require.NoError(t, err)
+
+		_, err = s.WebAuthn.VerifyAssertion(ctx, s.DB, fix.User1.ID, scope, passkey.Assert(t, s, scope.Action, scope.ResourceID))
+		require.ErrorIs(t, err, httperrors.ErrForbiddenPasskeyAssertionInvalid)
+	})
+}
+
+func TestPostPasskeyChallenge(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		payload := test.GenericPayload{"action": auth.AssertionActionTransferOwnership, "resource_id": uuid.NewString()}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/credentials/challenge", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		passkey := test.NewPasskey(t, s, fix.User1.ID)
+		res = test.PerformRequest(t, s, "POST", "/api/v1/credentials/challenge", test.GenericPayload{"action": "unknown", "resource_id": "1"}, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/credentials/challenge", payload, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PasskeyChallengeResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Len(t, *response.Challenge, 32)
+		assert.Equal(t, "localhost", *response.RpID)
+		require.Len(t, response.AllowCredentials, 1)
+		assert.Equal(t, passkey.CredentialID, []byte(response.AllowCredentials[0]))
+
+		// The challenge confirms the operation it was requested for.
+		challenge, err := models.PasskeyChallenges(models.PasskeyChallengeWhere.UserID.EQ(fix.User1.ID)).One(t.Context(), s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, payload["action"], challenge.Action)
+		assert.Equal(t, payload["resource_id"], challenge.ResourceID)
+	})
+}
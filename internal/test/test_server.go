@@ -70,6 +70,10 @@ func execClosureNewTestServer(ctx context.Context, t *testing.T, config config.S
 	config.Push.UseFCMProvider = false
 	config.Push.UseMockProvider = true
 
+	// always use the in-memory fake MPC server in tests, signatures created by it verify through fake.Verify
+	config.Mpc.Fake = true
+	config.Mpc.AllowFake = true
+
 	s, err := api.InitNewServerWithDB(config, db, t)
 	if err != nil {
 		t.Fatalf("Failed to initialize server: %v", err)
@@ -87,6 +91,11 @@ func execClosureNewTestServer(ctx context.Context, t *testing.T, config config.S
 		t.Fatalf("failed to shutdown server: %v", err)
 	}
 
+	// stops the fake MPC server
+	if err := s.MpcConn.Close(); err != nil {
+		t.Fatalf("failed to close MPC server connection: %v", err)
+	}
+
 	// disallow any further refs to managed object after running the test
 	//nolint: wastedassign
 	s = nil
@@ -30,7 +30,7 @@ type CreateSigningRequestPayload struct {
 	// Required: true
 	ToAddress *string `json:"to_address"`
 
-	// tx data
+	// Hex encoded unsigned transaction, the recipient of its funds must match to_address
 	TxData string `json:"tx_data,omitempty"`
 
 	// wallet id
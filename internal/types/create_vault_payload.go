@@ -7,6 +7,7 @@ package types
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -23,26 +24,77 @@ type CreateVaultPayload struct {
 	// Example: ["eth","btc"]
 	Chains []string `json:"chains"`
 
+	// Root keys generated for the vault, at most one per curve. Wallets of the vault derive their
+	// keys with the configuration of the key of their curve.
+	Keys []*VaultKeyConfig `json:"keys"`
+
 	// name
 	// Example: My Team Vault
 	// Required: true
 	Name *string `json:"name"`
+
+	// Organization the vault is created in, defaults to the user's default organization
+	// Format: uuid4
+	OrganizationID strfmt.UUID4 `json:"organization_id,omitempty"`
+
+	// Number of approvals required for signing, defaults to 2
+	// Example: 2
+	// Minimum: 1
+	Threshold int64 `json:"threshold,omitempty"`
 }
 
 // Validate validates this create vault payload
 func (m *CreateVaultPayload) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateKeys(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateOrganizationID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThreshold(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *CreateVaultPayload) validateKeys(formats strfmt.Registry) error {
+	if swag.IsZero(m.Keys) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Keys); i++ {
+		if swag.IsZero(m.Keys[i]) { // not required
+			continue
+		}
+
+		if m.Keys[i] != nil {
+			if err := m.Keys[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("keys" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *CreateVaultPayload) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -52,8 +104,61 @@ func (m *CreateVaultPayload) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this create vault payload based on context it is used
+func (m *CreateVaultPayload) validateOrganizationID(formats strfmt.Registry) error {
+	if swag.IsZero(m.OrganizationID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("organization_id", "body", "uuid4", m.OrganizationID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateVaultPayload) validateThreshold(formats strfmt.Registry) error {
+	if swag.IsZero(m.Threshold) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("threshold", "body", m.Threshold, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create vault payload based on the context it is used
 func (m *CreateVaultPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateKeys(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateVaultPayload) contextValidateKeys(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Keys); i++ {
+
+		if m.Keys[i] != nil {
+			if err := m.Keys[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("keys" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// KeyRecoveryVote key recovery vote
+//
+// swagger:model keyRecoveryVote
+type KeyRecoveryVote struct {
+
+	// action
+	// Required: true
+	// Enum: [approve reject]
+	Action *string `json:"action"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// user id
+	// Required: true
+	// Format: uuid4
+	UserID *strfmt.UUID4 `json:"user_id"`
+}
+
+// Validate validates this key recovery vote
+func (m *KeyRecoveryVote) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUserID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var keyRecoveryVoteTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["approve","reject"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		keyRecoveryVoteTypeActionPropEnum = append(keyRecoveryVoteTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// KeyRecoveryVoteActionApprove captures enum value "approve"
+	KeyRecoveryVoteActionApprove string = "approve"
+
+	// KeyRecoveryVoteActionReject captures enum value "reject"
+	KeyRecoveryVoteActionReject string = "reject"
+)
+
+// prop value enum
+func (m *KeyRecoveryVote) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, keyRecoveryVoteTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *KeyRecoveryVote) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *KeyRecoveryVote) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *KeyRecoveryVote) validateUserID(formats strfmt.Registry) error {
+
+	if err := validate.Required("user_id", "body", m.UserID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("user_id", "body", "uuid4", m.UserID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this key recovery vote based on context it is used
+func (m *KeyRecoveryVote) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *KeyRecoveryVote) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *KeyRecoveryVote) UnmarshalBinary(b []byte) error {
+	var res KeyRecoveryVote
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
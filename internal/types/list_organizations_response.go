@@ -19,6 +19,9 @@ import (
 // swagger:model listOrganizationsResponse
 type ListOrganizationsResponse struct {
 
+	// default organization id
+	DefaultOrganizationID string `json:"default_organization_id,omitempty"`
+
 	// organizations
 	Organizations []*OrganizationItem `json:"organizations"`
 }
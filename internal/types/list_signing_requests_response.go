@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ListSigningRequestsResponse list signing requests response
+//
+// swagger:model listSigningRequestsResponse
+type ListSigningRequestsResponse struct {
+
+	// requests
+	Requests []*SigningRequestItem `json:"requests"`
+
+	// total
+	Total int64 `json:"total,omitempty"`
+}
+
+// Validate validates this list signing requests response
+func (m *ListSigningRequestsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRequests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ListSigningRequestsResponse) validateRequests(formats strfmt.Registry) error {
+	if swag.IsZero(m.Requests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Requests); i++ {
+		if swag.IsZero(m.Requests[i]) { // not required
+			continue
+		}
+
+		if m.Requests[i] != nil {
+			if err := m.Requests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("requests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("requests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this list signing requests response based on the context it is used
+func (m *ListSigningRequestsResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRequests(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ListSigningRequestsResponse) contextValidateRequests(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Requests); i++ {
+
+		if m.Requests[i] != nil {
+			if err := m.Requests[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("requests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("requests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ListSigningRequestsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ListSigningRequestsResponse) UnmarshalBinary(b []byte) error {
+	var res ListSigningRequestsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -68,6 +68,243 @@ const (
 
 	// PublicHTTPErrorTypeMISSINGSCOPES captures enum value "MISSING_SCOPES"
 	PublicHTTPErrorTypeMISSINGSCOPES PublicHTTPErrorType = "MISSING_SCOPES"
+
+	// PublicHTTPErrorTypeNOTORGANIZATIONMEMBER captures enum value "NOT_ORGANIZATION_MEMBER"
+	PublicHTTPErrorTypeNOTORGANIZATIONMEMBER PublicHTTPErrorType = "NOT_ORGANIZATION_MEMBER"
+
+	// PublicHTTPErrorTypeINSUFFICIENTROLE captures enum value "INSUFFICIENT_ROLE"
+	PublicHTTPErrorTypeINSUFFICIENTROLE PublicHTTPErrorType = "INSUFFICIENT_ROLE"
+
+	// PublicHTTPErrorTypeORGANIZATIONREQUIRED captures enum value "ORGANIZATION_REQUIRED"
+	PublicHTTPErrorTypeORGANIZATIONREQUIRED PublicHTTPErrorType = "ORGANIZATION_REQUIRED"
+
+	// PublicHTTPErrorTypeALREADYORGANIZATIONMEMBER captures enum value "ALREADY_ORGANIZATION_MEMBER"
+	PublicHTTPErrorTypeALREADYORGANIZATIONMEMBER PublicHTTPErrorType = "ALREADY_ORGANIZATION_MEMBER"
+
+	// PublicHTTPErrorTypeINVITATIONNOTFOUND captures enum value "INVITATION_NOT_FOUND"
+	PublicHTTPErrorTypeINVITATIONNOTFOUND PublicHTTPErrorType = "INVITATION_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVITATIONEXPIRED captures enum value "INVITATION_EXPIRED"
+	PublicHTTPErrorTypeINVITATIONEXPIRED PublicHTTPErrorType = "INVITATION_EXPIRED"
+
+	// PublicHTTPErrorTypeINVITATIONNOTPENDING captures enum value "INVITATION_NOT_PENDING"
+	PublicHTTPErrorTypeINVITATIONNOTPENDING PublicHTTPErrorType = "INVITATION_NOT_PENDING"
+
+	// PublicHTTPErrorTypeREGISTRATIONPASSWORDREQUIRED captures enum value "REGISTRATION_PASSWORD_REQUIRED"
+	PublicHTTPErrorTypeREGISTRATIONPASSWORDREQUIRED PublicHTTPErrorType = "REGISTRATION_PASSWORD_REQUIRED"
+
+	// PublicHTTPErrorTypeLASTADMIN captures enum value "LAST_ADMIN"
+	PublicHTTPErrorTypeLASTADMIN PublicHTTPErrorType = "LAST_ADMIN"
+
+	// PublicHTTPErrorTypeOWNERMEMBERSHIPIMMUTABLE captures enum value "OWNER_MEMBERSHIP_IMMUTABLE"
+	PublicHTTPErrorTypeOWNERMEMBERSHIPIMMUTABLE PublicHTTPErrorType = "OWNER_MEMBERSHIP_IMMUTABLE"
+
+	// PublicHTTPErrorTypeNEWOWNERNOTMEMBER captures enum value "NEW_OWNER_NOT_MEMBER"
+	PublicHTTPErrorTypeNEWOWNERNOTMEMBER PublicHTTPErrorType = "NEW_OWNER_NOT_MEMBER"
+
+	// PublicHTTPErrorTypeUNKNOWNCHAIN captures enum value "UNKNOWN_CHAIN"
+	PublicHTTPErrorTypeUNKNOWNCHAIN PublicHTTPErrorType = "UNKNOWN_CHAIN"
+
+	// PublicHTTPErrorTypeINVALIDADDRESS captures enum value "INVALID_ADDRESS"
+	PublicHTTPErrorTypeINVALIDADDRESS PublicHTTPErrorType = "INVALID_ADDRESS"
+
+	// PublicHTTPErrorTypeADDRESSBOOKENTRYNOTFOUND captures enum value "ADDRESS_BOOK_ENTRY_NOT_FOUND"
+	PublicHTTPErrorTypeADDRESSBOOKENTRYNOTFOUND PublicHTTPErrorType = "ADDRESS_BOOK_ENTRY_NOT_FOUND"
+
+	// PublicHTTPErrorTypeADDRESSBOOKENTRYEXISTS captures enum value "ADDRESS_BOOK_ENTRY_EXISTS"
+	PublicHTTPErrorTypeADDRESSBOOKENTRYEXISTS PublicHTTPErrorType = "ADDRESS_BOOK_ENTRY_EXISTS"
+
+	// PublicHTTPErrorTypeADDRESSBOOKENTRYNOTPENDING captures enum value "ADDRESS_BOOK_ENTRY_NOT_PENDING"
+	PublicHTTPErrorTypeADDRESSBOOKENTRYNOTPENDING PublicHTTPErrorType = "ADDRESS_BOOK_ENTRY_NOT_PENDING"
+
+	// PublicHTTPErrorTypeSELFAPPROVALFORBIDDEN captures enum value "SELF_APPROVAL_FORBIDDEN"
+	PublicHTTPErrorTypeSELFAPPROVALFORBIDDEN PublicHTTPErrorType = "SELF_APPROVAL_FORBIDDEN"
+
+	// PublicHTTPErrorTypeDESTINATIONNOTWHITELISTED captures enum value "DESTINATION_NOT_WHITELISTED"
+	PublicHTTPErrorTypeDESTINATIONNOTWHITELISTED PublicHTTPErrorType = "DESTINATION_NOT_WHITELISTED"
+
+	// PublicHTTPErrorTypeDESTINATIONCOOLINGOFF captures enum value "DESTINATION_COOLING_OFF"
+	PublicHTTPErrorTypeDESTINATIONCOOLINGOFF PublicHTTPErrorType = "DESTINATION_COOLING_OFF"
+
+	// PublicHTTPErrorTypeVAULTNOTFOUND captures enum value "VAULT_NOT_FOUND"
+	PublicHTTPErrorTypeVAULTNOTFOUND PublicHTTPErrorType = "VAULT_NOT_FOUND"
+
+	// PublicHTTPErrorTypeWALLETNOTINVAULT captures enum value "WALLET_NOT_IN_VAULT"
+	PublicHTTPErrorTypeWALLETNOTINVAULT PublicHTTPErrorType = "WALLET_NOT_IN_VAULT"
+
+	// PublicHTTPErrorTypeVAULTARCHIVED captures enum value "VAULT_ARCHIVED"
+	PublicHTTPErrorTypeVAULTARCHIVED PublicHTTPErrorType = "VAULT_ARCHIVED"
+
+	// PublicHTTPErrorTypeINVALIDTHRESHOLD captures enum value "INVALID_THRESHOLD"
+	PublicHTTPErrorTypeINVALIDTHRESHOLD PublicHTTPErrorType = "INVALID_THRESHOLD"
+
+	// PublicHTTPErrorTypeTHRESHOLDCHANGEPENDING captures enum value "THRESHOLD_CHANGE_PENDING"
+	PublicHTTPErrorTypeTHRESHOLDCHANGEPENDING PublicHTTPErrorType = "THRESHOLD_CHANGE_PENDING"
+
+	// PublicHTTPErrorTypePROPOSALNOTPENDING captures enum value "PROPOSAL_NOT_PENDING"
+	PublicHTTPErrorTypePROPOSALNOTPENDING PublicHTTPErrorType = "PROPOSAL_NOT_PENDING"
+
+	// PublicHTTPErrorTypePROPOSALALREADYVOTED captures enum value "PROPOSAL_ALREADY_VOTED"
+	PublicHTTPErrorTypePROPOSALALREADYVOTED PublicHTTPErrorType = "PROPOSAL_ALREADY_VOTED"
+
+	// PublicHTTPErrorTypeNOTELIGIBLEAPPROVER captures enum value "NOT_ELIGIBLE_APPROVER"
+	PublicHTTPErrorTypeNOTELIGIBLEAPPROVER PublicHTTPErrorType = "NOT_ELIGIBLE_APPROVER"
+
+	// PublicHTTPErrorTypePASSKEYREQUIRED captures enum value "PASSKEY_REQUIRED"
+	PublicHTTPErrorTypePASSKEYREQUIRED PublicHTTPErrorType = "PASSKEY_REQUIRED"
+
+	// PublicHTTPErrorTypePASSKEYASSERTIONINVALID captures enum value "PASSKEY_ASSERTION_INVALID"
+	PublicHTTPErrorTypePASSKEYASSERTIONINVALID PublicHTTPErrorType = "PASSKEY_ASSERTION_INVALID"
+
+	// PublicHTTPErrorTypeINVALIDTRANSACTION captures enum value "INVALID_TRANSACTION"
+	PublicHTTPErrorTypeINVALIDTRANSACTION PublicHTTPErrorType = "INVALID_TRANSACTION"
+
+	// PublicHTTPErrorTypeTRANSACTIONRECIPIENTMISMATCH captures enum value "TRANSACTION_RECIPIENT_MISMATCH"
+	PublicHTTPErrorTypeTRANSACTIONRECIPIENTMISMATCH PublicHTTPErrorType = "TRANSACTION_RECIPIENT_MISMATCH"
+
+	// PublicHTTPErrorTypeSIGNINGREQUESTNOTSIGNED captures enum value "SIGNING_REQUEST_NOT_SIGNED"
+	PublicHTTPErrorTypeSIGNINGREQUESTNOTSIGNED PublicHTTPErrorType = "SIGNING_REQUEST_NOT_SIGNED"
+
+	// PublicHTTPErrorTypeINVALIDCURSOR captures enum value "INVALID_CURSOR"
+	PublicHTTPErrorTypeINVALIDCURSOR PublicHTTPErrorType = "INVALID_CURSOR"
+
+	// PublicHTTPErrorTypeCHAININACTIVE captures enum value "CHAIN_INACTIVE"
+	PublicHTTPErrorTypeCHAININACTIVE PublicHTTPErrorType = "CHAIN_INACTIVE"
+
+	// PublicHTTPErrorTypeASSETNOTFOUND captures enum value "ASSET_NOT_FOUND"
+	PublicHTTPErrorTypeASSETNOTFOUND PublicHTTPErrorType = "ASSET_NOT_FOUND"
+
+	// PublicHTTPErrorTypeASSETEXISTS captures enum value "ASSET_EXISTS"
+	PublicHTTPErrorTypeASSETEXISTS PublicHTTPErrorType = "ASSET_EXISTS"
+
+	// PublicHTTPErrorTypeUNSUPPORTEDASSETTYPE captures enum value "UNSUPPORTED_ASSET_TYPE"
+	PublicHTTPErrorTypeUNSUPPORTEDASSETTYPE PublicHTTPErrorType = "UNSUPPORTED_ASSET_TYPE"
+
+	// PublicHTTPErrorTypeINVALIDDECIMALS captures enum value "INVALID_DECIMALS"
+	PublicHTTPErrorTypeINVALIDDECIMALS PublicHTTPErrorType = "INVALID_DECIMALS"
+
+	// PublicHTTPErrorTypeNOTATOKEN captures enum value "NOT_A_TOKEN"
+	PublicHTTPErrorTypeNOTATOKEN PublicHTTPErrorType = "NOT_A_TOKEN"
+
+	// PublicHTTPErrorTypeASSETMETADATAUNAVAILABLE captures enum value "ASSET_METADATA_UNAVAILABLE"
+	PublicHTTPErrorTypeASSETMETADATAUNAVAILABLE PublicHTTPErrorType = "ASSET_METADATA_UNAVAILABLE"
+
+	// PublicHTTPErrorTypeASSETMETADATAMISMATCH captures enum value "ASSET_METADATA_MISMATCH"
+	PublicHTTPErrorTypeASSETMETADATAMISMATCH PublicHTTPErrorType = "ASSET_METADATA_MISMATCH"
+
+	// PublicHTTPErrorTypeASSETSYMBOLCONFLICT captures enum value "ASSET_SYMBOL_CONFLICT"
+	PublicHTTPErrorTypeASSETSYMBOLCONFLICT PublicHTTPErrorType = "ASSET_SYMBOL_CONFLICT"
+
+	// PublicHTTPErrorTypeWEBHOOKPROVIDERNOTFOUND captures enum value "WEBHOOK_PROVIDER_NOT_FOUND"
+	PublicHTTPErrorTypeWEBHOOKPROVIDERNOTFOUND PublicHTTPErrorType = "WEBHOOK_PROVIDER_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVALIDWEBHOOKSIGNATURE captures enum value "INVALID_WEBHOOK_SIGNATURE"
+	PublicHTTPErrorTypeINVALIDWEBHOOKSIGNATURE PublicHTTPErrorType = "INVALID_WEBHOOK_SIGNATURE"
+
+	// PublicHTTPErrorTypeINVALIDWEBHOOKPAYLOAD captures enum value "INVALID_WEBHOOK_PAYLOAD"
+	PublicHTTPErrorTypeINVALIDWEBHOOKPAYLOAD PublicHTTPErrorType = "INVALID_WEBHOOK_PAYLOAD"
+
+	// PublicHTTPErrorTypeWEBHOOKEVENTNOTFOUND captures enum value "WEBHOOK_EVENT_NOT_FOUND"
+	PublicHTTPErrorTypeWEBHOOKEVENTNOTFOUND PublicHTTPErrorType = "WEBHOOK_EVENT_NOT_FOUND"
+
+	// PublicHTTPErrorTypeWEBHOOKEVENTNOTREPROCESSABLE captures enum value "WEBHOOK_EVENT_NOT_REPROCESSABLE"
+	PublicHTTPErrorTypeWEBHOOKEVENTNOTREPROCESSABLE PublicHTTPErrorType = "WEBHOOK_EVENT_NOT_REPROCESSABLE"
+
+	// PublicHTTPErrorTypeINVALIDWEBHOOKURL captures enum value "INVALID_WEBHOOK_URL"
+	PublicHTTPErrorTypeINVALIDWEBHOOKURL PublicHTTPErrorType = "INVALID_WEBHOOK_URL"
+
+	// PublicHTTPErrorTypeWEBHOOKENDPOINTNOTFOUND captures enum value "WEBHOOK_ENDPOINT_NOT_FOUND"
+	PublicHTTPErrorTypeWEBHOOKENDPOINTNOTFOUND PublicHTTPErrorType = "WEBHOOK_ENDPOINT_NOT_FOUND"
+
+	// PublicHTTPErrorTypeWEBHOOKDELIVERYNOTFOUND captures enum value "WEBHOOK_DELIVERY_NOT_FOUND"
+	PublicHTTPErrorTypeWEBHOOKDELIVERYNOTFOUND PublicHTTPErrorType = "WEBHOOK_DELIVERY_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVALIDDEVICEPUBLICKEY captures enum value "INVALID_DEVICE_PUBLIC_KEY"
+	PublicHTTPErrorTypeINVALIDDEVICEPUBLICKEY PublicHTTPErrorType = "INVALID_DEVICE_PUBLIC_KEY"
+
+	// PublicHTTPErrorTypeKEYSHARENOTFOUND captures enum value "KEY_SHARE_NOT_FOUND"
+	PublicHTTPErrorTypeKEYSHARENOTFOUND PublicHTTPErrorType = "KEY_SHARE_NOT_FOUND"
+
+	// PublicHTTPErrorTypeSHARENOTDELIVERED captures enum value "SHARE_NOT_DELIVERED"
+	PublicHTTPErrorTypeSHARENOTDELIVERED PublicHTTPErrorType = "SHARE_NOT_DELIVERED"
+
+	// PublicHTTPErrorTypeINVALIDSHAREDELIVERY captures enum value "INVALID_SHARE_DELIVERY"
+	PublicHTTPErrorTypeINVALIDSHAREDELIVERY PublicHTTPErrorType = "INVALID_SHARE_DELIVERY"
+
+	// PublicHTTPErrorTypeSHAREDELIVERYUNAVAILABLE captures enum value "SHARE_DELIVERY_UNAVAILABLE"
+	PublicHTTPErrorTypeSHAREDELIVERYUNAVAILABLE PublicHTTPErrorType = "SHARE_DELIVERY_UNAVAILABLE"
+
+	// PublicHTTPErrorTypeKEYRECOVERYNOTFOUND captures enum value "KEY_RECOVERY_NOT_FOUND"
+	PublicHTTPErrorTypeKEYRECOVERYNOTFOUND PublicHTTPErrorType = "KEY_RECOVERY_NOT_FOUND"
+
+	// PublicHTTPErrorTypeKEYRECOVERYINPROGRESS captures enum value "KEY_RECOVERY_IN_PROGRESS"
+	PublicHTTPErrorTypeKEYRECOVERYINPROGRESS PublicHTTPErrorType = "KEY_RECOVERY_IN_PROGRESS"
+
+	// PublicHTTPErrorTypeKEYRECOVERYNOTPENDING captures enum value "KEY_RECOVERY_NOT_PENDING"
+	PublicHTTPErrorTypeKEYRECOVERYNOTPENDING PublicHTTPErrorType = "KEY_RECOVERY_NOT_PENDING"
+
+	// PublicHTTPErrorTypeKEYRECOVERYALREADYVOTED captures enum value "KEY_RECOVERY_ALREADY_VOTED"
+	PublicHTTPErrorTypeKEYRECOVERYALREADYVOTED PublicHTTPErrorType = "KEY_RECOVERY_ALREADY_VOTED"
+
+	// PublicHTTPErrorTypeNOTRECOVERYAPPROVER captures enum value "NOT_RECOVERY_APPROVER"
+	PublicHTTPErrorTypeNOTRECOVERYAPPROVER PublicHTTPErrorType = "NOT_RECOVERY_APPROVER"
+
+	// PublicHTTPErrorTypeNORECOVERYAPPROVERS captures enum value "NO_RECOVERY_APPROVERS"
+	PublicHTTPErrorTypeNORECOVERYAPPROVERS PublicHTTPErrorType = "NO_RECOVERY_APPROVERS"
+
+	// PublicHTTPErrorTypeKEYNOTFOUND captures enum value "KEY_NOT_FOUND"
+	PublicHTTPErrorTypeKEYNOTFOUND PublicHTTPErrorType = "KEY_NOT_FOUND"
+
+	// PublicHTTPErrorTypeMPCNODESOFFLINE captures enum value "MPC_NODES_OFFLINE"
+	PublicHTTPErrorTypeMPCNODESOFFLINE PublicHTTPErrorType = "MPC_NODES_OFFLINE"
+
+	// PublicHTTPErrorTypeDEVICENOTFOUND captures enum value "DEVICE_NOT_FOUND"
+	PublicHTTPErrorTypeDEVICENOTFOUND PublicHTTPErrorType = "DEVICE_NOT_FOUND"
+
+	// PublicHTTPErrorTypeDEVICEALREADYENROLLED captures enum value "DEVICE_ALREADY_ENROLLED"
+	PublicHTTPErrorTypeDEVICEALREADYENROLLED PublicHTTPErrorType = "DEVICE_ALREADY_ENROLLED"
+
+	// PublicHTTPErrorTypeDEVICEREVOKED captures enum value "DEVICE_REVOKED"
+	PublicHTTPErrorTypeDEVICEREVOKED PublicHTTPErrorType = "DEVICE_REVOKED"
+
+	// PublicHTTPErrorTypeCREDENTIALNOTFOUND captures enum value "CREDENTIAL_NOT_FOUND"
+	PublicHTTPErrorTypeCREDENTIALNOTFOUND PublicHTTPErrorType = "CREDENTIAL_NOT_FOUND"
+
+	// PublicHTTPErrorTypeINVALIDSUCCESSORKEY captures enum value "INVALID_SUCCESSOR_KEY"
+	PublicHTTPErrorTypeINVALIDSUCCESSORKEY PublicHTTPErrorType = "INVALID_SUCCESSOR_KEY"
+
+	// PublicHTTPErrorTypeKEYNOTACTIVE captures enum value "KEY_NOT_ACTIVE"
+	PublicHTTPErrorTypeKEYNOTACTIVE PublicHTTPErrorType = "KEY_NOT_ACTIVE"
+
+	// PublicHTTPErrorTypeKEYNOTRETIRING captures enum value "KEY_NOT_RETIRING"
+	PublicHTTPErrorTypeKEYNOTRETIRING PublicHTTPErrorType = "KEY_NOT_RETIRING"
+
+	// PublicHTTPErrorTypeKEYRETIREMENTPENDING captures enum value "KEY_RETIREMENT_PENDING"
+	PublicHTTPErrorTypeKEYRETIREMENTPENDING PublicHTTPErrorType = "KEY_RETIREMENT_PENDING"
+
+	// PublicHTTPErrorTypeKEYFUNDSNOTSWEPT captures enum value "KEY_FUNDS_NOT_SWEPT"
+	PublicHTTPErrorTypeKEYFUNDSNOTSWEPT PublicHTTPErrorType = "KEY_FUNDS_NOT_SWEPT"
+
+	// PublicHTTPErrorTypeSWEEPDESTINATIONREQUIRED captures enum value "SWEEP_DESTINATION_REQUIRED"
+	PublicHTTPErrorTypeSWEEPDESTINATIONREQUIRED PublicHTTPErrorType = "SWEEP_DESTINATION_REQUIRED"
+
+	// PublicHTTPErrorTypeINVALIDMPCPROTOCOL captures enum value "INVALID_MPC_PROTOCOL"
+	PublicHTTPErrorTypeINVALIDMPCPROTOCOL PublicHTTPErrorType = "INVALID_MPC_PROTOCOL"
+
+	// PublicHTTPErrorTypeINVALIDKEYTHRESHOLD captures enum value "INVALID_KEY_THRESHOLD"
+	PublicHTTPErrorTypeINVALIDKEYTHRESHOLD PublicHTTPErrorType = "INVALID_KEY_THRESHOLD"
+
+	// PublicHTTPErrorTypeDUPLICATEKEYCURVE captures enum value "DUPLICATE_KEY_CURVE"
+	PublicHTTPErrorTypeDUPLICATEKEYCURVE PublicHTTPErrorType = "DUPLICATE_KEY_CURVE"
+
+	// PublicHTTPErrorTypeKEYSPECMISMATCH captures enum value "KEY_SPEC_MISMATCH"
+	PublicHTTPErrorTypeKEYSPECMISMATCH PublicHTTPErrorType = "KEY_SPEC_MISMATCH"
+
+	// PublicHTTPErrorTypeKEYREFRESHPENDING captures enum value "KEY_REFRESH_PENDING"
+	PublicHTTPErrorTypeKEYREFRESHPENDING PublicHTTPErrorType = "KEY_REFRESH_PENDING"
+
+	// PublicHTTPErrorTypeKEYREFRESHINPROGRESS captures enum value "KEY_REFRESH_IN_PROGRESS"
+	PublicHTTPErrorTypeKEYREFRESHINPROGRESS PublicHTTPErrorType = "KEY_REFRESH_IN_PROGRESS"
 )
 
 // for schema
@@ -75,7 +312,7 @@ var publicHttpErrorTypeEnum []interface{}
 
 func init() {
 	var res []PublicHTTPErrorType
-	if err := json.Unmarshal([]byte(`["generic","PUSH_TOKEN_ALREADY_EXISTS","OLD_PUSH_TOKEN_NOT_FOUND","ZERO_FILE_SIZE","USER_DEACTIVATED","INVALID_PASSWORD","NOT_LOCAL_USER","TOKEN_NOT_FOUND","TOKEN_EXPIRED","USER_ALREADY_EXISTS","MALFORMED_TOKEN","LAST_AUTHENTICATED_AT_EXCEEDED","MISSING_SCOPES"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["generic","PUSH_TOKEN_ALREADY_EXISTS","OLD_PUSH_TOKEN_NOT_FOUND","ZERO_FILE_SIZE","USER_DEACTIVATED","INVALID_PASSWORD","NOT_LOCAL_USER","TOKEN_NOT_FOUND","TOKEN_EXPIRED","USER_ALREADY_EXISTS","MALFORMED_TOKEN","LAST_AUTHENTICATED_AT_EXCEEDED","MISSING_SCOPES","NOT_ORGANIZATION_MEMBER","INSUFFICIENT_ROLE","ORGANIZATION_REQUIRED","ALREADY_ORGANIZATION_MEMBER","INVITATION_NOT_FOUND","INVITATION_EXPIRED","INVITATION_NOT_PENDING","REGISTRATION_PASSWORD_REQUIRED","LAST_ADMIN","OWNER_MEMBERSHIP_IMMUTABLE","NEW_OWNER_NOT_MEMBER","UNKNOWN_CHAIN","INVALID_ADDRESS","ADDRESS_BOOK_ENTRY_NOT_FOUND","ADDRESS_BOOK_ENTRY_EXISTS","ADDRESS_BOOK_ENTRY_NOT_PENDING","SELF_APPROVAL_FORBIDDEN","DESTINATION_NOT_WHITELISTED","DESTINATION_COOLING_OFF","VAULT_NOT_FOUND","WALLET_NOT_IN_VAULT","VAULT_ARCHIVED","INVALID_THRESHOLD","THRESHOLD_CHANGE_PENDING","PROPOSAL_NOT_PENDING","PROPOSAL_ALREADY_VOTED","NOT_ELIGIBLE_APPROVER","PASSKEY_REQUIRED","PASSKEY_ASSERTION_INVALID","INVALID_TRANSACTION","TRANSACTION_RECIPIENT_MISMATCH","SIGNING_REQUEST_NOT_SIGNED","INVALID_CURSOR","CHAIN_INACTIVE","ASSET_NOT_FOUND","ASSET_EXISTS","UNSUPPORTED_ASSET_TYPE","INVALID_DECIMALS","NOT_A_TOKEN","ASSET_METADATA_UNAVAILABLE","ASSET_METADATA_MISMATCH","ASSET_SYMBOL_CONFLICT","WEBHOOK_PROVIDER_NOT_FOUND","INVALID_WEBHOOK_SIGNATURE","INVALID_WEBHOOK_PAYLOAD","WEBHOOK_EVENT_NOT_FOUND","WEBHOOK_EVENT_NOT_REPROCESSABLE","INVALID_WEBHOOK_URL","WEBHOOK_ENDPOINT_NOT_FOUND","WEBHOOK_DELIVERY_NOT_FOUND","INVALID_DEVICE_PUBLIC_KEY","KEY_SHARE_NOT_FOUND","SHARE_NOT_DELIVERED","INVALID_SHARE_DELIVERY","SHARE_DELIVERY_UNAVAILABLE","KEY_RECOVERY_NOT_FOUND","KEY_RECOVERY_IN_PROGRESS","KEY_RECOVERY_NOT_PENDING","KEY_RECOVERY_ALREADY_VOTED","NOT_RECOVERY_APPROVER","NO_RECOVERY_APPROVERS","KEY_NOT_FOUND","MPC_NODES_OFFLINE","DEVICE_NOT_FOUND","DEVICE_ALREADY_ENROLLED","DEVICE_REVOKED","CREDENTIAL_NOT_FOUND","INVALID_SUCCESSOR_KEY","KEY_NOT_ACTIVE","KEY_NOT_RETIRING","KEY_RETIREMENT_PENDING","KEY_FUNDS_NOT_SWEPT","SWEEP_DESTINATION_REQUIRED","INVALID_MPC_PROTOCOL","INVALID_KEY_THRESHOLD","DUPLICATE_KEY_CURVE","KEY_SPEC_MISMATCH","KEY_REFRESH_PENDING","KEY_REFRESH_IN_PROGRESS"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -19,6 +19,11 @@ import (
 // swagger:model putUpdatePushTokenPayload
 type PutUpdatePushTokenPayload struct {
 
+	// Language of the device as BCP 47 tag, notifications are localized to it. The default language is used if omitted.
+	// Example: de-AT
+	// Max Length: 35
+	Language *string `json:"language,omitempty"`
+
 	// New push token for given provider.
 	// Example: 1c91e550-8167-439c-8021-dee7de2f7e96
 	// Required: true
@@ -30,7 +35,7 @@ type PutUpdatePushTokenPayload struct {
 	// Max Length: 500
 	OldToken *string `json:"oldToken,omitempty"`
 
-	// Identifier of the provider the token is for (eg. "fcm", "apn"). Currently only "fcm" is supported.
+	// Identifier of the provider the token is for, "fcm" or "apn" for tokens delivered by APNs directly.
 	// Example: fcm
 	// Required: true
 	// Max Length: 500
@@ -41,6 +46,10 @@ type PutUpdatePushTokenPayload struct {
 func (m *PutUpdatePushTokenPayload) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateLanguage(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNewToken(formats); err != nil {
 		res = append(res, err)
 	}
@@ -59,6 +68,18 @@ func (m *PutUpdatePushTokenPayload) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutUpdatePushTokenPayload) validateLanguage(formats strfmt.Registry) error {
+	if swag.IsZero(m.Language) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("language", "body", *m.Language, 35); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PutUpdatePushTokenPayload) validateNewToken(formats strfmt.Registry) error {
 
 	if err := validate.Required("newToken", "body", m.NewToken); err != nil {
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RequestKeyRecoveryPayload request key recovery payload
+//
+// swagger:model requestKeyRecoveryPayload
+type RequestKeyRecoveryPayload struct {
+
+	// key id
+	// Required: true
+	KeyID *string `json:"key_id"`
+
+	// reason
+	// Max Length: 500
+	Reason string `json:"reason,omitempty"`
+
+	// User whose share is recovered, e.g. after the device was lost
+	// Required: true
+	// Format: uuid4
+	UserID *strfmt.UUID4 `json:"user_id"`
+}
+
+// Validate validates this request key recovery payload
+func (m *RequestKeyRecoveryPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKeyID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUserID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RequestKeyRecoveryPayload) validateKeyID(formats strfmt.Registry) error {
+
+	if err := validate.Required("key_id", "body", m.KeyID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RequestKeyRecoveryPayload) validateReason(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reason) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("reason", "body", m.Reason, 500); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RequestKeyRecoveryPayload) validateUserID(formats strfmt.Registry) error {
+
+	if err := validate.Required("user_id", "body", m.UserID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("user_id", "body", "uuid4", m.UserID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this request key recovery payload based on context it is used
+func (m *RequestKeyRecoveryPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RequestKeyRecoveryPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RequestKeyRecoveryPayload) UnmarshalBinary(b []byte) error {
+	var res RequestKeyRecoveryPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
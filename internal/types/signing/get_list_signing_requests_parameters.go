@@ -0,0 +1,274 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package signing
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetListSigningRequestsParams creates a new GetListSigningRequestsParams object
+// no default values defined in spec.
+func NewGetListSigningRequestsParams() GetListSigningRequestsParams {
+
+	return GetListSigningRequestsParams{}
+}
+
+// GetListSigningRequestsParams contains all the bound params for the get list signing requests operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetListSigningRequests
+type GetListSigningRequestsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: query
+	*/
+	Limit *int64 `query:"limit"`
+	/*
+	  In: query
+	*/
+	OrganizationID *strfmt.UUID4 `query:"organizationId"`
+	/*
+	  In: query
+	*/
+	Page *int64 `query:"page"`
+	/*
+	  In: query
+	*/
+	Status *string `query:"status"`
+	/*
+	  In: query
+	*/
+	VaultID *string `query:"vaultId"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetListSigningRequestsParams() beforehand.
+func (o *GetListSigningRequestsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrganizationID, qhkOrganizationID, _ := qs.GetOK("organizationId")
+	if err := o.bindOrganizationID(qOrganizationID, qhkOrganizationID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPage, qhkPage, _ := qs.GetOK("page")
+	if err := o.bindPage(qPage, qhkPage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qVaultID, qhkVaultID, _ := qs.GetOK("vaultId")
+	if err := o.bindVaultID(qVaultID, qhkVaultID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetListSigningRequestsParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// limit
+	// Required: false
+	// AllowEmptyValue: false
+
+	// organizationId
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateOrganizationID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// page
+	// Required: false
+	// AllowEmptyValue: false
+
+	// status
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// vaultId
+	// Required: false
+	// AllowEmptyValue: false
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetListSigningRequestsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	return nil
+}
+
+// bindOrganizationID binds and validates parameter OrganizationID from query.
+func (o *GetListSigningRequestsParams) bindOrganizationID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("organizationId", "query", "strfmt.UUID4", raw)
+	}
+	o.OrganizationID = (value.(*strfmt.UUID4))
+
+	if err := o.validateOrganizationID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrganizationID carries on validations for parameter OrganizationID
+func (o *GetListSigningRequestsParams) validateOrganizationID(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.OrganizationID == nil {
+		return nil
+	}
+
+	if err := validate.FormatOf("organizationId", "query", "uuid4", (*o.OrganizationID).String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindPage binds and validates parameter Page from query.
+func (o *GetListSigningRequestsParams) bindPage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("page", "query", "int64", raw)
+	}
+	o.Page = &value
+
+	return nil
+}
+
+// bindStatus binds and validates parameter Status from query.
+func (o *GetListSigningRequestsParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Status = &raw
+
+	if err := o.validateStatus(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStatus carries on validations for parameter Status
+func (o *GetListSigningRequestsParams) validateStatus(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Status == nil {
+		return nil
+	}
+
+	if err := validate.EnumCase("status", "query", *o.Status, []interface{}{"pending", "completed", "broadcast", "rejected", "cancelled"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindVaultID binds and validates parameter VaultID from query.
+func (o *GetListSigningRequestsParams) bindVaultID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.VaultID = &raw
+
+	return nil
+}
@@ -36,32 +36,94 @@ func (o *SwaggerSpec) initHandlerCache() {
 	o.Handlers["HEAD"] = make(map[string]bool)
 	o.Handlers["PATCH"] = make(map[string]bool)
 
+	o.Handlers["DELETE"]["/api/v1/organizations/{orgId}/address-book/{entryId}"] = true
+	o.Handlers["DELETE"]["/api/v1/organizations/{orgId}/invitations/{invitationId}"] = true
 	o.Handlers["DELETE"]["/api/v1/organizations/{orgId}/members/{userId}"] = true
+	o.Handlers["DELETE"]["/api/v1/credentials/{credentialId}"] = true
+	o.Handlers["DELETE"]["/api/v1/devices/{deviceId}"] = true
 	o.Handlers["DELETE"]["/api/v1/auth/account"] = true
+	o.Handlers["DELETE"]["/api/v1/organizations/{orgId}/webhooks/{endpointId}"] = true
 	o.Handlers["GET"]["/.well-known/assetlinks.json"] = true
 	o.Handlers["GET"]["/.well-known/apple-app-site-association"] = true
+	o.Handlers["GET"]["/api/v1/assets/metadata"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/backup-health"] = true
 	o.Handlers["GET"]["/api/v1/auth/register"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/audit-logs/download"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/audit-logs/export"] = true
 	o.Handlers["GET"]["/-/healthy"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/recoveries/{recoveryId}"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/address-book"] = true
+	o.Handlers["GET"]["/api/v1/assets"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/audit-logs"] = true
+	o.Handlers["GET"]["/api/v1/chains"] = true
+	o.Handlers["GET"]["/api/v1/devices"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/recoveries"] = true
+	o.Handlers["GET"]["/api/v1/vaults/{vaultId}/keys/{keyId}/refreshes"] = true
+	o.Handlers["GET"]["/api/v1/nodes"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/invitations"] = true
 	o.Handlers["GET"]["/api/v1/organizations/{orgId}/members"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/vaults"] = true
 	o.Handlers["GET"]["/api/v1/organizations"] = true
+	o.Handlers["GET"]["/api/v1/vaults/{vaultId}/keys"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/backups"] = true
+	o.Handlers["GET"]["/api/v1/requests"] = true
+	o.Handlers["GET"]["/api/v1/vaults/{vaultId}/deposits"] = true
+	o.Handlers["GET"]["/api/v1/vaults"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/webhooks/{endpointId}/deliveries"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/webhooks"] = true
+	o.Handlers["GET"]["/-/webhooks/events"] = true
+	o.Handlers["GET"]["/api/v1/nodes/keys/{keyId}"] = true
+	o.Handlers["GET"]["/api/v1/organizations/{orgId}/notification-preferences"] = true
 	o.Handlers["GET"]["/-/ready"] = true
+	o.Handlers["GET"]["/api/v1/vaults/{vaultId}/keys/{keyId}"] = true
+	o.Handlers["GET"]["/api/v1/backups/shares/{keyId}"] = true
 	o.Handlers["GET"]["/swagger.yml"] = true
 	o.Handlers["GET"]["/api/v1/auth/userinfo"] = true
+	o.Handlers["GET"]["/api/v1/vaults/{vaultId}"] = true
 	o.Handlers["GET"]["/-/version"] = true
-	o.Handlers["GET"]["/api/v1/requests"] = true
+	o.Handlers["PATCH"]["/api/v1/organizations/{orgId}/address-book/{entryId}"] = true
+	o.Handlers["PATCH"]["/api/v1/assets/{assetId}"] = true
+	o.Handlers["PATCH"]["/api/v1/vaults/{vaultId}/keys/{keyId}"] = true
+	o.Handlers["PATCH"]["/api/v1/vaults/{vaultId}"] = true
+	o.Handlers["POST"]["/api/v1/invitations/{token}/accept"] = true
 	o.Handlers["POST"]["/api/v1/organizations/{orgId}/members"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/address-book/{entryId}/approve"] = true
 	o.Handlers["POST"]["/api/v1/requests/{requestId}/approve"] = true
+	o.Handlers["POST"]["/api/v1/vaults/{vaultId}/proposals/{proposalId}/approve"] = true
+	o.Handlers["POST"]["/api/v1/vaults/{vaultId}/archive"] = true
+	o.Handlers["POST"]["/api/v1/requests/{requestId}/broadcast"] = true
 	o.Handlers["POST"]["/api/v1/auth/change-password"] = true
 	o.Handlers["POST"]["/api/v1/auth/register/{registrationToken}"] = true
+	o.Handlers["POST"]["/api/v1/vaults/{vaultId}/keys/{keyId}/complete-retirement"] = true
+	o.Handlers["POST"]["/api/v1/backups/shares/{keyId}/confirm"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/address-book"] = true
+	o.Handlers["POST"]["/api/v1/assets"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/invitations"] = true
 	o.Handlers["POST"]["/api/v1/organizations"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/vaults"] = true
 	o.Handlers["POST"]["/api/v1/vaults/{vaultId}/sign"] = true
 	o.Handlers["POST"]["/api/v1/vaults"] = true
 	o.Handlers["POST"]["/api/v1/vaults/{vaultId}/wallets"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/webhooks"] = true
+	o.Handlers["POST"]["/api/v1/backups/shares/{keyId}/deliver"] = true
+	o.Handlers["POST"]["/api/v1/devices/{deviceId}/heartbeat"] = true
+	o.Handlers["POST"]["/api/v1/devices"] = true
 	o.Handlers["POST"]["/api/v1/auth/forgot-password/complete"] = true
 	o.Handlers["POST"]["/api/v1/auth/forgot-password"] = true
 	o.Handlers["POST"]["/api/v1/auth/login"] = true
 	o.Handlers["POST"]["/api/v1/auth/logout"] = true
+	o.Handlers["POST"]["/api/v1/credentials/challenge"] = true
+	o.Handlers["POST"]["/api/v1/webhooks/{provider}"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/webhooks/{endpointId}/deliveries/{deliveryId}/redeliver"] = true
+	o.Handlers["POST"]["/api/v1/vaults/{vaultId}/keys/{keyId}/refresh"] = true
 	o.Handlers["POST"]["/api/v1/auth/refresh"] = true
 	o.Handlers["POST"]["/api/v1/auth/register"] = true
+	o.Handlers["POST"]["/-/webhooks/events/{eventId}/reprocess"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/recoveries"] = true
+	o.Handlers["POST"]["/api/v1/vaults/{vaultId}/keys/{keyId}/retire"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/transfer-ownership"] = true
+	o.Handlers["POST"]["/api/v1/organizations/{orgId}/recoveries/{recoveryId}/vote"] = true
+	o.Handlers["PUT"]["/api/v1/organizations/{orgId}/default"] = true
+	o.Handlers["PUT"]["/api/v1/organizations/{orgId}/notification-preferences"] = true
 	o.Handlers["PUT"]["/api/v1/push/token"] = true
 }
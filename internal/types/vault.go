@@ -0,0 +1,423 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Vault vault
+//
+// swagger:model vault
+type Vault struct {
+
+	// archived at
+	// Format: date-time
+	ArchivedAt strfmt.DateTime `json:"archived_at,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// id
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// keys
+	Keys []*VaultKey `json:"keys"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// organization id
+	// Format: uuid4
+	OrganizationID strfmt.UUID4 `json:"organization_id,omitempty"`
+
+	// pending proposals
+	PendingProposals []*VaultProposal `json:"pending_proposals"`
+
+	// status
+	// Required: true
+	// Enum: [active archived]
+	Status *string `json:"status"`
+
+	// threshold
+	// Required: true
+	Threshold *int64 `json:"threshold"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+
+	// wallets
+	Wallets []*VaultWallet `json:"wallets"`
+
+	// whitelist only
+	WhitelistOnly bool `json:"whitelist_only,omitempty"`
+}
+
+// Validate validates this vault
+func (m *Vault) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateArchivedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKeys(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOrganizationID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePendingProposals(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThreshold(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWallets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Vault) validateArchivedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ArchivedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("archived_at", "body", "date-time", m.ArchivedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validateKeys(formats strfmt.Registry) error {
+	if swag.IsZero(m.Keys) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Keys); i++ {
+		if swag.IsZero(m.Keys[i]) { // not required
+			continue
+		}
+
+		if m.Keys[i] != nil {
+			if err := m.Keys[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("keys" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Vault) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validateOrganizationID(formats strfmt.Registry) error {
+	if swag.IsZero(m.OrganizationID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("organization_id", "body", "uuid4", m.OrganizationID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validatePendingProposals(formats strfmt.Registry) error {
+	if swag.IsZero(m.PendingProposals) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PendingProposals); i++ {
+		if swag.IsZero(m.PendingProposals[i]) { // not required
+			continue
+		}
+
+		if m.PendingProposals[i] != nil {
+			if err := m.PendingProposals[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pending_proposals" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pending_proposals" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var vaultTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["active","archived"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		vaultTypeStatusPropEnum = append(vaultTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// VaultStatusActive captures enum value "active"
+	VaultStatusActive string = "active"
+
+	// VaultStatusArchived captures enum value "archived"
+	VaultStatusArchived string = "archived"
+)
+
+// prop value enum
+func (m *Vault) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, vaultTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Vault) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validateThreshold(formats strfmt.Registry) error {
+
+	if err := validate.Required("threshold", "body", m.Threshold); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Vault) validateWallets(formats strfmt.Registry) error {
+	if swag.IsZero(m.Wallets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Wallets); i++ {
+		if swag.IsZero(m.Wallets[i]) { // not required
+			continue
+		}
+
+		if m.Wallets[i] != nil {
+			if err := m.Wallets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("wallets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("wallets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this vault based on the context it is used
+func (m *Vault) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateKeys(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePendingProposals(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateWallets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Vault) contextValidateKeys(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Keys); i++ {
+
+		if m.Keys[i] != nil {
+			if err := m.Keys[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("keys" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Vault) contextValidatePendingProposals(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.PendingProposals); i++ {
+
+		if m.PendingProposals[i] != nil {
+			if err := m.PendingProposals[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pending_proposals" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pending_proposals" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Vault) contextValidateWallets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Wallets); i++ {
+
+		if m.Wallets[i] != nil {
+			if err := m.Wallets[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("wallets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("wallets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Vault) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Vault) UnmarshalBinary(b []byte) error {
+	var res Vault
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,108 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vault
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPostArchiveVaultRouteParams creates a new PostArchiveVaultRouteParams object
+// no default values defined in spec.
+func NewPostArchiveVaultRouteParams() PostArchiveVaultRouteParams {
+
+	return PostArchiveVaultRouteParams{}
+}
+
+// PostArchiveVaultRouteParams contains all the bound params for the post archive vault route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostArchiveVaultRoute
+type PostArchiveVaultRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*ID of vault
+	  Required: true
+	  In: path
+	*/
+	VaultID strfmt.UUID4 `param:"vaultId"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostArchiveVaultRouteParams() beforehand.
+func (o *PostArchiveVaultRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rVaultID, rhkVaultID, _ := route.Params.GetOK("vaultId")
+	if err := o.bindVaultID(rVaultID, rhkVaultID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostArchiveVaultRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// vaultId
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	if err := o.validateVaultID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindVaultID binds and validates parameter VaultID from path.
+func (o *PostArchiveVaultRouteParams) bindVaultID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("vaultId", "path", "strfmt.UUID4", raw)
+	}
+	o.VaultID = *(value.(*strfmt.UUID4))
+
+	if err := o.validateVaultID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateVaultID carries on validations for parameter VaultID
+func (o *PostArchiveVaultRouteParams) validateVaultID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("vaultId", "path", "uuid4", o.VaultID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
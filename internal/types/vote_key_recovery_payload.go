@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VoteKeyRecoveryPayload vote key recovery payload
+//
+// swagger:model voteKeyRecoveryPayload
+type VoteKeyRecoveryPayload struct {
+
+	// action
+	// Required: true
+	// Enum: [approve reject]
+	Action *string `json:"action"`
+
+	// Base64 encoded WebAuthn Authenticator Data
+	// Format: byte
+	AuthenticatorData strfmt.Base64 `json:"authenticator_data,omitempty"`
+
+	// Base64 encoded WebAuthn Client Data JSON
+	// Format: byte
+	ClientDataJSON strfmt.Base64 `json:"client_data_json,omitempty"`
+
+	// Base64 encoded WebAuthn Credential ID
+	// Format: byte
+	CredentialID strfmt.Base64 `json:"credential_id,omitempty"`
+
+	// Base64 encoded WebAuthn Assertion Signature
+	// Format: byte
+	Signature strfmt.Base64 `json:"signature,omitempty"`
+}
+
+// Validate validates this vote key recovery payload
+func (m *VoteKeyRecoveryPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var voteKeyRecoveryPayloadTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["approve","reject"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		voteKeyRecoveryPayloadTypeActionPropEnum = append(voteKeyRecoveryPayloadTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// VoteKeyRecoveryPayloadActionApprove captures enum value "approve"
+	VoteKeyRecoveryPayloadActionApprove string = "approve"
+
+	// VoteKeyRecoveryPayloadActionReject captures enum value "reject"
+	VoteKeyRecoveryPayloadActionReject string = "reject"
+)
+
+// prop value enum
+func (m *VoteKeyRecoveryPayload) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, voteKeyRecoveryPayloadTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *VoteKeyRecoveryPayload) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this vote key recovery payload based on context it is used
+func (m *VoteKeyRecoveryPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VoteKeyRecoveryPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VoteKeyRecoveryPayload) UnmarshalBinary(b []byte) error {
+	var res VoteKeyRecoveryPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,298 @@
+// Package address validates and normalizes destination addresses per chain type.
+package address
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	ChainTypeEVM    = "EVM"
+	ChainTypeUTXO   = "UTXO"
+	ChainTypeSolana = "SOLANA"
+	ChainTypeTron   = "TRON"
+
+	maxLength = 255
+)
+
+var (
+	ErrInvalidAddress = errors.New("invalid address")
+
+	evmAddressRegex = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+)
+
+// Normalize validates the address for the given chain type (as stored in chains.type) and returns its
+// canonical representation, which is safe to compare byte-wise: EVM addresses are returned EIP-55
+// checksummed, bech32 addresses lowercased. Addresses of unknown chain types are only trimmed.
+func Normalize(chainType string, addr string) (string, error) {
+	addr = strings.TrimSpace(addr)
+	if len(addr) == 0 || len(addr) > maxLength {
+		return "", ErrInvalidAddress
+	}
+
+	switch strings.ToUpper(chainType) {
+	case ChainTypeEVM:
+		return normalizeEVM(addr)
+	case ChainTypeUTXO:
+		return normalizeUTXO(addr)
+	case ChainTypeSolana:
+		return normalizeSolana(addr)
+	case ChainTypeTron:
+		return normalizeTron(addr)
+	default:
+		if strings.ContainsAny(addr, " \t\r\n") {
+			return "", ErrInvalidAddress
+		}
+		return addr, nil
+	}
+}
+
+func normalizeEVM(addr string) (string, error) {
+	if !evmAddressRegex.MatchString(addr) {
+		return "", ErrInvalidAddress
+	}
+
+	checksummed := eip55Checksum(addr[2:])
+
+	// Mixed case addresses carry a checksum which has to match, single case addresses don't.
+	hexPart := addr[2:]
+	if hexPart != strings.ToLower(hexPart) && hexPart != strings.ToUpper(hexPart) && "0x"+hexPart != checksummed {
+		return "", ErrInvalidAddress
+	}
+
+	return checksummed, nil
+}
+
+// eip55Checksum returns the checksummed representation of the 40 hex digits of an EVM address.
+// https://eips.ethereum.org/EIPS/eip-55
+func eip55Checksum(hexAddr string) string {
+	lower := strings.ToLower(hexAddr)
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte(lower))
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	res := []byte(lower)
+	for i, c := range res {
+		if c >= 'a' && c <= 'f' && hash[i] >= '8' {
+			res[i] = c - ('a' - 'A')
+		}
+	}
+
+	return "0x" + string(res)
+}
+
+func normalizeUTXO(addr string) (string, error) {
+	lower := strings.ToLower(addr)
+	for _, hrp := range []string{"bc", "tb", "bcrt"} {
+		if strings.HasPrefix(lower, hrp+"1") {
+			if err := verifyBech32(addr, hrp); err != nil {
+				return "", err
+			}
+			return lower, nil
+		}
+	}
+
+	// Legacy P2PKH and P2SH addresses are Base58Check encoded: version byte, 20 bytes hash and checksum.
+	decoded, err := DecodeBase58(addr)
+	if err != nil || len(decoded) != 25 {
+		return "", ErrInvalidAddress
+	}
+	switch decoded[0] {
+	case 0x00, 0x05, 0x6f, 0xc4: // mainnet and testnet P2PKH / P2SH
+	default:
+		return "", ErrInvalidAddress
+	}
+	if !verifyBase58Checksum(decoded) {
+		return "", ErrInvalidAddress
+	}
+
+	return addr, nil
+}
+
+func normalizeTron(addr string) (string, error) {
+	// Tron addresses are Base58Check encoded: version byte 0x41, 20 bytes account hash and checksum.
+	decoded, err := DecodeBase58(addr)
+	if err != nil || len(decoded) != 25 || decoded[0] != 0x41 || !verifyBase58Checksum(decoded) {
+		return "", ErrInvalidAddress
+	}
+	return addr, nil
+}
+
+// verifyBase58Checksum checks the trailing 4 bytes of a decoded Base58Check payload,
+// which are the start of the double SHA-256 of the preceding bytes.
+func verifyBase58Checksum(decoded []byte) bool {
+	payload := decoded[:len(decoded)-4]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	for i := 0; i < 4; i++ {
+		if second[i] != decoded[len(payload)+i] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeSolana(addr string) (string, error) {
+	// Solana addresses are the Base58 encoded 32 bytes ed25519 public key.
+	decoded, err := DecodeBase58(addr)
+	if err != nil || len(decoded) != 32 {
+		return "", ErrInvalidAddress
+	}
+	return addr, nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// DecodeBase58 decodes the Base58 string using the Bitcoin alphabet, as used by Bitcoin, Solana and Tron addresses.
+func DecodeBase58(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range []byte(s) {
+		idx := strings.IndexByte(base58Alphabet, c)
+		if idx < 0 {
+			return nil, ErrInvalidAddress
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(idx)))
+	}
+
+	// Every leading '1' encodes a leading zero byte.
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// EncodeBase58 encodes the bytes using the Bitcoin alphabet, see DecodeBase58.
+func EncodeBase58(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var res []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		res = append(res, base58Alphabet[mod.Int64()])
+	}
+
+	// Every leading zero byte is encoded as a leading '1'.
+	for i := 0; i < len(b) && b[i] == 0; i++ {
+		res = append(res, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return string(res)
+}
+
+const (
+	bech32Charset         = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32Constant        = 1
+	bech32mConstant       = 0x2bc830a3
+	bech32ChecksumLength  = 6
+	segwitMinProgramBytes = 2
+	segwitMaxProgramBytes = 40
+)
+
+// verifyBech32 verifies a segwit address as specified by BIP-173 (version 0, bech32)
+// and BIP-350 (version 1+, bech32m).
+func verifyBech32(addr string, hrp string) error {
+	if addr != strings.ToLower(addr) && addr != strings.ToUpper(addr) {
+		return ErrInvalidAddress
+	}
+	addr = strings.ToLower(addr)
+
+	dataPart := addr[len(hrp)+1:]
+	if len(dataPart) < bech32ChecksumLength+1 || len(addr) > 90 {
+		return ErrInvalidAddress
+	}
+
+	data := make([]byte, 0, len(dataPart))
+	for _, c := range []byte(dataPart) {
+		idx := strings.IndexByte(bech32Charset, c)
+		if idx < 0 {
+			return ErrInvalidAddress
+		}
+		data = append(data, byte(idx))
+	}
+
+	version := data[0]
+	if version > 16 {
+		return ErrInvalidAddress
+	}
+
+	expected := uint32(bech32Constant)
+	if version > 0 {
+		expected = bech32mConstant
+	}
+	if bech32Polymod(append(bech32ExpandHRP(hrp), data...)) != expected {
+		return ErrInvalidAddress
+	}
+
+	program, err := convertBits(data[1:len(data)-bech32ChecksumLength], 5, 8)
+	if err != nil || len(program) < segwitMinProgramBytes || len(program) > segwitMaxProgramBytes {
+		return ErrInvalidAddress
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return ErrInvalidAddress
+	}
+
+	return nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32ExpandHRP(hrp string) []byte {
+	res := make([]byte, 0, len(hrp)*2+1)
+	for _, c := range []byte(hrp) {
+		res = append(res, c>>5)
+	}
+	res = append(res, 0)
+	for _, c := range []byte(hrp) {
+		res = append(res, c&31)
+	}
+	return res
+}
+
+// convertBits regroups the 5 bit groups of bech32 data into bytes, rejecting non-zero padding.
+func convertBits(data []byte, fromBits uint, toBits uint) ([]byte, error) {
+	acc := uint32(0)
+	bits := uint(0)
+	maxv := uint32(1)<<toBits - 1
+	res := make([]byte, 0, len(data)*int(fromBits)/int(toBits))
+	for _, v := range data {
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			res = append(res, byte((acc>>bits)&maxv))
+		}
+	}
+	if bits >= fromBits || (acc<<(toBits-bits))&maxv != 0 {
+		return nil, ErrInvalidAddress
+	}
+	return res, nil
+}
@@ -0,0 +1,132 @@
+package address_test
+
+import (
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEVM(t *testing.T) {
+	// https://eips.ethereum.org/EIPS/eip-55 test vectors
+	checksummed := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	res, err := address.Normalize("EVM", checksummed)
+	require.NoError(t, err)
+	assert.Equal(t, checksummed, res)
+
+	res, err = address.Normalize("evm", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	require.NoError(t, err)
+	assert.Equal(t, checksummed, res)
+
+	res, err = address.Normalize("EVM", " 0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED ")
+	require.NoError(t, err)
+	assert.Equal(t, checksummed, res)
+
+	for _, invalid := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", // wrong checksum
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg",
+		"",
+	} {
+		_, err := address.Normalize("EVM", invalid)
+		assert.ErrorIs(t, err, address.ErrInvalidAddress, invalid)
+	}
+}
+
+func TestNormalizeUTXO(t *testing.T) {
+	for _, valid := range []string{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",                             // P2PKH
+		"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",                             // P2SH
+		"bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",                     // P2WPKH
+		"bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3297", // BIP-350 P2TR
+	} {
+		res, err := address.Normalize("UTXO", valid)
+		require.NoError(t, err, valid)
+		assert.Equal(t, valid, res)
+	}
+
+	res, err := address.Normalize("UTXO", "BC1QAR0SRRR7XFKVY5L643LYDNW9RE59GTZZWF5MDQ")
+	require.NoError(t, err)
+	assert.Equal(t, "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq", res)
+
+	for _, invalid := range []string{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb",                             // wrong checksum
+		"bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdp",                     // wrong checksum
+		"bc1Qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",                     // mixed case
+		"bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3298", // wrong bech32m checksum
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	} {
+		_, err := address.Normalize("UTXO", invalid)
+		assert.ErrorIs(t, err, address.ErrInvalidAddress, invalid)
+	}
+}
+
+func TestNormalizeSolana(t *testing.T) {
+	res, err := address.Normalize("SOLANA", "11111111111111111111111111111111")
+	require.NoError(t, err)
+	assert.Equal(t, "11111111111111111111111111111111", res)
+
+	res, err = address.Normalize("SOLANA", "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	require.NoError(t, err)
+	assert.Equal(t, "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", res)
+
+	for _, invalid := range []string{
+		"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5D",  // too short
+		"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5D0", // invalid character
+		"",
+	} {
+		_, err := address.Normalize("SOLANA", invalid)
+		assert.ErrorIs(t, err, address.ErrInvalidAddress, invalid)
+	}
+}
+
+func TestNormalizeTron(t *testing.T) {
+	res, err := address.Normalize("TRON", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	require.NoError(t, err)
+	assert.Equal(t, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", res)
+
+	for _, invalid := range []string{
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", // checksum mismatch
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6",  // too short
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", // bitcoin version byte
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	} {
+		_, err := address.Normalize("TRON", invalid)
+		assert.ErrorIs(t, err, address.ErrInvalidAddress, invalid)
+	}
+}
+
+func TestBase58RoundTrip(t *testing.T) {
+	for _, encoded := range []string{
+		"11111111111111111111111111111111",
+		"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+	} {
+		decoded, err := address.DecodeBase58(encoded)
+		require.NoError(t, err, encoded)
+		assert.Equal(t, encoded, address.EncodeBase58(decoded))
+	}
+
+	assert.Len(t, mustDecodeBase58(t, "11111111111111111111111111111111"), 32)
+	assert.Equal(t, "", address.EncodeBase58(nil))
+}
+
+func mustDecodeBase58(t *testing.T, s string) []byte {
+	t.Helper()
+	decoded, err := address.DecodeBase58(s)
+	require.NoError(t, err)
+	return decoded
+}
+
+func TestNormalizeUnknownChainType(t *testing.T) {
+	res, err := address.Normalize("COSMOS", " cosmos1abc ")
+	require.NoError(t, err)
+	assert.Equal(t, "cosmos1abc", res)
+
+	_, err = address.Normalize("COSMOS", "cosmos1 abc")
+	assert.ErrorIs(t, err, address.ErrInvalidAddress)
+}
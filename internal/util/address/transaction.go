@@ -0,0 +1,184 @@
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidTransaction     = errors.New("invalid transaction")
+	ErrUnsupportedTransaction = errors.New("unsupported transaction")
+)
+
+// erc20Recipients maps selectors of ERC-20 calls moving or granting access to funds to the index of the
+// argument receiving them: transfer(to, value), transferFrom(from, to, value) and approve(spender, value).
+var erc20Recipients = map[string]int{
+	"a9059cbb": 0,
+	"23b872dd": 1,
+	"095ea7b3": 0,
+}
+
+// Transfer is the movement of funds of a decoded transaction.
+type Transfer struct {
+	// To is the normalized address the transaction is sent to.
+	To string
+	// HasValue reports whether the transaction moves native funds.
+	HasValue bool
+	// TokenRecipient is the normalized address receiving the funds of an ERC-20 call in the calldata, empty for
+	// other calls.
+	TokenRecipient string
+}
+
+// Recipient returns the address receiving the funds of the transaction. The recipient within ERC-20 calldata is
+// only trusted for calls without native value to a known token contract, as any contract may implement the
+// selectors; every other transaction is directed at its to address.
+func (t *Transfer) Recipient(tokenContract bool) string {
+	if t.TokenRecipient == "" || t.HasValue || !tokenContract {
+		return t.To
+	}
+	return t.TokenRecipient
+}
+
+// DecodeTransfer decodes the hex encoded unsigned transaction for the given chain type.
+// Only EVM transactions are decoded, others return ErrUnsupportedTransaction.
+func DecodeTransfer(chainType string, txHex string) (*Transfer, error) {
+	if strings.ToUpper(chainType) != ChainTypeEVM {
+		return nil, ErrUnsupportedTransaction
+	}
+
+	raw, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(txHex), "0x"))
+	if err != nil || len(raw) == 0 {
+		return nil, ErrInvalidTransaction
+	}
+
+	to, value, data, err := decodeEVMTransaction(raw)
+	if err != nil {
+		return nil, err
+	}
+	// Contract creations have no recipient.
+	if len(to) != 20 {
+		return nil, ErrInvalidTransaction
+	}
+
+	transfer := &Transfer{
+		HasValue: len(bytes.TrimLeft(value, "\x00")) > 0,
+	}
+	if transfer.To, err = normalizeEVM("0x" + hex.EncodeToString(to)); err != nil {
+		return nil, err
+	}
+
+	if len(data) >= 4 {
+		if arg, ok := erc20Recipients[hex.EncodeToString(data[:4])]; ok {
+			word := data[4:]
+			if len(word) < (arg+1)*32 {
+				return nil, ErrInvalidTransaction
+			}
+			word = word[arg*32 : (arg+1)*32]
+			// Addresses are left padded to 32 bytes.
+			if !bytes.Equal(word[:12], make([]byte, 12)) {
+				return nil, ErrInvalidTransaction
+			}
+			if transfer.TokenRecipient, err = normalizeEVM("0x" + hex.EncodeToString(word[12:])); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return transfer, nil
+}
+
+// decodeEVMTransaction returns the to, value and data fields of an unsigned legacy (optionally EIP-155), EIP-2930 or
+// EIP-1559 transaction.
+func decodeEVMTransaction(raw []byte) ([]byte, []byte, []byte, error) {
+	// nonce, gasPrice, gasLimit, to, value, data and with EIP-155 chainId, 0, 0
+	toIdx, dataIdx, minFields, accessListIdx := 3, 5, 6, -1
+	switch raw[0] {
+	case 0x01:
+		// chainId, nonce, gasPrice, gasLimit, to, value, data, accessList
+		toIdx, dataIdx, minFields, accessListIdx = 4, 6, 8, 7
+		raw = raw[1:]
+	case 0x02:
+		// chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimit, to, value, data, accessList
+		toIdx, dataIdx, minFields, accessListIdx = 5, 7, 9, 8
+		raw = raw[1:]
+	}
+
+	list, rest, isList, err := decodeRLP(raw)
+	if err != nil || !isList || len(rest) != 0 {
+		return nil, nil, nil, ErrInvalidTransaction
+	}
+
+	var fields [][]byte
+	for len(list) > 0 {
+		var field []byte
+		var fieldIsList bool
+		field, list, fieldIsList, err = decodeRLP(list)
+		if err != nil {
+			return nil, nil, nil, ErrInvalidTransaction
+		}
+		if fieldIsList != (len(fields) == accessListIdx) {
+			return nil, nil, nil, ErrInvalidTransaction
+		}
+		fields = append(fields, field)
+	}
+	// Only legacy transactions may carry the three EIP-155 fields.
+	if len(fields) != minFields && (accessListIdx >= 0 || len(fields) != minFields+3) {
+		return nil, nil, nil, ErrInvalidTransaction
+	}
+
+	// The value directly precedes the data in every type.
+	return fields[toIdx], fields[dataIdx-1], fields[dataIdx], nil
+}
+
+// decodeRLP decodes the first item of the RLP encoded input and returns its payload, the remaining input and
+// whether the item is a list. https://ethereum.org/en/developers/docs/data-structures-and-encoding/rlp/
+func decodeRLP(in []byte) ([]byte, []byte, bool, error) {
+	if len(in) == 0 {
+		return nil, nil, false, ErrInvalidTransaction
+	}
+
+	prefix := in[0]
+	var offset, length int
+	isList := prefix >= 0xc0
+	switch {
+	case prefix < 0x80:
+		return in[:1], in[1:], false, nil
+	case prefix <= 0xb7:
+		offset, length = 1, int(prefix-0x80)
+	case prefix <= 0xbf:
+		lenOfLen := int(prefix - 0xb7)
+		l, err := decodeRLPLength(in[1:], lenOfLen)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		offset, length = 1+lenOfLen, l
+	case prefix <= 0xf7:
+		offset, length = 1, int(prefix-0xc0)
+	default:
+		lenOfLen := int(prefix - 0xf7)
+		l, err := decodeRLPLength(in[1:], lenOfLen)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		offset, length = 1+lenOfLen, l
+	}
+
+	if length > len(in)-offset {
+		return nil, nil, false, ErrInvalidTransaction
+	}
+	return in[offset : offset+length], in[offset+length:], isList, nil
+}
+
+func decodeRLPLength(in []byte, lenOfLen int) (int, error) {
+	// Lengths beyond 4 bytes exceed any sensible transaction.
+	if lenOfLen > 4 || len(in) < lenOfLen || in[0] == 0 {
+		return 0, ErrInvalidTransaction
+	}
+	length := 0
+	for _, b := range in[:lenOfLen] {
+		length = length<<8 | int(b)
+	}
+	return length, nil
+}
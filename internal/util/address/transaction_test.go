@@ -0,0 +1,130 @@
+package address_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/util/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rlpString and rlpList encode short RLP items, sufficient for the transactions of these tests.
+func rlpString(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return b
+	}
+	if len(b) <= 55 {
+		return append([]byte{byte(0x80 + len(b))}, b...)
+	}
+	return append([]byte{0xb8, byte(len(b))}, b...)
+}
+
+func rlpList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	if len(payload) <= 55 {
+		return append([]byte{byte(0xc0 + len(payload))}, payload...)
+	}
+	return append([]byte{0xf8, byte(len(payload))}, payload...)
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	require.NoError(t, err)
+	return b
+}
+
+func TestRecipientLegacy(t *testing.T) {
+	// https://eips.ethereum.org/EIPS/eip-155 signing data of the example transaction
+	res, err := address.DecodeTransfer("evm", "0xec098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080018080")
+	require.NoError(t, err)
+	assert.Equal(t, "0x3535353535353535353535353535353535353535", res.Recipient(false))
+	assert.True(t, res.HasValue)
+}
+
+func TestRecipientERC20(t *testing.T) {
+	token := mustHex(t, "0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	word := append(make([]byte, 12), mustHex(t, recipient)...)
+	amount := append(make([]byte, 31), 0x64)
+
+	transfer := append(append(mustHex(t, "a9059cbb"), word...), amount...)
+	tx := append([]byte{0x02}, rlpList(
+		rlpString([]byte{0x01}), rlpString(nil), rlpString([]byte{0x01}), rlpString([]byte{0x02}), rlpString([]byte{0x52, 0x08}),
+		rlpString(token), rlpString(nil), rlpString(transfer), rlpList(),
+	)...)
+	res, err := address.DecodeTransfer("EVM", hex.EncodeToString(tx))
+	require.NoError(t, err)
+	assert.Equal(t, recipient, res.Recipient(true))
+
+	// transferFrom moves the funds to its second argument.
+	transferFrom := append(append(append(mustHex(t, "23b872dd"), make([]byte, 32)...), word...), amount...)
+	tx = rlpList(rlpString(nil), rlpString([]byte{0x01}), rlpString([]byte{0x52, 0x08}), rlpString(token), rlpString(nil), rlpString(transferFrom))
+	res, err = address.DecodeTransfer("EVM", "0x"+hex.EncodeToString(tx))
+	require.NoError(t, err)
+	assert.Equal(t, recipient, res.Recipient(true))
+
+	// Calls of other methods are directed at the contract itself.
+	other := append(mustHex(t, "70a08231"), word...)
+	tx = rlpList(rlpString(nil), rlpString([]byte{0x01}), rlpString([]byte{0x52, 0x08}), rlpString(token), rlpString(nil), rlpString(other))
+	res, err = address.DecodeTransfer("EVM", "0x"+hex.EncodeToString(tx))
+	require.NoError(t, err)
+	assert.Equal(t, "0xdAC17F958D2ee523a2206206994597C13D831ec7", res.Recipient(true))
+}
+
+func TestRecipientERC20Bypass(t *testing.T) {
+	token := mustHex(t, "0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	recipient := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	word := append(make([]byte, 12), mustHex(t, recipient)...)
+	transfer := append(append(mustHex(t, "a9059cbb"), word...), append(make([]byte, 31), 0x64)...)
+
+	tests := []struct {
+		name          string
+		value         []byte
+		tokenContract bool
+		want          string
+	}{
+		{name: "token transfer", tokenContract: true, want: recipient},
+		{name: "native value with transfer calldata", value: []byte{0x01}, tokenContract: true, want: "0xdAC17F958D2ee523a2206206994597C13D831ec7"},
+		{name: "unknown contract with transfer calldata", want: "0xdAC17F958D2ee523a2206206994597C13D831ec7"},
+		{name: "unknown contract with native value", value: []byte{0x01}, want: "0xdAC17F958D2ee523a2206206994597C13D831ec7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := rlpList(rlpString(nil), rlpString([]byte{0x01}), rlpString([]byte{0x52, 0x08}), rlpString(token), rlpString(tt.value), rlpString(transfer))
+			res, err := address.DecodeTransfer("EVM", hex.EncodeToString(tx))
+			require.NoError(t, err)
+			assert.Equal(t, recipient, res.TokenRecipient)
+			assert.Equal(t, tt.want, res.Recipient(tt.tokenContract))
+		})
+	}
+}
+
+func TestRecipientInvalid(t *testing.T) {
+	truncated := append(mustHex(t, "a9059cbb"), make([]byte, 16)...)
+
+	for _, invalid := range []string{
+		"",
+		"0xdeadbeef",
+		"0xzz",
+		// contract creation
+		hex.EncodeToString(rlpList(rlpString(nil), rlpString([]byte{0x01}), rlpString([]byte{0x52, 0x08}), rlpString(nil), rlpString(nil), rlpString(nil))),
+		// truncated calldata
+		hex.EncodeToString(rlpList(rlpString(nil), rlpString([]byte{0x01}), rlpString([]byte{0x52, 0x08}), rlpString(make([]byte, 20)), rlpString(nil), rlpString(truncated))),
+		// missing fields
+		hex.EncodeToString(rlpList(rlpString(nil), rlpString([]byte{0x01}), rlpString([]byte{0x52, 0x08}), rlpString(make([]byte, 20)))),
+		// trailing bytes
+		"0xec098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008001808000",
+	} {
+		_, err := address.DecodeTransfer("EVM", invalid)
+		assert.ErrorIs(t, err, address.ErrInvalidTransaction, invalid)
+	}
+
+	_, err := address.DecodeTransfer("SOLANA", "0xdeadbeef")
+	assert.ErrorIs(t, err, address.ErrUnsupportedTransaction)
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"context"
+	"net"
+	"strings"
+)
+
+// RequestOrigin describes the client a request originated from, as recorded in the audit log.
+type RequestOrigin struct {
+	IPAddress string
+	UserAgent string
+}
+
+// NewRequestOrigin returns the origin for the given remote address and user agent. The remote address may
+// include a port, addresses which cannot be parsed as IP are dropped.
+func NewRequestOrigin(remoteAddr string, userAgent string) RequestOrigin {
+	host := strings.TrimSpace(remoteAddr)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	origin := RequestOrigin{
+		UserAgent: strings.TrimSpace(userAgent),
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		origin.IPAddress = ip.String()
+	}
+
+	return origin
+}
+
+// WithRequestOrigin returns a copy of the context carrying the given origin.
+func WithRequestOrigin(ctx context.Context, origin RequestOrigin) context.Context {
+	return context.WithValue(ctx, CTXKeyRequestOrigin, origin)
+}
+
+// RequestOriginFromContext returns the origin of the request, returning an empty origin if none was set
+// (e.g. for actions triggered by background jobs).
+func RequestOriginFromContext(ctx context.Context) RequestOrigin {
+	o := ctx.Value(CTXKeyRequestOrigin)
+	if o == nil {
+		return RequestOrigin{}
+	}
+
+	origin, ok := o.(RequestOrigin)
+	if !ok {
+		return RequestOrigin{}
+	}
+
+	return origin
+}
@@ -0,0 +1,38 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/kashguard/go-mpc-vault/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestOrigin(t *testing.T) {
+	tests := []struct {
+		remoteAddr string
+		userAgent  string
+		want       util.RequestOrigin
+	}{
+		{"192.168.0.1", "curl/8.0", util.RequestOrigin{IPAddress: "192.168.0.1", UserAgent: "curl/8.0"}},
+		{"192.168.0.1:52314", "", util.RequestOrigin{IPAddress: "192.168.0.1"}},
+		{"[2001:db8::1]:443", " grpc-go/1.70.0 ", util.RequestOrigin{IPAddress: "2001:db8::1", UserAgent: "grpc-go/1.70.0"}},
+		{"2001:DB8::1", "", util.RequestOrigin{IPAddress: "2001:db8::1"}},
+		{"not-an-ip, 10.0.0.1", "agent", util.RequestOrigin{UserAgent: "agent"}},
+		{"", "", util.RequestOrigin{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.remoteAddr, func(t *testing.T) {
+			assert.Equal(t, tt.want, util.NewRequestOrigin(tt.remoteAddr, tt.userAgent))
+		})
+	}
+}
+
+func TestRequestOriginFromContext(t *testing.T) {
+	ctx := t.Context()
+	assert.Equal(t, util.RequestOrigin{}, util.RequestOriginFromContext(ctx))
+
+	origin := util.RequestOrigin{IPAddress: "10.0.0.1", UserAgent: "test"}
+	ctx = util.WithRequestOrigin(ctx, origin)
+	assert.Equal(t, origin, util.RequestOriginFromContext(ctx))
+}
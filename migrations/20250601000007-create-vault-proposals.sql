@@ -0,0 +1,46 @@
+-- +migrate Up
+ALTER TABLE vaults
+    ADD COLUMN status varchar(50) NOT NULL DEFAULT 'active'; -- 'active', 'archived'
+
+ALTER TABLE vaults
+    ADD COLUMN archived_at timestamptz;
+
+CREATE TABLE IF NOT EXISTS vault_proposals (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+    vault_id uuid NOT NULL REFERENCES vaults (id) ON DELETE CASCADE,
+    initiator_id uuid REFERENCES users (id),
+    kind varchar(50) NOT NULL, -- 'threshold_change'
+    payload jsonb NOT NULL, -- e.g. {"threshold": 3}
+    required_approvals int NOT NULL,
+    status varchar(50) NOT NULL DEFAULT 'pending', -- pending, executed, rejected, cancelled
+    created_at timestamptz DEFAULT NOW(),
+    updated_at timestamptz DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS vault_proposal_approvals (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+    vault_proposal_id uuid NOT NULL REFERENCES vault_proposals (id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users (id),
+    action varchar(50) NOT NULL, -- 'approve', 'reject'
+    credential_id text, -- WebAuthn Credential ID used for the approval
+    created_at timestamptz DEFAULT NOW(),
+    updated_at timestamptz DEFAULT NOW(),
+    UNIQUE (vault_proposal_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vault_proposals_vault_id ON vault_proposals (vault_id);
+
+CREATE INDEX IF NOT EXISTS idx_vault_proposals_initiator_id ON vault_proposals (initiator_id);
+
+CREATE INDEX IF NOT EXISTS idx_vault_proposal_approvals_user_id ON vault_proposal_approvals (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS vault_proposal_approvals;
+
+DROP TABLE IF EXISTS vault_proposals;
+
+ALTER TABLE vaults
+    DROP COLUMN IF EXISTS archived_at;
+
+ALTER TABLE vaults
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,22 @@
+-- +migrate Up
+ALTER TABLE app_user_profiles
+    ADD COLUMN default_organization_id uuid REFERENCES organizations (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_app_user_profiles_default_organization_id ON app_user_profiles (default_organization_id);
+
+-- CreateRequest did not persist the vault of a request, derive it from the wallet.
+UPDATE
+    signing_requests
+SET
+    vault_id = wallets.vault_id
+FROM
+    wallets
+WHERE
+    signing_requests.wallet_id = wallets.id
+    AND signing_requests.vault_id IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_app_user_profiles_default_organization_id;
+
+ALTER TABLE app_user_profiles
+    DROP COLUMN IF EXISTS default_organization_id;
@@ -0,0 +1,29 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS organization_invitations (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    token uuid NOT NULL UNIQUE DEFAULT gen_random_uuid (),
+    email varchar(255) NOT NULL,
+    role varchar(50) NOT NULL, -- 'admin', 'operator', 'auditor'
+    status varchar(50) NOT NULL DEFAULT 'pending', -- pending, accepted, revoked
+    invited_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    accepted_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    valid_until timestamptz NOT NULL,
+    accepted_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_organization_id ON organization_invitations (organization_id);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_invited_by ON organization_invitations (invited_by);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_accepted_by ON organization_invitations (accepted_by);
+
+-- At most a single pending invitation per email and organization.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organization_invitations_pending_email ON organization_invitations (organization_id, email)
+WHERE
+    status = 'pending';
+
+-- +migrate Down
+DROP TABLE IF EXISTS organization_invitations;
@@ -0,0 +1,35 @@
+-- +migrate Up
+ALTER TABLE address_book
+    ADD COLUMN status varchar(50) NOT NULL DEFAULT 'active'; -- 'pending_approval', 'active'
+
+ALTER TABLE address_book
+    ADD COLUMN usable_after timestamptz; -- end of the cooling-off period, NULL for entries predating it
+
+ALTER TABLE address_book
+    ADD COLUMN approved_by uuid REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE address_book
+    ADD COLUMN approved_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS idx_address_book_approved_by ON address_book (approved_by);
+
+ALTER TABLE vaults
+    ADD COLUMN whitelist_only boolean NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE vaults
+    DROP COLUMN IF EXISTS whitelist_only;
+
+DROP INDEX IF EXISTS idx_address_book_approved_by;
+
+ALTER TABLE address_book
+    DROP COLUMN IF EXISTS approved_at;
+
+ALTER TABLE address_book
+    DROP COLUMN IF EXISTS approved_by;
+
+ALTER TABLE address_book
+    DROP COLUMN IF EXISTS usable_after;
+
+ALTER TABLE address_book
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- Audit entries have to outlive the users they reference, e.g. after an account was deleted.
+ALTER TABLE audit_logs
+    DROP CONSTRAINT IF EXISTS audit_logs_user_id_fkey;
+
+ALTER TABLE audit_logs
+    ADD COLUMN credential_id text; -- base64url encoded ID of the passkey which confirmed the action
+
+ALTER TABLE audit_logs
+    ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_organization_id_created_at ON audit_logs (organization_id, created_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_audit_logs_organization_id_created_at;
+
+ALTER TABLE audit_logs
+    ALTER COLUMN created_at DROP NOT NULL;
+
+ALTER TABLE audit_logs
+    DROP COLUMN IF EXISTS credential_id;
+
+ALTER TABLE audit_logs
+    ADD CONSTRAINT audit_logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id);
@@ -0,0 +1,34 @@
+-- +migrate Up
+-- Each organization (and the global chain of entries without organization) forms its own hash chain,
+-- an entry references the hash of its predecessor within the chain.
+ALTER TABLE audit_logs
+    ADD COLUMN sequence bigint,
+    ADD COLUMN prev_hash text,
+    ADD COLUMN hash text;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_audit_logs_chain_sequence ON audit_logs (COALESCE(organization_id, '00000000-0000-0000-0000-000000000000'::uuid), sequence);
+
+-- Checkpoints sign the head of a chain, so truncating or rewriting the chain is detectable.
+CREATE TABLE audit_checkpoints (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    organization_id uuid,
+    sequence bigint NOT NULL,
+    hash text NOT NULL,
+    key_id varchar(64) NOT NULL,
+    signature text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT audit_checkpoints_pkey PRIMARY KEY (id),
+    CONSTRAINT audit_checkpoints_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_checkpoints_organization_id_sequence ON audit_checkpoints (organization_id, sequence);
+
+-- +migrate Down
+DROP TABLE IF EXISTS audit_checkpoints;
+
+DROP INDEX IF EXISTS idx_audit_logs_chain_sequence;
+
+ALTER TABLE audit_logs
+    DROP COLUMN IF EXISTS hash,
+    DROP COLUMN IF EXISTS prev_hash,
+    DROP COLUMN IF EXISTS sequence;
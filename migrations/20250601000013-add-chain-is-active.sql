@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Inactive chains stay listed for existing wallets, but no new wallets can be created on them.
+ALTER TABLE chains
+    ADD COLUMN is_active boolean NOT NULL DEFAULT TRUE;
+
+CREATE INDEX IF NOT EXISTS idx_assets_chain_id ON assets (chain_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_assets_chain_id;
+
+ALTER TABLE chains
+    DROP COLUMN IF EXISTS is_active;
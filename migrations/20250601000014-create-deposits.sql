@@ -0,0 +1,49 @@
+-- +migrate Up
+-- Deposits are credited to the wallet balances once buried under the required confirmations of their chain.
+ALTER TABLE chains
+    ADD COLUMN required_confirmations int NOT NULL DEFAULT 12;
+
+-- The last block scanned for deposits per chain, scanning resumes with its successor.
+CREATE TABLE chain_scan_cursors (
+    chain_id varchar(50) NOT NULL,
+    block_number bigint NOT NULL,
+    block_hash varchar(255) NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT chain_scan_cursors_pkey PRIMARY KEY (chain_id),
+    CONSTRAINT chain_scan_cursors_chain_id_fkey FOREIGN KEY (chain_id) REFERENCES chains (id) ON DELETE CASCADE
+);
+
+CREATE TABLE deposits (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    wallet_id uuid NOT NULL,
+    chain_id varchar(50) NOT NULL,
+    asset_id uuid, -- NULL for native transfers on chains without native asset within the catalog
+    tx_hash varchar(255) NOT NULL,
+    log_index int NOT NULL, -- -1 for native transfers
+    from_address varchar(255) NOT NULL,
+    amount varchar(100) NOT NULL, -- Wei/Lamports
+    block_number bigint NOT NULL,
+    block_hash varchar(255) NOT NULL,
+    confirmations int NOT NULL DEFAULT 0,
+    status varchar(20) NOT NULL DEFAULT 'pending', -- 'pending', 'confirmed', 'reorged'
+    confirmed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT deposits_pkey PRIMARY KEY (id),
+    CONSTRAINT deposits_wallet_id_fkey FOREIGN KEY (wallet_id) REFERENCES wallets (id) ON DELETE CASCADE,
+    CONSTRAINT deposits_chain_id_fkey FOREIGN KEY (chain_id) REFERENCES chains (id),
+    CONSTRAINT deposits_asset_id_fkey FOREIGN KEY (asset_id) REFERENCES assets (id),
+    CONSTRAINT deposits_chain_id_tx_hash_log_index_wallet_id_key UNIQUE (chain_id, tx_hash, log_index, wallet_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_deposits_wallet_id_created_at ON deposits (wallet_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_deposits_chain_id_status ON deposits (chain_id, status);
+
+-- +migrate Down
+DROP TABLE IF EXISTS deposits;
+
+DROP TABLE IF EXISTS chain_scan_cursors;
+
+ALTER TABLE chains
+    DROP COLUMN IF EXISTS required_confirmations;
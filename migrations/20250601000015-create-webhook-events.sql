@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Events received from blockchain data providers, deduplicated by the event ID of the provider.
+-- The payload is kept to process failed events again.
+CREATE TABLE webhook_events (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    provider varchar(50) NOT NULL,
+    event_id varchar(255) NOT NULL,
+    event_type varchar(100) NOT NULL,
+    payload jsonb NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'received', -- 'received', 'processing', 'processed', 'ignored', 'failed'
+    attempts int NOT NULL DEFAULT 0,
+    last_error text,
+    processed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhook_events_pkey PRIMARY KEY (id),
+    CONSTRAINT webhook_events_provider_event_id_key UNIQUE (provider, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_events_status_created_at ON webhook_events (status, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_events;
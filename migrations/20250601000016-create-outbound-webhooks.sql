@@ -0,0 +1,68 @@
+-- +migrate Up
+-- Endpoints of organizations subscribed to events, payloads are signed with the secret of the endpoint.
+CREATE TABLE webhook_endpoints (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    organization_id uuid NOT NULL,
+    url text NOT NULL,
+    description varchar(255),
+    secret varchar(255) NOT NULL,
+    event_types text[] NOT NULL DEFAULT '{}', -- empty for all event types
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhook_endpoints_pkey PRIMARY KEY (id),
+    CONSTRAINT webhook_endpoints_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE,
+    CONSTRAINT webhook_endpoints_created_by_fkey FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_organization_id ON webhook_endpoints (organization_id);
+
+-- Transactional outbox, events are written within the transaction of the change they describe and
+-- dispatched to the endpoints subscribed afterwards.
+CREATE TABLE outbox_events (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    organization_id uuid NOT NULL,
+    event_type varchar(100) NOT NULL,
+    resource_type varchar(50) NOT NULL,
+    resource_id varchar(255) NOT NULL,
+    payload jsonb NOT NULL,
+    dispatched_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT outbox_events_pkey PRIMARY KEY (id),
+    CONSTRAINT outbox_events_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_undispatched ON outbox_events (created_at)
+WHERE
+    dispatched_at IS NULL;
+
+CREATE TABLE webhook_deliveries (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    endpoint_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'pending', -- 'pending', 'succeeded', 'dead'
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT NOW(),
+    last_status_code int,
+    last_error text,
+    delivered_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id),
+    CONSTRAINT webhook_deliveries_endpoint_id_fkey FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints (id) ON DELETE CASCADE,
+    CONSTRAINT webhook_deliveries_event_id_fkey FOREIGN KEY (event_id) REFERENCES outbox_events (id) ON DELETE CASCADE,
+    CONSTRAINT webhook_deliveries_endpoint_id_event_id_key UNIQUE (endpoint_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at)
+WHERE
+    status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint_id_created_at ON webhook_deliveries (endpoint_id, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TABLE IF EXISTS outbox_events;
+
+DROP TABLE IF EXISTS webhook_endpoints;
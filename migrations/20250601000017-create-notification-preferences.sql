@@ -0,0 +1,22 @@
+-- +migrate Up
+-- Language of the device the token belongs to, notifications are localized per device.
+ALTER TABLE push_tokens
+    ADD COLUMN language varchar(35);
+
+-- Notification events a user receives for an organization, users without preferences receive all events.
+CREATE TABLE notification_preferences (
+    user_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    events text[] NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT notification_preferences_pkey PRIMARY KEY (user_id, organization_id),
+    CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT notification_preferences_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notification_preferences;
+
+ALTER TABLE push_tokens
+    DROP COLUMN IF EXISTS language;
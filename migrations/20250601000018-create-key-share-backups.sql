@@ -0,0 +1,27 @@
+-- +migrate Up
+-- Backup state of the client share each user holds of a key, users without row did not back up their share yet.
+CREATE TABLE key_share_backups (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    key_id varchar(255) NOT NULL,
+    share_index int NOT NULL,
+    status varchar(20) NOT NULL, -- delivered, confirmed, failed
+    device_public_key bytea NOT NULL,
+    delivered_at timestamptz NOT NULL,
+    confirmed_at timestamptz,
+    failure_reason text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT key_share_backups_pkey PRIMARY KEY (id),
+    CONSTRAINT key_share_backups_user_id_key_id_key UNIQUE (user_id, key_id),
+    CONSTRAINT key_share_backups_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE,
+    CONSTRAINT key_share_backups_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_key_share_backups_organization_id ON key_share_backups (organization_id);
+
+CREATE INDEX IF NOT EXISTS idx_key_share_backups_key_id ON key_share_backups (key_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS key_share_backups;
@@ -0,0 +1,49 @@
+-- +migrate Up
+-- Recovery of the share a user held of a key, e.g. after the device was lost. Recoveries must be approved by a quorum of
+-- the admins of the organization, the key is frozen for signing while a recovery is pending or recovering.
+CREATE TABLE key_share_recoveries (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    organization_id uuid NOT NULL,
+    vault_id uuid NOT NULL,
+    key_id varchar(255) NOT NULL,
+    node_id varchar(255) NOT NULL,
+    subject_user_id uuid NOT NULL,
+    initiator_id uuid,
+    reason text,
+    required_approvals int NOT NULL,
+    status varchar(20) NOT NULL, -- pending, recovering, completed, failed, rejected
+    failure_reason text,
+    completed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT key_share_recoveries_pkey PRIMARY KEY (id),
+    CONSTRAINT key_share_recoveries_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE,
+    CONSTRAINT key_share_recoveries_vault_id_fkey FOREIGN KEY (vault_id) REFERENCES vaults (id) ON DELETE CASCADE,
+    CONSTRAINT key_share_recoveries_subject_user_id_fkey FOREIGN KEY (subject_user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT key_share_recoveries_initiator_id_fkey FOREIGN KEY (initiator_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_key_share_recoveries_organization_id ON key_share_recoveries (organization_id);
+
+-- At most one recovery per key is active at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_key_share_recoveries_active_key_id ON key_share_recoveries (key_id)
+WHERE
+    status IN ('pending', 'recovering');
+
+CREATE TABLE key_share_recovery_approvals (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    recovery_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    action varchar(20) NOT NULL, -- approve, reject
+    credential_id text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT key_share_recovery_approvals_pkey PRIMARY KEY (id),
+    CONSTRAINT key_share_recovery_approvals_recovery_id_user_id_key UNIQUE (recovery_id, user_id),
+    CONSTRAINT key_share_recovery_approvals_recovery_id_fkey FOREIGN KEY (recovery_id) REFERENCES key_share_recoveries (id) ON DELETE CASCADE,
+    CONSTRAINT key_share_recovery_approvals_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS key_share_recovery_approvals;
+
+DROP TABLE IF EXISTS key_share_recoveries;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Backup health of the keys of the vaults as last checked against the MPC server. A key is at risk if the share of a
+-- node could not be recovered from its backup shares.
+CREATE TABLE key_backup_health (
+    key_id varchar(255) NOT NULL,
+    organization_id uuid NOT NULL,
+    vault_id uuid NOT NULL,
+    at_risk boolean NOT NULL,
+    nodes jsonb NOT NULL DEFAULT '[]', -- node_id, total_shares, required_shares, backup_shares, recoverable per node
+    error text, -- set if the MPC server could not be queried, nodes keep the last known state
+    checked_at timestamptz NOT NULL,
+    degraded_at timestamptz, -- the key became at risk, cleared once healthy again
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT key_backup_health_pkey PRIMARY KEY (key_id),
+    CONSTRAINT key_backup_health_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE,
+    CONSTRAINT key_backup_health_vault_id_fkey FOREIGN KEY (vault_id) REFERENCES vaults (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_key_backup_health_organization_id ON key_backup_health (organization_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS key_backup_health;
@@ -0,0 +1,34 @@
+-- +migrate Up
+-- Signer devices of users registered as client nodes with the MPC server. Each device is bound to the passkey it was
+-- enrolled with and revoked along with it.
+CREATE TABLE user_devices (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    credential_id uuid, -- user_credentials.id, unset once the credential was removed
+    node_id varchar(255) NOT NULL, -- assigned by the MPC server, e.g. client-{user_id}
+    device_id varchar(255) NOT NULL,
+    public_key text NOT NULL,
+    name varchar(100),
+    version varchar(50),
+    status varchar(20) NOT NULL, -- active, revoked
+    node_status varchar(50), -- status reported by the last heartbeat, e.g. online, busy
+    last_heartbeat_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT user_devices_pkey PRIMARY KEY (id),
+    CONSTRAINT user_devices_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT user_devices_credential_id_fkey FOREIGN KEY (credential_id) REFERENCES user_credentials (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_devices_user_id ON user_devices (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_user_devices_node_id ON user_devices (node_id);
+
+-- A device is enrolled at most once at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_devices_active_device_id ON user_devices (user_id, device_id)
+WHERE
+    status = 'active';
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_devices;
@@ -0,0 +1,26 @@
+-- +migrate Up
+-- Lifecycle and annotations of the root keys backing the vaults, kept by the vault as the MPC server offers no way to
+-- update them. Keys without a row are active.
+CREATE TABLE root_keys (
+    key_id varchar(255) NOT NULL, -- MPC Infra KeyID
+    vault_id uuid NOT NULL,
+    description text,
+    tags jsonb NOT NULL DEFAULT '{}',
+    status varchar(50) NOT NULL DEFAULT 'active', -- active, retiring, retired
+    successor_key_id varchar(255), -- key the funds are swept to before retirement
+    retirement_proposal_id uuid,
+    retiring_at timestamptz,
+    retired_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT root_keys_pkey PRIMARY KEY (key_id),
+    CONSTRAINT root_keys_vault_id_fkey FOREIGN KEY (vault_id) REFERENCES vaults (id) ON DELETE CASCADE,
+    CONSTRAINT root_keys_retirement_proposal_id_fkey FOREIGN KEY (retirement_proposal_id) REFERENCES vault_proposals (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_root_keys_vault_id ON root_keys (vault_id);
+
+CREATE INDEX IF NOT EXISTS idx_root_keys_retirement_proposal_id ON root_keys (retirement_proposal_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS root_keys;
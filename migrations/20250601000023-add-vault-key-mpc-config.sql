@@ -0,0 +1,20 @@
+-- +migrate Up
+-- MPC configuration the key was generated with, unset for keys created before it was configurable.
+ALTER TABLE vault_keys
+    ADD COLUMN protocol varchar(50); -- 'gg18', 'gg20', 'frost'
+
+ALTER TABLE vault_keys
+    ADD COLUMN threshold int; -- number of nodes required to sign
+
+ALTER TABLE vault_keys
+    ADD COLUMN total_nodes int;
+
+-- +migrate Down
+ALTER TABLE vault_keys
+    DROP COLUMN IF EXISTS total_nodes;
+
+ALTER TABLE vault_keys
+    DROP COLUMN IF EXISTS threshold;
+
+ALTER TABLE vault_keys
+    DROP COLUMN IF EXISTS protocol;
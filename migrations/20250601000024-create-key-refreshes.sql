@@ -0,0 +1,48 @@
+-- +migrate Up
+-- Epoch of the shares of the key, incremented by every completed refresh. Shares of earlier epochs are invalidated by
+-- the MPC server.
+ALTER TABLE vault_keys
+    ADD COLUMN refresh_epoch int NOT NULL DEFAULT 0;
+
+ALTER TABLE vault_keys
+    ADD COLUMN refreshed_at timestamptz;
+
+-- Proactive refreshes of the shares of the keys of the vaults, kept as rotation history. Refreshes are approved by the
+-- quorum of the vault through a proposal, the key is frozen for signing while a refresh is approved or running.
+CREATE TABLE key_refreshes (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    vault_id uuid NOT NULL,
+    key_id varchar(255) NOT NULL,
+    proposal_id uuid,
+    trigger varchar(20) NOT NULL, -- manual, scheduled
+    status varchar(20) NOT NULL, -- approved, running, completed, failed
+    epoch int NOT NULL, -- epoch the shares are moved to
+    failure_reason text,
+    started_at timestamptz,
+    completed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT key_refreshes_pkey PRIMARY KEY (id),
+    CONSTRAINT key_refreshes_vault_id_fkey FOREIGN KEY (vault_id) REFERENCES vaults (id) ON DELETE CASCADE,
+    CONSTRAINT key_refreshes_proposal_id_fkey FOREIGN KEY (proposal_id) REFERENCES vault_proposals (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_key_refreshes_key_id ON key_refreshes (key_id);
+
+CREATE INDEX IF NOT EXISTS idx_key_refreshes_vault_id ON key_refreshes (vault_id);
+
+CREATE INDEX IF NOT EXISTS idx_key_refreshes_proposal_id ON key_refreshes (proposal_id);
+
+-- At most one refresh per key is active at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_key_refreshes_active_key_id ON key_refreshes (key_id)
+WHERE
+    status IN ('approved', 'running');
+
+-- +migrate Down
+DROP TABLE IF EXISTS key_refreshes;
+
+ALTER TABLE vault_keys
+    DROP COLUMN IF EXISTS refreshed_at;
+
+ALTER TABLE vault_keys
+    DROP COLUMN IF EXISTS refresh_epoch;
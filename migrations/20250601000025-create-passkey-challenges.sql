@@ -0,0 +1,27 @@
+-- +migrate Up
+-- Challenges issued to users confirming sensitive operations (votes, ownership transfers, device enrollments) with
+-- a passkey assertion. A challenge is consumed by the first assertion answering it.
+CREATE TABLE passkey_challenges (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    challenge varchar(255) NOT NULL, -- base64url encoded, as echoed in the client data
+    session_data jsonb NOT NULL, -- webauthn.SessionData the assertion is validated against
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT passkey_challenges_pkey PRIMARY KEY (id),
+    CONSTRAINT passkey_challenges_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_passkey_challenges_challenge ON passkey_challenges (challenge);
+
+CREATE INDEX IF NOT EXISTS idx_passkey_challenges_expires_at ON passkey_challenges (expires_at);
+
+-- Whether the passkey may be synced across devices, assertions reporting otherwise are refused.
+ALTER TABLE user_credentials
+    ADD COLUMN backup_eligible boolean NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE user_credentials
+    DROP COLUMN IF EXISTS backup_eligible;
+
+DROP TABLE IF EXISTS passkey_challenges;
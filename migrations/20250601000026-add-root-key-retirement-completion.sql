@@ -0,0 +1,18 @@
+-- +migrate Up
+-- Approved key_retirement_completion proposal, the key is deleted once its funds were confirmed swept.
+ALTER TABLE root_keys
+    ADD COLUMN completion_proposal_id uuid;
+
+ALTER TABLE root_keys
+    ADD CONSTRAINT root_keys_completion_proposal_id_fkey FOREIGN KEY (completion_proposal_id) REFERENCES vault_proposals (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_root_keys_completion_proposal_id ON root_keys (completion_proposal_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_root_keys_completion_proposal_id;
+
+ALTER TABLE root_keys
+    DROP CONSTRAINT IF EXISTS root_keys_completion_proposal_id_fkey;
+
+ALTER TABLE root_keys
+    DROP COLUMN IF EXISTS completion_proposal_id;
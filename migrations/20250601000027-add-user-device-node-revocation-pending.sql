@@ -0,0 +1,15 @@
+-- +migrate Up
+-- Set along with the revocation of the device until its client node was reported revoked to the MPC server.
+ALTER TABLE user_devices
+    ADD COLUMN node_revocation_pending boolean NOT NULL DEFAULT FALSE;
+
+-- Revocations reported before could have failed, they are reported once more.
+UPDATE user_devices SET node_revocation_pending = TRUE WHERE status = 'revoked';
+
+CREATE INDEX IF NOT EXISTS idx_user_devices_node_revocation_pending ON user_devices (node_id) WHERE node_revocation_pending;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_user_devices_node_revocation_pending;
+
+ALTER TABLE user_devices
+    DROP COLUMN IF EXISTS node_revocation_pending;
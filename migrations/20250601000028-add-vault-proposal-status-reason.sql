@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Why the proposal was cancelled instead of executed once approved.
+ALTER TABLE vault_proposals
+    ADD COLUMN status_reason text;
+
+-- +migrate Down
+ALTER TABLE vault_proposals
+    DROP COLUMN IF EXISTS status_reason;
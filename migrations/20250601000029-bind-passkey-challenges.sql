@@ -0,0 +1,13 @@
+-- +migrate Up
+-- Challenges are bound to the operation and resource they confirm, an assertion answering a challenge issued for
+-- another operation is refused. Challenges issued before are unbound and dropped.
+DELETE FROM passkey_challenges;
+
+ALTER TABLE passkey_challenges
+    ADD COLUMN action text NOT NULL,
+    ADD COLUMN resource_id text NOT NULL;
+
+-- +migrate Down
+ALTER TABLE passkey_challenges
+    DROP COLUMN IF EXISTS resource_id,
+    DROP COLUMN IF EXISTS action;